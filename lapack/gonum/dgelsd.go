@@ -0,0 +1,241 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dgelsd computes the minimum-norm solution to a real linear least squares
+// problem
+//
+//	minimize || A*X - B ||_2
+//
+// using the singular value decomposition (SVD) of A. A is an m×n matrix which
+// may be rank-deficient.
+//
+// Several right hand side vectors b and solution vectors x can be handled in a
+// single call; they are stored as the columns of the max(m,n)×nrhs right hand
+// side matrix B and the n×nrhs solution matrix X.
+//
+// A is first reduced to bidiagonal form and the least squares problem with the
+// bidiagonal matrix is solved by Dlalsd, which uses a divide and conquer
+// method.
+//
+// The effective rank of A is determined by treating as zero those singular
+// values which are less than or equal to rcond times the largest singular
+// value. If rcond is not in the interval (0,1), machine precision is used
+// instead.
+//
+// On entry, b contains the m×nrhs right hand side matrix B. On return, the
+// leading n×nrhs submatrix of b contains the solution matrix X. b must have
+// at least max(m,n) rows.
+//
+// On return, A has been overwritten.
+//
+// s must have length min(m,n), otherwise Dgelsd will panic. On return, s
+// contains the singular values of A in decreasing order. The condition number
+// of A in the 2-norm is s[0]/s[min(m,n)-1].
+//
+// work must have length at least max(1,lwork), and lwork must be -1 or at
+// least
+//
+//	3*mn + max(max(m,n), nrhs, 9*mn + 2*mn*smlsiz + 8*mn*nlvl + mn*nrhs + (smlsiz+1)²)
+//
+// and iwork must have length at least 3*mn*nlvl + 11*mn, where mn = min(m,n),
+// smlsiz = 25 is the maximum size of the subproblems at the bottom of the
+// computation tree and
+//
+//	nlvl = max(0, int(log₂(mn/(smlsiz+1))) + 1).
+//
+// If mn == 0, lwork must be at least 1 and iwork is not referenced. If
+// lwork == -1, instead of performing Dgelsd, only the optimal value of lwork
+// will be stored in work[0] and the minimum length of iwork in iwork[0], and
+// iwork must have length at least 1.
+//
+// Dgelsd returns the effective rank of A and whether the computation of the SVD
+// converged.
+func (impl Implementation) Dgelsd(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, s []float64, rcond float64, work []float64, lwork int, iwork []int) (rank int, ok bool) {
+	mn := min(m, n)
+	mx := max(m, n)
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	}
+
+	// Workspace layout: the off-diagonal of the bidiagonal matrix is stored
+	// in work[0:mn], τ_Q in work[mn:2*mn] and τ_P in work[2*mn:3*mn]. The
+	// remaining workspace is used by the called routines.
+	smlsiz := impl.Ilaenv(9, "DGELSD", " ", 0, 0, 0, 0)
+	lwkmin := 1
+	lwkopt := 1
+	liwork := 1
+	if mn > 0 {
+		nlvl := max(0, int(math.Log(float64(mn)/float64(smlsiz+1))/math.Ln2)+1)
+		wlalsd := 9*mn + 2*mn*smlsiz + 8*mn*nlvl + mn*nrhs + (smlsiz+1)*(smlsiz+1)
+		liwork = 3*mn*nlvl + 11*mn
+		lwkmin = 3*mn + max(mx, nrhs, wlalsd)
+		impl.Dgebrd(m, n, a, lda, s, work, work, work, work, -1)
+		lwkopt = max(lwkmin, 3*mn+int(work[0]))
+		impl.Dormbr(lapack.ApplyQ, blas.Left, blas.Trans, m, nrhs, n, a, lda, work, b, ldb, work, -1)
+		lwkopt = max(lwkopt, 3*mn+int(work[0]))
+		impl.Dormbr(lapack.ApplyP, blas.Left, blas.NoTrans, n, nrhs, mn, a, lda, work, b, ldb, work, -1)
+		lwkopt = max(lwkopt, 3*mn+int(work[0]))
+	}
+	switch {
+	case lwork < lwkmin && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	if lwork == -1 {
+		if len(iwork) < 1 {
+			panic(shortIWork)
+		}
+		work[0] = float64(lwkopt)
+		iwork[0] = liwork
+		return 0, true
+	}
+
+	// Quick return if possible.
+	if mn == 0 {
+		if nrhs > 0 {
+			impl.Dlaset(blas.All, mx, nrhs, 0, 0, b, ldb)
+		}
+		work[0] = 1
+		return 0, true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case nrhs > 0 && len(b) < (mx-1)*ldb+nrhs:
+		panic(shortB)
+	case len(s) != mn:
+		panic(badLenS)
+	case len(iwork) < liwork:
+		panic(shortIWork)
+	}
+
+	// Get machine parameters.
+	eps := dlamchP
+	sfmin := dlamchS
+	smlnum := sfmin / eps
+	bignum := 1 / smlnum
+
+	// Scale A if max entry outside range [smlnum,bignum].
+	anrm := impl.Dlange(lapack.MaxAbs, m, n, a, lda, nil)
+	var iascl int
+	switch {
+	case anrm == 0:
+		// Matrix all zero. Return zero solution.
+		impl.Dlaset(blas.All, mx, nrhs, 0, 0, b, ldb)
+		for i := range s {
+			s[i] = 0
+		}
+		work[0] = float64(lwkopt)
+		return 0, true
+	case anrm < smlnum:
+		// Scale matrix norm up to smlnum.
+		impl.Dlascl(lapack.General, 0, 0, anrm, smlnum, m, n, a, lda)
+		iascl = 1
+	case anrm > bignum:
+		// Scale matrix norm down to bignum.
+		impl.Dlascl(lapack.General, 0, 0, anrm, bignum, m, n, a, lda)
+		iascl = 2
+	}
+
+	// Scale B if max entry outside range [smlnum,bignum].
+	var bnrm float64
+	var ibscl int
+	if nrhs > 0 {
+		bnrm = impl.Dlange(lapack.MaxAbs, m, nrhs, b, ldb, nil)
+		switch {
+		case 0 < bnrm && bnrm < smlnum:
+			// Scale matrix norm up to smlnum.
+			impl.Dlascl(lapack.General, 0, 0, bnrm, smlnum, m, nrhs, b, ldb)
+			ibscl = 1
+		case bnrm > bignum:
+			// Scale matrix norm down to bignum.
+			impl.Dlascl(lapack.General, 0, 0, bnrm, bignum, m, nrhs, b, ldb)
+			ibscl = 2
+		}
+
+		// If m < n, make sure that the rows of B below the right hand
+		// side are zero.
+		if m < n {
+			impl.Dlaset(blas.All, n-m, nrhs, 0, 0, b[m*ldb:], ldb)
+		}
+	}
+
+	e := work[:mn]
+	tauQ := work[mn : 2*mn]
+	tauP := work[2*mn : 3*mn]
+	wrk := work[3*mn : lwork]
+
+	// Bidiagonalize A = Q * B_d * Pᵀ, storing the diagonal of B_d in s.
+	impl.Dgebrd(m, n, a, lda, s, e, tauQ, tauP, wrk, len(wrk))
+
+	uplo := blas.Upper
+	if m < n {
+		uplo = blas.Lower
+	}
+	if nrhs == 0 {
+		// There is no right hand side, so only compute the singular values
+		// and determine the effective rank of A.
+		ok = impl.Dbdsqr(uplo, mn, 0, 0, 0, s, e, nil, 1, nil, 1, nil, 1, work[mn:lwork])
+		if ok {
+			rcnd := rcond
+			if rcond <= 0 || rcond >= 1 {
+				rcnd = dlamchE
+			}
+			thr := rcnd * s[0]
+			for rank < mn && s[rank] > thr {
+				rank++
+			}
+		}
+	} else {
+		// Multiply B by the transpose of the left bidiagonalizing vectors.
+		impl.Dormbr(lapack.ApplyQ, blas.Left, blas.Trans, m, nrhs, n, a, lda, tauQ, b, ldb, wrk, len(wrk))
+
+		// Solve the bidiagonal least squares problem.
+		rank, ok = impl.Dlalsd(uplo, smlsiz, mn, nrhs, s, e, b, ldb, rcond, wrk, iwork)
+		if ok {
+			// Multiply B by the right bidiagonalizing vectors.
+			impl.Dormbr(lapack.ApplyP, blas.Left, blas.NoTrans, n, nrhs, mn, a, lda, tauP, b, ldb, wrk, len(wrk))
+		}
+	}
+
+	// Undo scaling.
+	switch iascl {
+	case 1:
+		impl.Dlascl(lapack.General, 0, 0, anrm, smlnum, n, nrhs, b, ldb)
+		impl.Dlascl(lapack.General, 0, 0, smlnum, anrm, mn, 1, s, 1)
+	case 2:
+		impl.Dlascl(lapack.General, 0, 0, anrm, bignum, n, nrhs, b, ldb)
+		impl.Dlascl(lapack.General, 0, 0, bignum, anrm, mn, 1, s, 1)
+	}
+	switch ibscl {
+	case 1:
+		impl.Dlascl(lapack.General, 0, 0, smlnum, bnrm, n, nrhs, b, ldb)
+	case 2:
+		impl.Dlascl(lapack.General, 0, 0, bignum, bnrm, n, nrhs, b, ldb)
+	}
+
+	work[0] = float64(lwkopt)
+	return rank, ok
+}
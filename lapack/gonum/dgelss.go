@@ -0,0 +1,230 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dgelss computes the minimum-norm solution to a real linear least squares
+// problem
+//
+//	minimize || A*X - B ||_2
+//
+// using the singular value decomposition (SVD) of A. A is an m×n matrix which
+// may be rank-deficient.
+//
+// Several right hand side vectors b and solution vectors x can be handled in a
+// single call; they are stored as the columns of the max(m,n)×nrhs right hand
+// side matrix B and the n×nrhs solution matrix X.
+//
+// A is first reduced to bidiagonal form and the SVD of the bidiagonal matrix
+// is computed by Dbdsqr, which applies the left singular vectors directly to B.
+//
+// The effective rank of A is determined by treating as zero those singular
+// values which are less than or equal to rcond times the largest singular
+// value. If rcond is negative, machine precision is used instead.
+//
+// On entry, b contains the m×nrhs right hand side matrix B. On return, the
+// leading n×nrhs submatrix of b contains the solution matrix X. b must have
+// at least max(m,n) rows.
+//
+// On return, the first min(m,n) rows of A have been overwritten by the right
+// singular vectors of A, stored rowwise.
+//
+// s must have length min(m,n), otherwise Dgelss will panic. On return, s
+// contains the singular values of A in decreasing order. The condition number
+// of A in the 2-norm is s[0]/s[min(m,n)-1].
+//
+// work must have length at least max(1,lwork), and lwork must be -1 or at
+// least
+//
+//	3*mn + max(2*mn, max(m,n), nrhs)
+//
+// where mn = min(m,n), otherwise Dgelss will panic. If mn == 0, lwork must be
+// at least 1. If lwork == -1, instead of performing Dgelss, only the optimal
+// value of lwork will be stored in work[0].
+//
+// Dgelss returns the effective rank of A and whether the computation of the SVD
+// converged.
+func (impl Implementation) Dgelss(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, s []float64, rcond float64, work []float64, lwork int) (rank int, ok bool) {
+	mn := min(m, n)
+	mx := max(m, n)
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	}
+
+	// Workspace layout: the off-diagonal of the bidiagonal matrix is stored
+	// in work[0:mn], τ_Q in work[mn:2*mn] and τ_P in work[2*mn:3*mn]. The
+	// remaining workspace is used by the called routines.
+	lwkmin := 1
+	lwkopt := 1
+	if mn > 0 {
+		lwkmin = 3*mn + max(2*mn, mx, nrhs)
+		impl.Dgebrd(m, n, a, lda, s, work, work, work, work, -1)
+		lwkopt = max(lwkmin, 3*mn+int(work[0]))
+		impl.Dormbr(lapack.ApplyQ, blas.Left, blas.Trans, m, nrhs, n, a, lda, work, b, ldb, work, -1)
+		lwkopt = max(lwkopt, 3*mn+int(work[0]))
+		impl.Dorgbr(lapack.GeneratePT, mn, n, mn, a, lda, work, work, -1)
+		lwkopt = max(lwkopt, 3*mn+int(work[0]))
+		lwkopt = max(lwkopt, n*nrhs)
+	}
+	switch {
+	case lwork < lwkmin && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	if lwork == -1 {
+		work[0] = float64(lwkopt)
+		return 0, true
+	}
+
+	// Quick return if possible.
+	if mn == 0 {
+		if nrhs > 0 {
+			impl.Dlaset(blas.All, mx, nrhs, 0, 0, b, ldb)
+		}
+		work[0] = 1
+		return 0, true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case nrhs > 0 && len(b) < (mx-1)*ldb+nrhs:
+		panic(shortB)
+	case len(s) != mn:
+		panic(badLenS)
+	}
+
+	// Get machine parameters.
+	eps := dlamchP
+	sfmin := dlamchS
+	smlnum := sfmin / eps
+	bignum := 1 / smlnum
+
+	// Scale A if max entry outside range [smlnum,bignum].
+	anrm := impl.Dlange(lapack.MaxAbs, m, n, a, lda, nil)
+	var iascl int
+	switch {
+	case anrm == 0:
+		// Matrix all zero. Return zero solution.
+		impl.Dlaset(blas.All, mx, nrhs, 0, 0, b, ldb)
+		for i := range s {
+			s[i] = 0
+		}
+		work[0] = float64(lwkopt)
+		return 0, true
+	case anrm < smlnum:
+		// Scale matrix norm up to smlnum.
+		impl.Dlascl(lapack.General, 0, 0, anrm, smlnum, m, n, a, lda)
+		iascl = 1
+	case anrm > bignum:
+		// Scale matrix norm down to bignum.
+		impl.Dlascl(lapack.General, 0, 0, anrm, bignum, m, n, a, lda)
+		iascl = 2
+	}
+
+	// Scale B if max entry outside range [smlnum,bignum].
+	var bnrm float64
+	var ibscl int
+	if nrhs > 0 {
+		bnrm = impl.Dlange(lapack.MaxAbs, m, nrhs, b, ldb, nil)
+		switch {
+		case 0 < bnrm && bnrm < smlnum:
+			// Scale matrix norm up to smlnum.
+			impl.Dlascl(lapack.General, 0, 0, bnrm, smlnum, m, nrhs, b, ldb)
+			ibscl = 1
+		case bnrm > bignum:
+			// Scale matrix norm down to bignum.
+			impl.Dlascl(lapack.General, 0, 0, bnrm, bignum, m, nrhs, b, ldb)
+			ibscl = 2
+		}
+	}
+
+	e := work[:mn]
+	tauQ := work[mn : 2*mn]
+	tauP := work[2*mn : 3*mn]
+	wrk := work[3*mn : lwork]
+
+	// Bidiagonalize A = Q * B_d * Pᵀ, storing the diagonal of B_d in s.
+	impl.Dgebrd(m, n, a, lda, s, e, tauQ, tauP, wrk, len(wrk))
+
+	// Multiply B by the transpose of the left bidiagonalizing vectors.
+	impl.Dormbr(lapack.ApplyQ, blas.Left, blas.Trans, m, nrhs, n, a, lda, tauQ, b, ldb, wrk, len(wrk))
+
+	// Generate the right bidiagonalizing vectors in A.
+	impl.Dorgbr(lapack.GeneratePT, mn, n, mn, a, lda, tauP, wrk, len(wrk))
+
+	// Perform bidiagonal QR iteration, multiplying B by the transpose of the
+	// left singular vectors and computing the right singular vectors in A.
+	uplo := blas.Upper
+	if m < n {
+		uplo = blas.Lower
+	}
+	ok = impl.Dbdsqr(uplo, mn, n, 0, nrhs, s, e, a, lda, nil, 1, b, ldb, work[mn:lwork])
+	if ok {
+		// Determine the effective rank of A and multiply B by the reciprocals
+		// of the singular values.
+		thr := max(rcond*s[0], sfmin)
+		if rcond < 0 {
+			thr = max(eps*s[0], sfmin)
+		}
+		for rank < mn && s[rank] > thr {
+			rank++
+		}
+		if nrhs > 0 {
+			for i := 0; i < mn; i++ {
+				if i < rank {
+					impl.Drscl(nrhs, s[i], b[i*ldb:], 1)
+				} else {
+					impl.Dlaset(blas.All, 1, nrhs, 0, 0, b[i*ldb:], ldb)
+				}
+			}
+
+			// Multiply B by the right singular vectors, using as many
+			// columns of B at a time as fit into the workspace.
+			bi := blas64.Implementation()
+			bl := min(nrhs, lwork/n)
+			for j := 0; j < nrhs; j += bl {
+				nc := min(bl, nrhs-j)
+				bi.Dgemm(blas.Trans, blas.NoTrans, n, nc, mn, 1, a, lda, b[j:], ldb, 0, work, nc)
+				impl.Dlacpy(blas.All, n, nc, work, nc, b[j:], ldb)
+			}
+		}
+	}
+
+	// Undo scaling.
+	switch iascl {
+	case 1:
+		impl.Dlascl(lapack.General, 0, 0, anrm, smlnum, n, nrhs, b, ldb)
+		impl.Dlascl(lapack.General, 0, 0, smlnum, anrm, mn, 1, s, 1)
+	case 2:
+		impl.Dlascl(lapack.General, 0, 0, anrm, bignum, n, nrhs, b, ldb)
+		impl.Dlascl(lapack.General, 0, 0, bignum, anrm, mn, 1, s, 1)
+	}
+	switch ibscl {
+	case 1:
+		impl.Dlascl(lapack.General, 0, 0, smlnum, bnrm, n, nrhs, b, ldb)
+	case 2:
+		impl.Dlascl(lapack.General, 0, 0, bignum, bnrm, n, nrhs, b, ldb)
+	}
+
+	work[0] = float64(lwkopt)
+	return rank, ok
+}
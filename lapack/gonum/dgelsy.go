@@ -0,0 +1,248 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dgelsy computes the minimum-norm solution to a real linear least squares
+// problem
+//
+//	minimize || A*X - B ||_2
+//
+// using a complete orthogonal factorization of A. A is an m×n matrix which may
+// be rank-deficient.
+//
+// Several right hand side vectors b and solution vectors x can be handled in a
+// single call; they are stored as the columns of the max(m,n)×nrhs right hand
+// side matrix B and the n×nrhs solution matrix X.
+//
+// The routine first computes a QR factorization with column pivoting:
+//
+//	A * P = Q * [ R11 R12 ]
+//	            [  0  R22 ]
+//
+// with R11 defined as the largest leading submatrix whose estimated condition
+// number is less than 1/rcond. The order of R11, rank, is the effective rank of
+// A.
+//
+// Then, R22 is considered to be negligible, and R12 is annihilated by
+// orthogonal transformations from the right, arriving at the complete
+// orthogonal factorization:
+//
+//	A * P = Q * [ T11 0 ] * Z
+//	            [  0  0 ]
+//
+// The minimum-norm solution is then
+//
+//	X = P * Zᵀ * [ inv(T11)*Q1ᵀ*B ]
+//	             [        0       ]
+//
+// where Q1 consists of the first rank columns of Q.
+//
+// On entry, b contains the m×nrhs right hand side matrix B. On return, the
+// leading n×nrhs submatrix of b contains the solution matrix X. b must have
+// at least max(m,n) rows.
+//
+// On return, A has been overwritten by details of its complete orthogonal
+// factorization.
+//
+// jpvt specifies a column pivot to be applied to A as described in Dgeqp3. On
+// return, jpvt holds the permutation that was applied; the jth column of A*P
+// was the jpvt[j] column of A. jpvt must have length n, otherwise Dgelsy will
+// panic.
+//
+// rcond is used to determine the effective rank of A, which is defined as the
+// order of the largest leading triangular submatrix R11 in the QR factorization
+// with pivoting of A, whose estimated condition number is less than 1/rcond.
+//
+// work must have length at least max(1,lwork), and lwork must be -1 or at
+// least mn + max(3*n+1, mn+nrhs) where mn = min(m,n), otherwise Dgelsy
+// will panic. If lwork == -1, instead of performing Dgelsy, only the optimal
+// value of lwork will be stored in work[0].
+//
+// Dgelsy returns the effective rank of A.
+func (impl Implementation) Dgelsy(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, jpvt []int, rcond float64, work []float64, lwork int) (rank int) {
+	mn := min(m, n)
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	}
+
+	lwkmin := 1
+	lwkopt := 1
+	if mn > 0 && nrhs > 0 {
+		nb1 := impl.Ilaenv(1, "DGEQRF", " ", m, n, -1, -1)
+		nb2 := impl.Ilaenv(1, "DGERQF", " ", m, n, -1, -1)
+		nb3 := impl.Ilaenv(1, "DORMQR", " ", m, n, nrhs, -1)
+		nb4 := impl.Ilaenv(1, "DORMRQ", " ", m, n, nrhs, -1)
+		nb := max(max(nb1, nb2), max(nb3, nb4))
+		lwkmin = mn + max(3*n+1, mn+nrhs)
+		lwkopt = max(lwkmin, max(mn+2*n+nb*(n+1), 2*mn+nb*nrhs))
+	}
+	switch {
+	case lwork < lwkmin && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	if lwork == -1 {
+		work[0] = float64(lwkopt)
+		return 0
+	}
+
+	// Quick return if possible.
+	if mn == 0 || nrhs == 0 {
+		work[0] = 1
+		return 0
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(b) < (max(m, n)-1)*ldb+nrhs:
+		panic(shortB)
+	case len(jpvt) != n:
+		panic(badLenJpvt)
+	}
+
+	// Get machine parameters.
+	smlnum := dlamchS / dlamchP
+	bignum := 1 / smlnum
+
+	// Scale A, B if max entries outside range [smlnum,bignum].
+	anrm := impl.Dlange(lapack.MaxAbs, m, n, a, lda, nil)
+	var iascl int
+	switch {
+	case 0 < anrm && anrm < smlnum:
+		// Scale matrix norm up to smlnum.
+		impl.Dlascl(lapack.General, 0, 0, anrm, smlnum, m, n, a, lda)
+		iascl = 1
+	case anrm > bignum:
+		// Scale matrix norm down to bignum.
+		impl.Dlascl(lapack.General, 0, 0, anrm, bignum, m, n, a, lda)
+		iascl = 2
+	case anrm == 0:
+		// Matrix all zero. Return zero solution.
+		impl.Dlaset(blas.All, max(m, n), nrhs, 0, 0, b, ldb)
+		work[0] = float64(lwkopt)
+		return 0
+	}
+
+	bnrm := impl.Dlange(lapack.MaxAbs, m, nrhs, b, ldb, nil)
+	var ibscl int
+	switch {
+	case 0 < bnrm && bnrm < smlnum:
+		// Scale matrix norm up to smlnum.
+		impl.Dlascl(lapack.General, 0, 0, bnrm, smlnum, m, nrhs, b, ldb)
+		ibscl = 1
+	case bnrm > bignum:
+		// Scale matrix norm down to bignum.
+		impl.Dlascl(lapack.General, 0, 0, bnrm, bignum, m, nrhs, b, ldb)
+		ibscl = 2
+	}
+
+	// Compute QR factorization with column pivoting of A:
+	//  A * P = Q * R.
+	tau := work[:mn]
+	impl.Dgeqp3(m, n, a, lda, jpvt, tau, work[mn:], lwork-mn)
+	wsize := mn + int(work[mn])
+
+	// Determine rank using incremental condition estimation.
+	xmin := work[mn : 2*mn]
+	xmax := work[2*mn : 3*mn]
+	col := work[3*mn : 4*mn]
+	xmin[0] = 1
+	xmax[0] = 1
+	smax := math.Abs(a[0])
+	smin := smax
+	bi := blas64.Implementation()
+	if smax == 0 {
+		impl.Dlaset(blas.All, max(m, n), nrhs, 0, 0, b, ldb)
+		work[0] = float64(lwkopt)
+		return 0
+	}
+	rank = 1
+	for rank < mn {
+		i := rank
+		// Copy the part of column i of R above the diagonal.
+		bi.Dcopy(i, a[i:], lda, col, 1)
+		sminpr, s1, c1 := impl.Dlaic1(false, rank, xmin, smin, col, a[i*lda+i])
+		smaxpr, s2, c2 := impl.Dlaic1(true, rank, xmax, smax, col, a[i*lda+i])
+		if smaxpr*rcond > sminpr {
+			break
+		}
+		for k := 0; k < rank; k++ {
+			xmin[k] *= s1
+			xmax[k] *= s2
+		}
+		xmin[rank] = c1
+		xmax[rank] = c2
+		smin = sminpr
+		smax = smaxpr
+		rank++
+	}
+
+	// Logically partition R = [ R11 R12 ]
+	//                         [  0  R22 ]
+	// where R11 = R[0:rank,0:rank].
+	//
+	// [R11,R12] = [ T11, 0 ] * Y.
+	tauz := work[mn : mn+rank]
+	if rank < n {
+		impl.Dlatrz(rank, n, n-rank, a, lda, tauz, work[2*mn:])
+	}
+
+	// B[0:m,0:nrhs] := Qᵀ * B[0:m,0:nrhs].
+	impl.Dormqr(blas.Left, blas.Trans, m, nrhs, mn, a, lda, tau, b, ldb, work[2*mn:], lwork-2*mn)
+	wsize = max(wsize, 2*mn+int(work[2*mn]))
+
+	// B[0:rank,0:nrhs] := inv(T11) * B[0:rank,0:nrhs].
+	bi.Dtrsm(blas.Left, blas.Upper, blas.NoTrans, blas.NonUnit, rank, nrhs, 1, a, lda, b, ldb)
+
+	if rank < n {
+		// B[rank:n,0:nrhs] := 0.
+		impl.Dlaset(blas.All, n-rank, nrhs, 0, 0, b[rank*ldb:], ldb)
+
+		// B[0:n,0:nrhs] := Yᵀ * B[0:n,0:nrhs].
+		impl.Dormr3(blas.Left, blas.Trans, n, nrhs, rank, n-rank, a, lda, tauz, b, ldb, work[2*mn:])
+	}
+
+	// B[0:n,0:nrhs] := P * B[0:n,0:nrhs].
+	impl.Dlapmr(false, n, nrhs, b, ldb, jpvt)
+
+	// Undo scaling.
+	switch iascl {
+	case 1:
+		impl.Dlascl(lapack.General, 0, 0, anrm, smlnum, n, nrhs, b, ldb)
+		impl.Dlascl(lapack.UpperTri, 0, 0, smlnum, anrm, rank, rank, a, lda)
+	case 2:
+		impl.Dlascl(lapack.General, 0, 0, anrm, bignum, n, nrhs, b, ldb)
+		impl.Dlascl(lapack.UpperTri, 0, 0, bignum, anrm, rank, rank, a, lda)
+	}
+	switch ibscl {
+	case 1:
+		impl.Dlascl(lapack.General, 0, 0, smlnum, bnrm, n, nrhs, b, ldb)
+	case 2:
+		impl.Dlascl(lapack.General, 0, 0, bignum, bnrm, n, nrhs, b, ldb)
+	}
+
+	work[0] = float64(max(wsize, lwkopt))
+	return rank
+}
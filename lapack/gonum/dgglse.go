@@ -0,0 +1,170 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dgglse solves the linear equality-constrained least squares (LSE) problem
+//
+//	minimize || c - A*x ||_2   subject to   B*x = d
+//
+// where A is an m×n matrix, B is a p×n matrix, c is an m-vector and d is a
+// p-vector. It is assumed that
+//
+//	p <= n <= m+p,
+//
+// and
+//
+//	rank(B) = p,  rank([A; B]) = n.
+//
+// These conditions ensure that the LSE problem has a unique solution, which is
+// obtained using a generalized RQ factorization of the matrices B and A.
+//
+// On return, A and B are overwritten with the factors of the generalized RQ
+// factorization as computed by Dggrqf, c contains in its elements n-p:m the
+// residual vector whose sum of squares gives the residual sum of squares of the
+// solution, d is destroyed, and x contains the solution of the LSE problem. c
+// must have length m, d must have length p and x must have length n, otherwise
+// Dgglse will panic.
+//
+// work must have length at least max(1,lwork), and lwork must be -1 or at
+// least max(1,m+n+p), otherwise Dgglse will panic. If lwork == -1, instead of
+// performing Dgglse, only the optimal value of lwork will be stored in work[0].
+//
+// Dgglse returns whether the solution was found. It will be false if either the
+// upper triangular factor T12 of B or R11 of A is singular, that is, if the
+// rank conditions above are not satisfied.
+func (impl Implementation) Dgglse(m, n, p int, a []float64, lda int, b []float64, ldb int, c, d, x, work []float64, lwork int) (ok bool) {
+	mn := min(m, n)
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case p < 0:
+		panic(pLT0)
+	case p > n:
+		panic(pGTN)
+	case n > m+p:
+		panic(nGTMP)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, n):
+		panic(badLdB)
+	}
+
+	var lwkmin, lwkopt int
+	if n == 0 {
+		lwkmin = 1
+		lwkopt = 1
+	} else {
+		nb1 := impl.Ilaenv(1, "DGEQRF", " ", m, n, -1, -1)
+		nb2 := impl.Ilaenv(1, "DGERQF", " ", m, n, -1, -1)
+		nb3 := impl.Ilaenv(1, "DORMQR", " ", m, n, p, -1)
+		nb4 := impl.Ilaenv(1, "DORMRQ", " ", m, n, p, -1)
+		nb := max(max(nb1, nb2), max(nb3, nb4))
+		lwkmin = m + n + p
+		lwkopt = p + mn + max(m, n)*nb
+	}
+	switch {
+	case lwork < lwkmin && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	if lwork == -1 {
+		work[0] = float64(lwkopt)
+		return true
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		work[0] = 1
+		return true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(b) < (p-1)*ldb+n:
+		panic(shortB)
+	case len(c) != m:
+		panic(badLenC)
+	case len(d) != p:
+		panic(badLenD)
+	case len(x) != n:
+		panic(badLenX)
+	}
+
+	// Compute the generalized RQ factorization of matrices B and A:
+	//
+	//  B*Qᵀ = [ 0 T12 ] p    Zᵀ*A*Qᵀ = [ R11 R12 ] n-p
+	//         n-p  p                   [  0  R22 ] m+p-n
+	//                                    n-p   p
+	//
+	// where T12 and R11 are upper triangular, and Q and Z are orthogonal.
+	taub := work[:p]
+	taua := work[p : p+mn]
+	wrk := work[p+mn:]
+	lwrk := lwork - p - mn
+	impl.Dggrqf(p, m, n, b, ldb, taub, a, lda, taua, wrk, lwrk)
+	lopt := int(wrk[0])
+
+	// Update c = Zᵀ*c = [ c1 ] n-p
+	//                   [ c2 ] m+p-n
+	impl.Dormqr(blas.Left, blas.Trans, m, 1, mn, a, lda, taua, c, 1, wrk, lwrk)
+	lopt = max(lopt, int(wrk[0]))
+
+	bi := blas64.Implementation()
+	if p > 0 {
+		// Solve T12*x2 = d for x2.
+		ok = impl.Dtrtrs(blas.Upper, blas.NoTrans, blas.NonUnit, p, 1, b[n-p:], ldb, d, 1)
+		if !ok {
+			return false
+		}
+
+		// Put the solution in x.
+		bi.Dcopy(p, d, 1, x[n-p:], 1)
+
+		// Update c1.
+		bi.Dgemv(blas.NoTrans, n-p, p, -1, a[n-p:], lda, d, 1, 1, c, 1)
+	}
+
+	if n > p {
+		// Solve R11*x1 = c1 for x1.
+		ok = impl.Dtrtrs(blas.Upper, blas.NoTrans, blas.NonUnit, n-p, 1, a, lda, c, 1)
+		if !ok {
+			return false
+		}
+
+		// Put the solution in x.
+		bi.Dcopy(n-p, c, 1, x, 1)
+	}
+
+	// Compute the residual vector.
+	var nr int
+	if m < n {
+		nr = m + p - n
+		if nr > 0 {
+			bi.Dgemv(blas.NoTrans, nr, n-m, -1, a[(n-p)*lda+m:], lda, d[nr:], 1, 1, c[n-p:], 1)
+		}
+	} else {
+		nr = p
+	}
+	if nr > 0 {
+		bi.Dtrmv(blas.Upper, blas.NoTrans, blas.NonUnit, nr, a[(n-p)*lda+n-p:], lda, d, 1)
+		bi.Daxpy(nr, -1, d, 1, c[n-p:], 1)
+	}
+
+	// Backward transformation x = Qᵀ*x.
+	impl.Dormrq(blas.Left, blas.Trans, n, 1, p, b, ldb, taub, x, 1, wrk, lwrk)
+	work[0] = float64(p + mn + max(lopt, int(wrk[0])))
+
+	return true
+}
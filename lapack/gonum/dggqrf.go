@@ -0,0 +1,96 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// Dggqrf computes a generalized QR factorization of an n×m matrix A and an n×p
+// matrix B:
+//
+//	A = Q * R,    B = Q * T * Z,
+//
+// where Q is an n×n orthogonal matrix, Z is a p×p orthogonal matrix, and R and
+// T assume one of the forms
+//
+//	R = [ R11 ] m    if n >= m,    R = [ R11 R12 ] n    if n < m,
+//	    [  0  ] n-m                      n   m-n
+//	       m
+//
+// where R11 is upper triangular, and
+//
+//	T = [ 0 T12 ] n    if n <= p,    T = [ T11 ] n-p    if n > p,
+//	    p-n   n                          [ T21 ] p
+//	                                        p
+//
+// where T12 or T21 is a p×p upper triangular matrix.
+//
+// On return, the elements on and above the diagonal of A contain the
+// min(n,m)×m upper trapezoidal matrix R. The elements below the diagonal, with
+// taua, represent Q as a product of min(n,m) elementary reflectors as returned
+// by Dgeqrf. If n <= p, the upper triangle of B[0:n,p-n:p] contains the n×n
+// upper triangular matrix T. If n > p, the elements on and above the
+// (n-p)-th subdiagonal contain the n×p upper trapezoidal matrix T. The remaining
+// elements, with taub, represent Z as a product of min(n,p) elementary
+// reflectors as returned by Dgerqf.
+//
+// taua must have length min(n,m) and taub must have length min(n,p), otherwise
+// Dggqrf will panic.
+//
+// work must have length at least max(1,lwork), and lwork must be -1 or at
+// least max(1,n,m,p), otherwise Dggqrf will panic. If lwork == -1, instead of
+// performing Dggqrf, only the optimal value of lwork will be stored in work[0].
+//
+// Dggqrf is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dggqrf(n, m, p int, a []float64, lda int, taua []float64, b []float64, ldb int, taub, work []float64, lwork int) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case m < 0:
+		panic(mLT0)
+	case p < 0:
+		panic(pLT0)
+	case lda < max(1, m):
+		panic(badLdA)
+	case ldb < max(1, p):
+		panic(badLdB)
+	case lwork < max(1, max(n, max(m, p))) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	nb1 := impl.Ilaenv(1, "DGEQRF", " ", n, m, -1, -1)
+	nb2 := impl.Ilaenv(1, "DGERQF", " ", n, p, -1, -1)
+	nb3 := impl.Ilaenv(1, "DORMQR", " ", n, m, p, -1)
+	nb := max(nb1, max(nb2, nb3))
+	lwkopt := max(1, max(n, max(m, p))*nb)
+	if lwork == -1 {
+		work[0] = float64(lwkopt)
+		return
+	}
+
+	switch {
+	case m > 0 && len(a) < (n-1)*lda+m:
+		panic(shortA)
+	case p > 0 && len(b) < (n-1)*ldb+p:
+		panic(shortB)
+	case len(taua) != min(n, m):
+		panic(badLenTauA)
+	case len(taub) != min(n, p):
+		panic(badLenTauB)
+	}
+
+	// QR factorization of the n×m matrix A: A = Q*R.
+	impl.Dgeqrf(n, m, a, lda, taua, work, lwork)
+	lopt := int(work[0])
+
+	// Update B := Qᵀ*B.
+	impl.Dormqr(blas.Left, blas.Trans, n, p, min(n, m), a, lda, taua, b, ldb, work, lwork)
+	lopt = max(lopt, int(work[0]))
+
+	// RQ factorization of the n×p matrix B: B = T*Z.
+	impl.Dgerqf(n, p, b, ldb, taub, work, lwork)
+	work[0] = float64(max(lopt, max(lwkopt, int(work[0]))))
+}
@@ -0,0 +1,96 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// Dggrqf computes a generalized RQ factorization of an m×n matrix A and a p×n
+// matrix B:
+//
+//	A = R * Q,    B = Z * T * Q,
+//
+// where Q is an n×n orthogonal matrix, Z is a p×p orthogonal matrix, and R and
+// T assume one of the forms
+//
+//	R = [ 0 R12 ] m    if m <= n,    R = [ R11 ] m-n    if m > n,
+//	    n-m   m                          [ R21 ] n
+//	                                        n
+//
+// where R12 or R21 is upper triangular, and
+//
+//	T = [ T11 ] n    if p >= n,    T = [ T11 T12 ] p    if p < n,
+//	    [  0  ] p-n                      p   n-p
+//	       n
+//
+// where T11 is upper triangular.
+//
+// On return, if m <= n, the upper triangle of A[0:m,n-m:n] contains the m×m
+// upper triangular matrix R. If m > n, the elements on and above the
+// (m-n)-th subdiagonal contain the m×n upper trapezoidal matrix R. The
+// remaining elements, with taua, represent Q as a product of min(m,n)
+// elementary reflectors as returned by Dgerqf. The elements on and above the
+// diagonal of B contain the min(p,n)×n upper trapezoidal matrix T, and the
+// elements below the diagonal, with taub, represent Z as a product of
+// min(p,n) elementary reflectors as returned by Dgeqrf.
+//
+// taua must have length min(m,n) and taub must have length min(p,n), otherwise
+// Dggrqf will panic.
+//
+// work must have length at least max(1,lwork), and lwork must be -1 or at
+// least max(1,m,n,p), otherwise Dggrqf will panic. If lwork == -1, instead of
+// performing Dggrqf, only the optimal value of lwork will be stored in work[0].
+//
+// Dggrqf is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dggrqf(m, p, n int, a []float64, lda int, taua []float64, b []float64, ldb int, taub, work []float64, lwork int) {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case p < 0:
+		panic(pLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, n):
+		panic(badLdB)
+	case lwork < max(1, max(m, max(n, p))) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	nb1 := impl.Ilaenv(1, "DGERQF", " ", m, n, -1, -1)
+	nb2 := impl.Ilaenv(1, "DGEQRF", " ", p, n, -1, -1)
+	nb3 := impl.Ilaenv(1, "DORMRQ", " ", m, n, p, -1)
+	nb := max(nb1, max(nb2, nb3))
+	lwkopt := max(1, max(n, max(m, p))*nb)
+	if lwork == -1 {
+		work[0] = float64(lwkopt)
+		return
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(b) < (p-1)*ldb+n:
+		panic(shortB)
+	case len(taua) != min(m, n):
+		panic(badLenTauA)
+	case len(taub) != min(p, n):
+		panic(badLenTauB)
+	}
+
+	// RQ factorization of the m×n matrix A: A = R*Q.
+	impl.Dgerqf(m, n, a, lda, taua, work, lwork)
+	lopt := int(work[0])
+
+	// Update B := B*Qᵀ.
+	impl.Dormrq(blas.Right, blas.Trans, p, n, min(m, n), a[max(0, m-n)*lda:], lda, taua, b, ldb, work, lwork)
+	lopt = max(lopt, int(work[0]))
+
+	// QR factorization of the p×n matrix B: B = Z*T.
+	impl.Dgeqrf(p, n, b, ldb, taub, work, lwork)
+	work[0] = float64(max(lopt, max(lwkopt, int(work[0]))))
+}
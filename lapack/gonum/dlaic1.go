@@ -0,0 +1,191 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dlaic1 applies one step of incremental condition estimation in its simplest
+// version.
+//
+// Let x be a vector of length j with ‖x‖_2 = 1, and let sest be an estimate of
+// the smallest (if largest is false) or largest (if largest is true) singular
+// value of a j×j lower triangular matrix L, such that
+//
+//	‖L*x‖_2 = sest.
+//
+// Dlaic1 computes sestpr, s and c such that the vector
+//
+//	xhat = [ s*x ]
+//	       [  c  ]
+//
+// is an approximate singular vector of the lower triangular matrix
+//
+//	Lhat = [ L     0     ]
+//	       [ wᵀ  gamma ]
+//
+// in the sense that
+//
+//	‖Lhat*xhat‖_2 = sestpr.
+//
+// Depending on largest, an estimate for the largest or smallest singular value
+// is computed.
+//
+// Note that [s c]ᵀ and sestpr² are an eigenpair of the system
+//
+//	diag(sest*sest, 0) + [alpha  gamma] * [ alpha ]
+//	                                      [ gamma ]
+//
+// where alpha = xᵀ*w.
+//
+// x and w must have length at least j, otherwise Dlaic1 will panic.
+//
+// Dlaic1 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlaic1(largest bool, j int, x []float64, sest float64, w []float64, gamma float64) (sestpr, s, c float64) {
+	switch {
+	case j < 0:
+		panic(jLT0)
+	case len(x) < j:
+		panic(shortX)
+	case len(w) < j:
+		panic(shortW)
+	}
+
+	const eps = dlamchE
+
+	alpha := blas64.Implementation().Ddot(j, x, 1, w, 1)
+	absalp := math.Abs(alpha)
+	absgam := math.Abs(gamma)
+	absest := math.Abs(sest)
+
+	if largest {
+		// Estimating largest singular value.
+		switch {
+		case sest == 0:
+			s1 := math.Max(absgam, absalp)
+			if s1 == 0 {
+				return 0, 0, 1
+			}
+			s = alpha / s1
+			c = gamma / s1
+			tmp := math.Sqrt(s*s + c*c)
+			return s1 * tmp, s / tmp, c / tmp
+		case absgam <= eps*absest:
+			tmp := math.Max(absest, absalp)
+			s1 := absest / tmp
+			s2 := absalp / tmp
+			return tmp * math.Sqrt(s1*s1+s2*s2), 1, 0
+		case absalp <= eps*absest:
+			if absgam <= absest {
+				return absest, 1, 0
+			}
+			return absgam, 0, 1
+		case absest <= eps*absalp || absest <= eps*absgam:
+			if absgam <= absalp {
+				tmp := absgam / absalp
+				s = math.Sqrt(1 + tmp*tmp)
+				sestpr = absalp * s
+				c = (gamma / absalp) / s
+				s = math.Copysign(1, alpha) / s
+				return sestpr, s, c
+			}
+			tmp := absalp / absgam
+			c = math.Sqrt(1 + tmp*tmp)
+			sestpr = absgam * c
+			s = (alpha / absgam) / c
+			c = math.Copysign(1, gamma) / c
+			return sestpr, s, c
+		default:
+			// Normal case.
+			zeta1 := alpha / absest
+			zeta2 := gamma / absest
+			b := (1 - zeta1*zeta1 - zeta2*zeta2) * 0.5
+			c = zeta1 * zeta1
+			var t float64
+			if b > 0 {
+				t = c / (b + math.Sqrt(b*b+c))
+			} else {
+				t = math.Sqrt(b*b+c) - b
+			}
+			sine := -zeta1 / t
+			cosine := -zeta2 / (1 + t)
+			tmp := math.Sqrt(sine*sine + cosine*cosine)
+			return math.Sqrt(t+1) * absest, sine / tmp, cosine / tmp
+		}
+	}
+
+	// Estimating smallest singular value.
+	switch {
+	case sest == 0:
+		sine, cosine := 1.0, 0.0
+		if math.Max(absgam, absalp) != 0 {
+			sine = -gamma
+			cosine = alpha
+		}
+		s1 := math.Max(math.Abs(sine), math.Abs(cosine))
+		s = sine / s1
+		c = cosine / s1
+		tmp := math.Sqrt(s*s + c*c)
+		return 0, s / tmp, c / tmp
+	case absgam <= eps*absest:
+		return absgam, 0, 1
+	case absalp <= eps*absest:
+		if absgam <= absest {
+			return absgam, 0, 1
+		}
+		return absest, 1, 0
+	case absest <= eps*absalp || absest <= eps*absgam:
+		if absgam <= absalp {
+			tmp := absgam / absalp
+			c = math.Sqrt(1 + tmp*tmp)
+			sestpr = absest * (tmp / c)
+			s = -(gamma / absalp) / c
+			c = math.Copysign(1, alpha) / c
+			return sestpr, s, c
+		}
+		tmp := absalp / absgam
+		s = math.Sqrt(1 + tmp*tmp)
+		sestpr = absest / s
+		c = (alpha / absgam) / s
+		s = -math.Copysign(1, gamma) / s
+		return sestpr, s, c
+	}
+
+	// Normal case.
+	zeta1 := alpha / absest
+	zeta2 := gamma / absest
+	norma := math.Max(1+zeta1*zeta1+math.Abs(zeta1*zeta2), math.Abs(zeta1*zeta2)+zeta2*zeta2)
+
+	// See if root is closer to zero or to one.
+	var sine, cosine float64
+	test := 1 + 2*(zeta1-zeta2)*(zeta1+zeta2)
+	if test >= 0 {
+		// Root is close to zero, compute directly.
+		b := (zeta1*zeta1 + zeta2*zeta2 + 1) * 0.5
+		c = zeta2 * zeta2
+		t := c / (b + math.Sqrt(math.Abs(b*b-c)))
+		sine = zeta1 / (1 - t)
+		cosine = -zeta2 / t
+		sestpr = math.Sqrt(t+4*eps*eps*norma) * absest
+	} else {
+		// Root is closer to one, shift by that amount.
+		b := (zeta2*zeta2 + zeta1*zeta1 - 1) * 0.5
+		c = zeta1 * zeta1
+		var t float64
+		if b >= 0 {
+			t = -c / (b + math.Sqrt(b*b+c))
+		} else {
+			t = b - math.Sqrt(b*b+c)
+		}
+		sine = -zeta1 / t
+		cosine = -zeta2 / (1 + t)
+		sestpr = math.Sqrt(1+t+4*eps*eps*norma) * absest
+	}
+	tmp := math.Sqrt(sine*sine + cosine*cosine)
+	return sestpr, sine / tmp, cosine / tmp
+}
@@ -0,0 +1,224 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dlals0 applies back the multiplying factors of either the left or the right
+// singular vector matrix of a diagonal matrix appended by a row to the right
+// hand side matrix B in solving the least squares problem using the divide
+// and conquer singular value decomposition approach. It is used by Dlalsa.
+//
+// For the left singular vector matrix, three types of orthogonal matrices are
+// involved:
+//   - L1: a product of Givens rotations applied in the deflation,
+//   - Pl: a permutation which moves the deflated singular values to the end,
+//   - Ul: the left singular vector matrix of the updated problem.
+//
+// For the right singular vector matrix, four types of orthogonal matrices are
+// involved:
+//   - R1: a product of Givens rotations applied in the deflation,
+//   - Pr: a permutation which moves the deflated singular values to the end,
+//   - Vr: the right singular vector matrix of the updated problem,
+//   - R2: a Givens rotation applied to eliminate the last column if sqre == 1.
+//
+// If icompq == 0, the left singular vector matrix Ul * Pl * L1 is applied
+// to B from the left. If icompq == 1, the transpose of the right singular
+// vector matrix R1 * Pr * Vrᵀ * R2 is applied to B from the left. icompq must
+// be 0 or 1, otherwise Dlals0 will panic.
+//
+// The problem has n = nl+nr+1 rows and m = n+sqre columns. sqre must be 0 or
+// 1. B is an n×nrhs matrix if icompq == 0 and an m×nrhs matrix if
+// icompq == 1, and BX is workspace of the same size. On return, b contains the
+// transformed matrix.
+//
+// perm, givptr, givcol, givnum, poles, difl, difr, z, k, c and s describe the
+// singular vectors as returned by Dlasd6. givcol, givnum, poles and difr are
+// matrices with two columns and leading dimensions ldgcol, ldgnum, ldgnum and
+// ldgnum respectively.
+//
+// work must have length at least k.
+//
+// Dlals0 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlals0(icompq, nl, nr, sqre, nrhs int, b []float64, ldb int, bx []float64, ldbx int, perm []int, givptr int, givcol []int, ldgcol int, givnum []float64, ldgnum int, poles, difl, difr, z []float64, k int, c, s float64, work []float64) {
+	n := nl + nr + 1
+	m := n + sqre
+	switch {
+	case icompq != 0 && icompq != 1:
+		panic(badIcompq)
+	case nl < 1:
+		panic(nlLT1)
+	case nr < 1:
+		panic(nrLT1)
+	case sqre != 0 && sqre != 1:
+		panic(badSqre)
+	case nrhs < 1:
+		panic(nrhsLT1)
+	case ldb < nrhs:
+		panic(badLdB)
+	case ldbx < nrhs:
+		panic(badLdBX)
+	case givptr < 0:
+		panic(givptrLT0)
+	case ldgcol < 2:
+		panic(badLdGCol)
+	case ldgnum < 2:
+		panic(badLdGNum)
+	case k < 1:
+		panic(kLT1)
+	}
+
+	rows := n
+	if icompq == 1 {
+		rows = m
+	}
+	switch {
+	case len(b) < (rows-1)*ldb+nrhs:
+		panic(shortB)
+	case len(bx) < (rows-1)*ldbx+nrhs:
+		panic(shortBX)
+	case len(perm) < n:
+		panic(shortPerm)
+	case givptr > 0 && len(givcol) < (givptr-1)*ldgcol+2:
+		panic(shortGivcol)
+	case givptr > 0 && len(givnum) < (givptr-1)*ldgnum+2:
+		panic(shortGivnum)
+	case len(poles) < (k-1)*ldgnum+2:
+		panic(shortPoles)
+	case len(difl) < k:
+		panic(shortDifl)
+	case len(difr) < (k-1)*ldgnum+2:
+		panic(shortDifr)
+	case len(z) < k:
+		panic(shortZ)
+	case len(work) < k:
+		panic(shortWork)
+	}
+
+	bi := blas64.Implementation()
+
+	if icompq == 0 {
+		// Apply back the orthogonal transformations from the left.
+
+		// Step 1: apply back the Givens rotations performed.
+		for i := 0; i < givptr; i++ {
+			bi.Drot(nrhs, b[givcol[i*ldgcol+1]*ldb:], 1, b[givcol[i*ldgcol]*ldb:], 1, givnum[i*ldgnum+1], givnum[i*ldgnum])
+		}
+
+		// Step 2: permute the rows of B.
+		bi.Dcopy(nrhs, b[nl*ldb:], 1, bx, 1)
+		for i := 1; i < n; i++ {
+			bi.Dcopy(nrhs, b[perm[i]*ldb:], 1, bx[i*ldbx:], 1)
+		}
+
+		// Step 3: apply the inverse of the left singular vector matrix to
+		// BX.
+		if k == 1 {
+			bi.Dcopy(nrhs, bx, 1, b, 1)
+			if z[0] < 0 {
+				bi.Dscal(nrhs, -1, b, 1)
+			}
+		} else {
+			for j := 0; j < k; j++ {
+				diflj := difl[j]
+				dj := poles[j*ldgnum]
+				dsigj := -poles[j*ldgnum+1]
+				var difrj, dsigjp float64
+				if j < k-1 {
+					difrj = -difr[j*ldgnum]
+					dsigjp = -poles[(j+1)*ldgnum+1]
+				}
+				if z[j] == 0 || poles[j*ldgnum+1] == 0 {
+					work[j] = 0
+				} else {
+					work[j] = -poles[j*ldgnum+1] * z[j] / diflj / (poles[j*ldgnum+1] + dj)
+				}
+				for i := 0; i < j; i++ {
+					if z[i] == 0 || poles[i*ldgnum+1] == 0 {
+						work[i] = 0
+					} else {
+						work[i] = poles[i*ldgnum+1] * z[i] / ((poles[i*ldgnum+1] + dsigj) - diflj) / (poles[i*ldgnum+1] + dj)
+					}
+				}
+				for i := j + 1; i < k; i++ {
+					if z[i] == 0 || poles[i*ldgnum+1] == 0 {
+						work[i] = 0
+					} else {
+						work[i] = poles[i*ldgnum+1] * z[i] / ((poles[i*ldgnum+1] + dsigjp) + difrj) / (poles[i*ldgnum+1] + dj)
+					}
+				}
+				work[0] = -1
+				temp := bi.Dnrm2(k, work, 1)
+				bi.Dgemv(blas.Trans, k, nrhs, 1, bx, ldbx, work, 1, 0, b[j*ldb:], 1)
+				impl.Dlascl(lapack.General, 0, 0, temp, 1, 1, nrhs, b[j*ldb:], ldb)
+			}
+		}
+
+		// Move the deflated rows of BX to B also.
+		if k < max(m, n) {
+			impl.Dlacpy(blas.All, n-k, nrhs, bx[k*ldbx:], ldbx, b[k*ldb:], ldb)
+		}
+		return
+	}
+
+	// Apply back the right orthogonal transformations.
+
+	// Step 1: apply back the new right singular vector matrix to B.
+	if k == 1 {
+		bi.Dcopy(nrhs, b, 1, bx, 1)
+	} else {
+		for j := 0; j < k; j++ {
+			dsigj := poles[j*ldgnum+1]
+			if z[j] == 0 {
+				work[j] = 0
+			} else {
+				work[j] = -z[j] / difl[j] / (dsigj + poles[j*ldgnum]) / difr[j*ldgnum+1]
+			}
+			for i := 0; i < j; i++ {
+				if z[j] == 0 {
+					work[i] = 0
+				} else {
+					work[i] = z[j] / ((dsigj - poles[(i+1)*ldgnum+1]) - difr[i*ldgnum]) / (dsigj + poles[i*ldgnum]) / difr[i*ldgnum+1]
+				}
+			}
+			for i := j + 1; i < k; i++ {
+				if z[j] == 0 {
+					work[i] = 0
+				} else {
+					work[i] = z[j] / ((dsigj - poles[i*ldgnum+1]) - difl[i]) / (dsigj + poles[i*ldgnum]) / difr[i*ldgnum+1]
+				}
+			}
+			bi.Dgemv(blas.Trans, k, nrhs, 1, b, ldb, work, 1, 0, bx[j*ldbx:], 1)
+		}
+	}
+
+	// Step 2: if sqre == 1, apply back the rotation that is related to the
+	// right null space of the subproblem.
+	if sqre == 1 {
+		bi.Dcopy(nrhs, b[(m-1)*ldb:], 1, bx[(m-1)*ldbx:], 1)
+		bi.Drot(nrhs, bx, 1, bx[(m-1)*ldbx:], 1, c, s)
+	}
+	if k < max(m, n) {
+		impl.Dlacpy(blas.All, n-k, nrhs, b[k*ldb:], ldb, bx[k*ldbx:], ldbx)
+	}
+
+	// Step 3: permute the rows of B.
+	bi.Dcopy(nrhs, bx, 1, b[nl*ldb:], 1)
+	if sqre == 1 {
+		bi.Dcopy(nrhs, bx[(m-1)*ldbx:], 1, b[(m-1)*ldb:], 1)
+	}
+	for i := 1; i < n; i++ {
+		bi.Dcopy(nrhs, bx[i*ldbx:], 1, b[perm[i]*ldb:], 1)
+	}
+
+	// Step 4: apply back the Givens rotations performed.
+	for i := givptr - 1; i >= 0; i-- {
+		bi.Drot(nrhs, b[givcol[i*ldgcol+1]*ldb:], 1, b[givcol[i*ldgcol]*ldb:], 1, givnum[i*ldgnum+1], -givnum[i*ldgnum])
+	}
+}
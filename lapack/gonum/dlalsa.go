@@ -0,0 +1,184 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dlalsa is an intermediate step in solving the least squares problem by
+// computing the singular value decomposition of an n×n upper bidiagonal
+// matrix in compact form as returned by Dlasda. It is used by Dlalsd.
+//
+// If icompq == 0, Dlalsa applies the transpose of the left singular vector
+// matrix to the n×nrhs right hand side matrix B. If icompq == 1, Dlalsa
+// applies the right singular vector matrix to B. icompq must be 0 or 1,
+// otherwise Dlalsa will panic. On return, BX contains the result and B has
+// been overwritten.
+//
+// u, vt, k, difl, difr, z, poles, givptr, givcol, ldgcol, perm, givnum, c and
+// s describe the singular vectors as returned by Dlasda with icompq == 1. n
+// must be greater than smlsiz.
+//
+// work must have length at least n and iwork must have length at least 3*n,
+// otherwise Dlalsa will panic.
+//
+// Dlalsa is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlalsa(icompq, smlsiz, n, nrhs int, b []float64, ldb int, bx []float64, ldbx int, u []float64, ldu int, vt []float64, ldvt int, k []int, difl, difr, z, poles []float64, givptr, givcol []int, ldgcol int, perm []int, givnum, c, s, work []float64, iwork []int) {
+	switch {
+	case icompq != 0 && icompq != 1:
+		panic(badIcompq)
+	case smlsiz < 3:
+		panic(badSmlsiz)
+	case n <= smlsiz:
+		panic(nLESmlsiz)
+	case nrhs < 1:
+		panic(nrhsLT1)
+	case ldb < nrhs:
+		panic(badLdB)
+	case ldbx < nrhs:
+		panic(badLdBX)
+	case ldu < smlsiz:
+		panic(badLdU)
+	case ldvt < smlsiz+1:
+		panic(badLdVT)
+	case ldgcol < n:
+		panic(badLdGCol)
+	}
+
+	// Find the number of levels and nodes of the computation tree.
+	nlvl := int(math.Log(float64(n)/float64(smlsiz+1))/math.Ln2) + 1
+	nd := 1<<uint(nlvl) - 1
+	switch {
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	case len(bx) < (n-1)*ldbx+nrhs:
+		panic(shortBX)
+	case len(u) < (n-1)*ldu+smlsiz:
+		panic(shortU)
+	case len(vt) < (n-1)*ldvt+smlsiz+1:
+		panic(shortVT)
+	case len(k) < nd:
+		panic(shortK)
+	case len(difl) < nlvl*ldgcol:
+		panic(shortDifl)
+	case len(difr) < 2*nlvl*ldgcol:
+		panic(shortDifr)
+	case len(z) < nlvl*ldgcol:
+		panic(shortZ)
+	case len(poles) < 2*nlvl*ldgcol:
+		panic(shortPoles)
+	case len(givptr) < nd:
+		panic(shortGivptr)
+	case len(givcol) < 2*nlvl*ldgcol:
+		panic(shortGivcol)
+	case len(perm) < nlvl*ldgcol:
+		panic(shortPerm)
+	case len(givnum) < 2*nlvl*ldgcol:
+		panic(shortGivnum)
+	case len(c) < nd:
+		panic(shortC)
+	case len(s) < nd:
+		panic(shortS)
+	case len(work) < n:
+		panic(shortWork)
+	case len(iwork) < 3*n:
+		panic(shortIWork)
+	}
+
+	// Book-keeping and set up the computation tree.
+	inode := 0
+	ndiml := inode + n
+	ndimr := ndiml + n
+	impl.Dlasdt(n, smlsiz, iwork[inode:], iwork[ndiml:], iwork[ndimr:])
+
+	bi := blas64.Implementation()
+
+	// The nodes on the bottom level of the tree are the ones from ndb1 on.
+	ndb1 := (nd+1)/2 - 1
+
+	if icompq == 0 {
+		// Apply the transpose of the left singular vector matrix of the
+		// subproblems on the bottom level to B.
+		for i := ndb1; i < nd; i++ {
+			// ic is the center row of the subproblem, nl and nr are the
+			// sizes of its left and right children and nlf and nrf are
+			// their first rows.
+			ic := iwork[inode+i]
+			nl := iwork[ndiml+i]
+			nr := iwork[ndimr+i]
+			nlf := ic - nl
+			nrf := ic + 1
+			bi.Dgemm(blas.Trans, blas.NoTrans, nl, nrhs, nl, 1, u[nlf*ldu:], ldu, b[nlf*ldb:], ldb, 0, bx[nlf*ldbx:], ldbx)
+			bi.Dgemm(blas.Trans, blas.NoTrans, nr, nrhs, nr, 1, u[nrf*ldu:], ldu, b[nrf*ldb:], ldb, 0, bx[nrf*ldbx:], ldbx)
+		}
+
+		// The rows of B at the centers of the nodes are copied to BX.
+		for i := 0; i < nd; i++ {
+			ic := iwork[inode+i]
+			bi.Dcopy(nrhs, b[ic*ldb:], 1, bx[ic*ldbx:], 1)
+		}
+
+		// Apply the left singular vector matrices of the merged
+		// subproblems bottom-up, in the same order as Dlasda has
+		// computed them.
+		j := 1 << uint(nlvl)
+		for lvl := nlvl - 1; lvl >= 0; lvl-- {
+			lf := 1<<uint(lvl) - 1
+			ll := 2 * lf
+			lv := lvl * ldgcol
+			for i := lf; i <= ll; i++ {
+				ic := iwork[inode+i]
+				nl := iwork[ndiml+i]
+				nr := iwork[ndimr+i]
+				nlf := ic - nl
+				j--
+				impl.Dlals0(icompq, nl, nr, 0, nrhs, bx[nlf*ldbx:], ldbx, b[nlf*ldb:], ldb, perm[lv+nlf:], givptr[j-1], givcol[2*lv+2*nlf:], 2, givnum[2*lv+2*nlf:], 2, poles[2*lv+2*nlf:], difl[lv+nlf:], difr[2*lv+2*nlf:], z[lv+nlf:], k[j-1], c[j-1], s[j-1], work)
+			}
+		}
+		return
+	}
+
+	// Apply the right singular vector matrices of the merged subproblems
+	// top-down.
+	j := 0
+	for lvl := 0; lvl < nlvl; lvl++ {
+		lf := 1<<uint(lvl) - 1
+		ll := 2 * lf
+		lv := lvl * ldgcol
+		for i := ll; i >= lf; i-- {
+			ic := iwork[inode+i]
+			nl := iwork[ndiml+i]
+			nr := iwork[ndimr+i]
+			nlf := ic - nl
+			sqre := 1
+			if i == ll {
+				sqre = 0
+			}
+			impl.Dlals0(icompq, nl, nr, sqre, nrhs, b[nlf*ldb:], ldb, bx[nlf*ldbx:], ldbx, perm[lv+nlf:], givptr[j], givcol[2*lv+2*nlf:], 2, givnum[2*lv+2*nlf:], 2, poles[2*lv+2*nlf:], difl[lv+nlf:], difr[2*lv+2*nlf:], z[lv+nlf:], k[j], c[j], s[j], work)
+			j++
+		}
+	}
+
+	// Apply the right singular vector matrices of the subproblems on the
+	// bottom level to B.
+	for i := ndb1; i < nd; i++ {
+		ic := iwork[inode+i]
+		nl := iwork[ndiml+i]
+		nr := iwork[ndimr+i]
+		nlf := ic - nl
+		nrf := ic + 1
+		nlp1 := nl + 1
+		nrp1 := nr + 1
+		if i == nd-1 {
+			nrp1 = nr
+		}
+		bi.Dgemm(blas.Trans, blas.NoTrans, nlp1, nrhs, nlp1, 1, vt[nlf*ldvt:], ldvt, b[nlf*ldb:], ldb, 0, bx[nlf*ldbx:], ldbx)
+		bi.Dgemm(blas.Trans, blas.NoTrans, nrp1, nrhs, nrp1, 1, vt[nrf*ldvt:], ldvt, b[nrf*ldb:], ldb, 0, bx[nrf*ldbx:], ldbx)
+	}
+}
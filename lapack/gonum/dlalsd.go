@@ -0,0 +1,279 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dlalsd uses the singular value decomposition of an n×n bidiagonal matrix B
+// with diagonal d and off-diagonal e to solve the least squares problem
+//
+//	minimize || B*X - R ||_2
+//
+// for the n×nrhs right hand side matrix R stored in b. B is upper bidiagonal if
+// uplo == blas.Upper and lower bidiagonal if uplo == blas.Lower.
+//
+// The singular values of B smaller than rcond times the largest singular value
+// are treated as zero in solving the least squares problem. If rcond is not in
+// the interval (0,1), machine precision is used instead.
+//
+// The problem is split at small off-diagonal elements into independent
+// subproblems. The subproblems with more than smlsiz rows are solved by the
+// divide and conquer method of Dlasda and Dlalsa, and the others by Dlasdq.
+// smlsiz must be at least 3.
+//
+// On return, d contains the singular values of B in decreasing order, e has
+// been overwritten and b contains the solution X.
+//
+// d must have length at least n and e must have length at least n-1. work
+// must have length at least
+//
+//	9*n + 2*n*smlsiz + 8*n*nlvl + n*nrhs + (smlsiz+1)²
+//
+// and iwork must have length at least 3*n*nlvl + 11*n, where
+//
+//	nlvl = max(0, int(log₂(n/(smlsiz+1))) + 1).
+//
+// Dlalsd returns the effective rank of B and whether the computation of the
+// singular values converged.
+//
+// Dlalsd is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlalsd(uplo blas.Uplo, smlsiz, n, nrhs int, d, e, b []float64, ldb int, rcond float64, work []float64, iwork []int) (rank int, ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case smlsiz < 3:
+		panic(badSmlsiz)
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 1:
+		panic(nrhsLT1)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0, true
+	}
+
+	nlvl := max(0, int(math.Log(float64(n)/float64(smlsiz+1))/math.Ln2)+1)
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1:
+		panic(shortE)
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	case len(work) < 9*n+2*n*smlsiz+8*n*nlvl+n*nrhs+(smlsiz+1)*(smlsiz+1):
+		panic(shortWork)
+	case len(iwork) < 3*n*nlvl+11*n:
+		panic(shortIWork)
+	}
+
+	const eps = dlamchE
+	rcnd := rcond
+	if rcond <= 0 || rcond >= 1 {
+		rcnd = eps
+	}
+
+	if n == 1 {
+		if d[0] == 0 {
+			impl.Dlaset(blas.All, 1, nrhs, 0, 0, b, ldb)
+			return 0, true
+		}
+		impl.Dlascl(lapack.General, 0, 0, d[0], 1, 1, nrhs, b, ldb)
+		d[0] = math.Abs(d[0])
+		return 1, true
+	}
+
+	bi := blas64.Implementation()
+
+	// Rotate the matrix if it is lower bidiagonal.
+	if uplo == blas.Lower {
+		for i := 0; i < n-1; i++ {
+			cs, sn, r := impl.Dlartg(d[i], e[i])
+			d[i] = r
+			e[i] = sn * d[i+1]
+			d[i+1] *= cs
+			bi.Drot(nrhs, b[i*ldb:], 1, b[(i+1)*ldb:], 1, cs, sn)
+		}
+	}
+
+	// Scale.
+	orgnrm := impl.Dlanst(lapack.MaxAbs, n, d, e)
+	if orgnrm == 0 {
+		impl.Dlaset(blas.All, n, nrhs, 0, 0, b, ldb)
+		return 0, true
+	}
+	impl.Dlascl(lapack.General, 0, 0, orgnrm, 1, n, 1, d, 1)
+	impl.Dlascl(lapack.General, 0, 0, orgnrm, 1, n-1, 1, e, 1)
+
+	// If n is not larger than the minimum divide size smlsiz, solve the
+	// problem with Dlasdq.
+	if n <= smlsiz {
+		vt := work[:n*n]
+		nwork := n * n
+		impl.Dlaset(blas.All, n, n, 0, 1, vt, n)
+		ok = impl.Dlasdq(blas.Upper, 0, n, n, 0, nrhs, d, e, vt, n, nil, 1, b, ldb, work[nwork:])
+		if !ok {
+			return 0, false
+		}
+		tol := rcnd * math.Abs(d[bi.Idamax(n, d, 1)])
+		for i := 0; i < n; i++ {
+			if d[i] <= tol {
+				impl.Dlaset(blas.All, 1, nrhs, 0, 0, b[i*ldb:], ldb)
+			} else {
+				impl.Dlascl(lapack.General, 0, 0, d[i], 1, 1, nrhs, b[i*ldb:], ldb)
+				rank++
+			}
+		}
+		bi.Dgemm(blas.Trans, blas.NoTrans, n, nrhs, n, 1, vt, n, b, ldb, 0, work[nwork:], nrhs)
+		impl.Dlacpy(blas.All, n, nrhs, work[nwork:], nrhs, b, ldb)
+
+		// Unscale.
+		impl.Dlascl(lapack.General, 0, 0, 1, orgnrm, n, 1, d, 1)
+		impl.Dlasrt(lapack.SortDecreasing, n, d)
+		impl.Dlascl(lapack.General, 0, 0, orgnrm, 1, n, nrhs, b, ldb)
+		return rank, true
+	}
+
+	// Book-keeping of the workspace. The singular vectors of the
+	// subproblems are stored in compact form as computed by Dlasda, with
+	// the arrays that are stored by level of the computation tree using
+	// vectors of length n for each level.
+	smlszp := smlsiz + 1
+	ldu := smlsiz
+	ldvt := smlszp
+	u := 0
+	vt := u + n*ldu
+	difl := vt + n*ldvt
+	difr := difl + nlvl*n
+	z := difr + 2*nlvl*n
+	c := z + nlvl*n
+	s := c + n
+	poles := s + n
+	givnum := poles + 2*nlvl*n
+	bx := givnum + 2*nlvl*n
+	nwork := bx + n*nrhs
+
+	// iwork[0:n] holds the first rows of the subproblems and
+	// iwork[sizei:sizei+n] their sizes.
+	sizei := n
+	k := sizei + n
+	givptr := k + n
+	perm := givptr + n
+	givcol := perm + nlvl*n
+	iwk := givcol + 2*nlvl*n
+
+	for i, v := range d[:n] {
+		if math.Abs(v) < eps {
+			d[i] = math.Copysign(eps, v)
+		}
+	}
+
+	// The rows of the solution are stored in BX with leading dimension
+	// nrhs.
+	ldbx := nrhs
+	var nsub int
+	st := 0
+	for i := 0; i < n-1; i++ {
+		if math.Abs(e[i]) >= eps && i < n-2 {
+			continue
+		}
+
+		// A subproblem is found. First determine its size and then apply
+		// divide and conquer on it.
+		iwork[nsub] = st
+		nsub++
+		var nsize int
+		switch {
+		case i < n-2:
+			// A subproblem with e[i] small for i < n-2.
+			nsize = i - st + 1
+			iwork[sizei+nsub-1] = nsize
+		case math.Abs(e[i]) >= eps:
+			// A subproblem with e[n-2] not too small but i = n-2.
+			nsize = n - st
+			iwork[sizei+nsub-1] = nsize
+		default:
+			// A subproblem with e[n-2] small. This implies a 1×1
+			// subproblem at d[n-1], which is not solved explicitly.
+			nsize = i - st + 1
+			iwork[sizei+nsub-1] = nsize
+			iwork[nsub] = n - 1
+			nsub++
+			iwork[sizei+nsub-1] = 1
+			bi.Dcopy(nrhs, b[(n-1)*ldb:], 1, work[bx+(n-1)*ldbx:], 1)
+		}
+		switch {
+		case nsize == 1:
+			// A 1×1 subproblem is not solved explicitly.
+			bi.Dcopy(nrhs, b[st*ldb:], 1, work[bx+st*ldbx:], 1)
+		case nsize <= smlsiz:
+			// A small subproblem is solved by Dlasdq.
+			impl.Dlaset(blas.All, nsize, nsize, 0, 1, work[vt+st*ldvt:], ldvt)
+			ok = impl.Dlasdq(blas.Upper, 0, nsize, nsize, 0, nrhs, d[st:], e[st:], work[vt+st*ldvt:], ldvt, nil, 1, b[st*ldb:], ldb, work[nwork:])
+			if !ok {
+				return 0, false
+			}
+			impl.Dlacpy(blas.All, nsize, nrhs, b[st*ldb:], ldb, work[bx+st*ldbx:], ldbx)
+		default:
+			// A large subproblem is solved by divide and conquer.
+			ok = impl.Dlasda(1, smlsiz, nsize, 0, d[st:], e[st:], work[u+st*ldu:], ldu, work[vt+st*ldvt:], ldvt,
+				iwork[k+st:], work[difl+st:], work[difr+2*st:], work[z+st:], work[poles+2*st:], iwork[givptr+st:],
+				iwork[givcol+2*st:], n, iwork[perm+st:], work[givnum+2*st:], work[c+st:], work[s+st:], work[nwork:], iwork[iwk:])
+			if !ok {
+				return 0, false
+			}
+			impl.Dlalsa(0, smlsiz, nsize, nrhs, b[st*ldb:], ldb, work[bx+st*ldbx:], ldbx, work[u+st*ldu:], ldu, work[vt+st*ldvt:], ldvt,
+				iwork[k+st:], work[difl+st:], work[difr+2*st:], work[z+st:], work[poles+2*st:], iwork[givptr+st:],
+				iwork[givcol+2*st:], n, iwork[perm+st:], work[givnum+2*st:], work[c+st:], work[s+st:], work[nwork:], iwork[iwk:])
+		}
+		st = i + 1
+	}
+
+	// Apply the singular values and treat the tiny ones as zero.
+	tol := rcnd * math.Abs(d[bi.Idamax(n, d, 1)])
+	for i := 0; i < n; i++ {
+		// Some of the elements in d can be negative because 1×1
+		// subproblems were not solved explicitly.
+		if math.Abs(d[i]) <= tol {
+			impl.Dlaset(blas.All, 1, nrhs, 0, 0, work[bx+i*ldbx:], ldbx)
+		} else {
+			rank++
+			impl.Dlascl(lapack.General, 0, 0, d[i], 1, 1, nrhs, work[bx+i*ldbx:], ldbx)
+		}
+		d[i] = math.Abs(d[i])
+	}
+
+	// Now apply back the right singular vectors.
+	for i := 0; i < nsub; i++ {
+		st := iwork[i]
+		nsize := iwork[sizei+i]
+		switch {
+		case nsize == 1:
+			bi.Dcopy(nrhs, work[bx+st*ldbx:], 1, b[st*ldb:], 1)
+		case nsize <= smlsiz:
+			bi.Dgemm(blas.Trans, blas.NoTrans, nsize, nrhs, nsize, 1, work[vt+st*ldvt:], ldvt, work[bx+st*ldbx:], ldbx, 0, b[st*ldb:], ldb)
+		default:
+			impl.Dlalsa(1, smlsiz, nsize, nrhs, work[bx+st*ldbx:], ldbx, b[st*ldb:], ldb, work[u+st*ldu:], ldu, work[vt+st*ldvt:], ldvt,
+				iwork[k+st:], work[difl+st:], work[difr+2*st:], work[z+st:], work[poles+2*st:], iwork[givptr+st:],
+				iwork[givcol+2*st:], n, iwork[perm+st:], work[givnum+2*st:], work[c+st:], work[s+st:], work[nwork:], iwork[iwk:])
+		}
+	}
+
+	// Unscale and sort the singular values.
+	impl.Dlascl(lapack.General, 0, 0, 1, orgnrm, n, 1, d, 1)
+	impl.Dlasrt(lapack.SortDecreasing, n, d)
+	impl.Dlascl(lapack.General, 0, 0, orgnrm, 1, n, nrhs, b, ldb)
+
+	return rank, true
+}
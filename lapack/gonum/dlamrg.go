@@ -0,0 +1,67 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+// Dlamrg creates a permutation that merges two sorted sublists of a into a
+// single list sorted in increasing order. The first sublist consists of the
+// n1 elements a[0:n1] and the second of the n2 elements a[n1:n1+n2].
+//
+// If dtrd1 is 1, the first sublist is sorted in increasing order, and if dtrd1
+// is -1, in decreasing order. dtrd2 describes the second sublist in the same
+// way. For other values of dtrd1 and dtrd2 Dlamrg will panic.
+//
+// On return, index contains the permutation, that is, a[index[i]] for
+// i = 0, ..., n1+n2-1 is sorted in increasing order. index must have length at
+// least n1+n2.
+//
+// Dlamrg is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlamrg(n1, n2 int, a []float64, dtrd1, dtrd2 int, index []int) {
+	switch {
+	case n1 < 0:
+		panic(badN1)
+	case n2 < 0:
+		panic(badN2)
+	case dtrd1 != 1 && dtrd1 != -1:
+		panic(badDtrd1)
+	case dtrd2 != 1 && dtrd2 != -1:
+		panic(badDtrd2)
+	case len(a) < n1+n2:
+		panic(shortA)
+	case len(index) < n1+n2:
+		panic(shortIndex)
+	}
+
+	ind1 := 0
+	if dtrd1 < 0 {
+		ind1 = n1 - 1
+	}
+	ind2 := n1
+	if dtrd2 < 0 {
+		ind2 = n1 + n2 - 1
+	}
+	var i int
+	for n1 > 0 && n2 > 0 {
+		if a[ind1] <= a[ind2] {
+			index[i] = ind1
+			ind1 += dtrd1
+			n1--
+		} else {
+			index[i] = ind2
+			ind2 += dtrd2
+			n2--
+		}
+		i++
+	}
+	for ; n1 > 0; n1-- {
+		index[i] = ind1
+		ind1 += dtrd1
+		i++
+	}
+	for ; n2 > 0; n2-- {
+		index[i] = ind2
+		ind2 += dtrd2
+		i++
+	}
+}
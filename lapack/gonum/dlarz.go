@@ -0,0 +1,103 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dlarz applies an elementary reflector H to an m×n matrix C:
+//
+//	C = H * C  if side == blas.Left
+//	C = C * H  if side == blas.Right
+//
+// H is represented in the form
+//
+//	H = I - tau * v * vᵀ
+//
+// where tau is a scalar and v is a vector. H is a reflector as returned by
+// Dlatrz, that is, the vector v has the form
+//
+//	v = [ 1 0 ... 0 vz ]
+//
+// where vz is a vector of length l stored in v[0:l*incv].
+//
+// If side == blas.Left, l must be at most m, and work must have length at
+// least n. If side == blas.Right, l must be at most n, and work must have
+// length at least m. Otherwise Dlarz will panic.
+//
+// Dlarz is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlarz(side blas.Side, m, n, l int, v []float64, incv int, tau float64, c []float64, ldc int, work []float64) {
+	left := side == blas.Left
+	switch {
+	case !left && side != blas.Right:
+		panic(badSide)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case l < 0:
+		panic(lLT0)
+	case left && l > m:
+		panic(lGTM)
+	case !left && l > n:
+		panic(lGTN)
+	case incv == 0:
+		panic(zeroIncV)
+	case ldc < max(1, n):
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 || tau == 0 {
+		return
+	}
+
+	switch {
+	case l > 0 && len(v) < 1+(l-1)*abs(incv):
+		panic(shortV)
+	case len(c) < (m-1)*ldc+n:
+		panic(shortC)
+	case left && len(work) < n:
+		panic(shortWork)
+	case !left && len(work) < m:
+		panic(shortWork)
+	}
+
+	bi := blas64.Implementation()
+	if left {
+		// Form H * C.
+
+		// w[0:n] = C[0, 0:n].
+		bi.Dcopy(n, c, 1, work, 1)
+		if l > 0 {
+			// w[0:n] += C[m-l:m, 0:n]ᵀ * v[0:l].
+			bi.Dgemv(blas.Trans, l, n, 1, c[(m-l)*ldc:], ldc, v, incv, 1, work, 1)
+		}
+		// C[0, 0:n] -= tau * w[0:n].
+		bi.Daxpy(n, -tau, work, 1, c, 1)
+		if l > 0 {
+			// C[m-l:m, 0:n] -= tau * v[0:l] * w[0:n]ᵀ.
+			bi.Dger(l, n, -tau, v, incv, work, 1, c[(m-l)*ldc:], ldc)
+		}
+		return
+	}
+
+	// Form C * H.
+
+	// w[0:m] = C[0:m, 0].
+	bi.Dcopy(m, c, ldc, work, 1)
+	if l > 0 {
+		// w[0:m] += C[0:m, n-l:n] * v[0:l].
+		bi.Dgemv(blas.NoTrans, m, l, 1, c[n-l:], ldc, v, incv, 1, work, 1)
+	}
+	// C[0:m, 0] -= tau * w[0:m].
+	bi.Daxpy(m, -tau, work, 1, c, ldc)
+	if l > 0 {
+		// C[0:m, n-l:n] -= tau * w[0:m] * v[0:l]ᵀ.
+		bi.Dger(m, l, -tau, work, 1, v, incv, c[n-l:], ldc)
+	}
+}
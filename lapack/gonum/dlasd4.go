@@ -0,0 +1,165 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dlasd4 computes the square root of the i-th updated eigenvalue of a positive
+// symmetric rank-one modification to a positive diagonal matrix
+//
+//	diag(d) * diag(d) + rho * z * zᵀ
+//
+// that is, the i-th singular value sigma of a matrix whose squared singular
+// values are the eigenvalues of this modification. The elements of d must be
+// non-negative and satisfy d[0] < d[1] < ... < d[n-1], and rho must be
+// positive. The elements of z are assumed to be non-zero. sigma is the i-th
+// root of the secular equation
+//
+//	1 + rho * Σ_j z[j]² / ((d[j] - sigma) * (d[j] + sigma)) = 0
+//
+// which lies between d[i] and d[i+1] if i < n-1 and above d[n-1] otherwise.
+//
+// The root is found by a safeguarded Newton iteration for the secular function
+// multiplied by the difference between sigma² and the squared pole nearest to
+// the root, with the variable shifted so that the distances between sigma and
+// the elements of d are computed accurately. On return, delta[j] contains
+// d[j] - sigma and work[j] contains d[j] + sigma for j = 0, ..., n-1. These are
+// needed to compute the singular vectors.
+//
+// d, z, delta and work must have length at least n, otherwise Dlasd4 will
+// panic. i must satisfy 0 <= i < n.
+//
+// Dlasd4 returns sigma and whether the iteration converged.
+//
+// Dlasd4 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlasd4(n, i int, d, z, delta []float64, rho float64, work []float64) (sigma float64, ok bool) {
+	switch {
+	case n < 1:
+		panic(nLT1)
+	case i < 0 || n <= i:
+		panic(badI)
+	case rho <= 0:
+		panic(nonPosRho)
+	case len(d) < n:
+		panic(shortD)
+	case len(z) < n:
+		panic(shortZ)
+	case len(delta) < n:
+		panic(shortDelta)
+	case len(work) < n:
+		panic(shortWork)
+	}
+
+	if n == 1 {
+		// The root is given explicitly.
+		sigma = math.Sqrt(d[0]*d[0] + rho*z[0]*z[0])
+		work[0] = d[0] + sigma
+		delta[0] = -rho * z[0] * z[0] / work[0]
+		return sigma, true
+	}
+
+	// The secular equation is solved for tau = sigma² - d[org]², where
+	// d[org] is the pole nearest to the root. tau lies between zero and bnd.
+	var org int
+	var bnd float64
+	if i == n-1 {
+		// The last root lies between d[n-1] and sqrt(d[n-1]² + rho*|z|²).
+		org = n - 1
+		bi := blas64.Implementation()
+		bnd = rho * bi.Ddot(n, z, 1, z, 1)
+	} else {
+		// Decide whether the root is closer to d[i] or d[i+1] from the sign
+		// of the secular function at the midpoint between the poles.
+		org = i
+		bnd = (d[i+1] - d[i]) * (d[i+1] + d[i]) / 2
+		f := 1.0
+		for j := 0; j < n; j++ {
+			f += rho * z[j] * z[j] / ((d[j]-d[i])*(d[j]+d[i]) - bnd)
+		}
+		if f < 0 {
+			org = i + 1
+			bnd = -bnd
+		}
+	}
+
+	// Store the shifted poles d[j]² - d[org]² in delta.
+	dorg := d[org]
+	for j := 0; j < n; j++ {
+		delta[j] = (d[j] - dorg) * (d[j] + dorg)
+	}
+	rhoz := rho * z[org] * z[org]
+
+	// The secular function multiplied by tau is
+	//
+	//  g(tau) = tau - rhoz + tau * rho * Σ_{j≠org} z[j]² / (delta[j] - tau),
+	//
+	// which is negative at zero and non-negative at bnd. Start from the root
+	// of its linear approximation at zero if it lies in the interval.
+	neg, pos := 0.0, bnd
+	tau := bnd / 2
+	dg0 := 1.0
+	for j := 0; j < n; j++ {
+		if j != org {
+			dg0 += rho * z[j] * z[j] / delta[j]
+		}
+	}
+	if t := rhoz / dg0; math.Min(0, bnd) < t && t < math.Max(0, bnd) {
+		tau = t
+	}
+
+	const (
+		eps     = dlamchE
+		maxIter = 400
+	)
+	for iter := 0; iter < maxIter; iter++ {
+		// Evaluate g, its derivative and a bound on the rounding error in g.
+		g := tau - rhoz
+		dg := 1.0
+		erretm := math.Abs(tau) + rhoz
+		for j := 0; j < n; j++ {
+			if j == org {
+				continue
+			}
+			temp := rho * z[j] * z[j] / (delta[j] - tau)
+			g += tau * temp
+			dg += temp * delta[j] / (delta[j] - tau)
+			erretm += math.Abs(tau * temp)
+		}
+		if math.Abs(g) <= float64(n)*eps*erretm {
+			ok = true
+			break
+		}
+
+		// Update the bracket and take a Newton step, falling back to
+		// bisection if the step leaves the bracket.
+		if g < 0 {
+			neg = tau
+		} else {
+			pos = tau
+		}
+		lo, hi := math.Min(neg, pos), math.Max(neg, pos)
+		next := tau - g/dg
+		if !(lo < next && next < hi) {
+			next = lo + (hi-lo)/2
+		}
+		if math.Abs(next-tau) <= 2*eps*math.Abs(tau) || next == lo || next == hi {
+			tau = next
+			ok = true
+			break
+		}
+		tau = next
+	}
+
+	sigma = math.Sqrt(dorg*dorg + tau)
+	for j := 0; j < n; j++ {
+		work[j] = d[j] + sigma
+		delta[j] = (delta[j] - tau) / work[j]
+	}
+	return sigma, ok
+}
@@ -0,0 +1,152 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dlasd6 computes the singular values of an updated upper bidiagonal matrix
+// obtained by merging two smaller ones by appending a row. It is used by
+// Dlasda, which computes the singular value decomposition of a bidiagonal
+// matrix by divide and conquer, and computes the singular vectors only in
+// a factored form.
+//
+// The merged matrix has n = nl+nr+1 rows and m = n+sqre columns. Its row nl
+// contains alpha in column nl and beta in column nl+1, the rows above it the
+// upper bidiagonal nl×(nl+1) matrix B1 and the rows below it the upper
+// bidiagonal nr×(nr+sqre) matrix B2 in the lower right corner. sqre must be
+// 0 or 1, otherwise Dlasd6 will panic. The singular value decompositions of B1
+// and B2 are assumed to be known.
+//
+// On entry, d[0:nl] contains the singular values of B1 and d[nl+1:n] those of
+// B2, and idxq contains the permutations which separately sort the two sets of
+// singular values into increasing order. vf and vl contain the first and the
+// last components of the right singular vectors of B1 and B2 in the same
+// order as d, and have length at least m.
+//
+// On return, d contains the singular values of the merged matrix, vf and vl
+// contain the first and the last components of its right singular vectors and
+// idxq contains the permutation which sorts d into increasing order.
+//
+// If icompq == 1, the right and the left singular vectors are described in
+// factored form by the returned k, c and s together with perm, givcol, givnum,
+// poles, difl, difr and z:
+//   - perm[1:n] contains the permutation applied to B1 and B2 in the deflation,
+//   - givcol and givnum are n×2 matrices whose first givptr rows contain the
+//     pairs of rows rotated by the Givens rotations applied in the deflation and
+//     their sines and cosines,
+//   - poles is an n×2 matrix whose first column contains the new singular values
+//     and whose second column contains the poles of the secular equation,
+//   - difl, difr and z contain the distances between the new singular values
+//     and the poles, the normalizing factors of the right singular vectors and
+//     the components of the updating vector as computed by Dlasd8. difr is an
+//     n×2 matrix with leading dimension ldgnum.
+//
+// If icompq == 0, only the singular values are computed and perm, givcol,
+// givnum and poles are not referenced, and difr is a vector of length n.
+// icompq must be 0 or 1.
+//
+// d, idxq, perm and difl must have length at least n, z must have length at
+// least m, work must have length at least 4*m and iwork must have length at
+// least 3*n.
+//
+// Dlasd6 returns the dimension k of the non-deflated problem, the number of
+// Givens rotations givptr applied in the deflation, the cosine and sine c and
+// s of the rotation applied to eliminate the last column if sqre == 1, and
+// whether the secular equation solver converged.
+//
+// Dlasd6 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlasd6(icompq, nl, nr, sqre int, d, vf, vl []float64, alpha, beta float64, idxq, perm, givcol []int, ldgcol int, givnum []float64, ldgnum int, poles, difl, difr, z, work []float64, iwork []int) (k, givptr int, c, s float64, ok bool) {
+	n := nl + nr + 1
+	m := n + sqre
+	switch {
+	case icompq != 0 && icompq != 1:
+		panic(badIcompq)
+	case nl < 1:
+		panic(nlLT1)
+	case nr < 1:
+		panic(nrLT1)
+	case sqre != 0 && sqre != 1:
+		panic(badSqre)
+	case icompq == 1 && ldgcol < 2:
+		panic(badLdGCol)
+	case icompq == 1 && ldgnum < 2:
+		panic(badLdGNum)
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(vf) < m:
+		panic(shortVF)
+	case len(vl) < m:
+		panic(shortVL)
+	case len(idxq) < n:
+		panic(shortIdxq)
+	case icompq == 1 && len(poles) < (n-1)*ldgnum+2:
+		panic(shortPoles)
+	case len(difl) < n:
+		panic(shortDifl)
+	case icompq == 0 && len(difr) < n:
+		panic(shortDifr)
+	case icompq == 1 && len(difr) < (n-1)*ldgnum+2:
+		panic(shortDifr)
+	case len(z) < m:
+		panic(shortZ)
+	case len(work) < 4*m:
+		panic(shortWork)
+	case len(iwork) < 3*n:
+		panic(shortIWork)
+	}
+
+	// The following values are for bookkeeping purposes only. They are
+	// offsets of the portions of the workspace used by a particular array in
+	// Dlasd7 and Dlasd8.
+	isigma := 0
+	iw := isigma + n
+	ivfw := iw + m
+	ivlw := ivfw + m
+	idx := 0
+	idxp := idx + 2*n
+
+	// Scale.
+	orgnrm := math.Max(math.Abs(alpha), math.Abs(beta))
+	d[nl] = 0
+	for _, v := range d[:n] {
+		orgnrm = math.Max(orgnrm, math.Abs(v))
+	}
+	impl.Dlascl(lapack.General, 0, 0, orgnrm, 1, n, 1, d, 1)
+	alpha /= orgnrm
+	beta /= orgnrm
+
+	// Sort and deflate singular values.
+	k, givptr, c, s = impl.Dlasd7(icompq, nl, nr, sqre, d, z, work[iw:], vf, work[ivfw:], vl, work[ivlw:], alpha, beta, work[isigma:], iwork[idx:], iwork[idxp:], idxq, perm, givcol, ldgcol, givnum, ldgnum)
+
+	// Solve the secular equation, compute difl and difr, and update vf and
+	// vl.
+	ok = impl.Dlasd8(icompq, k, d, z, vf, vl, difl, difr, ldgnum, work[isigma:], work[iw:])
+	if !ok {
+		return k, givptr, c, s, false
+	}
+
+	// Save the poles if icompq == 1.
+	if icompq == 1 {
+		bi := blas64.Implementation()
+		bi.Dcopy(k, d, 1, poles, ldgnum)
+		bi.Dcopy(k, work[isigma:], 1, poles[1:], ldgnum)
+	}
+
+	// Unscale.
+	impl.Dlascl(lapack.General, 0, 0, 1, orgnrm, n, 1, d, 1)
+
+	// Prepare the idxq sorting permutation.
+	impl.Dlamrg(k, n-k, d, 1, -1, idxq)
+
+	return k, givptr, c, s, true
+}
@@ -0,0 +1,294 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dlasd7 merges the two sets of singular values of the subproblems of a
+// divide and conquer step into a single sorted set, and deflates the size of
+// the problem. It is used by Dlasd6.
+//
+// There are two ways in which deflation can occur: when two or more singular
+// values are close together or if there is a tiny element in the z vector.
+// For each such occurrence the order of the related secular equation problem
+// is reduced by one.
+//
+// The problem merges an upper bidiagonal nl×(nl+1) matrix and an upper
+// bidiagonal nr×(nr+1+sqre) matrix with the scalars alpha and beta, which are
+// the diagonal and the off-diagonal elements of the row nl joining them,
+// into a matrix with n = nl+nr+1 rows and m = n+sqre columns. sqre must be 0
+// or 1, otherwise Dlasd7 will panic. If icompq == 1, the permutation and the
+// Givens rotations applied in the deflation are recorded in perm, givcol and
+// givnum. icompq must be 0 or 1.
+//
+// On entry, d[0:nl] contains the singular values of the upper block and
+// d[nl+1:n] those of the lower block, and idxq contains the permutations which
+// separately sort the two blocks into increasing order. vf and vl contain the
+// first and the last components of the right singular vectors of the two
+// blocks, stored in the same order as d.
+//
+// On return, d[0:k] contains the singular values to be used by the secular
+// equation and d[k:n] contains the deflated singular values, and z[0:k]
+// contains the updating vector. vf and vl are updated for the merged problem.
+// dsigma[0:k] contains the poles of the secular equation. zw, vfw, vlw, idx
+// and idxp are workspace. d, dsigma, idx, idxp, idxq and perm must have length
+// at least n, and z, zw, vf, vfw, vl and vlw must have length at least m.
+//
+// If icompq == 1, perm[1:n] contains the permutation applied to each block
+// to put the deflated singular values at the end, and the i-th Givens rotation
+// applied in the deflation is given by the rows givcol[i*ldgcol] and
+// givcol[i*ldgcol+1] that it rotates, and its sine and cosine
+// givnum[i*ldgnum] and givnum[i*ldgnum+1]. givcol and givnum must then have
+// n rows and two columns.
+//
+// Dlasd7 returns the dimension k of the non-deflated problem, the number of
+// recorded Givens rotations givptr, and the cosine and sine c and s of the
+// rotation that eliminates the additional column if sqre == 1.
+//
+// Dlasd7 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlasd7(icompq, nl, nr, sqre int, d, z, zw, vf, vfw, vl, vlw []float64, alpha, beta float64, dsigma []float64, idx, idxp, idxq, perm, givcol []int, ldgcol int, givnum []float64, ldgnum int) (k, givptr int, c, s float64) {
+	n := nl + nr + 1
+	m := n + sqre
+	switch {
+	case icompq != 0 && icompq != 1:
+		panic(badIcompq)
+	case nl < 1:
+		panic(nlLT1)
+	case nr < 1:
+		panic(nrLT1)
+	case sqre != 0 && sqre != 1:
+		panic(badSqre)
+	case icompq == 1 && ldgcol < 2:
+		panic(badLdGCol)
+	case icompq == 1 && ldgnum < 2:
+		panic(badLdGNum)
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(z) < m:
+		panic(shortZ)
+	case len(zw) < m:
+		panic(shortZW)
+	case len(vf) < m:
+		panic(shortVF)
+	case len(vfw) < m:
+		panic(shortVFW)
+	case len(vl) < m:
+		panic(shortVL)
+	case len(vlw) < m:
+		panic(shortVLW)
+	case len(dsigma) < n:
+		panic(shortDSigma)
+	case len(idx) < n:
+		panic(shortIdx)
+	case len(idxp) < n:
+		panic(shortIdxp)
+	case len(idxq) < n:
+		panic(shortIdxq)
+	case icompq == 1 && len(perm) < n:
+		panic(shortPerm)
+	case icompq == 1 && len(givcol) < (n-1)*ldgcol+2:
+		panic(shortGivcol)
+	case icompq == 1 && len(givnum) < (n-1)*ldgnum+2:
+		panic(shortGivnum)
+	}
+
+	bi := blas64.Implementation()
+
+	// Generate the first part of the vector z and move the singular values
+	// in the first part of d one position backward.
+	z1 := alpha * vl[nl]
+	vl[nl] = 0
+	tau := vf[nl]
+	for i := nl - 1; i >= 0; i-- {
+		z[i+1] = alpha * vl[i]
+		vl[i] = 0
+		vf[i+1] = vf[i]
+		d[i+1] = d[i]
+		idxq[i+1] = idxq[i] + 1
+	}
+	vf[0] = tau
+
+	// Generate the second part of the vector z.
+	for i := nl + 1; i < m; i++ {
+		z[i] = beta * vf[i]
+		vf[i] = 0
+	}
+
+	// Sort the singular values into increasing order.
+	for i := nl + 1; i < n; i++ {
+		idxq[i] += nl + 1
+	}
+
+	// dsigma, zw, vfw and vlw are used as storage space.
+	for i := 1; i < n; i++ {
+		dsigma[i] = d[idxq[i]]
+		zw[i] = z[idxq[i]]
+		vfw[i] = vf[idxq[i]]
+		vlw[i] = vl[idxq[i]]
+	}
+	impl.Dlamrg(nl, nr, dsigma[1:], 1, 1, idx[1:])
+	for i := 1; i < n; i++ {
+		idxi := 1 + idx[i]
+		d[i] = dsigma[idxi]
+		z[i] = zw[idxi]
+		vf[i] = vfw[idxi]
+		vl[i] = vlw[idxi]
+	}
+
+	// Calculate the allowable deflation tolerance.
+	const eps = dlamchE
+	tol := math.Max(math.Abs(alpha), math.Abs(beta))
+	tol = 64 * eps * math.Max(math.Abs(d[n-1]), tol)
+
+	// There are two kinds of deflation. First a value in the z vector is
+	// small, second two or more singular values are very close together
+	// (their difference is small).
+	//
+	// If the value in the z vector is small, we simply permute the array so
+	// that the corresponding singular value is moved to the end.
+	//
+	// If two values in the d vector are close, we perform a two-sided
+	// rotation designed to make one of the corresponding z vector entries
+	// zero, and then permute the array so that the deflated singular value is
+	// moved to the end.
+	//
+	// If there are multiple singular values then the problem deflates. Here
+	// the number of equal singular values are found. As each equal singular
+	// value is found, an elementary reflector is computed to rotate the
+	// corresponding singular subspace so that the corresponding components of
+	// z are zero in this new basis.
+	k = 1
+	k2 := n
+	jprev := -1
+	for j := 1; j < n; j++ {
+		if math.Abs(z[j]) <= tol {
+			// Deflate due to small z component.
+			k2--
+			idxp[k2] = j
+			continue
+		}
+		jprev = j
+		break
+	}
+	if jprev >= 0 {
+		for j := jprev + 1; j < n; j++ {
+			if math.Abs(z[j]) <= tol {
+				// Deflate due to small z component.
+				k2--
+				idxp[k2] = j
+				continue
+			}
+			if math.Abs(d[j]-d[jprev]) > tol {
+				// The singular values are not close enough to deflate.
+				zw[k] = z[jprev]
+				dsigma[k] = d[jprev]
+				idxp[k] = jprev
+				k++
+				jprev = j
+				continue
+			}
+
+			// Deflation is possible.
+			s = z[jprev]
+			c = z[j]
+			tau = impl.Dlapy2(c, s)
+			z[j] = tau
+			z[jprev] = 0
+			c /= tau
+			s = -s / tau
+
+			// Record the appropriate Givens rotation.
+			if icompq == 1 {
+				idxjp := idxq[idx[jprev]+1]
+				idxj := idxq[idx[j]+1]
+				if idxjp <= nl {
+					idxjp--
+				}
+				if idxj <= nl {
+					idxj--
+				}
+				givcol[givptr*ldgcol+1] = idxjp
+				givcol[givptr*ldgcol] = idxj
+				givnum[givptr*ldgnum+1] = c
+				givnum[givptr*ldgnum] = s
+				givptr++
+			}
+			bi.Drot(1, vf[jprev:], 1, vf[j:], 1, c, s)
+			bi.Drot(1, vl[jprev:], 1, vl[j:], 1, c, s)
+			k2--
+			idxp[k2] = jprev
+			jprev = j
+		}
+
+		// Record the last singular value.
+		zw[k] = z[jprev]
+		dsigma[k] = d[jprev]
+		idxp[k] = jprev
+		k++
+	}
+
+	// Sort the singular values into dsigma. The singular values which were
+	// not deflated go into the first k slots of dsigma, except that
+	// dsigma[0] is treated separately.
+	for j := 1; j < n; j++ {
+		jp := idxp[j]
+		dsigma[j] = d[jp]
+		vfw[j] = vf[jp]
+		vlw[j] = vl[jp]
+	}
+	if icompq == 1 {
+		for j := 1; j < n; j++ {
+			jp := idxp[j]
+			perm[j] = idxq[idx[jp]+1]
+			if perm[j] <= nl {
+				perm[j]--
+			}
+		}
+	}
+
+	// The deflated singular values go back into the last n-k slots of d.
+	copy(d[k:n], dsigma[k:n])
+
+	// Determine dsigma[0], dsigma[1], z[0], vf[0], vl[0], vf[m-1] and
+	// vl[m-1].
+	dsigma[0] = 0
+	hlftol := tol / 2
+	if math.Abs(dsigma[1]) <= hlftol {
+		dsigma[1] = hlftol
+	}
+	if m > n {
+		z[0] = impl.Dlapy2(z1, z[m-1])
+		if z[0] <= tol {
+			c = 1
+			s = 0
+			z[0] = tol
+		} else {
+			c = z1 / z[0]
+			s = -z[m-1] / z[0]
+		}
+		bi.Drot(1, vf[m-1:], 1, vf, 1, c, s)
+		bi.Drot(1, vl[m-1:], 1, vl, 1, c, s)
+	} else {
+		if math.Abs(z1) <= tol {
+			z[0] = tol
+		} else {
+			z[0] = z1
+		}
+	}
+
+	// Restore z, vf and vl.
+	copy(z[1:k], zw[1:k])
+	copy(vf[1:n], vfw[1:n])
+	copy(vl[1:n], vlw[1:n])
+
+	return k, givptr, c, s
+}
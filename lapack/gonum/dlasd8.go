@@ -0,0 +1,166 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dlasd8 finds the square roots of the roots of the secular equation, as
+// defined by the values in dsigma and z, and stores them in d. It also
+// modifies vf and vl, and computes the distances between the updated singular
+// values and the poles. It is used by Dlasd6.
+//
+// The secular equation is
+//
+//	1 + rho * Σ_j z[j]² / ((dsigma[j] - sigma) * (dsigma[j] + sigma)) = 0
+//
+// where rho is the squared norm of z and z is normalized to unit length. The
+// poles dsigma must satisfy 0 = dsigma[0] < dsigma[1] < ... < dsigma[k-1].
+//
+// On return, d[0:k] contains the updated singular values and z[0:k] contains
+// the updating vector recomputed from the updated singular values, so that
+// the singular vectors are numerically orthogonal. vf[0:k] and vl[0:k] contain
+// the first and the last components of the right singular vectors of the
+// updated problem.
+//
+// On return, difl[j] contains d[j] - dsigma[j] for j = 0, ..., k-1. If
+// icompq == 0, difr is a vector and difr[j] contains d[j] - dsigma[j+1] for
+// j = 0, ..., k-2. If icompq == 1, difr is a k×2 matrix with leading dimension
+// lddifr, whose first column contains d[j] - dsigma[j+1] and whose second
+// column contains the normalizing factors of the right singular vectors.
+// icompq must be 0 or 1.
+//
+// d, z, vf, vl, difl and dsigma must have length at least k, and work must
+// have length at least 3*k.
+//
+// Dlasd8 returns whether the secular equation solver converged.
+//
+// Dlasd8 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlasd8(icompq, k int, d, z, vf, vl, difl, difr []float64, lddifr int, dsigma, work []float64) (ok bool) {
+	switch {
+	case icompq != 0 && icompq != 1:
+		panic(badIcompq)
+	case k < 1:
+		panic(kLT1)
+	case icompq == 1 && lddifr < 2:
+		panic(badLdDifr)
+	}
+
+	switch {
+	case len(d) < k:
+		panic(shortD)
+	case len(z) < k:
+		panic(shortZ)
+	case len(vf) < k:
+		panic(shortVF)
+	case len(vl) < k:
+		panic(shortVL)
+	case len(difl) < k:
+		panic(shortDifl)
+	case icompq == 0 && len(difr) < k:
+		panic(shortDifr)
+	case icompq == 1 && len(difr) < (k-1)*lddifr+2:
+		panic(shortDifr)
+	case len(dsigma) < k:
+		panic(shortDSigma)
+	case len(work) < 3*k:
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if k == 1 {
+		d[0] = math.Abs(z[0])
+		difl[0] = d[0]
+		if icompq == 1 {
+			difr[1] = 1
+		}
+		return true
+	}
+
+	// The stride of the first column of difr.
+	ldr := 1
+	if icompq == 1 {
+		ldr = lddifr
+	}
+
+	bi := blas64.Implementation()
+
+	// Normalize z.
+	rho := bi.Dnrm2(k, z, 1)
+	impl.Dlascl(lapack.General, 0, 0, rho, 1, k, 1, z, 1)
+	rho *= rho
+
+	// work[0:k] holds the differences dsigma[j] - sigma and work[k:2*k] the
+	// sums dsigma[j] + sigma for the current root sigma, and work[2*k:3*k]
+	// accumulates the products that define the updated z.
+	delta := work[:k]
+	sum := work[k : 2*k]
+	prod := work[2*k : 3*k]
+	for i := range prod {
+		prod[i] = 1
+	}
+
+	// Compute the updated singular values, the arrays difl and difr, and the
+	// updated z.
+	for j := 0; j < k; j++ {
+		d[j], ok = impl.Dlasd4(k, j, dsigma, z, delta, rho, sum)
+		if !ok {
+			// The root finder failed to converge.
+			return false
+		}
+		prod[j] *= delta[j] * sum[j]
+		difl[j] = -delta[j]
+		if j < k-1 {
+			difr[j*ldr] = -delta[j+1]
+		}
+		for i := 0; i < j; i++ {
+			prod[i] *= delta[i] * sum[i] / (dsigma[i] - dsigma[j]) / (dsigma[i] + dsigma[j])
+		}
+		for i := j + 1; i < k; i++ {
+			prod[i] *= delta[i] * sum[i] / (dsigma[i] - dsigma[j]) / (dsigma[i] + dsigma[j])
+		}
+	}
+
+	// Compute the updated z.
+	for i := 0; i < k; i++ {
+		z[i] = math.Copysign(math.Sqrt(math.Abs(prod[i])), z[i])
+	}
+
+	// Update vf and vl. The components of the right singular vectors are
+	// computed in work[0:k], and the updated vf and vl in work[k:2*k] and
+	// work[2*k:3*k].
+	v := work[:k]
+	for j := 0; j < k; j++ {
+		diflj := difl[j]
+		dj := d[j]
+		dsigj := -dsigma[j]
+		var difrj, dsigjp float64
+		if j < k-1 {
+			difrj = -difr[j*ldr]
+			dsigjp = -dsigma[j+1]
+		}
+		v[j] = -z[j] / diflj / (dsigma[j] + dj)
+		for i := 0; i < j; i++ {
+			v[i] = z[i] / ((dsigma[i] + dsigj) - diflj) / (dsigma[i] + dj)
+		}
+		for i := j + 1; i < k; i++ {
+			v[i] = z[i] / ((dsigma[i] + dsigjp) + difrj) / (dsigma[i] + dj)
+		}
+		temp := bi.Dnrm2(k, v, 1)
+		sum[j] = bi.Ddot(k, v, 1, vf, 1) / temp
+		prod[j] = bi.Ddot(k, v, 1, vl, 1) / temp
+		if icompq == 1 {
+			difr[j*lddifr+1] = temp
+		}
+	}
+	copy(vf[:k], sum)
+	copy(vl[:k], prod)
+
+	return true
+}
@@ -0,0 +1,255 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dlasda computes the singular value decomposition of a real upper bidiagonal
+// matrix B with diagonal d and off-diagonal e using a divide and conquer
+// method. B has n rows and m = n+sqre columns, and its additional element is
+// stored in e[n-1] if sqre == 1. sqre must be 0 or 1, otherwise Dlasda will
+// panic. The singular vectors are computed in a compact form that is used by
+// Dlalsa to apply them to a right hand side.
+//
+// The matrix is split by Dlasdt into a tree of subproblems with at most smlsiz
+// rows at the bottom level. The subproblems at the bottom level are solved by
+// Dlasdq and merged up the tree by Dlasd6. If n <= smlsiz, the decomposition
+// is computed directly by Dlasdq.
+//
+// On return, d contains the singular values of B and e has been overwritten.
+//
+// If icompq == 0, only the singular values are computed. u, vt, k, poles,
+// givptr, givcol, perm, givnum, c and s are not referenced, and difl, difr
+// and z are used as workspace and must have length at least n, n and m
+// respectively.
+//
+// If icompq == 1, the singular vectors are computed in compact form:
+//   - u is an n×smlsiz matrix and vt is an m×(smlsiz+1) matrix that contain
+//     the left and the right singular vector matrices of the subproblems at
+//     the bottom level of the tree,
+//   - k, givptr, c and s contain for each node of the tree the dimension of
+//     the non-deflated problem, the number of Givens rotations and the cosine
+//     and sine of the rotation applied in the merge as returned by Dlasd6, and
+//     must have length at least n,
+//   - difl, z and perm are stored by level of the tree in consecutive
+//     vectors of length ldgcol, and must have length at least nlvl*ldgcol
+//     where nlvl is the number of levels returned by Dlasdt,
+//   - difr, poles, givnum and givcol are stored by level of the tree in
+//     consecutive ldgcol×2 matrices with leading dimension 2, and must have
+//     length at least 2*nlvl*ldgcol.
+//
+// If icompq == 1, ldgcol must be at least m. icompq must be 0 or 1.
+//
+// work must have length at least 6*m + (smlsiz+1)² and iwork must have length
+// at least 7*n, otherwise Dlasda will panic.
+//
+// Dlasda returns whether the decomposition was successful.
+//
+// Dlasda is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlasda(icompq, smlsiz, n, sqre int, d, e, u []float64, ldu int, vt []float64, ldvt int, k []int, difl, difr, z, poles []float64, givptr, givcol []int, ldgcol int, perm []int, givnum, c, s, work []float64, iwork []int) (ok bool) {
+	m := n + sqre
+	switch {
+	case icompq != 0 && icompq != 1:
+		panic(badIcompq)
+	case smlsiz < 3:
+		panic(badSmlsiz)
+	case n < 0:
+		panic(nLT0)
+	case sqre != 0 && sqre != 1:
+		panic(badSqre)
+	case icompq == 1 && ldu < max(1, smlsiz):
+		panic(badLdU)
+	case icompq == 1 && ldvt < smlsiz+1:
+		panic(badLdVT)
+	case icompq == 1 && ldgcol < max(1, m):
+		panic(badLdGCol)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	smlszp := smlsiz + 1
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1+sqre:
+		panic(shortE)
+	case len(work) < 6*m+smlszp*smlszp:
+		panic(shortWork)
+	case len(iwork) < 7*n:
+		panic(shortIWork)
+	}
+
+	// If the matrix is small, compute its singular value decomposition
+	// directly.
+	if n <= smlsiz {
+		if icompq == 0 {
+			return impl.Dlasdq(blas.Upper, sqre, n, 0, 0, 0, d, e, nil, 1, nil, 1, nil, 1, work)
+		}
+		switch {
+		case len(u) < (n-1)*ldu+n:
+			panic(shortU)
+		case len(vt) < (m-1)*ldvt+m:
+			panic(shortVT)
+		}
+		impl.Dlaset(blas.All, n, n, 0, 1, u, ldu)
+		impl.Dlaset(blas.All, m, m, 0, 1, vt, ldvt)
+		return impl.Dlasdq(blas.Upper, sqre, n, m, n, 0, d, e, vt, ldvt, u, ldu, nil, 1, work)
+	}
+
+	nlvl := int(math.Log(float64(n)/float64(smlszp))/math.Ln2) + 1
+	if icompq == 0 {
+		switch {
+		case len(difl) < n:
+			panic(shortDifl)
+		case len(difr) < n:
+			panic(shortDifr)
+		case len(z) < m:
+			panic(shortZ)
+		}
+	} else {
+		switch {
+		case len(u) < (n-1)*ldu+smlsiz:
+			panic(shortU)
+		case len(vt) < (m-1)*ldvt+smlszp:
+			panic(shortVT)
+		case len(k) < n:
+			panic(shortK)
+		case len(difl) < nlvl*ldgcol:
+			panic(shortDifl)
+		case len(difr) < 2*nlvl*ldgcol:
+			panic(shortDifr)
+		case len(z) < nlvl*ldgcol:
+			panic(shortZ)
+		case len(poles) < 2*nlvl*ldgcol:
+			panic(shortPoles)
+		case len(givptr) < n:
+			panic(shortGivptr)
+		case len(givcol) < 2*nlvl*ldgcol:
+			panic(shortGivcol)
+		case len(perm) < nlvl*ldgcol:
+			panic(shortPerm)
+		case len(givnum) < 2*nlvl*ldgcol:
+			panic(shortGivnum)
+		case len(c) < n:
+			panic(shortC)
+		case len(s) < n:
+			panic(shortS)
+		}
+	}
+
+	// Book-keeping and set up the computation tree.
+	inode := 0
+	ndiml := inode + n
+	ndimr := ndiml + n
+	idxq := ndimr + n
+	iwk := idxq + n
+
+	vf := 0
+	vl := vf + m
+	nwork1 := vl + m
+	nwork2 := nwork1 + smlszp*smlszp
+
+	_, nd := impl.Dlasdt(n, smlsiz, iwork[inode:], iwork[ndiml:], iwork[ndimr:])
+
+	bi := blas64.Implementation()
+
+	// For the nodes on the bottom level of the tree, solve their
+	// subproblems by Dlasdq.
+	ndb1 := (nd+1)/2 - 1
+	for i := ndb1; i < nd; i++ {
+		// ic is the center row of the subproblem, nl and nr are the sizes
+		// of its left and right children and nlf and nrf are their first
+		// rows.
+		ic := iwork[inode+i]
+		nl := iwork[ndiml+i]
+		nlp1 := nl + 1
+		nr := iwork[ndimr+i]
+		nlf := ic - nl
+		nrf := ic + 1
+		sqrei := 1
+		if icompq == 0 {
+			impl.Dlaset(blas.All, nlp1, nlp1, 0, 1, work[nwork1:], smlszp)
+			ok = impl.Dlasdq(blas.Upper, sqrei, nl, nlp1, 0, 0, d[nlf:], e[nlf:], work[nwork1:], smlszp, nil, 1, nil, 1, work[nwork2:])
+			bi.Dcopy(nlp1, work[nwork1:], smlszp, work[vf+nlf:], 1)
+			bi.Dcopy(nlp1, work[nwork1+nl:], smlszp, work[vl+nlf:], 1)
+		} else {
+			impl.Dlaset(blas.All, nl, nl, 0, 1, u[nlf*ldu:], ldu)
+			impl.Dlaset(blas.All, nlp1, nlp1, 0, 1, vt[nlf*ldvt:], ldvt)
+			ok = impl.Dlasdq(blas.Upper, sqrei, nl, nlp1, nl, 0, d[nlf:], e[nlf:], vt[nlf*ldvt:], ldvt, u[nlf*ldu:], ldu, nil, 1, work[nwork1:])
+			bi.Dcopy(nlp1, vt[nlf*ldvt:], ldvt, work[vf+nlf:], 1)
+			bi.Dcopy(nlp1, vt[nlf*ldvt+nl:], ldvt, work[vl+nlf:], 1)
+		}
+		if !ok {
+			return false
+		}
+		for j := 0; j < nl; j++ {
+			iwork[idxq+nlf+j] = j
+		}
+
+		if i == nd-1 && sqre == 0 {
+			sqrei = 0
+		}
+		nrp1 := nr + sqrei
+		if icompq == 0 {
+			impl.Dlaset(blas.All, nrp1, nrp1, 0, 1, work[nwork1:], smlszp)
+			ok = impl.Dlasdq(blas.Upper, sqrei, nr, nrp1, 0, 0, d[nrf:], e[nrf:], work[nwork1:], smlszp, nil, 1, nil, 1, work[nwork2:])
+			bi.Dcopy(nrp1, work[nwork1:], smlszp, work[vf+nrf:], 1)
+			bi.Dcopy(nrp1, work[nwork1+nrp1-1:], smlszp, work[vl+nrf:], 1)
+		} else {
+			impl.Dlaset(blas.All, nr, nr, 0, 1, u[nrf*ldu:], ldu)
+			impl.Dlaset(blas.All, nrp1, nrp1, 0, 1, vt[nrf*ldvt:], ldvt)
+			ok = impl.Dlasdq(blas.Upper, sqrei, nr, nrp1, nr, 0, d[nrf:], e[nrf:], vt[nrf*ldvt:], ldvt, u[nrf*ldu:], ldu, nil, 1, work[nwork1:])
+			bi.Dcopy(nrp1, vt[nrf*ldvt:], ldvt, work[vf+nrf:], 1)
+			bi.Dcopy(nrp1, vt[nrf*ldvt+nrp1-1:], ldvt, work[vl+nrf:], 1)
+		}
+		if !ok {
+			return false
+		}
+		for j := 0; j < nr; j++ {
+			iwork[idxq+nrf+j] = j
+		}
+	}
+
+	// Now conquer each subproblem bottom-up. The results for the nodes are
+	// stored in k, givptr, c and s in the reverse order of their
+	// processing.
+	j := 1 << uint(nlvl)
+	for lvl := nlvl - 1; lvl >= 0; lvl-- {
+		// Find the first node lf and the last node ll on the current level.
+		lf := 1<<uint(lvl) - 1
+		ll := 2 * lf
+		for i := lf; i <= ll; i++ {
+			ic := iwork[inode+i]
+			nl := iwork[ndiml+i]
+			nr := iwork[ndimr+i]
+			nlf := ic - nl
+			sqrei := 1
+			if i == ll {
+				sqrei = sqre
+			}
+			alpha := d[ic]
+			beta := e[ic]
+			if icompq == 0 {
+				_, _, _, _, ok = impl.Dlasd6(0, nl, nr, sqrei, d[nlf:], work[vf+nlf:], work[vl+nlf:], alpha, beta, iwork[idxq+nlf:], nil, nil, 2, nil, 2, nil, difl, difr, z, work[nwork1:], iwork[iwk:])
+			} else {
+				j--
+				lv := lvl * ldgcol
+				k[j-1], givptr[j-1], c[j-1], s[j-1], ok = impl.Dlasd6(1, nl, nr, sqrei, d[nlf:], work[vf+nlf:], work[vl+nlf:], alpha, beta, iwork[idxq+nlf:], perm[lv+nlf:], givcol[2*lv+2*nlf:], 2, givnum[2*lv+2*nlf:], 2, poles[2*lv+2*nlf:], difl[lv+nlf:], difr[2*lv+2*nlf:], z[lv+nlf:], work[nwork1:], iwork[iwk:])
+			}
+			if !ok {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,200 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dlasdq computes the singular value decomposition of a real bidiagonal
+// matrix B with diagonal d and off-diagonal e
+//
+//	B = Q * S * Pᵀ
+//
+// where S is a diagonal matrix of singular values, and Q and P are orthogonal
+// matrices of left and right singular vectors. B is an n×n matrix if
+// sqre == 0. If sqre == 1, B is an n×(n+1) matrix if uplo == blas.Upper and
+// an (n+1)×n matrix if uplo == blas.Lower, and its additional element is
+// stored in e[n-1]. sqre must be 0 or 1, otherwise Dlasdq will panic.
+//
+// d must have length at least n and e must have length at least n-1+sqre. On
+// return, d contains the singular values of B in increasing order and e has
+// been overwritten.
+//
+// VT is a matrix with ncvt columns whose elements are stored in vt. It has
+// n+1 rows if uplo == blas.Upper and sqre == 1, and n rows otherwise. On
+// return, VT is overwritten by Pᵀ * VT. VT is not used if ncvt == 0.
+//
+// U is a matrix with nru rows whose elements are stored in u. It has n+1
+// columns if uplo == blas.Lower and sqre == 1, and n columns otherwise. On
+// return, U is overwritten by U * Q. U is not used if nru == 0.
+//
+// C is a matrix with ncc columns whose elements are stored in c. It has n+1
+// rows if uplo == blas.Lower and sqre == 1, and n rows otherwise. On return, C
+// is overwritten by Qᵀ * C. C is not used if ncc == 0.
+//
+// work must have length at least 4*n, otherwise Dlasdq will panic.
+//
+// Dlasdq returns whether the decomposition was successful.
+//
+// Dlasdq is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlasdq(uplo blas.Uplo, sqre, n, ncvt, nru, ncc int, d, e, vt []float64, ldvt int, u []float64, ldu int, c []float64, ldc int, work []float64) (ok bool) {
+	// Dimensions of VT, U and C that depend on the shape of B.
+	vtRows, uCols, cRows := n, n, n
+	if uplo == blas.Upper {
+		vtRows += sqre
+	} else {
+		uCols += sqre
+		cRows += sqre
+	}
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case sqre != 0 && sqre != 1:
+		panic(badSqre)
+	case n < 0:
+		panic(nLT0)
+	case ncvt < 0:
+		panic(ncvtLT0)
+	case nru < 0:
+		panic(nruLT0)
+	case ncc < 0:
+		panic(nccLT0)
+	case ldvt < max(1, ncvt):
+		panic(badLdVT)
+	case (ldu < max(1, uCols) && nru > 0) || (ldu < 1 && nru == 0):
+		panic(badLdU)
+	case ldc < max(1, ncc):
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1+sqre:
+		panic(shortE)
+	case ncvt > 0 && len(vt) < (vtRows-1)*ldvt+ncvt:
+		panic(shortVT)
+	case nru > 0 && len(u) < (nru-1)*ldu+uCols:
+		panic(shortU)
+	case ncc > 0 && len(c) < (cRows-1)*ldc+ncc:
+		panic(shortC)
+	case len(work) < 4*n:
+		panic(shortWork)
+	}
+
+	rotate := ncvt > 0 || nru > 0 || ncc > 0
+	np1 := n + 1
+	iuplo := uplo
+	sqre1 := sqre
+
+	// If the matrix is non-square upper bidiagonal, rotate it to be lower
+	// bidiagonal. The rotations are on the right.
+	if iuplo == blas.Upper && sqre1 == 1 {
+		for i := 0; i < n-1; i++ {
+			cs, sn, r := impl.Dlartg(d[i], e[i])
+			d[i] = r
+			e[i] = sn * d[i+1]
+			d[i+1] *= cs
+			if rotate {
+				work[i] = cs
+				work[n+i] = sn
+			}
+		}
+		cs, sn, r := impl.Dlartg(d[n-1], e[n-1])
+		d[n-1] = r
+		e[n-1] = 0
+		if rotate {
+			work[n-1] = cs
+			work[2*n-1] = sn
+		}
+		iuplo = blas.Lower
+		sqre1 = 0
+
+		// Update singular vectors if desired.
+		if ncvt > 0 {
+			impl.Dlasr(blas.Left, lapack.Variable, lapack.Forward, np1, ncvt, work[:n], work[n:2*n], vt, ldvt)
+		}
+	}
+
+	// If the matrix is lower bidiagonal, rotate it to be upper bidiagonal by
+	// applying Givens rotations on the left.
+	if iuplo == blas.Lower {
+		for i := 0; i < n-1; i++ {
+			cs, sn, r := impl.Dlartg(d[i], e[i])
+			d[i] = r
+			e[i] = sn * d[i+1]
+			d[i+1] *= cs
+			if rotate {
+				work[i] = cs
+				work[n+i] = sn
+			}
+		}
+
+		// If the matrix is (n+1)×n lower bidiagonal, one additional rotation
+		// is needed.
+		if sqre1 == 1 {
+			cs, sn, r := impl.Dlartg(d[n-1], e[n-1])
+			d[n-1] = r
+			if rotate {
+				work[n-1] = cs
+				work[2*n-1] = sn
+			}
+		}
+
+		// Update singular vectors if desired.
+		if nru > 0 {
+			impl.Dlasr(blas.Right, lapack.Variable, lapack.Forward, nru, n+sqre1, work[:n], work[n:2*n], u, ldu)
+		}
+		if ncc > 0 {
+			impl.Dlasr(blas.Left, lapack.Variable, lapack.Forward, n+sqre1, ncc, work[:n], work[n:2*n], c, ldc)
+		}
+	}
+
+	// Compute the singular value decomposition of the n×n upper bidiagonal
+	// matrix.
+	ok = impl.Dbdsqr(blas.Upper, n, ncvt, nru, ncc, d, e, vt, ldvt, u, ldu, c, ldc, work)
+	if !ok {
+		return false
+	}
+
+	// Sort the singular values into increasing order with one transposition
+	// per singular vector.
+	bi := blas64.Implementation()
+	for i := 0; i < n; i++ {
+		// Scan for the smallest d[i].
+		isub := i
+		smin := d[i]
+		for j := i + 1; j < n; j++ {
+			if d[j] < smin {
+				isub = j
+				smin = d[j]
+			}
+		}
+		if isub == i {
+			continue
+		}
+		// Swap the singular values and vectors.
+		d[isub] = d[i]
+		d[i] = smin
+		if ncvt > 0 {
+			bi.Dswap(ncvt, vt[isub*ldvt:], 1, vt[i*ldvt:], 1)
+		}
+		if nru > 0 {
+			bi.Dswap(nru, u[isub:], ldu, u[i:], ldu)
+		}
+		if ncc > 0 {
+			bi.Dswap(ncc, c[isub*ldc:], 1, c[i*ldc:], 1)
+		}
+	}
+	return true
+}
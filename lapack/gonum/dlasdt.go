@@ -0,0 +1,72 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// Dlasdt creates a tree of subproblems for the divide and conquer computation
+// of the singular value decomposition of an n×n bidiagonal matrix. The
+// subproblems at the bottom level of the tree have at most msub rows.
+//
+// The nodes of the tree are numbered level by level starting at the root, so
+// that the children of node i are the nodes 2*i+1 and 2*i+2. On return, inode
+// contains the centers of the subproblems, and ndiml and ndimr contain the
+// numbers of rows of their left and right children. Node i divides the rows
+// inode[i]-ndiml[i], ..., inode[i]+ndimr[i] of the matrix into the left child,
+// the row inode[i] and the right child. inode, ndiml and ndimr must have
+// length at least n.
+//
+// Dlasdt returns the number of levels lvl of the tree and the number of its
+// nodes nd.
+//
+// Dlasdt is an internal routine. It is exported for testing purposes.
+func (Implementation) Dlasdt(n, msub int, inode, ndiml, ndimr []int) (lvl, nd int) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case msub < 1:
+		panic(msubLT1)
+	case len(inode) < n:
+		panic(shortInode)
+	case len(ndiml) < n:
+		panic(shortNdiml)
+	case len(ndimr) < n:
+		panic(shortNdimr)
+	}
+
+	// Find the number of levels on the tree.
+	maxn := max(1, n)
+	lvl = int(math.Log(float64(maxn)/float64(msub+1))/math.Ln2) + 1
+
+	if n == 0 {
+		return lvl, 0
+	}
+
+	i := n / 2
+	inode[0] = i
+	ndiml[0] = i
+	ndimr[0] = n - i - 1
+	il := -1
+	ir := 0
+	llst := 1
+	for nlvl := 1; nlvl < lvl; nlvl++ {
+		// Construct the tree at level nlvl, counting the levels from 0 at
+		// the root.
+		for i := 0; i < llst; i++ {
+			il += 2
+			ir += 2
+			ncrnt := llst - 1 + i
+			ndiml[il] = ndiml[ncrnt] / 2
+			ndimr[il] = ndiml[ncrnt] - ndiml[il] - 1
+			inode[il] = inode[ncrnt] - ndimr[il] - 1
+			ndiml[ir] = ndimr[ncrnt] / 2
+			ndimr[ir] = ndimr[ncrnt] - ndiml[ir] - 1
+			inode[ir] = inode[ncrnt] + ndiml[ir] + 1
+		}
+		llst *= 2
+	}
+	nd = 2*llst - 1
+	return lvl, nd
+}
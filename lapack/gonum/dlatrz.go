@@ -0,0 +1,86 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// Dlatrz factors the m×(m+l) upper trapezoidal matrix
+//
+//	[ A1 A2 ] = [ A[0:m,0:m] A[0:m,n-l:n] ]
+//
+// as
+//
+//	[ A1 A2 ] = [ R 0 ] * Z
+//
+// by means of orthogonal transformations, where Z is an (m+l)×(m+l) orthogonal
+// matrix and R and A1 are m×m upper triangular matrices. The columns
+// A[0:m,m:n-l] are not referenced.
+//
+// On return, the leading m×m upper triangular part of A contains the upper
+// triangular matrix R, and the elements A[0:m,n-l:n], with tau, represent the
+// orthogonal matrix Z as a product of m elementary reflectors
+//
+//	Z = Z_0 * Z_1 * ... * Z_{m-1}.
+//
+// Each Z_k has the form
+//
+//	Z_k = I - tau[k] * u * uᵀ
+//
+// where u has the form
+//
+//	u = [ e_k ]
+//	    [  0  ]
+//	    [  z  ]
+//
+// and z is the vector of length l stored in A[k,n-l:n].
+//
+// tau must have length m and work must have length at least m, otherwise
+// Dlatrz will panic.
+//
+// Dlatrz is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlatrz(m, n, l int, a []float64, lda int, tau, work []float64) {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < m:
+		panic(nLTM)
+	case l < 0:
+		panic(lLT0)
+	case l > n-m:
+		panic(lGTNM)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if m == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(tau) != m:
+		panic(badLenTau)
+	case len(work) < m:
+		panic(shortWork)
+	}
+
+	if m == n {
+		for i := range tau {
+			tau[i] = 0
+		}
+		return
+	}
+
+	for i := m - 1; i >= 0; i-- {
+		// Generate elementary reflector H_i to annihilate
+		// [ A[i,i] A[i,n-l:n] ].
+		a[i*lda+i], tau[i] = impl.Dlarfg(l+1, a[i*lda+i], a[i*lda+n-l:], 1)
+
+		// Apply H_i to A[0:i,i:n] from the right.
+		impl.Dlarz(blas.Right, i, n-i, l, a[i*lda+n-l:], 1, tau[i], a[i:], lda, work)
+	}
+}
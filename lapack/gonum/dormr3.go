@@ -0,0 +1,102 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// Dormr3 multiplies an m×n matrix C by an orthogonal matrix Z defined as the
+// product of k elementary reflectors
+//
+//	Z = Z_0 * Z_1 * ... * Z_{k-1}
+//
+// as returned by Dlatrz:
+//
+//	C = Z * C   if side == blas.Left and trans == blas.NoTrans
+//	C = Zᵀ * C  if side == blas.Left and trans == blas.Trans
+//	C = C * Z   if side == blas.Right and trans == blas.NoTrans
+//	C = C * Zᵀ  if side == blas.Right and trans == blas.Trans
+//
+// l is the number of columns of the matrix A containing the meaningful part of
+// the Householder reflectors. If side == blas.Left, A is a matrix of size k×m
+// and 0 <= l <= m, and if side == blas.Right, A is a matrix of size k×n and
+// 0 <= l <= n. The i-th row of A must contain the vector which defines the
+// elementary reflector Z_i in its last l columns.
+//
+// tau contains the Householder scales and must have length k, otherwise
+// Dormr3 will panic.
+//
+// work must have length at least n if side == blas.Left and at least m if
+// side == blas.Right, otherwise Dormr3 will panic.
+//
+// Dormr3 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dormr3(side blas.Side, trans blas.Transpose, m, n, k, l int, a []float64, lda int, tau, c []float64, ldc int, work []float64) {
+	left := side == blas.Left
+	nq := n
+	nw := m
+	if left {
+		nq = m
+		nw = n
+	}
+	switch {
+	case !left && side != blas.Right:
+		panic(badSide)
+	case trans != blas.NoTrans && trans != blas.Trans:
+		panic(badTrans)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case k < 0:
+		panic(kLT0)
+	case k > nq:
+		if left {
+			panic(kGTM)
+		}
+		panic(kGTN)
+	case l < 0:
+		panic(lLT0)
+	case l > nq:
+		if left {
+			panic(lGTM)
+		}
+		panic(lGTN)
+	case lda < max(1, nq):
+		panic(badLdA)
+	case ldc < max(1, n):
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 || k == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (k-1)*lda+nq:
+		panic(shortA)
+	case len(tau) != k:
+		panic(badLenTau)
+	case len(c) < (m-1)*ldc+n:
+		panic(shortC)
+	case len(work) < nw:
+		panic(shortWork)
+	}
+
+	ja := nq - l
+	forward := (left && trans == blas.Trans) || (!left && trans == blas.NoTrans)
+	for j := 0; j < k; j++ {
+		i := j
+		if !forward {
+			i = k - 1 - j
+		}
+		if left {
+			// Z_i or Z_iᵀ is applied to C[i:m,0:n].
+			impl.Dlarz(side, m-i, n, l, a[i*lda+ja:], 1, tau[i], c[i*ldc:], ldc, work)
+		} else {
+			// Z_i or Z_iᵀ is applied to C[0:m,i:n].
+			impl.Dlarz(side, m, n-i, l, a[i*lda+ja:], 1, tau[i], c[i:], ldc, work)
+		}
+	}
+}
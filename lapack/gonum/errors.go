@@ -36,6 +36,10 @@ const (
 	bothSVDOver         = "lapack: both jobU and jobVT are lapack.SVDOverwrite"
 
 	// Panic strings for bad numerical and string values.
+	badDtrd1    = "lapack: bad value of dtrd1"
+	badDtrd2    = "lapack: bad value of dtrd2"
+	badI        = "lapack: i out of range"
+	badIcompq   = "lapack: bad value of icompq"
 	badIfst     = "lapack: ifst out of range"
 	badIhi      = "lapack: ihi out of range"
 	badIhiz     = "lapack: ihiz out of range"
@@ -61,7 +65,11 @@ const (
 	badNw       = "lapack: bad value of nw"
 	badPp       = "lapack: bad value of pp"
 	badShifts   = "lapack: bad shifts"
+	badSmlsiz   = "lapack: bad value of smlsiz"
+	badSqre     = "lapack: bad value of sqre"
+	givptrLT0   = "lapack: givptr < 0"
 	i0LT0       = "lapack: i0 < 0"
+	jLT0        = "lapack: j < 0"
 	kGTM        = "lapack: k > m"
 	kGTN        = "lapack: k > n"
 	kLT0        = "lapack: k < 0"
@@ -69,11 +77,18 @@ const (
 	kdLT0       = "lapack: kd < 0"
 	klLT0       = "lapack: kl < 0"
 	kuLT0       = "lapack: ku < 0"
+	lGTM        = "lapack: l > m"
+	lGTN        = "lapack: l > n"
+	lGTNM       = "lapack: l > n-m"
+	lLT0        = "lapack: l < 0"
 	mGTN        = "lapack: m > n"
 	mLT0        = "lapack: m < 0"
 	mmLT0       = "lapack: mm < 0"
+	msubLT1     = "lapack: msub < 1"
 	n0LT0       = "lapack: n0 < 0"
 	nGTM        = "lapack: n > m"
+	nGTMP       = "lapack: n > m+p"
+	nLESmlsiz   = "lapack: n <= smlsiz"
 	nLT0        = "lapack: n < 0"
 	nLT1        = "lapack: n < 1"
 	nLTM        = "lapack: n < m"
@@ -87,14 +102,19 @@ const (
 	negANorm    = "lapack: anorm < 0"
 	negZ        = "lapack: negative z value"
 	nhLT0       = "lapack: nh < 0"
+	nlLT1       = "lapack: nl < 1"
+	nonPosRho   = "lapack: rho <= 0"
 	notIsolated = "lapack: block is not isolated"
+	nrLT1       = "lapack: nr < 1"
 	nrhsLT0     = "lapack: nrhs < 0"
+	nrhsLT1     = "lapack: nrhs < 1"
 	nruLT0      = "lapack: nru < 0"
 	nshftsLT0   = "lapack: nshfts < 0"
 	nshftsOdd   = "lapack: nshfts must be even"
 	nvLT0       = "lapack: nv < 0"
 	offsetGTM   = "lapack: offset > m"
 	offsetLT0   = "lapack: offset < 0"
+	pGTN        = "lapack: p > n"
 	pLT0        = "lapack: p < 0"
 	recurLT0    = "lapack: recur < 0"
 	zeroCFrom   = "lapack: zero cfrom"
@@ -102,63 +122,96 @@ const (
 	// Panic strings for bad slice lengths.
 	badLenAlpha    = "lapack: bad length of alpha"
 	badLenBeta     = "lapack: bad length of beta"
+	badLenC        = "lapack: bad length of c"
+	badLenD        = "lapack: bad length of d"
 	badLenIpiv     = "lapack: bad length of ipiv"
 	badLenJpiv     = "lapack: bad length of jpiv"
 	badLenJpvt     = "lapack: bad length of jpvt"
 	badLenK        = "lapack: bad length of k"
 	badLenPiv      = "lapack: bad length of piv"
+	badLenS        = "lapack: bad length of s"
 	badLenSelected = "lapack: bad length of selected"
 	badLenSi       = "lapack: bad length of si"
 	badLenSr       = "lapack: bad length of sr"
 	badLenTau      = "lapack: bad length of tau"
+	badLenTauA     = "lapack: bad length of taua"
+	badLenTauB     = "lapack: bad length of taub"
 	badLenWi       = "lapack: bad length of wi"
 	badLenWr       = "lapack: bad length of wr"
+	badLenX        = "lapack: bad length of x"
+	badLenY        = "lapack: bad length of y"
 
 	// Panic strings for insufficient slice lengths.
-	shortA     = "lapack: insufficient length of a"
-	shortAB    = "lapack: insufficient length of ab"
-	shortAuxv  = "lapack: insufficient length of auxv"
-	shortB     = "lapack: insufficient length of b"
-	shortC     = "lapack: insufficient length of c"
-	shortCNorm = "lapack: insufficient length of cnorm"
-	shortD     = "lapack: insufficient length of d"
-	shortDL    = "lapack: insufficient length of dl"
-	shortDU    = "lapack: insufficient length of du"
-	shortE     = "lapack: insufficient length of e"
-	shortF     = "lapack: insufficient length of f"
-	shortH     = "lapack: insufficient length of h"
-	shortIWork = "lapack: insufficient length of iwork"
-	shortIsgn  = "lapack: insufficient length of isgn"
-	shortQ     = "lapack: insufficient length of q"
-	shortRHS   = "lapack: insufficient length of rhs"
-	shortS     = "lapack: insufficient length of s"
-	shortScale = "lapack: insufficient length of scale"
-	shortT     = "lapack: insufficient length of t"
-	shortTau   = "lapack: insufficient length of tau"
-	shortTauP  = "lapack: insufficient length of tauP"
-	shortTauQ  = "lapack: insufficient length of tauQ"
-	shortU     = "lapack: insufficient length of u"
-	shortV     = "lapack: insufficient length of v"
-	shortVL    = "lapack: insufficient length of vl"
-	shortVR    = "lapack: insufficient length of vr"
-	shortVT    = "lapack: insufficient length of vt"
-	shortVn1   = "lapack: insufficient length of vn1"
-	shortVn2   = "lapack: insufficient length of vn2"
-	shortW     = "lapack: insufficient length of w"
-	shortWH    = "lapack: insufficient length of wh"
-	shortWV    = "lapack: insufficient length of wv"
-	shortWi    = "lapack: insufficient length of wi"
-	shortWork  = "lapack: insufficient length of work"
-	shortWr    = "lapack: insufficient length of wr"
-	shortX     = "lapack: insufficient length of x"
-	shortY     = "lapack: insufficient length of y"
-	shortZ     = "lapack: insufficient length of z"
+	shortA      = "lapack: insufficient length of a"
+	shortAB     = "lapack: insufficient length of ab"
+	shortAuxv   = "lapack: insufficient length of auxv"
+	shortB      = "lapack: insufficient length of b"
+	shortBX     = "lapack: insufficient length of bx"
+	shortC      = "lapack: insufficient length of c"
+	shortCNorm  = "lapack: insufficient length of cnorm"
+	shortD      = "lapack: insufficient length of d"
+	shortDL     = "lapack: insufficient length of dl"
+	shortDSigma = "lapack: insufficient length of dsigma"
+	shortDU     = "lapack: insufficient length of du"
+	shortDelta  = "lapack: insufficient length of delta"
+	shortDifl   = "lapack: insufficient length of difl"
+	shortDifr   = "lapack: insufficient length of difr"
+	shortE      = "lapack: insufficient length of e"
+	shortF      = "lapack: insufficient length of f"
+	shortGivcol = "lapack: insufficient length of givcol"
+	shortGivnum = "lapack: insufficient length of givnum"
+	shortGivptr = "lapack: insufficient length of givptr"
+	shortH      = "lapack: insufficient length of h"
+	shortIWork  = "lapack: insufficient length of iwork"
+	shortIdx    = "lapack: insufficient length of idx"
+	shortIdxp   = "lapack: insufficient length of idxp"
+	shortIdxq   = "lapack: insufficient length of idxq"
+	shortIndex  = "lapack: insufficient length of index"
+	shortInode  = "lapack: insufficient length of inode"
+	shortIsgn   = "lapack: insufficient length of isgn"
+	shortK      = "lapack: insufficient length of k"
+	shortNdiml  = "lapack: insufficient length of ndiml"
+	shortNdimr  = "lapack: insufficient length of ndimr"
+	shortPerm   = "lapack: insufficient length of perm"
+	shortPoles  = "lapack: insufficient length of poles"
+	shortQ      = "lapack: insufficient length of q"
+	shortRHS    = "lapack: insufficient length of rhs"
+	shortS      = "lapack: insufficient length of s"
+	shortScale  = "lapack: insufficient length of scale"
+	shortT      = "lapack: insufficient length of t"
+	shortTau    = "lapack: insufficient length of tau"
+	shortTauP   = "lapack: insufficient length of tauP"
+	shortTauQ   = "lapack: insufficient length of tauQ"
+	shortU      = "lapack: insufficient length of u"
+	shortV      = "lapack: insufficient length of v"
+	shortVF     = "lapack: insufficient length of vf"
+	shortVFW    = "lapack: insufficient length of vfw"
+	shortVL     = "lapack: insufficient length of vl"
+	shortVLW    = "lapack: insufficient length of vlw"
+	shortVR     = "lapack: insufficient length of vr"
+	shortVT     = "lapack: insufficient length of vt"
+	shortVn1    = "lapack: insufficient length of vn1"
+	shortVn2    = "lapack: insufficient length of vn2"
+	shortW      = "lapack: insufficient length of w"
+	shortWH     = "lapack: insufficient length of wh"
+	shortWV     = "lapack: insufficient length of wv"
+	shortWi     = "lapack: insufficient length of wi"
+	shortWork   = "lapack: insufficient length of work"
+	shortWr     = "lapack: insufficient length of wr"
+	shortX      = "lapack: insufficient length of x"
+	shortY      = "lapack: insufficient length of y"
+	shortZ      = "lapack: insufficient length of z"
+	shortZW     = "lapack: insufficient length of zw"
 
 	// Panic strings for bad leading dimensions of matrices.
 	badLdA    = "lapack: bad leading dimension of A"
 	badLdB    = "lapack: bad leading dimension of B"
+	badLdBX   = "lapack: bad leading dimension of BX"
 	badLdC    = "lapack: bad leading dimension of C"
+	badLdDifr = "lapack: bad leading dimension of DIFR"
 	badLdF    = "lapack: bad leading dimension of F"
+	badLdGCol = "lapack: bad leading dimension of GIVCOL"
+	badLdGNum = "lapack: bad leading dimension of GIVNUM"
 	badLdH    = "lapack: bad leading dimension of H"
 	badLdQ    = "lapack: bad leading dimension of Q"
 	badLdT    = "lapack: bad leading dimension of T"
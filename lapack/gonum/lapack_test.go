@@ -92,6 +92,21 @@ func TestDgesc2(t *testing.T) {
 	testlapack.Dgesc2Test(t, impl)
 }
 
+func TestDgelsd(t *testing.T) {
+	t.Parallel()
+	testlapack.DgelsdTest(t, impl)
+}
+
+func TestDgelss(t *testing.T) {
+	t.Parallel()
+	testlapack.DgelssTest(t, impl)
+}
+
+func TestDgelsy(t *testing.T) {
+	t.Parallel()
+	testlapack.DgelsyTest(t, impl)
+}
+
 func TestDgeqp3(t *testing.T) {
 	t.Parallel()
 	testlapack.Dgeqp3Test(t, impl)
@@ -148,11 +163,21 @@ func TestDgetrs(t *testing.T) {
 	testlapack.DgetrsTest(t, impl)
 }
 
+func TestDggglm(t *testing.T) {
+	t.Parallel()
+	testlapack.DggglmTest(t, impl)
+}
+
 func TestDgghrd(t *testing.T) {
 	t.Parallel()
 	testlapack.DgghrdTest(t, impl)
 }
 
+func TestDgglse(t *testing.T) {
+	t.Parallel()
+	testlapack.DgglseTest(t, impl)
+}
+
 func TestDggsvd3(t *testing.T) {
 	t.Parallel()
 	testlapack.Dggsvd3Test(t, impl)
@@ -228,6 +253,11 @@ func TestDlaln2(t *testing.T) {
 	testlapack.Dlaln2Test(t, impl)
 }
 
+func TestDlalsd(t *testing.T) {
+	t.Parallel()
+	testlapack.DlalsdTest(t, impl)
+}
+
 func TestDlangb(t *testing.T) {
 	t.Parallel()
 	testlapack.DlangbTest(t, impl)
@@ -278,6 +308,16 @@ func TestDlascl(t *testing.T) {
 	testlapack.DlasclTest(t, impl)
 }
 
+func TestDlasd4(t *testing.T) {
+	t.Parallel()
+	testlapack.Dlasd4Test(t, impl)
+}
+
+func TestDlasda(t *testing.T) {
+	t.Parallel()
+	testlapack.DlasdaTest(t, impl)
+}
+
 func TestDlaset(t *testing.T) {
 	t.Parallel()
 	testlapack.DlasetTest(t, impl)
@@ -433,6 +473,11 @@ func TestDlauu2(t *testing.T) {
 	testlapack.Dlauu2Test(t, impl)
 }
 
+func TestDlatrz(t *testing.T) {
+	t.Parallel()
+	testlapack.DlatrzTest(t, impl)
+}
+
 func TestDlauum(t *testing.T) {
 	t.Parallel()
 	testlapack.DlauumTest(t, impl)
@@ -518,6 +563,11 @@ func TestDormr2(t *testing.T) {
 	testlapack.Dormr2Test(t, impl)
 }
 
+func TestDormrq(t *testing.T) {
+	t.Parallel()
+	testlapack.DormrqTest(t, impl)
+}
+
 func TestDorm2r(t *testing.T) {
 	t.Parallel()
 	testlapack.Dorm2rTest(t, impl)
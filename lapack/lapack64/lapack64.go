@@ -215,6 +215,98 @@ func Gels(trans blas.Transpose, a blas64.General, b blas64.General, work []float
 	return lapack64.Dgels(trans, a.Rows, a.Cols, b.Cols, a.Data, max(1, a.Stride), b.Data, max(1, b.Stride), work, lwork)
 }
 
+// Gelsd computes the minimum-norm solution to a real linear least squares
+// problem
+//
+//	minimize || A*X - B ||_2
+//
+// using the singular value decomposition of A computed by a divide and
+// conquer method. A is an m×n matrix which may be rank-deficient. On return, A
+// has been overwritten.
+//
+// On entry, b contains the m×nrhs right hand side matrix B. On return, the
+// leading n×nrhs submatrix of b contains the solution matrix X. b must have at
+// least max(m,n) rows.
+//
+// The effective rank of A is determined by treating as zero those singular
+// values which are less than or equal to rcond times the largest singular
+// value. If rcond is not in the interval (0,1), machine precision is used
+// instead.
+//
+// s must have length min(m,n) and on return contains the singular values of A
+// in decreasing order.
+//
+// work must have length at least max(1,lwork), and lwork and the length of
+// iwork must be at least the values returned by a workspace query. If
+// lwork == -1, instead of performing Gelsd, only the optimal value of lwork
+// will be stored in work[0] and the minimum length of iwork in iwork[0].
+//
+// Gelsd returns the effective rank of A and whether the computation of the SVD
+// converged.
+func Gelsd(a, b blas64.General, s []float64, rcond float64, work []float64, lwork int, iwork []int) (rank int, ok bool) {
+	return lapack64.Dgelsd(a.Rows, a.Cols, b.Cols, a.Data, max(1, a.Stride), b.Data, max(1, b.Stride), s, rcond, work, lwork, iwork)
+}
+
+// Gelss computes the minimum-norm solution to a real linear least squares
+// problem
+//
+//	minimize || A*X - B ||_2
+//
+// using the singular value decomposition of A. A is an m×n matrix which may be
+// rank-deficient. On return, the first min(m,n) rows of A have been
+// overwritten by the right singular vectors of A.
+//
+// On entry, b contains the m×nrhs right hand side matrix B. On return, the
+// leading n×nrhs submatrix of b contains the solution matrix X. b must have at
+// least max(m,n) rows.
+//
+// The effective rank of A is determined by treating as zero those singular
+// values which are less than or equal to rcond times the largest singular
+// value. If rcond is negative, machine precision is used instead.
+//
+// s must have length min(m,n) and on return contains the singular values of A
+// in decreasing order.
+//
+// work must have length at least max(1,lwork), and lwork must be -1 or at
+// least 3*min(m,n) + max(2*min(m,n), max(m,n), nrhs). If lwork == -1, instead
+// of performing Gelss, only the optimal value of lwork will be stored in
+// work[0].
+//
+// Gelss returns the effective rank of A and whether the computation of the SVD
+// converged.
+func Gelss(a, b blas64.General, s []float64, rcond float64, work []float64, lwork int) (rank int, ok bool) {
+	return lapack64.Dgelss(a.Rows, a.Cols, b.Cols, a.Data, max(1, a.Stride), b.Data, max(1, b.Stride), s, rcond, work, lwork)
+}
+
+// Gelsy computes the minimum-norm solution to a real linear least squares
+// problem
+//
+//	minimize || A*X - B ||_2
+//
+// using a complete orthogonal factorization of A. A is an m×n matrix which may
+// be rank-deficient. On return, A has been overwritten by details of its
+// complete orthogonal factorization.
+//
+// On entry, b contains the m×nrhs right hand side matrix B. On return, the
+// leading n×nrhs submatrix of b contains the solution matrix X. b must have at
+// least max(m,n) rows.
+//
+// jpvt specifies a column pivot to be applied to A as described in Geqp3. On
+// return, jpvt holds the permutation that was applied.
+//
+// rcond is used to determine the effective rank of A, which is defined as the
+// order of the largest leading triangular submatrix R11 in the QR factorization
+// with pivoting of A, whose estimated condition number is less than 1/rcond.
+//
+// work must have length at least max(1,lwork), and lwork must be -1 or at
+// least min(m,n) + max(3*n+1, min(m,n)+nrhs). If lwork == -1, instead of
+// performing Gelsy, only the optimal value of lwork will be stored in work[0].
+//
+// Gelsy returns the effective rank of A.
+func Gelsy(a, b blas64.General, jpvt []int, rcond float64, work []float64, lwork int) (rank int) {
+	return lapack64.Dgelsy(a.Rows, a.Cols, b.Cols, a.Data, max(1, a.Stride), b.Data, max(1, b.Stride), jpvt, rcond, work, lwork)
+}
+
 // Geqp3 computes a QR factorization with column pivoting of the m×n matrix A:
 //
 //	A*P = Q*R
@@ -528,6 +620,51 @@ func Ggsvd3(jobU, jobV, jobQ lapack.GSVDJob, a, b blas64.General, alpha, beta []
 	return lapack64.Dggsvd3(jobU, jobV, jobQ, a.Rows, a.Cols, b.Rows, a.Data, max(1, a.Stride), b.Data, max(1, b.Stride), alpha, beta, u.Data, max(1, u.Stride), v.Data, max(1, v.Stride), q.Data, max(1, q.Stride), work, lwork, iwork)
 }
 
+// Ggglm solves a general Gauss-Markov linear model (GLM) problem
+//
+//	minimize || y ||_2   subject to   d = A*x + B*y
+//	   x
+//
+// where A is an n×m matrix, B is an n×p matrix, and d is a given n-vector. It
+// is assumed that m <= n <= m+p, rank(A) = m and rank([A B]) = n.
+//
+// On return, A and B are overwritten and d is destroyed. x and y contain the
+// solution of the GLM problem. d must have length n, x must have length m and y
+// must have length p.
+//
+// work must have length at least max(1,lwork), and lwork must be -1 or at
+// least max(1,n+m+p). If lwork == -1, instead of performing Ggglm, only the
+// optimal value of lwork will be stored in work[0].
+//
+// Ggglm returns whether the solution was found. It will be false if the rank
+// conditions above are not satisfied.
+func Ggglm(a, b blas64.General, d, x, y, work []float64, lwork int) (ok bool) {
+	return lapack64.Dggglm(a.Rows, a.Cols, b.Cols, a.Data, max(1, a.Stride), b.Data, max(1, b.Stride), d, x, y, work, lwork)
+}
+
+// Gglse solves the linear equality-constrained least squares (LSE) problem
+//
+//	minimize || c - A*x ||_2   subject to   B*x = d
+//
+// where A is an m×n matrix, B is a p×n matrix, c is an m-vector and d is a
+// p-vector. It is assumed that p <= n <= m+p, rank(B) = p and
+// rank([A; B]) = n.
+//
+// On return, A and B are overwritten, c contains in its elements n-p:m the
+// residual vector whose sum of squares gives the residual sum of squares of the
+// solution, d is destroyed, and x contains the solution. c must have length m,
+// d must have length p and x must have length n.
+//
+// work must have length at least max(1,lwork), and lwork must be -1 or at
+// least max(1,m+n+p). If lwork == -1, instead of performing Gglse, only the
+// optimal value of lwork will be stored in work[0].
+//
+// Gglse returns whether the solution was found. It will be false if the rank
+// conditions above are not satisfied.
+func Gglse(a, b blas64.General, c, d, x, work []float64, lwork int) (ok bool) {
+	return lapack64.Dgglse(a.Rows, a.Cols, b.Rows, a.Data, max(1, a.Stride), b.Data, max(1, b.Stride), c, d, x, work, lwork)
+}
+
 // Gtsv solves one of the equations
 //
 //	A * X = B   if trans == blas.NoTrans
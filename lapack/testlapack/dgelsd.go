@@ -0,0 +1,88 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+type Dgelsder interface {
+	Dgelsd(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, s []float64, rcond float64, work []float64, lwork int, iwork []int) (rank int, ok bool)
+}
+
+func DgelsdTest(t *testing.T, impl Dgelsder) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{0, 1, 2, 3, 5, 10, 31, 70, 130} {
+		for _, n := range []int{0, 1, 2, 3, 5, 10, 31, 70, 130} {
+			for _, nrhs := range []int{0, 1, 3, 10} {
+				for _, wl := range []worklen{minimumWork, optimumWork} {
+					for _, r := range []int{0, 1, min(m, n) / 2, min(m, n)} {
+						if r > min(m, n) {
+							continue
+						}
+						lda := max(1, n)
+						ldb := max(1, nrhs)
+						dgelsdTest(t, impl, rnd, m, n, nrhs, r, lda, ldb, wl)
+						dgelsdTest(t, impl, rnd, m, n, nrhs, r, lda+5, ldb+3, wl)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dgelsdTest(t *testing.T, impl Dgelsder, rnd *rand.Rand, m, n, nrhs, r, lda, ldb int, wl worklen) {
+	const tol = 1e-11
+
+	name := fmt.Sprintf("m=%v,n=%v,nrhs=%v,rank=%v,lda=%v,ldb=%v,work=%v", m, n, nrhs, r, lda, ldb, wl)
+
+	a, pinv := randomRankDeficient(m, n, r, lda, rnd)
+	b := randomGeneral(max(m, n), nrhs, ldb, rnd)
+	want := minNormSolution(pinv, b)
+
+	mn := min(m, n)
+	s := make([]float64, mn)
+
+	work := make([]float64, 1)
+	iwork := make([]int, 1)
+	impl.Dgelsd(m, n, nrhs, a.Data, a.Stride, b.Data, b.Stride, s, -1, work, -1, iwork)
+	lwork := int(work[0])
+	liwork := iwork[0]
+	if wl == minimumWork {
+		lwork = 1
+		if mn > 0 {
+			const smlsiz = 25
+			nlvl := max(0, int(math.Log2(float64(mn)/(smlsiz+1)))+1)
+			wlalsd := 9*mn + 2*mn*smlsiz + 8*mn*nlvl + mn*nrhs + (smlsiz+1)*(smlsiz+1)
+			lwork = 3*mn + max(max(m, n), nrhs, wlalsd)
+		}
+	}
+	work = make([]float64, lwork)
+	iwork = make([]int, liwork)
+
+	rank, ok := impl.Dgelsd(m, n, nrhs, a.Data, a.Stride, b.Data, b.Stride, s, 1e-10, work, lwork, iwork)
+	if !ok {
+		t.Errorf("%v: SVD did not converge", name)
+		return
+	}
+	if mn == 0 {
+		return
+	}
+	if rank != r {
+		t.Errorf("%v: unexpected rank; got %v, want %v", name, rank, r)
+		return
+	}
+	if !sort.IsSorted(sort.Reverse(sort.Float64Slice(s))) {
+		t.Errorf("%v: singular values not in decreasing order", name)
+	}
+	if resid := distLstSqSolution(n, nrhs, b, want); resid > tol {
+		t.Errorf("%v: unexpected solution; |X - pinv(A)*B|=%v, want<=%v", name, resid, tol)
+	}
+}
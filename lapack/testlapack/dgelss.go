@@ -0,0 +1,81 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+type Dgelsser interface {
+	Dgelss(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, s []float64, rcond float64, work []float64, lwork int) (rank int, ok bool)
+}
+
+func DgelssTest(t *testing.T, impl Dgelsser) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{0, 1, 2, 3, 5, 10, 31, 70} {
+		for _, n := range []int{0, 1, 2, 3, 5, 10, 31, 70} {
+			for _, nrhs := range []int{0, 1, 3, 10} {
+				for _, wl := range []worklen{minimumWork, optimumWork} {
+					for _, r := range []int{0, 1, min(m, n) / 2, min(m, n)} {
+						if r > min(m, n) {
+							continue
+						}
+						lda := max(1, n)
+						ldb := max(1, nrhs)
+						dgelssTest(t, impl, rnd, m, n, nrhs, r, lda, ldb, wl)
+						dgelssTest(t, impl, rnd, m, n, nrhs, r, lda+5, ldb+3, wl)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dgelssTest(t *testing.T, impl Dgelsser, rnd *rand.Rand, m, n, nrhs, r, lda, ldb int, wl worklen) {
+	const tol = 1e-11
+
+	name := fmt.Sprintf("m=%v,n=%v,nrhs=%v,rank=%v,lda=%v,ldb=%v,work=%v", m, n, nrhs, r, lda, ldb, wl)
+
+	a, pinv := randomRankDeficient(m, n, r, lda, rnd)
+	b := randomGeneral(max(m, n), nrhs, ldb, rnd)
+	want := minNormSolution(pinv, b)
+
+	mn := min(m, n)
+	s := make([]float64, mn)
+
+	work := make([]float64, 1)
+	impl.Dgelss(m, n, nrhs, a.Data, a.Stride, b.Data, b.Stride, s, -1, work, -1)
+	lwork := int(work[0])
+	if wl == minimumWork {
+		lwork = 1
+		if mn > 0 {
+			lwork = 3*mn + max(2*mn, max(m, n), nrhs)
+		}
+	}
+	work = make([]float64, lwork)
+
+	rank, ok := impl.Dgelss(m, n, nrhs, a.Data, a.Stride, b.Data, b.Stride, s, 1e-10, work, lwork)
+	if !ok {
+		t.Errorf("%v: SVD did not converge", name)
+		return
+	}
+	if mn == 0 {
+		return
+	}
+	if rank != r {
+		t.Errorf("%v: unexpected rank; got %v, want %v", name, rank, r)
+		return
+	}
+	if !sort.IsSorted(sort.Reverse(sort.Float64Slice(s))) {
+		t.Errorf("%v: singular values not in decreasing order", name)
+	}
+	if resid := distLstSqSolution(n, nrhs, b, want); resid > tol {
+		t.Errorf("%v: unexpected solution; |X - pinv(A)*B|=%v, want<=%v", name, resid, tol)
+	}
+}
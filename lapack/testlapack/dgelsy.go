@@ -0,0 +1,153 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dgelsyer interface {
+	Dgelsy(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, jpvt []int, rcond float64, work []float64, lwork int) int
+}
+
+func DgelsyTest(t *testing.T, impl Dgelsyer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{0, 1, 2, 3, 5, 10, 31, 70} {
+		for _, n := range []int{0, 1, 2, 3, 5, 10, 31, 70} {
+			for _, nrhs := range []int{0, 1, 3, 10} {
+				for _, wl := range []worklen{minimumWork, mediumWork, optimumWork} {
+					for _, r := range []int{0, 1, min(m, n) / 2, min(m, n)} {
+						if r > min(m, n) {
+							continue
+						}
+						lda := max(1, n)
+						ldb := max(1, nrhs)
+						dgelsyTest(t, impl, rnd, m, n, nrhs, r, lda, ldb, wl)
+						dgelsyTest(t, impl, rnd, m, n, nrhs, r, lda+5, ldb+3, wl)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dgelsyTest(t *testing.T, impl Dgelsyer, rnd *rand.Rand, m, n, nrhs, r, lda, ldb int, wl worklen) {
+	const tol = 1e-11
+
+	name := fmt.Sprintf("m=%v,n=%v,nrhs=%v,rank=%v,lda=%v,ldb=%v,work=%v", m, n, nrhs, r, lda, ldb, wl)
+
+	a, pinv := randomRankDeficient(m, n, r, lda, rnd)
+	b := randomGeneral(max(m, n), nrhs, ldb, rnd)
+	want := minNormSolution(pinv, b)
+
+	jpvt := make([]int, n)
+	for i := range jpvt {
+		jpvt[i] = -1
+	}
+
+	work := make([]float64, 1)
+	impl.Dgelsy(m, n, nrhs, a.Data, a.Stride, b.Data, b.Stride, jpvt, 1e-10, work, -1)
+	lwork := int(work[0])
+	switch wl {
+	case minimumWork:
+		mn := min(m, n)
+		if mn == 0 || nrhs == 0 {
+			lwork = 1
+		} else {
+			lwork = mn + max(3*n+1, mn+nrhs)
+		}
+	case mediumWork:
+		mn := min(m, n)
+		if mn == 0 || nrhs == 0 {
+			lwork = 1
+		} else {
+			lwork = (lwork + mn + max(3*n+1, mn+nrhs)) / 2
+		}
+	}
+	work = make([]float64, lwork)
+
+	rank := impl.Dgelsy(m, n, nrhs, a.Data, a.Stride, b.Data, b.Stride, jpvt, 1e-10, work, lwork)
+	if m == 0 || n == 0 || nrhs == 0 {
+		return
+	}
+	if rank != r {
+		t.Errorf("%v: unexpected rank; got %v, want %v", name, rank, r)
+		return
+	}
+	if resid := distLstSqSolution(n, nrhs, b, want); resid > tol {
+		t.Errorf("%v: unexpected solution; |X - pinv(A)*B|=%v, want<=%v", name, resid, tol)
+	}
+}
+
+// randomRankDeficient returns a random m×n matrix A of rank r and its
+// Moore-Penrose pseudo-inverse.
+func randomRankDeficient(m, n, r, lda int, rnd *rand.Rand) (a, pinv blas64.General) {
+	a = nanGeneral(m, n, lda)
+	pinv = zeros(n, m, max(1, m))
+	if m == 0 || n == 0 {
+		return a, pinv
+	}
+	u := randomOrthogonal(m, rnd)
+	v := randomOrthogonal(n, rnd)
+	sigma := make([]float64, r)
+	for i := range sigma {
+		sigma[i] = 1 + 9*rnd.Float64()
+	}
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			var sum float64
+			for k := 0; k < r; k++ {
+				sum += u.Data[i*u.Stride+k] * sigma[k] * v.Data[j*v.Stride+k]
+			}
+			a.Data[i*a.Stride+j] = sum
+		}
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < m; j++ {
+			var sum float64
+			for k := 0; k < r; k++ {
+				sum += v.Data[i*v.Stride+k] * u.Data[j*u.Stride+k] / sigma[k]
+			}
+			pinv.Data[i*pinv.Stride+j] = sum
+		}
+	}
+	return a, pinv
+}
+
+// minNormSolution returns the minimum-norm least squares solution pinv*B where
+// B is stored in the first pinv.Cols rows of b.
+func minNormSolution(pinv, b blas64.General) blas64.General {
+	x := zeros(pinv.Rows, b.Cols, max(1, b.Cols))
+	if pinv.Rows == 0 || pinv.Cols == 0 || b.Cols == 0 {
+		return x
+	}
+	bm := blas64.General{
+		Rows:   pinv.Cols,
+		Cols:   b.Cols,
+		Stride: b.Stride,
+		Data:   b.Data,
+	}
+	blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, pinv, bm, 0, x)
+	return x
+}
+
+// distLstSqSolution returns the max-column-sum norm of the difference between
+// the leading n×nrhs part of b and want.
+func distLstSqSolution(n, nrhs int, b, want blas64.General) float64 {
+	diff := zeros(n, nrhs, max(1, nrhs))
+	for i := 0; i < n; i++ {
+		for j := 0; j < nrhs; j++ {
+			diff.Data[i*diff.Stride+j] = b.Data[i*b.Stride+j] - want.Data[i*want.Stride+j]
+		}
+	}
+	return dlange(lapack.MaxColumnSum, n, nrhs, diff.Data, diff.Stride)
+}
@@ -0,0 +1,117 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+)
+
+type Dggglmer interface {
+	Dgesvder
+	Dggglm(n, m, p int, a []float64, lda int, b []float64, ldb int, d, x, y, work []float64, lwork int) bool
+}
+
+func DggglmTest(t *testing.T, impl Dggglmer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 5, 10, 40} {
+		for _, m := range []int{0, 1, 2, 3, 5, 10, 40} {
+			for _, p := range []int{0, 1, 2, 3, 5, 10, 40} {
+				if m > n || n > m+p {
+					continue
+				}
+				for _, wl := range []worklen{minimumWork, mediumWork, optimumWork} {
+					dggglmTest(t, impl, rnd, n, m, p, max(1, m), max(1, p), wl)
+					dggglmTest(t, impl, rnd, n, m, p, m+3, p+5, wl)
+				}
+			}
+		}
+	}
+}
+
+func dggglmTest(t *testing.T, impl Dggglmer, rnd *rand.Rand, n, m, p, lda, ldb int, wl worklen) {
+	const tol = 1e-12
+
+	name := fmt.Sprintf("n=%v,m=%v,p=%v,lda=%v,ldb=%v,work=%v", n, m, p, lda, ldb, wl)
+
+	a := randomGeneral(n, m, lda, rnd)
+	b := randomGeneral(n, p, ldb, rnd)
+	d := randomSlice(n, rnd)
+	x := nanSlice(m)
+	y := nanSlice(p)
+
+	aCopy := cloneGeneral(a)
+	bCopy := cloneGeneral(b)
+	dCopy := make([]float64, n)
+	copy(dCopy, d)
+
+	work := make([]float64, 1)
+	impl.Dggglm(n, m, p, a.Data, a.Stride, b.Data, b.Stride, d, x, y, work, -1)
+	lwork := int(work[0])
+	lwkmin := max(1, n+m+p)
+	if n == 0 {
+		lwkmin = 1
+	}
+	switch wl {
+	case minimumWork:
+		lwork = lwkmin
+	case mediumWork:
+		lwork = (lwork + lwkmin) / 2
+	}
+	work = make([]float64, lwork)
+
+	ok := impl.Dggglm(n, m, p, a.Data, a.Stride, b.Data, b.Stride, d, x, y, work, lwork)
+	if !ok {
+		t.Errorf("%v: unexpected failure", name)
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	// Check that d = A*x + B*y.
+	r := make([]float64, n)
+	copy(r, dCopy)
+	if m > 0 {
+		blas64.Gemv(blas.NoTrans, -1, aCopy, blas64.Vector{N: m, Data: x, Inc: 1}, 1, blas64.Vector{N: n, Data: r, Inc: 1})
+	}
+	if p > 0 {
+		blas64.Gemv(blas.NoTrans, -1, bCopy, blas64.Vector{N: p, Data: y, Inc: 1}, 1, blas64.Vector{N: n, Data: r, Inc: 1})
+	}
+	if resid := floats.Norm(r, math.Inf(1)); resid > tol {
+		t.Errorf("%v: constraint not satisfied; |d-A*x-B*y|=%v, want<=%v", name, resid, tol)
+	}
+
+	// The optimality condition is that y = Bᵀ*λ for some λ in the null
+	// space of Aᵀ. Let N be an orthonormal basis of the null space of Aᵀ,
+	// then y must be orthogonal to the null space of (Bᵀ*N)ᵀ.
+	nullAT := nullSpace(impl, m, n, transposeGeneral(aCopy))
+	k := n - m
+	ntb := zeros(k, p, max(1, p))
+	for i := 0; i < k; i++ {
+		for j := 0; j < p; j++ {
+			var sum float64
+			for l := 0; l < n; l++ {
+				sum += nullAT[i*n+l] * bCopy.Data[l*bCopy.Stride+j]
+			}
+			ntb.Data[i*ntb.Stride+j] = sum
+		}
+	}
+	nullNTB := nullSpace(impl, k, p, ntb)
+	proj := make([]float64, p-k)
+	for i := range proj {
+		proj[i] = floats.Dot(nullNTB[i*p:(i+1)*p], y)
+	}
+	if resid := floats.Norm(proj, math.Inf(1)); resid > tol {
+		t.Errorf("%v: optimality condition not satisfied; |Zᵀ*y|=%v, want<=%v", name, resid, tol)
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dgglseer interface {
+	Dgesvder
+	Dgglse(m, n, p int, a []float64, lda int, b []float64, ldb int, c, d, x, work []float64, lwork int) bool
+}
+
+func DgglseTest(t *testing.T, impl Dgglseer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{0, 1, 2, 3, 5, 10, 40} {
+		for _, n := range []int{0, 1, 2, 3, 5, 10, 40} {
+			for _, p := range []int{0, 1, 2, 3, 5, 10, 40} {
+				if p > n || n > m+p {
+					continue
+				}
+				for _, wl := range []worklen{minimumWork, mediumWork, optimumWork} {
+					dgglseTest(t, impl, rnd, m, n, p, max(1, n), max(1, n), wl)
+					dgglseTest(t, impl, rnd, m, n, p, n+3, n+5, wl)
+				}
+			}
+		}
+	}
+}
+
+func dgglseTest(t *testing.T, impl Dgglseer, rnd *rand.Rand, m, n, p, lda, ldb int, wl worklen) {
+	const tol = 1e-12
+
+	name := fmt.Sprintf("m=%v,n=%v,p=%v,lda=%v,ldb=%v,work=%v", m, n, p, lda, ldb, wl)
+
+	a := randomGeneral(m, n, lda, rnd)
+	b := randomGeneral(p, n, ldb, rnd)
+	c := randomSlice(m, rnd)
+	d := randomSlice(p, rnd)
+	x := nanSlice(n)
+
+	aCopy := cloneGeneral(a)
+	bCopy := cloneGeneral(b)
+	cCopy := make([]float64, m)
+	copy(cCopy, c)
+	dCopy := make([]float64, p)
+	copy(dCopy, d)
+
+	work := make([]float64, 1)
+	impl.Dgglse(m, n, p, a.Data, a.Stride, b.Data, b.Stride, c, d, x, work, -1)
+	lwork := int(work[0])
+	lwkmin := max(1, m+n+p)
+	if n == 0 {
+		lwkmin = 1
+	}
+	switch wl {
+	case minimumWork:
+		lwork = lwkmin
+	case mediumWork:
+		lwork = (lwork + lwkmin) / 2
+	}
+	work = make([]float64, lwork)
+
+	ok := impl.Dgglse(m, n, p, a.Data, a.Stride, b.Data, b.Stride, c, d, x, work, lwork)
+	if !ok {
+		t.Errorf("%v: unexpected failure", name)
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	// Check that B*x = d.
+	bx := make([]float64, p)
+	if p > 0 {
+		blas64.Gemv(blas.NoTrans, 1, bCopy, blas64.Vector{N: n, Data: x, Inc: 1}, 0, blas64.Vector{N: p, Data: bx, Inc: 1})
+	}
+	if resid := floats.Distance(bx, dCopy, math.Inf(1)); resid > tol {
+		t.Errorf("%v: constraint not satisfied; |B*x-d|=%v, want<=%v", name, resid, tol)
+	}
+
+	// Compute the residual r = c - A*x.
+	r := make([]float64, m)
+	copy(r, cCopy)
+	if m > 0 {
+		blas64.Gemv(blas.NoTrans, -1, aCopy, blas64.Vector{N: n, Data: x, Inc: 1}, 1, blas64.Vector{N: m, Data: r, Inc: 1})
+	}
+
+	// Check that the residual sum of squares is returned in c[n-p:m].
+	if n-p < m {
+		got := floats.Dot(c[n-p:], c[n-p:])
+		want := floats.Dot(r, r)
+		if math.Abs(got-want) > tol*math.Max(1, want) {
+			t.Errorf("%v: unexpected residual sum of squares; got %v, want %v", name, got, want)
+		}
+	}
+
+	// The optimality condition is that Aᵀ*r lies in the range of Bᵀ,
+	// that is, Aᵀ*r is orthogonal to the null space of B.
+	atr := make([]float64, n)
+	if m > 0 {
+		blas64.Gemv(blas.Trans, 1, aCopy, blas64.Vector{N: m, Data: r, Inc: 1}, 0, blas64.Vector{N: n, Data: atr, Inc: 1})
+	}
+	nullB := nullSpace(impl, p, n, bCopy)
+	proj := make([]float64, n-p)
+	for i := range proj {
+		proj[i] = floats.Dot(nullB[i*n:(i+1)*n], atr)
+	}
+	if resid := floats.Norm(proj, math.Inf(1)); resid > tol {
+		t.Errorf("%v: optimality condition not satisfied; |Nᵀ*Aᵀ*r|=%v, want<=%v", name, resid, tol)
+	}
+}
+
+// nullSpace returns the rows n-r:n of Vᵀ, where A = U*Σ*Vᵀ is the SVD of the
+// full-rank r×n matrix A, r <= n. These rows form an orthonormal basis of the
+// null space of A and are stored in a slice with stride n.
+func nullSpace(impl Dgesvder, r, n int, a blas64.General) []float64 {
+	if n == 0 {
+		return nil
+	}
+	if r == 0 {
+		return eye(n, n).Data
+	}
+	aCopy := zeros(r, n, n)
+	copyGeneral(aCopy, a)
+	s := make([]float64, r)
+	vt := make([]float64, n*n)
+	work := make([]float64, 1)
+	impl.Dgesvd(lapack.SVDNone, lapack.SVDAll, r, n, aCopy.Data, aCopy.Stride, s, nil, 1, vt, n, work, -1)
+	work = make([]float64, int(work[0]))
+	ok := impl.Dgesvd(lapack.SVDNone, lapack.SVDAll, r, n, aCopy.Data, aCopy.Stride, s, nil, 1, vt, n, work, len(work))
+	if !ok {
+		panic("testlapack: SVD did not converge")
+	}
+	return vt[r*n:]
+}
@@ -0,0 +1,124 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dlalsder interface {
+	Dlalsd(uplo blas.Uplo, smlsiz, n, nrhs int, d, e, b []float64, ldb int, rcond float64, work []float64, iwork []int) (rank int, ok bool)
+	Dbdsqrer
+}
+
+func DlalsdTest(t *testing.T, impl Dlalsder) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 4, 10, 25, 26, 27, 50, 64, 130} {
+			for _, nrhs := range []int{1, 2, 5} {
+				for _, split := range []string{"none", "random", "last"} {
+					for _, smlsiz := range []int{3, 25} {
+						ldb := nrhs
+						dlalsdTest(t, impl, rnd, uplo, smlsiz, n, nrhs, ldb, split)
+						dlalsdTest(t, impl, rnd, uplo, smlsiz, n, nrhs, ldb+4, split)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dlalsdTest(t *testing.T, impl Dlalsder, rnd *rand.Rand, uplo blas.Uplo, smlsiz, n, nrhs, ldb int, split string) {
+	const tol = 1e-13
+
+	name := fmt.Sprintf("uplo=%v,smlsiz=%v,n=%v,nrhs=%v,ldb=%v,split=%v", string(uplo), smlsiz, n, nrhs, ldb, split)
+
+	// Generate a random bidiagonal matrix with full rank, optionally with
+	// some zero off-diagonal elements so that it splits into independent
+	// subproblems.
+	d := make([]float64, n)
+	for i := range d {
+		d[i] = 1 + rnd.Float64()
+		if rnd.Intn(2) == 0 {
+			d[i] *= -1
+		}
+	}
+	e := make([]float64, max(0, n-1))
+	for i := range e {
+		e[i] = rnd.NormFloat64()
+		if split == "random" && rnd.Intn(8) == 0 {
+			e[i] = 0
+		}
+	}
+	if split == "last" && n > 1 {
+		e[n-2] = 0
+	}
+	dCopy := make([]float64, len(d))
+	copy(dCopy, d)
+	eCopy := make([]float64, len(e))
+	copy(eCopy, e)
+
+	b := randomGeneral(n, nrhs, ldb, rnd)
+	bCopy := cloneGeneral(b)
+
+	nlvl := max(0, int(math.Log2(float64(n)/float64(smlsiz+1)))+1)
+	work := make([]float64, 9*n+2*n*smlsiz+8*n*nlvl+n*nrhs+(smlsiz+1)*(smlsiz+1))
+	iwork := make([]int, 3*n*nlvl+11*n)
+
+	rank, ok := impl.Dlalsd(uplo, smlsiz, n, nrhs, d, e, b.Data, b.Stride, -1, work, iwork)
+	if !ok {
+		t.Errorf("%v: unexpected failure", name)
+		return
+	}
+	if n == 0 {
+		return
+	}
+	if rank != n {
+		t.Errorf("%v: unexpected rank; got %v, want %v", name, rank, n)
+	}
+
+	// Check that the singular values are correct.
+	if !sort.IsSorted(sort.Reverse(sort.Float64Slice(d))) {
+		t.Errorf("%v: singular values not in decreasing order", name)
+	}
+	want := make([]float64, n)
+	copy(want, dCopy)
+	eWant := make([]float64, len(eCopy))
+	copy(eWant, eCopy)
+	impl.Dbdsqr(uplo, n, 0, 0, 0, want, eWant, nil, 1, nil, 1, nil, 1, make([]float64, 4*n))
+	if !floats.EqualApprox(d, want, tol*want[0]) {
+		t.Errorf("%v: unexpected singular values", name)
+	}
+
+	// Check that X solves the linear system B*X = R by computing the
+	// residual R - B*X.
+	resid := zeros(n, nrhs, nrhs)
+	for i := 0; i < n; i++ {
+		for j := 0; j < nrhs; j++ {
+			v := bCopy.Data[i*bCopy.Stride+j] - dCopy[i]*b.Data[i*b.Stride+j]
+			if uplo == blas.Upper && i < n-1 {
+				v -= eCopy[i] * b.Data[(i+1)*b.Stride+j]
+			}
+			if uplo == blas.Lower && i > 0 {
+				v -= eCopy[i-1] * b.Data[(i-1)*b.Stride+j]
+			}
+			resid.Data[i*resid.Stride+j] = v
+		}
+	}
+	rnorm := dlange(lapack.MaxColumnSum, n, nrhs, resid.Data, resid.Stride)
+	xnorm := dlange(lapack.MaxColumnSum, n, nrhs, b.Data, b.Stride)
+	if rnorm > tol*float64(n)*want[0]*xnorm {
+		t.Errorf("%v: unexpected residual; |R - B*X|=%v, |X|=%v", name, rnorm, xnorm)
+	}
+}
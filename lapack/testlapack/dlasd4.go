@@ -0,0 +1,107 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+type Dlasd4er interface {
+	Dlasd4(n, i int, d, z, delta []float64, rho float64, work []float64) (sigma float64, ok bool)
+}
+
+func Dlasd4Test(t *testing.T, impl Dlasd4er) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 4, 5, 10, 20, 50, 100} {
+		for _, rho := range []float64{1e-6, 1, 1e6} {
+			for cas := 0; cas < 10; cas++ {
+				dlasd4Test(t, impl, rnd, n, rho, cas%2 == 0)
+			}
+		}
+	}
+}
+
+func dlasd4Test(t *testing.T, impl Dlasd4er, rnd *rand.Rand, n int, rho float64, zeroPole bool) {
+	const tol = 1e-13
+
+	name := fmt.Sprintf("n=%v,rho=%v,zeroPole=%v", n, rho, zeroPole)
+
+	// Generate increasing non-negative poles and a unit vector z.
+	d := make([]float64, n)
+	for i := range d {
+		d[i] = rnd.Float64()
+	}
+	sort.Float64s(d)
+	if zeroPole {
+		d[0] = 0
+	}
+	z := make([]float64, n)
+	var znorm float64
+	for i := range z {
+		z[i] = rnd.NormFloat64()
+		znorm = math.Hypot(znorm, z[i])
+	}
+	for i := range z {
+		z[i] /= znorm
+	}
+
+	// The sum of the squared updated singular values is the trace of
+	// diag(d)² + rho*z*zᵀ.
+	trace := rho
+	for _, v := range d {
+		trace += v * v
+	}
+
+	delta := make([]float64, n)
+	work := make([]float64, n)
+	var sum float64
+	prev := -1.0
+	for i := 0; i < n; i++ {
+		sigma, ok := impl.Dlasd4(n, i, d, z, delta, rho, work)
+		if !ok {
+			t.Errorf("%v,i=%v: no convergence", name, i)
+			continue
+		}
+		sum += sigma * sigma
+
+		// Check that the roots are interlaced with the poles.
+		if sigma < d[i] || (i < n-1 && sigma > d[i+1]) || sigma <= prev {
+			t.Errorf("%v,i=%v: root %v not in the expected interval", name, i, sigma)
+		}
+		prev = sigma
+
+		// Check that delta and work contain the differences and sums of
+		// the poles and the root.
+		for j := 0; j < n; j++ {
+			if math.Abs(delta[j]-(d[j]-sigma)) > tol*math.Max(1, math.Sqrt(trace)) {
+				t.Errorf("%v,i=%v: unexpected delta[%v]; got %v, want %v", name, i, j, delta[j], d[j]-sigma)
+			}
+			if math.Abs(work[j]-(d[j]+sigma)) > tol*math.Max(1, math.Sqrt(trace)) {
+				t.Errorf("%v,i=%v: unexpected work[%v]; got %v, want %v", name, i, j, work[j], d[j]+sigma)
+			}
+		}
+
+		// Check that sigma is a root of the secular equation relative to
+		// the magnitude of its terms.
+		f := 1.0
+		var scale float64
+		for j := 0; j < n; j++ {
+			term := rho * z[j] * z[j] / (delta[j] * work[j])
+			f += term
+			scale += math.Abs(term)
+		}
+		if math.Abs(f) > 1e-10*(1+scale) {
+			t.Errorf("%v,i=%v: secular function not zero; got %v", name, i, f)
+		}
+	}
+	if math.Abs(sum-trace) > tol*float64(n)*trace {
+		t.Errorf("%v: sum of squared roots %v does not equal trace %v", name, sum, trace)
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+type Dlasdaer interface {
+	Dlasda(icompq, smlsiz, n, sqre int, d, e, u []float64, ldu int, vt []float64, ldvt int, k []int, difl, difr, z, poles []float64, givptr, givcol []int, ldgcol int, perm []int, givnum, c, s, work []float64, iwork []int) (ok bool)
+	Dbdsqrer
+}
+
+func DlasdaTest(t *testing.T, impl Dlasdaer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, icompq := range []int{0, 1} {
+		for _, n := range []int{0, 1, 2, 3, 4, 10, 25, 26, 27, 50, 64, 130} {
+			for _, sqre := range []int{0, 1} {
+				for _, smlsiz := range []int{3, 25} {
+					dlasdaTest(t, impl, rnd, icompq, smlsiz, n, sqre)
+				}
+			}
+		}
+	}
+}
+
+func dlasdaTest(t *testing.T, impl Dlasdaer, rnd *rand.Rand, icompq, smlsiz, n, sqre int) {
+	const tol = 1e-13
+
+	name := fmt.Sprintf("icompq=%v,smlsiz=%v,n=%v,sqre=%v", icompq, smlsiz, n, sqre)
+
+	m := n + sqre
+	d := make([]float64, n)
+	for i := range d {
+		d[i] = rnd.NormFloat64()
+	}
+	e := make([]float64, max(0, m-1))
+	for i := range e {
+		e[i] = rnd.NormFloat64()
+	}
+
+	// The singular values of the n×m upper bidiagonal matrix are those of the
+	// m×m upper bidiagonal matrix obtained by appending a zero row, with the
+	// exception of an additional zero singular value if sqre == 1.
+	want := make([]float64, m)
+	copy(want, d)
+	eWant := make([]float64, len(e))
+	copy(eWant, e)
+	impl.Dbdsqr(blas.Upper, m, 0, 0, 0, want, eWant, nil, 1, nil, 1, nil, 1, make([]float64, 4*m))
+	want = want[:n]
+
+	nlvl := max(1, int(math.Log2(float64(max(1, n))/float64(smlsiz+1)))+1)
+	ldgcol := max(1, m)
+	ldu := smlsiz
+	ldvt := smlsiz + 1
+	var (
+		u, vt                        []float64
+		k, givptr, givcol, perm      []int
+		difl, difr, z, poles, givnum []float64
+		c, s                         []float64
+	)
+	if icompq == 0 {
+		difl = make([]float64, n)
+		difr = make([]float64, n)
+		z = make([]float64, m)
+	} else {
+		u = make([]float64, n*ldu)
+		vt = make([]float64, m*ldvt)
+		k = make([]int, n)
+		givptr = make([]int, n)
+		givcol = make([]int, 2*nlvl*ldgcol)
+		perm = make([]int, nlvl*ldgcol)
+		difl = make([]float64, nlvl*ldgcol)
+		difr = make([]float64, 2*nlvl*ldgcol)
+		z = make([]float64, nlvl*ldgcol)
+		poles = make([]float64, 2*nlvl*ldgcol)
+		givnum = make([]float64, 2*nlvl*ldgcol)
+		c = make([]float64, n)
+		s = make([]float64, n)
+	}
+	work := make([]float64, 6*m+(smlsiz+1)*(smlsiz+1))
+	iwork := make([]int, 7*n)
+
+	ok := impl.Dlasda(icompq, smlsiz, n, sqre, d, e, u, ldu, vt, ldvt, k, difl, difr, z, poles, givptr, givcol, ldgcol, perm, givnum, c, s, work, iwork)
+	if !ok {
+		t.Errorf("%v: unexpected failure", name)
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	sort.Sort(sort.Reverse(sort.Float64Slice(d)))
+	if !floats.EqualApprox(d, want, tol*want[0]) {
+		t.Errorf("%v: unexpected singular values", name)
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dlatrzer interface {
+	Dlatrz(m, n, l int, a []float64, lda int, tau, work []float64)
+	Dormr3(side blas.Side, trans blas.Transpose, m, n, k, l int, a []float64, lda int, tau, c []float64, ldc int, work []float64)
+}
+
+func DlatrzTest(t *testing.T, impl Dlatrzer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{0, 1, 2, 3, 5, 10, 25} {
+		for _, n := range []int{0, 1, 2, 3, 5, 10, 25} {
+			if n < m {
+				continue
+			}
+			for _, lda := range []int{max(1, n), n + 4} {
+				dlatrzTest(t, impl, rnd, m, n, lda)
+			}
+		}
+	}
+}
+
+func dlatrzTest(t *testing.T, impl Dlatrzer, rnd *rand.Rand, m, n, lda int) {
+	const tol = 1e-14
+
+	name := fmt.Sprintf("m=%v,n=%v,lda=%v", m, n, lda)
+
+	// Generate a random upper trapezoidal matrix A.
+	a := randomGeneral(m, n, lda, rnd)
+	for i := 0; i < m; i++ {
+		for j := 0; j < i; j++ {
+			a.Data[i*a.Stride+j] = 0
+		}
+	}
+	aCopy := cloneGeneral(a)
+
+	l := n - m
+	tau := nanSlice(m)
+	work := nanSlice(m)
+	impl.Dlatrz(m, n, l, a.Data, a.Stride, tau, work)
+	if m == 0 {
+		return
+	}
+
+	// Extract [ R 0 ].
+	r := zeros(m, n, n)
+	for i := 0; i < m; i++ {
+		for j := i; j < m; j++ {
+			r.Data[i*r.Stride+j] = a.Data[i*a.Stride+j]
+		}
+	}
+
+	// Compute [ R 0 ] * Z.
+	work = nanSlice(m)
+	impl.Dormr3(blas.Right, blas.NoTrans, m, n, m, l, a.Data, a.Stride, tau, r.Data, r.Stride, work)
+
+	// Check that Z is orthogonal by forming it explicitly.
+	z := eye(n, n)
+	work = nanSlice(n)
+	impl.Dormr3(blas.Left, blas.NoTrans, n, n, m, l, a.Data, a.Stride, tau, z.Data, z.Stride, work)
+	if resid := residualOrthogonal(z, false); resid > tol*float64(n) {
+		t.Errorf("%v: Z is not orthogonal; resid=%v, want<=%v", name, resid, tol*float64(n))
+	}
+
+	// Check that [ R 0 ] * Z = A.
+	diff := zeros(m, n, n)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			diff.Data[i*diff.Stride+j] = r.Data[i*r.Stride+j] - aCopy.Data[i*aCopy.Stride+j]
+		}
+	}
+	if resid := dlange(lapack.MaxColumnSum, m, n, diff.Data, diff.Stride); resid > tol*float64(n) {
+		t.Errorf("%v: |[R 0]*Z - A|=%v, want<=%v", name, resid, tol*float64(n))
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+type Dormrqer interface {
+	Dormr2er
+	Dormrq(side blas.Side, trans blas.Transpose, m, n, k int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int)
+}
+
+func DormrqTest(t *testing.T, impl Dormrqer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, side := range []blas.Side{blas.Left, blas.Right} {
+		for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, wl := range []worklen{minimumWork, mediumWork, optimumWork} {
+				for _, test := range []struct {
+					common, adim, cdim, lda, ldc int
+				}{
+					{0, 0, 0, 0, 0},
+					{6, 7, 8, 0, 0},
+					{6, 8, 7, 0, 0},
+					{7, 6, 8, 0, 0},
+					{7, 8, 6, 0, 0},
+					{8, 6, 7, 0, 0},
+					{8, 7, 6, 0, 0},
+					{100, 200, 300, 0, 0},
+					{100, 300, 200, 0, 0},
+					{200, 100, 300, 0, 0},
+					{200, 300, 100, 0, 0},
+					{300, 100, 200, 0, 0},
+					{300, 200, 100, 0, 0},
+					{100, 200, 300, 400, 500},
+					{200, 100, 300, 400, 500},
+					{300, 200, 100, 500, 400},
+				} {
+					ma := test.adim
+					na := test.common
+					var mc, nc int
+					if side == blas.Left {
+						mc = test.common
+						nc = test.cdim
+					} else {
+						mc = test.cdim
+						nc = test.common
+					}
+					name := fmt.Sprintf("side=%v,trans=%v,work=%v,ma=%v,na=%v,mc=%v,nc=%v",
+						sideToString(side), transToString(trans), wl, ma, na, mc, nc)
+
+					lda := test.lda
+					if lda == 0 {
+						lda = max(1, na)
+					}
+					a := make([]float64, ma*lda)
+					for i := range a {
+						a[i] = rnd.NormFloat64()
+					}
+					ldc := test.ldc
+					if ldc == 0 {
+						ldc = max(1, nc)
+					}
+					c := make([]float64, mc*ldc)
+					for i := range c {
+						c[i] = rnd.NormFloat64()
+					}
+
+					// Compute the RQ factorization of A.
+					k := min(ma, na)
+					tau := make([]float64, k)
+					work := make([]float64, 1)
+					impl.Dgerqf(ma, na, a, lda, tau, work, -1)
+					work = make([]float64, int(work[0]))
+					impl.Dgerqf(ma, na, a, lda, tau, work, len(work))
+
+					// The reflectors are stored in the last k rows of A.
+					off := max(0, ma-k) * lda
+
+					// Compute the reference result with the unblocked Dormr2.
+					ans := make([]float64, len(c))
+					copy(ans, c)
+					nw := nc
+					if side == blas.Right {
+						nw = mc
+					}
+					work = make([]float64, max(1, nw))
+					impl.Dormr2(side, trans, mc, nc, k, a[off:], lda, tau, ans, ldc, work)
+
+					var lwork int
+					switch wl {
+					case minimumWork:
+						lwork = nw
+					case optimumWork:
+						impl.Dormrq(side, trans, mc, nc, k, a[off:], lda, tau, c, ldc, work, -1)
+						lwork = int(work[0])
+					case mediumWork:
+						work := make([]float64, 1)
+						impl.Dormrq(side, trans, mc, nc, k, a[off:], lda, tau, c, ldc, work, -1)
+						lwork = (int(work[0]) + nw) / 2
+					}
+					lwork = max(1, lwork)
+					work = make([]float64, lwork)
+
+					impl.Dormrq(side, trans, mc, nc, k, a[off:], lda, tau, c, ldc, work, lwork)
+					if !floats.EqualApprox(c, ans, 1e-12) {
+						t.Errorf("%v: Dormrq and Dormr2 results mismatch", name)
+					}
+				}
+			}
+		}
+	}
+}
@@ -137,6 +137,7 @@ var (
 	ErrSliceLengthMismatch = Error{"mat: input slice length mismatch"}
 	ErrNotPSD              = Error{"mat: input not positive symmetric definite"}
 	ErrFailedEigen         = Error{"mat: eigendecomposition not successful"}
+	ErrFailedSVD           = Error{"mat: singular value decomposition not successful"}
 )
 
 // ErrorStack represents matrix handling errors that have been recovered by Maybe wrappers.
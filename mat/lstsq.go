@@ -0,0 +1,279 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+// LstSqResult holds ancillary information about the solution of a linear
+// least squares problem.
+type LstSqResult struct {
+	// Rank is the effective rank of A.
+	Rank int
+
+	// Residuals holds the squared 2-norm of the residual b_j - A*x_j
+	// for each column j of B.
+	Residuals []float64
+
+	// SingularValues holds the singular values of A in decreasing
+	// order. SingularValues is nil if the solution was not computed
+	// using the singular value decomposition of A.
+	SingularValues []float64
+}
+
+// LstSq computes the minimum-norm solution to the linear least squares problem
+//
+//	minimize over x |b - A*x|_2 and |x|_2
+//
+// where A is an m×n matrix, b is a given m element vector and x is n element
+// solution vector, using the singular value decomposition of A. Unlike Solve,
+// LstSq does not require A to have full rank. Singular values of A less than
+// or equal to rcond times the largest singular value are treated as zero when
+// determining the effective rank of A.
+//
+// Several right-hand side vectors b and solution vectors x can be handled in a
+// single call. Vectors b are stored in the columns of the m×k matrix B. Vectors
+// x will be stored into the columns of dst, which must be either empty or have
+// size n×k.
+//
+// LstSq returns the effective rank of A, the residual sum of squares for each
+// column of B and the singular values of A. If the singular value
+// decomposition fails to converge, LstSq returns ErrFailedSVD and dst is not
+// modified.
+// LstSq will panic if rcond is negative.
+func LstSq(dst *Dense, a, b Matrix, rcond float64) (LstSqResult, error) {
+	if rcond < 0 {
+		panic(badRcond)
+	}
+	r, c := a.Dims()
+	br, bc := b.Dims()
+	if r != br {
+		panic(ErrShape)
+	}
+
+	aCopy := DenseCopyOf(a)
+	x := lstSqRHS(b, r, c)
+	s := make([]float64, min(r, c))
+
+	work := []float64{0}
+	lapack64.Gelss(aCopy.mat, x.mat, s, rcond, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	rank, ok := lapack64.Gelss(aCopy.mat, x.mat, s, rcond, work, len(work))
+	putFloat64s(work)
+	if !ok {
+		return LstSqResult{}, ErrFailedSVD
+	}
+
+	dst.reuseAsNonZeroed(c, bc)
+	dst.Copy(x.Slice(0, c, 0, bc))
+	return LstSqResult{
+		Rank:           rank,
+		Residuals:      lstSqResiduals(a, b, dst),
+		SingularValues: s,
+	}, nil
+}
+
+// LstSqQRP computes the minimum-norm solution to the linear least squares
+// problem
+//
+//	minimize over x |b - A*x|_2 and |x|_2
+//
+// where A is an m×n matrix, b is a given m element vector and x is n element
+// solution vector, using a complete orthogonal factorization of A computed from
+// its QR factorization with column pivoting. A may be rank-deficient. The
+// effective rank of A is the order of the largest leading triangular submatrix
+// of the pivoted R factor whose estimated condition number is less than
+// 1/rcond.
+//
+// Several right-hand side vectors b and solution vectors x can be handled in a
+// single call. Vectors b are stored in the columns of the m×k matrix B. Vectors
+// x will be stored into the columns of dst, which must be either empty or have
+// size n×k.
+//
+// LstSqQRP is usually faster than LstSq. It returns the effective rank of A and
+// the residual sum of squares for each column of B. The SingularValues field
+// of the returned result is nil.
+// LstSqQRP will panic if rcond is negative.
+func LstSqQRP(dst *Dense, a, b Matrix, rcond float64) LstSqResult {
+	if rcond < 0 {
+		panic(badRcond)
+	}
+	r, c := a.Dims()
+	br, bc := b.Dims()
+	if r != br {
+		panic(ErrShape)
+	}
+	dst.reuseAsNonZeroed(c, bc)
+
+	aCopy := DenseCopyOf(a)
+	x := lstSqRHS(b, r, c)
+	jpvt := make([]int, c)
+	for i := range jpvt {
+		jpvt[i] = -1
+	}
+
+	work := []float64{0}
+	lapack64.Gelsy(aCopy.mat, x.mat, jpvt, rcond, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	rank := lapack64.Gelsy(aCopy.mat, x.mat, jpvt, rcond, work, len(work))
+	putFloat64s(work)
+
+	dst.Copy(x.Slice(0, c, 0, bc))
+	return LstSqResult{
+		Rank:      rank,
+		Residuals: lstSqResiduals(a, b, dst),
+	}
+}
+
+// LstSqEq solves the linear equality-constrained least squares problem
+//
+//	minimize over x |c - A*x|_2 subject to B*x = d
+//
+// where A is an m×n matrix, B is a p×n matrix, c is an m element vector and d
+// is a p element vector. It is assumed that
+//
+//	p <= n <= m+p,
+//
+// and
+//
+//	rank(B) = p,  rank([A; B]) = n,
+//
+// which ensure that the problem has a unique solution. The solution is stored
+// into dst, which must be either empty or have length n.
+//
+// LstSqEq returns the residual sum of squares |c - A*x|_2². If the rank
+// conditions are not satisfied, LstSqEq returns ErrSingular and dst is not
+// modified.
+// LstSqEq will panic if the dimensions of the inputs are not consistent.
+func LstSqEq(dst *VecDense, a Matrix, c Vector, b Matrix, d Vector) (rss float64, err error) {
+	m, n := a.Dims()
+	p, nb := b.Dims()
+	switch {
+	case nb != n:
+		panic(ErrShape)
+	case c.Len() != m:
+		panic(ErrShape)
+	case d.Len() != p:
+		panic(ErrShape)
+	case p > n || n > m+p:
+		panic(ErrShape)
+	}
+
+	aCopy := DenseCopyOf(a)
+	bCopy := DenseCopyOf(b)
+	cCopy := vecCopyOf(c)
+	dCopy := vecCopyOf(d)
+	x := make([]float64, n)
+
+	work := []float64{0}
+	lapack64.Gglse(aCopy.mat, bCopy.mat, cCopy, dCopy, x, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	ok := lapack64.Gglse(aCopy.mat, bCopy.mat, cCopy, dCopy, x, work, len(work))
+	putFloat64s(work)
+	if !ok {
+		return 0, ErrSingular
+	}
+
+	dst.reuseAsNonZeroed(n)
+	dst.CopyVec(NewVecDense(n, x))
+	if n-p < m {
+		r := cCopy[n-p:]
+		rss = blas64.Dot(blas64.Vector{N: len(r), Data: r, Inc: 1}, blas64.Vector{N: len(r), Data: r, Inc: 1})
+	}
+	return rss, nil
+}
+
+// GLM solves the general Gauss-Markov linear model problem
+//
+//	minimize over x and y |y|_2 subject to d = A*x + B*y
+//
+// where A is an n×m matrix, B is an n×p matrix and d is an n element vector.
+// It is assumed that
+//
+//	m <= n <= m+p,
+//
+// and
+//
+//	rank(A) = m,  rank([A B]) = n,
+//
+// which ensure that there is a unique solution x and a minimal 2-norm solution
+// y. If B is square and nonsingular, the problem is equivalent to the weighted
+// linear least squares problem
+//
+//	minimize over x |B⁻¹*(d - A*x)|_2.
+//
+// The solutions are stored into x and y, which must be either empty or have
+// length m and p respectively.
+//
+// If the rank conditions are not satisfied, GLM returns ErrSingular and x and
+// y are not modified.
+// GLM will panic if the dimensions of the inputs are not consistent.
+func GLM(x, y *VecDense, a, b Matrix, d Vector) error {
+	n, m := a.Dims()
+	nb, p := b.Dims()
+	switch {
+	case nb != n:
+		panic(ErrShape)
+	case d.Len() != n:
+		panic(ErrShape)
+	case m > n || n > m+p:
+		panic(ErrShape)
+	}
+
+	aCopy := DenseCopyOf(a)
+	bCopy := DenseCopyOf(b)
+	dCopy := vecCopyOf(d)
+	xs := make([]float64, m)
+	ys := make([]float64, p)
+
+	work := []float64{0}
+	lapack64.Ggglm(aCopy.mat, bCopy.mat, dCopy, xs, ys, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	ok := lapack64.Ggglm(aCopy.mat, bCopy.mat, dCopy, xs, ys, work, len(work))
+	putFloat64s(work)
+	if !ok {
+		return ErrSingular
+	}
+
+	x.reuseAsNonZeroed(m)
+	y.reuseAsNonZeroed(p)
+	x.CopyVec(NewVecDense(m, xs))
+	y.CopyVec(NewVecDense(p, ys))
+	return nil
+}
+
+// lstSqRHS returns a max(m,n)×k Dense holding B in its first m rows.
+func lstSqRHS(b Matrix, m, n int) *Dense {
+	_, k := b.Dims()
+	x := NewDense(max(m, n), k, nil)
+	x.Slice(0, m, 0, k).(*Dense).Copy(b)
+	return x
+}
+
+// lstSqResiduals returns the squared 2-norms of the columns of B - A*X.
+func lstSqResiduals(a, b Matrix, x *Dense) []float64 {
+	var r Dense
+	r.Mul(a, x)
+	r.Sub(b, &r)
+	rr, rc := r.Dims()
+	res := make([]float64, rc)
+	for i := 0; i < rr; i++ {
+		for j, v := range r.RawRowView(i) {
+			res[j] += v * v
+		}
+	}
+	return res
+}
+
+// vecCopyOf returns a newly allocated slice holding the elements of v.
+func vecCopyOf(v Vector) []float64 {
+	s := make([]float64, v.Len())
+	for i := range s {
+		s[i] = v.AtVec(i)
+	}
+	return s
+}
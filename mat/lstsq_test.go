@@ -0,0 +1,235 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestLstSq(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n, rank, k int
+	}{
+		{1, 1, 1, 1},
+		{5, 3, 3, 2},
+		{3, 5, 3, 2},
+		{10, 6, 4, 3},
+		{6, 10, 4, 3},
+		{8, 8, 5, 1},
+		{20, 15, 15, 4},
+	} {
+		// Construct a rank-deficient matrix as the product of
+		// an m×rank and a rank×n random matrix.
+		l := NewDense(test.m, test.rank, nil)
+		r := NewDense(test.rank, test.n, nil)
+		for _, d := range []*Dense{l, r} {
+			rows, cols := d.Dims()
+			for i := 0; i < rows; i++ {
+				for j := 0; j < cols; j++ {
+					d.Set(i, j, rnd.NormFloat64())
+				}
+			}
+		}
+		var a Dense
+		a.Mul(l, r)
+		b := NewDense(test.m, test.k, nil)
+		for i := 0; i < test.m; i++ {
+			for j := 0; j < test.k; j++ {
+				b.Set(i, j, rnd.NormFloat64())
+			}
+		}
+
+		// Compute the reference minimum-norm solution.
+		var svd SVD
+		if !svd.Factorize(&a, SVDFull) {
+			t.Fatal("unexpected SVD failure")
+		}
+		var want Dense
+		svd.SolveTo(&want, b, test.rank)
+
+		var got Dense
+		res, err := LstSq(&got, &a, b, 1e-10)
+		if err != nil {
+			t.Errorf("m=%d,n=%d: unexpected error: %v", test.m, test.n, err)
+			continue
+		}
+		if res.Rank != test.rank {
+			t.Errorf("m=%d,n=%d: unexpected rank from LstSq: got %d, want %d", test.m, test.n, res.Rank, test.rank)
+		}
+		if !EqualApprox(&got, &want, tol) {
+			t.Errorf("m=%d,n=%d: unexpected LstSq solution:\ngot:\n%v\nwant:\n%v", test.m, test.n, Formatted(&got), Formatted(&want))
+		}
+		if !floats.EqualApprox(res.SingularValues, svd.Values(nil), tol) {
+			t.Errorf("m=%d,n=%d: unexpected singular values: got %v, want %v", test.m, test.n, res.SingularValues, svd.Values(nil))
+		}
+		checkLstSqResiduals(t, "LstSq", &a, b, &got, res.Residuals, tol)
+
+		got.Reset()
+		res = LstSqQRP(&got, &a, b, 1e-10)
+		if res.Rank != test.rank {
+			t.Errorf("m=%d,n=%d: unexpected rank from LstSqQRP: got %d, want %d", test.m, test.n, res.Rank, test.rank)
+		}
+		if res.SingularValues != nil {
+			t.Errorf("m=%d,n=%d: unexpected singular values from LstSqQRP", test.m, test.n)
+		}
+		if !EqualApprox(&got, &want, tol) {
+			t.Errorf("m=%d,n=%d: unexpected LstSqQRP solution:\ngot:\n%v\nwant:\n%v", test.m, test.n, Formatted(&got), Formatted(&want))
+		}
+		checkLstSqResiduals(t, "LstSqQRP", &a, b, &got, res.Residuals, tol)
+	}
+}
+
+func checkLstSqResiduals(t *testing.T, name string, a, b Matrix, x *Dense, got []float64, tol float64) {
+	t.Helper()
+	var r Dense
+	r.Mul(a, x)
+	r.Sub(b, &r)
+	_, k := r.Dims()
+	if len(got) != k {
+		t.Errorf("%s: unexpected number of residuals: got %d, want %d", name, len(got), k)
+		return
+	}
+	for j := 0; j < k; j++ {
+		want := Dot(r.ColView(j), r.ColView(j))
+		if math.Abs(got[j]-want) > tol {
+			t.Errorf("%s: unexpected residual for column %d: got %v, want %v", name, j, got[j], want)
+		}
+	}
+}
+
+func TestLstSqEq(t *testing.T) {
+	t.Parallel()
+	// Fit a line y = x0 + x1*t through three points, constrained to pass
+	// through the point (t, y) = (0, 1).
+	a := NewDense(3, 2, []float64{
+		1, 1,
+		1, 2,
+		1, 3,
+	})
+	c := NewVecDense(3, []float64{2, 2.5, 4.5})
+	b := NewDense(1, 2, []float64{1, 0})
+	d := NewVecDense(1, []float64{1})
+
+	var x VecDense
+	rss, err := LstSqEq(&x, a, c, b, d)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// With x0 = 1, the problem reduces to minimizing
+	// |[1 1.5 3.5] - x1*[1 2 3]|_2 whose solution is x1 = 14.5/14.
+	x1 := 14.5 / 14
+	want := NewVecDense(2, []float64{1, x1})
+	if !EqualApprox(&x, want, 1e-14) {
+		t.Errorf("unexpected solution: got %v, want %v", x.RawVector().Data, want.RawVector().Data)
+	}
+	var wantRSS float64
+	for i, v := range []float64{1, 1.5, 3.5} {
+		r := v - x1*float64(i+1)
+		wantRSS += r * r
+	}
+	if math.Abs(rss-wantRSS) > 1e-14 {
+		t.Errorf("unexpected residual sum of squares: got %v, want %v", rss, wantRSS)
+	}
+
+	// A rank-deficient constraint matrix must be reported, leaving the
+	// destination unmodified.
+	var bad VecDense
+	_, err = LstSqEq(&bad, a, c, NewDense(1, 2, []float64{0, 0}), d)
+	if err != ErrSingular {
+		t.Errorf("unexpected error for singular constraint: got %v, want %v", err, ErrSingular)
+	}
+	if !bad.IsEmpty() {
+		t.Errorf("destination modified for singular constraint")
+	}
+}
+
+func TestGLM(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		n, m int
+	}{
+		{1, 1},
+		{4, 2},
+		{10, 3},
+		{7, 7},
+	} {
+		n, m := test.n, test.m
+		a := NewDense(n, m, nil)
+		for i := 0; i < n; i++ {
+			for j := 0; j < m; j++ {
+				a.Set(i, j, rnd.NormFloat64())
+			}
+		}
+		// Use a diagonal B so that the problem is a weighted least
+		// squares problem with weights 1/B_ii².
+		w := make([]float64, n)
+		bd := NewDense(n, n, nil)
+		for i := range w {
+			bd.Set(i, i, 0.5+rnd.Float64())
+			w[i] = 1 / bd.At(i, i)
+		}
+		d := NewVecDense(n, nil)
+		for i := 0; i < n; i++ {
+			d.SetVec(i, rnd.NormFloat64())
+		}
+
+		var x, y VecDense
+		err := GLM(&x, &y, a, bd, d)
+		if err != nil {
+			t.Errorf("n=%d,m=%d: unexpected error: %v", n, m, err)
+			continue
+		}
+
+		// Check that d = A*x + B*y.
+		var r VecDense
+		r.MulVec(a, &x)
+		var by VecDense
+		by.MulVec(bd, &y)
+		r.AddVec(&r, &by)
+		if !EqualApprox(&r, d, tol) {
+			t.Errorf("n=%d,m=%d: constraint not satisfied", n, m)
+		}
+
+		// Compare x with the weighted least squares solution.
+		wa := NewDense(n, m, nil)
+		wd := NewVecDense(n, nil)
+		for i := 0; i < n; i++ {
+			for j := 0; j < m; j++ {
+				wa.Set(i, j, w[i]*a.At(i, j))
+			}
+			wd.SetVec(i, w[i]*d.AtVec(i))
+		}
+		var want VecDense
+		err = want.SolveVec(wa, wd)
+		if err != nil {
+			t.Fatalf("n=%d,m=%d: unexpected error from SolveVec: %v", n, m, err)
+		}
+		if !EqualApprox(&x, &want, tol) {
+			t.Errorf("n=%d,m=%d: unexpected solution: got %v, want %v", n, m, x.RawVector().Data, want.RawVector().Data)
+		}
+	}
+
+	// A rank-deficient A must be reported, leaving the destinations
+	// unmodified.
+	var x, y VecDense
+	err := GLM(&x, &y, NewDense(2, 1, nil), NewDense(2, 2, []float64{1, 0, 0, 1}), NewVecDense(2, []float64{1, 2}))
+	if err != ErrSingular {
+		t.Errorf("unexpected error for singular A: got %v, want %v", err, ErrSingular)
+	}
+	if !x.IsEmpty() || !y.IsEmpty() {
+		t.Errorf("destinations modified for singular A")
+	}
+}
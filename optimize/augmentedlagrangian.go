@@ -0,0 +1,228 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	defaultAugLagPenalty      = 10
+	defaultAugLagIncrease     = 10
+	defaultAugLagMaxPenalty   = 1e12
+	defaultAugLagInnerTol     = 1e-2
+	augLagInfeasibilityFactor = 0.5
+)
+
+// ErrPenaltyLimit signifies that AugmentedLagrangian could not reduce the
+// violation of the constraints before the penalty parameter reached its
+// upper bound. This usually indicates that the constraints are infeasible.
+var ErrPenaltyLimit = errors.New("optimize: penalty parameter limit reached")
+
+// AugmentedLagrangian implements the augmented Lagrangian method for
+// nonlinear optimization with equality and inequality constraints.
+//
+// At each outer iteration k the method minimizes the augmented Lagrangian
+// function
+//
+//	L_A(x) = f(x) + Σ_{i∈E} (ρ/2 c_i(x)² - λ_i c_i(x))
+//	              + Σ_{i∈I} 1/(2ρ) (max(0, μ_i - ρ c_i(x))² - μ_i²)
+//
+// of Powell, Hestenes and Rockafellar with an unconstrained Method, and
+// updates the Lagrange multiplier estimates λ and μ by
+//
+//	λ_i ← λ_i - ρ c_i(x),
+//	μ_i ← max(0, μ_i - ρ c_i(x)).
+//
+// The penalty parameter ρ is increased if the violation of the constraints
+// is not reduced sufficiently. The inner minimizations are solved with a
+// gradient threshold that decreases with the outer iterations, and each of
+// them is started from the location found by the previous one.
+//
+// References:
+//   - Birgin, E.G., Martínez, J.M.: Practical Augmented Lagrangian Methods
+//     for Constrained Optimization. SIAM (2014)
+//   - Nocedal, J., Wright, S.: Numerical Optimization (2nd ed). Springer (2006),
+//     Chapter 17
+type AugmentedLagrangian struct {
+	// Method is the method used for the inner unconstrained minimizations.
+	// The inner problems have a gradient but no Hessian. If Method is nil,
+	// it is defaulted to LBFGS.
+	Method Method
+
+	// InitialPenalty is the initial value of the penalty parameter ρ. If it
+	// is zero, it is defaulted to 10.
+	InitialPenalty float64
+	// PenaltyIncrease is the factor by which the penalty parameter is
+	// increased. It must be greater than 1. If it is zero, it is defaulted
+	// to 10.
+	PenaltyIncrease float64
+	// MaxPenalty is the upper bound on the penalty parameter. If it is zero,
+	// it is defaulted to 1e12.
+	MaxPenalty float64
+}
+
+func (al *AugmentedLagrangian) minimize(c *constraintEvaluator, initX []float64, settings *ConstrainedSettings, optTol, feasTol float64) (*ConstrainedResult, error) {
+	rho := al.InitialPenalty
+	if rho == 0 {
+		rho = defaultAugLagPenalty
+	}
+	increase := al.PenaltyIncrease
+	if increase == 0 {
+		increase = defaultAugLagIncrease
+	}
+	maxRho := al.MaxPenalty
+	if maxRho == 0 {
+		maxRho = defaultAugLagMaxPenalty
+	}
+	if rho <= 0 {
+		panic("optimize: AugmentedLagrangian.InitialPenalty must be positive")
+	}
+	if increase <= 1 {
+		panic("optimize: AugmentedLagrangian.PenaltyIncrease must be greater than 1")
+	}
+	if maxRho < rho {
+		panic("optimize: AugmentedLagrangian.MaxPenalty less than InitialPenalty")
+	}
+	method := al.Method
+	if method == nil {
+		method = &LBFGS{}
+	}
+
+	dim := c.dim
+	mE, mI := c.p.NumEquality, c.p.NumInequality
+	lambda := make([]float64, mE)
+	mu := make([]float64, mI)
+	grad := make([]float64, dim)
+	work := make([]float64, max(dim, mE, mI))
+
+	// The inner problem evaluates the augmented Lagrangian with the current
+	// multipliers and penalty parameter.
+	inner := Problem{
+		Func: func(x []float64) float64 {
+			f := c.p.Func(x)
+			c.constraints(x)
+			for i, v := range c.eq {
+				f += v * (0.5*rho*v - lambda[i])
+			}
+			for i, v := range c.ineq {
+				t := math.Max(0, mu[i]-rho*v)
+				f += (t*t - mu[i]*mu[i]) / (2 * rho)
+			}
+			return f
+		},
+		Grad: func(dst, x []float64) {
+			c.grad(dst, x)
+			c.constraints(x)
+			c.jacobians(x)
+			g := mat.NewVecDense(dim, dst)
+			if mE > 0 {
+				for i, v := range c.eq {
+					work[i] = lambda[i] - rho*v
+				}
+				g.AddVec(g, mulTransVec(c.eqJac, work[:mE], -1))
+			}
+			if mI > 0 {
+				for i, v := range c.ineq {
+					work[i] = math.Max(0, mu[i]-rho*v)
+				}
+				g.AddVec(g, mulTransVec(c.ineqJac, work[:mI], -1))
+			}
+		},
+	}
+	var innerSettings Settings
+	if settings.Inner != nil {
+		innerSettings = *settings.Inner
+	}
+	innerSettings.Concurrent = 0
+
+	x := make([]float64, dim)
+	copy(x, initX)
+	var (
+		stats    Stats
+		status   Status
+		err      error
+		kkt      KKTResiduals
+		infeas   = math.Inf(1)
+		innerTol = defaultAugLagInnerTol
+	)
+	for {
+		innerSettings.GradientThreshold = innerTol
+		res, innerErr := Minimize(inner, x, &innerSettings, method)
+		if res == nil {
+			return nil, innerErr
+		}
+		stats.MajorIterations++
+		stats.FuncEvaluations += res.FuncEvaluations
+		stats.GradEvaluations += res.GradEvaluations
+		copy(x, res.X)
+
+		// Measure the infeasibility, taking into account that an inactive
+		// inequality constraint need not hold as an equality.
+		c.constraints(x)
+		newInfeas := 0.0
+		for _, v := range c.eq {
+			newInfeas = math.Max(newInfeas, math.Abs(v))
+		}
+		for i, v := range c.ineq {
+			newInfeas = math.Max(newInfeas, math.Abs(math.Min(v, mu[i]/rho)))
+		}
+
+		// Update the multipliers.
+		for i, v := range c.eq {
+			lambda[i] -= rho * v
+		}
+		for i, v := range c.ineq {
+			mu[i] = math.Max(0, mu[i]-rho*v)
+		}
+
+		c.grad(grad, x)
+		c.jacobians(x)
+		kkt = c.kkt(work[:dim], grad, lambda, mu)
+		if kkt.Stationarity <= optTol && kkt.Feasibility <= feasTol && kkt.Complementarity <= optTol {
+			status = Success
+			break
+		}
+		if settings.MajorIterations > 0 && stats.MajorIterations >= settings.MajorIterations {
+			status = IterationLimit
+			break
+		}
+
+		// Increase the penalty parameter if the infeasibility has not
+		// decreased enough. A large penalty parameter makes the inner
+		// problems ill-conditioned, so it is kept once the constraints are
+		// satisfied to the required accuracy.
+		if newInfeas > feasTol && newInfeas > augLagInfeasibilityFactor*infeas {
+			rho *= increase
+			if rho > maxRho {
+				status = Failure
+				err = ErrPenaltyLimit
+				break
+			}
+		}
+		infeas = newInfeas
+		innerTol = math.Max(0.1*innerTol, optTol)
+	}
+
+	f := c.p.Func(x)
+	stats.FuncEvaluations++
+	return &ConstrainedResult{
+		Location: Location{
+			X:        x,
+			F:        f,
+			Gradient: grad,
+		},
+		Equality:              append([]float64(nil), c.eq...),
+		Inequality:            append([]float64(nil), c.ineq...),
+		EqualityMultipliers:   lambda,
+		InequalityMultipliers: mu,
+		KKT:                   kkt,
+		Stats:                 stats,
+		Status:                status,
+	}, err
+}
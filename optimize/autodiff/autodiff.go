@@ -0,0 +1,103 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package autodiff
+
+import (
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/num/dual"
+	"gonum.org/v1/gonum/num/hyperdual"
+)
+
+// Grad returns a function that evaluates the gradient of the scalar
+// function f at x and stores the result in grad, as required by the Grad
+// field of optimize.Problem. The function f is typically a generic function
+// of a Number type instantiated with dual.Number, and it must not modify
+// its argument. The gradient is computed with len(x) evaluations of f.
+//
+// The returned function allocates its workspace at each call, so that it
+// may be called concurrently if f may be.
+func Grad(f func(x []dual.Number) dual.Number) func(grad, x []float64) {
+	return func(grad, x []float64) {
+		if len(grad) != len(x) {
+			panic("autodiff: incorrect size of the gradient")
+		}
+		xd := make([]dual.Number, len(x))
+		for i, v := range x {
+			xd[i].Real = v
+		}
+		for i := range x {
+			xd[i].Emag = 1
+			grad[i] = f(xd).Emag
+			xd[i].Emag = 0
+		}
+	}
+}
+
+// Hess returns a function that evaluates the Hessian of the scalar function
+// f at x and stores the result in hess, as required by the Hess field of
+// optimize.Problem. The function f is typically a generic function of a
+// Number type instantiated with hyperdual.Number, and it must not modify
+// its argument. The Hessian is computed with n(n+1)/2 evaluations of f,
+// where n = len(x).
+//
+// The returned function allocates its workspace at each call, so that it
+// may be called concurrently if f may be.
+func Hess(f func(x []hyperdual.Number) hyperdual.Number) func(hess *mat.SymDense, x []float64) {
+	return func(hess *mat.SymDense, x []float64) {
+		n := len(x)
+		if hess.SymmetricDim() != n {
+			panic("autodiff: incorrect size of the Hessian")
+		}
+		xh := make([]hyperdual.Number, n)
+		for i, v := range x {
+			xh[i].Real = v
+		}
+		for i := 0; i < n; i++ {
+			xh[i].E1mag = 1
+			for j := i; j < n; j++ {
+				xh[j].E2mag = 1
+				hess.SetSym(i, j, f(xh).E1E2mag)
+				xh[j].E2mag = 0
+			}
+			xh[i].E1mag = 0
+		}
+	}
+}
+
+// Jacobian returns a function that evaluates the Jacobian matrix of the
+// vector-valued function f at x and stores the result in dst, as required
+// by the Jacobian fields of leastsq.Problem and nonlin.Problem. The function
+// f evaluates the function at x and stores the result in y, and it is
+// typically a generic function of a Number type instantiated with
+// dual.Number. It must not modify x. The number of rows of dst determines
+// the length of y, and the Jacobian is computed with len(x) evaluations of
+// f.
+//
+// The returned function allocates its workspace at each call, so that it
+// may be called concurrently if f may be.
+func Jacobian(f func(y, x []dual.Number)) func(dst *mat.Dense, x []float64) {
+	return func(dst *mat.Dense, x []float64) {
+		m, n := dst.Dims()
+		if n != len(x) {
+			panic("autodiff: incorrect size of the Jacobian")
+		}
+		xd := make([]dual.Number, n)
+		for i, v := range x {
+			xd[i].Real = v
+		}
+		yd := make([]dual.Number, m)
+		for j := range x {
+			xd[j].Emag = 1
+			for i := range yd {
+				yd[i] = dual.Number{}
+			}
+			f(yd, xd)
+			for i, v := range yd {
+				dst.Set(i, j, v.Emag)
+			}
+			xd[j].Emag = 0
+		}
+	}
+}
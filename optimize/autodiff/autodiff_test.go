@@ -0,0 +1,303 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package autodiff
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/num/dual"
+	"gonum.org/v1/gonum/num/hyperdual"
+	"gonum.org/v1/gonum/optimize"
+	"gonum.org/v1/gonum/optimize/functions"
+)
+
+func beale[T Number](x []T) T {
+	one := Const[T](1)
+	f1 := Sub(Const[T](1.5), Mul(x[0], Sub(one, x[1])))
+	f2 := Sub(Const[T](2.25), Mul(x[0], Sub(one, Mul(x[1], x[1]))))
+	f3 := Sub(Const[T](2.625), Mul(x[0], Sub(one, Mul(x[1], Mul(x[1], x[1])))))
+	return Add(Mul(f1, f1), Add(Mul(f2, f2), Mul(f3, f3)))
+}
+
+func brownAndDennis[T Number](x []T) T {
+	var sum T
+	for i := 1; i <= 20; i++ {
+		c := float64(i) / 5
+		f1 := Shift(Add(x[0], Scale(c, x[1])), -math.Exp(c))
+		f2 := Shift(Add(x[2], Scale(math.Sin(c), x[3])), -math.Cos(c))
+		f := Add(Mul(f1, f1), Mul(f2, f2))
+		sum = Add(sum, Mul(f, f))
+	}
+	return sum
+}
+
+func extendedRosenbrock[T Number](x []T) T {
+	var sum T
+	for i := 0; i < len(x)-1; i++ {
+		a := Sub(Const[T](1), x[i])
+		b := Sub(x[i+1], Mul(x[i], x[i]))
+		sum = Add(sum, Add(Mul(a, a), Scale(100, Mul(b, b))))
+	}
+	return sum
+}
+
+// powellBadlyScaledResiduals are the residuals of the PowellBadlyScaled
+// function.
+func powellBadlyScaledResiduals[T Number](y, x []T) {
+	y[0] = Shift(Scale(1e4, Mul(x[0], x[1])), -1)
+	y[1] = Shift(Add(Exp(Neg(x[0])), Exp(Neg(x[1]))), -1.0001)
+}
+
+func powellBadlyScaled[T Number](x []T) T {
+	y := make([]T, 2)
+	powellBadlyScaledResiduals(y, x)
+	return Add(Mul(y[0], y[0]), Mul(y[1], y[1]))
+}
+
+func wood[T Number](x []T) T {
+	f1 := Sub(x[1], Mul(x[0], x[0]))
+	f2 := Sub(Const[T](1), x[0])
+	f3 := Sub(x[3], Mul(x[2], x[2]))
+	f4 := Sub(Const[T](1), x[2])
+	f5 := Shift(Add(x[1], x[3]), -2)
+	f6 := Sub(x[1], x[3])
+	sum := Scale(100, Mul(f1, f1))
+	sum = Add(sum, Mul(f2, f2))
+	sum = Add(sum, Scale(90, Mul(f3, f3)))
+	sum = Add(sum, Mul(f4, f4))
+	sum = Add(sum, Scale(10, Mul(f5, f5)))
+	return Add(sum, Scale(0.1, Mul(f6, f6)))
+}
+
+type gradHesser interface {
+	Func(x []float64) float64
+	Grad(grad, x []float64)
+}
+
+func TestGradHess(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		name string
+		want gradHesser
+		f    func([]float64) float64
+		fd   func([]dual.Number) dual.Number
+		fh   func([]hyperdual.Number) hyperdual.Number
+		dim  int
+	}{
+		{"Beale", functions.Beale{}, beale[float64], beale[dual.Number], beale[hyperdual.Number], 2},
+		{"BrownAndDennis", functions.BrownAndDennis{}, brownAndDennis[float64], brownAndDennis[dual.Number], brownAndDennis[hyperdual.Number], 4},
+		{"ExtendedRosenbrock", functions.ExtendedRosenbrock{}, extendedRosenbrock[float64], extendedRosenbrock[dual.Number], extendedRosenbrock[hyperdual.Number], 10},
+		{"PowellBadlyScaled", functions.PowellBadlyScaled{}, powellBadlyScaled[float64], powellBadlyScaled[dual.Number], powellBadlyScaled[hyperdual.Number], 2},
+		{"Wood", functions.Wood{}, wood[float64], wood[dual.Number], wood[hyperdual.Number], 4},
+	} {
+		grad := Grad(test.fd)
+		hess := Hess(test.fh)
+		for trial := 0; trial < 10; trial++ {
+			x := make([]float64, test.dim)
+			for i := range x {
+				x[i] = 2 * rnd.NormFloat64()
+			}
+			xCopy := make([]float64, len(x))
+			copy(xCopy, x)
+
+			want := test.want.Func(x)
+			if got := test.f(x); !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+				t.Errorf("%s: unexpected function value: got %v, want %v", test.name, got, want)
+			}
+
+			gotGrad := make([]float64, test.dim)
+			grad(gotGrad, x)
+			wantGrad := make([]float64, test.dim)
+			test.want.Grad(wantGrad, x)
+			if !floats.EqualApprox(gotGrad, wantGrad, 1e-12*math.Max(1, floats.Norm(wantGrad, math.Inf(1)))) {
+				t.Errorf("%s: unexpected gradient: got %v, want %v", test.name, gotGrad, wantGrad)
+			}
+
+			gotHess := mat.NewSymDense(test.dim, nil)
+			hess(gotHess, x)
+			if h, ok := test.want.(interface {
+				Hess(*mat.SymDense, []float64)
+			}); ok {
+				wantHess := mat.NewSymDense(test.dim, nil)
+				h.Hess(wantHess, x)
+				if !mat.EqualApprox(gotHess, wantHess, 1e-12*math.Max(1, mat.Norm(wantHess, math.Inf(1)))) {
+					t.Errorf("%s: unexpected Hessian:\ngot  %v\nwant %v", test.name, mat.Formatted(gotHess), mat.Formatted(wantHess))
+				}
+			} else {
+				// Compare the diagonal of the Hessian with the derivatives of
+				// the gradient computed with hyperdual numbers.
+				for i := range x {
+					xh := make([]hyperdual.Number, len(x))
+					for j, v := range x {
+						xh[j].Real = v
+					}
+					xh[i].E1mag = 1
+					xh[i].E2mag = 1
+					got := test.fh(xh)
+					if !scalar.EqualWithinAbsOrRel(got.E1mag, wantGrad[i], 1e-12, 1e-12) {
+						t.Errorf("%s: unexpected hyperdual gradient", test.name)
+					}
+					if got.E1E2mag != gotHess.At(i, i) {
+						t.Errorf("%s: unexpected diagonal of Hessian", test.name)
+					}
+				}
+			}
+			if !floats.Equal(x, xCopy) {
+				t.Errorf("%s: location modified", test.name)
+			}
+		}
+	}
+}
+
+func TestJacobian(t *testing.T) {
+	t.Parallel()
+	jac := Jacobian(powellBadlyScaledResiduals[dual.Number])
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 10; trial++ {
+		x := []float64{rnd.NormFloat64(), rnd.NormFloat64()}
+		got := mat.NewDense(2, 2, nil)
+		jac(got, x)
+		want := mat.NewDense(2, 2, []float64{
+			1e4 * x[1], 1e4 * x[0],
+			-math.Exp(-x[0]), -math.Exp(-x[1]),
+		})
+		if !mat.EqualApprox(got, want, 1e-12) {
+			t.Errorf("unexpected Jacobian:\ngot  %v\nwant %v", mat.Formatted(got), mat.Formatted(want))
+		}
+
+		// The gradient of the sum of squares is 2 Jᵀy.
+		y := make([]float64, 2)
+		powellBadlyScaledResiduals(y, x)
+		var g mat.VecDense
+		g.MulVec(got.T(), mat.NewVecDense(2, y))
+		g.ScaleVec(2, &g)
+		wantGrad := make([]float64, 2)
+		functions.PowellBadlyScaled{}.Grad(wantGrad, x)
+		if !floats.EqualApprox(g.RawVector().Data, wantGrad, 1e-8*floats.Norm(wantGrad, math.Inf(1))) {
+			t.Errorf("unexpected gradient from Jacobian: got %v, want %v", g.RawVector().Data, wantGrad)
+		}
+	}
+
+	// A Jacobian with more rows than columns.
+	jac = Jacobian(func(y, x []dual.Number) {
+		y[0] = Mul(x[0], x[0])
+		y[1] = Sin(x[0])
+		y[2] = Exp(x[0])
+	})
+	got := mat.NewDense(3, 1, nil)
+	jac(got, []float64{0.5})
+	want := mat.NewDense(3, 1, []float64{1, math.Cos(0.5), math.Exp(0.5)})
+	if !mat.EqualApprox(got, want, 1e-15) {
+		t.Errorf("unexpected Jacobian:\ngot  %v\nwant %v", mat.Formatted(got), mat.Formatted(want))
+	}
+}
+
+func TestElementary(t *testing.T) {
+	t.Parallel()
+	// The derivatives of the elementary functions at x = 0.3 and, for
+	// functions of two arguments, with the second argument 1.7.
+	const x, y = 0.3, 1.7
+	for _, test := range []struct {
+		name   string
+		f      func(dual.Number) dual.Number
+		fh     func(hyperdual.Number) hyperdual.Number
+		ff     func(float64) float64
+		df     float64
+		d2f    float64
+		withFD bool
+	}{
+		{"Div", func(x dual.Number) dual.Number { return Div(Const[dual.Number](y), x) }, func(x hyperdual.Number) hyperdual.Number { return Div(Const[hyperdual.Number](y), x) }, func(x float64) float64 { return Div(y, x) }, -y / (x * x), 2 * y / (x * x * x), false},
+		{"Inv", Inv[dual.Number], Inv[hyperdual.Number], Inv[float64], -1 / (x * x), 2 / (x * x * x), false},
+		{"Sqrt", Sqrt[dual.Number], Sqrt[hyperdual.Number], Sqrt[float64], 0.5 / math.Sqrt(x), -0.25 / (x * math.Sqrt(x)), false},
+		{"Exp", Exp[dual.Number], Exp[hyperdual.Number], Exp[float64], math.Exp(x), math.Exp(x), false},
+		{"Log", Log[dual.Number], Log[hyperdual.Number], Log[float64], 1 / x, -1 / (x * x), false},
+		{"Pow", func(x dual.Number) dual.Number { return Pow(x, Const[dual.Number](y)) }, func(x hyperdual.Number) hyperdual.Number { return Pow(x, Const[hyperdual.Number](y)) }, func(x float64) float64 { return Pow(x, y) }, y * math.Pow(x, y-1), y * (y - 1) * math.Pow(x, y-2), false},
+		{"PowReal", func(x dual.Number) dual.Number { return PowReal(x, y) }, func(x hyperdual.Number) hyperdual.Number { return PowReal(x, y) }, func(x float64) float64 { return PowReal(x, y) }, y * math.Pow(x, y-1), y * (y - 1) * math.Pow(x, y-2), false},
+		{"Sin", Sin[dual.Number], Sin[hyperdual.Number], Sin[float64], math.Cos(x), -math.Sin(x), false},
+		{"Cos", Cos[dual.Number], Cos[hyperdual.Number], Cos[float64], -math.Sin(x), -math.Cos(x), false},
+		{"Tan", Tan[dual.Number], Tan[hyperdual.Number], Tan[float64], 1 + math.Tan(x)*math.Tan(x), 0, true},
+		{"Asin", Asin[dual.Number], Asin[hyperdual.Number], Asin[float64], 1 / math.Sqrt(1-x*x), 0, true},
+		{"Acos", Acos[dual.Number], Acos[hyperdual.Number], Acos[float64], -1 / math.Sqrt(1-x*x), 0, true},
+		{"Atan", Atan[dual.Number], Atan[hyperdual.Number], Atan[float64], 1 / (1 + x*x), -2 * x / ((1 + x*x) * (1 + x*x)), false},
+		{"Sinh", Sinh[dual.Number], Sinh[hyperdual.Number], Sinh[float64], math.Cosh(x), math.Sinh(x), false},
+		{"Cosh", Cosh[dual.Number], Cosh[hyperdual.Number], Cosh[float64], math.Sinh(x), math.Cosh(x), false},
+		{"Tanh", Tanh[dual.Number], Tanh[hyperdual.Number], Tanh[float64], 1 - math.Tanh(x)*math.Tanh(x), 0, true},
+		{"Asinh", Asinh[dual.Number], Asinh[hyperdual.Number], Asinh[float64], 1 / math.Sqrt(x*x+1), 0, true},
+		{"Atanh", Atanh[dual.Number], Atanh[hyperdual.Number], Atanh[float64], 1 / (1 - x*x), 0, true},
+		{"Abs", func(x dual.Number) dual.Number { return Abs(Neg(x)) }, func(x hyperdual.Number) hyperdual.Number { return Abs(Neg(x)) }, func(x float64) float64 { return Abs(Neg(x)) }, 1, 0, false},
+	} {
+		d := test.f(dual.Number{Real: x, Emag: 1})
+		h := test.fh(hyperdual.Number{Real: x, E1mag: 1, E2mag: 1})
+		want := test.ff(x)
+		if d.Real != want || h.Real != want {
+			t.Errorf("%s: unexpected value: got %v and %v, want %v", test.name, d.Real, h.Real, want)
+		}
+		if !scalar.EqualWithinAbsOrRel(d.Emag, test.df, 1e-14, 1e-14) || !scalar.EqualWithinAbsOrRel(h.E1mag, test.df, 1e-14, 1e-14) {
+			t.Errorf("%s: unexpected derivative: got %v and %v, want %v", test.name, d.Emag, h.E1mag, test.df)
+		}
+		d2f := test.d2f
+		if test.withFD {
+			// Compare with a central difference of the first derivative.
+			const step = 1e-5
+			d2f = (test.f(dual.Number{Real: x + step, Emag: 1}).Emag - test.f(dual.Number{Real: x - step, Emag: 1}).Emag) / (2 * step)
+		}
+		if !scalar.EqualWithinAbsOrRel(h.E1E2mag, d2f, 1e-8, 1e-8) {
+			t.Errorf("%s: unexpected second derivative: got %v, want %v", test.name, h.E1E2mag, d2f)
+		}
+	}
+	if v := Value(Const[hyperdual.Number](2.5)); v != 2.5 {
+		t.Errorf("unexpected value: got %v, want 2.5", v)
+	}
+	if v := Value(Acosh(Const[dual.Number](2))); v != math.Acosh(2) {
+		t.Errorf("unexpected value of Acosh: got %v, want %v", v, math.Acosh(2))
+	}
+}
+
+func TestMinimize(t *testing.T) {
+	t.Parallel()
+	p := optimize.Problem{
+		Func: extendedRosenbrock[float64],
+		Grad: Grad(extendedRosenbrock[dual.Number]),
+		Hess: Hess(extendedRosenbrock[hyperdual.Number]),
+	}
+	x := []float64{-1.2, 1, -1.2, 1}
+	res, err := optimize.Minimize(p, x, nil, &optimize.Newton{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []float64{1, 1, 1, 1}
+	if !floats.EqualApprox(res.X, want, 1e-8) {
+		t.Errorf("unexpected minimizer: got %v, want %v", res.X, want)
+	}
+}
+
+func TestPanics(t *testing.T) {
+	t.Parallel()
+	for k, fn := range []func(){
+		func() { Grad(beale[dual.Number])(make([]float64, 1), make([]float64, 2)) },
+		func() { Hess(beale[hyperdual.Number])(mat.NewSymDense(3, nil), make([]float64, 2)) },
+		func() { Jacobian(powellBadlyScaledResiduals[dual.Number])(mat.NewDense(2, 3, nil), make([]float64, 2)) },
+	} {
+		if !panics(fn) {
+			t.Errorf("case %d: expected panic", k)
+		}
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		r := recover()
+		panicked = r != nil
+	}()
+	fn()
+	return
+}
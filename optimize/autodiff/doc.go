@@ -0,0 +1,15 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package autodiff provides exact derivatives of functions for the optimize
+// packages using forward-mode automatic differentiation with the dual
+// numbers of gonum.org/v1/gonum/num/dual and the hyperdual numbers of
+// gonum.org/v1/gonum/num/hyperdual.
+//
+// A function is written once as a generic function of the Number type
+// using the arithmetic functions of this package, and instantiated with
+// float64 for function values, with dual.Number for gradients and
+// Jacobians, and with hyperdual.Number for Hessians. The derivatives are
+// exact up to rounding, unlike finite difference approximations.
+package autodiff // import "gonum.org/v1/gonum/optimize/autodiff"
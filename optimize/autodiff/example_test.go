@@ -0,0 +1,44 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package autodiff_test
+
+import (
+	"fmt"
+	"log"
+
+	"gonum.org/v1/gonum/num/dual"
+	"gonum.org/v1/gonum/num/hyperdual"
+	"gonum.org/v1/gonum/optimize"
+	"gonum.org/v1/gonum/optimize/autodiff"
+)
+
+// rosenbrock is the Rosenbrock function written against the Number types.
+func rosenbrock[T autodiff.Number](x []T) T {
+	a := autodiff.Sub(autodiff.Const[T](1), x[0])
+	b := autodiff.Sub(x[1], autodiff.Mul(x[0], x[0]))
+	return autodiff.Add(autodiff.Mul(a, a), autodiff.Scale(100, autodiff.Mul(b, b)))
+}
+
+func Example() {
+	p := optimize.Problem{
+		Func: rosenbrock[float64],
+		Grad: autodiff.Grad(rosenbrock[dual.Number]),
+		Hess: autodiff.Hess(rosenbrock[hyperdual.Number]),
+	}
+
+	grad := make([]float64, 2)
+	p.Grad(grad, []float64{0, 0})
+	fmt.Printf("gradient at the origin: %v\n", grad)
+
+	result, err := optimize.Minimize(p, []float64{-1.2, 1}, nil, &optimize.Newton{})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("minimizer: %.6f\n", result.X)
+
+	// Output:
+	// gradient at the origin: [-2 0]
+	// minimizer: [1.000000 1.000000]
+}
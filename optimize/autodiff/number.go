@@ -0,0 +1,219 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package autodiff
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/num/dual"
+	"gonum.org/v1/gonum/num/hyperdual"
+)
+
+// Number is the constraint satisfied by the number types that functions
+// differentiated by this package are written against. A generic function
+// of a Number type evaluates function values when instantiated with
+// float64, first derivatives when instantiated with dual.Number and second
+// derivatives when instantiated with hyperdual.Number.
+type Number interface {
+	float64 | dual.Number | hyperdual.Number
+}
+
+// Const returns the number with the real part v and no derivative parts.
+func Const[T Number](v float64) T {
+	var x T
+	switch p := any(&x).(type) {
+	case *float64:
+		*p = v
+	case *dual.Number:
+		p.Real = v
+	case *hyperdual.Number:
+		p.Real = v
+	}
+	return x
+}
+
+// Value returns the real part of x. Value may be used in comparisons, for
+// example to select branches of piecewise functions.
+func Value[T Number](x T) float64 {
+	switch v := any(x).(type) {
+	case float64:
+		return v
+	case dual.Number:
+		return v.Real
+	case hyperdual.Number:
+		return v.Real
+	}
+	panic(badNumber)
+}
+
+const badNumber = "autodiff: unsupported number type"
+
+// unary applies the function for the dynamic type of x.
+func unary[T Number](x T, f func(float64) float64, fd func(dual.Number) dual.Number, fh func(hyperdual.Number) hyperdual.Number) T {
+	switch v := any(x).(type) {
+	case float64:
+		return any(f(v)).(T)
+	case dual.Number:
+		return any(fd(v)).(T)
+	case hyperdual.Number:
+		return any(fh(v)).(T)
+	}
+	panic(badNumber)
+}
+
+// binary applies the function for the dynamic type of x and y.
+func binary[T Number](x, y T, f func(x, y float64) float64, fd func(x, y dual.Number) dual.Number, fh func(x, y hyperdual.Number) hyperdual.Number) T {
+	switch v := any(x).(type) {
+	case float64:
+		return any(f(v, any(y).(float64))).(T)
+	case dual.Number:
+		return any(fd(v, any(y).(dual.Number))).(T)
+	case hyperdual.Number:
+		return any(fh(v, any(y).(hyperdual.Number))).(T)
+	}
+	panic(badNumber)
+}
+
+// Add returns x+y.
+func Add[T Number](x, y T) T {
+	return binary(x, y, func(x, y float64) float64 { return x + y }, dual.Add, hyperdual.Add)
+}
+
+// Sub returns x-y.
+func Sub[T Number](x, y T) T {
+	return binary(x, y, func(x, y float64) float64 { return x - y }, dual.Sub, hyperdual.Sub)
+}
+
+// Mul returns x*y.
+func Mul[T Number](x, y T) T {
+	return binary(x, y, func(x, y float64) float64 { return x * y }, dual.Mul, hyperdual.Mul)
+}
+
+// Div returns x/y.
+func Div[T Number](x, y T) T {
+	return binary(x, y,
+		func(x, y float64) float64 { return x / y },
+		func(x, y dual.Number) dual.Number { return dual.Mul(x, dual.Inv(y)) },
+		func(x, y hyperdual.Number) hyperdual.Number { return hyperdual.Mul(x, hyperdual.Inv(y)) },
+	)
+}
+
+// Scale returns f*x.
+func Scale[T Number](f float64, x T) T {
+	return unary(x,
+		func(x float64) float64 { return f * x },
+		func(x dual.Number) dual.Number { return dual.Scale(f, x) },
+		func(x hyperdual.Number) hyperdual.Number { return hyperdual.Scale(f, x) },
+	)
+}
+
+// Shift returns x+c.
+func Shift[T Number](x T, c float64) T {
+	return Add(x, Const[T](c))
+}
+
+// Neg returns -x.
+func Neg[T Number](x T) T {
+	return Scale(-1, x)
+}
+
+// Inv returns 1/x.
+func Inv[T Number](x T) T {
+	return unary(x, func(x float64) float64 { return 1 / x }, dual.Inv, hyperdual.Inv)
+}
+
+// Abs returns the absolute value of x.
+func Abs[T Number](x T) T {
+	return unary(x, math.Abs, dual.Abs, hyperdual.Abs)
+}
+
+// Sqrt returns the square root of x.
+func Sqrt[T Number](x T) T {
+	return unary(x, math.Sqrt, dual.Sqrt, hyperdual.Sqrt)
+}
+
+// Pow returns x**p.
+func Pow[T Number](x, p T) T {
+	return binary(x, p, math.Pow, dual.Pow, hyperdual.Pow)
+}
+
+// PowReal returns x**p.
+func PowReal[T Number](x T, p float64) T {
+	return unary(x,
+		func(x float64) float64 { return math.Pow(x, p) },
+		func(x dual.Number) dual.Number { return dual.PowReal(x, p) },
+		func(x hyperdual.Number) hyperdual.Number { return hyperdual.PowReal(x, p) },
+	)
+}
+
+// Exp returns e**x.
+func Exp[T Number](x T) T {
+	return unary(x, math.Exp, dual.Exp, hyperdual.Exp)
+}
+
+// Log returns the natural logarithm of x.
+func Log[T Number](x T) T {
+	return unary(x, math.Log, dual.Log, hyperdual.Log)
+}
+
+// Sin returns the sine of x.
+func Sin[T Number](x T) T {
+	return unary(x, math.Sin, dual.Sin, hyperdual.Sin)
+}
+
+// Cos returns the cosine of x.
+func Cos[T Number](x T) T {
+	return unary(x, math.Cos, dual.Cos, hyperdual.Cos)
+}
+
+// Tan returns the tangent of x.
+func Tan[T Number](x T) T {
+	return unary(x, math.Tan, dual.Tan, hyperdual.Tan)
+}
+
+// Asin returns the inverse sine of x.
+func Asin[T Number](x T) T {
+	return unary(x, math.Asin, dual.Asin, hyperdual.Asin)
+}
+
+// Acos returns the inverse cosine of x.
+func Acos[T Number](x T) T {
+	return unary(x, math.Acos, dual.Acos, hyperdual.Acos)
+}
+
+// Atan returns the inverse tangent of x.
+func Atan[T Number](x T) T {
+	return unary(x, math.Atan, dual.Atan, hyperdual.Atan)
+}
+
+// Sinh returns the hyperbolic sine of x.
+func Sinh[T Number](x T) T {
+	return unary(x, math.Sinh, dual.Sinh, hyperdual.Sinh)
+}
+
+// Cosh returns the hyperbolic cosine of x.
+func Cosh[T Number](x T) T {
+	return unary(x, math.Cosh, dual.Cosh, hyperdual.Cosh)
+}
+
+// Tanh returns the hyperbolic tangent of x.
+func Tanh[T Number](x T) T {
+	return unary(x, math.Tanh, dual.Tanh, hyperdual.Tanh)
+}
+
+// Asinh returns the inverse hyperbolic sine of x.
+func Asinh[T Number](x T) T {
+	return unary(x, math.Asinh, dual.Asinh, hyperdual.Asinh)
+}
+
+// Acosh returns the inverse hyperbolic cosine of x.
+func Acosh[T Number](x T) T {
+	return unary(x, math.Acosh, dual.Acosh, hyperdual.Acosh)
+}
+
+// Atanh returns the inverse hyperbolic tangent of x.
+func Atanh[T Number](x T) T {
+	return unary(x, math.Atanh, dual.Atanh, hyperdual.Atanh)
+}
@@ -0,0 +1,615 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"sort"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Acquisition is an acquisition function of BayesianOptimization. The next
+// location is the maximizer of the acquisition function of the posterior
+// mean μ(x) and standard deviation σ(x) of the surrogate.
+type Acquisition int
+
+const (
+	// ExpectedImprovement is the expected improvement over the best
+	// function value f* found so far,
+	//  E[max(f* - ξ - f(x), 0)],
+	// where ξ is given by BayesianOptimization.Xi.
+	ExpectedImprovement Acquisition = iota
+	// UpperConfidenceBound is the upper confidence bound of the negated
+	// function,
+	//  -μ(x) + κσ(x),
+	// where κ is given by BayesianOptimization.Kappa.
+	UpperConfidenceBound
+	// ProbabilityOfImprovement is the probability of an improvement over
+	// the best function value f* found so far,
+	//  P(f(x) < f* - ξ),
+	// where ξ is given by BayesianOptimization.Xi.
+	ProbabilityOfImprovement
+)
+
+// GPKernel is a covariance function of a Gaussian process.
+type GPKernel interface {
+	// Cov returns the covariance between the function values at x and y.
+	Cov(x, y []float64) float64
+}
+
+// SquaredExponentialKernel is the squared exponential covariance function
+//
+//	k(x, y) = σ² exp(-|x-y|²/(2ℓ²)),
+//
+// where ℓ is the LengthScale and σ² the Variance. If LengthScale or
+// Variance is zero, it is defaulted to 1.
+type SquaredExponentialKernel struct {
+	LengthScale float64
+	Variance    float64
+}
+
+func (k SquaredExponentialKernel) Cov(x, y []float64) float64 {
+	r := scaledDistance(x, y, k.LengthScale)
+	return kernelVariance(k.Variance) * math.Exp(-0.5*r*r)
+}
+
+// MaternKernel is the Matérn covariance function with smoothness ν of
+// 1/2, 3/2 or 5/2,
+//
+//	k(x, y) = σ² exp(-r),                         ν = 1/2,
+//	k(x, y) = σ² (1 + √3 r) exp(-√3 r),           ν = 3/2,
+//	k(x, y) = σ² (1 + √5 r + 5r²/3) exp(-√5 r),   ν = 5/2,
+//
+// where r = |x-y|/ℓ, ℓ is the LengthScale and σ² the Variance. If Nu is
+// zero, it is defaulted to 2.5, and if LengthScale or Variance is zero, it is
+// defaulted to 1. Cov panics if Nu is not one of the supported values.
+type MaternKernel struct {
+	Nu          float64
+	LengthScale float64
+	Variance    float64
+}
+
+func (k MaternKernel) Cov(x, y []float64) float64 {
+	r := scaledDistance(x, y, k.LengthScale)
+	v := kernelVariance(k.Variance)
+	switch k.Nu {
+	case 0.5:
+		return v * math.Exp(-r)
+	case 1.5:
+		s := math.Sqrt(3) * r
+		return v * (1 + s) * math.Exp(-s)
+	case 0, 2.5:
+		s := math.Sqrt(5) * r
+		return v * (1 + s + s*s/3) * math.Exp(-s)
+	default:
+		panic("optimize: unsupported Matérn smoothness")
+	}
+}
+
+// scaledDistance returns the Euclidean distance between x and y divided by
+// the length scale l, or by 1 if l is zero.
+func scaledDistance(x, y []float64, l float64) float64 {
+	if l == 0 {
+		l = 1
+	}
+	return floats.Distance(x, y, 2) / l
+}
+
+func kernelVariance(v float64) float64 {
+	if v == 0 {
+		return 1
+	}
+	return v
+}
+
+var (
+	_ Statuser = (*BayesianOptimization)(nil)
+	_ Method   = (*BayesianOptimization)(nil)
+)
+
+// BayesianOptimization implements Bayesian optimization of an expensive
+// function over a bounded box. The function is modeled by a Gaussian-process
+// surrogate fitted to all evaluations so far, and the next locations to be
+// evaluated are the maximizers of an acquisition function of the posterior
+// distribution of the surrogate, which balances sampling where the
+// surrogate predicts low function values and where it is uncertain.
+//
+// The surrogate is fitted to the locations scaled to the unit box and to the
+// function values standardized to zero mean and unit variance. Infinite and
+// NaN function values are replaced by the largest finite value. The initial
+// design consists of the initial location and a Latin hypercube sample of
+// the box.
+//
+// After the initial design, BatchSize locations are proposed in each major
+// iteration and evaluated concurrently according to Settings.Concurrent.
+// The locations of a batch are chosen sequentially using the kriging
+// believer heuristic, where the surrogate is updated with the posterior mean
+// at each chosen location as if it had been evaluated.
+//
+// BayesianOptimization does not converge by itself, and the number of
+// evaluations should be limited with Settings.FuncEvaluations. The cost of
+// an iteration grows with the cube of the number of evaluations, so the
+// method is suited to functions whose evaluation is much more expensive.
+//
+// References:
+//   - Jones, D.R., Schonlau, M., Welch, W.J.: Efficient global optimization
+//     of expensive black-box functions. Journal of Global Optimization
+//     13(4) (1998), 455-492
+//   - Ginsbourger, D., Le Riche, R., Carraro, L.: Kriging is well-suited to
+//     parallelize optimization. In: Computational Intelligence in Expensive
+//     Optimization Problems, Springer (2010), 131-162
+type BayesianOptimization struct {
+	// Lower and Upper are the lower and upper bounds on the variables.
+	// They must have length equal to the problem dimension, and they must
+	// be finite with Lower[i] <= Upper[i], otherwise BayesianOptimization
+	// will panic.
+	Lower, Upper []float64
+
+	// Kernel is the covariance function of the surrogate in the scaled
+	// coordinates of the unit box. If Kernel is nil, a MaternKernel with
+	// ν = 5/2 is used, whose length scale is chosen in each iteration by
+	// maximizing the marginal likelihood of the evaluations.
+	Kernel GPKernel
+	// Noise is the variance of the noise of the standardized function
+	// values. If Noise is 0, it is defaulted to 1e-6. It is increased if the
+	// covariance matrix of the surrogate is not positive definite.
+	Noise float64
+
+	// Acquisition is the acquisition function.
+	Acquisition Acquisition
+	// Kappa is the weight κ of the standard deviation in the
+	// UpperConfidenceBound acquisition function. If Kappa is 0, it is
+	// defaulted to 2.
+	Kappa float64
+	// Xi is the minimum improvement ξ of the standardized function value in
+	// the ExpectedImprovement and ProbabilityOfImprovement acquisition
+	// functions. Xi must not be negative.
+	Xi float64
+
+	// InitSamples is the number of locations in the initial design,
+	// including the initial location. If InitSamples is 0, it is defaulted
+	// to max(2*dim, 5).
+	InitSamples int
+	// BatchSize is the number of locations proposed in each major
+	// iteration. If BatchSize is 0, it is defaulted to the number of
+	// concurrent evaluations.
+	BatchSize int
+	// AcquisitionSamples is the number of random locations at which the
+	// acquisition function is evaluated before the best of them is refined
+	// by a local search. If AcquisitionSamples is 0, it is defaulted to
+	// 1000.
+	AcquisitionSamples int
+
+	// Src allows a random number generator to be supplied for generating
+	// samples. If Src is nil the generator in golang.org/x/math/rand is
+	// used.
+	Src rand.Source
+
+	rnd     *rand.Rand
+	box     box
+	dim     int
+	noise   float64
+	kappa   float64
+	xi      float64
+	initN   int
+	batch   int
+	samples int
+
+	x0 []float64
+	// xs and fs hold the evaluated locations in unit coordinates and their
+	// function values. next holds the locations of the next generation.
+	xs          [][]float64
+	fs          []float64
+	next        *mat.Dense
+	initialized bool
+
+	runner generationRunner
+}
+
+// Status returns the status of the method.
+func (bo *BayesianOptimization) Status() (Status, error) {
+	return bo.runner.status(), nil
+}
+
+func (*BayesianOptimization) Uses(has Available) (uses Available, err error) {
+	return has.function()
+}
+
+func (bo *BayesianOptimization) Init(dim, tasks int) int {
+	if dim <= 0 {
+		panic(nonpositiveDimension)
+	}
+	if tasks < 0 {
+		panic(negativeTasks)
+	}
+	if bo.Lower == nil || bo.Upper == nil {
+		panic("bayesian optimization: bounds are required")
+	}
+	bo.box.init("bayesian optimization", dim, bo.Lower, bo.Upper, 1)
+	for i, l := range bo.box.lower {
+		if math.IsInf(l, 0) || math.IsInf(bo.box.upper[i], 0) {
+			panic("bayesian optimization: bounds must be finite")
+		}
+	}
+	bo.noise = bo.Noise
+	if bo.noise == 0 {
+		bo.noise = 1e-6
+	} else if bo.noise < 0 {
+		panic("bayesian optimization: negative noise")
+	}
+	bo.kappa = bo.Kappa
+	if bo.kappa == 0 {
+		bo.kappa = 2
+	} else if bo.kappa < 0 {
+		panic("bayesian optimization: negative kappa")
+	}
+	bo.xi = bo.Xi
+	if bo.xi < 0 {
+		panic("bayesian optimization: negative xi")
+	}
+	bo.initN = bo.InitSamples
+	if bo.initN == 0 {
+		bo.initN = max(2*dim, 5)
+	} else if bo.initN < 0 {
+		panic("bayesian optimization: negative number of initial samples")
+	}
+	bo.batch = bo.BatchSize
+	if bo.batch == 0 {
+		bo.batch = max(tasks, 1)
+	} else if bo.batch < 0 {
+		panic("bayesian optimization: negative batch size")
+	}
+	bo.samples = bo.AcquisitionSamples
+	if bo.samples == 0 {
+		bo.samples = 1000
+	} else if bo.samples < 0 {
+		panic("bayesian optimization: negative number of acquisition samples")
+	}
+
+	bo.rnd = newRand(bo.Src)
+	bo.dim = dim
+	bo.x0 = resize(bo.x0, dim)
+	bo.xs = bo.xs[:0]
+	bo.fs = bo.fs[:0]
+	size := max(bo.initN, bo.batch)
+	bo.next = mat.NewDense(size, dim, nil)
+	bo.initialized = false
+	bo.runner.init(dim, size)
+	return min(tasks, size)
+}
+
+func (bo *BayesianOptimization) Run(operations chan<- Task, results <-chan Task, tasks []Task) {
+	copy(bo.x0, tasks[0].X)
+	bo.box.project(bo.x0)
+	bo.initialDesign()
+	bo.runner.run(bo, operations, results, tasks)
+}
+
+// initialDesign stores the initial location and a Latin hypercube sample of
+// the box in the rows of next.
+func (bo *BayesianOptimization) initialDesign() {
+	n := bo.initN - 1
+	perm := make([]int, n)
+	for j := 0; j < bo.dim; j++ {
+		for i := range perm {
+			perm[i] = i
+		}
+		bo.rnd.Shuffle(n, func(a, b int) { perm[a], perm[b] = perm[b], perm[a] })
+		for i, p := range perm {
+			u := (float64(p) + bo.rnd.Float64()) / float64(n)
+			bo.next.Set(i+1, j, bo.box.lower[j]+u*bo.box.scale[j])
+		}
+	}
+	bo.next.SetRow(0, bo.x0)
+}
+
+func (bo *BayesianOptimization) generationSize() int {
+	if !bo.initialized {
+		return bo.initN
+	}
+	return bo.batch
+}
+
+func (bo *BayesianOptimization) candidate(i int, x []float64) {
+	copy(x, bo.next.RawRowView(i))
+}
+
+func (bo *BayesianOptimization) update(xs *mat.Dense, fs []float64) bool {
+	n, _ := xs.Dims()
+	for i := 0; i < n; i++ {
+		u := make([]float64, bo.dim)
+		bo.toUnit(u, xs.RawRowView(i))
+		bo.xs = append(bo.xs, u)
+		bo.fs = append(bo.fs, fs[i])
+	}
+	bo.initialized = true
+	bo.propose()
+	return false
+}
+
+// toUnit stores the location x scaled to the unit box in u.
+func (bo *BayesianOptimization) toUnit(u, x []float64) {
+	for i, v := range x {
+		if bo.box.scale[i] == 0 {
+			u[i] = 0
+			continue
+		}
+		u[i] = (v - bo.box.lower[i]) / bo.box.scale[i]
+	}
+}
+
+// fromUnit stores the location u in the unit box scaled to the bounds in x.
+func (bo *BayesianOptimization) fromUnit(x, u []float64) {
+	for i, v := range u {
+		x[i] = bo.box.lower[i] + v*bo.box.scale[i]
+	}
+	bo.box.project(x)
+}
+
+// propose stores the next batch of locations in the rows of next.
+func (bo *BayesianOptimization) propose() {
+	xs := make([][]float64, len(bo.xs), len(bo.xs)+bo.batch)
+	copy(xs, bo.xs)
+	ys := standardize(bo.fs)
+
+	kernel := bo.Kernel
+	if kernel == nil {
+		kernel = bo.fitLengthScale(xs, ys)
+	}
+	var gp gpSurrogate
+	gp.fit(kernel, bo.noise, xs, ys)
+	for i := 0; i < bo.batch; i++ {
+		u := bo.maximizeAcquisition(&gp, ys)
+		bo.fromUnit(bo.next.RawRowView(i), u)
+		if i == bo.batch-1 {
+			break
+		}
+		// Kriging believer: pretend the posterior mean has been observed.
+		mean, _ := gp.predict(u)
+		xs = append(xs, u)
+		ys = append(ys, mean)
+		gp.fit(kernel, bo.noise, xs, ys)
+	}
+}
+
+// fitLengthScale returns the Matérn 5/2 kernel whose length scale maximizes
+// the marginal likelihood of ys at xs among a logarithmic grid of length
+// scales.
+func (bo *BayesianOptimization) fitLengthScale(xs [][]float64, ys []float64) GPKernel {
+	const (
+		minScale = 0.02
+		maxScale = 2
+		steps    = 12
+	)
+	sqrtDim := math.Sqrt(float64(bo.dim))
+	var best GPKernel
+	bestL := math.Inf(-1)
+	var gp gpSurrogate
+	for i := 0; i < steps; i++ {
+		l := minScale * math.Pow(maxScale/minScale, float64(i)/(steps-1)) * sqrtDim
+		k := MaternKernel{Nu: 2.5, LengthScale: l}
+		gp.fit(k, bo.noise, xs, ys)
+		if ll := gp.logLikelihood(ys); ll > bestL || best == nil {
+			best = k
+			bestL = ll
+		}
+	}
+	return best
+}
+
+// maximizeAcquisition returns the location in the unit box that maximizes
+// the acquisition function of gp, where ys are the standardized function
+// values of the surrogate.
+func (bo *BayesianOptimization) maximizeAcquisition(gp *gpSurrogate, ys []float64) []float64 {
+	fBest := floats.Min(ys)
+	acq := func(u []float64) float64 {
+		return bo.acquisition(gp, fBest, u)
+	}
+
+	// Sample uniformly in the box and around the best evaluations.
+	order := make([]int, len(bo.fs))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return ys[order[i]] < ys[order[j]] })
+	elite := order[:min(5, len(order))]
+
+	best := make([]float64, bo.dim)
+	bestA := math.Inf(-1)
+	u := make([]float64, bo.dim)
+	for i := 0; i < bo.samples; i++ {
+		if i%4 == 3 && len(elite) > 0 {
+			center := gp.xs[elite[bo.rnd.Intn(len(elite))]]
+			for j := range u {
+				u[j] = math.Min(math.Max(center[j]+0.1*bo.rnd.NormFloat64(), 0), 1)
+			}
+		} else {
+			for j := range u {
+				u[j] = bo.rnd.Float64()
+			}
+		}
+		if a := acq(u); a > bestA {
+			bestA = a
+			copy(best, u)
+		}
+	}
+
+	// Refine the best sample by a compass search.
+	const (
+		initStep = 0.05
+		minStep  = 1e-4
+	)
+	maxEvals := 50 * bo.dim
+	var evals int
+	for step := initStep; step > minStep && evals < maxEvals; {
+		improved := false
+		for j := 0; j < bo.dim && evals < maxEvals; j++ {
+			for _, s := range [2]float64{step, -step} {
+				v := best[j]
+				best[j] = math.Min(math.Max(v+s, 0), 1)
+				evals++
+				if a := acq(best); a > bestA {
+					bestA = a
+					improved = true
+					break
+				}
+				best[j] = v
+			}
+		}
+		if !improved {
+			step /= 2
+		}
+	}
+	return best
+}
+
+// acquisition returns the value of the acquisition function at u, where
+// fBest is the best standardized function value.
+func (bo *BayesianOptimization) acquisition(gp *gpSurrogate, fBest float64, u []float64) float64 {
+	mean, sd := gp.predict(u)
+	switch bo.Acquisition {
+	default:
+		panic("bayesian optimization: unknown acquisition function")
+	case ExpectedImprovement:
+		imp := fBest - bo.xi - mean
+		if sd == 0 {
+			return math.Max(imp, 0)
+		}
+		z := imp / sd
+		return imp*normalCDF(z) + sd*math.Exp(-0.5*z*z)/math.Sqrt(2*math.Pi)
+	case UpperConfidenceBound:
+		return -mean + bo.kappa*sd
+	case ProbabilityOfImprovement:
+		imp := fBest - bo.xi - mean
+		if sd == 0 {
+			if imp > 0 {
+				return 1
+			}
+			return 0
+		}
+		return normalCDF(imp / sd)
+	}
+}
+
+// normalCDF returns the cumulative distribution function of the standard
+// normal distribution at z.
+func normalCDF(z float64) float64 {
+	return 0.5 * math.Erfc(-z/math.Sqrt2)
+}
+
+// standardize returns the function values fs standardized to zero mean and
+// unit variance, where infinite and NaN values are replaced by the largest
+// finite value.
+func standardize(fs []float64) []float64 {
+	ys := make([]float64, len(fs), len(fs)+1)
+	worst := math.Inf(-1)
+	for _, f := range fs {
+		if !math.IsInf(f, 0) && !math.IsNaN(f) {
+			worst = math.Max(worst, f)
+		}
+	}
+	if math.IsInf(worst, -1) {
+		// There are no finite values.
+		return ys
+	}
+	for i, f := range fs {
+		if math.IsInf(f, 0) || math.IsNaN(f) {
+			f = worst
+		}
+		ys[i] = f
+	}
+	mean := floats.Sum(ys) / float64(len(ys))
+	var ss float64
+	for _, y := range ys {
+		ss += (y - mean) * (y - mean)
+	}
+	sd := math.Sqrt(ss / float64(len(ys)))
+	if sd == 0 {
+		sd = 1
+	}
+	for i, y := range ys {
+		ys[i] = (y - mean) / sd
+	}
+	return ys
+}
+
+// gpSurrogate is the posterior of a Gaussian process with zero prior mean
+// conditioned on noisy observations.
+type gpSurrogate struct {
+	kernel GPKernel
+	xs     [][]float64
+	chol   mat.Cholesky
+	u      mat.TriDense
+	alpha  *mat.VecDense
+
+	k, v *mat.VecDense
+}
+
+// fit conditions the Gaussian process with the given kernel on the
+// observations ys at xs with the noise variance. The noise is increased
+// until the covariance matrix is positive definite.
+func (gp *gpSurrogate) fit(kernel GPKernel, noise float64, xs [][]float64, ys []float64) {
+	n := len(xs)
+	gp.kernel = kernel
+	gp.xs = xs
+	cov := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			cov.SetSym(i, j, kernel.Cov(xs[i], xs[j]))
+		}
+	}
+	for {
+		for i := 0; i < n; i++ {
+			cov.SetSym(i, i, kernel.Cov(xs[i], xs[i])+noise)
+		}
+		if gp.chol.Factorize(cov) {
+			break
+		}
+		noise *= 10
+		if noise > 1e10 {
+			panic("bayesian optimization: bad covariance matrix")
+		}
+	}
+	gp.u.Reset()
+	gp.chol.UTo(&gp.u)
+	gp.alpha = mat.NewVecDense(n, nil)
+	err := gp.chol.SolveVecTo(gp.alpha, mat.NewVecDense(n, ys))
+	if err != nil && !isConditionError(err) {
+		panic(err)
+	}
+	gp.k = mat.NewVecDense(n, nil)
+	gp.v = mat.NewVecDense(n, nil)
+}
+
+// predict returns the posterior mean and standard deviation at x.
+func (gp *gpSurrogate) predict(x []float64) (mean, sd float64) {
+	for i, xi := range gp.xs {
+		gp.k.SetVec(i, gp.kernel.Cov(x, xi))
+	}
+	mean = mat.Dot(gp.k, gp.alpha)
+	// The posterior variance is k(x, x) - kᵀ K⁻¹ k = k(x, x) - |L⁻¹ k|²,
+	// where K = L Lᵀ.
+	err := gp.v.SolveVec(gp.u.T(), gp.k)
+	if err != nil && !isConditionError(err) {
+		panic(err)
+	}
+	variance := gp.kernel.Cov(x, x) - mat.Dot(gp.v, gp.v)
+	return mean, math.Sqrt(math.Max(variance, 0))
+}
+
+// logLikelihood returns the log marginal likelihood of the observations ys.
+func (gp *gpSurrogate) logLikelihood(ys []float64) float64 {
+	n := len(ys)
+	return -0.5*mat.Dot(mat.NewVecDense(n, ys), gp.alpha) - 0.5*gp.chol.LogDet() - 0.5*float64(n)*math.Log(2*math.Pi)
+}
+
+func isConditionError(err error) bool {
+	_, ok := err.(mat.Condition)
+	return ok
+}
@@ -0,0 +1,195 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"fmt"
+	"math"
+	"sync/atomic"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/optimize/functions"
+)
+
+func TestBayesianOptimization(t *testing.T) {
+	t.Parallel()
+	const braninMin = 0.39788735772973816
+	lower := []float64{-5, 0}
+	upper := []float64{10, 15}
+	for _, test := range []struct {
+		name   string
+		method *BayesianOptimization
+		evals  int
+		tol    float64
+	}{
+		{
+			name:   "EI",
+			method: &BayesianOptimization{},
+			evals:  40,
+			tol:    0.01,
+		},
+		{
+			name:   "UCB",
+			method: &BayesianOptimization{Acquisition: UpperConfidenceBound},
+			evals:  40,
+			tol:    0.05,
+		},
+		{
+			name:   "PI",
+			method: &BayesianOptimization{Acquisition: ProbabilityOfImprovement, Xi: 0.01},
+			evals:  40,
+			tol:    0.05,
+		},
+		{
+			name:   "EI squared exponential",
+			method: &BayesianOptimization{Kernel: SquaredExponentialKernel{LengthScale: 0.3}},
+			evals:  40,
+			tol:    0.05,
+		},
+		{
+			name:   "EI Matérn 3/2",
+			method: &BayesianOptimization{Kernel: MaternKernel{Nu: 1.5, LengthScale: 0.3}},
+			evals:  40,
+			tol:    0.05,
+		},
+	} {
+		for _, concurrent := range []int{0, 4} {
+			name := fmt.Sprintf("%s concurrent %d", test.name, concurrent)
+			var outside int32
+			problem := Problem{
+				Func: func(x []float64) float64 {
+					for i, v := range x {
+						if v < lower[i] || upper[i] < v {
+							atomic.StoreInt32(&outside, 1)
+						}
+					}
+					return functions.BraninHoo{}.Func(x)
+				},
+			}
+			method := *test.method
+			method.Lower = lower
+			method.Upper = upper
+			method.Src = rand.NewSource(1)
+			settings := &Settings{
+				Converger:       NeverTerminate{},
+				FuncEvaluations: test.evals,
+				Concurrent:      concurrent,
+			}
+			result, err := Minimize(problem, []float64{0, 0}, settings, &method)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			if outside != 0 {
+				t.Errorf("%s: function evaluated outside the bounds", name)
+			}
+			if result.Status != FunctionEvaluationLimit {
+				t.Errorf("%s: unexpected status: got %v, want %v", name, result.Status, FunctionEvaluationLimit)
+			}
+			// Evaluations in progress are completed after the limit is
+			// reached.
+			if result.FuncEvaluations > test.evals+concurrent {
+				t.Errorf("%s: too many evaluations: got %d, want at most %d", name, result.FuncEvaluations, test.evals+concurrent)
+			}
+			if result.F-braninMin > test.tol {
+				t.Errorf("%s: unexpected minimum value: got %v, want %v", name, result.F, braninMin)
+			}
+		}
+	}
+}
+
+func TestBayesianOptimizationInfinite(t *testing.T) {
+	t.Parallel()
+	// Evaluations that fail are treated as the worst value found.
+	problem := Problem{
+		Func: func(x []float64) float64 {
+			if x[0] > 0.5 {
+				return math.NaN()
+			}
+			return (x[0]-0.2)*(x[0]-0.2) + (x[1]-0.3)*(x[1]-0.3)
+		},
+	}
+	method := &BayesianOptimization{
+		Lower: []float64{-1, -1},
+		Upper: []float64{1, 1},
+		Src:   rand.NewSource(1),
+	}
+	settings := &Settings{
+		Converger:       NeverTerminate{},
+		FuncEvaluations: 30,
+	}
+	result, err := Minimize(problem, []float64{0.9, 0.9}, settings, method)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.F > 1e-3 {
+		t.Errorf("unexpected minimum value: got %v, want 0", result.F)
+	}
+}
+
+func TestGPKernels(t *testing.T) {
+	t.Parallel()
+	x := []float64{0.1, 0.2}
+	y := []float64{0.4, 0.6}
+	r := 0.5 / 0.25
+	for _, test := range []struct {
+		name   string
+		kernel GPKernel
+		want   float64
+	}{
+		{
+			name:   "squared exponential",
+			kernel: SquaredExponentialKernel{LengthScale: 0.25, Variance: 2},
+			want:   2 * math.Exp(-0.5*r*r),
+		},
+		{
+			name:   "Matérn 1/2",
+			kernel: MaternKernel{Nu: 0.5, LengthScale: 0.25, Variance: 2},
+			want:   2 * math.Exp(-r),
+		},
+		{
+			name:   "Matérn 3/2",
+			kernel: MaternKernel{Nu: 1.5, LengthScale: 0.25, Variance: 2},
+			want:   2 * (1 + math.Sqrt(3)*r) * math.Exp(-math.Sqrt(3)*r),
+		},
+		{
+			name:   "Matérn 5/2",
+			kernel: MaternKernel{LengthScale: 0.25, Variance: 2},
+			want:   2 * (1 + math.Sqrt(5)*r + 5*r*r/3) * math.Exp(-math.Sqrt(5)*r),
+		},
+	} {
+		if got := test.kernel.Cov(x, y); !scalar.EqualWithinAbsOrRel(got, test.want, 1e-14, 1e-14) {
+			t.Errorf("%s: unexpected covariance: got %v, want %v", test.name, got, test.want)
+		}
+		if got := test.kernel.Cov(x, x); got != 2 {
+			t.Errorf("%s: unexpected variance: got %v, want 2", test.name, got)
+		}
+	}
+	if !panics(func() { MaternKernel{Nu: 1}.Cov(x, y) }) {
+		t.Errorf("expected panic for unsupported smoothness")
+	}
+}
+
+func TestBayesianOptimizationPanics(t *testing.T) {
+	t.Parallel()
+	lower := []float64{0, 0}
+	upper := []float64{1, 1}
+	for k, method := range []Method{
+		&BayesianOptimization{},
+		&BayesianOptimization{Lower: lower},
+		&BayesianOptimization{Lower: []float64{0, math.Inf(-1)}, Upper: upper},
+		&BayesianOptimization{Lower: []float64{0}, Upper: []float64{1}},
+		&BayesianOptimization{Lower: lower, Upper: upper, Noise: -1},
+		&BayesianOptimization{Lower: lower, Upper: upper, BatchSize: -1},
+		&BayesianOptimization{Lower: lower, Upper: upper, InitSamples: -1},
+	} {
+		if !panics(func() { method.Init(2, 1) }) {
+			t.Errorf("method %d: expected panic for invalid settings", k)
+		}
+	}
+}
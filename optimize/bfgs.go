@@ -5,15 +5,18 @@
 package optimize
 
 import (
+	"encoding"
 	"math"
 
 	"gonum.org/v1/gonum/mat"
 )
 
 var (
-	_ Method          = (*BFGS)(nil)
-	_ localMethod     = (*BFGS)(nil)
-	_ NextDirectioner = (*BFGS)(nil)
+	_ Method                     = (*BFGS)(nil)
+	_ localMethod                = (*BFGS)(nil)
+	_ NextDirectioner            = (*BFGS)(nil)
+	_ encoding.BinaryMarshaler   = (*BFGS)(nil)
+	_ encoding.BinaryUnmarshaler = (*BFGS)(nil)
 )
 
 // BFGS implements the Broyden–Fletcher–Goldfarb–Shanno optimization method. It
@@ -21,6 +24,9 @@ var (
 // estimate of the inverse Hessian of the objective function. It exhibits
 // super-linear convergence when in proximity to a local minimum. It has memory
 // cost that is O(n^2) relative to the input dimension.
+//
+// The state of BFGS at a major iteration can be saved with MarshalBinary and
+// restored with UnmarshalBinary to continue the optimization with Resume.
 type BFGS struct {
 	// Linesearcher selects suitable steps along the descent direction.
 	// Accepted steps should satisfy the strong Wolfe conditions.
@@ -45,7 +51,8 @@ type BFGS struct {
 
 	invHess *mat.SymDense
 
-	first bool // Indicator of the first iteration.
+	first    bool // Indicator of the first iteration.
+	restored bool // Indicator of the state restored by UnmarshalBinary.
 }
 
 func (b *BFGS) Status() (Status, error) {
@@ -59,6 +66,9 @@ func (*BFGS) Uses(has Available) (uses Available, err error) {
 func (b *BFGS) Init(dim, tasks int) int {
 	b.status = NotTerminated
 	b.err = nil
+	if !b.restored {
+		b.dim = 0
+	}
 	return 1
 }
 
@@ -85,6 +95,15 @@ func (b *BFGS) iterateLocal(loc *Location) (Operation, error) {
 }
 
 func (b *BFGS) InitDirection(loc *Location, dir []float64) (stepSize float64) {
+	if b.restored {
+		// Continue from the major iteration at which the state was saved.
+		b.restored = false
+		if len(loc.X) != b.dim {
+			panic("bfgs: restored state does not match the problem dimension")
+		}
+		return b.NextDirection(loc, dir)
+	}
+
 	dim := len(loc.X)
 	b.dim = dim
 	b.first = true
@@ -190,3 +209,63 @@ func (*BFGS) needs() struct {
 		Hessian  bool
 	}{true, false}
 }
+
+// MarshalBinary encodes the state of the method at the last major iteration
+// into a binary form and returns the result.
+func (b *BFGS) MarshalBinary() ([]byte, error) {
+	var e encoder
+	e.header("bfgs")
+	e.int(b.dim)
+	if b.dim == 0 {
+		// The optimization has not started.
+		return e.buf, nil
+	}
+	e.floats(b.x.RawVector().Data)
+	e.floats(b.grad.RawVector().Data)
+	e.bool(b.first)
+	for i := 0; i < b.dim; i++ {
+		for j := i; j < b.dim; j++ {
+			e.float64(b.invHess.At(i, j))
+		}
+	}
+	return e.buf, nil
+}
+
+// UnmarshalBinary restores the state of the method from the binary form
+// returned by MarshalBinary. The next optimization with the method continues
+// from the restored state. UnmarshalBinary returns ErrCheckpointMethod if data
+// is not the state of a BFGS, and ErrBadCheckpoint if data is malformed.
+func (b *BFGS) UnmarshalBinary(data []byte) error {
+	d := decoder{buf: data}
+	d.header("bfgs")
+	dim := d.int()
+	if d.err == nil && dim == 0 {
+		b.restored = false
+		return d.finish()
+	}
+	x := d.floats()
+	grad := d.floats()
+	first := d.bool()
+	if d.err == nil && (dim < 0 || len(x) != dim || len(grad) != dim || dim*(dim+1)/2 != d.remaining()) {
+		return ErrBadCheckpoint
+	}
+	invHess := mat.NewSymDense(max(dim, 1), nil)
+	for i := 0; i < dim; i++ {
+		for j := i; j < dim; j++ {
+			invHess.SetSym(i, j, d.float64())
+		}
+	}
+	if err := d.finish(); err != nil {
+		return err
+	}
+	b.dim = dim
+	b.x.CloneFromVec(mat.NewVecDense(dim, x))
+	b.grad.CloneFromVec(mat.NewVecDense(dim, grad))
+	b.s.Reset()
+	b.y.Reset()
+	b.tmp.Reset()
+	b.invHess = invHess
+	b.first = first
+	b.restored = true
+	return nil
+}
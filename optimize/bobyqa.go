@@ -0,0 +1,874 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	defaultInitRadius  = 0.5
+	defaultFinalRadius = 1e-6
+	// interpCondTol is the largest condition number of the interpolation
+	// system that is solved by LU factorization. Systems with a larger
+	// condition number are solved in the least-squares sense with the
+	// singular values below interpRcond relative to the largest treated as
+	// zero.
+	interpCondTol = 1e12
+	interpRcond   = 1e-13
+	// interpPoisedTol is the bound on the magnitude of the Lagrange functions
+	// in the trust region of a well poised interpolation set.
+	interpPoisedTol = 2
+)
+
+var (
+	_ Method   = (*BOBYQA)(nil)
+	_ Statuser = (*BOBYQA)(nil)
+	_ Method   = (*NEWUOA)(nil)
+	_ Statuser = (*NEWUOA)(nil)
+)
+
+// BOBYQA implements Powell's BOBYQA method for derivative-free minimization
+// subject to simple bounds on the variables
+//
+//	Lower[i] <= x[i] <= Upper[i].
+//
+// BOBYQA is a trust-region method that approximates the objective function
+// by a quadratic model interpolating the function values at NumPoints
+// locations. The model is updated with a single new function value at each
+// iteration, and the freedom left in the model by the interpolation
+// conditions is taken up by minimizing the Frobenius norm of the change of
+// the Hessian of the model. This makes BOBYQA suitable for expensive
+// objective functions, where it typically needs far fewer evaluations than
+// NelderMead.
+//
+// The trust-region radius is bounded below by a resolution ρ that starts at
+// InitRadius and is reduced when no further progress can be made, until it
+// reaches FinalRadius. BOBYQA then terminates with MethodConverge. All
+// locations at which the objective function is evaluated are feasible. The
+// initial location is moved onto the feasible region, and onto a bound if it
+// is closer to the bound than InitRadius.
+//
+// The interpolation system is solved directly as in Py-BOBYQA instead of
+// updating its inverse as in Powell's implementation, which trades some
+// linear algebra per iteration for numerical robustness. The function values
+// at the initial interpolation locations are evaluated concurrently. BOBYQA
+// terminates with ErrFunc if the objective function returns NaN or +Inf.
+//
+// References:
+//   - Powell, M.J.D.: The BOBYQA algorithm for bound constrained optimization
+//     without derivatives. Technical Report DAMTP 2009/NA06, University of
+//     Cambridge (2009)
+//   - Cartis, C., Fiala, J., Marteau, B., Roberts, L.: Improving the
+//     flexibility and robustness of model-based derivative-free optimization
+//     solvers. ACM Transactions on Mathematical Software 45(3) (2019), 32:1-32:41
+type BOBYQA struct {
+	// Lower and Upper are the lower and upper bounds on the variables. If
+	// Lower is nil, the variables are unbounded from below, and if Upper is
+	// nil, the variables are unbounded from above. Individual bounds may be
+	// infinite. If not nil, the lengths of Lower and Upper must equal the
+	// problem dimension, and Lower[i] < Upper[i] must hold for all i,
+	// otherwise BOBYQA will panic.
+	Lower, Upper []float64
+	// InitRadius is the initial trust-region radius, which should be about
+	// one tenth of the greatest expected change of a variable. It is reduced
+	// to half of the smallest distance between the bounds if necessary. If
+	// InitRadius is 0, it is defaulted to 0.5.
+	InitRadius float64
+	// FinalRadius is the final trust-region radius, which sets the accuracy
+	// of the location of the minimum. If FinalRadius is 0, it is defaulted
+	// to 1e-6.
+	FinalRadius float64
+	// NumPoints is the number of interpolation locations. It must be
+	// between dim+2 and (dim+1)(dim+2)/2. If NumPoints is 0, it is
+	// defaulted to 2*dim+1.
+	NumPoints int
+
+	status Status
+	err    error
+
+	bounds box
+	model  quadraticTrustRegion
+}
+
+func (b *BOBYQA) Status() (Status, error) {
+	return b.status, b.err
+}
+
+func (*BOBYQA) Uses(has Available) (uses Available, err error) {
+	return has.function()
+}
+
+func (b *BOBYQA) Init(dim, tasks int) int {
+	if dim <= 0 {
+		panic(nonpositiveDimension)
+	}
+	if tasks < 0 {
+		panic(negativeTasks)
+	}
+	b.bounds.init("bobyqa", dim, b.Lower, b.Upper, 0)
+	for i, l := range b.bounds.lower {
+		if l == b.bounds.upper[i] {
+			panic("bobyqa: inconsistent bounds")
+		}
+	}
+	npt := b.model.init("bobyqa", dim, b.NumPoints, b.InitRadius, b.FinalRadius)
+	b.status = NotTerminated
+	b.err = nil
+	return min(tasks, npt)
+}
+
+func (b *BOBYQA) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	e := newDFOEvaluator(operation, result, tasks)
+	b.status, b.err = b.model.run(e, tasks[0], b.bounds.lower, b.bounds.upper)
+	b.model.finish(e, b.status)
+}
+
+// NEWUOA implements Powell's NEWUOA method for unconstrained derivative-free
+// minimization. NEWUOA is the unconstrained predecessor of BOBYQA and uses
+// the same quadratic interpolation models and trust-region framework. See
+// the documentation of BOBYQA for the details of the method.
+//
+// Reference:
+//   - Powell, M.J.D.: The NEWUOA software for unconstrained optimization
+//     without derivatives. In: Large-Scale Nonlinear Optimization, pp.
+//     255-297. Springer (2006)
+type NEWUOA struct {
+	// InitRadius is the initial trust-region radius, which should be about
+	// one tenth of the greatest expected change of a variable. If InitRadius
+	// is 0, it is defaulted to 0.5.
+	InitRadius float64
+	// FinalRadius is the final trust-region radius, which sets the accuracy
+	// of the location of the minimum. If FinalRadius is 0, it is defaulted
+	// to 1e-6.
+	FinalRadius float64
+	// NumPoints is the number of interpolation locations. It must be
+	// between dim+2 and (dim+1)(dim+2)/2. If NumPoints is 0, it is
+	// defaulted to 2*dim+1.
+	NumPoints int
+
+	status Status
+	err    error
+
+	bounds box
+	model  quadraticTrustRegion
+}
+
+func (n *NEWUOA) Status() (Status, error) {
+	return n.status, n.err
+}
+
+func (*NEWUOA) Uses(has Available) (uses Available, err error) {
+	return has.function()
+}
+
+func (n *NEWUOA) Init(dim, tasks int) int {
+	if dim <= 0 {
+		panic(nonpositiveDimension)
+	}
+	if tasks < 0 {
+		panic(negativeTasks)
+	}
+	n.bounds.init("newuoa", dim, nil, nil, 0)
+	npt := n.model.init("newuoa", dim, n.NumPoints, n.InitRadius, n.FinalRadius)
+	n.status = NotTerminated
+	n.err = nil
+	return min(tasks, npt)
+}
+
+func (n *NEWUOA) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	e := newDFOEvaluator(operation, result, tasks)
+	n.status, n.err = n.model.run(e, tasks[0], n.bounds.lower, n.bounds.upper)
+	n.model.finish(e, n.status)
+}
+
+// quadraticTrustRegion implements the trust-region method with quadratic
+// interpolation models shared by BOBYQA and NEWUOA.
+type quadraticTrustRegion struct {
+	dim, npt       int
+	rhoBeg, rhoEnd float64
+
+	lower, upper []float64
+
+	// y holds the interpolation locations in its rows and fy the function
+	// values at them. kopt is the index of the best location.
+	y    *mat.Dense
+	fy   []float64
+	kopt int
+
+	rho, delta float64
+
+	// g and h are the gradient and the Hessian of the quadratic model at the
+	// best location.
+	g []float64
+	h *mat.SymDense
+
+	// The interpolation system is formed with the displacements of the
+	// locations from the best location in the rows of dy, which are scaled
+	// by 1/sigma for conditioning.
+	dy    *mat.Dense
+	sigma float64
+	lu    mat.LU
+	svd   mat.SVD
+	// rank is the numerical rank of the system if it is solved by SVD and
+	// -1 if it is solved by LU factorization.
+	rank int
+
+	// Storage for the solution of the interpolation system.
+	rhs, sol *mat.VecDense
+}
+
+// init sets the parameters of the method and returns the number of
+// interpolation locations.
+func (q *quadraticTrustRegion) init(name string, dim, npt int, rhoBeg, rhoEnd float64) int {
+	if npt == 0 {
+		npt = 2*dim + 1
+	}
+	if npt < dim+2 || npt > (dim+1)*(dim+2)/2 {
+		panic(name + ": invalid number of interpolation points")
+	}
+	if rhoBeg == 0 {
+		rhoBeg = defaultInitRadius
+	}
+	if rhoEnd == 0 {
+		rhoEnd = math.Min(defaultFinalRadius, rhoBeg)
+	}
+	if !(0 < rhoEnd && rhoEnd <= rhoBeg) {
+		panic(name + ": invalid trust region radii")
+	}
+	q.dim = dim
+	q.npt = npt
+	q.rhoBeg = rhoBeg
+	q.rhoEnd = rhoEnd
+	q.y = mat.NewDense(npt, dim, nil)
+	q.fy = resize(q.fy, npt)
+	q.g = resize(q.g, dim)
+	q.h = mat.NewSymDense(dim, nil)
+	q.dy = mat.NewDense(npt, dim, nil)
+	q.rhs = mat.NewVecDense(npt+dim+1, nil)
+	q.sol = mat.NewVecDense(npt+dim+1, nil)
+	return npt
+}
+
+// finish completes the optimization with the best location found.
+func (q *quadraticTrustRegion) finish(e *dfoEvaluator, status Status) {
+	var x []float64
+	var f float64
+	if q.kopt >= 0 {
+		x = q.y.RawRowView(q.kopt)
+		f = q.fy[q.kopt]
+	}
+	e.finish(x, f, status != NotTerminated)
+}
+
+// run runs the method from the initial task. It returns NotTerminated if
+// the optimization has been stopped by Minimize.
+func (q *quadraticTrustRegion) run(e *dfoEvaluator, task Task, lower, upper []float64) (Status, error) {
+	n, npt := q.dim, q.npt
+	q.lower = lower
+	q.upper = upper
+	q.kopt = -1
+	for i := range q.g {
+		q.g[i] = 0
+	}
+	q.h.Zero()
+
+	rho := q.rhoBeg
+	for i, l := range lower {
+		rho = math.Min(rho, 0.5*(upper[i]-l))
+	}
+	rhoEnd := math.Min(q.rhoEnd, rho)
+
+	// Move the initial location onto the nearest bound, or to a distance of
+	// at least rho from it, so that the initial interpolation locations are
+	// feasible.
+	x0 := make([]float64, n)
+	moved := false
+	for i, v := range task.X {
+		l, u := lower[i], upper[i]
+		switch {
+		case v <= l:
+			x0[i] = l
+		case v < l+rho:
+			x0[i] = l + rho
+		case v >= u:
+			x0[i] = u
+		case v > u-rho:
+			x0[i] = u - rho
+		default:
+			x0[i] = v
+		}
+		moved = moved || x0[i] != v
+	}
+
+	// The initial locations are x0, x0 + rho*e_i and x0 - rho*e_i, with the
+	// steps reversed or doubled at the bounds, followed by steps along two
+	// coordinates.
+	step := make([]float64, n)
+	for i := 0; i < npt; i++ {
+		row := q.y.RawRowView(i)
+		copy(row, x0)
+		switch {
+		case i == 0:
+		case i <= n:
+			j := i - 1
+			step[j] = rho
+			if x0[j] == upper[j] {
+				step[j] = -rho
+			}
+			row[j] += step[j]
+		case i <= 2*n:
+			j := i - n - 1
+			switch x0[j] {
+			case lower[j], upper[j]:
+				row[j] += 2 * step[j]
+			default:
+				row[j] -= step[j]
+			}
+		}
+	}
+	k := 2*n + 1
+	for p := 0; p < n && k < npt; p++ {
+		for r := p + 1; r < n && k < npt; r++ {
+			row := q.y.RawRowView(k)
+			row[p] += step[p]
+			row[r] += step[r]
+			k++
+		}
+	}
+	xs := make([][]float64, 0, npt)
+	first := 0
+	if !moved && task.Op&FuncEvaluation != 0 {
+		q.fy[0] = task.F
+		first = 1
+	}
+	for i := first; i < npt; i++ {
+		xs = append(xs, q.y.RawRowView(i))
+	}
+	if !e.evaluate(xs, q.fy[first:]) {
+		return NotTerminated, nil
+	}
+	for _, f := range q.fy {
+		if math.IsNaN(f) || math.IsInf(f, 1) {
+			return Failure, ErrFunc(f)
+		}
+	}
+	q.kopt = floats.MinIdx(q.fy)
+	if math.IsInf(q.fy[q.kopt], -1) {
+		return MethodConverge, nil
+	}
+	if !e.major(q.y.RawRowView(q.kopt), q.fy[q.kopt]) {
+		return NotTerminated, nil
+	}
+
+	q.rho = rho
+	q.delta = rho
+	s := make([]float64, n)
+	sl := make([]float64, n)
+	su := make([]float64, n)
+	xnew := make([]float64, n)
+	lag := make([]float64, npt)
+	improveGeometry := false
+	reduce := false
+	for {
+		q.buildModel()
+		xopt := q.y.RawRowView(q.kopt)
+		fopt := q.fy[q.kopt]
+		floats.SubTo(sl, lower, xopt)
+		floats.SubTo(su, upper, xopt)
+
+		if reduce {
+			// Reduce the resolution only if the model is accurate, that is
+			// if the interpolation set is well poised.
+			reduce = false
+			if k := q.poisedness(xnew, sl, su); k >= 0 {
+				f, ok := e.evaluateOne(xnew)
+				if !ok {
+					return NotTerminated, nil
+				}
+				if status, err := q.replace(k, xnew, f); status != NotTerminated {
+					return status, err
+				}
+				if !e.major(q.y.RawRowView(q.kopt), q.fy[q.kopt]) {
+					return NotTerminated, nil
+				}
+				continue
+			}
+			if q.rho <= rhoEnd {
+				return MethodConverge, nil
+			}
+			q.reduceRho(rhoEnd)
+			continue
+		}
+		if improveGeometry {
+			improveGeometry = false
+			k, _ := q.farthest()
+			if _, ok := q.geometryStep(xnew, k, sl, su); !ok {
+				// The bounds prevent any step, so continue with the
+				// next resolution.
+				if q.rho <= rhoEnd {
+					return MethodConverge, nil
+				}
+				q.reduceRho(rhoEnd)
+				continue
+			}
+			f, ok := e.evaluateOne(xnew)
+			if !ok {
+				return NotTerminated, nil
+			}
+			if status, err := q.replace(k, xnew, f); status != NotTerminated {
+				return status, err
+			}
+			if !e.major(q.y.RawRowView(q.kopt), q.fy[q.kopt]) {
+				return NotTerminated, nil
+			}
+			continue
+		}
+
+		boxTrustRegionStep(s, q.g, q.h, q.delta, sl, su)
+		dnorm := floats.Norm(s, 2)
+		if dnorm < 0.5*q.rho {
+			// The step is too short to be worth an evaluation. Improve the
+			// geometry of the interpolation set if there are distant
+			// locations, otherwise reduce the resolution.
+			q.delta = 0.1 * q.delta
+			if q.delta <= 1.5*q.rho {
+				q.delta = q.rho
+			}
+			if _, dist := q.farthest(); dist > 2*q.delta {
+				improveGeometry = true
+				continue
+			}
+			if k := q.poisedness(xnew, sl, su); k >= 0 {
+				f, ok := e.evaluateOne(xnew)
+				if !ok {
+					return NotTerminated, nil
+				}
+				if status, err := q.replace(k, xnew, f); status != NotTerminated {
+					return status, err
+				}
+				if !e.major(q.y.RawRowView(q.kopt), q.fy[q.kopt]) {
+					return NotTerminated, nil
+				}
+				continue
+			}
+			if q.rho <= rhoEnd {
+				return MethodConverge, nil
+			}
+			q.reduceRho(rhoEnd)
+			continue
+		}
+
+		for i, v := range s {
+			xnew[i] = math.Min(math.Max(xopt[i]+v, lower[i]), upper[i])
+		}
+		f, ok := e.evaluateOne(xnew)
+		if !ok {
+			return NotTerminated, nil
+		}
+		var hs mat.VecDense
+		hs.MulVec(q.h, mat.NewVecDense(n, s))
+		pred := -floats.Dot(q.g, s) - 0.5*floats.Dot(s, hs.RawVector().Data)
+		ratio := -1.0
+		if pred > 0 {
+			ratio = (fopt - f) / pred
+		}
+		switch {
+		case ratio <= 0.1:
+			q.delta = math.Min(0.5*q.delta, dnorm)
+		case ratio <= 0.7:
+			q.delta = math.Max(0.5*q.delta, dnorm)
+		default:
+			q.delta = math.Max(0.5*q.delta, 2*dnorm)
+		}
+		if q.delta <= 1.5*q.rho {
+			q.delta = q.rho
+		}
+
+		// Replace the location whose removal keeps the interpolation set
+		// best poised, preferring locations far from the best one.
+		q.lagrangeValues(lag, xnew)
+		center := xopt
+		if f < fopt {
+			center = xnew
+		}
+		knew := -1
+		var best float64
+		for k := range lag {
+			if k == q.kopt && !(f < fopt) {
+				continue
+			}
+			d2 := floats.Distance(q.y.RawRowView(k), center, 2) / q.delta
+			d2 *= d2
+			score := math.Abs(lag[k]) * math.Max(1, d2*d2)
+			if knew == -1 || score > best {
+				knew = k
+				best = score
+			}
+		}
+		if status, err := q.replace(knew, xnew, f); status != NotTerminated {
+			return status, err
+		}
+		if !e.major(q.y.RawRowView(q.kopt), q.fy[q.kopt]) {
+			return NotTerminated, nil
+		}
+
+		if ratio < 0.1 {
+			if _, dist := q.farthest(); dist > 2*q.delta {
+				improveGeometry = true
+			} else if math.Max(q.delta, dnorm) <= q.rho && ratio <= 0 {
+				reduce = true
+			}
+		}
+	}
+}
+
+// replace replaces interpolation location k by x with the function value f.
+func (q *quadraticTrustRegion) replace(k int, x []float64, f float64) (Status, error) {
+	if math.IsNaN(f) || math.IsInf(f, 1) {
+		return Failure, ErrFunc(f)
+	}
+	q.y.SetRow(k, x)
+	q.fy[k] = f
+	if f < q.fy[q.kopt] {
+		q.kopt = k
+	}
+	if math.IsInf(f, -1) {
+		return MethodConverge, nil
+	}
+	return NotTerminated, nil
+}
+
+// reduceRho reduces the resolution of the method.
+func (q *quadraticTrustRegion) reduceRho(rhoEnd float64) {
+	q.delta = 0.5 * q.rho
+	ratio := q.rho / rhoEnd
+	switch {
+	case ratio <= 16:
+		q.rho = rhoEnd
+	case ratio <= 250:
+		q.rho = math.Sqrt(ratio) * rhoEnd
+	default:
+		q.rho *= 0.1
+	}
+	q.delta = math.Max(q.delta, q.rho)
+}
+
+// farthest returns the index of the interpolation location farthest from
+// the best location and its distance.
+func (q *quadraticTrustRegion) farthest() (k int, dist float64) {
+	xopt := q.y.RawRowView(q.kopt)
+	for i := 0; i < q.npt; i++ {
+		if d := floats.Distance(q.y.RawRowView(i), xopt, 2); d > dist {
+			k = i
+			dist = d
+		}
+	}
+	return k, dist
+}
+
+// buildModel factorizes the interpolation system at the best location and
+// updates the quadratic model so that it interpolates the function values
+// with the least change of its Hessian in the Frobenius norm.
+//
+// The system for the scaled displacements d_k of the locations is
+//
+//	[A  e  D] [λ]   [r]
+//	[eᵀ 0  0] [c] = [0]
+//	[Dᵀ 0  0] [g]   [0]
+//
+// with A_kj = (d_kᵀ d_j)²/2, and the change of the Hessian is Σ λ_k d_k d_kᵀ.
+// The solutions for the unit vectors in place of r define the Lagrange
+// functions of the interpolation set.
+func (q *quadraticTrustRegion) buildModel() {
+	n, npt := q.dim, q.npt
+	xopt := q.y.RawRowView(q.kopt)
+	fopt := q.fy[q.kopt]
+	q.sigma = q.delta
+	for k := 0; k < npt; k++ {
+		d := q.dy.RawRowView(k)
+		floats.SubTo(d, q.y.RawRowView(k), xopt)
+		floats.Scale(1/q.sigma, d)
+	}
+	w := mat.NewDense(npt+n+1, npt+n+1, nil)
+	for k := 0; k < npt; k++ {
+		dk := q.dy.RawRowView(k)
+		for j := k; j < npt; j++ {
+			v := floats.Dot(dk, q.dy.RawRowView(j))
+			w.Set(k, j, 0.5*v*v)
+			w.Set(j, k, 0.5*v*v)
+		}
+		w.Set(k, npt, 1)
+		w.Set(npt, k, 1)
+		for i, v := range dk {
+			w.Set(k, npt+1+i, v)
+			w.Set(npt+1+i, k, v)
+		}
+	}
+	q.lu.Factorize(w)
+	q.rank = -1
+	if q.lu.Cond() > interpCondTol {
+		if !q.svd.Factorize(w, mat.SVDThin) {
+			panic("optimize: interpolation system factorization failed")
+		}
+		q.rank = q.svd.Rank(interpRcond)
+	}
+
+	// The right-hand side holds the residuals of the current model, which
+	// is exact at the best location.
+	rhs := q.rhs.RawVector().Data
+	for i := range rhs {
+		rhs[i] = 0
+	}
+	var hd mat.VecDense
+	for k := 0; k < npt; k++ {
+		dk := mat.NewVecDense(n, q.dy.RawRowView(k))
+		hd.MulVec(q.h, dk)
+		quad := 0.5 * q.sigma * q.sigma * mat.Dot(dk, &hd)
+		rhs[k] = q.fy[k] - fopt - q.sigma*floats.Dot(q.g, dk.RawVector().Data) - quad
+	}
+	q.solve()
+	sol := q.sol.RawVector().Data
+	// The residuals are relative to the current model, so the gradient and
+	// the Hessian are updated.
+	for i := range q.g {
+		q.g[i] += sol[npt+1+i] / q.sigma
+	}
+	var dh mat.SymDense
+	q.hessianChange(&dh, sol[:npt])
+	q.h.AddSym(q.h, &dh)
+}
+
+// solve solves the interpolation system for the right-hand side in rhs and
+// stores the solution into sol.
+func (q *quadraticTrustRegion) solve() {
+	if q.rank < 0 {
+		err := q.lu.SolveVecTo(q.sol, false, q.rhs)
+		if _, ok := err.(mat.Condition); err == nil || ok {
+			return
+		}
+	}
+	q.svd.SolveVecTo(q.sol, q.rhs, q.rank)
+}
+
+// hessianChange stores Σ λ_k d_k d_kᵀ / sigma² into dst.
+func (q *quadraticTrustRegion) hessianChange(dst *mat.SymDense, lambda []float64) {
+	dst.ReuseAsSym(q.dim)
+	dst.Zero()
+	for k, l := range lambda {
+		dst.SymRankOne(dst, l/(q.sigma*q.sigma), mat.NewVecDense(q.dim, q.dy.RawRowView(k)))
+	}
+}
+
+// lagrangeValues stores the values of the Lagrange functions of the
+// interpolation set at x into dst.
+func (q *quadraticTrustRegion) lagrangeValues(dst, x []float64) {
+	n, npt := q.dim, q.npt
+	xopt := q.y.RawRowView(q.kopt)
+	s := make([]float64, n)
+	floats.SubTo(s, x, xopt)
+	floats.Scale(1/q.sigma, s)
+	rhs := q.rhs.RawVector().Data
+	for k := 0; k < npt; k++ {
+		v := floats.Dot(q.dy.RawRowView(k), s)
+		rhs[k] = 0.5 * v * v
+	}
+	rhs[npt] = 1
+	copy(rhs[npt+1:], s)
+	q.solve()
+	copy(dst, q.sol.RawVector().Data[:npt])
+}
+
+// geometryStep stores into x a location near the best one that maximizes
+// the magnitude of the Lagrange function of location k, so that replacing
+// location k by x improves the poisedness of the interpolation set. It
+// returns the magnitude of the Lagrange function at x, or false if no step
+// exists within the bounds.
+func (q *quadraticTrustRegion) geometryStep(x []float64, k int, sl, su []float64) (float64, bool) {
+	n, npt := q.dim, q.npt
+	xopt := q.y.RawRowView(q.kopt)
+	dist := floats.Distance(q.y.RawRowView(k), xopt, 2)
+	radius := math.Max(math.Min(0.1*dist, 0.5*q.delta), q.rho)
+
+	// Form the gradient and the Hessian of the Lagrange function at the best
+	// location.
+	rhs := q.rhs.RawVector().Data
+	for i := range rhs {
+		rhs[i] = 0
+	}
+	rhs[k] = 1
+	q.solve()
+	sol := q.sol.RawVector().Data
+	g := make([]float64, n)
+	floats.ScaleTo(g, 1/q.sigma, sol[npt+1:])
+	var h mat.SymDense
+	q.hessianChange(&h, sol[:npt])
+
+	// The candidate steps minimize and maximize the Lagrange function in the
+	// trust region and move along the line towards location k.
+	candidates := make([][]float64, 4)
+	for i := range candidates {
+		candidates[i] = make([]float64, n)
+	}
+	boxTrustRegionStep(candidates[0], g, &h, radius, sl, su)
+	floats.Scale(-1, g)
+	h.ScaleSym(-1, &h)
+	boxTrustRegionStep(candidates[1], g, &h, radius, sl, su)
+	// The sign of the Lagrange function does not matter, so it is evaluated
+	// with the negated gradient and Hessian.
+	c := -sol[npt]
+	for i := range candidates[2] {
+		v := (q.y.At(k, i) - xopt[i]) * radius / dist
+		candidates[2][i] = math.Min(math.Max(v, sl[i]), su[i])
+		candidates[3][i] = math.Min(math.Max(-v, sl[i]), su[i])
+	}
+	var best float64
+	found := false
+	step := make([]float64, n)
+	var hs mat.VecDense
+	for _, s := range candidates {
+		if floats.Norm(s, 2) == 0 {
+			continue
+		}
+		for i, v := range s {
+			s[i] = math.Min(math.Max(xopt[i]+v, q.lower[i]), q.upper[i])
+		}
+		floats.SubTo(step, s, xopt)
+		sv := mat.NewVecDense(n, step)
+		hs.MulVec(&h, sv)
+		lag := c + floats.Dot(g, step) + 0.5*mat.Dot(sv, &hs)
+		if v := math.Abs(lag); !found || v > best {
+			copy(x, s)
+			best = v
+			found = true
+		}
+	}
+	return best, found
+}
+
+// poisedness checks whether the interpolation set is well poised in the
+// trust region, that is whether the magnitudes of all Lagrange functions
+// are bounded by interpPoisedTol. If not, it stores into x the location
+// that improves the poisedness the most and returns the index of the
+// location it replaces. Otherwise poisedness returns -1.
+func (q *quadraticTrustRegion) poisedness(x []float64, sl, su []float64) int {
+	worst := -1
+	lambda := float64(interpPoisedTol)
+	xk := make([]float64, q.dim)
+	for k := 0; k < q.npt; k++ {
+		if k == q.kopt {
+			continue
+		}
+		if v, ok := q.geometryStep(xk, k, sl, su); ok && v > lambda {
+			worst = k
+			lambda = v
+			copy(x, xk)
+		}
+	}
+	return worst
+}
+
+// boxTrustRegionStep approximately minimizes the quadratic model
+//
+//	gᵀs + sᵀ H s / 2
+//
+// subject to |s| <= delta and sl <= s <= su, where sl <= 0 <= su, and stores
+// the step into dst. The truncated conjugate gradient method is used, and
+// it is restarted with the variable fixed whenever a bound is reached.
+func boxTrustRegionStep(dst, g []float64, h mat.Symmetric, delta float64, sl, su []float64) {
+	n := len(g)
+	for i := range dst {
+		dst[i] = 0
+	}
+	fixed := make([]bool, n)
+	for i, v := range g {
+		fixed[i] = (sl[i] >= 0 && v > 0) || (su[i] <= 0 && v < 0)
+	}
+	gs := make([]float64, n) // Gradient of the model at dst.
+	copy(gs, g)
+	r := make([]float64, n)
+	d := make([]float64, n)
+	var hd mat.VecDense
+	tol := 1e-10 * floats.Norm(g, 2)
+Restart:
+	for restart := 0; restart <= n; restart++ {
+		var rr float64
+		for i := range r {
+			r[i] = 0
+			if !fixed[i] {
+				r[i] = -gs[i]
+			}
+			rr += r[i] * r[i]
+		}
+		copy(d, r)
+		for iter := 0; iter < n; iter++ {
+			if math.Sqrt(rr) <= tol {
+				return
+			}
+			hd.MulVec(h, mat.NewVecDense(n, d))
+			hdData := hd.RawVector().Data
+			dhd := floats.Dot(d, hdData)
+
+			// Find the step lengths to the trust-region boundary, to the
+			// nearest bound and to the minimum along d.
+			ss := floats.Dot(dst, dst)
+			sd := floats.Dot(dst, d)
+			dd := floats.Dot(d, d)
+			alphaTR := (math.Sqrt(math.Max(sd*sd+dd*(delta*delta-ss), 0)) - sd) / dd
+			alphaBd := math.Inf(1)
+			ibd := -1
+			for i, v := range d {
+				var a float64
+				switch {
+				case v > 0:
+					a = (su[i] - dst[i]) / v
+				case v < 0:
+					a = (sl[i] - dst[i]) / v
+				default:
+					continue
+				}
+				if a < alphaBd {
+					alphaBd = a
+					ibd = i
+				}
+			}
+			alphaCG := math.Inf(1)
+			if dhd > 0 {
+				alphaCG = rr / dhd
+			}
+			alpha := math.Min(alphaCG, math.Min(alphaTR, alphaBd))
+			floats.AddScaled(dst, alpha, d)
+			floats.AddScaled(gs, alpha, hdData)
+			switch alpha {
+			case alphaBd:
+				if d[ibd] > 0 {
+					dst[ibd] = su[ibd]
+				} else {
+					dst[ibd] = sl[ibd]
+				}
+				fixed[ibd] = true
+				if alpha == alphaTR {
+					return
+				}
+				continue Restart
+			case alphaTR:
+				return
+			}
+			rrOld := rr
+			rr = 0
+			for i := range r {
+				if !fixed[i] {
+					r[i] = -gs[i]
+				}
+				rr += r[i] * r[i]
+			}
+			floats.AddScaledTo(d, r, rr/rrOld, d)
+		}
+		return
+	}
+}
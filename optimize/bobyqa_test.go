@@ -0,0 +1,304 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"sync/atomic"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/optimize/functions"
+)
+
+func TestNEWUOA(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name   string
+		f      func([]float64) float64
+		x      []float64
+		method *NEWUOA
+		wantF  float64
+		tol    float64
+		maxEva int
+	}{
+		{
+			name:   "Beale",
+			f:      functions.Beale{}.Func,
+			x:      []float64{1, 1},
+			method: &NEWUOA{FinalRadius: 1e-8},
+			tol:    1e-12,
+			maxEva: 200,
+		},
+		{
+			name:   "BrownBadlyScaled",
+			f:      functions.BrownBadlyScaled{}.Func,
+			x:      []float64{1, 1},
+			method: &NEWUOA{FinalRadius: 1e-8},
+			tol:    1e-12,
+			maxEva: 1000,
+		},
+		{
+			name:   "ExtendedPowellSingular",
+			f:      functions.ExtendedPowellSingular{}.Func,
+			x:      []float64{3, -1, 0, 3},
+			method: &NEWUOA{FinalRadius: 1e-8},
+			tol:    1e-12,
+			maxEva: 1500,
+		},
+		{
+			name:   "ExtendedRosenbrock",
+			f:      functions.ExtendedRosenbrock{}.Func,
+			x:      []float64{-1.2, 1},
+			method: &NEWUOA{FinalRadius: 1e-8},
+			tol:    1e-12,
+			maxEva: 300,
+		},
+		{
+			name:   "ExtendedRosenbrockMinPoints",
+			f:      functions.ExtendedRosenbrock{}.Func,
+			x:      []float64{-1.2, 1, -1.2, 1},
+			method: &NEWUOA{NumPoints: 6, FinalRadius: 1e-8},
+			tol:    1e-10,
+			maxEva: 2000,
+		},
+		{
+			name:   "ExtendedRosenbrockMaxPoints",
+			f:      functions.ExtendedRosenbrock{}.Func,
+			x:      []float64{-1.2, 1, -1.2, 1},
+			method: &NEWUOA{NumPoints: 15, FinalRadius: 1e-8},
+			tol:    1e-10,
+			maxEva: 2000,
+		},
+		{
+			name:   "ExtendedRosenbrock20",
+			f:      functions.ExtendedRosenbrock{}.Func,
+			x:      make([]float64, 20),
+			method: &NEWUOA{},
+			tol:    1e-8,
+			maxEva: 5000,
+		},
+		{
+			name:   "Watson",
+			f:      functions.Watson{}.Func,
+			x:      make([]float64, 6),
+			method: &NEWUOA{FinalRadius: 1e-8},
+			wantF:  0.0022876700535523838,
+			tol:    1e-12,
+			maxEva: 1500,
+		},
+	} {
+		var first *Result
+		for _, concurrent := range []int{0, 4} {
+			settings := &Settings{
+				Converger:  NeverTerminate{},
+				Concurrent: concurrent,
+			}
+			result, err := Minimize(Problem{Func: test.f}, test.x, settings, test.method)
+			if err != nil {
+				t.Errorf("%s (concurrent %d): unexpected error: %v", test.name, concurrent, err)
+				continue
+			}
+			if result.Status != MethodConverge {
+				t.Errorf("%s (concurrent %d): unexpected status: got %v, want %v", test.name, concurrent, result.Status, MethodConverge)
+			}
+			if math.Abs(result.F-test.wantF) > test.tol {
+				t.Errorf("%s (concurrent %d): unexpected minimum: got %v, want %v", test.name, concurrent, result.F, test.wantF)
+			}
+			if f := test.f(result.X); f != result.F {
+				t.Errorf("%s (concurrent %d): function value at the minimum %v does not match the result %v", test.name, concurrent, f, result.F)
+			}
+			if result.FuncEvaluations > test.maxEva {
+				t.Errorf("%s (concurrent %d): too many function evaluations: got %d, want at most %d", test.name, concurrent, result.FuncEvaluations, test.maxEva)
+			}
+			// The method is deterministic and only the initial evaluations
+			// are concurrent.
+			if first == nil {
+				first = result
+			} else if result.F != first.F || !floats.Equal(result.X, first.X) || result.FuncEvaluations != first.FuncEvaluations {
+				t.Errorf("%s: result depends on the concurrency", test.name)
+			}
+		}
+	}
+}
+
+func TestBOBYQA(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name         string
+		f            func([]float64) float64
+		x            []float64
+		lower, upper []float64
+		wantX        []float64
+		wantF        float64
+	}{
+		{
+			name:  "Unbounded",
+			f:     functions.ExtendedRosenbrock{}.Func,
+			x:     []float64{-1.2, 1},
+			wantX: []float64{1, 1},
+		},
+		{
+			name:  "InactiveBounds",
+			f:     functions.ExtendedRosenbrock{}.Func,
+			x:     []float64{-1.2, 1},
+			lower: []float64{-2, -2},
+			upper: []float64{2, 2},
+			wantX: []float64{1, 1},
+		},
+		{
+			name:  "ActiveUpper",
+			f:     functions.ExtendedRosenbrock{}.Func,
+			x:     []float64{-1.2, 1},
+			lower: []float64{-2, -1},
+			upper: []float64{0.5, 2},
+			wantX: []float64{0.5, 0.25},
+			wantF: 0.25,
+		},
+		{
+			// The initial location is outside the bounds.
+			name:  "ActiveLower",
+			f:     functions.ExtendedRosenbrock{}.Func,
+			x:     []float64{0, 0},
+			lower: []float64{1.5, math.Inf(-1)},
+			upper: nil,
+			wantX: []float64{1.5, 2.25},
+			wantF: 0.25,
+		},
+		{
+			// The variables are bounded on one side only, with the initial
+			// location close to the bounds. The minimum is a local minimum
+			// of the chained Rosenbrock function found also by LBFGSB.
+			name:  "OneSided",
+			f:     functions.ExtendedRosenbrock{}.Func,
+			x:     []float64{-0.9, 0.1, -1.2, 1},
+			lower: []float64{-1, 0, math.Inf(-1), math.Inf(-1)},
+			upper: []float64{0.8, math.Inf(1), math.Inf(1), 0.5},
+			wantX: []float64{0.8, 0.6509190765430856, 0.429401627928419, 0.18438575806757643},
+			wantF: 0.50261844160975366,
+		},
+	} {
+		var infeasible atomic.Bool
+		problem := Problem{
+			Func: func(x []float64) float64 {
+				for i, v := range x {
+					if test.lower != nil && v < test.lower[i] || test.upper != nil && v > test.upper[i] {
+						infeasible.Store(true)
+					}
+				}
+				return test.f(x)
+			},
+		}
+		method := &BOBYQA{Lower: test.lower, Upper: test.upper, FinalRadius: 1e-9}
+		result, err := Minimize(problem, test.x, &Settings{Converger: NeverTerminate{}}, method)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if infeasible.Load() {
+			t.Errorf("%s: function evaluated outside the bounds", test.name)
+		}
+		if result.Status != MethodConverge {
+			t.Errorf("%s: unexpected status: got %v, want %v", test.name, result.Status, MethodConverge)
+		}
+		if math.Abs(result.F-test.wantF) > 1e-10 {
+			t.Errorf("%s: unexpected minimum: got %v, want %v", test.name, result.F, test.wantF)
+		}
+		if !floats.EqualApprox(result.X, test.wantX, 1e-6) {
+			t.Errorf("%s: unexpected minimum location: got %v, want %v", test.name, result.X, test.wantX)
+		}
+	}
+}
+
+func TestBOBYQAInitValues(t *testing.T) {
+	t.Parallel()
+	problem := Problem{Func: functions.ExtendedRosenbrock{}.Func}
+	x := []float64{-1.2, 1, 0.5}
+	for _, method := range []Method{
+		&BOBYQA{Lower: []float64{-2, -2, -2}, Upper: []float64{2, 2, 2}},
+		&NEWUOA{},
+	} {
+		settings := &Settings{Converger: NeverTerminate{}}
+		result, err := Minimize(problem, x, settings, method)
+		if err != nil {
+			t.Fatalf("%T: unexpected error: %v", method, err)
+		}
+		settings.InitValues = &Location{F: problem.Func(x)}
+		result2, err := Minimize(problem, x, settings, method)
+		if err != nil {
+			t.Fatalf("%T: unexpected error with initial values: %v", method, err)
+		}
+		if result.F != result2.F || !floats.Equal(result.X, result2.X) {
+			t.Errorf("%T: different minimum with initial values", method)
+		}
+		if result.FuncEvaluations != result2.FuncEvaluations+1 {
+			t.Errorf("%T: initial values do not reduce the number of evaluations by one: got %d and %d",
+				method, result.FuncEvaluations, result2.FuncEvaluations)
+		}
+	}
+}
+
+func TestBOBYQAStop(t *testing.T) {
+	t.Parallel()
+	// The evaluation limit stops the method before the trust region is
+	// reduced to its final radius, and the best location is reported.
+	problem := Problem{Func: functions.ExtendedRosenbrock{}.Func}
+	x := []float64{-1.2, 1, -1.2, 1}
+	for _, concurrent := range []int{0, 3} {
+		settings := &Settings{
+			Converger:       NeverTerminate{},
+			FuncEvaluations: 50,
+			Concurrent:      concurrent,
+		}
+		result, err := Minimize(problem, x, settings, &NEWUOA{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Status != FunctionEvaluationLimit {
+			t.Errorf("unexpected status: got %v, want %v", result.Status, FunctionEvaluationLimit)
+		}
+		if f := problem.Func(result.X); f != result.F || f >= problem.Func(x) {
+			t.Errorf("unexpected result: got %v at %v", result.F, result.X)
+		}
+	}
+}
+
+func TestBOBYQAFuncError(t *testing.T) {
+	t.Parallel()
+	// The initial interpolation locations include {0, 1}.
+	problem := Problem{
+		Func: func(x []float64) float64 {
+			if x[0] > -0.5 {
+				return math.NaN()
+			}
+			return x[0]*x[0] + x[1]*x[1]
+		},
+	}
+	_, err := Minimize(problem, []float64{-1, 1}, nil, &BOBYQA{InitRadius: 1})
+	if _, ok := err.(ErrFunc); !ok {
+		t.Errorf("unexpected error: got %v, want ErrFunc", err)
+	}
+}
+
+func TestBOBYQAPanics(t *testing.T) {
+	t.Parallel()
+	for k, test := range []struct {
+		method Method
+		dim    int
+	}{
+		{method: &BOBYQA{Lower: []float64{0}}, dim: 2},
+		{method: &BOBYQA{Lower: []float64{0, 1}, Upper: []float64{1, 1}}, dim: 2},
+		{method: &BOBYQA{NumPoints: 3}, dim: 2},
+		{method: &BOBYQA{NumPoints: 7}, dim: 2},
+		{method: &BOBYQA{InitRadius: 1e-3, FinalRadius: 1e-2}, dim: 2},
+		{method: &NEWUOA{NumPoints: 11}, dim: 3},
+		{method: &NEWUOA{FinalRadius: -1}, dim: 3},
+		{method: &NEWUOA{}, dim: 0},
+	} {
+		if !panics(func() { test.method.Init(test.dim, 1) }) {
+			t.Errorf("case %d: expected panic for %#v", k, test.method)
+		}
+	}
+}
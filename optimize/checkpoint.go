@@ -0,0 +1,468 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"time"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+const checkpointVersion = 1
+
+var (
+	// ErrBadCheckpoint signifies that the binary form of a checkpoint or of
+	// the state of a Method is malformed.
+	ErrBadCheckpoint = errors.New("optimize: malformed checkpoint")
+
+	// ErrCheckpointMethod signifies that the state of a Method is restored
+	// from the state of a different kind of Method.
+	ErrCheckpointMethod = errors.New("optimize: checkpoint is for a different method")
+)
+
+// Checkpoint is the state of an optimization at a major iteration, from
+// which the optimization can be continued with Resume.
+type Checkpoint struct {
+	// Location is the location of the major iteration at which the
+	// checkpoint was taken. It is the location from which Resume continues
+	// the optimization, and it need not be the best location found so far.
+	Location Location
+	// Stats holds the statistics of the optimization up to the checkpoint.
+	Stats Stats
+	// State is the binary form of the state of the Method returned by its
+	// MarshalBinary method.
+	State []byte
+}
+
+// MarshalBinary encodes the checkpoint into a binary form and returns the
+// result.
+func (c *Checkpoint) MarshalBinary() ([]byte, error) {
+	var e encoder
+	e.header("checkpoint")
+	e.floats(c.Location.X)
+	e.float64(c.Location.F)
+	e.floats(c.Location.Gradient)
+	if c.Location.Hessian == nil {
+		e.int(-1)
+	} else {
+		n := c.Location.Hessian.SymmetricDim()
+		e.int(n)
+		for i := 0; i < n; i++ {
+			for j := i; j < n; j++ {
+				e.float64(c.Location.Hessian.At(i, j))
+			}
+		}
+	}
+	e.int(c.Stats.MajorIterations)
+	e.int(c.Stats.FuncEvaluations)
+	e.int(c.Stats.GradEvaluations)
+	e.int(c.Stats.HessEvaluations)
+	e.int(int(c.Stats.Runtime))
+	e.bytes(c.State)
+	return e.buf, nil
+}
+
+// UnmarshalBinary decodes the binary form into the receiver. It returns
+// ErrBadCheckpoint if data is malformed.
+func (c *Checkpoint) UnmarshalBinary(data []byte) error {
+	d := decoder{buf: data}
+	d.header("checkpoint")
+	var loc Location
+	loc.X = d.floats()
+	loc.F = d.float64()
+	loc.Gradient = d.floats()
+	if n := d.int(); n > 0 && d.err == nil {
+		if n*(n+1)/2 > d.remaining() {
+			return ErrBadCheckpoint
+		}
+		loc.Hessian = mat.NewSymDense(n, nil)
+		for i := 0; i < n; i++ {
+			for j := i; j < n; j++ {
+				loc.Hessian.SetSym(i, j, d.float64())
+			}
+		}
+	}
+	var stats Stats
+	stats.MajorIterations = d.int()
+	stats.FuncEvaluations = d.int()
+	stats.GradEvaluations = d.int()
+	stats.HessEvaluations = d.int()
+	stats.Runtime = time.Duration(d.int())
+	state := d.bytes()
+	if err := d.finish(); err != nil {
+		return err
+	}
+	c.Location = loc
+	c.Stats = stats
+	c.State = state
+	return nil
+}
+
+// Resume continues the optimization of p from the checkpoint c using method.
+// The state of method is restored from c.State by its UnmarshalBinary method,
+// so method must be the same kind of Method with the same settings as in the
+// optimization that wrote the checkpoint. Resume panics if method does not
+// implement encoding.BinaryUnmarshaler, and it returns the error of
+// UnmarshalBinary if the state cannot be restored.
+//
+// The optimization starts from the location of the checkpoint, whose values
+// replace settings.InitValues. The statistics continue from those of the
+// checkpoint, so the limits in settings apply to the total of the
+// optimization before and after the checkpoint. The state of the Converger
+// is not part of the checkpoint, and convergence is checked afresh from the
+// checkpoint. Otherwise, Resume behaves like Minimize.
+func Resume(p Problem, c *Checkpoint, settings *Settings, method Method) (*Result, error) {
+	startTime := time.Now().Add(-c.Stats.Runtime)
+	u, ok := method.(encoding.BinaryUnmarshaler)
+	if !ok {
+		panic("optimize: method does not implement encoding.BinaryUnmarshaler")
+	}
+	if err := u.UnmarshalBinary(c.State); err != nil {
+		return nil, err
+	}
+	if settings == nil {
+		settings = &Settings{}
+	}
+	s := *settings
+	s.InitValues = &Location{F: c.Location.F}
+	if c.Location.Gradient != nil {
+		s.InitValues.Gradient = make([]float64, len(c.Location.Gradient))
+		copy(s.InitValues.Gradient, c.Location.Gradient)
+	}
+	if c.Location.Hessian != nil {
+		s.InitValues.Hessian = mat.NewSymDense(c.Location.Hessian.SymmetricDim(), nil)
+		s.InitValues.Hessian.CopySym(c.Location.Hessian)
+	}
+	stats := c.Stats
+	if _, ok := method.(localMethod); ok && stats.MajorIterations > 0 {
+		// Local methods report the starting location as a major iteration,
+		// which has been counted before the checkpoint.
+		stats.MajorIterations--
+	}
+	initX := make([]float64, len(c.Location.X))
+	copy(initX, c.Location.X)
+	return minimizeFrom(p, initX, &s, method, &stats, startTime)
+}
+
+var _ Recorder = (*CheckpointRecorder)(nil)
+
+// CheckpointRecorder is a Recorder that writes checkpoints of an
+// optimization to W at major iterations. Each checkpoint is written as the
+// length of its binary form as a little-endian uint64 followed by the
+// binary form returned by Checkpoint.MarshalBinary, and it can be read with
+// ReadCheckpoint.
+//
+// The state of the method is marshaled while the method waits for the
+// result of the major iteration. This is the case for the Methods in this
+// package that implement encoding.BinaryMarshaler.
+type CheckpointRecorder struct {
+	// W is the destination of the checkpoints.
+	W io.Writer
+	// Method is the method used in the optimization.
+	Method encoding.BinaryMarshaler
+	// Interval is the number of major iterations between checkpoints. If
+	// Interval is 0, it is defaulted to 1.
+	Interval int
+}
+
+// Init checks the fields of the recorder.
+func (r *CheckpointRecorder) Init() error {
+	if r.W == nil {
+		return errors.New("optimize: nil checkpoint writer")
+	}
+	if r.Method == nil {
+		return errors.New("optimize: nil checkpoint method")
+	}
+	if r.Interval < 0 {
+		return errors.New("optimize: negative checkpoint interval")
+	}
+	return nil
+}
+
+// Record writes a checkpoint if op is a MajorIteration and the number of
+// major iterations is a multiple of Interval.
+func (r *CheckpointRecorder) Record(loc *Location, op Operation, stats *Stats) error {
+	if op != MajorIteration {
+		return nil
+	}
+	interval := r.Interval
+	if interval == 0 {
+		interval = 1
+	}
+	if stats.MajorIterations%interval != 0 {
+		return nil
+	}
+	state, err := r.Method.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	c := Checkpoint{
+		Location: *loc,
+		Stats:    *stats,
+		State:    state,
+	}
+	data, err := c.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	var size [8]byte
+	binary.LittleEndian.PutUint64(size[:], uint64(len(data)))
+	if _, err := r.W.Write(size[:]); err != nil {
+		return err
+	}
+	_, err = r.W.Write(data)
+	return err
+}
+
+// ReadCheckpoint reads the next checkpoint written by a CheckpointRecorder
+// from r. It returns io.EOF if there are no more checkpoints, and
+// io.ErrUnexpectedEOF if the checkpoint is incomplete, for example because
+// the optimization was interrupted while writing it.
+func ReadCheckpoint(r io.Reader) (*Checkpoint, error) {
+	var size [8]byte
+	if _, err := io.ReadFull(r, size[:]); err != nil {
+		return nil, err
+	}
+	n := binary.LittleEndian.Uint64(size[:])
+	if n > math.MaxInt32 {
+		return nil, ErrBadCheckpoint
+	}
+	data := make([]byte, n)
+	if _, err := io.ReadFull(r, data); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	var c Checkpoint
+	if err := c.UnmarshalBinary(data); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// LastCheckpoint reads all checkpoints written by a CheckpointRecorder from
+// r and returns the last complete one. An incomplete checkpoint at the end
+// of r is ignored. LastCheckpoint returns io.EOF if r contains no complete
+// checkpoint.
+func LastCheckpoint(r io.Reader) (*Checkpoint, error) {
+	var last *Checkpoint
+	for {
+		c, err := ReadCheckpoint(r)
+		switch err {
+		case nil:
+			last = c
+			continue
+		case io.EOF, io.ErrUnexpectedEOF:
+			if last == nil {
+				return nil, io.EOF
+			}
+			return last, nil
+		default:
+			return nil, err
+		}
+	}
+}
+
+// encoder appends values in little-endian binary form to buf.
+type encoder struct {
+	buf []byte
+}
+
+// header appends the version and the name of the encoded type.
+func (e *encoder) header(name string) {
+	e.int(checkpointVersion)
+	e.bytes([]byte(name))
+}
+
+func (e *encoder) uint64(v uint64) {
+	e.buf = binary.LittleEndian.AppendUint64(e.buf, v)
+}
+
+func (e *encoder) int(v int) {
+	e.uint64(uint64(int64(v)))
+}
+
+func (e *encoder) bool(v bool) {
+	if v {
+		e.int(1)
+	} else {
+		e.int(0)
+	}
+}
+
+func (e *encoder) float64(v float64) {
+	e.uint64(math.Float64bits(v))
+}
+
+// floats appends the length and the elements of s, where a nil s has
+// length -1.
+func (e *encoder) floats(s []float64) {
+	if s == nil {
+		e.int(-1)
+		return
+	}
+	e.int(len(s))
+	for _, v := range s {
+		e.float64(v)
+	}
+}
+
+// bytes appends the length and the elements of b, where a nil b has length
+// -1.
+func (e *encoder) bytes(b []byte) {
+	if b == nil {
+		e.int(-1)
+		return
+	}
+	e.int(len(b))
+	e.buf = append(e.buf, b...)
+}
+
+// source appends the state of src if it implements
+// encoding.BinaryMarshaler.
+func (e *encoder) source(src rand.Source) error {
+	m, ok := src.(encoding.BinaryMarshaler)
+	if !ok {
+		e.bytes(nil)
+		return nil
+	}
+	b, err := m.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	e.bytes(b)
+	return nil
+}
+
+// decoder reads values in little-endian binary form from buf. The first
+// error is kept in err, and all following reads return zero values.
+type decoder struct {
+	buf []byte
+	err error
+}
+
+// header reads and checks the version and the name of the encoded type.
+func (d *decoder) header(name string) {
+	if d.int() != checkpointVersion {
+		d.fail(ErrBadCheckpoint)
+		return
+	}
+	if got := d.bytes(); d.err == nil && string(got) != name {
+		d.fail(ErrCheckpointMethod)
+	}
+}
+
+func (d *decoder) fail(err error) {
+	if d.err == nil {
+		d.err = err
+	}
+	d.buf = nil
+}
+
+// remaining returns the number of 8-byte words left in buf.
+func (d *decoder) remaining() int {
+	return len(d.buf) / 8
+}
+
+func (d *decoder) uint64() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	if len(d.buf) < 8 {
+		d.fail(ErrBadCheckpoint)
+		return 0
+	}
+	v := binary.LittleEndian.Uint64(d.buf)
+	d.buf = d.buf[8:]
+	return v
+}
+
+func (d *decoder) int() int {
+	return int(int64(d.uint64()))
+}
+
+func (d *decoder) bool() bool {
+	return d.int() != 0
+}
+
+func (d *decoder) float64() float64 {
+	return math.Float64frombits(d.uint64())
+}
+
+// length reads a length written by floats or bytes and checks that as many
+// elements of the given size in bytes remain in buf.
+func (d *decoder) length(size int) (n int, isNil bool) {
+	n = d.int()
+	if d.err != nil {
+		return 0, true
+	}
+	if n == -1 {
+		return 0, true
+	}
+	if n < 0 || n > len(d.buf)/size {
+		d.fail(ErrBadCheckpoint)
+		return 0, true
+	}
+	return n, false
+}
+
+func (d *decoder) floats() []float64 {
+	n, isNil := d.length(8)
+	if isNil {
+		return nil
+	}
+	s := make([]float64, n)
+	for i := range s {
+		s[i] = d.float64()
+	}
+	return s
+}
+
+func (d *decoder) bytes() []byte {
+	n, isNil := d.length(1)
+	if isNil {
+		return nil
+	}
+	b := make([]byte, n)
+	copy(b, d.buf)
+	d.buf = d.buf[n:]
+	return b
+}
+
+// source restores the state of src from the state read from buf if both
+// are present and src implements encoding.BinaryUnmarshaler.
+func (d *decoder) source(src rand.Source) {
+	b := d.bytes()
+	if b == nil || d.err != nil {
+		return
+	}
+	u, ok := src.(encoding.BinaryUnmarshaler)
+	if !ok {
+		return
+	}
+	if err := u.UnmarshalBinary(b); err != nil {
+		d.fail(err)
+	}
+}
+
+// finish returns the first error, or ErrBadCheckpoint if there are unread
+// bytes.
+func (d *decoder) finish() error {
+	if d.err != nil {
+		return d.err
+	}
+	if len(d.buf) != 0 {
+		return ErrBadCheckpoint
+	}
+	return nil
+}
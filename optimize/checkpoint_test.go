@@ -0,0 +1,250 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize/functions"
+)
+
+type checkpointMethod interface {
+	Method
+	MarshalBinary() ([]byte, error)
+	UnmarshalBinary([]byte) error
+}
+
+func TestResume(t *testing.T) {
+	t.Parallel()
+	problem := Problem{
+		Func: functions.ExtendedRosenbrock{}.Func,
+		Grad: functions.ExtendedRosenbrock{}.Grad,
+	}
+	initX := []float64{-1.2, 1, -1.2, 1}
+	for _, test := range []struct {
+		name      string
+		method    func() checkpointMethod
+		iters     int
+		interrupt int
+	}{
+		{
+			name:      "LBFGS",
+			method:    func() checkpointMethod { return &LBFGS{Store: 3} },
+			iters:     30,
+			interrupt: 12,
+		},
+		{
+			name:      "BFGS",
+			method:    func() checkpointMethod { return &BFGS{} },
+			iters:     25,
+			interrupt: 10,
+		},
+		{
+			name:      "NelderMead",
+			method:    func() checkpointMethod { return &NelderMead{} },
+			iters:     200,
+			interrupt: 77,
+		},
+		{
+			name: "CmaEsChol",
+			method: func() checkpointMethod {
+				return &CmaEsChol{Src: rand.NewSource(uint64(rand.Int63()))}
+			},
+			iters:     100,
+			interrupt: 40,
+		},
+		{
+			// Checkpoint at the starting location.
+			name:      "LBFGS start",
+			method:    func() checkpointMethod { return &LBFGS{} },
+			iters:     20,
+			interrupt: 2,
+		},
+	} {
+		settings := func(iters int) *Settings {
+			return &Settings{
+				Converger:         NeverTerminate{},
+				GradientThreshold: 1e-14,
+				MajorIterations:   iters,
+			}
+		}
+
+		// The uninterrupted optimization.
+		method := test.method()
+		if cma, ok := method.(*CmaEsChol); ok {
+			cma.Src = rand.NewSource(1)
+		}
+		want, err := Minimize(problem, initX, settings(test.iters), method)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+
+		// The interrupted optimization, with checkpoints written at every
+		// major iteration.
+		method = test.method()
+		if cma, ok := method.(*CmaEsChol); ok {
+			cma.Src = rand.NewSource(1)
+		}
+		var buf bytes.Buffer
+		s := settings(test.interrupt)
+		s.Recorder = &CheckpointRecorder{W: &buf, Method: method}
+		_, err = Minimize(problem, initX, s, method)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		c, err := LastCheckpoint(&buf)
+		if err != nil {
+			t.Errorf("%s: unexpected error reading checkpoint: %v", test.name, err)
+			continue
+		}
+		// The last major iteration terminates the optimization and is not
+		// recorded.
+		if c.Stats.MajorIterations != test.interrupt-1 {
+			t.Errorf("%s: unexpected major iteration of the last checkpoint: got %d, want %d",
+				test.name, c.Stats.MajorIterations, test.interrupt-1)
+		}
+
+		// Resume with a new method. The state of the random source of
+		// CmaEsChol is restored.
+		got, err := Resume(problem, c, settings(test.iters), test.method())
+		if err != nil {
+			t.Errorf("%s: unexpected error resuming: %v", test.name, err)
+			continue
+		}
+		if got.Status != want.Status {
+			t.Errorf("%s: unexpected status: got %v, want %v", test.name, got.Status, want.Status)
+		}
+		if got.F != want.F || !floats.Equal(got.X, want.X) {
+			t.Errorf("%s: resumed optimization differs: got %v at %v, want %v at %v", test.name, got.F, got.X, want.F, want.X)
+		}
+		if got.MajorIterations != want.MajorIterations || got.FuncEvaluations != want.FuncEvaluations ||
+			got.GradEvaluations != want.GradEvaluations {
+			t.Errorf("%s: unexpected statistics: got %+v, want %+v", test.name, got.Stats, want.Stats)
+		}
+	}
+}
+
+func TestCheckpointMarshal(t *testing.T) {
+	t.Parallel()
+	hess := mat.NewSymDense(2, []float64{1, 2, 2, 3})
+	for _, c := range []Checkpoint{
+		{},
+		{
+			Location: Location{X: []float64{1, 2}, F: 3},
+			Stats:    Stats{MajorIterations: 4, FuncEvaluations: 5},
+			State:    []byte{6, 7},
+		},
+		{
+			Location: Location{X: []float64{1, 2}, F: 3, Gradient: []float64{4, 5}, Hessian: hess},
+			Stats:    Stats{MajorIterations: 4, FuncEvaluations: 5, GradEvaluations: 6, HessEvaluations: 7, Runtime: 8},
+			State:    []byte{},
+		},
+	} {
+		data, err := c.MarshalBinary()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var got Checkpoint
+		err = got.UnmarshalBinary(data)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			continue
+		}
+		// The lower triangle of the storage of a Hessian is not kept, so
+		// Hessians are compared by value.
+		if (got.Location.Hessian == nil) != (c.Location.Hessian == nil) || got.Location.Hessian != nil && !mat.Equal(got.Location.Hessian, c.Location.Hessian) {
+			t.Errorf("unexpected Hessian after round trip: got %v, want %v", got.Location.Hessian, c.Location.Hessian)
+		}
+		got.Location.Hessian, c.Location.Hessian = nil, nil
+		if !reflect.DeepEqual(got, c) {
+			t.Errorf("unexpected checkpoint after round trip: got %+v, want %+v", got, c)
+		}
+		for n := 0; n < len(data); n += 7 {
+			if err := got.UnmarshalBinary(data[:n]); err != ErrBadCheckpoint {
+				t.Errorf("unexpected error for truncated data of length %d: got %v, want %v", n, err, ErrBadCheckpoint)
+			}
+		}
+	}
+}
+
+func TestCheckpointErrors(t *testing.T) {
+	t.Parallel()
+	problem := Problem{
+		Func: functions.ExtendedRosenbrock{}.Func,
+		Grad: functions.ExtendedRosenbrock{}.Grad,
+	}
+	method := &LBFGS{}
+	var buf bytes.Buffer
+	settings := &Settings{
+		MajorIterations: 5,
+		Recorder:        &CheckpointRecorder{W: &buf, Method: method},
+	}
+	_, err := Minimize(problem, []float64{-1.2, 1}, settings, method)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	data := buf.Bytes()
+
+	// An incomplete checkpoint at the end is ignored.
+	first, err := ReadCheckpoint(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	last, err := LastCheckpoint(bytes.NewReader(data[:len(data)-3]))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if last.Stats.MajorIterations != 3 {
+		t.Errorf("unexpected last complete checkpoint: got major iteration %d, want 3", last.Stats.MajorIterations)
+	}
+	r := bytes.NewReader(data)
+	for {
+		_, err = ReadCheckpoint(r)
+		if err != nil {
+			break
+		}
+	}
+	if err != io.EOF {
+		t.Errorf("unexpected error at the end: got %v, want %v", err, io.EOF)
+	}
+	if _, err := LastCheckpoint(bytes.NewReader(nil)); err != io.EOF {
+		t.Errorf("unexpected error for no checkpoints: got %v, want %v", err, io.EOF)
+	}
+	if _, err := ReadCheckpoint(bytes.NewReader(data[:len(data)-3])); err != nil {
+		t.Errorf("unexpected error reading the first checkpoint: %v", err)
+	}
+
+	// The state cannot be restored into a different method.
+	for _, m := range []checkpointMethod{&BFGS{}, &NelderMead{}, &CmaEsChol{}} {
+		if err := m.UnmarshalBinary(last.State); !errors.Is(err, ErrCheckpointMethod) {
+			t.Errorf("unexpected error restoring into %T: got %v, want %v", m, err, ErrCheckpointMethod)
+		}
+		if _, err := Resume(problem, last, nil, m); err == nil {
+			t.Errorf("expected error resuming with %T", m)
+		}
+	}
+	if err := (&LBFGS{Store: 3}).UnmarshalBinary(last.State); err != ErrBadCheckpoint {
+		t.Errorf("unexpected error restoring with different Store: got %v, want %v", err, ErrBadCheckpoint)
+	}
+	if err := (&LBFGS{}).UnmarshalBinary(last.State[:len(last.State)-1]); err != ErrBadCheckpoint {
+		t.Errorf("unexpected error restoring truncated state: got %v, want %v", err, ErrBadCheckpoint)
+	}
+	if !panics(func() { Resume(problem, first, nil, &GradientDescent{}) }) {
+		t.Errorf("expected panic resuming with a method without state")
+	}
+	if err := (&CheckpointRecorder{Method: method}).Init(); err == nil {
+		t.Errorf("expected error for nil writer")
+	}
+}
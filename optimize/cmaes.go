@@ -5,6 +5,7 @@
 package optimize
 
 import (
+	"encoding"
 	"math"
 	"sort"
 
@@ -55,6 +56,12 @@ var _ Method = (*CmaEsChol)(nil)
 //
 //	https://en.wikipedia.org/wiki/CMA-ES
 //	https://arxiv.org/pdf/1604.00772.pdf
+//
+// The state of CmaEsChol at a major iteration can be saved with MarshalBinary
+// and restored with UnmarshalBinary to continue the optimization with Resume.
+// The state of Src is included if it implements encoding.BinaryMarshaler and
+// encoding.BinaryUnmarshaler, as the sources in golang.org/x/exp/rand do, so
+// that the resumed optimization generates the same samples.
 type CmaEsChol struct {
 	// InitStepSize sets the initial size of the covariance matrix adaptation.
 	// If InitStepSize is 0, a default value of 0.5 is used. InitStepSize cannot
@@ -114,11 +121,15 @@ type CmaEsChol struct {
 	receivedIdx int
 	operation   chan<- Task
 	updateErr   error
+
+	restored bool // Indicator of the state restored by UnmarshalBinary.
 }
 
 var (
-	_ Statuser = (*CmaEsChol)(nil)
-	_ Method   = (*CmaEsChol)(nil)
+	_ Statuser                   = (*CmaEsChol)(nil)
+	_ Method                     = (*CmaEsChol)(nil)
+	_ encoding.BinaryMarshaler   = (*CmaEsChol)(nil)
+	_ encoding.BinaryUnmarshaler = (*CmaEsChol)(nil)
 )
 
 func (cma *CmaEsChol) methodConverged() Status {
@@ -192,12 +203,32 @@ func (cma *CmaEsChol) Init(dim, tasks int) int {
 	cma.fs = resize(cma.fs, cma.pop)
 
 	// Allocate and initialize adaptive parameters.
-	cma.invSigma = 1 / cma.InitStepSize
-	if cma.InitStepSize == 0 {
-		cma.invSigma = 10.0 / 3
-	} else if cma.InitStepSize < 0 {
+	if cma.InitStepSize < 0 {
 		panic("cma-es-chol: negative initial step size")
 	}
+	if cma.restored {
+		// The adaptive parameters and the overall best are restored.
+		if len(cma.mean) != dim {
+			panic("cma-es-chol: restored state does not match the problem dimension")
+		}
+	} else {
+		cma.invSigma = 1 / cma.InitStepSize
+		if cma.InitStepSize == 0 {
+			cma.invSigma = 10.0 / 3
+		}
+		cma.initAdaptive(dim)
+	}
+
+	cma.sentIdx = 0
+	cma.receivedIdx = 0
+	cma.operation = nil
+	cma.updateErr = nil
+	t := min(tasks, cma.pop)
+	return t
+}
+
+// initAdaptive initializes the adaptive parameters and the overall best.
+func (cma *CmaEsChol) initAdaptive(dim int) {
 	cma.pc = resize(cma.pc, dim)
 	for i := range cma.pc {
 		cma.pc[i] = 0
@@ -229,13 +260,6 @@ func (cma *CmaEsChol) Init(dim, tasks int) int {
 
 	cma.bestX = resize(cma.bestX, dim)
 	cma.bestF = math.Inf(1)
-
-	cma.sentIdx = 0
-	cma.receivedIdx = 0
-	cma.operation = nil
-	cma.updateErr = nil
-	t := min(tasks, cma.pop)
-	return t
 }
 
 func (cma *CmaEsChol) sendInitTasks(tasks []Task) {
@@ -299,7 +323,10 @@ func (cma *CmaEsChol) findBestAndUpdateTask(task Task) Task {
 }
 
 func (cma *CmaEsChol) Run(operations chan<- Task, results <-chan Task, tasks []Task) {
-	copy(cma.mean, tasks[0].X)
+	if !cma.restored {
+		copy(cma.mean, tasks[0].X)
+	}
+	cma.restored = false
 	cma.operation = operations
 	// Send the initial tasks. We know there are at most as many tasks as elements
 	// of the population.
@@ -467,3 +494,83 @@ func (b bestSorter) Swap(i, j int) {
 	b.F[i], b.F[j] = b.F[j], b.F[i]
 	b.Idx[i], b.Idx[j] = b.Idx[j], b.Idx[i]
 }
+
+// MarshalBinary encodes the state of the method at the last major iteration
+// into a binary form and returns the result.
+func (cma *CmaEsChol) MarshalBinary() ([]byte, error) {
+	var e encoder
+	e.header("cma-es-chol")
+	e.int(cma.dim)
+	if cma.dim == 0 {
+		// The optimization has not started.
+		return e.buf, nil
+	}
+	e.int(cma.pop)
+	e.floats(cma.mean)
+	e.floats(cma.pc)
+	e.floats(cma.ps)
+	e.float64(cma.invSigma)
+	u := cma.chol.RawU()
+	for i := 0; i < cma.dim; i++ {
+		for j := i; j < cma.dim; j++ {
+			e.float64(u.At(i, j))
+		}
+	}
+	e.floats(cma.bestX)
+	e.float64(cma.bestF)
+	if err := e.source(cma.Src); err != nil {
+		return nil, err
+	}
+	return e.buf, nil
+}
+
+// UnmarshalBinary restores the state of the method from the binary form
+// returned by MarshalBinary. The next optimization with the method continues
+// from the restored state. UnmarshalBinary returns ErrCheckpointMethod if data
+// is not the state of a CmaEsChol, and ErrBadCheckpoint if data is malformed
+// or the population size does not match a non-zero Population.
+func (cma *CmaEsChol) UnmarshalBinary(data []byte) error {
+	d := decoder{buf: data}
+	d.header("cma-es-chol")
+	dim := d.int()
+	if d.err == nil && dim == 0 {
+		cma.restored = false
+		return d.finish()
+	}
+	pop := d.int()
+	mean := d.floats()
+	pc := d.floats()
+	ps := d.floats()
+	invSigma := d.float64()
+	if d.err == nil && (dim <= 0 || pop <= 0 || cma.Population != 0 && cma.Population != pop ||
+		len(mean) != dim || len(pc) != dim || len(ps) != dim || dim*(dim+1)/2 > d.remaining()) {
+		return ErrBadCheckpoint
+	}
+	var u *mat.TriDense
+	if d.err == nil {
+		u = mat.NewTriDense(dim, mat.Upper, nil)
+		for i := 0; i < dim; i++ {
+			for j := i; j < dim; j++ {
+				u.SetTri(i, j, d.float64())
+			}
+		}
+	}
+	bestX := d.floats()
+	bestF := d.float64()
+	d.source(cma.Src)
+	if err := d.finish(); err != nil {
+		return err
+	}
+	if len(bestX) != dim {
+		return ErrBadCheckpoint
+	}
+	cma.mean = mean
+	cma.pc = pc
+	cma.ps = ps
+	cma.invSigma = invSigma
+	cma.chol.SetFromU(u)
+	cma.bestX = bestX
+	cma.bestF = bestF
+	cma.restored = true
+	return nil
+}
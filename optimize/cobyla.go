@@ -0,0 +1,708 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize/convex/qp"
+)
+
+const (
+	// cobylaMaxDist and cobylaMinSigma bound the distances of the vertices
+	// of an acceptable simplex from the best vertex and from their opposite
+	// faces relative to the resolution.
+	cobylaMaxDist  = 2.1
+	cobylaMinSigma = 0.25
+	// cobylaReplaceDist is the distance relative to the resolution beyond
+	// which vertices are preferred for replacement by a trial location.
+	cobylaReplaceDist = 1.1
+	// cobylaGeometryStep is the length of a geometry improving step relative
+	// to the resolution.
+	cobylaGeometryStep = 0.5
+	// cobylaCondTol is the largest condition number of the simplex before
+	// it is rebuilt.
+	cobylaCondTol = 1e12
+)
+
+var (
+	_ Method   = (*COBYLA)(nil)
+	_ Statuser = (*COBYLA)(nil)
+)
+
+// COBYLA implements Powell's COBYLA method (Constrained Optimization BY
+// Linear Approximations) for derivative-free minimization subject to the
+// inequality constraints
+//
+//	c_i(x) >= 0, i = 0, ..., NumInequality-1.
+//
+// COBYLA maintains a simplex of dim+1 locations, at which the objective
+// function and the constraint functions are interpolated by linear models.
+// Each iteration computes a step within a trust region of radius ρ that
+// first minimizes the largest violation of the linearized constraints and
+// then minimizes the linearized objective function subject to not
+// increasing that violation. The step is accepted based on the merit
+// function
+//
+//	f(x) + μ max(0, -min_i c_i(x)),
+//
+// where the penalty parameter μ is increased as needed to make the predicted
+// reduction of the merit function positive. The vertices of the simplex are
+// replaced so that the simplex remains well shaped, and ρ is reduced from
+// InitRadius to FinalRadius when no further progress can be made. COBYLA
+// then terminates with MethodConverge.
+//
+// The locations reported to Minimize are the best vertices of the simplex
+// with respect to the merit function, so the final location may violate the
+// constraints by a small amount if the objective function decreases at the
+// boundary of the feasible region. The initial simplex is evaluated
+// concurrently. COBYLA terminates with ErrFunc if the objective function or
+// a constraint function returns NaN, or the objective function returns +Inf.
+//
+// Reference:
+//   - Powell, M.J.D.: A direct search optimization method that models the
+//     objective and constraint functions by linear interpolation. In:
+//     Advances in Optimization and Numerical Analysis, pp. 51-67. Springer
+//     (1994)
+type COBYLA struct {
+	// Inequality evaluates the inequality constraints c at x and stores the
+	// result in dst. Inequality must not modify x. It is evaluated by COBYLA
+	// at every location at which the objective function is evaluated, and
+	// it is not evaluated concurrently.
+	Inequality func(dst, x []float64)
+	// NumInequality is the number of inequality constraints.
+	NumInequality int
+
+	// InitRadius is the initial trust-region radius, which should be about
+	// one tenth of the greatest expected change of a variable. If InitRadius
+	// is 0, it is defaulted to 0.5.
+	InitRadius float64
+	// FinalRadius is the final trust-region radius, which sets the accuracy
+	// of the location of the minimum. If FinalRadius is 0, it is defaulted
+	// to 1e-6.
+	FinalRadius float64
+
+	status Status
+	err    error
+
+	dim            int
+	rhoBeg, rhoEnd float64
+	rho, mu        float64
+
+	// The vertices of the simplex are stored in the rows of x, together
+	// with the function values in f, the constraint values in the rows of
+	// con and the constraint violations in viol. best is the index of the
+	// best vertex.
+	x    *mat.Dense
+	f    []float64
+	con  *mat.Dense
+	viol []float64
+	best int
+
+	// others holds the indices of the vertices other than the best one, and
+	// the rows of d hold their displacements from the best vertex.
+	others []int
+	d      *mat.Dense
+	dinv   *mat.Dense
+	lu     mat.LU
+
+	// g and a are the gradients of the linear models of the objective and
+	// the constraint functions.
+	g []float64
+	a *mat.Dense
+
+	eye *mat.SymDense
+}
+
+func (c *COBYLA) Status() (Status, error) {
+	return c.status, c.err
+}
+
+func (*COBYLA) Uses(has Available) (uses Available, err error) {
+	return has.function()
+}
+
+func (c *COBYLA) Init(dim, tasks int) int {
+	if dim <= 0 {
+		panic(nonpositiveDimension)
+	}
+	if tasks < 0 {
+		panic(negativeTasks)
+	}
+	if c.NumInequality < 0 {
+		panic("cobyla: negative number of constraints")
+	}
+	if c.NumInequality > 0 && c.Inequality == nil {
+		panic("cobyla: inequality constraint function is undefined")
+	}
+	c.rhoBeg = c.InitRadius
+	if c.rhoBeg == 0 {
+		c.rhoBeg = defaultInitRadius
+	}
+	c.rhoEnd = c.FinalRadius
+	if c.rhoEnd == 0 {
+		c.rhoEnd = math.Min(defaultFinalRadius, c.rhoBeg)
+	}
+	if !(0 < c.rhoEnd && c.rhoEnd <= c.rhoBeg) {
+		panic("cobyla: invalid trust region radii")
+	}
+	m := c.NumInequality
+	c.dim = dim
+	c.x = mat.NewDense(dim+1, dim, nil)
+	c.f = resize(c.f, dim+1)
+	c.viol = resize(c.viol, dim+1)
+	c.con = nil
+	c.a = nil
+	if m > 0 {
+		c.con = mat.NewDense(dim+1, m, nil)
+		c.a = mat.NewDense(m, dim, nil)
+	}
+	c.others = make([]int, dim)
+	c.d = mat.NewDense(dim, dim, nil)
+	c.dinv = mat.NewDense(dim, dim, nil)
+	c.g = resize(c.g, dim)
+	c.eye = mat.NewSymDense(dim, nil)
+	for i := 0; i < dim; i++ {
+		c.eye.SetSym(i, i, 1)
+	}
+	c.status = NotTerminated
+	c.err = nil
+	return min(tasks, dim+1)
+}
+
+func (c *COBYLA) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	e := newDFOEvaluator(operation, result, tasks)
+	c.status, c.err = c.run(e, tasks[0])
+	var x []float64
+	var f float64
+	if c.best >= 0 {
+		x = c.x.RawRowView(c.best)
+		f = c.f[c.best]
+	}
+	e.finish(x, f, c.status != NotTerminated)
+}
+
+func (c *COBYLA) run(e *dfoEvaluator, task Task) (Status, error) {
+	n := c.dim
+	c.best = -1
+	c.rho = c.rhoBeg
+	c.mu = 0
+
+	// The initial simplex is formed by the initial location and steps of
+	// length rho along the coordinates.
+	xs := make([][]float64, 0, n+1)
+	first := 0
+	if task.Op&FuncEvaluation != 0 {
+		c.f[0] = task.F
+		first = 1
+	}
+	for i := 0; i <= n; i++ {
+		row := c.x.RawRowView(i)
+		copy(row, task.X)
+		if i > 0 {
+			row[i-1] += c.rho
+		}
+		if i >= first {
+			xs = append(xs, row)
+		}
+	}
+	if !e.evaluate(xs, c.f[first:]) {
+		return NotTerminated, nil
+	}
+	for k := 0; k <= n; k++ {
+		viol, err := c.constraints(c.conRow(k), c.x.RawRowView(k), c.f[k])
+		if err != nil {
+			return Failure, err
+		}
+		c.viol[k] = viol
+	}
+
+	xnew := make([]float64, n)
+	conNew := make([]float64, c.NumInequality)
+	checkGeometry := false
+	for {
+		c.best = c.bestVertex()
+		if math.IsInf(c.f[c.best], -1) {
+			return MethodConverge, nil
+		}
+		if !e.major(c.x.RawRowView(c.best), c.f[c.best]) {
+			return NotTerminated, nil
+		}
+		if !c.linearModels() {
+			// The simplex is degenerate, so it is rebuilt around the best
+			// vertex.
+			if ok, err := c.rebuild(e); !ok {
+				return errStatus(err), err
+			}
+			continue
+		}
+		xb := c.x.RawRowView(c.best)
+
+		if checkGeometry {
+			checkGeometry = false
+			j := c.badVertex()
+			if j < 0 {
+				if c.rho <= c.rhoEnd {
+					return MethodConverge, nil
+				}
+				c.reduceRho()
+				continue
+			}
+			c.geometryStep(xnew, j)
+			f, viol, ok, err := c.evaluate(e, conNew, xnew)
+			if !ok {
+				return errStatus(err), err
+			}
+			c.setVertex(c.others[j], xnew, f, conNew, viol)
+			continue
+		}
+
+		s := c.trustRegionStep()
+		snorm := floats.Norm(s, 2)
+		if snorm < 0.5*c.rho {
+			checkGeometry = true
+			continue
+		}
+
+		// Increase the penalty parameter if the predicted reduction of the
+		// merit function is not positive.
+		as := make([]float64, c.NumInequality)
+		prerec := c.viol[c.best] - c.linearViolation(as, s)
+		gs := floats.Dot(c.g, s)
+		if prerec > 0 {
+			if barmu := gs / prerec; c.mu < 1.5*barmu {
+				c.mu = 2 * barmu
+				if c.bestVertex() != c.best {
+					continue
+				}
+			}
+		}
+		pred := c.mu*prerec - gs
+
+		// The barycentric coordinates of the step with respect to the
+		// vertices other than the best one solve Dᵀα = s.
+		var alpha mat.VecDense
+		alpha.MulVec(c.dinv.T(), mat.NewVecDense(n, s))
+
+		floats.AddTo(xnew, xb, s)
+		fNew, violNew, ok, err := c.evaluate(e, conNew, xnew)
+		if !ok {
+			return errStatus(err), err
+		}
+		meritBest := c.merit(c.f[c.best], c.viol[c.best])
+		meritNew := c.merit(fNew, violNew)
+		ratio := -1.0
+		if pred > 0 {
+			ratio = (meritBest - meritNew) / pred
+		}
+
+		// Replace the vertex with the largest barycentric coordinate, which
+		// keeps the simplex best shaped, or, among the vertices whose
+		// replacement keeps the simplex acceptable, the one farthest from
+		// the best vertex after the step. If the trial location is not an
+		// improvement, it only replaces a vertex if the volume of the
+		// simplex increases.
+		improved := meritNew < meritBest
+		jdrop := -1
+		maxAlpha := 1.0
+		if improved {
+			maxAlpha = 0
+		}
+		maxDist := cobylaReplaceDist * c.rho
+		far := -1
+		for j := range c.others {
+			a := math.Abs(alpha.AtVec(j))
+			if a > maxAlpha {
+				jdrop = j
+				maxAlpha = a
+			}
+			// The distance of the vertex from its opposite face, and that
+			// of the trial location if it replaces the vertex.
+			sigma := 1 / mat.Norm(c.dinv.ColView(j), 2)
+			if sigmaNew := a * sigma; sigmaNew < cobylaMinSigma*c.rho && sigmaNew < sigma {
+				continue
+			}
+			dist := floats.Norm(c.d.RawRowView(j), 2)
+			if improved {
+				dist = floats.Distance(c.d.RawRowView(j), s, 2)
+			}
+			if dist > maxDist {
+				far = j
+				maxDist = dist
+			}
+		}
+		if far >= 0 {
+			jdrop = far
+		}
+		if jdrop >= 0 {
+			c.setVertex(c.others[jdrop], xnew, fNew, conNew, violNew)
+		}
+		if ratio < 0.1 {
+			checkGeometry = true
+		}
+	}
+}
+
+// errStatus returns the status of the method after it has been stopped
+// with the error err.
+func errStatus(err error) Status {
+	if err != nil {
+		return Failure
+	}
+	return NotTerminated
+}
+
+// evaluate evaluates the objective and the constraint functions at x. It
+// stores the constraint values into con and returns the function value and
+// the constraint violation. evaluate returns false if the optimization has
+// been stopped or the function values are invalid.
+func (c *COBYLA) evaluate(e *dfoEvaluator, con, x []float64) (f, viol float64, ok bool, err error) {
+	f, ok = e.evaluateOne(x)
+	if !ok {
+		return f, 0, false, nil
+	}
+	viol, err = c.constraints(con, x, f)
+	return f, viol, err == nil, err
+}
+
+// constraints evaluates the constraint functions at x into con and returns
+// the constraint violation. It returns an error if the function value f or
+// the constraint values are invalid.
+func (c *COBYLA) constraints(con, x []float64, f float64) (float64, error) {
+	if math.IsNaN(f) || math.IsInf(f, 1) {
+		return 0, ErrFunc(f)
+	}
+	if c.NumInequality == 0 {
+		return 0, nil
+	}
+	c.Inequality(con, x)
+	var viol float64
+	for _, v := range con {
+		if math.IsNaN(v) {
+			return 0, ErrFunc(v)
+		}
+		viol = math.Max(viol, -v)
+	}
+	return viol, nil
+}
+
+// conRow returns the constraint values of vertex k.
+func (c *COBYLA) conRow(k int) []float64 {
+	if c.NumInequality == 0 {
+		return nil
+	}
+	return c.con.RawRowView(k)
+}
+
+// setVertex sets vertex k to the location x with the function value f, the
+// constraint values con and the constraint violation viol.
+func (c *COBYLA) setVertex(k int, x []float64, f float64, con []float64, viol float64) {
+	c.x.SetRow(k, x)
+	c.f[k] = f
+	copy(c.conRow(k), con)
+	c.viol[k] = viol
+}
+
+// merit returns the merit function for the function value f and the
+// constraint violation viol.
+func (c *COBYLA) merit(f, viol float64) float64 {
+	if c.mu == 0 {
+		return f
+	}
+	return f + c.mu*viol
+}
+
+// bestVertex returns the index of the vertex with the smallest merit
+// function, with ties broken by the constraint violation.
+func (c *COBYLA) bestVertex() int {
+	best := 0
+	for k := 1; k <= c.dim; k++ {
+		mk, mb := c.merit(c.f[k], c.viol[k]), c.merit(c.f[best], c.viol[best])
+		if mk < mb || (mk == mb && c.viol[k] < c.viol[best]) {
+			best = k
+		}
+	}
+	return best
+}
+
+// linearModels computes the displacements of the vertices from the best
+// vertex, their inverse and the linear models of the objective and the
+// constraint functions. It returns false if the simplex is degenerate.
+func (c *COBYLA) linearModels() bool {
+	n, m := c.dim, c.NumInequality
+	xb := c.x.RawRowView(c.best)
+	j := 0
+	for k := 0; k <= n; k++ {
+		if k == c.best {
+			continue
+		}
+		c.others[j] = k
+		floats.SubTo(c.d.RawRowView(j), c.x.RawRowView(k), xb)
+		j++
+	}
+	c.lu.Factorize(c.d)
+	if c.lu.Cond() > cobylaCondTol {
+		return false
+	}
+	eye := mat.NewDiagDense(n, nil)
+	for i := 0; i < n; i++ {
+		eye.SetDiag(i, 1)
+	}
+	if err := c.lu.SolveTo(c.dinv, false, eye); err != nil {
+		if _, ok := err.(mat.Condition); !ok {
+			return false
+		}
+	}
+	// The gradients solve D g = f_j - f_best.
+	df := make([]float64, n)
+	for j, k := range c.others {
+		df[j] = c.f[k] - c.f[c.best]
+	}
+	gv := mat.NewVecDense(n, c.g)
+	gv.MulVec(c.dinv, mat.NewVecDense(n, df))
+	if m > 0 {
+		dc := mat.NewDense(n, m, nil)
+		for j, k := range c.others {
+			floats.SubTo(dc.RawRowView(j), c.con.RawRowView(k), c.con.RawRowView(c.best))
+		}
+		c.a.Mul(dc.T(), c.dinv.T())
+	}
+	return true
+}
+
+// badVertex returns the index in others of a vertex that makes the simplex
+// unacceptable, or -1 if the simplex is acceptable. A vertex that is too far
+// from the best vertex is preferred.
+func (c *COBYLA) badVertex() int {
+	far, farDist := -1, cobylaMaxDist*c.rho
+	flat, flatSigma := -1, cobylaMinSigma*c.rho
+	for j := range c.others {
+		if d := floats.Norm(c.d.RawRowView(j), 2); d > farDist {
+			far, farDist = j, d
+		}
+		// The distance of the vertex from its opposite face is the
+		// reciprocal of the norm of the corresponding column of D⁻¹.
+		if sigma := 1 / mat.Norm(c.dinv.ColView(j), 2); sigma < flatSigma {
+			flat, flatSigma = j, sigma
+		}
+	}
+	if far >= 0 {
+		return far
+	}
+	return flat
+}
+
+// geometryStep stores into x a location that replaces vertex j of others.
+// The location is at a distance proportional to rho from the best vertex
+// along the normal of the face opposite vertex j, with the sign chosen to
+// reduce the linearized merit function.
+func (c *COBYLA) geometryStep(x []float64, j int) {
+	n := c.dim
+	dx := make([]float64, n)
+	mat.Col(dx, j, c.dinv)
+	floats.Scale(cobylaGeometryStep*c.rho/floats.Norm(dx, 2), dx)
+	as := make([]float64, c.NumInequality)
+	if c.NumInequality > 0 {
+		var v mat.VecDense
+		v.MulVec(c.a, mat.NewVecDense(n, dx))
+		copy(as, v.RawVector().Data)
+	}
+	plus := floats.Dot(c.g, dx) + c.mu*c.linearViolation(as, nil)
+	floats.Scale(-1, as)
+	minus := -floats.Dot(c.g, dx) + c.mu*c.linearViolation(as, nil)
+	if minus < plus {
+		floats.Scale(-1, dx)
+	}
+	floats.AddTo(x, c.x.RawRowView(c.best), dx)
+}
+
+// linearViolation returns the violation of the linearized constraints after
+// a step whose change of the constraints is as.
+func (c *COBYLA) linearViolation(as, s []float64) float64 {
+	if c.NumInequality == 0 {
+		return 0
+	}
+	if s != nil {
+		var v mat.VecDense
+		v.MulVec(c.a, mat.NewVecDense(c.dim, s))
+		copy(as, v.RawVector().Data)
+	}
+	cb := c.con.RawRowView(c.best)
+	var viol float64
+	for i, v := range cb {
+		viol = math.Max(viol, -(v + as[i]))
+	}
+	return viol
+}
+
+// rebuild replaces the vertices other than the best one by steps of length
+// rho along the coordinates.
+func (c *COBYLA) rebuild(e *dfoEvaluator) (bool, error) {
+	n := c.dim
+	xb := c.x.RawRowView(c.best)
+	xs := make([][]float64, 0, n)
+	ks := make([]int, 0, n)
+	j := 0
+	for k := 0; k <= n; k++ {
+		if k == c.best {
+			continue
+		}
+		row := c.x.RawRowView(k)
+		copy(row, xb)
+		row[j] += c.rho
+		j++
+		xs = append(xs, row)
+		ks = append(ks, k)
+	}
+	fs := make([]float64, n)
+	if !e.evaluate(xs, fs) {
+		return false, nil
+	}
+	for i, k := range ks {
+		c.f[k] = fs[i]
+		viol, err := c.constraints(c.conRow(k), c.x.RawRowView(k), c.f[k])
+		if err != nil {
+			return false, err
+		}
+		c.viol[k] = viol
+	}
+	return true, nil
+}
+
+// reduceRho halves the resolution and reduces the penalty parameter if the
+// spread of the objective function over the simplex allows it.
+func (c *COBYLA) reduceRho() {
+	c.rho *= 0.5
+	if c.rho <= 1.5*c.rhoEnd {
+		c.rho = c.rhoEnd
+	}
+	if c.mu == 0 {
+		return
+	}
+	var denom float64
+	for i := 0; i < c.NumInequality; i++ {
+		col := mat.Col(nil, i, c.con)
+		cmin, cmax := floats.Min(col), floats.Max(col)
+		if cmin < 0.5*cmax {
+			v := math.Max(cmax, 0) - cmin
+			if denom <= 0 || v < denom {
+				denom = v
+			}
+		}
+	}
+	fmin, fmax := floats.Min(c.f), floats.Max(c.f)
+	switch {
+	case denom == 0:
+		c.mu = 0
+	case fmax-fmin < c.mu*denom:
+		c.mu = (fmax - fmin) / denom
+	}
+}
+
+// trustRegionStep returns the step from the best vertex that minimizes the
+// largest violation of the linearized constraints within the trust region,
+// and then minimizes the linearized objective function subject to not
+// increasing that violation.
+//
+// For a violation level t, the step that minimizes gᵀs over the polyhedron
+// P_t = {s : c_i + a_iᵀs >= -t} intersected with the ball of radius rho is
+// the projection of -u*g onto P_t for the u at which its norm equals rho, or
+// the solution of the linear program if it lies within the ball. The norms
+// of the projections increase with u and decrease with t, so both stages
+// are solved by bisection.
+func (c *COBYLA) trustRegionStep() []float64 {
+	n, m := c.dim, c.NumInequality
+	rho := c.rho
+	gnorm := floats.Norm(c.g, 2)
+	if m == 0 {
+		s := make([]float64, n)
+		if gnorm > 0 {
+			floats.ScaleTo(s, -rho/gnorm, c.g)
+		}
+		return s
+	}
+
+	cb := c.con.RawRowView(c.best)
+	var na mat.Dense
+	na.Scale(-1, c.a)
+	h := make([]float64, m)
+	z := make([]float64, n)
+	var warm *qp.Result
+	// project returns the projection of z onto P_t.
+	project := func(t float64) ([]float64, bool) {
+		for i, v := range cb {
+			h[i] = v + t
+		}
+		nz := make([]float64, n)
+		floats.ScaleTo(nz, -1, z)
+		res, err := qp.ActiveSet(c.eye, nz, &na, h, nil, nil, &qp.ActiveSetSettings{WarmStart: warm})
+		if err != nil {
+			return nil, false
+		}
+		warm = res
+		return res.X, true
+	}
+	const maxBisect = 60
+	const relTol = 1e-6
+
+	// Find the smallest violation level for which P_t intersects the ball.
+	t0 := c.viol[c.best]
+	var t float64
+	s, ok := project(0)
+	if !ok || floats.Norm(s, 2) > rho {
+		lo, hi := 0.0, t0
+		for i := 0; i < maxBisect && hi-lo > relTol*t0; i++ {
+			mid := 0.5 * (lo + hi)
+			if s, ok := project(mid); ok && floats.Norm(s, 2) <= rho {
+				hi = mid
+			} else {
+				lo = mid
+			}
+		}
+		t = hi
+		if s, ok = project(t); !ok {
+			return make([]float64, n)
+		}
+	}
+	if gnorm == 0 || floats.Norm(s, 2) >= (1-relTol)*rho {
+		return s
+	}
+
+	// Minimize the linearized objective function over P_t within the ball.
+	lo, sLo := 0.0, s
+	hi := rho / gnorm
+	for i := 0; ; i++ {
+		floats.ScaleTo(z, -hi, c.g)
+		s, ok := project(t)
+		if !ok {
+			return sLo
+		}
+		if floats.Norm(s, 2) > rho {
+			break
+		}
+		lo, sLo = hi, s
+		if i == maxBisect {
+			// The linear program has a solution within the ball.
+			return sLo
+		}
+		hi *= 2
+	}
+	for i := 0; i < maxBisect && floats.Norm(sLo, 2) < (1-relTol)*rho; i++ {
+		mid := 0.5 * (lo + hi)
+		floats.ScaleTo(z, -mid, c.g)
+		s, ok := project(t)
+		if !ok {
+			break
+		}
+		if floats.Norm(s, 2) <= rho {
+			lo, sLo = mid, s
+		} else {
+			hi = mid
+		}
+	}
+	return sLo
+}
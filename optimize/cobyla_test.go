@@ -0,0 +1,199 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/optimize/functions"
+)
+
+func TestCOBYLA(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name   string
+		f      func([]float64) float64
+		c      func(dst, x []float64)
+		numC   int
+		x      []float64
+		wantX  []float64
+		wantF  float64
+		maxEva int
+	}{
+		{
+			name: "HalfPlane",
+			f: func(x []float64) float64 {
+				return x[0]*x[0] + x[1]*x[1]
+			},
+			c: func(dst, x []float64) {
+				dst[0] = x[0] + x[1] - 1
+			},
+			numC:   1,
+			x:      []float64{2, 0},
+			wantX:  []float64{0.5, 0.5},
+			wantF:  0.5,
+			maxEva: 200,
+		},
+		{
+			name: "LinearDisk",
+			f: func(x []float64) float64 {
+				return -x[0] - x[1]
+			},
+			c: func(dst, x []float64) {
+				dst[0] = 1 - x[0]*x[0] - x[1]*x[1]
+			},
+			numC:   1,
+			x:      []float64{0, 0},
+			wantX:  []float64{math.Sqrt2 / 2, math.Sqrt2 / 2},
+			wantF:  -math.Sqrt2,
+			maxEva: 200,
+		},
+		{
+			// The initial location is infeasible.
+			name: "SaddleDisk",
+			f: func(x []float64) float64 {
+				return x[0] * x[1]
+			},
+			c: func(dst, x []float64) {
+				dst[0] = 1 - x[0]*x[0] - x[1]*x[1]
+			},
+			numC:   1,
+			x:      []float64{2, -1},
+			wantX:  []float64{math.Sqrt2 / 2, -math.Sqrt2 / 2},
+			wantF:  -0.5,
+			maxEva: 300,
+		},
+		{
+			// Problem 43 of Hock and Schittkowski, also known as the
+			// Rosen-Suzuki problem.
+			name: "RosenSuzuki",
+			f: func(x []float64) float64 {
+				return x[0]*x[0] + x[1]*x[1] + 2*x[2]*x[2] + x[3]*x[3] -
+					5*x[0] - 5*x[1] - 21*x[2] + 7*x[3]
+			},
+			c: func(dst, x []float64) {
+				dst[0] = 8 - x[0]*x[0] - x[1]*x[1] - x[2]*x[2] - x[3]*x[3] - x[0] + x[1] - x[2] + x[3]
+				dst[1] = 10 - x[0]*x[0] - 2*x[1]*x[1] - x[2]*x[2] - 2*x[3]*x[3] + x[0] + x[3]
+				dst[2] = 5 - 2*x[0]*x[0] - x[1]*x[1] - x[2]*x[2] - 2*x[0] + x[1] + x[3]
+			},
+			numC:   3,
+			x:      []float64{0, 0, 0, 0},
+			wantX:  []float64{0, 1, 2, -1},
+			wantF:  -44,
+			maxEva: 500,
+		},
+		{
+			name:   "Unconstrained",
+			f:      functions.Beale{}.Func,
+			x:      []float64{1, 1},
+			wantX:  []float64{3, 0.5},
+			maxEva: 5000,
+		},
+	} {
+		method := &COBYLA{
+			Inequality:    test.c,
+			NumInequality: test.numC,
+			FinalRadius:   1e-8,
+		}
+		var first *Result
+		for _, concurrent := range []int{0, 3} {
+			settings := &Settings{
+				Converger:       NeverTerminate{},
+				Concurrent:      concurrent,
+				FuncEvaluations: test.maxEva,
+			}
+			result, err := Minimize(Problem{Func: test.f}, test.x, settings, method)
+			if err != nil {
+				t.Errorf("%s (concurrent %d): unexpected error: %v", test.name, concurrent, err)
+				continue
+			}
+			if result.Status != MethodConverge {
+				t.Errorf("%s (concurrent %d): unexpected status: got %v, want %v", test.name, concurrent, result.Status, MethodConverge)
+			}
+			if math.Abs(result.F-test.wantF) > 1e-6 {
+				t.Errorf("%s (concurrent %d): unexpected minimum: got %v, want %v", test.name, concurrent, result.F, test.wantF)
+			}
+			if !floats.EqualApprox(result.X, test.wantX, 1e-4) {
+				t.Errorf("%s (concurrent %d): unexpected minimum location: got %v, want %v", test.name, concurrent, result.X, test.wantX)
+			}
+			if test.numC > 0 {
+				con := make([]float64, test.numC)
+				test.c(con, result.X)
+				if min := floats.Min(con); min < -1e-6 {
+					t.Errorf("%s (concurrent %d): constraint violated at the minimum: %v", test.name, concurrent, min)
+				}
+			}
+			if first == nil {
+				first = result
+			} else if result.F != first.F || !floats.Equal(result.X, first.X) || result.FuncEvaluations != first.FuncEvaluations {
+				t.Errorf("%s: result depends on the concurrency", test.name)
+			}
+		}
+	}
+}
+
+func TestCOBYLAInitValues(t *testing.T) {
+	t.Parallel()
+	problem := Problem{
+		Func: func(x []float64) float64 {
+			return x[0]*x[0] + x[1]*x[1]
+		},
+	}
+	method := &COBYLA{
+		Inequality: func(dst, x []float64) {
+			dst[0] = x[0] + x[1] - 1
+		},
+		NumInequality: 1,
+	}
+	x := []float64{2, 0}
+	settings := &Settings{Converger: NeverTerminate{}}
+	result, err := Minimize(problem, x, settings, method)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	settings.InitValues = &Location{F: problem.Func(x)}
+	result2, err := Minimize(problem, x, settings, method)
+	if err != nil {
+		t.Fatalf("unexpected error with initial values: %v", err)
+	}
+	if result.F != result2.F || !floats.Equal(result.X, result2.X) {
+		t.Errorf("different minimum with initial values")
+	}
+	if result.FuncEvaluations != result2.FuncEvaluations+1 {
+		t.Errorf("initial values do not reduce the number of evaluations by one: got %d and %d",
+			result.FuncEvaluations, result2.FuncEvaluations)
+	}
+}
+
+func TestCOBYLAFuncError(t *testing.T) {
+	t.Parallel()
+	problem := Problem{Func: functions.ExtendedRosenbrock{}.Func}
+	method := &COBYLA{
+		Inequality: func(dst, x []float64) {
+			dst[0] = math.NaN()
+		},
+		NumInequality: 1,
+	}
+	_, err := Minimize(problem, []float64{-1.2, 1}, nil, method)
+	if _, ok := err.(ErrFunc); !ok {
+		t.Errorf("unexpected error: got %v, want ErrFunc", err)
+	}
+}
+
+func TestCOBYLAPanics(t *testing.T) {
+	t.Parallel()
+	for k, method := range []*COBYLA{
+		{NumInequality: -1},
+		{NumInequality: 1},
+		{InitRadius: 1e-3, FinalRadius: 1e-2},
+		{FinalRadius: -1},
+	} {
+		if !panics(func() { method.Init(2, 1) }) {
+			t.Errorf("case %d: expected panic", k)
+		}
+	}
+}
@@ -0,0 +1,272 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"time"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+const defaultConstrainedTolerance = 1e-8
+
+// ConstrainedProblem describes a nonlinear optimization problem with equality
+// and inequality constraints
+//
+//	minimize f(x)
+//	subject to c_E(x) = 0,
+//	           c_I(x) >= 0,
+//
+// where f is the objective function given by the embedded Problem, c_E is the
+// vector of NumEquality equality constraint functions and c_I is the vector
+// of NumInequality inequality constraint functions.
+type ConstrainedProblem struct {
+	// Problem holds the objective function. Func must not be nil. If Grad is
+	// nil, the gradient is approximated with central finite differences
+	// using fd.Gradient. Hess is not used.
+	Problem
+
+	// Equality evaluates the equality constraints c_E at x and stores the
+	// result in dst. Equality must not modify x.
+	Equality func(dst, x []float64)
+	// EqualityJac evaluates the NumEquality×n Jacobian of the equality
+	// constraints at x and stores the result in dst. EqualityJac must not
+	// modify x. If EqualityJac is nil, the Jacobian is approximated with
+	// central finite differences using fd.Jacobian.
+	EqualityJac func(dst *mat.Dense, x []float64)
+	// NumEquality is the number of equality constraints.
+	NumEquality int
+
+	// Inequality evaluates the inequality constraints c_I at x and stores the
+	// result in dst. Inequality must not modify x.
+	Inequality func(dst, x []float64)
+	// InequalityJac evaluates the NumInequality×n Jacobian of the inequality
+	// constraints at x and stores the result in dst. InequalityJac must not
+	// modify x. If InequalityJac is nil, the Jacobian is approximated with
+	// central finite differences using fd.Jacobian.
+	InequalityJac func(dst *mat.Dense, x []float64)
+	// NumInequality is the number of inequality constraints.
+	NumInequality int
+}
+
+// KKTResiduals holds the residuals of the Karush-Kuhn-Tucker first-order
+// optimality conditions
+//
+//	∇f(x) - J_E(x)ᵀλ - J_I(x)ᵀμ = 0,
+//	c_E(x) = 0,
+//	c_I(x) >= 0,
+//	μ >= 0,
+//	μ_i c_I,i(x) = 0,
+//
+// at a location x with the Lagrange multipliers λ and μ.
+type KKTResiduals struct {
+	// Stationarity is the infinity norm of the gradient of the Lagrangian.
+	Stationarity float64
+	// Feasibility is the largest violation of the constraints.
+	Feasibility float64
+	// Complementarity is the largest absolute value of μ_i c_I,i(x).
+	Complementarity float64
+}
+
+// ConstrainedResult represents the answer of a constrained optimization.
+type ConstrainedResult struct {
+	// Location is the final location. Gradient holds the gradient of the
+	// objective function.
+	Location
+
+	// Equality and Inequality are the values of the constraint functions at
+	// the final location.
+	Equality   []float64
+	Inequality []float64
+
+	// EqualityMultipliers and InequalityMultipliers are the estimates of the
+	// Lagrange multipliers λ and μ of the equality and inequality
+	// constraints.
+	EqualityMultipliers   []float64
+	InequalityMultipliers []float64
+
+	// KKT holds the residuals of the optimality conditions at the final
+	// location.
+	KKT KKTResiduals
+
+	// Stats holds the statistics of the optimization. MajorIterations counts
+	// the outer iterations of the method and the evaluation counts are
+	// summed over all inner minimizations.
+	Stats
+
+	// Status is the status of the optimization. It is Success if the KKT
+	// residuals are below the tolerances.
+	Status Status
+}
+
+// ConstrainedSettings holds the settings for MinimizeConstrained.
+type ConstrainedSettings struct {
+	// OptimalityTolerance is the tolerance on the stationarity and the
+	// complementarity KKT residuals. If it is zero, it is defaulted to 1e-8.
+	OptimalityTolerance float64
+	// FeasibilityTolerance is the tolerance on the largest constraint
+	// violation. If it is zero, it is defaulted to 1e-8.
+	FeasibilityTolerance float64
+
+	// MajorIterations is the maximum number of outer iterations. If it is
+	// zero, the number of iterations is not limited.
+	MajorIterations int
+
+	// Inner holds the settings for the unconstrained inner minimizations.
+	// The GradientThreshold is set by the method and Concurrent is ignored.
+	// If Inner is nil, the zero value is used.
+	Inner *Settings
+}
+
+// MinimizeConstrained finds a local minimizer of the constrained problem p
+// starting at initX. If method is nil, the zero value of AugmentedLagrangian
+// is used. If settings is nil, the zero value is used.
+//
+// MinimizeConstrained returns the final location together with the values of
+// the constraints, the estimates of the Lagrange multipliers and the KKT
+// residuals. An error is returned if an inner minimization could not start
+// or if the penalty parameter exceeds its limit, which usually indicates
+// that the constraints are infeasible.
+func MinimizeConstrained(p ConstrainedProblem, initX []float64, settings *ConstrainedSettings, method *AugmentedLagrangian) (*ConstrainedResult, error) {
+	startTime := time.Now()
+	if p.Func == nil {
+		panic("optimize: objective function is undefined")
+	}
+	if p.NumEquality < 0 || p.NumInequality < 0 {
+		panic("optimize: negative number of constraints")
+	}
+	if p.NumEquality > 0 && p.Equality == nil {
+		panic("optimize: equality constraint function is undefined")
+	}
+	if p.NumInequality > 0 && p.Inequality == nil {
+		panic("optimize: inequality constraint function is undefined")
+	}
+	dim := len(initX)
+	if dim == 0 {
+		return nil, ErrZeroDimensional
+	}
+	if settings == nil {
+		settings = &ConstrainedSettings{}
+	}
+	if method == nil {
+		method = &AugmentedLagrangian{}
+	}
+	optTol := settings.OptimalityTolerance
+	if optTol == 0 {
+		optTol = defaultConstrainedTolerance
+	}
+	feasTol := settings.FeasibilityTolerance
+	if feasTol == 0 {
+		feasTol = defaultConstrainedTolerance
+	}
+
+	c := newConstraintEvaluator(&p, dim)
+	result, err := method.minimize(c, initX, settings, optTol, feasTol)
+	if result != nil {
+		result.Runtime = time.Since(startTime)
+	}
+	return result, err
+}
+
+// constraintEvaluator evaluates the objective and the constraint functions
+// and their derivatives, using finite differences where needed.
+type constraintEvaluator struct {
+	p   *ConstrainedProblem
+	dim int
+
+	eq, ineq       []float64
+	eqJac, ineqJac *mat.Dense
+}
+
+func newConstraintEvaluator(p *ConstrainedProblem, dim int) *constraintEvaluator {
+	c := &constraintEvaluator{
+		p:    p,
+		dim:  dim,
+		eq:   make([]float64, p.NumEquality),
+		ineq: make([]float64, p.NumInequality),
+	}
+	if p.NumEquality > 0 {
+		c.eqJac = mat.NewDense(p.NumEquality, dim, nil)
+	}
+	if p.NumInequality > 0 {
+		c.ineqJac = mat.NewDense(p.NumInequality, dim, nil)
+	}
+	return c
+}
+
+// constraints evaluates the constraints at x into c.eq and c.ineq.
+func (c *constraintEvaluator) constraints(x []float64) {
+	if c.p.NumEquality > 0 {
+		c.p.Equality(c.eq, x)
+	}
+	if c.p.NumInequality > 0 {
+		c.p.Inequality(c.ineq, x)
+	}
+}
+
+// jacobians evaluates the constraint Jacobians at x into c.eqJac and
+// c.ineqJac. Missing Jacobians are approximated with central differences,
+// which are accurate enough for the tight tolerances on the KKT residuals.
+func (c *constraintEvaluator) jacobians(x []float64) {
+	if c.p.NumEquality > 0 {
+		if c.p.EqualityJac != nil {
+			c.p.EqualityJac(c.eqJac, x)
+		} else {
+			fd.Jacobian(c.eqJac, c.p.Equality, x, &fd.JacobianSettings{Formula: fd.Central})
+		}
+	}
+	if c.p.NumInequality > 0 {
+		if c.p.InequalityJac != nil {
+			c.p.InequalityJac(c.ineqJac, x)
+		} else {
+			fd.Jacobian(c.ineqJac, c.p.Inequality, x, &fd.JacobianSettings{Formula: fd.Central})
+		}
+	}
+}
+
+// grad evaluates the gradient of the objective function at x into dst.
+func (c *constraintEvaluator) grad(dst, x []float64) {
+	if c.p.Grad != nil {
+		c.p.Grad(dst, x)
+		return
+	}
+	fd.Gradient(dst, c.p.Func, x, &fd.Settings{Formula: fd.Central})
+}
+
+// kkt returns the KKT residuals at x with the multipliers lambda and mu. The
+// constraints and their Jacobians must have been evaluated at x, and grad
+// must hold the gradient of the objective function at x. The gradient of the
+// Lagrangian is stored into work.
+func (c *constraintEvaluator) kkt(work, grad, lambda, mu []float64) KKTResiduals {
+	copy(work, grad)
+	w := mat.NewVecDense(c.dim, work)
+	if c.p.NumEquality > 0 {
+		w.AddVec(w, mulTransVec(c.eqJac, lambda, -1))
+	}
+	if c.p.NumInequality > 0 {
+		w.AddVec(w, mulTransVec(c.ineqJac, mu, -1))
+	}
+	var r KKTResiduals
+	r.Stationarity = floats.Norm(work, math.Inf(1))
+	for _, v := range c.eq {
+		r.Feasibility = math.Max(r.Feasibility, math.Abs(v))
+	}
+	for i, v := range c.ineq {
+		r.Feasibility = math.Max(r.Feasibility, -v)
+		r.Complementarity = math.Max(r.Complementarity, math.Abs(mu[i]*v))
+	}
+	return r
+}
+
+// mulTransVec returns alpha * aᵀv.
+func mulTransVec(a *mat.Dense, v []float64, alpha float64) *mat.VecDense {
+	var dst mat.VecDense
+	dst.MulVec(a.T(), mat.NewVecDense(len(v), v))
+	dst.ScaleVec(alpha, &dst)
+	return &dst
+}
@@ -0,0 +1,259 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize/functions"
+)
+
+type constrainedTest struct {
+	name string
+	p    ConstrainedProblem
+	x    []float64
+
+	want       []float64
+	wantF      float64
+	wantLambda []float64
+	wantMu     []float64
+	tol        float64
+}
+
+var constrainedTests = []constrainedTest{
+	{
+		// minimize (x-1)² + (y-2)² subject to x + y = 1.
+		name: "EqualityQuadratic",
+		p: ConstrainedProblem{
+			Problem: Problem{
+				Func: func(x []float64) float64 {
+					return (x[0]-1)*(x[0]-1) + (x[1]-2)*(x[1]-2)
+				},
+				Grad: func(grad, x []float64) {
+					grad[0] = 2 * (x[0] - 1)
+					grad[1] = 2 * (x[1] - 2)
+				},
+			},
+			Equality: func(dst, x []float64) {
+				dst[0] = x[0] + x[1] - 1
+			},
+			EqualityJac: func(dst *mat.Dense, x []float64) {
+				dst.Set(0, 0, 1)
+				dst.Set(0, 1, 1)
+			},
+			NumEquality: 1,
+		},
+		x:          []float64{5, 5},
+		want:       []float64{0, 1},
+		wantF:      2,
+		wantLambda: []float64{-2},
+	},
+	{
+		// minimize x² + y² subject to x + y >= 1.
+		name: "ActiveInequality",
+		p: ConstrainedProblem{
+			Problem: Problem{
+				Func: func(x []float64) float64 {
+					return x[0]*x[0] + x[1]*x[1]
+				},
+				Grad: func(grad, x []float64) {
+					grad[0] = 2 * x[0]
+					grad[1] = 2 * x[1]
+				},
+			},
+			Inequality: func(dst, x []float64) {
+				dst[0] = x[0] + x[1] - 1
+			},
+			InequalityJac: func(dst *mat.Dense, x []float64) {
+				dst.Set(0, 0, 1)
+				dst.Set(0, 1, 1)
+			},
+			NumInequality: 1,
+		},
+		x:      []float64{-3, 2},
+		want:   []float64{0.5, 0.5},
+		wantF:  0.5,
+		wantMu: []float64{1},
+	},
+	{
+		// minimize x² + y² subject to x + y >= -1. The constraint is
+		// inactive at the solution.
+		name: "InactiveInequality",
+		p: ConstrainedProblem{
+			Problem: Problem{
+				Func: func(x []float64) float64 {
+					return x[0]*x[0] + x[1]*x[1]
+				},
+				Grad: func(grad, x []float64) {
+					grad[0] = 2 * x[0]
+					grad[1] = 2 * x[1]
+				},
+			},
+			Inequality: func(dst, x []float64) {
+				dst[0] = x[0] + x[1] + 1
+			},
+			NumInequality: 1,
+		},
+		x:      []float64{-3, 2},
+		want:   []float64{0, 0},
+		wantF:  0,
+		wantMu: []float64{0},
+	},
+	{
+		// The Rosenbrock function on the unit disk.
+		name: "RosenbrockDisk",
+		p: ConstrainedProblem{
+			Problem: Problem{
+				Func: functions.ExtendedRosenbrock{}.Func,
+				Grad: functions.ExtendedRosenbrock{}.Grad,
+			},
+			Inequality: func(dst, x []float64) {
+				dst[0] = 1 - x[0]*x[0] - x[1]*x[1]
+			},
+			InequalityJac: func(dst *mat.Dense, x []float64) {
+				dst.Set(0, 0, -2*x[0])
+				dst.Set(0, 1, -2*x[1])
+			},
+			NumInequality: 1,
+		},
+		x:     []float64{0, 0},
+		want:  []float64{0.7864151541684254, 0.6176983125233897},
+		wantF: 0.04567480871101,
+		tol:   1e-6,
+	},
+	{
+		// Problem 71 from the Hock-Schittkowski collection with the bounds
+		// written as inequality constraints. The Jacobians are approximated
+		// by finite differences.
+		name: "HS071",
+		p: ConstrainedProblem{
+			Problem: Problem{
+				Func: func(x []float64) float64 {
+					return x[0]*x[3]*(x[0]+x[1]+x[2]) + x[2]
+				},
+				Grad: func(grad, x []float64) {
+					grad[0] = x[3] * (2*x[0] + x[1] + x[2])
+					grad[1] = x[0] * x[3]
+					grad[2] = x[0]*x[3] + 1
+					grad[3] = x[0] * (x[0] + x[1] + x[2])
+				},
+			},
+			Equality: func(dst, x []float64) {
+				dst[0] = floats.Dot(x, x) - 40
+			},
+			NumEquality: 1,
+			Inequality: func(dst, x []float64) {
+				dst[0] = x[0]*x[1]*x[2]*x[3] - 25
+				for i, v := range x {
+					dst[1+2*i] = v - 1
+					dst[2+2*i] = 5 - v
+				}
+			},
+			NumInequality: 9,
+		},
+		x:     []float64{1, 5, 5, 1},
+		want:  []float64{1, 4.742999637, 3.821149978, 1.379408293},
+		wantF: 17.0140172891,
+		tol:   1e-6,
+	},
+}
+
+func TestMinimizeConstrained(t *testing.T) {
+	t.Parallel()
+	for _, test := range constrainedTests {
+		for _, method := range []struct {
+			name string
+			m    *AugmentedLagrangian
+		}{
+			{"LBFGS", nil},
+			{"BFGS", &AugmentedLagrangian{Method: &BFGS{}}},
+		} {
+			name := test.name + "/" + method.name
+			tol := test.tol
+			if tol == 0 {
+				tol = 1e-7
+			}
+			settings := &ConstrainedSettings{
+				OptimalityTolerance:  1e-7,
+				FeasibilityTolerance: 1e-9,
+			}
+			x := make([]float64, len(test.x))
+			copy(x, test.x)
+			result, err := MinimizeConstrained(test.p, x, settings, method.m)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			if !floats.Equal(x, test.x) {
+				t.Errorf("%s: initial location modified", name)
+			}
+			if result.Status != Success {
+				t.Errorf("%s: unexpected status: %v", name, result.Status)
+			}
+			if !floats.EqualApprox(result.X, test.want, tol) {
+				t.Errorf("%s: unexpected solution: got %v, want %v", name, result.X, test.want)
+			}
+			if math.Abs(result.F-test.wantF) > tol {
+				t.Errorf("%s: unexpected minimum value: got %v, want %v", name, result.F, test.wantF)
+			}
+			if test.wantLambda != nil && !floats.EqualApprox(result.EqualityMultipliers, test.wantLambda, tol) {
+				t.Errorf("%s: unexpected equality multipliers: got %v, want %v", name, result.EqualityMultipliers, test.wantLambda)
+			}
+			if test.wantMu != nil && !floats.EqualApprox(result.InequalityMultipliers, test.wantMu, tol) {
+				t.Errorf("%s: unexpected inequality multipliers: got %v, want %v", name, result.InequalityMultipliers, test.wantMu)
+			}
+			for _, mu := range result.InequalityMultipliers {
+				if mu < 0 {
+					t.Errorf("%s: negative inequality multiplier %v", name, mu)
+				}
+			}
+			kkt := result.KKT
+			if kkt.Stationarity > settings.OptimalityTolerance || kkt.Complementarity > settings.OptimalityTolerance ||
+				kkt.Feasibility > settings.FeasibilityTolerance {
+				t.Errorf("%s: KKT residuals too large: %+v", name, kkt)
+			}
+
+			// Check the reported KKT residuals independently.
+			c := newConstraintEvaluator(&test.p, len(x))
+			c.constraints(result.X)
+			c.jacobians(result.X)
+			if !floats.Equal(c.eq, result.Equality) || !floats.Equal(c.ineq, result.Inequality) {
+				t.Errorf("%s: constraint values do not match the solution", name)
+			}
+			grad := make([]float64, len(x))
+			c.grad(grad, result.X)
+			got := c.kkt(make([]float64, len(x)), grad, result.EqualityMultipliers, result.InequalityMultipliers)
+			if got != kkt {
+				t.Errorf("%s: mismatched KKT residuals: got %+v, want %+v", name, kkt, got)
+			}
+		}
+	}
+}
+
+func TestMinimizeConstrainedInfeasible(t *testing.T) {
+	t.Parallel()
+	// The constraints x >= 1 and x <= 0 cannot be satisfied together.
+	p := ConstrainedProblem{
+		Problem: Problem{
+			Func: func(x []float64) float64 { return x[0] * x[0] },
+			Grad: func(grad, x []float64) { grad[0] = 2 * x[0] },
+		},
+		Inequality: func(dst, x []float64) {
+			dst[0] = x[0] - 1
+			dst[1] = -x[0]
+		},
+		NumInequality: 2,
+	}
+	result, err := MinimizeConstrained(p, []float64{3}, nil, nil)
+	if err != ErrPenaltyLimit {
+		t.Errorf("unexpected error: got %v, want %v", err, ErrPenaltyLimit)
+	}
+	if result == nil || result.Status != Failure {
+		t.Errorf("unexpected result: %+v", result)
+	}
+}
@@ -32,38 +32,8 @@ import (
 //
 // If there are no constraints of the given type, the inputs may be nil.
 func Convert(c []float64, g mat.Matrix, h []float64, a mat.Matrix, b []float64) (cNew []float64, aNew *mat.Dense, bNew []float64) {
-	nVar := len(c)
-	nIneq := len(h)
-
-	// Check input sizes.
-	if g == nil {
-		if nIneq != 0 {
-			panic(badShape)
-		}
-	} else {
-		gr, gc := g.Dims()
-		if gr != nIneq {
-			panic(badShape)
-		}
-		if gc != nVar {
-			panic(badShape)
-		}
-	}
-
-	nEq := len(b)
-	if a == nil {
-		if nEq != 0 {
-			panic(badShape)
-		}
-	} else {
-		ar, ac := a.Dims()
-		if ar != nEq {
-			panic(badShape)
-		}
-		if ac != nVar {
-			panic(badShape)
-		}
-	}
+	checkGeneralForm(c, g, h, a, b)
+	nVar, nIneq, nEq := len(c), len(h), len(b)
 
 	// Convert the general form LP.
 	// Derivation:
@@ -106,6 +76,39 @@ func Convert(c []float64, g mat.Matrix, h []float64, a mat.Matrix, b []float64)
 	//   		[A, -A, 0] xt = b
 	//			x >= 0
 
+	nNewVar, nNewEq := nVar+nVar+nIneq, nIneq+nEq
+	aNew = mat.NewDense(nNewEq, nNewVar, nil)
+	cNew, bNew = convert(c, g, h, a, b, aNew.Set)
+	return cNew, aNew, bNew
+}
+
+// ConvertSparse converts a General-form LP into a standard form LP in the same
+// way as Convert, but returns the constraint matrix of the standard form LP as
+// a Sparse matrix. The inputs g and a may be any mat.Matrix, including Sparse.
+// ConvertSparse is suitable for large problems where Convert would allocate a
+// prohibitively large dense matrix.
+func ConvertSparse(c []float64, g mat.Matrix, h []float64, a mat.Matrix, b []float64) (cNew []float64, aNew *Sparse, bNew []float64) {
+	checkGeneralForm(c, g, h, a, b)
+	var (
+		rows, cols []int
+		vals       []float64
+	)
+	cNew, bNew = convert(c, g, h, a, b, func(i, j int, v float64) {
+		rows = append(rows, i)
+		cols = append(cols, j)
+		vals = append(vals, v)
+	})
+	return cNew, NewSparse(len(bNew), len(cNew), rows, cols, vals), bNew
+}
+
+// convert computes the standard form of a General-form LP as described in
+// Convert. The non-zero elements of the new constraint matrix are passed to
+// set.
+func convert(c []float64, g mat.Matrix, h []float64, a mat.Matrix, b []float64, set func(i, j int, v float64)) (cNew, bNew []float64) {
+	nVar := len(c)
+	nIneq := len(h)
+	nEq := len(b)
+
 	// New size of x is [xp, xn, s]
 	nNewVar := nVar + nVar + nIneq
 
@@ -124,18 +127,68 @@ func Convert(c []float64, g mat.Matrix, h []float64, a mat.Matrix, b []float64)
 	copy(bNew[nIneq:], b)
 
 	// Construct aNew = [G, -G, I; A, -A, 0].
-	aNew = mat.NewDense(nNewEq, nNewVar, nil)
+	setBlock := func(m mat.Matrix, off int) {
+		fn := func(i, j int, v float64) {
+			if v != 0 {
+				set(off+i, j, v)
+				set(off+i, nVar+j, -v)
+			}
+		}
+		if nz, ok := m.(mat.NonZeroDoer); ok {
+			nz.DoNonZero(fn)
+			return
+		}
+		r, c := m.Dims()
+		for i := 0; i < r; i++ {
+			for j := 0; j < c; j++ {
+				fn(i, j, m.At(i, j))
+			}
+		}
+	}
 	if nIneq != 0 {
-		aNew.Slice(0, nIneq, 0, nVar).(*mat.Dense).Copy(g)
-		aNew.Slice(0, nIneq, nVar, 2*nVar).(*mat.Dense).Scale(-1, g)
-		aView := aNew.Slice(0, nIneq, 2*nVar, 2*nVar+nIneq).(*mat.Dense)
+		setBlock(g, 0)
 		for i := 0; i < nIneq; i++ {
-			aView.Set(i, i, 1)
+			set(i, 2*nVar+i, 1)
 		}
 	}
 	if nEq != 0 {
-		aNew.Slice(nIneq, nIneq+nEq, 0, nVar).(*mat.Dense).Copy(a)
-		aNew.Slice(nIneq, nIneq+nEq, nVar, 2*nVar).(*mat.Dense).Scale(-1, a)
+		setBlock(a, nIneq)
+	}
+	return cNew, bNew
+}
+
+// checkGeneralForm panics if the dimensions of a General-form LP do not match.
+func checkGeneralForm(c []float64, g mat.Matrix, h []float64, a mat.Matrix, b []float64) {
+	nVar := len(c)
+	nIneq := len(h)
+
+	// Check input sizes.
+	if g == nil {
+		if nIneq != 0 {
+			panic(badShape)
+		}
+	} else {
+		gr, gc := g.Dims()
+		if gr != nIneq {
+			panic(badShape)
+		}
+		if gc != nVar {
+			panic(badShape)
+		}
+	}
+
+	nEq := len(b)
+	if a == nil {
+		if nEq != 0 {
+			panic(badShape)
+		}
+	} else {
+		ar, ac := a.Dims()
+		if ar != nEq {
+			panic(badShape)
+		}
+		if ac != nVar {
+			panic(badShape)
+		}
 	}
-	return cNew, aNew, bNew
 }
@@ -0,0 +1,271 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lp
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	// dualFeasTol is the smallest tolerance on the primal feasibility of
+	// the basic variables used by the dual simplex method.
+	dualFeasTol = 1e-12
+	// dualPivotTol is the smallest magnitude of a pivot element in the
+	// ratio test of the dual simplex method.
+	dualPivotTol = 1e-9
+	// basisCondTol is the largest condition number of a basis matrix that
+	// is not treated as singular.
+	basisCondTol = 1e14
+)
+
+// DualSimplex solves a linear program in standard form
+//
+//	minimize	cᵀ x
+//	s.t. 		A*x = b
+//				x >= 0
+//
+// using the dual simplex method starting from the basis given by the indices
+// of the basic variables in basic. The basis must be dual feasible, that is
+// the reduced costs c - Aᵀ y with y = A_B⁻ᵀ c_B must be non-negative up to
+// tol, but it does not need to be primal feasible.
+//
+// The dual simplex method is well suited for re-solving a problem after its
+// right-hand side b has changed or after constraints have been added, since
+// the optimal basis of the original problem remains dual feasible. The
+// returned Result holds the optimal basis in its Basic field, which can be
+// used to warm start the next solve. The Solver type manages the warm starts
+// automatically.
+//
+// The input tol is the tolerance on the primal feasibility of the basic
+// variables. If the problem is infeasible, ErrInfeasible is returned together
+// with a Result holding a certificate of infeasibility. If the basis is
+// singular, ErrSingular is returned.
+//
+// DualSimplex will panic if len(c) does not equal the number of columns of A,
+// if len(b) or len(basic) does not equal the number of rows of A, or if the
+// basis is not dual feasible.
+func DualSimplex(c []float64, A mat.Matrix, b []float64, tol float64, basic []int) (*Result, error) {
+	m, n := A.Dims()
+	if len(c) != n || len(b) != m {
+		panic(badShape)
+	}
+	if len(basic) != m {
+		panic("lp: basic incorrect length")
+	}
+	for _, v := range basic {
+		if v < 0 || v >= n {
+			panic("lp: basic index out of range")
+		}
+	}
+	f, err := newBasisFactor(c, A, b, append([]int(nil), basic...))
+	if err != nil {
+		return nil, err
+	}
+	if !f.dualFeasible(math.Max(tol, dualFeasTol)) {
+		panic("lp: supplied basis is not dual feasible")
+	}
+	return f.dualSimplex(tol)
+}
+
+// basisFactor holds a standard form LP together with the LU factorization of
+// a basis matrix and the corresponding primal and dual solutions.
+type basisFactor struct {
+	c []float64
+	a mat.Matrix
+	b []float64
+
+	basic   []int
+	isBasic []bool
+
+	ab *mat.Dense
+	lu mat.LU
+
+	// xb holds the values of the basic variables, y the dual values and d
+	// the reduced costs of all variables.
+	xb []float64
+	y  []float64
+	d  []float64
+}
+
+// newBasisFactor returns the basisFactor for the basis given by basic. The
+// slice basic is retained and updated by the dual simplex method.
+func newBasisFactor(c []float64, A mat.Matrix, b []float64, basic []int) (*basisFactor, error) {
+	m, n := A.Dims()
+	f := &basisFactor{
+		c:       c,
+		a:       A,
+		b:       b,
+		basic:   basic,
+		isBasic: make([]bool, n),
+		ab:      mat.NewDense(m, m, nil),
+		xb:      make([]float64, m),
+		y:       make([]float64, m),
+		d:       make([]float64, n),
+	}
+	for _, v := range basic {
+		if f.isBasic[v] {
+			return nil, ErrSingular
+		}
+		f.isBasic[v] = true
+	}
+	if err := f.factorize(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// factorize factorizes the basis matrix and computes the basic solution, the
+// dual values and the reduced costs.
+func (f *basisFactor) factorize() error {
+	m := len(f.basic)
+	extractColumns(f.ab, f.a, f.basic)
+	f.lu.Factorize(f.ab)
+	if f.lu.Cond() > basisCondTol {
+		return ErrSingular
+	}
+	err := f.lu.SolveVecTo(mat.NewVecDense(m, f.xb), false, mat.NewVecDense(m, f.b))
+	if err != nil {
+		if _, ok := err.(mat.Condition); !ok {
+			return ErrSingular
+		}
+	}
+	cb := make([]float64, m)
+	for i, v := range f.basic {
+		cb[i] = f.c[v]
+	}
+	err = f.lu.SolveVecTo(mat.NewVecDense(m, f.y), true, mat.NewVecDense(m, cb))
+	if err != nil {
+		if _, ok := err.(mat.Condition); !ok {
+			return ErrSingular
+		}
+	}
+	// d = c - Aᵀ y, which is zero for the basic variables.
+	copy(f.d, f.c)
+	mulTransVec(f.d, f.a, f.y, -1)
+	for _, v := range f.basic {
+		f.d[v] = 0
+	}
+	return nil
+}
+
+// solveUnit stores column i of A_B⁻¹ into dst, or column i of A_B⁻ᵀ if trans
+// is true.
+func (f *basisFactor) solveUnit(dst []float64, i int, trans bool) error {
+	m := len(dst)
+	e := mat.NewVecDense(m, nil)
+	e.SetVec(i, 1)
+	err := f.lu.SolveVecTo(mat.NewVecDense(m, dst), trans, e)
+	if err != nil {
+		if _, ok := err.(mat.Condition); !ok {
+			return ErrSingular
+		}
+	}
+	return nil
+}
+
+// mulTransVec adds alpha * Aᵀ y to dst.
+func mulTransVec(dst []float64, A mat.Matrix, y []float64, alpha float64) {
+	if s, ok := A.(*Sparse); ok {
+		s.mulTransVecTo(dst, alpha, y, 1)
+		return
+	}
+	m, n := A.Dims()
+	var tmp mat.VecDense
+	tmp.MulVec(A.T(), mat.NewVecDense(m, y))
+	floats.AddScaled(dst, alpha, tmp.RawVector().Data[:n])
+}
+
+// primalFeasible returns whether the basic solution is feasible up to tol.
+func (f *basisFactor) primalFeasible(tol float64) bool {
+	return len(f.xb) == 0 || floats.Min(f.xb) >= -tol
+}
+
+// dualFeasible returns whether all reduced costs are at least -tol.
+func (f *basisFactor) dualFeasible(tol float64) bool {
+	return floats.Min(f.d) >= -tol
+}
+
+// dualSimplex runs the dual simplex method from the current dual feasible
+// basis.
+func (f *basisFactor) dualSimplex(tol float64) (*Result, error) {
+	tol = math.Max(tol, dualFeasTol)
+	m, n := f.a.Dims()
+	rho := make([]float64, m)
+	alpha := make([]float64, n)
+	maxIter := 50 * (m + n)
+	for iter := 0; ; iter++ {
+		// Choose the most infeasible basic variable to leave the basis. If
+		// there is none the basis is optimal.
+		r := -1
+		if m > 0 {
+			r = floats.MinIdx(f.xb)
+		}
+		if r < 0 || f.xb[r] >= -tol {
+			return f.result(iter), nil
+		}
+		if iter == maxIter {
+			return f.result(iter), ErrIterationLimit
+		}
+
+		// Compute row r of A_B⁻¹ A, alpha = Aᵀ A_B⁻ᵀ e_r.
+		if err := f.solveUnit(rho, r, true); err != nil {
+			return nil, err
+		}
+		for j := range alpha {
+			alpha[j] = 0
+		}
+		mulTransVec(alpha, f.a, rho, 1)
+
+		// The entering variable keeps the reduced costs non-negative. Ties
+		// are broken by the magnitude of the pivot element.
+		q := -1
+		var minRatio, maxPivot float64
+		for j, aj := range alpha {
+			if f.isBasic[j] || aj > -dualPivotTol {
+				continue
+			}
+			ratio := math.Max(f.d[j], 0) / -aj
+			if q == -1 || ratio < minRatio-dualFeasTol || (ratio <= minRatio+dualFeasTol && -aj > maxPivot) {
+				q = j
+				minRatio = ratio
+				maxPivot = -aj
+			}
+		}
+		if q == -1 {
+			// Row r shows that x_B[r] = ρᵀ b - Σ_j α_j x_j < 0 for all
+			// x >= 0, so ρ is a certificate of infeasibility.
+			cert := make([]float64, m)
+			floats.ScaleTo(cert, 1/floats.Dot(rho, f.b), rho)
+			return &Result{Iterations: iter, Certificate: cert}, ErrInfeasible
+		}
+
+		f.isBasic[f.basic[r]] = false
+		f.isBasic[q] = true
+		f.basic[r] = q
+		if err := f.factorize(); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// result returns the Result for the current basis after iter iterations.
+func (f *basisFactor) result(iter int) *Result {
+	_, n := f.a.Dims()
+	x := make([]float64, n)
+	for i, v := range f.basic {
+		x[v] = math.Max(f.xb[i], 0)
+	}
+	return &Result{
+		F:           floats.Dot(f.c, x),
+		X:           x,
+		Dual:        append([]float64(nil), f.y...),
+		ReducedCost: append([]float64(nil), f.d...),
+		Basic:       append([]int(nil), f.basic...),
+		Iterations:  iter,
+	}
+}
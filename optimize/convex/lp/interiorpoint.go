@@ -0,0 +1,472 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lp
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// ErrIterationLimit is returned when an iterative solver does not converge
+// within the maximum number of iterations.
+var ErrIterationLimit = errors.New("lp: iteration limit reached")
+
+const (
+	defaultInteriorPointTol   = 1e-8
+	defaultInteriorPointIters = 100
+
+	// ipStepFactor is the fraction of the step to the boundary of the
+	// positive orthant that is taken by the interior-point method.
+	ipStepFactor = 0.99
+	// ipRegularization is the initial relative regularization of the normal
+	// equations, which keeps them positive definite when A does not have
+	// full row rank.
+	ipRegularization = 1e-14
+)
+
+// Result holds the solution of a linear program in standard form
+//
+//	minimize	cᵀ x
+//	s.t. 		A*x = b
+//				x >= 0 ,
+//
+// together with the solution of its dual
+//
+//	maximize	bᵀ y
+//	s.t. 		Aᵀ y + s = c
+//				s >= 0 .
+type Result struct {
+	// F is the optimal value of the objective function.
+	F float64
+	// X is the optimal solution of the primal problem.
+	X []float64
+	// Dual holds the dual values y, that is the Lagrange multipliers of the
+	// equality constraints. Dual[i] is the rate of change of the optimal
+	// value with respect to b[i].
+	Dual []float64
+	// ReducedCost holds the reduced costs s = c - Aᵀ y of the variables.
+	ReducedCost []float64
+
+	// Basic holds the indices of the basic variables of the optimal basis
+	// found by a simplex method. It is nil for InteriorPoint.
+	Basic []int
+
+	// Iterations is the number of iterations of the method.
+	Iterations int
+
+	// Certificate is a certificate of infeasibility or unboundedness and it
+	// is only set when the solver returns ErrInfeasible or ErrUnbounded.
+	//
+	// If the error is ErrInfeasible, Certificate is a vector y such that
+	// Aᵀ y <= 0 and bᵀ y = 1, which shows by Farkas' lemma that there is no
+	// x >= 0 with A*x = b.
+	//
+	// If the error is ErrUnbounded, Certificate is a ray x >= 0 such that
+	// A*x = 0 and cᵀ x = -1, which shows that the dual problem is
+	// infeasible. The objective function decreases without bound along the
+	// ray from any feasible point of the primal problem.
+	Certificate []float64
+}
+
+// InteriorPointSettings holds the settings for InteriorPoint.
+type InteriorPointSettings struct {
+	// Tolerance is the relative tolerance on the primal and dual
+	// infeasibility and on the duality gap. If Tolerance is zero, it is
+	// defaulted to 1e-8.
+	Tolerance float64
+	// MaxIterations is the maximum number of iterations. If MaxIterations is
+	// zero, it is defaulted to 100.
+	MaxIterations int
+}
+
+// InteriorPoint solves a linear program in standard form
+//
+//	minimize	cᵀ x
+//	s.t. 		A*x = b
+//				x >= 0
+//
+// using the primal-dual interior-point method with Mehrotra's
+// predictor-corrector steps. The returned Result contains the optimal
+// solution together with the dual values and the reduced costs.
+//
+// InteriorPoint is applied to the homogeneous self-dual embedding of the
+// linear program, so it does not need a feasible starting point and it
+// detects infeasible and unbounded problems. In that case ErrInfeasible or
+// ErrUnbounded is returned together with a Result holding a certificate.
+// ErrUnbounded is returned if the dual problem is infeasible, in which case
+// the primal problem is unbounded if it is feasible. If the method does not
+// converge within the maximum number of iterations, ErrIterationLimit is
+// returned together with the current estimate of the solution. If the normal
+// equations cannot be factorized or solved, ErrLinSolve is returned together
+// with the current estimate.
+//
+// Unlike Simplex, InteriorPoint does not form a dense tableau. It works with
+// a sparse copy of A and only forms the dense m×m normal equations matrix
+// A*D*Aᵀ, where m is the number of rows of A, so it is suitable for problems
+// with many more variables than constraints. If A is a *Sparse, it is used
+// directly, and any other mat.Matrix implementing mat.NonZeroDoer is copied
+// without visiting its zero elements. A does not need to have full row rank.
+// The ConvertSparse function can be used to transform a general LP into
+// standard form.
+//
+// If settings is nil, the zero value is used. InteriorPoint will panic if
+// len(c) does not equal the number of columns of A or if len(b) does not
+// equal the number of rows of A.
+//
+// References:
+//   - Mehrotra, S.: On the implementation of a primal-dual interior point
+//     method. SIAM J. Optim. 2(4) (1992), 575-601
+//   - Andersen, E.D., Andersen, K.D.: The MOSEK interior point optimizer for
+//     linear programming: an implementation of the homogeneous algorithm.
+//     In: High Performance Optimization, pp. 197-232. Springer (2000)
+func InteriorPoint(c []float64, A mat.Matrix, b []float64, settings *InteriorPointSettings) (*Result, error) {
+	m, n := A.Dims()
+	if len(c) != n || len(b) != m {
+		panic(badShape)
+	}
+	if settings == nil {
+		settings = &InteriorPointSettings{}
+	}
+	tol := settings.Tolerance
+	if tol == 0 {
+		tol = defaultInteriorPointTol
+	}
+	if tol < 0 {
+		panic("lp: negative tolerance")
+	}
+	maxIter := settings.MaxIterations
+	if maxIter == 0 {
+		maxIter = defaultInteriorPointIters
+	}
+	if maxIter < 0 {
+		panic("lp: negative MaxIterations")
+	}
+	ip := newInteriorPoint(c, sparseFrom(A), b)
+	return ip.solve(tol, maxIter)
+}
+
+// interiorPoint holds the state of the homogeneous self-dual interior-point
+// method. The embedding is
+//
+//	A x - b τ = 0
+//	Aᵀ y + s - c τ = 0
+//	bᵀ y - cᵀ x - κ = 0
+//	x, s, τ, κ >= 0 ,
+//
+// whose solutions with τ > 0 give the optimal solution (x, y, s)/τ of the
+// linear program, and whose solutions with κ > 0 give a certificate of
+// infeasibility.
+type interiorPoint struct {
+	m, n int
+	a    *Sparse
+	b, c []float64
+
+	normB, normC float64
+
+	// Current point and residuals of the embedding.
+	x, s, y  []float64
+	tau, kap float64
+	rp, rd   []float64
+	rg       float64
+
+	// Normal equations A*D*Aᵀ and the parts of the direction that only
+	// depend on them.
+	d      []float64
+	normal *mat.SymDense
+	chol   mat.Cholesky
+	q, v   []float64
+
+	// Current direction and the predictor direction.
+	dx, ds, dy         []float64
+	dtau, dk           float64
+	rxs                []float64
+	affX, affS         []float64
+	affDtau, affDkappa float64
+
+	workN, workM []float64
+}
+
+func newInteriorPoint(c []float64, a *Sparse, b []float64) *interiorPoint {
+	m, n := a.Dims()
+	ip := &interiorPoint{
+		m: m,
+		n: n,
+		a: a,
+		b: b,
+		c: c,
+
+		x:   make([]float64, n),
+		s:   make([]float64, n),
+		y:   make([]float64, m),
+		tau: 1,
+		kap: 1,
+
+		rp: make([]float64, m),
+		rd: make([]float64, n),
+
+		normB: floats.Norm(b, math.Inf(1)),
+		normC: floats.Norm(c, math.Inf(1)),
+
+		d:      make([]float64, n),
+		normal: mat.NewSymDense(m, nil),
+		q:      make([]float64, m),
+		v:      make([]float64, n),
+		dx:     make([]float64, n),
+		ds:     make([]float64, n),
+		dy:     make([]float64, m),
+		rxs:    make([]float64, n),
+		workN:  make([]float64, n),
+		workM:  make([]float64, m),
+		affX:   make([]float64, n),
+		affS:   make([]float64, n),
+	}
+	for i := range ip.x {
+		ip.x[i] = 1
+		ip.s[i] = 1
+	}
+	return ip
+}
+
+func (ip *interiorPoint) solve(tol float64, maxIter int) (*Result, error) {
+	for iter := 0; ; iter++ {
+		mu := ip.residuals()
+
+		// Test for optimality of the scaled point.
+		cx, by := floats.Dot(ip.c, ip.x), floats.Dot(ip.b, ip.y)
+		pInfeas := floats.Norm(ip.rp, math.Inf(1)) / ip.tau
+		dInfeas := floats.Norm(ip.rd, math.Inf(1)) / ip.tau
+		gap := math.Abs(cx-by) / ip.tau
+		if pInfeas <= tol*(1+ip.normB) && dInfeas <= tol*(1+ip.normC) && gap <= tol*(1+math.Abs(by/ip.tau)) {
+			return ip.result(iter), nil
+		}
+
+		// Test for infeasibility. Once τ is small relative to κ, the point
+		// approaches a solution of the embedding with τ = 0, in which case
+		// y is a certificate of primal infeasibility if bᵀ y > 0 and x is a
+		// certificate of dual infeasibility if cᵀ x < 0. The certificate with
+		// the smaller relative residual is used. If the residuals stagnate
+		// while τ vanishes, the best certificate found is returned.
+		if ip.tau < ip.kap {
+			// The slack s absorbs the positive part of -Aᵀ y, so
+			// workN = Aᵀ y + s should vanish.
+			floats.ScaleTo(ip.workN, ip.tau, ip.c)
+			floats.Sub(ip.workN, ip.rd)
+			resY := math.Inf(1)
+			if by > 0 {
+				resY = floats.Norm(ip.workN, math.Inf(1)) / by
+			}
+			// workM = -A x should vanish.
+			floats.AddScaledTo(ip.workM, ip.rp, -ip.tau, ip.b)
+			resX := math.Inf(1)
+			if cx < 0 {
+				resX = -floats.Norm(ip.workM, math.Inf(1)) / cx
+			}
+			if math.Min(resX, resY) <= tol || (ip.tau <= tol*ip.kap && !math.IsInf(math.Min(resX, resY), 1)) {
+				if resY <= resX {
+					cert := make([]float64, ip.m)
+					floats.ScaleTo(cert, 1/by, ip.y)
+					return &Result{Iterations: iter, Certificate: cert}, ErrInfeasible
+				}
+				cert := make([]float64, ip.n)
+				floats.ScaleTo(cert, -1/cx, ip.x)
+				return &Result{Iterations: iter, Certificate: cert}, ErrUnbounded
+			}
+		}
+
+		if iter == maxIter {
+			return ip.result(iter), ErrIterationLimit
+		}
+
+		if !ip.factorize() {
+			return ip.result(iter), ErrLinSolve
+		}
+
+		// Predictor step towards the solution of the embedding.
+		for i, xi := range ip.x {
+			ip.rxs[i] = -xi * ip.s[i]
+		}
+		if !ip.direction(1, -ip.tau*ip.kap) {
+			return ip.result(iter), ErrLinSolve
+		}
+		alpha := ip.stepLength()
+		copy(ip.affX, ip.dx)
+		copy(ip.affS, ip.ds)
+		ip.affDtau, ip.affDkappa = ip.dtau, ip.dk
+		var muAff float64
+		for i, xi := range ip.x {
+			muAff += (xi + alpha*ip.dx[i]) * (ip.s[i] + alpha*ip.ds[i])
+		}
+		muAff += (ip.tau + alpha*ip.dtau) * (ip.kap + alpha*ip.dk)
+		muAff /= float64(ip.n + 1)
+		sigma := math.Pow(muAff/mu, 3)
+
+		// Corrector step with centering.
+		for i, xi := range ip.x {
+			ip.rxs[i] = -xi*ip.s[i] + sigma*mu - ip.affX[i]*ip.affS[i]
+		}
+		if !ip.direction(1-sigma, -ip.tau*ip.kap+sigma*mu-ip.affDtau*ip.affDkappa) {
+			return ip.result(iter), ErrLinSolve
+		}
+		alpha = math.Min(1, ipStepFactor*ip.stepLength())
+
+		floats.AddScaled(ip.x, alpha, ip.dx)
+		floats.AddScaled(ip.s, alpha, ip.ds)
+		floats.AddScaled(ip.y, alpha, ip.dy)
+		ip.tau += alpha * ip.dtau
+		ip.kap += alpha * ip.dk
+	}
+}
+
+// residuals computes the residuals of the embedding and returns the
+// complementarity measure μ.
+func (ip *interiorPoint) residuals() float64 {
+	// rp = b τ - A x.
+	floats.ScaleTo(ip.rp, ip.tau, ip.b)
+	ip.a.mulVecTo(ip.rp, -1, ip.x, 1)
+	// rd = c τ - Aᵀ y - s.
+	floats.ScaleTo(ip.rd, ip.tau, ip.c)
+	floats.Sub(ip.rd, ip.s)
+	ip.a.mulTransVecTo(ip.rd, -1, ip.y, 1)
+	// rg = κ + cᵀ x - bᵀ y.
+	ip.rg = ip.kap + floats.Dot(ip.c, ip.x) - floats.Dot(ip.b, ip.y)
+	return (floats.Dot(ip.x, ip.s) + ip.tau*ip.kap) / float64(ip.n+1)
+}
+
+// factorize forms and factorizes the normal equations matrix A*D*Aᵀ with
+// D = X S⁻¹, and solves for the parts of the direction that do not depend
+// on the right-hand side. It returns whether the factorization succeeded.
+func (ip *interiorPoint) factorize() bool {
+	for i, xi := range ip.x {
+		ip.d[i] = xi / ip.s[i]
+	}
+	ip.a.normalTo(ip.normal, ip.d)
+	diag := ip.workM
+	var maxDiag float64
+	for i := range diag {
+		diag[i] = ip.normal.At(i, i)
+		maxDiag = math.Max(maxDiag, diag[i])
+	}
+	if maxDiag == 0 {
+		maxDiag = 1
+	}
+	// Regularize the normal equations, increasing the regularization if the
+	// factorization fails.
+	for reg := ipRegularization; reg < 1; reg *= 100 {
+		for i := 0; i < ip.m; i++ {
+			ip.normal.SetSym(i, i, diag[i]+reg*maxDiag)
+		}
+		if ip.chol.Factorize(ip.normal) {
+			// q = (A D Aᵀ)⁻¹ (b + A D c) and v = D (Aᵀ q - c).
+			for i, ci := range ip.c {
+				ip.workN[i] = ip.d[i] * ci
+			}
+			copy(ip.workM, ip.b)
+			ip.a.mulVecTo(ip.workM, 1, ip.workN, 1)
+			if !ip.solveNormal(ip.q, ip.workM) {
+				return false
+			}
+			ip.a.mulTransVecTo(ip.v, 1, ip.q, 0)
+			floats.Sub(ip.v, ip.c)
+			floats.Mul(ip.v, ip.d)
+			return true
+		}
+	}
+	return false
+}
+
+// solveNormal solves the factorized normal equations. Ill-conditioning is
+// expected close to the solution and it does not spoil the direction, so
+// only failures of the solve are reported.
+func (ip *interiorPoint) solveNormal(dst, rhs []float64) bool {
+	err := ip.chol.SolveVecTo(mat.NewVecDense(ip.m, dst), mat.NewVecDense(ip.m, rhs))
+	if err == nil {
+		return true
+	}
+	_, ok := err.(mat.Condition)
+	return ok && !floats.HasNaN(dst)
+}
+
+// direction computes the Newton direction for the embedding
+//
+//	A dx - b dτ = η rp
+//	Aᵀ dy + ds - c dτ = η rd
+//	bᵀ dy - cᵀ dx - dκ = η rg
+//	S dx + X ds = rxs
+//	κ dτ + τ dκ = rtk ,
+//
+// where rxs is held in ip.rxs. It returns whether the normal equations could
+// be solved.
+func (ip *interiorPoint) direction(eta, rtk float64) bool {
+	// t = η rd - X⁻¹ rxs, stored in workN.
+	t := ip.workN
+	for i, xi := range ip.x {
+		t[i] = eta*ip.rd[i] - ip.rxs[i]/xi
+	}
+	// p = (A D Aᵀ)⁻¹ (η rp + A D t), stored in dy.
+	floats.ScaleTo(ip.workM, eta, ip.rp)
+	for i, ti := range t {
+		ip.dx[i] = ip.d[i] * ti
+	}
+	ip.a.mulVecTo(ip.workM, 1, ip.dx, 1)
+	p := ip.dy
+	if !ip.solveNormal(p, ip.workM) {
+		return false
+	}
+	// u = D (Aᵀ p - t), stored in dx.
+	u := ip.dx
+	ip.a.mulTransVecTo(u, 1, p, 0)
+	floats.Sub(u, t)
+	floats.Mul(u, ip.d)
+
+	num := eta*ip.rg + floats.Dot(ip.c, u) - floats.Dot(ip.b, p) + rtk/ip.tau
+	den := floats.Dot(ip.b, ip.q) - floats.Dot(ip.c, ip.v) + ip.kap/ip.tau
+	ip.dtau = num / den
+	floats.AddScaled(ip.dy, ip.dtau, ip.q)
+	floats.AddScaled(ip.dx, ip.dtau, ip.v)
+	for i, xi := range ip.x {
+		ip.ds[i] = (ip.rxs[i] - ip.s[i]*ip.dx[i]) / xi
+	}
+	ip.dk = (rtk - ip.kap*ip.dtau) / ip.tau
+	return true
+}
+
+// stepLength returns the largest step in [0, 1] along the current direction
+// that keeps x, s, τ and κ non-negative.
+func (ip *interiorPoint) stepLength() float64 {
+	alpha := 1.0
+	ratio := func(v, dv float64) {
+		if dv < 0 {
+			alpha = math.Min(alpha, -v/dv)
+		}
+	}
+	for i, xi := range ip.x {
+		ratio(xi, ip.dx[i])
+		ratio(ip.s[i], ip.ds[i])
+	}
+	ratio(ip.tau, ip.dtau)
+	ratio(ip.kap, ip.dk)
+	return alpha
+}
+
+// result returns the solution of the linear program at the current point.
+func (ip *interiorPoint) result(iter int) *Result {
+	x := make([]float64, ip.n)
+	floats.ScaleTo(x, 1/ip.tau, ip.x)
+	y := make([]float64, ip.m)
+	floats.ScaleTo(y, 1/ip.tau, ip.y)
+	s := make([]float64, ip.n)
+	copy(s, ip.c)
+	ip.a.mulTransVecTo(s, -1, y, 1)
+	return &Result{
+		F:           floats.Dot(ip.c, x),
+		X:           x,
+		Dual:        y,
+		ReducedCost: s,
+		Iterations:  iter,
+	}
+}
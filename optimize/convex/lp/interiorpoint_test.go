@@ -0,0 +1,322 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lp
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestInteriorPoint(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name    string
+		A       mat.Matrix
+		b, c    []float64
+		wantErr error
+		wantF   float64
+		wantX   []float64
+		wantY   []float64
+	}{
+		{
+			name: "Basic",
+			A: mat.NewDense(2, 4, []float64{
+				-1, 2, 1, 0,
+				3, 1, 0, 1,
+			}),
+			b:     []float64{4, 9},
+			c:     []float64{-1, -2, 0, 0},
+			wantF: -8,
+			wantX: []float64{2, 3, 0, 0},
+			wantY: []float64{-5.0 / 7, -4.0 / 7},
+		},
+		{
+			name: "RankDeficient",
+			A: mat.NewDense(3, 3, []float64{
+				1, 1, 1,
+				1, 1, 1,
+				1, -1, 0,
+			}),
+			b:     []float64{2, 2, 0},
+			c:     []float64{1, 1, 3},
+			wantF: 2,
+			wantX: []float64{1, 1, 0},
+		},
+		{
+			// x1 + x2 = -1 has no non-negative solution.
+			name:    "Infeasible",
+			A:       mat.NewDense(1, 2, []float64{1, 1}),
+			b:       []float64{-1},
+			c:       []float64{1, 1},
+			wantErr: ErrInfeasible,
+		},
+		{
+			// x1 - x2 = 1 with the objective -x1 decreases along (1, 1).
+			name:    "Unbounded",
+			A:       mat.NewDense(1, 2, []float64{1, -1}),
+			b:       []float64{1},
+			c:       []float64{-1, 0},
+			wantErr: ErrUnbounded,
+		},
+	} {
+		for _, sparse := range []bool{false, true} {
+			a := test.A
+			if sparse {
+				a = sparseFrom(test.A)
+			}
+			res, err := InteriorPoint(test.c, a, test.b, nil)
+			if err != test.wantErr {
+				t.Errorf("%s (sparse=%t): unexpected error: got %v, want %v", test.name, sparse, err, test.wantErr)
+				continue
+			}
+			if err != nil {
+				checkCertificate(t, test.name, test.c, test.A, test.b, res, err)
+				continue
+			}
+			checkInteriorPoint(t, test.name, test.c, test.A, test.b, res, 1e-8)
+			if math.Abs(res.F-test.wantF) > 1e-7 {
+				t.Errorf("%s (sparse=%t): unexpected optimal value: got %v, want %v", test.name, sparse, res.F, test.wantF)
+			}
+			if !floats.EqualApprox(res.X, test.wantX, 1e-7) {
+				t.Errorf("%s (sparse=%t): unexpected solution: got %v, want %v", test.name, sparse, res.X, test.wantX)
+			}
+			if test.wantY != nil && !floats.EqualApprox(res.Dual, test.wantY, 1e-7) {
+				t.Errorf("%s (sparse=%t): unexpected dual values: got %v, want %v", test.name, sparse, res.Dual, test.wantY)
+			}
+		}
+	}
+}
+
+func TestInteriorPointRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 500; i++ {
+		n := rnd.Intn(30) + 2
+		m := rnd.Intn(n-1) + 1
+		pZero := 0.5 * rnd.Float64()
+		randValue := func() float64 {
+			if rnd.Float64() < pZero {
+				return 0
+			}
+			return rnd.NormFloat64()
+		}
+		a := mat.NewDense(m, n, nil)
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				a.Set(i, j, randValue())
+			}
+		}
+		b := make([]float64, m)
+		for i := range b {
+			b[i] = randValue()
+		}
+		c := make([]float64, n)
+		for i := range c {
+			c[i] = randValue()
+		}
+
+		wantF, _, errSimplex := Simplex(c, a, b, 0, nil)
+		res, err := InteriorPoint(c, a, b, nil)
+		switch errSimplex {
+		case nil:
+			if err != nil {
+				t.Errorf("case %d: unexpected error: %v", i, err)
+				continue
+			}
+			checkInteriorPoint(t, "random", c, a, b, res, 1e-6)
+			if !scalar.EqualWithinAbsOrRel(res.F, wantF, 1e-6, 1e-6) {
+				t.Errorf("case %d: optimal value mismatch: got %v, want %v", i, res.F, wantF)
+			}
+		case ErrUnbounded, ErrInfeasible:
+			// Simplex reports a zero column with a negative cost as
+			// unbounded without testing feasibility, and an infeasible
+			// problem may have an infeasible dual too, so either
+			// certificate is valid.
+			if err != ErrInfeasible && err != ErrUnbounded {
+				t.Errorf("case %d: unexpected error: got %v, want %v", i, err, errSimplex)
+				continue
+			}
+			checkCertificate(t, "random", c, a, b, res, err)
+		}
+	}
+}
+
+func TestInteriorPointLargeSparse(t *testing.T) {
+	t.Parallel()
+	// A transportation problem with 100 sources, 200 destinations and
+	// 20000 routes. The supply constraints have slack variables. The
+	// constraint matrix has at most two non-zero elements per column and a
+	// dense tableau would hold over 6 million elements.
+	const (
+		nSrc = 100
+		nDst = 200
+	)
+	rnd := rand.New(rand.NewSource(1))
+	b := make([]float64, nSrc+nDst)
+	demand := b[nSrc:]
+	for j := range demand {
+		demand[j] = 1 + rnd.Float64()
+	}
+	supply := b[:nSrc]
+	for i := range supply {
+		supply[i] = 1.2 * floats.Sum(demand) / nSrc
+	}
+	n := nSrc*nDst + nSrc
+	c := make([]float64, n)
+	var rows, cols []int
+	var vals []float64
+	for i := 0; i < nSrc; i++ {
+		for j := 0; j < nDst; j++ {
+			k := i*nDst + j
+			c[k] = rnd.Float64()
+			rows = append(rows, i, nSrc+j)
+			cols = append(cols, k, k)
+			vals = append(vals, 1, 1)
+		}
+		rows = append(rows, i)
+		cols = append(cols, nSrc*nDst+i)
+		vals = append(vals, 1)
+	}
+	a := NewSparse(nSrc+nDst, n, rows, cols, vals)
+	res, err := InteriorPoint(c, a, b, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	checkInteriorPoint(t, "transportation", c, a, b, res, 1e-6)
+}
+
+func TestConvertSparse(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		nVar, nIneq, nEq int
+	}{
+		{3, 2, 0},
+		{3, 0, 2},
+		{4, 3, 2},
+	} {
+		c := make([]float64, test.nVar)
+		for i := range c {
+			c[i] = rnd.NormFloat64()
+		}
+		var g, a mat.Matrix
+		var h, b []float64
+		if test.nIneq > 0 {
+			gd := mat.NewDense(test.nIneq, test.nVar, nil)
+			for i := 0; i < test.nIneq; i++ {
+				gd.Set(i, rnd.Intn(test.nVar), rnd.NormFloat64())
+			}
+			g = gd
+			h = make([]float64, test.nIneq)
+		}
+		if test.nEq > 0 {
+			ad := mat.NewDense(test.nEq, test.nVar, nil)
+			for i := 0; i < test.nEq; i++ {
+				ad.Set(i, rnd.Intn(test.nVar), rnd.NormFloat64())
+			}
+			a = sparseFrom(ad)
+			b = make([]float64, test.nEq)
+		}
+		cWant, aWant, bWant := Convert(c, g, h, a, b)
+		cGot, aGot, bGot := ConvertSparse(c, g, h, a, b)
+		if !floats.Equal(cGot, cWant) || !floats.Equal(bGot, bWant) {
+			t.Errorf("unexpected vectors for %+v", test)
+		}
+		if !mat.Equal(aGot, aWant) {
+			t.Errorf("unexpected matrix for %+v:\ngot  %v\nwant %v", test, mat.Formatted(aGot), mat.Formatted(aWant))
+		}
+	}
+}
+
+func TestSparse(t *testing.T) {
+	t.Parallel()
+	s := NewSparse(3, 4,
+		[]int{2, 0, 1, 2, 0, 1},
+		[]int{3, 0, 1, 3, 2, 1},
+		[]float64{1, 2, 3, 4, 5, -3},
+	)
+	want := mat.NewDense(3, 4, []float64{
+		2, 0, 5, 0,
+		0, 0, 0, 0,
+		0, 0, 0, 5,
+	})
+	if !mat.Equal(s, want) {
+		t.Errorf("unexpected matrix:\ngot  %v\nwant %v", mat.Formatted(s), mat.Formatted(want))
+	}
+	if s.NNZ() != 3 {
+		t.Errorf("unexpected number of non-zero elements: got %d, want 3", s.NNZ())
+	}
+	if !mat.Equal(s.T(), want.T()) {
+		t.Errorf("unexpected transpose")
+	}
+	if !mat.Equal(sparseFrom(want), want) {
+		t.Errorf("unexpected sparse copy of a dense matrix")
+	}
+}
+
+// checkInteriorPoint checks the optimality conditions of the solution in res.
+func checkInteriorPoint(t *testing.T, name string, c []float64, a mat.Matrix, b []float64, res *Result, tol float64) {
+	t.Helper()
+	var ax mat.VecDense
+	ax.MulVec(a, mat.NewVecDense(len(res.X), res.X))
+	if !floats.EqualApprox(ax.RawVector().Data, b, tol*(1+floats.Norm(b, math.Inf(1)))) {
+		t.Errorf("%s: solution infeasible", name)
+	}
+	// The reduced costs must equal c - Aᵀ y.
+	var s mat.VecDense
+	s.MulVec(a.T(), mat.NewVecDense(len(res.Dual), res.Dual))
+	s.SubVec(mat.NewVecDense(len(c), c), &s)
+	if !floats.EqualApprox(s.RawVector().Data, res.ReducedCost, 1e-12*(1+floats.Norm(c, math.Inf(1)))) {
+		t.Errorf("%s: reduced costs do not match the dual values", name)
+	}
+	scale := 1 + math.Abs(res.F)
+	for i, x := range res.X {
+		if x < 0 {
+			t.Errorf("%s: negative solution element %v", name, x)
+		}
+		if res.ReducedCost[i] < -tol*scale {
+			t.Errorf("%s: negative reduced cost %v", name, res.ReducedCost[i])
+		}
+	}
+	if gap := res.F - floats.Dot(b, res.Dual); math.Abs(gap) > tol*scale {
+		t.Errorf("%s: duality gap too large: %v", name, gap)
+	}
+}
+
+// checkCertificate checks the certificate of infeasibility or unboundedness
+// in res.
+func checkCertificate(t *testing.T, name string, c []float64, a mat.Matrix, b []float64, res *Result, err error) {
+	t.Helper()
+	const tol = 1e-6
+	cert := mat.NewVecDense(len(res.Certificate), res.Certificate)
+	var v mat.VecDense
+	switch err {
+	case ErrInfeasible:
+		v.MulVec(a.T(), cert)
+		if max := mat.Max(&v); max > tol {
+			t.Errorf("%s: invalid infeasibility certificate: max(Aᵀ y) = %v", name, max)
+		}
+		if by := floats.Dot(b, res.Certificate); math.Abs(by-1) > 1e-12 {
+			t.Errorf("%s: invalid infeasibility certificate: bᵀ y = %v", name, by)
+		}
+	case ErrUnbounded:
+		v.MulVec(a, cert)
+		if norm := mat.Norm(&v, math.Inf(1)); norm > tol {
+			t.Errorf("%s: invalid unboundedness certificate: |A x| = %v", name, norm)
+		}
+		if floats.Min(res.Certificate) < 0 {
+			t.Errorf("%s: invalid unboundedness certificate: negative element", name)
+		}
+		if cx := floats.Dot(c, res.Certificate); math.Abs(cx+1) > 1e-12 {
+			t.Errorf("%s: invalid unboundedness certificate: cᵀ x = %v", name, cx)
+		}
+	}
+}
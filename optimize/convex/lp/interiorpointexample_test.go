@@ -0,0 +1,41 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lp_test
+
+import (
+	"fmt"
+	"log"
+
+	"gonum.org/v1/gonum/optimize/convex/lp"
+)
+
+func ExampleInteriorPoint() {
+	// Solve the general form LP
+	//  minimize   -x0 - 2 x1
+	//  s.t.       -x0 + 2 x1 <= 4
+	//            3 x0 +   x1 <= 9
+	//               x0, x1 >= 0
+	// with the constraints stored in a sparse matrix.
+	c := []float64{-1, -2}
+	G := lp.NewSparse(4, 2,
+		[]int{0, 0, 1, 1, 2, 3},
+		[]int{0, 1, 0, 1, 0, 1},
+		[]float64{-1, 2, 3, 1, -1, -1},
+	)
+	h := []float64{4, 9, 0, 0}
+	cNew, aNew, bNew := lp.ConvertSparse(c, G, h, nil, nil)
+
+	res, err := lp.InteriorPoint(cNew, aNew, bNew, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("opt: %.6f\n", res.F)
+	fmt.Printf("x: [%.6f %.6f]\n", res.X[0]-res.X[2], res.X[1]-res.X[3])
+	fmt.Printf("dual: [%.6f %.6f]\n", res.Dual[0], res.Dual[1])
+	// Output:
+	// opt: -8.000000
+	// x: [2.000000 3.000000]
+	// dual: [-0.714286 -0.571429]
+}
@@ -0,0 +1,476 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lp
+
+import (
+	"container/heap"
+	"errors"
+	"math"
+	"time"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	// ErrNodeLimit is returned by MILP when the maximum number of nodes has
+	// been explored before the optimal solution was proved.
+	ErrNodeLimit = errors.New("lp: node limit reached")
+	// ErrTimeLimit is returned by MILP when the time limit has been reached
+	// before the optimal solution was proved.
+	ErrTimeLimit = errors.New("lp: time limit reached")
+)
+
+const (
+	defaultIntegralityTol = 1e-6
+	defaultGapTol         = 1e-6
+
+	// gomoryMinFrac is the smallest fractional part of a basic variable that
+	// is used to derive a Gomory cut. Cuts from nearly integral rows are
+	// numerically unreliable.
+	gomoryMinFrac = 0.01
+)
+
+// SearchStrategy is the strategy for choosing the next node of the
+// branch-and-bound tree.
+type SearchStrategy int
+
+const (
+	// BestBound explores the node with the smallest bound on the objective
+	// function first. It minimizes the number of nodes needed to prove
+	// optimality.
+	BestBound SearchStrategy = iota
+	// DepthFirst explores the most recently created node first. It finds
+	// feasible solutions quickly and needs little memory.
+	DepthFirst
+)
+
+// MILPSettings holds the settings for MILP.
+type MILPSettings struct {
+	// Strategy is the strategy for choosing the next node to explore.
+	Strategy SearchStrategy
+
+	// CutRounds is the number of rounds of Gomory mixed-integer cuts that
+	// are added to the relaxation at the root node before branching.
+	CutRounds int
+
+	// NodeLimit is the maximum number of nodes to explore. If NodeLimit is
+	// zero, the number of nodes is not limited.
+	NodeLimit int
+	// TimeLimit is the maximum run time. If TimeLimit is zero, the run time
+	// is not limited. The limit is checked before each node is explored, so
+	// the run time can exceed it by the time needed to solve one relaxation.
+	TimeLimit time.Duration
+
+	// GapTolerance is the relative gap between the incumbent and the bound
+	// at which the search is stopped. If GapTolerance is zero, it is
+	// defaulted to 1e-6.
+	GapTolerance float64
+	// IntegralityTolerance is the largest distance of a variable from the
+	// nearest integer for which the variable is considered integral. If
+	// IntegralityTolerance is zero, it is defaulted to 1e-6.
+	IntegralityTolerance float64
+
+	// Tol is the tolerance used for solving the linear programming
+	// relaxations. See Solver.
+	Tol float64
+}
+
+// MILPResult holds the result of MILP.
+type MILPResult struct {
+	// F is the objective value of the incumbent, the best integer feasible
+	// solution found. It is +Inf if no solution has been found.
+	F float64
+	// X is the incumbent, or nil if no solution has been found. The integer
+	// variables are rounded to the nearest integer.
+	X []float64
+
+	// Bound is a lower bound on the optimal objective value.
+	Bound float64
+	// Gap is the relative gap between the incumbent and the bound,
+	// (F - Bound) / max(1, |F|).
+	Gap float64
+
+	// Nodes is the number of explored nodes of the branch-and-bound tree.
+	Nodes int
+	// Cuts is the number of cutting planes added at the root node.
+	Cuts int
+}
+
+// MILP solves a mixed-integer linear program in standard form
+//
+//	minimize	cᵀ x
+//	s.t. 		A*x = b
+//				x >= 0
+//				x_j integer if integer[j] ,
+//
+// using branch-and-bound on the linear programming relaxation. The
+// relaxations are solved by a Solver, and each node is warm started with the
+// dual simplex method from the optimal basis of its parent. Optionally,
+// Gomory mixed-integer cuts are added at the root node before branching.
+// Nodes are branched on the integer variable whose value is the most
+// fractional.
+//
+// MILP returns the incumbent together with a lower bound on the optimal value
+// and the relative gap between them. The search stops with a nil error when
+// the gap is below the tolerance. If the node or time limits are reached,
+// ErrNodeLimit or ErrTimeLimit is returned together with the incumbent found
+// so far, if any. If the problem has no integer feasible solution,
+// ErrInfeasible is returned, and if the relaxation is unbounded,
+// ErrUnbounded is returned. The search may not terminate if the integer
+// variables are unbounded in the relaxation, so a limit should be set in that
+// case.
+//
+// The Convert function can be used to transform a general mixed-integer LP
+// into standard form. Since an integer variable is split into the difference
+// of two variables, both of them must be marked as integer.
+//
+// If settings is nil, the zero value is used. MILP will panic if len(c) does
+// not equal the number of columns of A, if len(b) does not equal the number
+// of rows of A or if len(integer) does not equal len(c).
+func MILP(c []float64, A mat.Matrix, b []float64, integer []bool, settings *MILPSettings) (*MILPResult, error) {
+	start := time.Now()
+	m, n := A.Dims()
+	if len(c) != n || len(b) != m || len(integer) != n {
+		panic(badShape)
+	}
+	if settings == nil {
+		settings = &MILPSettings{}
+	}
+	intTol := settings.IntegralityTolerance
+	if intTol == 0 {
+		intTol = defaultIntegralityTol
+	}
+	gapTol := settings.GapTolerance
+	if gapTol == 0 {
+		gapTol = defaultGapTol
+	}
+	if intTol < 0 || intTol >= 0.5 || gapTol < 0 || settings.CutRounds < 0 || settings.NodeLimit < 0 || settings.TimeLimit < 0 {
+		panic("lp: invalid MILP settings")
+	}
+
+	bb := &branchAndBound{
+		n:        n,
+		c:        c,
+		intTol:   intTol,
+		gapTol:   gapTol,
+		strategy: settings.Strategy,
+		result: &MILPResult{
+			F:     math.Inf(1),
+			Bound: math.Inf(-1),
+			Gap:   math.Inf(1),
+		},
+	}
+
+	// Solve the root relaxation and strengthen it by cutting planes.
+	root := NewSolver(c, A, b)
+	root.Tol = settings.Tol
+	res, err := root.Solve()
+	if err != nil {
+		return nil, err
+	}
+	bb.integer = append([]bool(nil), integer...)
+	for round := 0; round < settings.CutRounds; round++ {
+		added := bb.addGomoryCuts(root)
+		if added == 0 {
+			break
+		}
+		bb.result.Cuts += added
+		res, err = root.Solve()
+		if err != nil {
+			return nil, err
+		}
+	}
+	bb.rootC, bb.rootA, bb.rootB = root.c, root.a, root.b
+	bb.tol = settings.Tol
+
+	heap.Push(bb, &milpNode{bound: res.F, basis: root.Basis(), res: res})
+	for bb.Len() > 0 {
+		bb.updateBound()
+		if bb.result.Gap <= bb.gapTol {
+			break
+		}
+		if settings.NodeLimit > 0 && bb.result.Nodes >= settings.NodeLimit {
+			return bb.result, ErrNodeLimit
+		}
+		if settings.TimeLimit > 0 && time.Since(start) >= settings.TimeLimit {
+			return bb.result, ErrTimeLimit
+		}
+
+		node := heap.Pop(bb).(*milpNode)
+		if bb.prune(node.bound) {
+			continue
+		}
+		bb.result.Nodes++
+		res := node.res
+		if res == nil {
+			res, err = bb.solve(node)
+			if err == ErrInfeasible {
+				continue
+			}
+			if err != nil {
+				return bb.result, err
+			}
+			if bb.prune(res.F) {
+				continue
+			}
+		}
+		bb.branch(node, res)
+	}
+
+	if bb.result.X == nil {
+		return bb.result, ErrInfeasible
+	}
+	bb.updateBound()
+	return bb.result, nil
+}
+
+// milpNode is a node of the branch-and-bound tree.
+type milpNode struct {
+	// bounds holds the branching constraints of the node.
+	bounds []milpBound
+	// basis is the optimal basis of the parent node.
+	basis []int
+	// bound is the lower bound on the objective value in the subtree.
+	bound float64
+	depth int
+	seq   int
+
+	// res holds the solution of the relaxation if it is known.
+	res *Result
+}
+
+// milpBound is the branching constraint x_j <= v if upper is true, and
+// x_j >= v otherwise.
+type milpBound struct {
+	j     int
+	upper bool
+	v     float64
+}
+
+// branchAndBound holds the state of the branch-and-bound search. It
+// implements heap.Interface for the open nodes of the tree.
+type branchAndBound struct {
+	n int
+	c []float64
+
+	// The relaxation at the root node, including the cutting planes.
+	rootC   []float64
+	rootA   *mat.Dense
+	rootB   []float64
+	integer []bool
+	tol     float64
+
+	intTol, gapTol float64
+	strategy       SearchStrategy
+
+	nodes  []*milpNode
+	seq    int
+	result *MILPResult
+}
+
+func (bb *branchAndBound) Len() int { return len(bb.nodes) }
+
+func (bb *branchAndBound) Less(i, j int) bool {
+	a, b := bb.nodes[i], bb.nodes[j]
+	if bb.strategy == DepthFirst {
+		if a.depth != b.depth {
+			return a.depth > b.depth
+		}
+		return a.seq > b.seq
+	}
+	if a.bound != b.bound {
+		return a.bound < b.bound
+	}
+	return a.seq < b.seq
+}
+
+func (bb *branchAndBound) Swap(i, j int) { bb.nodes[i], bb.nodes[j] = bb.nodes[j], bb.nodes[i] }
+
+func (bb *branchAndBound) Push(x interface{}) {
+	node := x.(*milpNode)
+	node.seq = bb.seq
+	bb.seq++
+	bb.nodes = append(bb.nodes, node)
+}
+
+func (bb *branchAndBound) Pop() interface{} {
+	node := bb.nodes[len(bb.nodes)-1]
+	bb.nodes[len(bb.nodes)-1] = nil
+	bb.nodes = bb.nodes[:len(bb.nodes)-1]
+	return node
+}
+
+// updateBound updates the bound and the gap from the open nodes and the
+// incumbent.
+func (bb *branchAndBound) updateBound() {
+	bound := bb.result.F
+	for _, node := range bb.nodes {
+		bound = math.Min(bound, node.bound)
+	}
+	bb.result.Bound = bound
+	f := bb.result.F
+	switch {
+	case math.IsInf(f, 1):
+		bb.result.Gap = math.Inf(1)
+	case len(bb.nodes) == 0:
+		bb.result.Bound = f
+		bb.result.Gap = 0
+	default:
+		bb.result.Gap = (f - bound) / math.Max(1, math.Abs(f))
+	}
+}
+
+// prune returns whether a subtree with the given bound cannot contain a
+// solution that is better than the incumbent by more than the gap tolerance.
+func (bb *branchAndBound) prune(bound float64) bool {
+	f := bb.result.F
+	return !math.IsInf(f, 1) && f-bound <= bb.gapTol*math.Max(1, math.Abs(f))
+}
+
+// solve solves the relaxation at node, warm started from the optimal basis
+// of its parent.
+func (bb *branchAndBound) solve(node *milpNode) (*Result, error) {
+	s := NewSolver(bb.rootC, bb.rootA, bb.rootB)
+	s.Tol = bb.tol
+	last := len(node.bounds) - 1
+	for k, bd := range node.bounds {
+		if k == last {
+			s.SetBasis(node.basis)
+		}
+		_, n := s.Dims()
+		row := make([]float64, n)
+		if bd.upper {
+			row[bd.j] = 1
+			s.AddConstraint(row, bd.v)
+		} else {
+			row[bd.j] = -1
+			s.AddConstraint(row, -bd.v)
+		}
+	}
+	return s.Solve()
+}
+
+// branch updates the incumbent if the solution of the relaxation at node is
+// integral, and otherwise creates the child nodes by branching on the most
+// fractional integer variable.
+func (bb *branchAndBound) branch(node *milpNode, res *Result) {
+	j := -1
+	var maxFrac float64
+	for k, isInt := range bb.integer[:bb.n] {
+		if !isInt {
+			continue
+		}
+		v := res.X[k]
+		frac := math.Abs(v - math.Round(v))
+		if frac > bb.intTol && frac > maxFrac {
+			j = k
+			maxFrac = frac
+		}
+	}
+	if j == -1 {
+		// The solution is integral.
+		x := make([]float64, bb.n)
+		copy(x, res.X)
+		for k, isInt := range bb.integer[:bb.n] {
+			if isInt {
+				x[k] = math.Round(x[k])
+			}
+		}
+		f := floats.Dot(bb.c, x)
+		if f < bb.result.F {
+			bb.result.F = f
+			bb.result.X = x
+		}
+		return
+	}
+
+	v := res.X[j]
+	down := &milpNode{
+		bounds: append(append([]milpBound(nil), node.bounds...), milpBound{j: j, upper: true, v: math.Floor(v)}),
+		basis:  res.Basic,
+		bound:  res.F,
+		depth:  node.depth + 1,
+	}
+	up := &milpNode{
+		bounds: append(append([]milpBound(nil), node.bounds...), milpBound{j: j, upper: false, v: math.Ceil(v)}),
+		basis:  res.Basic,
+		bound:  res.F,
+		depth:  node.depth + 1,
+	}
+	// With the depth-first strategy the branch towards the nearest integer
+	// is explored first.
+	if v-math.Floor(v) < 0.5 {
+		heap.Push(bb, up)
+		heap.Push(bb, down)
+	} else {
+		heap.Push(bb, down)
+		heap.Push(bb, up)
+	}
+}
+
+// addGomoryCuts adds the Gomory mixed-integer cuts derived from the rows of
+// the optimal tableau of s whose basic variable is integer but has a
+// fractional value. It returns the number of added cuts.
+//
+// The row of the tableau for the basic variable x_i is
+//
+//	x_i + Σ_{j∈N} ā_j x_j = b̄
+//
+// and with f_0 and f_j the fractional parts of b̄ and ā_j, the cut is
+//
+//	Σ_{j∈N integer, f_j <= f_0} f_j/f_0 x_j + Σ_{j∈N integer, f_j > f_0} (1-f_j)/(1-f_0) x_j
+//	  + Σ_{j∈N continuous, ā_j > 0} ā_j/f_0 x_j - Σ_{j∈N continuous, ā_j < 0} ā_j/(1-f_0) x_j >= 1 .
+func (bb *branchAndBound) addGomoryCuts(s *Solver) int {
+	f, err := newBasisFactor(s.c, s.a, s.b, append([]int(nil), s.basic...))
+	if err != nil {
+		return 0
+	}
+	m, n := s.a.Dims()
+	rho := make([]float64, m)
+	var cuts [][]float64
+	for r, v := range s.basic {
+		if !bb.integer[v] {
+			continue
+		}
+		f0 := f.xb[r] - math.Floor(f.xb[r])
+		if f0 < gomoryMinFrac || f0 > 1-gomoryMinFrac {
+			continue
+		}
+		if f.solveUnit(rho, r, true) != nil {
+			return 0
+		}
+		alpha := make([]float64, n)
+		mulTransVec(alpha, s.a, rho, 1)
+		cut := make([]float64, n)
+		for j, aj := range alpha {
+			if f.isBasic[j] {
+				continue
+			}
+			if bb.integer[j] {
+				fj := aj - math.Floor(aj)
+				if fj <= f0 {
+					cut[j] = fj / f0
+				} else {
+					cut[j] = (1 - fj) / (1 - f0)
+				}
+			} else if aj > 0 {
+				cut[j] = aj / f0
+			} else {
+				cut[j] = -aj / (1 - f0)
+			}
+		}
+		cuts = append(cuts, cut)
+	}
+	// The cuts are written as -cutᵀ x <= -1.
+	for _, cut := range cuts {
+		_, n := s.Dims()
+		row := make([]float64, n)
+		floats.ScaleTo(row[:len(cut)], -1, cut)
+		s.AddConstraint(row, -1)
+		bb.integer = append(bb.integer, false)
+	}
+	return len(cuts)
+}
@@ -0,0 +1,293 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lp
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+var milpSettings = []MILPSettings{
+	{Strategy: BestBound},
+	{Strategy: DepthFirst},
+	{Strategy: BestBound, CutRounds: 3},
+	{Strategy: DepthFirst, CutRounds: 1},
+}
+
+// boxedIP returns the standard form of the integer program
+//
+//	minimize	cᵀ x
+//	s.t.		G*x <= h
+//				0 <= x <= u, x integer
+//
+// with slack variables appended to x.
+func boxedIP(c []float64, g *mat.Dense, h, u []float64) (cNew []float64, a *mat.Dense, b []float64, integer []bool) {
+	m, n := g.Dims()
+	a = mat.NewDense(m+n, n+m+n, nil)
+	a.Slice(0, m, 0, n).(*mat.Dense).Copy(g)
+	for i := 0; i < m+n; i++ {
+		a.Set(i, n+i, 1)
+	}
+	for j := 0; j < n; j++ {
+		a.Set(m+j, j, 1)
+	}
+	b = append(append([]float64(nil), h...), u...)
+	cNew = make([]float64, n+m+n)
+	copy(cNew, c)
+	integer = make([]bool, n+m+n)
+	for j := 0; j < n; j++ {
+		integer[j] = true
+	}
+	return cNew, a, b, integer
+}
+
+// bruteForceIP returns the optimal value of the integer program in boxedIP by
+// enumeration.
+func bruteForceIP(c []float64, g *mat.Dense, h, u []float64) float64 {
+	n := len(c)
+	x := make([]float64, n)
+	best := math.Inf(1)
+	var gx mat.VecDense
+	var enumerate func(j int)
+	enumerate = func(j int) {
+		if j == n {
+			gx.MulVec(g, mat.NewVecDense(n, x))
+			for i, v := range h {
+				if gx.AtVec(i) > v+1e-9 {
+					return
+				}
+			}
+			best = math.Min(best, floats.Dot(c, x))
+			return
+		}
+		for v := 0.0; v <= u[j]; v++ {
+			x[j] = v
+			enumerate(j + 1)
+		}
+	}
+	enumerate(0)
+	return best
+}
+
+func TestMILPKnapsack(t *testing.T) {
+	t.Parallel()
+	// Maximize the value of the items subject to the weight capacity, with
+	// at most one of each item.
+	value := []float64{10, 13, 7, 8, 12, 5}
+	weight := []float64{5, 7, 4, 4, 6, 3}
+	const capacity = 17
+	const want = -33 // Items 1, 3 and 4.
+
+	n := len(value)
+	c := make([]float64, n)
+	floats.ScaleTo(c, -1, value)
+	u := make([]float64, n)
+	for i := range u {
+		u[i] = 1
+	}
+	c, a, b, integer := boxedIP(c, mat.NewDense(1, n, weight), []float64{capacity}, u)
+	for _, settings := range milpSettings {
+		settings := settings
+		res, err := MILP(c, a, b, integer, &settings)
+		if err != nil {
+			t.Errorf("%+v: unexpected error: %v", settings, err)
+			continue
+		}
+		checkMILP(t, fmt.Sprintf("%+v", settings), c, a, b, integer, res, want)
+		if settings.CutRounds > 0 && res.Cuts == 0 {
+			t.Errorf("%+v: no cuts added", settings)
+		}
+	}
+}
+
+func TestMILPAlternativeOptima(t *testing.T) {
+	t.Parallel()
+	// The relaxations of this bounded knapsack problem have alternative
+	// optimal solutions since items 0 and 2 have the same value and weight.
+	// Rounding errors in their zero reduced costs used to make the simplex
+	// method cycle between them, so that MILP did not return even with a
+	// time limit.
+	value := []float64{7, 4, 7, 1}
+	weight := []float64{9, 9, 9, 9}
+	const capacity = 17
+	const want = -7
+
+	n := len(value)
+	c := make([]float64, n)
+	floats.ScaleTo(c, -1, value)
+	u := []float64{3, 3, 3, 3}
+	c, a, b, integer := boxedIP(c, mat.NewDense(1, n, weight), []float64{capacity}, u)
+	for _, settings := range milpSettings {
+		settings := settings
+		settings.TimeLimit = 2 * time.Second
+		res, err := MILP(c, a, b, integer, &settings)
+		if err != nil {
+			t.Errorf("%+v: unexpected error: %v", settings, err)
+			continue
+		}
+		checkMILP(t, fmt.Sprintf("%+v", settings), c, a, b, integer, res, want)
+	}
+}
+
+func TestMILPRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		n := rnd.Intn(4) + 1
+		m := rnd.Intn(3) + 1
+		c := make([]float64, n)
+		for j := range c {
+			c[j] = float64(rnd.Intn(21) - 15)
+		}
+		g := mat.NewDense(m, n, nil)
+		for k := 0; k < m; k++ {
+			for j := 0; j < n; j++ {
+				g.Set(k, j, float64(rnd.Intn(13)-3)+rnd.Float64())
+			}
+		}
+		h := make([]float64, m)
+		for k := range h {
+			h[k] = 10 * rnd.Float64()
+		}
+		u := make([]float64, n)
+		for j := range u {
+			u[j] = float64(rnd.Intn(5) + 1)
+		}
+		want := bruteForceIP(c, g, h, u)
+		cs, a, b, integer := boxedIP(c, g, h, u)
+		for _, settings := range milpSettings {
+			settings := settings
+			name := fmt.Sprintf("case %d %+v", i, settings)
+			res, err := MILP(cs, a, b, integer, &settings)
+			if math.IsInf(want, 1) {
+				if err != ErrInfeasible {
+					t.Errorf("%s: unexpected error: got %v, want %v", name, err, ErrInfeasible)
+				}
+				continue
+			}
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			checkMILP(t, name, cs, a, b, integer, res, want)
+		}
+	}
+}
+
+func TestMILPMixed(t *testing.T) {
+	t.Parallel()
+	// minimize -1.1*x1 - 2*x2
+	// s.t.      x1 + 2*x2 <= 5.5
+	//          2*x1 - x2 <= 3
+	//          x1 integer, x2 continuous.
+	// The relaxation has the solution x1 = 2.3, x2 = 1.6.
+	c := []float64{-1.1, -2, 0, 0}
+	a := mat.NewDense(2, 4, []float64{
+		1, 2, 1, 0,
+		2, -1, 0, 1,
+	})
+	b := []float64{5.5, 3}
+	integer := []bool{true, false, false, false}
+	for _, settings := range milpSettings {
+		settings := settings
+		res, err := MILP(c, a, b, integer, &settings)
+		if err != nil {
+			t.Errorf("%+v: unexpected error: %v", settings, err)
+			continue
+		}
+		checkMILP(t, fmt.Sprintf("%+v", settings), c, a, b, integer, res, -5.7)
+		if !floats.EqualApprox(res.X[:2], []float64{2, 1.75}, 1e-9) {
+			t.Errorf("%+v: unexpected solution: got %v, want [2 1.75]", settings, res.X[:2])
+		}
+	}
+}
+
+func TestMILPLimits(t *testing.T) {
+	t.Parallel()
+	// A knapsack problem with equal ratios of value and weight needs many
+	// nodes to prove optimality.
+	const n = 10
+	weight := make([]float64, n)
+	c := make([]float64, n)
+	u := make([]float64, n)
+	for i := range weight {
+		weight[i] = float64(2 * (i + 10))
+		c[i] = -weight[i]
+		u[i] = 1
+	}
+	c, a, b, integer := boxedIP(c, mat.NewDense(1, n, weight), []float64{101}, u)
+
+	res, err := MILP(c, a, b, integer, &MILPSettings{NodeLimit: 5})
+	if err != ErrNodeLimit {
+		t.Fatalf("unexpected error: got %v, want %v", err, ErrNodeLimit)
+	}
+	if res.Nodes != 5 {
+		t.Errorf("unexpected number of nodes: got %d, want 5", res.Nodes)
+	}
+	if res.Bound > -100 || res.Bound < -101-1e-9 {
+		t.Errorf("invalid bound: %v with incumbent %v", res.Bound, res.F)
+	}
+
+	// The optimal value is -100 since all weights are even.
+	res, err = MILP(c, a, b, integer, &MILPSettings{Strategy: DepthFirst})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	checkMILP(t, "even knapsack", c, a, b, integer, res, -100)
+}
+
+func TestMILPUnboundedInfeasible(t *testing.T) {
+	t.Parallel()
+	// x1 - x2 = 0.5 with the objective -x1 is unbounded.
+	c := []float64{-1, 0}
+	a := mat.NewDense(1, 2, []float64{1, -1})
+	b := []float64{0.5}
+	_, err := MILP(c, a, b, []bool{true, true}, nil)
+	if err != ErrUnbounded {
+		t.Errorf("unexpected error: got %v, want %v", err, ErrUnbounded)
+	}
+
+	// x1 + x2 = 0.5 has no integer solution.
+	a = mat.NewDense(1, 2, []float64{1, 1})
+	_, err = MILP([]float64{1, 1}, a, b, []bool{true, true}, nil)
+	if err != ErrInfeasible {
+		t.Errorf("unexpected error: got %v, want %v", err, ErrInfeasible)
+	}
+}
+
+// checkMILP checks that the result of MILP is integer feasible and optimal.
+func checkMILP(t *testing.T, name string, c []float64, a mat.Matrix, b []float64, integer []bool, res *MILPResult, want float64) {
+	t.Helper()
+	const tol = 1e-6
+	if math.Abs(res.F-want) > tol {
+		t.Errorf("%s: unexpected optimal value: got %v, want %v", name, res.F, want)
+	}
+	if math.Abs(floats.Dot(c, res.X)-res.F) > tol {
+		t.Errorf("%s: objective value does not match the solution", name)
+	}
+	var ax mat.VecDense
+	ax.MulVec(a, mat.NewVecDense(len(res.X), res.X))
+	if !floats.EqualApprox(ax.RawVector().Data, b, tol) {
+		t.Errorf("%s: solution infeasible: Ax = %v, b = %v", name, ax.RawVector().Data, b)
+	}
+	for j, x := range res.X {
+		if x < -tol {
+			t.Errorf("%s: negative solution element %v", name, x)
+		}
+		if integer[j] && x != math.Round(x) {
+			t.Errorf("%s: non-integer solution element %v", name, x)
+		}
+	}
+	if res.Gap > defaultGapTol || res.Bound > res.F+tol {
+		t.Errorf("%s: invalid bound %v and gap %v", name, res.Bound, res.Gap)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 
 	"gonum.org/v1/gonum/floats"
 	"gonum.org/v1/gonum/mat"
@@ -66,7 +67,9 @@ const (
 // when the maximal reduced cost is below tol). An error will be returned if the
 // problem is infeasible or unbounded. In rare cases, numeric errors can cause
 // the Simplex to fail. In this case, an error will be returned along with the
-// most recently found feasible solution.
+// most recently found feasible solution. If the optimal solution is not found
+// within 50*(m+n) iterations, where A is an m×n matrix, ErrIterationLimit is
+// returned.
 //
 // The Convert function can be used to transform a general LP into standard form.
 //
@@ -89,17 +92,18 @@ const (
 //
 //	https://www.youtube.com/watch?v=ESzYPFkY3og&index=11&list=PLh464gFUoJWOmBYla3zbZbc4nv2AXez6X.
 func Simplex(c []float64, A mat.Matrix, b []float64, tol float64, initialBasic []int) (optF float64, optX []float64, err error) {
-	ans, x, _, err := simplex(initialBasic, c, A, b, tol)
+	ans, x, _, _, err := simplex(initialBasic, c, A, b, tol)
 	return ans, x, err
 }
 
-func simplex(initialBasic []int, c []float64, A mat.Matrix, b []float64, tol float64) (float64, []float64, []int, error) {
+func simplex(initialBasic []int, c []float64, A mat.Matrix, b []float64, tol float64) (float64, []float64, []int, int, error) {
+	var iter int
 	err := verifyInputs(initialBasic, c, A, b)
 	if err != nil {
 		if err == ErrUnbounded {
-			return math.Inf(-1), nil, nil, ErrUnbounded
+			return math.Inf(-1), nil, nil, iter, ErrUnbounded
 		}
-		return math.NaN(), nil, nil, err
+		return math.NaN(), nil, nil, iter, err
 	}
 	m, n := A.Dims()
 
@@ -110,15 +114,15 @@ func simplex(initialBasic []int, c []float64, A mat.Matrix, b []float64, tol flo
 		xVec := mat.NewVecDense(n, x)
 		err := xVec.SolveVec(A, bVec)
 		if err != nil {
-			return math.NaN(), nil, nil, ErrSingular
+			return math.NaN(), nil, nil, iter, ErrSingular
 		}
 		for _, v := range x {
 			if v < 0 {
-				return math.NaN(), nil, nil, ErrInfeasible
+				return math.NaN(), nil, nil, iter, ErrInfeasible
 			}
 		}
 		f := floats.Dot(x, c)
-		return f, x, nil, nil
+		return f, x, nil, iter, nil
 	}
 
 	// There is at least one optimal solution to the LP which is at the intersection
@@ -163,9 +167,9 @@ func simplex(initialBasic []int, c []float64, A mat.Matrix, b []float64, tol flo
 		copy(basicIdxs, initialBasic)
 	} else {
 		// No initial basis supplied. Solve the PhaseI problem.
-		basicIdxs, ab, xb, err = findInitialBasic(A, b)
+		basicIdxs, ab, xb, iter, err = findInitialBasic(A, b)
 		if err != nil {
-			return math.NaN(), nil, nil, err
+			return math.NaN(), nil, nil, iter, err
 		}
 	}
 
@@ -232,7 +236,12 @@ func simplex(initialBasic []int, c []float64, A mat.Matrix, b []float64, tol flo
 	// If no d_i is less than 0, then the problem is unbounded.
 	// 5) If the new xe is 0 (that is, bhat_i == 0), then this location is at
 	// the intersection of several constraints. Use the Bland rule instead
-	// of the rule in step 4 to avoid cycling.
+	// of the rule in step 4 to avoid cycling. Since the rule is applied to
+	// single steps only, a sequence of such steps can still cycle, so after
+	// m steps in a row that do not move, the Bland rule is applied strictly
+	// until a step moves.
+	maxIter := 50 * (m + n)
+	var stalled int
 	for {
 		// Compute reduced costs -- r = cn - anᵀ ab¯ᵀ cb
 		var tmp mat.VecDense
@@ -245,39 +254,55 @@ func simplex(initialBasic []int, c []float64, A mat.Matrix, b []float64, tol flo
 		tmp2.MulVec(an.T(), &tmp)
 		floats.SubTo(r, cn, data)
 
+		// Round the reduced costs before testing for optimality, so that
+		// rounding errors in zero reduced costs do not lead to steps between
+		// alternative optimal solutions.
+		for i, v := range r {
+			if math.Abs(v) < rRoundTol {
+				r[i] = 0
+			}
+		}
+
 		// Replace the most negative element in the simplex. If there are no
 		// negative entries then the optimal solution has been found.
 		minIdx := floats.MinIdx(r)
 		if r[minIdx] >= -tol {
 			break
 		}
-
-		for i, v := range r {
-			if math.Abs(v) < rRoundTol {
-				r[i] = 0
-			}
+		if iter == maxIter {
+			err = ErrIterationLimit
+			break
 		}
 
-		// Compute the moving distance.
-		err = computeMove(move, minIdx, A, ab, xb, nonBasicIdx)
-		if err != nil {
-			if err == ErrUnbounded {
-				return math.Inf(-1), nil, nil, ErrUnbounded
+		strict := stalled >= m
+		var replace int
+		if !strict {
+			// Compute the moving distance.
+			err = computeMove(move, minIdx, A, ab, xb, nonBasicIdx)
+			if err != nil {
+				if err == ErrUnbounded {
+					return math.Inf(-1), nil, nil, iter, ErrUnbounded
+				}
+				break
 			}
-			break
-		}
 
-		// Replace the basic index along the tightest constraint.
-		replace := floats.MinIdx(move)
-		if move[replace] <= 0 {
-			replace, minIdx, err = replaceBland(A, ab, xb, basicIdxs, nonBasicIdx, r, move)
+			// Replace the basic index along the tightest constraint.
+			replace = floats.MinIdx(move)
+		}
+		if strict || move[replace] <= 0 {
+			replace, minIdx, err = replaceBland(A, ab, xb, basicIdxs, nonBasicIdx, r, move, strict)
 			if err != nil {
 				if err == ErrUnbounded {
-					return math.Inf(-1), nil, nil, ErrUnbounded
+					return math.Inf(-1), nil, nil, iter, ErrUnbounded
 				}
 				break
 			}
 		}
+		if move[replace] <= blandZeroTol {
+			stalled++
+		} else {
+			stalled = 0
+		}
 
 		// Replace the constrained basicIdx with the newIdx.
 		basicIdxs[replace], nonBasicIdx[minIdx] = nonBasicIdx[minIdx], basicIdxs[replace]
@@ -286,6 +311,7 @@ func simplex(initialBasic []int, c []float64, A mat.Matrix, b []float64, tol flo
 		tmpCol2 := mat.Col(nil, minIdx, an)
 		ab.SetCol(replace, tmpCol2)
 		an.SetCol(minIdx, tmpCol1)
+		iter++
 
 		// Compute the new xb.
 		xbVec := mat.NewVecDense(len(xb), xb)
@@ -301,7 +327,7 @@ func simplex(initialBasic []int, c []float64, A mat.Matrix, b []float64, tol flo
 	for i, v := range basicIdxs {
 		xopt[v] = xb[i]
 	}
-	return opt, xopt, basicIdxs, err
+	return opt, xopt, basicIdxs, iter, err
 }
 
 // computeMove computes how far can be moved replacing each index. The results
@@ -346,12 +372,18 @@ func computeMove(move []float64, minIdx int, A mat.Matrix, ab *mat.Dense, xb []f
 
 // replaceBland uses the Bland rule to find the indices to swap if the minimum
 // move is 0. The indices to be swapped are replace and minIdx (following the
-// nomenclature in the main routine).
-func replaceBland(A mat.Matrix, ab *mat.Dense, xb []float64, basicIdxs, nonBasicIdx []int, r, move []float64) (replace, minIdx int, err error) {
+// nomenclature in the main routine). If strict is true, the candidates are
+// considered in the order of the indices of the variables as the Bland rule
+// requires to prevent cycling, otherwise in the order of their positions in
+// basicIdxs and nonBasicIdx, which change with every swap.
+func replaceBland(A mat.Matrix, ab *mat.Dense, xb []float64, basicIdxs, nonBasicIdx []int, r, move []float64, strict bool) (replace, minIdx int, err error) {
 	m, _ := A.Dims()
+	nonBasicOrder := positionOrder(nonBasicIdx, strict)
+	basicOrder := positionOrder(basicIdxs, strict)
 	// Use the traditional bland rule, except don't replace a constraint which
 	// causes the new ab to be singular.
-	for i, v := range r {
+	for _, i := range nonBasicOrder {
+		v := r[i]
 		if v > -blandNegTol {
 			continue
 		}
@@ -368,8 +400,8 @@ func replaceBland(A mat.Matrix, ab *mat.Dense, xb []float64, basicIdxs, nonBasic
 		}
 		// Find a zero index where replacement is non-singular.
 		biCopy := make([]int, len(basicIdxs))
-		for replace, v := range move {
-			if v > blandZeroTol {
+		for _, replace := range basicOrder {
+			if move[replace] > blandZeroTol {
 				continue
 			}
 			copy(biCopy, basicIdxs)
@@ -385,6 +417,19 @@ func replaceBland(A mat.Matrix, ab *mat.Dense, xb []float64, basicIdxs, nonBasic
 	return -1, -1, ErrBland
 }
 
+// positionOrder returns the positions of the elements of idx, ordered by the
+// values of the elements if byValue is true and in increasing order otherwise.
+func positionOrder(idx []int, byValue bool) []int {
+	order := make([]int, len(idx))
+	for i := range order {
+		order[i] = i
+	}
+	if byValue {
+		sort.Slice(order, func(a, b int) bool { return idx[order[a]] < idx[order[b]] })
+	}
+	return order
+}
+
 func verifyInputs(initialBasic []int, c []float64, A mat.Matrix, b []float64) error {
 	m, n := A.Dims()
 	if m > n {
@@ -494,12 +539,12 @@ func extractColumns(dst *mat.Dense, A mat.Matrix, cols []int) {
 }
 
 // findInitialBasic finds an initial basic solution, and returns the basic
-// indices, ab, and xb.
-func findInitialBasic(A mat.Matrix, b []float64) ([]int, *mat.Dense, []float64, error) {
+// indices, ab, xb and the number of iterations of the Phase I problem.
+func findInitialBasic(A mat.Matrix, b []float64) ([]int, *mat.Dense, []float64, int, error) {
 	m, n := A.Dims()
 	basicIdxs := findLinearlyIndependent(A)
 	if len(basicIdxs) != m {
-		return nil, nil, nil, ErrSingular
+		return nil, nil, nil, 0, ErrSingular
 	}
 
 	// It may be that this linearly independent basis is also a feasible set. If
@@ -509,7 +554,7 @@ func findInitialBasic(A mat.Matrix, b []float64) ([]int, *mat.Dense, []float64,
 	xb := make([]float64, m)
 	err := initializeFromBasic(xb, ab, b)
 	if err == nil {
-		return basicIdxs, ab, xb, nil
+		return basicIdxs, ab, xb, 0, nil
 	}
 
 	// This set was not feasible. Instead the "Phase I" problem must be solved
@@ -559,15 +604,15 @@ func findInitialBasic(A mat.Matrix, b []float64) ([]int, *mat.Dense, []float64,
 	c[n] = 1
 
 	// Solve the Phase I linear program.
-	_, xOpt, newBasic, err := simplex(basicIdxs, c, aNew, b, 1e-10)
+	_, xOpt, newBasic, iter, err := simplex(basicIdxs, c, aNew, b, 1e-10)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("lp: error finding feasible basis: %s", err)
+		return nil, nil, nil, iter, fmt.Errorf("lp: error finding feasible basis: %s", err)
 	}
 
 	// The original LP is infeasible if the added variable has non-zero value
 	// in the optimal solution to the Phase I problem.
 	if math.Abs(xOpt[n]) > phaseIZeroTol {
-		return nil, nil, nil, ErrInfeasible
+		return nil, nil, nil, iter, ErrInfeasible
 	}
 
 	// The basis found in Phase I is a feasible solution to the original LP if
@@ -581,7 +626,7 @@ func findInitialBasic(A mat.Matrix, b []float64) ([]int, *mat.Dense, []float64,
 	}
 	if addedIdx == -1 {
 		extractColumns(ab, A, newBasic)
-		return newBasic, ab, xb, nil
+		return newBasic, ab, xb, iter, nil
 	}
 
 	// The value of the added variable is in the basis, but it has a zero value.
@@ -606,10 +651,10 @@ func findInitialBasic(A mat.Matrix, b []float64) ([]int, *mat.Dense, []float64,
 		}
 		err := initializeFromBasic(xb, ab, b)
 		if err == nil {
-			return newBasic, ab, xb, nil
+			return newBasic, ab, xb, iter, nil
 		}
 	}
-	return nil, nil, nil, ErrInfeasible
+	return nil, nil, nil, iter, ErrInfeasible
 }
 
 // findLinearlyIndependent finds a set of linearly independent columns of A, and
@@ -38,6 +38,20 @@ func TestSimplex(t *testing.T) {
 			//initialBasic: nil,
 			tol: 0,
 		},
+		{
+			// Alternative optima with rounding errors in zero reduced
+			// costs that caused cycling
+			A: mat.NewDense(5, 9, []float64{
+				9, 9, 9, 9, 1, 0, 0, 0, 0,
+				1, 0, 0, 0, 0, 1, 0, 0, 0,
+				0, 1, 0, 0, 0, 0, 1, 0, 0,
+				0, 0, 1, 0, 0, 0, 0, 1, 0,
+				0, 0, 0, 1, 0, 0, 0, 0, 1,
+			}),
+			b:   []float64{17, 3, 3, 3, 3},
+			c:   []float64{-7, -4, -7, -1, 0, 0, 0, 0, 0},
+			tol: 0,
+		},
 		{
 			// Zero row that caused linear solver failure
 			A: mat.NewDense(3, 5, []float64{0.09917822373225804, 0, 0, -0.2588175087223661, -0.5935518220870567, 1.301111422556007, 0.12220247487326946, 0, 0, -1.9194869979254463, 0, 0, 0, 0, -0.8588221231396473}),
@@ -175,7 +189,7 @@ func testRandomSimplex(t *testing.T, nTest int, pZero float64, maxN int, rnd *ra
 }
 
 func testSimplex(t *testing.T, initialBasic []int, c []float64, a mat.Matrix, b []float64, convergenceTol float64) {
-	primalOpt, primalX, _, errPrimal := simplex(initialBasic, c, a, b, convergenceTol)
+	primalOpt, primalX, _, _, errPrimal := simplex(initialBasic, c, a, b, convergenceTol)
 	if errPrimal == nil {
 		// No error solving the simplex, check that the solution is feasible.
 		var bCheck mat.VecDense
@@ -222,7 +236,7 @@ func testSimplex(t *testing.T, initialBasic []int, c []float64, a mat.Matrix, b
 	negAT.Scale(-1, negAT)
 	cNew, aNew, bNew := Convert(b, negAT, c, nil, nil)
 
-	dualOpt, dualX, _, errDual := simplex(nil, cNew, aNew, bNew, convergenceTol)
+	dualOpt, dualX, _, _, errDual := simplex(nil, cNew, aNew, bNew, convergenceTol)
 	if errDual == nil {
 		// Check that the dual is feasible
 		var bCheck mat.VecDense
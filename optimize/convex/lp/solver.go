@@ -0,0 +1,423 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lp
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// Solver solves a linear program in standard form
+//
+//	minimize	cᵀ x
+//	s.t. 		A*x = b
+//				x >= 0
+//
+// repeatedly while the problem is modified. The optimal basis of each solve
+// is retained and used to warm start the next one. If the basis is still dual
+// feasible, which is the case after changing b or adding constraints, the
+// problem is re-solved with the dual simplex method. If it is still primal
+// feasible, which is the case after changing c, the problem is re-solved with
+// the primal simplex method. Otherwise the problem is solved from scratch.
+type Solver struct {
+	// Tol is the tolerance passed to Simplex and DualSimplex.
+	Tol float64
+
+	c []float64
+	a *mat.Dense
+	b []float64
+
+	// slack[j] is true if variable j is a slack variable added by
+	// AddConstraint.
+	slack []bool
+
+	// basic holds the basic variables of the most recent basis, and it is
+	// nil if there is none. optimal records whether the basis is optimal
+	// for the current problem.
+	basic   []int
+	optimal bool
+}
+
+// NewSolver returns a new Solver for the linear program in standard form given
+// by c, A and b. The inputs are copied. NewSolver will panic if len(c) does
+// not equal the number of columns of A or if len(b) does not equal the number
+// of rows of A.
+func NewSolver(c []float64, A mat.Matrix, b []float64) *Solver {
+	m, n := A.Dims()
+	if len(c) != n || len(b) != m {
+		panic(badShape)
+	}
+	return &Solver{
+		c:     append([]float64(nil), c...),
+		a:     mat.DenseCopyOf(A),
+		b:     append([]float64(nil), b...),
+		slack: make([]bool, n),
+	}
+}
+
+// Dims returns the number of constraints and the number of variables of the
+// current problem.
+func (s *Solver) Dims() (m, n int) {
+	return s.a.Dims()
+}
+
+// SetRHS sets the right-hand side of the constraints to b. SetRHS will panic
+// if len(b) does not equal the number of constraints.
+func (s *Solver) SetRHS(b []float64) {
+	if len(b) != len(s.b) {
+		panic(badShape)
+	}
+	copy(s.b, b)
+	s.optimal = false
+}
+
+// SetCost sets the cost vector of the objective function to c. SetCost will
+// panic if len(c) does not equal the number of variables.
+func (s *Solver) SetCost(c []float64) {
+	if len(c) != len(s.c) {
+		panic(badShape)
+	}
+	copy(s.c, c)
+	s.optimal = false
+}
+
+// Basis returns a copy of the indices of the basic variables of the most
+// recent basis. It returns nil if the Solver has no basis.
+func (s *Solver) Basis() []int {
+	if s.basic == nil {
+		return nil
+	}
+	return append([]int(nil), s.basic...)
+}
+
+// SetBasis sets the basis used to warm start the next solve, for example to
+// the optimal basis of a related problem. If basic is nil, the next solve
+// starts from scratch. SetBasis will panic if basic is not nil and its length
+// does not equal the number of constraints or if it holds an index out of
+// range.
+func (s *Solver) SetBasis(basic []int) {
+	s.optimal = false
+	if basic == nil {
+		s.basic = nil
+		return
+	}
+	m, n := s.a.Dims()
+	if len(basic) != m {
+		panic("lp: basic incorrect length")
+	}
+	for _, v := range basic {
+		if v < 0 || v >= n {
+			panic("lp: basic index out of range")
+		}
+	}
+	s.basic = append([]int(nil), basic...)
+}
+
+// AddConstraint adds the inequality constraint aᵀ x <= rhs to the problem.
+// The constraint is converted to the equality aᵀ x + s = rhs with a new slack
+// variable s >= 0 that has zero cost. The new constraint is the last row and
+// the slack variable the last column of the problem, and AddConstraint
+// returns the index of the new row. AddConstraint will panic if len(a) does
+// not equal the number of variables.
+//
+// The slack variable is added to the basis, so the basis remains dual
+// feasible and the next solve uses the dual simplex method.
+func (s *Solver) AddConstraint(a []float64, rhs float64) int {
+	m, n := s.a.Dims()
+	if len(a) != n {
+		panic(badShape)
+	}
+	grown := mat.NewDense(m+1, n+1, nil)
+	grown.Slice(0, m, 0, n).(*mat.Dense).Copy(s.a)
+	grown.Slice(m, m+1, 0, n).(*mat.Dense).Copy(mat.NewDense(1, n, a))
+	grown.Set(m, n, 1)
+	s.a = grown
+	s.b = append(s.b, rhs)
+	s.c = append(s.c, 0)
+	s.slack = append(s.slack, true)
+	if s.basic != nil {
+		s.basic = append(s.basic, n)
+	}
+	s.optimal = false
+	return m
+}
+
+// RemoveConstraint removes the constraint in row i from the problem. If the
+// constraint was added by AddConstraint, its slack variable is removed too,
+// and the indices of the following variables are decreased by one.
+// RemoveConstraint will panic if i is out of range or if the problem has only
+// one constraint.
+func (s *Solver) RemoveConstraint(i int) {
+	m, n := s.a.Dims()
+	if i < 0 || i >= m {
+		panic("lp: constraint index out of range")
+	}
+	if m == 1 {
+		panic("lp: cannot remove the only constraint")
+	}
+	col := -1
+	for j, isSlack := range s.slack {
+		if isSlack && s.a.At(i, j) != 0 {
+			col = j
+			break
+		}
+	}
+
+	// Remove a variable from the basis so that the remaining basis matrix
+	// is non-singular.
+	if s.basic != nil {
+		leave := -1
+		for k, v := range s.basic {
+			if v == col {
+				leave = k
+				break
+			}
+		}
+		if leave == -1 {
+			leave = s.leavingForRow(i)
+		}
+		if leave == -1 {
+			s.basic = nil
+		} else {
+			s.basic = append(s.basic[:leave], s.basic[leave+1:]...)
+			if col != -1 {
+				for k, v := range s.basic {
+					if v > col {
+						s.basic[k]--
+					}
+				}
+			}
+		}
+	}
+
+	nNew := n
+	if col != -1 {
+		nNew--
+	}
+	shrunk := mat.NewDense(m-1, nNew, nil)
+	for r, rNew := 0, 0; r < m; r++ {
+		if r == i {
+			continue
+		}
+		for j, jNew := 0, 0; j < n; j++ {
+			if j == col {
+				continue
+			}
+			shrunk.Set(rNew, jNew, s.a.At(r, j))
+			jNew++
+		}
+		rNew++
+	}
+	s.a = shrunk
+	s.b = append(s.b[:i], s.b[i+1:]...)
+	if col != -1 {
+		s.c = append(s.c[:col], s.c[col+1:]...)
+		s.slack = append(s.slack[:col], s.slack[col+1:]...)
+	}
+	s.optimal = false
+}
+
+// leavingForRow returns the position in the basis of the variable whose
+// removal together with row i keeps the basis matrix non-singular, or -1 if
+// the basis matrix is singular. The determinant of the reduced basis matrix
+// is proportional to the corresponding element of column i of A_B⁻¹, so the
+// element of largest magnitude is chosen.
+func (s *Solver) leavingForRow(i int) int {
+	m := len(s.basic)
+	ab := mat.NewDense(m, m, nil)
+	extractColumns(ab, s.a, s.basic)
+	var lu mat.LU
+	lu.Factorize(ab)
+	if lu.Cond() > basisCondTol {
+		return -1
+	}
+	e := mat.NewVecDense(m, nil)
+	e.SetVec(i, 1)
+	var beta mat.VecDense
+	err := lu.SolveVecTo(&beta, false, e)
+	if err != nil {
+		if _, ok := err.(mat.Condition); !ok {
+			return -1
+		}
+	}
+	leave := 0
+	for k := 1; k < m; k++ {
+		if math.Abs(beta.AtVec(k)) > math.Abs(beta.AtVec(leave)) {
+			leave = k
+		}
+	}
+	return leave
+}
+
+// Solve solves the current problem, warm starting from the most recent basis
+// if possible. The returned Result holds the optimal basis in Basic. Solve
+// returns the same errors as Simplex and DualSimplex. If the problem is found
+// infeasible by the dual simplex method, the Result holds a certificate of
+// infeasibility.
+func (s *Solver) Solve() (*Result, error) {
+	s.optimal = false
+	if s.basic != nil {
+		res, ok, err := s.warmStart()
+		if ok {
+			if err == nil {
+				s.basic = append(s.basic[:0], res.Basic...)
+				s.optimal = true
+			}
+			return res, err
+		}
+	}
+
+	// Solve the problem from scratch.
+	_, x, basic, iter, err := simplex(nil, s.c, s.a, s.b, s.Tol)
+	if err != nil {
+		return nil, err
+	}
+	if basic == nil {
+		// The problem is square and the solution is given by all variables.
+		basic = make([]int, len(x))
+		for i := range basic {
+			basic[i] = i
+		}
+	}
+	f, err := newBasisFactor(s.c, s.a, s.b, basic)
+	if err != nil {
+		return nil, err
+	}
+	s.basic = basic
+	s.optimal = true
+	return f.result(iter), nil
+}
+
+// warmStart attempts to solve the problem starting from the current basis.
+// It returns false if the basis cannot be used.
+func (s *Solver) warmStart() (*Result, bool, error) {
+	tol := math.Max(s.Tol, dualFeasTol)
+	f, err := newBasisFactor(s.c, s.a, s.b, append([]int(nil), s.basic...))
+	if err != nil {
+		return nil, false, nil
+	}
+	if f.dualFeasible(tol) {
+		res, err := f.dualSimplex(s.Tol)
+		switch err {
+		case nil, ErrInfeasible:
+			return res, true, err
+		default:
+			return nil, false, nil
+		}
+	}
+	if !f.primalFeasible(0) {
+		return nil, false, nil
+	}
+	_, _, basic, iter, err := simplex(f.basic, s.c, s.a, s.b, s.Tol)
+	if err != nil {
+		return nil, true, err
+	}
+	f, err = newBasisFactor(s.c, s.a, s.b, basic)
+	if err != nil {
+		return nil, false, nil
+	}
+	return f.result(iter), true, nil
+}
+
+// Sensitivity holds the results of the sensitivity analysis of an optimal
+// basis. The ranges are the intervals over which a single element of c or b
+// can vary while the other elements are fixed and the basis remains optimal.
+type Sensitivity struct {
+	// ShadowPrice holds the dual values of the constraints, the rate of
+	// change of the optimal value with respect to the elements of b.
+	ShadowPrice []float64
+	// ReducedCost holds the reduced costs of the variables.
+	ReducedCost []float64
+
+	// CostLower and CostUpper hold the range of values of each element of c
+	// for which the basis remains optimal. The range of a non-basic variable
+	// is unbounded above.
+	CostLower, CostUpper []float64
+	// RHSLower and RHSUpper hold the range of values of each element of b
+	// for which the basis remains feasible, and thus optimal. Within this
+	// range, the optimal value changes linearly with the shadow price.
+	RHSLower, RHSUpper []float64
+}
+
+// Sensitivity returns the sensitivity analysis of the optimal basis found by
+// the most recent call to Solve. Sensitivity will panic if the problem has
+// been modified since, or if the most recent solve was not successful.
+func (s *Solver) Sensitivity() *Sensitivity {
+	if !s.optimal {
+		panic("lp: no optimal basis")
+	}
+	f, err := newBasisFactor(s.c, s.a, s.b, append([]int(nil), s.basic...))
+	if err != nil {
+		panic("lp: no optimal basis")
+	}
+	m, n := s.a.Dims()
+	sens := &Sensitivity{
+		ShadowPrice: append([]float64(nil), f.y...),
+		ReducedCost: append([]float64(nil), f.d...),
+		CostLower:   make([]float64, n),
+		CostUpper:   make([]float64, n),
+		RHSLower:    make([]float64, m),
+		RHSUpper:    make([]float64, m),
+	}
+
+	// A non-basic variable stays non-basic while its reduced cost is
+	// non-negative.
+	for j, cj := range s.c {
+		if !f.isBasic[j] {
+			sens.CostLower[j] = cj - math.Max(f.d[j], 0)
+			sens.CostUpper[j] = math.Inf(1)
+		}
+	}
+
+	// Changing the cost of the basic variable in position r by δ changes the
+	// reduced costs of the non-basic variables by -δ α, where α is row r of
+	// A_B⁻¹ A.
+	rho := make([]float64, m)
+	alpha := make([]float64, n)
+	for r, v := range s.basic {
+		_ = f.solveUnit(rho, r, true)
+		for j := range alpha {
+			alpha[j] = 0
+		}
+		mulTransVec(alpha, s.a, rho, 1)
+		lo, hi := math.Inf(-1), math.Inf(1)
+		for j, aj := range alpha {
+			if f.isBasic[j] || math.Abs(aj) < dualPivotTol {
+				continue
+			}
+			delta := math.Max(f.d[j], 0) / aj
+			if aj > 0 {
+				hi = math.Min(hi, delta)
+			} else {
+				lo = math.Max(lo, delta)
+			}
+		}
+		sens.CostLower[v] = s.c[v] + lo
+		sens.CostUpper[v] = s.c[v] + hi
+	}
+
+	// Changing b[i] by δ changes the basic solution by δ β, where β is
+	// column i of A_B⁻¹.
+	beta := make([]float64, m)
+	for i, bi := range s.b {
+		_ = f.solveUnit(beta, i, false)
+		lo, hi := math.Inf(-1), math.Inf(1)
+		for k, bk := range beta {
+			if math.Abs(bk) < dualPivotTol {
+				continue
+			}
+			delta := -math.Max(f.xb[k], 0) / bk
+			if bk > 0 {
+				lo = math.Max(lo, delta)
+			} else {
+				hi = math.Min(hi, delta)
+			}
+		}
+		sens.RHSLower[i] = bi + lo
+		sens.RHSUpper[i] = bi + hi
+	}
+	return sens
+}
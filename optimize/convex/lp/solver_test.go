@@ -0,0 +1,353 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lp
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+// randomFeasibleLP returns a random standard form LP that is feasible and
+// bounded. The constraints include slack variables so that the feasible set
+// is a bounded polytope containing a known point.
+func randomFeasibleLP(rnd *rand.Rand, m, n int) (c []float64, a *mat.Dense, b []float64) {
+	a = mat.NewDense(m, n+m, nil)
+	x := make([]float64, n)
+	for j := range x {
+		x[j] = rnd.Float64()
+	}
+	b = make([]float64, m)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			v := rnd.Float64()
+			a.Set(i, j, v)
+			b[i] += v * x[j]
+		}
+		a.Set(i, n+i, 1)
+		b[i] += rnd.Float64()
+	}
+	c = make([]float64, n+m)
+	for j := 0; j < n; j++ {
+		c[j] = rnd.NormFloat64()
+	}
+	return c, a, b
+}
+
+func TestDualSimplex(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for cas := 0; cas < 200; cas++ {
+		m := rnd.Intn(8) + 1
+		n := rnd.Intn(10) + 1
+		c, a, b := randomFeasibleLP(rnd, m, n)
+		s := NewSolver(c, a, b)
+		res, err := s.Solve()
+		if err != nil {
+			t.Fatalf("case %d: unexpected error: %v", cas, err)
+		}
+		basic := append([]int(nil), res.Basic...)
+
+		// Perturb the right-hand side and re-solve from the optimal basis.
+		bNew := make([]float64, m)
+		for i := range bNew {
+			bNew[i] = b[i] * (0.5 + rnd.Float64())
+		}
+		got, err := DualSimplex(c, a, bNew, 0, basic)
+		if !reflect.DeepEqual(basic, res.Basic) {
+			t.Errorf("case %d: basic modified", cas)
+		}
+		wantF, _, wantErr := Simplex(c, a, bNew, 0, nil)
+		if err != wantErr {
+			t.Errorf("case %d: mismatched error: got %v, want %v", cas, err, wantErr)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if !scalar.EqualWithinAbsOrRel(got.F, wantF, 1e-10, 1e-10) {
+			t.Errorf("case %d: mismatched optimal value: got %v, want %v", cas, got.F, wantF)
+		}
+		checkBasicResult(t, "dual simplex", c, a, bNew, got)
+	}
+}
+
+func TestDualSimplexInfeasible(t *testing.T) {
+	t.Parallel()
+	// x0 + x1 + x2 = 1, x0 - x1 = 3 with x >= 0 requires x0 >= 3.
+	a := mat.NewDense(2, 3, []float64{
+		1, 1, 1,
+		1, -1, 0,
+	})
+	c := []float64{1, 1, 0}
+	b := []float64{1, 3}
+	// The basis {x1, x2} is dual feasible but not primal feasible.
+	res, err := DualSimplex(c, a, b, 0, []int{2, 1})
+	if err != ErrInfeasible {
+		t.Fatalf("unexpected error: got %v, want %v", err, ErrInfeasible)
+	}
+	checkCertificate(t, "dual simplex", c, a, b, res, err)
+}
+
+func TestSolver(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for cas := 0; cas < 100; cas++ {
+		m := rnd.Intn(6) + 2
+		n := rnd.Intn(8) + 1
+		c, a, b := randomFeasibleLP(rnd, m, n)
+		s := NewSolver(c, a, b)
+		res, err := s.Solve()
+		if err != nil {
+			t.Fatalf("case %d: unexpected error: %v", cas, err)
+		}
+		origF := res.F
+
+		// The cold solve starts from the basis of slack variables and each
+		// iteration brings one variable into the basis.
+		var entered int
+		for _, v := range res.Basic {
+			if v < n {
+				entered++
+			}
+		}
+		if res.Iterations < entered {
+			t.Errorf("case %d: too few iterations for the optimal basis: got %d, want at least %d", cas, res.Iterations, entered)
+		}
+
+		// Change the right-hand side.
+		bNew := make([]float64, m)
+		for i := range bNew {
+			bNew[i] = b[i] * (0.8 + 0.4*rnd.Float64())
+		}
+		s.SetRHS(bNew)
+		res, err = s.Solve()
+		wantF, _, wantErr := Simplex(c, a, bNew, 0, nil)
+		if err != wantErr || (err == nil && !scalar.EqualWithinAbsOrRel(res.F, wantF, 1e-10, 1e-10)) {
+			t.Errorf("case %d: SetRHS: got %v, %v, want %v, %v", cas, res, err, wantF, wantErr)
+			continue
+		}
+		s.SetRHS(b)
+
+		// Change the costs.
+		cNew := make([]float64, len(c))
+		for j := range cNew {
+			cNew[j] = c[j] + 0.3*rnd.NormFloat64()
+		}
+		s.SetCost(cNew)
+		res, err = s.Solve()
+		wantF, _, wantErr = Simplex(cNew, a, b, 0, nil)
+		if err != wantErr || (err == nil && !scalar.EqualWithinAbsOrRel(res.F, wantF, 1e-10, 1e-10)) {
+			t.Errorf("case %d: SetCost: got %v, %v, want %v, %v", cas, res, err, wantF, wantErr)
+			continue
+		}
+		s.SetCost(c)
+
+		// Add a cut that removes part of the feasible set.
+		row := make([]float64, len(c))
+		for j := 0; j < n; j++ {
+			row[j] = rnd.Float64()
+		}
+		rhs := 0.5 * floats.Dot(row, res.X)
+		if _, err := s.Solve(); err != nil {
+			t.Fatalf("case %d: unexpected error: %v", cas, err)
+		}
+		added := s.AddConstraint(row, rhs)
+		if added != m {
+			t.Errorf("case %d: unexpected index of the added constraint: %d", cas, added)
+		}
+		res, err = s.Solve()
+		cBig := append(append([]float64(nil), c...), 0)
+		aBig := mat.NewDense(m+1, len(cBig), nil)
+		aBig.Slice(0, m, 0, len(c)).(*mat.Dense).Copy(a)
+		aBig.Slice(m, m+1, 0, len(c)).(*mat.Dense).Copy(mat.NewDense(1, len(c), row))
+		aBig.Set(m, len(c), 1)
+		bBig := append(append([]float64(nil), b...), rhs)
+		wantF, _, wantErr = Simplex(cBig, aBig, bBig, 0, nil)
+		if err != wantErr || (err == nil && !scalar.EqualWithinAbsOrRel(res.F, wantF, 1e-10, 1e-10)) {
+			t.Errorf("case %d: AddConstraint: got %v, %v, want %v, %v", cas, res, err, wantF, wantErr)
+			continue
+		}
+		if err == nil {
+			checkBasicResult(t, "add constraint", cBig, aBig, bBig, res)
+		}
+
+		// Removing the cut restores the original problem.
+		s.RemoveConstraint(added)
+		if gm, gn := s.Dims(); gm != m || gn != len(c) {
+			t.Errorf("case %d: unexpected dimensions after RemoveConstraint: %d×%d", cas, gm, gn)
+		}
+		res, err = s.Solve()
+		if err != nil {
+			t.Errorf("case %d: RemoveConstraint: unexpected error: %v", cas, err)
+			continue
+		}
+		if !scalar.EqualWithinAbsOrRel(res.F, origF, 1e-10, 1e-10) {
+			t.Errorf("case %d: RemoveConstraint: got %v, want %v", cas, res.F, origF)
+		}
+
+		// Remove one of the original constraints.
+		i := rnd.Intn(m)
+		s.RemoveConstraint(i)
+		res, err = s.Solve()
+		aSmall := mat.NewDense(m-1, len(c), nil)
+		bSmall := make([]float64, 0, m-1)
+		for r, rNew := 0, 0; r < m; r++ {
+			if r == i {
+				continue
+			}
+			aSmall.SetRow(rNew, a.RawRowView(r))
+			bSmall = append(bSmall, b[r])
+			rNew++
+		}
+		wantF, _, wantErr = Simplex(c, aSmall, bSmall, 0, nil)
+		if wantErr == ErrZeroColumn {
+			// The slack variable of the removed row is an all-zero column
+			// that Simplex does not accept.
+			continue
+		}
+		if err != wantErr || (err == nil && !scalar.EqualWithinAbsOrRel(res.F, wantF, 1e-10, 1e-10)) {
+			t.Errorf("case %d: RemoveConstraint(%d): got %v, %v, want %v, %v", cas, i, res, err, wantF, wantErr)
+		}
+	}
+}
+
+func TestSensitivity(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for cas := 0; cas < 100; cas++ {
+		m := rnd.Intn(5) + 1
+		n := rnd.Intn(6) + 1
+		c, a, b := randomFeasibleLP(rnd, m, n)
+		s := NewSolver(c, a, b)
+		res, err := s.Solve()
+		if err != nil {
+			t.Fatalf("case %d: unexpected error: %v", cas, err)
+		}
+		sens := s.Sensitivity()
+		if !floats.EqualApprox(sens.ShadowPrice, res.Dual, 1e-12) || !floats.EqualApprox(sens.ReducedCost, res.ReducedCost, 1e-12) {
+			t.Errorf("case %d: mismatched dual values", cas)
+		}
+
+		// Within the cost ranges the solution does not change, so the
+		// optimal value is linear in the cost. At the ends of the ranges
+		// the problem is dual degenerate, so Simplex is given a tolerance
+		// to avoid cycling.
+		cNew := make([]float64, len(c))
+		for j := range c {
+			lo, hi := sens.CostLower[j], sens.CostUpper[j]
+			if lo > c[j] || hi < c[j] {
+				t.Errorf("case %d: cost range [%v, %v] does not contain %v", cas, lo, hi, c[j])
+				continue
+			}
+			for _, v := range []float64{lo, hi, 0.5 * (lo + c[j]), 0.5 * (hi + c[j])} {
+				if math.IsInf(v, 0) {
+					v = c[j] + 10*math.Copysign(1, v)
+				}
+				copy(cNew, c)
+				cNew[j] = v
+				f, _, err := Simplex(cNew, a, b, convergenceTol, nil)
+				if err != nil {
+					t.Errorf("case %d: unexpected error: %v", cas, err)
+					continue
+				}
+				if want := floats.Dot(cNew, res.X); !scalar.EqualWithinAbsOrRel(f, want, 1e-9, 1e-9) {
+					t.Errorf("case %d: c[%d]=%v in [%v, %v]: optimal value %v, want %v", cas, j, v, lo, hi, f, want)
+				}
+			}
+			// Just outside the range the solution is no longer optimal.
+			for _, v := range []float64{lo - 1e-3, hi + 1e-3} {
+				if math.IsInf(v, 0) {
+					continue
+				}
+				copy(cNew, c)
+				cNew[j] = v
+				f, _, err := Simplex(cNew, a, b, convergenceTol, nil)
+				if err == nil && f >= floats.Dot(cNew, res.X)-1e-9 {
+					t.Errorf("case %d: c[%d]=%v outside [%v, %v] but solution still optimal", cas, j, v, lo, hi)
+				}
+			}
+		}
+
+		// Within the right-hand side ranges the optimal value changes
+		// linearly with the shadow price.
+		bNew := make([]float64, m)
+		for i := range b {
+			lo, hi := sens.RHSLower[i], sens.RHSUpper[i]
+			if lo > b[i] || hi < b[i] {
+				t.Errorf("case %d: right-hand side range [%v, %v] does not contain %v", cas, lo, hi, b[i])
+				continue
+			}
+			for _, v := range []float64{lo, hi, 0.5 * (lo + b[i]), 0.5 * (hi + b[i])} {
+				if math.IsInf(v, 0) {
+					v = b[i] + 10*math.Copysign(1, v)
+				}
+				copy(bNew, b)
+				bNew[i] = v
+				f, _, err := Simplex(c, a, bNew, convergenceTol, nil)
+				if err != nil {
+					t.Errorf("case %d: unexpected error: %v", cas, err)
+					continue
+				}
+				if want := res.F + sens.ShadowPrice[i]*(v-b[i]); !scalar.EqualWithinAbsOrRel(f, want, 1e-9, 1e-9) {
+					t.Errorf("case %d: b[%d]=%v in [%v, %v]: optimal value %v, want %v", cas, i, v, lo, hi, f, want)
+				}
+			}
+		}
+	}
+}
+
+func TestSensitivityPanics(t *testing.T) {
+	t.Parallel()
+	s := NewSolver([]float64{1, 1}, mat.NewDense(1, 2, []float64{1, 1}), []float64{1})
+	if !panics(func() { s.Sensitivity() }) {
+		t.Errorf("expected panic before Solve")
+	}
+	if _, err := s.Solve(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	s.SetRHS([]float64{2})
+	if !panics(func() { s.Sensitivity() }) {
+		t.Errorf("expected panic after modification")
+	}
+}
+
+// checkBasicResult checks the optimality conditions of the basic solution in
+// res.
+func checkBasicResult(t *testing.T, name string, c []float64, a mat.Matrix, b []float64, res *Result) {
+	t.Helper()
+	checkInteriorPoint(t, name, c, a, b, res, 1e-10)
+	m, _ := a.Dims()
+	if len(res.Basic) != m {
+		t.Errorf("%s: basis has wrong length", name)
+	}
+	isBasic := make(map[int]bool)
+	for _, v := range res.Basic {
+		isBasic[v] = true
+	}
+	for j, x := range res.X {
+		if !isBasic[j] && x != 0 {
+			t.Errorf("%s: non-zero non-basic variable", name)
+		}
+		if isBasic[j] && res.ReducedCost[j] != 0 {
+			t.Errorf("%s: non-zero reduced cost of basic variable", name)
+		}
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		r := recover()
+		panicked = r != nil
+	}()
+	fn()
+	return
+}
@@ -0,0 +1,216 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lp
+
+import (
+	"sort"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	_ mat.Matrix         = (*Sparse)(nil)
+	_ mat.NonZeroDoer    = (*Sparse)(nil)
+	_ mat.ColNonZeroDoer = (*Sparse)(nil)
+)
+
+// Sparse is a sparse matrix stored in compressed sparse column format. It is
+// intended for holding large constraint matrices of linear programs, for
+// which a dense representation would not fit in memory.
+type Sparse struct {
+	r, c int
+
+	// The row indices and values of the non-zero elements of column j are
+	// stored in rowIdx[colPtr[j]:colPtr[j+1]] and data[colPtr[j]:colPtr[j+1]],
+	// sorted by row index.
+	colPtr []int
+	rowIdx []int
+	data   []float64
+}
+
+// NewSparse returns a new r×c sparse matrix built from the triplets
+// (rows[k], cols[k], vals[k]). Values of duplicate entries are summed, and
+// entries that are zero are not stored. NewSparse will panic if r or c is
+// not positive, if the lengths of rows, cols and vals differ, or if an index
+// is out of range.
+func NewSparse(r, c int, rows, cols []int, vals []float64) *Sparse {
+	if r <= 0 || c <= 0 {
+		if r == 0 || c == 0 {
+			panic(mat.ErrZeroLength)
+		}
+		panic("lp: negative dimension")
+	}
+	if len(rows) != len(vals) || len(cols) != len(vals) {
+		panic(badShape)
+	}
+	for k := range vals {
+		if rows[k] < 0 || rows[k] >= r || cols[k] < 0 || cols[k] >= c {
+			panic(mat.ErrIndexOutOfRange)
+		}
+	}
+
+	// Sort the triplets by column and then by row.
+	order := make([]int, len(vals))
+	for k := range order {
+		order[k] = k
+	}
+	sort.Slice(order, func(a, b int) bool {
+		ka, kb := order[a], order[b]
+		if cols[ka] != cols[kb] {
+			return cols[ka] < cols[kb]
+		}
+		return rows[ka] < rows[kb]
+	})
+
+	s := &Sparse{
+		r:      r,
+		c:      c,
+		colPtr: make([]int, c+1),
+	}
+	for n := 0; n < len(order); {
+		i, j := rows[order[n]], cols[order[n]]
+		var v float64
+		for ; n < len(order) && rows[order[n]] == i && cols[order[n]] == j; n++ {
+			v += vals[order[n]]
+		}
+		if v == 0 {
+			continue
+		}
+		s.rowIdx = append(s.rowIdx, i)
+		s.data = append(s.data, v)
+		s.colPtr[j+1]++
+	}
+	for j := 0; j < c; j++ {
+		s.colPtr[j+1] += s.colPtr[j]
+	}
+	return s
+}
+
+// sparseFrom returns a sparse copy of a. If a is a *Sparse, it is returned
+// directly.
+func sparseFrom(a mat.Matrix) *Sparse {
+	if s, ok := a.(*Sparse); ok {
+		return s
+	}
+	r, c := a.Dims()
+	var (
+		rows, cols []int
+		vals       []float64
+	)
+	add := func(i, j int, v float64) {
+		if v != 0 {
+			rows = append(rows, i)
+			cols = append(cols, j)
+			vals = append(vals, v)
+		}
+	}
+	if nz, ok := a.(mat.NonZeroDoer); ok {
+		nz.DoNonZero(add)
+	} else {
+		for i := 0; i < r; i++ {
+			for j := 0; j < c; j++ {
+				add(i, j, a.At(i, j))
+			}
+		}
+	}
+	return NewSparse(r, c, rows, cols, vals)
+}
+
+// Dims returns the dimensions of the matrix.
+func (s *Sparse) Dims() (r, c int) {
+	return s.r, s.c
+}
+
+// At returns the element at row i, column j.
+func (s *Sparse) At(i, j int) float64 {
+	if uint(i) >= uint(s.r) {
+		panic(mat.ErrRowAccess)
+	}
+	if uint(j) >= uint(s.c) {
+		panic(mat.ErrColAccess)
+	}
+	lo, hi := s.colPtr[j], s.colPtr[j+1]
+	k := lo + sort.SearchInts(s.rowIdx[lo:hi], i)
+	if k < hi && s.rowIdx[k] == i {
+		return s.data[k]
+	}
+	return 0
+}
+
+// T performs an implicit transpose by returning the receiver inside a
+// mat.Transpose.
+func (s *Sparse) T() mat.Matrix {
+	return mat.Transpose{Matrix: s}
+}
+
+// NNZ returns the number of stored non-zero elements.
+func (s *Sparse) NNZ() int {
+	return len(s.data)
+}
+
+// DoNonZero calls the function fn for each of the non-zero elements of s.
+// The elements are visited in column-major order.
+func (s *Sparse) DoNonZero(fn func(i, j int, v float64)) {
+	for j := 0; j < s.c; j++ {
+		s.DoColNonZero(j, fn)
+	}
+}
+
+// DoColNonZero calls the function fn for each of the non-zero elements of
+// column j of s.
+func (s *Sparse) DoColNonZero(j int, fn func(i, j int, v float64)) {
+	if uint(j) >= uint(s.c) {
+		panic(mat.ErrColAccess)
+	}
+	for k := s.colPtr[j]; k < s.colPtr[j+1]; k++ {
+		fn(s.rowIdx[k], j, s.data[k])
+	}
+}
+
+// mulVecTo computes dst = alpha * A * x + beta * dst.
+func (s *Sparse) mulVecTo(dst []float64, alpha float64, x []float64, beta float64) {
+	if beta != 1 {
+		for i := range dst {
+			dst[i] *= beta
+		}
+	}
+	for j := 0; j < s.c; j++ {
+		xj := alpha * x[j]
+		if xj == 0 {
+			continue
+		}
+		for k := s.colPtr[j]; k < s.colPtr[j+1]; k++ {
+			dst[s.rowIdx[k]] += s.data[k] * xj
+		}
+	}
+}
+
+// mulTransVecTo computes dst = alpha * Aᵀ * y + beta * dst.
+func (s *Sparse) mulTransVecTo(dst []float64, alpha float64, y []float64, beta float64) {
+	for j := 0; j < s.c; j++ {
+		var v float64
+		for k := s.colPtr[j]; k < s.colPtr[j+1]; k++ {
+			v += s.data[k] * y[s.rowIdx[k]]
+		}
+		dst[j] = alpha*v + beta*dst[j]
+	}
+}
+
+// normalTo computes dst = A * diag(d) * Aᵀ.
+func (s *Sparse) normalTo(dst *mat.SymDense, d []float64) {
+	dst.Zero()
+	raw := dst.RawSymmetric()
+	for j := 0; j < s.c; j++ {
+		lo, hi := s.colPtr[j], s.colPtr[j+1]
+		for k := lo; k < hi; k++ {
+			v := d[j] * s.data[k]
+			row := raw.Data[s.rowIdx[k]*raw.Stride:]
+			// Row indices are sorted, so only the upper triangle is updated.
+			for l := k; l < hi; l++ {
+				row[s.rowIdx[l]] += v * s.data[l]
+			}
+		}
+	}
+}
@@ -0,0 +1,369 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package qp
+
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	defaultActiveSetTol = 1e-9
+
+	// kktCondTol is the largest condition number of the KKT matrix of a
+	// working set that is not treated as singular.
+	kktCondTol = 1e12
+)
+
+// ActiveSetSettings holds the settings for ActiveSet.
+type ActiveSetSettings struct {
+	// Tolerance is the relative tolerance on the step and on the signs of
+	// the Lagrange multipliers. If Tolerance is zero, it is defaulted to
+	// 1e-9.
+	Tolerance float64
+	// MaxIterations is the maximum number of iterations. If MaxIterations
+	// is zero, it is defaulted to 10*(n+m)+100, where n is the number of
+	// variables and m the number of inequality constraints.
+	MaxIterations int
+
+	// WarmStart is the result of a related problem, for example one that
+	// differs only in c, h or b. If WarmStart.X is feasible, it is used as
+	// the starting point instead of a point found by solving a linear
+	// program, and the constraints in WarmStart.Active that are active at
+	// the starting point form the initial working set.
+	WarmStart *Result
+}
+
+// ActiveSet solves the convex quadratic program
+//
+//	minimize	½ xᵀ Q x + cᵀ x
+//	s.t.		G x <= h
+//				A x = b
+//
+// using a primal active-set method. Starting from a feasible point, the
+// method solves a sequence of equality constrained subproblems in which a
+// working set of inequality constraints holds with equality. Constraints are
+// added to the working set when they block a step and removed when their
+// Lagrange multiplier is negative. The method finds the exact solution in a
+// finite number of iterations and is suited to dense problems with up to a
+// few hundred variables and constraints.
+//
+// If there are no constraints of the given type, the inputs may be nil. Q
+// must be positive semidefinite, otherwise ErrNonConvex is returned. If the
+// constraints are infeasible, ErrInfeasible is returned, and if the
+// objective function is unbounded below, ErrUnbounded is returned, in both
+// cases together with a Result holding a certificate. If settings is nil,
+// the zero value is used.
+//
+// ActiveSet will panic if the dimensions of the inputs do not match.
+func ActiveSet(q mat.Symmetric, c []float64, g mat.Matrix, h []float64, a mat.Matrix, b []float64, settings *ActiveSetSettings) (*Result, error) {
+	n, mIneq, _ := checkProblem(q, c, g, h, a, b)
+	if settings == nil {
+		settings = &ActiveSetSettings{}
+	}
+	tol := settings.Tolerance
+	if tol == 0 {
+		tol = defaultActiveSetTol
+	}
+	maxIter := settings.MaxIterations
+	if maxIter == 0 {
+		maxIter = 10*(n+mIneq) + 100
+	}
+	if tol < 0 || maxIter < 0 {
+		panic("qp: invalid settings")
+	}
+
+	if !positiveSemidefinite(q, tol) {
+		return nil, ErrNonConvex
+	}
+
+	as := &activeSet{
+		n:       n,
+		q:       q,
+		c:       c,
+		g:       g,
+		h:       h,
+		a:       a,
+		b:       b,
+		tol:     tol,
+		working: make([]bool, mIneq),
+	}
+	if g != nil {
+		// The rows of G are accessed directly.
+		as.g = mat.DenseCopyOf(g)
+	}
+	// Find the starting point and the initial working set.
+	scale := 1 + math.Max(floats.Norm(h, math.Inf(1)), floats.Norm(b, math.Inf(1)))
+	ws := settings.WarmStart
+	if ws != nil && len(ws.X) == n && maxViolation(ws.X, g, h, a, b) <= tol*scale {
+		as.x = append([]float64(nil), ws.X...)
+		for _, i := range ws.Active {
+			if i < 0 || i >= mIneq || as.working[i] {
+				continue
+			}
+			if math.Abs(as.constraint(i)-h[i]) <= tol*scale {
+				as.working[i] = true
+			}
+		}
+	} else {
+		x, cert, err := feasiblePoint(n, g, h, a, b)
+		if err != nil {
+			if err == ErrInfeasible {
+				return &Result{Certificate: cert}, err
+			}
+			return nil, err
+		}
+		as.x = x
+	}
+	return as.solve(maxIter)
+}
+
+// positiveSemidefinite returns whether the smallest eigenvalue of q is at
+// least -tol times its largest eigenvalue.
+func positiveSemidefinite(q mat.Symmetric, tol float64) bool {
+	var eig mat.EigenSym
+	if !eig.Factorize(q, false) {
+		return false
+	}
+	vals := eig.Values(nil)
+	if len(vals) == 0 {
+		return true
+	}
+	return vals[0] >= -tol*math.Max(1, math.Abs(vals[len(vals)-1]))
+}
+
+// activeSet holds the state of the primal active-set method.
+type activeSet struct {
+	n    int
+	q    mat.Symmetric
+	c    []float64
+	g    mat.Matrix
+	h    []float64
+	a    mat.Matrix
+	b    []float64
+	tol  float64
+	x    []float64
+	grad []float64
+
+	// working[i] is true if inequality constraint i is in the working set.
+	working []bool
+}
+
+// constraint returns G_i x.
+func (as *activeSet) constraint(i int) float64 {
+	return mat.Dot(as.g.(mat.RowViewer).RowView(i), mat.NewVecDense(as.n, as.x))
+}
+
+func (as *activeSet) solve(maxIter int) (*Result, error) {
+	n := as.n
+	as.grad = make([]float64, n)
+	gp := make([]float64, len(as.h))
+	for iter := 0; ; iter++ {
+		if iter == maxIter {
+			return as.result(iter, nil, nil), ErrIterationLimit
+		}
+		// Compute the gradient g = Q x + c.
+		gv := mat.NewVecDense(n, as.grad)
+		gv.MulVec(as.q, mat.NewVecDense(n, as.x))
+		floats.Add(as.grad, as.c)
+
+		rows := as.workingRows()
+		p, v, ray := as.step(rows)
+		if p == nil {
+			return nil, ErrSingular
+		}
+
+		if !ray && floats.Norm(p, math.Inf(1)) <= as.tol*(1+floats.Norm(as.x, math.Inf(1))) {
+			// The point is optimal for the working set. Remove the
+			// constraint with the most negative multiplier, if any.
+			k := -1
+			minZ := -as.tol * (1 + floats.Norm(as.grad, math.Inf(1)))
+			for l, i := range rows {
+				if i >= 0 && v[l] < minZ {
+					k = i
+					minZ = v[l]
+				}
+			}
+			if k == -1 {
+				return as.result(iter, rows, v), nil
+			}
+			as.working[k] = false
+			continue
+		}
+
+		// Ratio test for the constraints that are not in the working set.
+		alpha := math.Inf(1)
+		if !ray {
+			alpha = 1
+		}
+		block := -1
+		if len(as.h) > 0 {
+			pv := mat.NewVecDense(n, p)
+			gpv := mat.NewVecDense(len(gp), gp)
+			gpv.MulVec(as.g, pv)
+			pTol := as.tol * floats.Norm(p, math.Inf(1))
+			for i, gpi := range gp {
+				if as.working[i] || gpi <= pTol {
+					continue
+				}
+				slack := math.Max(as.h[i]-as.constraint(i), 0)
+				ratio := slack / gpi
+				if ratio < alpha || (ratio == alpha && block != -1 && gpi > gp[block]) {
+					alpha = ratio
+					block = i
+				}
+			}
+		}
+		if math.IsInf(alpha, 1) {
+			// The objective function decreases linearly along the ray.
+			floats.Scale(-1/floats.Dot(as.c, p), p)
+			return &Result{Iterations: iter, Certificate: p}, ErrUnbounded
+		}
+		floats.AddScaled(as.x, alpha, p)
+		if block != -1 {
+			as.working[block] = true
+		}
+	}
+}
+
+// workingRows returns the constraints of the working set. The equality
+// constraints are encoded as -1-i and the inequality constraints as i.
+func (as *activeSet) workingRows() []int {
+	rows := make([]int, 0, len(as.b))
+	for i := range as.b {
+		rows = append(rows, -1-i)
+	}
+	for i, w := range as.working {
+		if w {
+			rows = append(rows, i)
+		}
+	}
+	return rows
+}
+
+// step solves the equality constrained subproblem for the working set
+// given by rows. It returns the step p and the Lagrange multipliers v of
+// the working constraints. If the subproblem is unbounded, step returns a
+// descent direction p with zero curvature that satisfies the working
+// constraints, and ray is true. If no step can be computed, p is nil.
+func (as *activeSet) step(rows []int) (p, v []float64, ray bool) {
+	n := as.n
+	k := len(rows)
+	// The KKT system is
+	//  [Q Wᵀ] [p] = [-g]
+	//  [W 0 ] [v]   [ 0]
+	// where the rows of W are the working constraints.
+	kkt := mat.NewSymDense(n+k, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			kkt.SetSym(i, j, as.q.At(i, j))
+		}
+	}
+	for l, r := range rows {
+		for j := 0; j < n; j++ {
+			if r < 0 {
+				kkt.SetSym(j, n+l, as.a.At(-1-r, j))
+			} else {
+				kkt.SetSym(j, n+l, as.g.At(r, j))
+			}
+		}
+	}
+	rhs := make([]float64, n+k)
+	floats.ScaleTo(rhs[:n], -1, as.grad)
+	sol := make([]float64, n+k)
+
+	var lu mat.LU
+	lu.Factorize(kkt)
+	if lu.Cond() <= kktCondTol {
+		err := lu.SolveVecTo(mat.NewVecDense(n+k, sol), false, mat.NewVecDense(n+k, rhs))
+		if err == nil {
+			return sol[:n], sol[n:], false
+		}
+		if _, ok := err.(mat.Condition); ok {
+			return sol[:n], sol[n:], false
+		}
+	}
+
+	// The KKT matrix is singular. Compute the minimum norm least-squares
+	// solution from the eigendecomposition.
+	var eig mat.EigenSym
+	if !eig.Factorize(kkt, true) {
+		return nil, nil, false
+	}
+	vals := eig.Values(nil)
+	var vecs mat.Dense
+	eig.VectorsTo(&vecs)
+	maxAbs := math.Max(math.Abs(vals[0]), math.Abs(vals[len(vals)-1]))
+	thresh := 1e-12 * maxAbs
+	var null []int
+	for l, lambda := range vals {
+		col := mat.Col(nil, l, &vecs)
+		if math.Abs(lambda) <= thresh {
+			null = append(null, l)
+			continue
+		}
+		floats.AddScaled(sol, floats.Dot(col, rhs)/lambda, col)
+	}
+	// If the system is consistent, the least-squares solution solves it.
+	res := make([]float64, n+k)
+	mat.NewVecDense(n+k, res).MulVec(kkt, mat.NewVecDense(n+k, sol))
+	floats.Sub(res, rhs)
+	if floats.Norm(res, math.Inf(1)) <= as.tol*(1+floats.Norm(rhs, math.Inf(1))) {
+		return sol[:n], sol[n:], false
+	}
+
+	// Otherwise there is a null vector [p; v] of the KKT matrix with
+	// gᵀ p != 0. Since Q is positive semidefinite, Q p = 0 and the
+	// objective function is linear along p.
+	var best []float64
+	var bestDot float64
+	for _, l := range null {
+		col := mat.Col(nil, l, &vecs)
+		d := floats.Dot(as.grad, col[:n])
+		if math.Abs(d) > math.Abs(bestDot) {
+			best = col[:n]
+			bestDot = d
+		}
+	}
+	if best == nil || bestDot == 0 {
+		return nil, nil, false
+	}
+	if bestDot > 0 {
+		floats.Scale(-1, best)
+	}
+	return best, nil, true
+}
+
+// result returns the Result at the current point with the multipliers v of
+// the working constraints in rows.
+func (as *activeSet) result(iter int, rows []int, v []float64) *Result {
+	z := make([]float64, len(as.h))
+	y := make([]float64, len(as.b))
+	for l, r := range rows {
+		if r < 0 {
+			y[-1-r] = v[l]
+		} else {
+			z[r] = math.Max(v[l], 0)
+		}
+	}
+	var active []int
+	for i, w := range as.working {
+		if w {
+			active = append(active, i)
+		}
+	}
+	sort.Ints(active)
+	return &Result{
+		F:          objective(as.q, as.c, as.x),
+		X:          append([]float64(nil), as.x...),
+		Z:          z,
+		Y:          y,
+		Active:     active,
+		Iterations: iter,
+	}
+}
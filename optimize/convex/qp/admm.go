@@ -0,0 +1,505 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package qp
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	defaultADMMAbsTol     = 1e-6
+	defaultADMMRelTol     = 1e-6
+	defaultADMMInfeasTol  = 1e-6
+	defaultADMMIterations = 10000
+	defaultADMMRho        = 0.1
+	defaultADMMSigma      = 1e-6
+	defaultADMMAlpha      = 1.6
+
+	// admmEqRhoScale is the factor by which the penalty parameter of the
+	// equality constraints is larger than that of the inequality
+	// constraints.
+	admmEqRhoScale = 1e3
+	// admmRhoInterval is the number of iterations between updates of the
+	// penalty parameter, and admmRhoFactor is the smallest relative change
+	// of the penalty parameter that causes an update.
+	admmRhoInterval = 25
+	admmRhoFactor   = 5
+	admmRhoMin      = 1e-6
+	admmRhoMax      = 1e6
+)
+
+// ADMMSettings holds the settings for ADMM.
+type ADMMSettings struct {
+	// AbsTol and RelTol are the absolute and relative tolerances on the
+	// primal and dual residuals. If they are zero, they are defaulted to
+	// 1e-6.
+	AbsTol float64
+	RelTol float64
+	// InfeasibleTol is the tolerance for detecting infeasibility and
+	// unboundedness. If InfeasibleTol is zero, it is defaulted to 1e-6.
+	InfeasibleTol float64
+	// MaxIterations is the maximum number of iterations. If MaxIterations
+	// is zero, it is defaulted to 10000.
+	MaxIterations int
+
+	// Rho is the initial penalty parameter, which is adapted during the
+	// iterations to balance the primal and dual residuals. If Rho is zero,
+	// it is defaulted to 0.1.
+	Rho float64
+	// Sigma is the regularization of the linear system that keeps it
+	// positive definite. If Sigma is zero, it is defaulted to 1e-6.
+	Sigma float64
+	// Alpha is the relaxation parameter in (0, 2). If Alpha is zero, it is
+	// defaulted to 1.6.
+	Alpha float64
+
+	// WarmStart is the result of a related problem. If it is not nil, the
+	// iterations start from WarmStart.X and the multipliers WarmStart.Z and
+	// WarmStart.Y.
+	WarmStart *Result
+}
+
+// ADMM solves the convex quadratic program
+//
+//	minimize	½ xᵀ Q x + cᵀ x
+//	s.t.		G x <= h
+//				A x = b
+//
+// using the alternating direction method of multipliers as in the OSQP
+// solver. The constraints are written as l <= C x <= u with C = [G; A], and
+// each iteration solves a linear system with the matrix
+//
+//	Q + σI + Cᵀ R C ,
+//
+// where R is a diagonal matrix of penalty parameters. The matrix is factorized
+// once and refactorized only when the penalty parameters are adapted, so
+// iterations are cheap and the method scales to large problems, but it
+// converges to solutions of moderate accuracy.
+//
+// The method terminates when the primal residual C x - z and the dual
+// residual Q x + c + Cᵀ y are below the tolerances. Periodically and at
+// termination, the solution is polished by solving the KKT system for the
+// constraints that the iterates estimate to be active, which gives an exact
+// solution once the active constraints are identified. Infeasibility and
+// unboundedness are detected from the differences of successive iterates,
+// and ErrInfeasible or ErrUnbounded is returned together with a Result
+// holding a certificate.
+//
+// If there are no constraints of the given type, the inputs may be nil. Q
+// must be positive semidefinite. If the linear system is not positive
+// definite, ErrNonConvex is returned. If settings is nil, the zero value is
+// used.
+//
+// ADMM will panic if the dimensions of the inputs do not match or if the
+// settings are invalid.
+func ADMM(q mat.Symmetric, c []float64, g mat.Matrix, h []float64, a mat.Matrix, b []float64, settings *ADMMSettings) (*Result, error) {
+	n, mIneq, mEq := checkProblem(q, c, g, h, a, b)
+	if settings == nil {
+		settings = &ADMMSettings{}
+	}
+	s := admm{
+		absTol:    settings.AbsTol,
+		relTol:    settings.RelTol,
+		infeasTol: settings.InfeasibleTol,
+		maxIter:   settings.MaxIterations,
+		rho:       settings.Rho,
+		sigma:     settings.Sigma,
+		alpha:     settings.Alpha,
+	}
+	setDefault := func(v *float64, def float64) {
+		if *v == 0 {
+			*v = def
+		}
+	}
+	setDefault(&s.absTol, defaultADMMAbsTol)
+	setDefault(&s.relTol, defaultADMMRelTol)
+	setDefault(&s.infeasTol, defaultADMMInfeasTol)
+	setDefault(&s.rho, defaultADMMRho)
+	setDefault(&s.sigma, defaultADMMSigma)
+	setDefault(&s.alpha, defaultADMMAlpha)
+	if s.maxIter == 0 {
+		s.maxIter = defaultADMMIterations
+	}
+	if s.absTol < 0 || s.relTol < 0 || s.infeasTol <= 0 || s.maxIter < 0 || s.rho <= 0 || s.sigma <= 0 || s.alpha <= 0 || s.alpha >= 2 {
+		panic("qp: invalid settings")
+	}
+
+	m := mIneq + mEq
+	s.n, s.mIneq, s.m = n, mIneq, m
+	s.q, s.c = q, c
+	if m > 0 {
+		s.cmat = mat.NewDense(m, n, nil)
+		if mIneq > 0 {
+			s.cmat.Slice(0, mIneq, 0, n).(*mat.Dense).Copy(g)
+		}
+		if mEq > 0 {
+			s.cmat.Slice(mIneq, m, 0, n).(*mat.Dense).Copy(a)
+		}
+	}
+	s.lower = make([]float64, m)
+	s.upper = make([]float64, m)
+	for i := 0; i < mIneq; i++ {
+		s.lower[i] = math.Inf(-1)
+		s.upper[i] = h[i]
+	}
+	copy(s.lower[mIneq:], b)
+	copy(s.upper[mIneq:], b)
+
+	s.x = make([]float64, n)
+	s.z = make([]float64, m)
+	s.y = make([]float64, m)
+	if ws := settings.WarmStart; ws != nil {
+		if len(ws.X) == n {
+			copy(s.x, ws.X)
+		}
+		if len(ws.Z) == mIneq {
+			copy(s.y[:mIneq], ws.Z)
+		}
+		if len(ws.Y) == mEq {
+			copy(s.y[mIneq:], ws.Y)
+		}
+	}
+	if m > 0 {
+		s.mulC(s.z, s.x)
+		s.project(s.z)
+	}
+	return s.solve()
+}
+
+// admm holds the state of the ADMM iterations.
+type admm struct {
+	absTol, relTol, infeasTol float64
+	maxIter                   int
+	rho, sigma, alpha         float64
+
+	n, mIneq, m  int
+	q            mat.Symmetric
+	c            []float64
+	cmat         *mat.Dense
+	lower, upper []float64
+
+	// rhoVec holds the penalty parameters of the constraints and chol the
+	// factorization of Q + σI + Cᵀ R C.
+	rhoVec []float64
+	chol   mat.Cholesky
+
+	x, z, y []float64
+}
+
+// mulC stores C x into dst.
+func (s *admm) mulC(dst, x []float64) {
+	if s.m == 0 {
+		return
+	}
+	mat.NewVecDense(s.m, dst).MulVec(s.cmat, mat.NewVecDense(s.n, x))
+}
+
+// mulCT stores Cᵀ y into dst.
+func (s *admm) mulCT(dst, y []float64) {
+	if s.m == 0 {
+		for i := range dst {
+			dst[i] = 0
+		}
+		return
+	}
+	mat.NewVecDense(s.n, dst).MulVec(s.cmat.T(), mat.NewVecDense(s.m, y))
+}
+
+// project projects z onto the box [l, u].
+func (s *admm) project(z []float64) {
+	for i := range z {
+		z[i] = math.Min(math.Max(z[i], s.lower[i]), s.upper[i])
+	}
+}
+
+// factorize sets the penalty parameters and factorizes the linear system.
+func (s *admm) factorize() bool {
+	s.rhoVec = make([]float64, s.m)
+	for i := range s.rhoVec {
+		s.rhoVec[i] = s.rho
+		if i >= s.mIneq {
+			s.rhoVec[i] *= admmEqRhoScale
+		}
+	}
+	k := mat.NewSymDense(s.n, nil)
+	for i := 0; i < s.n; i++ {
+		for j := i; j < s.n; j++ {
+			k.SetSym(i, j, s.q.At(i, j))
+		}
+		k.SetSym(i, i, k.At(i, i)+s.sigma)
+	}
+	if s.m > 0 {
+		var cr mat.Dense
+		cr.Apply(func(i, j int, v float64) float64 { return s.rhoVec[i] * v }, s.cmat)
+		var ctrc mat.Dense
+		ctrc.Mul(s.cmat.T(), &cr)
+		for i := 0; i < s.n; i++ {
+			for j := i; j < s.n; j++ {
+				k.SetSym(i, j, k.At(i, j)+0.5*(ctrc.At(i, j)+ctrc.At(j, i)))
+			}
+		}
+	}
+	return s.chol.Factorize(k)
+}
+
+func (s *admm) solve() (*Result, error) {
+	n, m := s.n, s.m
+	if !s.factorize() {
+		return nil, ErrNonConvex
+	}
+	rhs := make([]float64, n)
+	xt := make([]float64, n)
+	zt := make([]float64, m)
+	zr := make([]float64, m)
+	tmp := make([]float64, m)
+	xPrev := make([]float64, n)
+	yPrev := make([]float64, m)
+	dx := make([]float64, n)
+	dy := make([]float64, m)
+	cx := make([]float64, m)
+	qx := make([]float64, n)
+	cty := make([]float64, n)
+	rDual := make([]float64, n)
+
+	for iter := 1; iter <= s.maxIter; iter++ {
+		copy(xPrev, s.x)
+		copy(yPrev, s.y)
+
+		// Solve (Q + σI + Cᵀ R C) x̃ = σx - c + Cᵀ (R z - y).
+		for i := range tmp {
+			tmp[i] = s.rhoVec[i]*s.z[i] - s.y[i]
+		}
+		s.mulCT(rhs, tmp)
+		floats.AddScaled(rhs, s.sigma, s.x)
+		floats.Sub(rhs, s.c)
+		err := s.chol.SolveVecTo(mat.NewVecDense(n, xt), mat.NewVecDense(n, rhs))
+		if err != nil {
+			return nil, ErrNonConvex
+		}
+		s.mulC(zt, xt)
+
+		// Relaxed updates of x, z and y.
+		for i := range s.x {
+			s.x[i] = s.alpha*xt[i] + (1-s.alpha)*s.x[i]
+		}
+		for i := range zr {
+			zr[i] = s.alpha*zt[i] + (1-s.alpha)*s.z[i]
+			s.z[i] = zr[i] + s.y[i]/s.rhoVec[i]
+		}
+		s.project(s.z)
+		for i := range s.y {
+			s.y[i] += s.rhoVec[i] * (zr[i] - s.z[i])
+		}
+
+		// Check the convergence.
+		s.mulC(cx, s.x)
+		mat.NewVecDense(n, qx).MulVec(s.q, mat.NewVecDense(n, s.x))
+		s.mulCT(cty, s.y)
+		var rPrim float64
+		for i := range cx {
+			rPrim = math.Max(rPrim, math.Abs(cx[i]-s.z[i]))
+		}
+		floats.AddTo(rDual, qx, s.c)
+		floats.Add(rDual, cty)
+		epsPrim := s.absTol + s.relTol*math.Max(floats.Norm(cx, math.Inf(1)), floats.Norm(s.z, math.Inf(1)))
+		epsDual := s.absTol + s.relTol*math.Max(math.Max(floats.Norm(qx, math.Inf(1)), floats.Norm(cty, math.Inf(1))), floats.Norm(s.c, math.Inf(1)))
+		if rPrim <= epsPrim && floats.Norm(rDual, math.Inf(1)) <= epsDual {
+			if res, ok := s.polish(iter); ok {
+				return res, nil
+			}
+			return s.result(iter), nil
+		}
+
+		// Check the certificates of infeasibility and unboundedness.
+		floats.SubTo(dy, s.y, yPrev)
+		if cert, ok := s.primalInfeasible(dy); ok {
+			return &Result{Iterations: iter, Certificate: cert}, ErrInfeasible
+		}
+		floats.SubTo(dx, s.x, xPrev)
+		if cert, ok := s.dualInfeasible(dx, tmp); ok {
+			return &Result{Iterations: iter, Certificate: cert}, ErrUnbounded
+		}
+
+		if iter%admmRhoInterval != 0 {
+			continue
+		}
+		// The iterates often identify the active constraints long before
+		// they converge, and then the polished solution is exact.
+		if res, ok := s.polish(iter); ok {
+			return res, nil
+		}
+		// Adapt the penalty parameter to balance the residuals.
+		if m > 0 {
+			primScale := math.Max(floats.Norm(cx, math.Inf(1)), floats.Norm(s.z, math.Inf(1)))
+			dualScale := math.Max(math.Max(floats.Norm(qx, math.Inf(1)), floats.Norm(cty, math.Inf(1))), floats.Norm(s.c, math.Inf(1)))
+			ratio := (rPrim / math.Max(primScale, 1e-300)) / math.Max(floats.Norm(rDual, math.Inf(1))/math.Max(dualScale, 1e-300), 1e-300)
+			rho := math.Min(math.Max(s.rho*math.Sqrt(ratio), admmRhoMin), admmRhoMax)
+			if rho > admmRhoFactor*s.rho || rho < s.rho/admmRhoFactor {
+				s.rho = rho
+				if !s.factorize() {
+					return nil, ErrNonConvex
+				}
+			}
+		}
+	}
+	return s.result(s.maxIter), ErrIterationLimit
+}
+
+// primalInfeasible returns whether dy is a certificate of primal
+// infeasibility, and if so the scaled certificate.
+func (s *admm) primalInfeasible(dy []float64) ([]float64, bool) {
+	if s.m == 0 {
+		return nil, false
+	}
+	// Only the non-negative part of the multipliers of the inequality
+	// constraints, whose lower bounds are infinite, is meaningful.
+	cert := make([]float64, s.m)
+	copy(cert, dy)
+	for i := 0; i < s.mIneq; i++ {
+		cert[i] = math.Max(cert[i], 0)
+	}
+	norm := floats.Norm(cert, math.Inf(1))
+	if norm == 0 {
+		return nil, false
+	}
+	ct := make([]float64, s.n)
+	s.mulCT(ct, cert)
+	if floats.Norm(ct, math.Inf(1)) > s.infeasTol*norm {
+		return nil, false
+	}
+	// hᵀ z + bᵀ y = uᵀ y.
+	bound := floats.Dot(s.upper, cert)
+	if bound >= -s.infeasTol*norm {
+		return nil, false
+	}
+	floats.Scale(-1/bound, cert)
+	return cert, true
+}
+
+// dualInfeasible returns whether dx is a certificate of dual infeasibility,
+// and if so the scaled certificate. The slice tmp is used as workspace.
+func (s *admm) dualInfeasible(dx, tmp []float64) ([]float64, bool) {
+	norm := floats.Norm(dx, math.Inf(1))
+	if norm == 0 {
+		return nil, false
+	}
+	eps := s.infeasTol * norm
+	cd := floats.Dot(s.c, dx)
+	if cd >= -eps {
+		return nil, false
+	}
+	qd := make([]float64, s.n)
+	mat.NewVecDense(s.n, qd).MulVec(s.q, mat.NewVecDense(s.n, dx))
+	if floats.Norm(qd, math.Inf(1)) > eps {
+		return nil, false
+	}
+	s.mulC(tmp, dx)
+	for i, v := range tmp {
+		if v > eps || (i >= s.mIneq && v < -eps) {
+			return nil, false
+		}
+	}
+	cert := make([]float64, s.n)
+	floats.ScaleTo(cert, -1/cd, dx)
+	return cert, true
+}
+
+// polish solves the equality constrained problem in which the constraints
+// estimated to be active by the current iterate hold with equality. It
+// returns the Result for the solution and whether it satisfies the
+// termination criteria and the sign conditions of the multipliers.
+func (s *admm) polish(iter int) (*Result, bool) {
+	n := s.n
+	// An inequality constraint is estimated to be active if its multiplier
+	// is larger than its slack.
+	var rows []int
+	for i := 0; i < s.m; i++ {
+		if i >= s.mIneq || s.upper[i]-s.z[i] < s.y[i] {
+			rows = append(rows, i)
+		}
+	}
+	k := len(rows)
+	// Solve the KKT system
+	//  [Q Wᵀ] [x] = [-c]
+	//  [W 0 ] [v]   [ w]
+	// where W and w are the rows of C and u of the active constraints.
+	kkt := mat.NewSymDense(n+k, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			kkt.SetSym(i, j, s.q.At(i, j))
+		}
+	}
+	rhs := make([]float64, n+k)
+	floats.ScaleTo(rhs[:n], -1, s.c)
+	for l, r := range rows {
+		for j := 0; j < n; j++ {
+			kkt.SetSym(j, n+l, s.cmat.At(r, j))
+		}
+		rhs[n+l] = s.upper[r]
+	}
+	var lu mat.LU
+	lu.Factorize(kkt)
+	if lu.Cond() > kktCondTol {
+		return nil, false
+	}
+	sol := make([]float64, n+k)
+	if err := lu.SolveVecTo(mat.NewVecDense(n+k, sol), false, mat.NewVecDense(n+k, rhs)); err != nil {
+		if _, ok := err.(mat.Condition); !ok {
+			return nil, false
+		}
+	}
+	x := sol[:n]
+	y := make([]float64, s.m)
+	for l, r := range rows {
+		y[r] = sol[n+l]
+	}
+
+	// Check the feasibility and the signs of the multipliers.
+	cx := make([]float64, s.m)
+	s.mulC(cx, x)
+	epsPrim := s.absTol + s.relTol*floats.Norm(cx, math.Inf(1))
+	for i, v := range cx {
+		if v > s.upper[i]+epsPrim || v < s.lower[i]-epsPrim {
+			return nil, false
+		}
+	}
+	cty := make([]float64, n)
+	s.mulCT(cty, y)
+	epsDual := s.absTol + s.relTol*math.Max(floats.Norm(cty, math.Inf(1)), floats.Norm(s.c, math.Inf(1)))
+	for i := 0; i < s.mIneq; i++ {
+		if y[i] < -epsDual {
+			return nil, false
+		}
+		y[i] = math.Max(y[i], 0)
+	}
+	copy(s.x, x)
+	copy(s.y, y)
+	copy(s.z, cx)
+	s.project(s.z)
+	return s.result(iter), true
+}
+
+// result returns the Result for the current iterate.
+func (s *admm) result(iter int) *Result {
+	z := make([]float64, s.mIneq)
+	var active []int
+	for i := range z {
+		if s.y[i] > 0 {
+			z[i] = s.y[i]
+			active = append(active, i)
+		}
+	}
+	return &Result{
+		F:          objective(s.q, s.c, s.x),
+		X:          append([]float64(nil), s.x...),
+		Z:          z,
+		Y:          append([]float64(nil), s.y[s.mIneq:]...),
+		Active:     active,
+		Iterations: iter,
+	}
+}
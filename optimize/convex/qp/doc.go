@@ -0,0 +1,18 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package qp implements routines to solve convex quadratic programming
+// problems
+//
+//	minimize	½ xᵀ Q x + cᵀ x
+//	s.t.		G x <= h
+//				A x = b ,
+//
+// where Q is a symmetric positive semidefinite matrix.
+//
+// ActiveSet is a primal active-set method suited to small and medium dense
+// problems. ADMM is an operator splitting method in the style of OSQP that
+// scales to larger problems and computes solutions of moderate accuracy.
+// Both solvers can be warm started from the Result of a related problem.
+package qp // import "gonum.org/v1/gonum/optimize/convex/qp"
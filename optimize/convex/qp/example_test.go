@@ -0,0 +1,52 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package qp_test
+
+import (
+	"fmt"
+	"log"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize/convex/qp"
+)
+
+func ExampleActiveSet() {
+	// Find the portfolio of three assets with the smallest variance of the
+	// return that has an expected return of at least 10%. The weights are
+	// non-negative and sum to one.
+	cov := mat.NewSymDense(3, []float64{
+		0.04, 0.006, 0.002,
+		0.006, 0.09, 0.009,
+		0.002, 0.009, 0.16,
+	})
+	ret := []float64{0.06, 0.12, 0.16}
+
+	// The variance is wᵀ Σ w = ½ wᵀ (2Σ) w.
+	var q mat.SymDense
+	q.ScaleSym(2, cov)
+	c := make([]float64, 3)
+	g := mat.NewDense(4, 3, []float64{
+		-ret[0], -ret[1], -ret[2],
+		-1, 0, 0,
+		0, -1, 0,
+		0, 0, -1,
+	})
+	h := []float64{-0.1, 0, 0, 0}
+	a := mat.NewDense(1, 3, []float64{1, 1, 1})
+	b := []float64{1}
+
+	res, err := qp.ActiveSet(&q, c, g, h, a, b, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("variance: %.5f\n", res.F)
+	fmt.Printf("weights: %.4f\n", res.X)
+	fmt.Printf("active constraints: %v\n", res.Active)
+
+	// Output:
+	// variance: 0.02840
+	// weights: [0.4799 0.3002 0.2199]
+	// active constraints: [0]
+}
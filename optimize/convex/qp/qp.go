@@ -0,0 +1,239 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package qp
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize/convex/lp"
+)
+
+var (
+	// ErrInfeasible is returned when the constraints cannot be satisfied.
+	ErrInfeasible = errors.New("qp: problem is infeasible")
+	// ErrUnbounded is returned when the objective function is unbounded
+	// below on the feasible set.
+	ErrUnbounded = errors.New("qp: problem is unbounded")
+	// ErrNonConvex is returned when Q is found not to be positive
+	// semidefinite.
+	ErrNonConvex = errors.New("qp: problem is not convex")
+	// ErrSingular is returned when the equality constraints are linearly
+	// dependent.
+	ErrSingular = errors.New("qp: singular constraints")
+	// ErrIterationLimit is returned when a solver does not converge within
+	// the maximum number of iterations.
+	ErrIterationLimit = errors.New("qp: iteration limit reached")
+)
+
+const badShape = "qp: size mismatch"
+
+// Result holds the solution of a quadratic program
+//
+//	minimize	½ xᵀ Q x + cᵀ x
+//	s.t.		G x <= h
+//				A x = b .
+type Result struct {
+	// F is the optimal value of the objective function.
+	F float64
+	// X is the optimal solution.
+	X []float64
+
+	// Z holds the Lagrange multipliers of the inequality constraints and Y
+	// those of the equality constraints. At the solution z >= 0 and
+	//  Q x + c + Gᵀ z + Aᵀ y = 0 .
+	Z []float64
+	Y []float64
+
+	// Active holds the indices of the inequality constraints that are
+	// active at the solution.
+	Active []int
+
+	// Iterations is the number of iterations of the method.
+	Iterations int
+
+	// Certificate is a certificate of infeasibility or unboundedness and it
+	// is only set when the solver returns ErrInfeasible or ErrUnbounded.
+	//
+	// If the error is ErrInfeasible, Certificate holds the vector [z; y] with
+	// z >= 0, Gᵀ z + Aᵀ y = 0 and hᵀ z + bᵀ y < 0, which shows that there is
+	// no x with G x <= h and A x = b.
+	//
+	// If the error is ErrUnbounded, Certificate is a direction d with
+	// Q d = 0, G d <= 0, A d = 0 and cᵀ d < 0, along which the objective
+	// function decreases without bound from any feasible point.
+	Certificate []float64
+}
+
+// checkProblem panics if the dimensions of the quadratic program do not
+// match. It returns the number of variables, of inequality constraints and of
+// equality constraints.
+func checkProblem(q mat.Symmetric, c []float64, g mat.Matrix, h []float64, a mat.Matrix, b []float64) (n, mIneq, mEq int) {
+	n = len(c)
+	if q.SymmetricDim() != n {
+		panic(badShape)
+	}
+	if g == nil {
+		if len(h) != 0 {
+			panic(badShape)
+		}
+	} else {
+		r, cols := g.Dims()
+		if r != len(h) || cols != n {
+			panic(badShape)
+		}
+	}
+	if a == nil {
+		if len(b) != 0 {
+			panic(badShape)
+		}
+	} else {
+		r, cols := a.Dims()
+		if r != len(b) || cols != n {
+			panic(badShape)
+		}
+	}
+	return n, len(h), len(b)
+}
+
+// objective returns ½ xᵀ Q x + cᵀ x.
+func objective(q mat.Symmetric, c, x []float64) float64 {
+	xv := mat.NewVecDense(len(x), x)
+	return 0.5*mat.Inner(xv, q, xv) + floats.Dot(c, x)
+}
+
+// feasiblePoint returns a point that satisfies G x <= h and A x = b by
+// solving a linear program with zero cost. Variables that do not appear in
+// the constraints are set to zero. If the constraints are infeasible,
+// feasiblePoint returns ErrInfeasible and a certificate of infeasibility as
+// described in Result.
+func feasiblePoint(n int, g mat.Matrix, h []float64, a mat.Matrix, b []float64) (x, cert []float64, err error) {
+	mIneq, mEq := len(h), len(b)
+	used := make([]bool, n)
+	var cols []int
+	isZeroRow := func(m mat.Matrix, i int) bool {
+		zero := true
+		for j := 0; j < n; j++ {
+			if m.At(i, j) != 0 {
+				zero = false
+				used[j] = true
+			}
+		}
+		return zero
+	}
+	var ineq, eq []int
+	for i := 0; i < mIneq; i++ {
+		if isZeroRow(g, i) {
+			if h[i] < 0 {
+				cert = make([]float64, mIneq+mEq)
+				cert[i] = -1 / h[i]
+				return nil, cert, ErrInfeasible
+			}
+			continue
+		}
+		ineq = append(ineq, i)
+	}
+	for i := 0; i < mEq; i++ {
+		if isZeroRow(a, i) {
+			if b[i] != 0 {
+				cert = make([]float64, mIneq+mEq)
+				cert[mIneq+i] = -1 / b[i]
+				return nil, cert, ErrInfeasible
+			}
+			continue
+		}
+		eq = append(eq, i)
+	}
+	for j, u := range used {
+		if u {
+			cols = append(cols, j)
+		}
+	}
+	x = make([]float64, n)
+	if len(ineq)+len(eq) == 0 {
+		return x, nil, nil
+	}
+
+	// The standard form LP has the variables [xp; xn; s] with x = xp - xn
+	// and the slack variables s of the inequality constraints.
+	nc := len(cols)
+	nStd := 2*nc + len(ineq)
+	aStd := mat.NewDense(len(ineq)+len(eq), nStd, nil)
+	bStd := make([]float64, len(ineq)+len(eq))
+	for k, i := range ineq {
+		for l, j := range cols {
+			v := g.At(i, j)
+			aStd.Set(k, l, v)
+			aStd.Set(k, nc+l, -v)
+		}
+		aStd.Set(k, 2*nc+k, 1)
+		bStd[k] = h[i]
+	}
+	for k, i := range eq {
+		r := len(ineq) + k
+		for l, j := range cols {
+			v := a.At(i, j)
+			aStd.Set(r, l, v)
+			aStd.Set(r, nc+l, -v)
+		}
+		bStd[r] = b[i]
+	}
+	cStd := make([]float64, nStd)
+	_, xStd, err := lp.Simplex(cStd, aStd, bStd, phaseOneTol, nil)
+	if err != nil {
+		// The simplex method does not compute a certificate of
+		// infeasibility and fails on rank deficient constraints, but the
+		// interior-point method handles both.
+		var res *lp.Result
+		res, err = lp.InteriorPoint(cStd, aStd, bStd, nil)
+		switch err {
+		case nil:
+			xStd = res.X
+		case lp.ErrInfeasible:
+			// A certificate y of the standard form LP has y_G <= 0, so
+			// z = -y_G and y = -y_A.
+			cert = make([]float64, mIneq+mEq)
+			for k, i := range ineq {
+				cert[i] = math.Max(-res.Certificate[k], 0)
+			}
+			for k, i := range eq {
+				cert[mIneq+i] = -res.Certificate[len(ineq)+k]
+			}
+			return nil, cert, ErrInfeasible
+		default:
+			return nil, nil, ErrSingular
+		}
+	}
+	for l, j := range cols {
+		x[j] = xStd[l] - xStd[nc+l]
+	}
+	return x, nil, nil
+}
+
+// phaseOneTol is the tolerance used for finding a feasible point.
+const phaseOneTol = 1e-10
+
+// maxViolation returns the largest violation of the constraints at x.
+func maxViolation(x []float64, g mat.Matrix, h []float64, a mat.Matrix, b []float64) float64 {
+	var viol float64
+	xv := mat.NewVecDense(len(x), x)
+	var tmp mat.VecDense
+	if len(h) > 0 {
+		tmp.MulVec(g, xv)
+		for i, v := range h {
+			viol = math.Max(viol, tmp.AtVec(i)-v)
+		}
+	}
+	if len(b) > 0 {
+		tmp.Reset()
+		tmp.MulVec(a, xv)
+		for i, v := range b {
+			viol = math.Max(viol, math.Abs(tmp.AtVec(i)-v))
+		}
+	}
+	return viol
+}
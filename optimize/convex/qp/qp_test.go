@@ -0,0 +1,400 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package qp
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// solver is the common signature of ActiveSet and ADMM with default
+// settings and an optional warm start.
+type solver struct {
+	name  string
+	solve func(q mat.Symmetric, c []float64, g mat.Matrix, h []float64, a mat.Matrix, b []float64, warm *Result) (*Result, error)
+	// tol is the accuracy of the solution.
+	tol float64
+}
+
+var solvers = []solver{
+	{
+		name: "ActiveSet",
+		solve: func(q mat.Symmetric, c []float64, g mat.Matrix, h []float64, a mat.Matrix, b []float64, warm *Result) (*Result, error) {
+			return ActiveSet(q, c, g, h, a, b, &ActiveSetSettings{WarmStart: warm})
+		},
+		tol: 1e-8,
+	},
+	{
+		name: "ADMM",
+		solve: func(q mat.Symmetric, c []float64, g mat.Matrix, h []float64, a mat.Matrix, b []float64, warm *Result) (*Result, error) {
+			return ADMM(q, c, g, h, a, b, &ADMMSettings{WarmStart: warm})
+		},
+		tol: 1e-4,
+	},
+}
+
+type qpTest struct {
+	name    string
+	q       *mat.SymDense
+	c       []float64
+	g       *mat.Dense
+	h       []float64
+	a       *mat.Dense
+	b       []float64
+	wantErr error
+	wantX   []float64
+}
+
+// matrices returns the constraint matrices of test as mat.Matrix values that
+// are nil if there are no constraints.
+func (test qpTest) matrices() (g, a mat.Matrix) {
+	if test.g != nil {
+		g = test.g
+	}
+	if test.a != nil {
+		a = test.a
+	}
+	return g, a
+}
+
+var qpTests = []qpTest{
+	{
+		// Example 16.4 of Nocedal and Wright.
+		name: "NocedalWright",
+		q:    mat.NewSymDense(2, []float64{2, 0, 0, 2}),
+		c:    []float64{-2, -5},
+		g: mat.NewDense(5, 2, []float64{
+			-1, 2,
+			1, 2,
+			1, -2,
+			-1, 0,
+			0, -1,
+		}),
+		h:     []float64{2, 6, 2, 0, 0},
+		wantX: []float64{1.4, 1.7},
+	},
+	{
+		name:  "Equality",
+		q:     mat.NewSymDense(3, []float64{1, 0, 0, 0, 1, 0, 0, 0, 1}),
+		c:     []float64{0, 0, 0},
+		a:     mat.NewDense(1, 3, []float64{1, 1, 1}),
+		b:     []float64{1},
+		wantX: []float64{1.0 / 3, 1.0 / 3, 1.0 / 3},
+	},
+	{
+		// The objective function is linear in x2, which is bounded by the
+		// constraints.
+		name: "Semidefinite",
+		q:    mat.NewSymDense(2, []float64{1, 0, 0, 0}),
+		c:    []float64{-1, -1},
+		g: mat.NewDense(2, 2, []float64{
+			1, 1,
+			0, -1,
+		}),
+		h:     []float64{3, 0},
+		wantX: []float64{0, 3},
+	},
+	{
+		name:  "Unconstrained",
+		q:     mat.NewSymDense(2, []float64{2, 1, 1, 2}),
+		c:     []float64{-1, -1},
+		wantX: []float64{1.0 / 3, 1.0 / 3},
+	},
+	{
+		// x <= -1 and x >= 1.
+		name:    "Infeasible",
+		q:       mat.NewSymDense(1, []float64{1}),
+		c:       []float64{0},
+		g:       mat.NewDense(2, 1, []float64{1, -1}),
+		h:       []float64{-1, -1},
+		wantErr: ErrInfeasible,
+	},
+	{
+		// x1 + x2 = 1 and x1 + x2 = 2.
+		name:    "InfeasibleEquality",
+		q:       mat.NewSymDense(2, []float64{1, 0, 0, 1}),
+		c:       []float64{0, 0},
+		a:       mat.NewDense(2, 2, []float64{1, 1, 1, 1}),
+		b:       []float64{1, 2},
+		wantErr: ErrInfeasible,
+	},
+	{
+		// The objective function decreases along x2 >= 0.
+		name:    "Unbounded",
+		q:       mat.NewSymDense(2, []float64{1, 0, 0, 0}),
+		c:       []float64{0, -1},
+		g:       mat.NewDense(1, 2, []float64{0, -1}),
+		h:       []float64{0},
+		wantErr: ErrUnbounded,
+	},
+	{
+		name:    "NonConvex",
+		q:       mat.NewSymDense(2, []float64{1, 0, 0, -1}),
+		c:       []float64{0, 0},
+		wantErr: ErrNonConvex,
+	},
+}
+
+func TestSolvers(t *testing.T) {
+	t.Parallel()
+	for _, s := range solvers {
+		for _, test := range qpTests {
+			name := s.name + " " + test.name
+			g, a := test.matrices()
+			res, err := s.solve(test.q, test.c, g, test.h, a, test.b, nil)
+			if err != test.wantErr {
+				t.Errorf("%s: unexpected error: got %v, want %v", name, err, test.wantErr)
+				continue
+			}
+			if err != nil {
+				if err != ErrNonConvex {
+					checkCertificate(t, name, test, res, err)
+				}
+				continue
+			}
+			checkKKT(t, name, test, res, s.tol)
+			if !floats.EqualApprox(res.X, test.wantX, s.tol) {
+				t.Errorf("%s: unexpected solution: got %v, want %v", name, res.X, test.wantX)
+			}
+		}
+	}
+}
+
+func TestSolversRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		test := randomQP(rnd)
+		g, a := test.matrices()
+		want, err := ActiveSet(test.q, test.c, g, test.h, a, test.b, nil)
+		if err != nil {
+			t.Errorf("case %d: unexpected error: %v", i, err)
+			continue
+		}
+		checkKKT(t, fmt.Sprintf("ActiveSet case %d", i), test, want, 1e-8)
+
+		got, err := ADMM(test.q, test.c, g, test.h, a, test.b, nil)
+		if err != nil {
+			t.Errorf("case %d: unexpected ADMM error: %v", i, err)
+			continue
+		}
+		checkKKT(t, fmt.Sprintf("ADMM case %d", i), test, got, 1e-4)
+		if math.Abs(got.F-want.F) > 1e-4*(1+math.Abs(want.F)) {
+			t.Errorf("case %d: optimal value mismatch: ADMM %v, ActiveSet %v", i, got.F, want.F)
+		}
+	}
+}
+
+func TestWarmStart(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, s := range solvers {
+		var cold, warm int
+		for i := 0; i < 20; i++ {
+			test := randomQP(rnd)
+			g, a := test.matrices()
+			res, err := s.solve(test.q, test.c, g, test.h, a, test.b, nil)
+			if err != nil {
+				t.Fatalf("%s case %d: unexpected error: %v", s.name, i, err)
+			}
+			// Perturb the linear term slightly and solve again.
+			for j := range test.c {
+				test.c[j] += 1e-3 * rnd.NormFloat64()
+			}
+			resCold, err := s.solve(test.q, test.c, g, test.h, a, test.b, nil)
+			if err != nil {
+				t.Fatalf("%s case %d: unexpected error: %v", s.name, i, err)
+			}
+			resWarm, err := s.solve(test.q, test.c, g, test.h, a, test.b, res)
+			if err != nil {
+				t.Fatalf("%s case %d: unexpected error: %v", s.name, i, err)
+			}
+			checkKKT(t, fmt.Sprintf("%s warm case %d", s.name, i), test, resWarm, s.tol)
+			if math.Abs(resWarm.F-resCold.F) > s.tol*(1+math.Abs(resCold.F)) {
+				t.Errorf("%s case %d: optimal value mismatch: warm %v, cold %v", s.name, i, resWarm.F, resCold.F)
+			}
+			cold += resCold.Iterations
+			warm += resWarm.Iterations
+		}
+		if warm >= cold {
+			t.Errorf("%s: warm start did not reduce the iterations: warm %d, cold %d", s.name, warm, cold)
+		}
+	}
+}
+
+// randomQP returns a random feasible and bounded convex quadratic program.
+func randomQP(rnd *rand.Rand) qpTest {
+	n := rnd.Intn(8) + 2
+	mIneq := rnd.Intn(2 * n)
+	mEq := rnd.Intn(n)
+	// Q is a random positive semidefinite matrix of random rank.
+	rank := rnd.Intn(n + 1)
+	q := mat.NewSymDense(n, nil)
+	if rank > 0 {
+		l := mat.NewDense(n, rank, nil)
+		for i := 0; i < n; i++ {
+			for j := 0; j < rank; j++ {
+				l.Set(i, j, rnd.NormFloat64())
+			}
+		}
+		q.SymOuterK(1, l)
+	}
+	c := make([]float64, n)
+	for i := range c {
+		c[i] = rnd.NormFloat64()
+	}
+	// The constraints are satisfied at x0, and the box constraints
+	// -5 <= x <= 5 keep the problem bounded.
+	x0 := make([]float64, n)
+	for i := range x0 {
+		x0[i] = rnd.NormFloat64()
+	}
+	g := mat.NewDense(mIneq+2*n, n, nil)
+	h := make([]float64, mIneq+2*n)
+	for i := 0; i < mIneq; i++ {
+		for j := 0; j < n; j++ {
+			g.Set(i, j, rnd.NormFloat64())
+		}
+		h[i] = mat.Dot(g.RowView(i), mat.NewVecDense(n, x0)) + rnd.ExpFloat64()
+	}
+	for j := 0; j < n; j++ {
+		g.Set(mIneq+2*j, j, 1)
+		h[mIneq+2*j] = 5
+		g.Set(mIneq+2*j+1, j, -1)
+		h[mIneq+2*j+1] = 5
+	}
+	test := qpTest{q: q, c: c, g: g, h: h}
+	if mEq > 0 {
+		a := mat.NewDense(mEq, n, nil)
+		b := make([]float64, mEq)
+		for i := 0; i < mEq; i++ {
+			for j := 0; j < n; j++ {
+				a.Set(i, j, rnd.NormFloat64())
+			}
+			b[i] = mat.Dot(a.RowView(i), mat.NewVecDense(n, x0))
+		}
+		test.a, test.b = a, b
+	}
+	return test
+}
+
+// checkKKT checks the optimality conditions of the solution in res.
+func checkKKT(t *testing.T, name string, test qpTest, res *Result, tol float64) {
+	t.Helper()
+	g, a := test.matrices()
+	n := len(test.c)
+	x := mat.NewVecDense(n, res.X)
+	scale := 1 + floats.Norm(res.X, math.Inf(1))
+	if v := maxViolation(res.X, g, test.h, a, test.b); v > tol*scale {
+		t.Errorf("%s: solution infeasible: violation %v", name, v)
+	}
+	// Stationarity Q x + c + Gᵀ z + Aᵀ y = 0.
+	var r mat.VecDense
+	r.MulVec(test.q, x)
+	r.AddVec(&r, mat.NewVecDense(n, test.c))
+	var tmp mat.VecDense
+	if g != nil {
+		tmp.MulVec(g.T(), mat.NewVecDense(len(res.Z), res.Z))
+		r.AddVec(&r, &tmp)
+	}
+	if a != nil {
+		tmp.Reset()
+		tmp.MulVec(a.T(), mat.NewVecDense(len(res.Y), res.Y))
+		r.AddVec(&r, &tmp)
+	}
+	zScale := 1 + floats.Norm(res.Z, math.Inf(1)) + floats.Norm(res.Y, math.Inf(1))
+	if norm := mat.Norm(&r, math.Inf(1)); norm > tol*zScale*scale {
+		t.Errorf("%s: stationarity violated: %v", name, norm)
+	}
+	// Complementarity zᵢ (hᵢ - Gᵢ x) = 0 with z >= 0.
+	if g != nil {
+		tmp.Reset()
+		tmp.MulVec(g, x)
+		for i, z := range res.Z {
+			if z < 0 {
+				t.Errorf("%s: negative multiplier %v", name, z)
+			}
+			if s := test.h[i] - tmp.AtVec(i); z*s > tol*zScale*scale {
+				t.Errorf("%s: complementarity violated for constraint %d: %v", name, i, z*s)
+			}
+		}
+	}
+	if f := objective(test.q, test.c, res.X); math.Abs(f-res.F) > 1e-12*(1+math.Abs(f)) {
+		t.Errorf("%s: objective value does not match the solution", name)
+	}
+}
+
+// checkCertificate checks the certificate of infeasibility or unboundedness
+// in res.
+func checkCertificate(t *testing.T, name string, test qpTest, res *Result, err error) {
+	t.Helper()
+	const tol = 1e-6
+	g, a := test.matrices()
+	n := len(test.c)
+	mIneq := len(test.h)
+	cert := res.Certificate
+	switch err {
+	case ErrInfeasible:
+		if len(cert) != mIneq+len(test.b) {
+			t.Errorf("%s: certificate has wrong length", name)
+			return
+		}
+		z, y := cert[:mIneq], cert[mIneq:]
+		r := mat.NewVecDense(n, nil)
+		var tmp mat.VecDense
+		if g != nil {
+			tmp.MulVec(g.T(), mat.NewVecDense(mIneq, z))
+			r.AddVec(r, &tmp)
+		}
+		if a != nil {
+			tmp.Reset()
+			tmp.MulVec(a.T(), mat.NewVecDense(len(y), y))
+			r.AddVec(r, &tmp)
+		}
+		if norm := mat.Norm(r, math.Inf(1)); norm > tol {
+			t.Errorf("%s: invalid infeasibility certificate: |Gᵀ z + Aᵀ y| = %v", name, norm)
+		}
+		if len(z) > 0 && floats.Min(z) < 0 {
+			t.Errorf("%s: invalid infeasibility certificate: negative z", name)
+		}
+		if v := floats.Dot(test.h, z) + floats.Dot(test.b, y); v >= 0 {
+			t.Errorf("%s: invalid infeasibility certificate: hᵀ z + bᵀ y = %v", name, v)
+		}
+	case ErrUnbounded:
+		if len(cert) != n {
+			t.Errorf("%s: certificate has wrong length", name)
+			return
+		}
+		d := mat.NewVecDense(n, cert)
+		var tmp mat.VecDense
+		tmp.MulVec(test.q, d)
+		if norm := mat.Norm(&tmp, math.Inf(1)); norm > tol {
+			t.Errorf("%s: invalid unboundedness certificate: |Q d| = %v", name, norm)
+		}
+		if g != nil {
+			tmp.Reset()
+			tmp.MulVec(g, d)
+			if max := mat.Max(&tmp); max > tol {
+				t.Errorf("%s: invalid unboundedness certificate: max(G d) = %v", name, max)
+			}
+		}
+		if a != nil {
+			tmp.Reset()
+			tmp.MulVec(a, d)
+			if norm := mat.Norm(&tmp, math.Inf(1)); norm > tol {
+				t.Errorf("%s: invalid unboundedness certificate: |A d| = %v", name, norm)
+			}
+		}
+		if cd := floats.Dot(test.c, cert); cd >= 0 {
+			t.Errorf("%s: invalid unboundedness certificate: cᵀ d = %v", name, cd)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+// dfoEvaluator communicates with Minimize on behalf of the model-based
+// derivative-free methods. These methods are naturally written as
+// sequential algorithms that evaluate the objective function at a few
+// locations at a time, and dfoEvaluator turns such evaluations into tasks.
+type dfoEvaluator struct {
+	operation chan<- Task
+	result    <-chan Task
+
+	// free holds the tasks that are not in use by Minimize.
+	free []Task
+	// stopped is set when Minimize has sent PostIteration.
+	stopped bool
+
+	// lastX and lastF are the location last sent in a MajorIteration.
+	lastX []float64
+	lastF float64
+}
+
+func newDFOEvaluator(operation chan<- Task, result <-chan Task, tasks []Task) *dfoEvaluator {
+	return &dfoEvaluator{
+		operation: operation,
+		result:    result,
+		free:      append([]Task(nil), tasks...),
+		lastX:     make([]float64, len(tasks[0].X)),
+		lastF:     math.NaN(),
+	}
+}
+
+// pop removes a task from the free tasks and returns it.
+func (e *dfoEvaluator) pop() Task {
+	task := e.free[len(e.free)-1]
+	e.free = e.free[:len(e.free)-1]
+	return task
+}
+
+// evaluate evaluates the objective function at the locations xs and stores
+// the values into fs. At most as many locations as there are tasks are
+// evaluated concurrently. evaluate returns false if the optimization has been
+// stopped by Minimize, in which case the values in fs are not valid.
+func (e *dfoEvaluator) evaluate(xs [][]float64, fs []float64) bool {
+	if e.stopped {
+		return false
+	}
+	var sent, received int
+	for ; sent < len(xs) && len(e.free) > 0; sent++ {
+		e.send(e.pop(), sent, xs[sent])
+	}
+	for received < len(xs) {
+		task := <-e.result
+		switch task.Op {
+		default:
+			panic("unknown operation")
+		case PostIteration:
+			e.stopped = true
+			return false
+		case FuncEvaluation:
+			fs[task.ID] = task.F
+			received++
+			if sent < len(xs) {
+				e.send(task, sent, xs[sent])
+				sent++
+			} else {
+				e.free = append(e.free, task)
+			}
+		}
+	}
+	return true
+}
+
+func (e *dfoEvaluator) send(task Task, id int, x []float64) {
+	task.ID = id
+	task.Op = FuncEvaluation
+	copy(task.X, x)
+	e.operation <- task
+}
+
+// evaluateOne evaluates the objective function at x.
+func (e *dfoEvaluator) evaluateOne(x []float64) (f float64, ok bool) {
+	var fs [1]float64
+	ok = e.evaluate([][]float64{x}, fs[:])
+	return fs[0], ok
+}
+
+// major sends a MajorIteration with the best location x and the function
+// value f if they differ from the location sent last. major returns false
+// if the optimization has been stopped by Minimize.
+func (e *dfoEvaluator) major(x []float64, f float64) bool {
+	if e.stopped {
+		return false
+	}
+	if !e.changed(x, f) {
+		return true
+	}
+	e.lastF = f
+	copy(e.lastX, x)
+	task := e.pop()
+	task.ID = -1
+	task.Op = MajorIteration
+	task.F = f
+	copy(task.X, x)
+	e.operation <- task
+	for {
+		task := <-e.result
+		switch task.Op {
+		default:
+			panic("unknown operation")
+		case PostIteration:
+			e.stopped = true
+			return false
+		case MajorIteration:
+			e.free = append(e.free, task)
+			return true
+		}
+	}
+}
+
+// changed returns whether x and f differ from the location sent last.
+func (e *dfoEvaluator) changed(x []float64, f float64) bool {
+	return f != e.lastF || !floats.Equal(x, e.lastX)
+}
+
+// finish completes the optimization with the best location x and the
+// function value f, which are sent in a MajorIteration if x is not nil and
+// they differ from the location sent last. If done is true, the method has terminated
+// by itself and Minimize is notified with a MethodDone operation. finish
+// closes the operation channel.
+func (e *dfoEvaluator) finish(x []float64, f float64, done bool) {
+	if x != nil {
+		e.major(x, f)
+	}
+	if done && !e.stopped {
+		task := e.pop()
+		task.ID = -1
+		task.Op = MethodDone
+		e.operation <- task
+	}
+	for range e.result {
+	}
+	// The optimization was stopped before x could be sent.
+	if x != nil && e.changed(x, f) {
+		task := Task{ID: -1, Op: MajorIteration, Location: newLocation(len(x))}
+		task.F = f
+		copy(task.X, x)
+		e.operation <- task
+	}
+	close(e.operation)
+}
@@ -0,0 +1,255 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// DEStrategy is the mutation strategy of DifferentialEvolution.
+type DEStrategy int
+
+const (
+	// DERand1Bin mutates a random member of the population by the scaled
+	// difference of two other random members, v = x_r1 + F*(x_r2 - x_r3).
+	DERand1Bin DEStrategy = iota
+	// DEBest1Bin mutates the best member of the population,
+	// v = x_best + F*(x_r1 - x_r2). It converges faster than DERand1Bin
+	// but is more likely to converge to a local minimum.
+	DEBest1Bin
+	// DECurrentToBest1Bin moves each member towards the best member,
+	// v = x_i + F*(x_best - x_i) + F*(x_r1 - x_r2).
+	DECurrentToBest1Bin
+)
+
+var (
+	_ Statuser = (*DifferentialEvolution)(nil)
+	_ Method   = (*DifferentialEvolution)(nil)
+)
+
+// DifferentialEvolution implements the differential evolution method of
+// Storn and Price, a derivative-free global optimization method. In each
+// generation, a mutant vector is formed for every member of the population
+// by adding a scaled difference of population members to a base vector, as
+// given by the Strategy. A trial vector is formed by binomial crossover of
+// the member and the mutant, and it replaces the member in the next
+// generation if its function value is not worse.
+//
+// The trial vectors of a generation are evaluated concurrently according to
+// Settings.Concurrent. The variables may be bounded by Lower and Upper, and
+// trial vectors are projected onto the bounds. The initial population is
+// sampled uniformly for the variables that are bounded on both sides, and
+// from a normal distribution around the initial location with standard
+// deviation InitStepSize for the other variables. The initial location is a
+// member of the initial population.
+//
+// References:
+//   - Storn, R., Price, K.: Differential evolution – a simple and efficient
+//     heuristic for global optimization over continuous spaces. Journal of
+//     Global Optimization 11(4) (1997), 341-359
+type DifferentialEvolution struct {
+	// Lower and Upper are the lower and upper bounds on the variables. If
+	// Lower is nil, the variables are unbounded from below, and if Upper is
+	// nil, the variables are unbounded from above. If not nil, the lengths
+	// of Lower and Upper must equal the problem dimension, and
+	// Lower[i] <= Upper[i] must hold for all i, otherwise
+	// DifferentialEvolution will panic.
+	Lower, Upper []float64
+	// InitStepSize is the standard deviation of the initial population for
+	// the variables that are not bounded on both sides. If InitStepSize is
+	// 0, it is defaulted to 1.
+	InitStepSize float64
+
+	// Population is the size of the population. If Population is 0, it is
+	// defaulted to max(10*dim, 10). Population must be at least 5,
+	// otherwise DifferentialEvolution will panic.
+	Population int
+	// Strategy is the mutation strategy.
+	Strategy DEStrategy
+	// Mutation is the differential weight F in (0, 2]. If Mutation is 0, it
+	// is defaulted to 0.8.
+	Mutation float64
+	// Crossover is the crossover probability in [0, 1]. If Crossover is 0,
+	// it is defaulted to 0.9.
+	Crossover float64
+
+	// StopSpread sets the threshold for stopping the optimization if the
+	// difference between the largest and the smallest function value in the
+	// population becomes too small. If StopSpread is 0, it is defaulted to
+	// 1e-12, and if it is NaN the stopping criterion is not used.
+	StopSpread float64
+
+	// Src allows a random number generator to be supplied for generating
+	// samples. If Src is nil the generator in golang.org/x/math/rand is
+	// used.
+	Src rand.Source
+
+	rnd      *rand.Rand
+	box      box
+	dim      int
+	pop      int
+	mutation float64
+	cross    float64
+	spread   float64
+
+	x0 []float64
+	// xs and fs hold the population. initialized is false until the
+	// initial population has been evaluated.
+	xs          *mat.Dense
+	fs          []float64
+	initialized bool
+	best        int
+
+	runner generationRunner
+}
+
+// Status returns the status of the method.
+func (de *DifferentialEvolution) Status() (Status, error) {
+	return de.runner.status(), nil
+}
+
+func (*DifferentialEvolution) Uses(has Available) (uses Available, err error) {
+	return has.function()
+}
+
+func (de *DifferentialEvolution) Init(dim, tasks int) int {
+	if dim <= 0 {
+		panic(nonpositiveDimension)
+	}
+	if tasks < 0 {
+		panic(negativeTasks)
+	}
+	step := de.InitStepSize
+	if step == 0 {
+		step = 1
+	} else if step < 0 {
+		panic("differential evolution: negative initial step size")
+	}
+	de.box.init("differential evolution", dim, de.Lower, de.Upper, step)
+	de.pop = de.Population
+	if de.pop == 0 {
+		de.pop = max(10*dim, 10)
+	} else if de.pop < 5 {
+		panic("differential evolution: population too small")
+	}
+	de.mutation = de.Mutation
+	if de.mutation == 0 {
+		de.mutation = 0.8
+	} else if de.mutation < 0 || de.mutation > 2 {
+		panic("differential evolution: mutation out of range")
+	}
+	de.cross = de.Crossover
+	if de.cross == 0 {
+		de.cross = 0.9
+	} else if de.cross < 0 || de.cross > 1 {
+		panic("differential evolution: crossover out of range")
+	}
+	de.spread = de.StopSpread
+	if de.spread == 0 {
+		de.spread = 1e-12
+	}
+
+	de.rnd = newRand(de.Src)
+	de.dim = dim
+	de.x0 = resize(de.x0, dim)
+	de.xs = mat.NewDense(de.pop, dim, nil)
+	de.fs = resize(de.fs, de.pop)
+	de.initialized = false
+	de.runner.init(dim, de.pop)
+	return min(tasks, de.pop)
+}
+
+func (de *DifferentialEvolution) Run(operations chan<- Task, results <-chan Task, tasks []Task) {
+	copy(de.x0, tasks[0].X)
+	de.box.project(de.x0)
+	de.runner.run(de, operations, results, tasks)
+}
+
+func (de *DifferentialEvolution) generationSize() int {
+	return de.pop
+}
+
+func (de *DifferentialEvolution) candidate(i int, x []float64) {
+	if !de.initialized {
+		if i == 0 {
+			copy(x, de.x0)
+		} else {
+			de.box.sample(x, de.x0, de.rnd)
+		}
+		return
+	}
+
+	// Choose the distinct random members r1, r2 and r3 different from i.
+	var r [3]int
+	for k := range r {
+	Draw:
+		for {
+			r[k] = de.rnd.Intn(de.pop)
+			if r[k] == i {
+				continue
+			}
+			for _, v := range r[:k] {
+				if r[k] == v {
+					continue Draw
+				}
+			}
+			break
+		}
+	}
+	xi := de.xs.RawRowView(i)
+	f := de.mutation
+	switch de.Strategy {
+	default:
+		panic("differential evolution: unknown strategy")
+	case DERand1Bin:
+		floats.SubTo(x, de.xs.RawRowView(r[1]), de.xs.RawRowView(r[2]))
+		floats.Scale(f, x)
+		floats.Add(x, de.xs.RawRowView(r[0]))
+	case DEBest1Bin:
+		floats.SubTo(x, de.xs.RawRowView(r[0]), de.xs.RawRowView(r[1]))
+		floats.Scale(f, x)
+		floats.Add(x, de.xs.RawRowView(de.best))
+	case DECurrentToBest1Bin:
+		floats.SubTo(x, de.xs.RawRowView(r[0]), de.xs.RawRowView(r[1]))
+		floats.Add(x, de.xs.RawRowView(de.best))
+		floats.Sub(x, xi)
+		floats.Scale(f, x)
+		floats.Add(x, xi)
+	}
+
+	// Binomial crossover with at least one variable from the mutant.
+	jRand := de.rnd.Intn(de.dim)
+	for j := range x {
+		if j != jRand && de.rnd.Float64() >= de.cross {
+			x[j] = xi[j]
+		}
+	}
+	de.box.project(x)
+}
+
+func (de *DifferentialEvolution) update(xs *mat.Dense, fs []float64) bool {
+	if !de.initialized {
+		de.xs.Copy(xs)
+		copy(de.fs, fs)
+		de.initialized = true
+	} else {
+		for i, f := range fs {
+			if f <= de.fs[i] {
+				copy(de.xs.RawRowView(i), xs.RawRowView(i))
+				de.fs[i] = f
+			}
+		}
+	}
+	de.best = floats.MinIdx(de.fs)
+	if math.IsNaN(de.spread) {
+		return false
+	}
+	return floats.Max(de.fs)-de.fs[de.best] <= de.spread
+}
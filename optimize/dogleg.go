@@ -0,0 +1,158 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+var _ TrustRegionSubproblem = (*Dogleg)(nil)
+
+// Dogleg solves the trust-region subproblem with the dogleg method.
+//
+// If the Hessian B is positive definite, the step follows the piecewise linear
+// path from the origin to the unconstrained minimizer of the model along the
+// negative gradient, and from there to the full Newton step -B⁻¹g. The step is
+// the point at which the path leaves the trust region, or the Newton step if
+// it lies within the trust region.
+//
+// If B is not positive definite, the dogleg path is formed with the matrix
+// B + τI instead, where τ is found as in Newton by adding successively larger
+// multiples of the identity until B + τI is positive definite. The result is
+// replaced by the Cauchy point if the latter yields a lower value of the
+// model.
+//
+// Dogleg requires a Cholesky factorization of B at every call, and it does not
+// use directions of negative curvature, so TrustRegion with Dogleg cannot move
+// away from a saddle point at which the gradient vanishes.
+type Dogleg struct {
+	// Increase is the factor by which a scalar τ is successively increased
+	// so that (B + τI) is positive definite. Increase must be greater than 1.
+	// If Increase is 0, it is defaulted to 5.
+	Increase float64
+
+	mod  *mat.SymDense
+	chol mat.Cholesky
+	tau  float64
+	pb   []float64
+	pu   []float64
+	hg   []float64
+	pc   []float64
+}
+
+// Solve computes the dogleg step. It implements the TrustRegionSubproblem
+// interface.
+func (d *Dogleg) Solve(dst, grad []float64, hess mat.Symmetric, radius float64) {
+	n := len(grad)
+	if len(dst) != n {
+		panic("dogleg: slice length mismatch")
+	}
+	if d.Increase == 0 {
+		d.Increase = 5
+	}
+	if d.Increase <= 1 {
+		panic("dogleg: Increase must be greater than 1")
+	}
+	d.mod = resizeSymDense(d.mod, n)
+	d.pb = resize(d.pb, n)
+	d.pu = resize(d.pu, n)
+	d.hg = resize(d.hg, n)
+	d.pc = resize(d.pc, n)
+
+	gNorm := floats.Norm(grad, 2)
+	if gNorm == 0 {
+		for i := range dst {
+			dst[i] = 0
+		}
+		return
+	}
+	g := mat.NewVecDense(n, grad)
+	hg := mat.NewVecDense(n, d.hg)
+	hg.MulVec(hess, g)
+	gHg := floats.Dot(grad, d.hg)
+	cauchyPoint(d.pc, grad, gNorm, gHg, radius)
+
+	if !d.factorize(hess) {
+		copy(dst, d.pc)
+		return
+	}
+	pb := mat.NewVecDense(n, d.pb)
+	err := d.chol.SolveVecTo(pb, g)
+	if isSingular(err) {
+		copy(dst, d.pc)
+		return
+	}
+	pb.ScaleVec(-1, pb)
+	if floats.Norm(d.pb, 2) <= radius {
+		copy(dst, d.pb)
+	} else {
+		// Minimizer of the model along the negative gradient. The curvature
+		// is positive because the modified Hessian is positive definite.
+		curv := gHg + d.tau*gNorm*gNorm
+		floats.ScaleTo(d.pu, -gNorm*gNorm/curv, grad)
+		if floats.Norm(d.pu, 2) >= radius {
+			floats.ScaleTo(dst, -radius/gNorm, grad)
+		} else {
+			// Find the intersection of the second segment with the boundary.
+			floats.Sub(d.pb, d.pu)
+			tau := trustRegionBoundary(d.pu, d.pb, radius)
+			floats.AddScaledTo(dst, d.pu, tau, d.pb)
+		}
+	}
+	if d.tau != 0 && quadModel(d.pc, grad, hess, d.hg) < quadModel(dst, grad, hess, d.hg) {
+		copy(dst, d.pc)
+	}
+}
+
+// factorize computes the Cholesky factorization of hess + τI with the
+// smallest τ >= 0 tried by the modification strategy of Newton. Unlike in
+// Newton, τ is not reused from the previous call because a large τ would
+// keep the steps in the interior of the trust region.
+func (d *Dogleg) factorize(hess mat.Symmetric) bool {
+	n := hess.SymmetricDim()
+	d.mod.CopySym(hess)
+	minA := math.Inf(1)
+	for i := 0; i < n; i++ {
+		minA = math.Min(minA, hess.At(i, i))
+	}
+	d.tau = 0
+	if minA <= 0 {
+		d.tau = -minA + 0.001
+	}
+	for k := 0; k < maxNewtonModifications; k++ {
+		if d.tau != 0 {
+			for i := 0; i < n; i++ {
+				d.mod.SetSym(i, i, hess.At(i, i)+d.tau)
+			}
+		}
+		if d.chol.Factorize(d.mod) {
+			return true
+		}
+		d.tau = math.Max(d.Increase*d.tau, 0.001)
+	}
+	return false
+}
+
+// quadModel returns the value of the quadratic model gᵀp + 1/2 pᵀBp. work
+// must have the length of p and its contents are overwritten.
+func quadModel(p, grad []float64, hess mat.Symmetric, work []float64) float64 {
+	n := len(p)
+	bp := mat.NewVecDense(n, work)
+	bp.MulVec(hess, mat.NewVecDense(n, p))
+	return floats.Dot(grad, p) + 0.5*floats.Dot(p, work)
+}
+
+// cauchyPoint stores in dst the minimizer of the quadratic model along the
+// negative gradient within the trust region.
+func cauchyPoint(dst, grad []float64, gNorm, gHg, radius float64) {
+	tau := 1.0
+	if gHg > 0 {
+		tau = math.Min(gNorm*gNorm*gNorm/(radius*gHg), 1)
+	}
+	floats.ScaleTo(dst, -tau*radius/gNorm, grad)
+}
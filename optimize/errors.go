@@ -32,6 +32,17 @@ var (
 	// lies out of allowed bounds.
 	ErrLinesearcherBound = errors.New("linesearch: step out of bounds")
 
+	// ErrTrustRegionNoProgress signifies that TrustRegion cannot make further
+	// progress because the trust region has become so small that the trial
+	// step does not change the location or the model predicts no decrease
+	// due to floating-point arithmetic.
+	ErrTrustRegionNoProgress = errors.New("trustregion: no progress possible within the trust region")
+
+	// ErrLearningRate signifies that the LearningRateSchedule of a stochastic
+	// gradient method has returned a learning rate that is not positive and
+	// finite.
+	ErrLearningRate = errors.New("optimize: learning rate not positive and finite")
+
 	// ErrMissingGrad signifies that a Method requires a Gradient function that
 	// is not supplied by Problem.
 	ErrMissingGrad = errors.New("optimize: problem does not provide needed Grad function")
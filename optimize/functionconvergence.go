@@ -19,6 +19,7 @@ type Converger interface {
 var (
 	_ Converger = NeverTerminate{}
 	_ Converger = (*FunctionConverge)(nil)
+	_ Converger = (*AverageConverge)(nil)
 )
 
 // NeverTerminate implements Converger, always reporting NotTerminated.
@@ -83,3 +84,79 @@ func (fc *FunctionConverge) Converged(l *Location) Status {
 	}
 	return FunctionConvergence
 }
+
+// AverageConverge tests for insufficient improvement in the average function
+// value over windows of consecutive iterations. It is suited to stochastic
+// objective functions, for example functions evaluated on mini-batches of
+// data, where the improvement of individual function values is dominated by
+// noise. The function values of every Window iterations are averaged, and
+// a FunctionConvergence status is returned if there is no significant
+// decrease of the average for Iterations consecutive windows. A significant
+// decrease is considered if
+//
+//	f < f_best
+//
+// and
+//
+//	f_best - f > AverageConverge.Relative * maxabs(f, f_best) + AverageConverge.Absolute
+//
+// where f is the average of the last window and f_best is the best average.
+// If the decrease is significant, then the window counter is reset and
+// f_best is updated.
+//
+// If AverageConverge.Window is 0, it is defaulted to 100. If
+// AverageConverge.Iterations == 0, it has no effect.
+type AverageConverge struct {
+	Window     int
+	Absolute   float64
+	Relative   float64
+	Iterations int
+
+	first bool
+	best  float64
+	sum   float64
+	n     int
+	iter  int
+}
+
+func (ac *AverageConverge) Init(dim int) {
+	ac.first = true
+	ac.best = 0
+	ac.sum = 0
+	ac.n = 0
+	ac.iter = 0
+}
+
+func (ac *AverageConverge) Converged(l *Location) Status {
+	window := ac.Window
+	if window == 0 {
+		window = 100
+	}
+	ac.sum += l.F
+	ac.n++
+	if ac.n < window {
+		return NotTerminated
+	}
+	f := ac.sum / float64(ac.n)
+	ac.sum = 0
+	ac.n = 0
+	if ac.first {
+		ac.best = f
+		ac.first = false
+		return NotTerminated
+	}
+	if ac.Iterations == 0 {
+		return NotTerminated
+	}
+	maxAbs := math.Max(math.Abs(f), math.Abs(ac.best))
+	if f < ac.best && ac.best-f > ac.Relative*maxAbs+ac.Absolute {
+		ac.best = f
+		ac.iter = 0
+		return NotTerminated
+	}
+	ac.iter++
+	if ac.iter < ac.Iterations {
+		return NotTerminated
+	}
+	return FunctionConvergence
+}
@@ -0,0 +1,553 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package functions
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// This file contains the multi-objective test problems of Zitzler, Deb and
+// Thiele (ZDT) and of Deb, Thiele, Laumanns and Zitzler (DTLZ). Each problem
+// evaluates the objectives at x into dst with Func, sets the bounds on the
+// variables with Bounds and samples its Pareto front with ParetoFront.
+//
+// References:
+//   - Zitzler, E., Deb, K., Thiele, L.: Comparison of multiobjective
+//     evolutionary algorithms: empirical results. Evolutionary Computation
+//     8(2) (2000), 173-195
+//   - Deb, K., Thiele, L., Laumanns, M., Zitzler, E.: Scalable test problems
+//     for evolutionary multiobjective optimization. In: Evolutionary
+//     Multiobjective Optimization, pp. 105-145. Springer (2005)
+
+// ZDT1 implements the ZDT1 problem with two objectives and a convex Pareto
+// front
+//
+//	f_2 = 1 - √f_1, f_1 ∈ [0, 1].
+//
+// The variables are in [0, 1], and the problem is usually solved with 30
+// variables. The Pareto optimal solutions have x_i = 0 for i > 0.
+type ZDT1 struct{}
+
+func (ZDT1) Func(dst, x []float64) {
+	g := zdtG(dst, x)
+	dst[1] = g * (1 - math.Sqrt(dst[0]/g))
+}
+
+func (ZDT1) Bounds(lower, upper []float64) {
+	unitBounds(lower, upper)
+}
+
+// ParetoFront returns n points on the Pareto front in the rows of a matrix.
+func (ZDT1) ParetoFront(n int) *mat.Dense {
+	return zdtFront(n, 0, func(f1 float64) float64 { return 1 - math.Sqrt(f1) })
+}
+
+// ZDT2 implements the ZDT2 problem with two objectives and a concave Pareto
+// front
+//
+//	f_2 = 1 - f_1², f_1 ∈ [0, 1].
+//
+// The variables are in [0, 1], and the problem is usually solved with 30
+// variables. The Pareto optimal solutions have x_i = 0 for i > 0.
+type ZDT2 struct{}
+
+func (ZDT2) Func(dst, x []float64) {
+	g := zdtG(dst, x)
+	r := dst[0] / g
+	dst[1] = g * (1 - r*r)
+}
+
+func (ZDT2) Bounds(lower, upper []float64) {
+	unitBounds(lower, upper)
+}
+
+// ParetoFront returns n points on the Pareto front in the rows of a matrix.
+func (ZDT2) ParetoFront(n int) *mat.Dense {
+	return zdtFront(n, 0, func(f1 float64) float64 { return 1 - f1*f1 })
+}
+
+// ZDT3 implements the ZDT3 problem with two objectives and a Pareto front
+// consisting of five disconnected parts of the curve
+//
+//	f_2 = 1 - √f_1 - f_1 sin(10π f_1), f_1 ∈ [0, 1].
+//
+// The variables are in [0, 1], and the problem is usually solved with 30
+// variables. The Pareto optimal solutions have x_i = 0 for i > 0.
+type ZDT3 struct{}
+
+func (ZDT3) Func(dst, x []float64) {
+	g := zdtG(dst, x)
+	r := dst[0] / g
+	dst[1] = g * (1 - math.Sqrt(r) - r*math.Sin(10*math.Pi*dst[0]))
+}
+
+func (ZDT3) Bounds(lower, upper []float64) {
+	unitBounds(lower, upper)
+}
+
+// ParetoFront returns the non-dominated points among n points sampled on
+// the curve containing the Pareto front in the rows of a matrix.
+func (ZDT3) ParetoFront(n int) *mat.Dense {
+	return nonDominated(zdtFront(n, 0, func(f1 float64) float64 {
+		return 1 - math.Sqrt(f1) - f1*math.Sin(10*math.Pi*f1)
+	}))
+}
+
+// ZDT4 implements the ZDT4 problem with two objectives, the Pareto front of
+// ZDT1 and many local Pareto fronts created by the Rastrigin function,
+//
+//	g(x) = 1 + 10(n-1) + Σ_{i>0} (x_i² - 10 cos(4π x_i)).
+//
+// The variable x_0 is in [0, 1] and the other variables are in [-5, 5]. The
+// problem is usually solved with 10 variables. The Pareto optimal solutions
+// have x_i = 0 for i > 0.
+type ZDT4 struct{}
+
+func (ZDT4) Func(dst, x []float64) {
+	if len(x) < 2 || len(dst) != 2 {
+		panic(badInputDim)
+	}
+	dst[0] = x[0]
+	g := 1 + 10*float64(len(x)-1)
+	for _, v := range x[1:] {
+		g += v*v - 10*math.Cos(4*math.Pi*v)
+	}
+	dst[1] = g * (1 - math.Sqrt(dst[0]/g))
+}
+
+func (ZDT4) Bounds(lower, upper []float64) {
+	if len(lower) != len(upper) {
+		panic(badInputDim)
+	}
+	for i := range lower {
+		lower[i], upper[i] = -5, 5
+	}
+	lower[0], upper[0] = 0, 1
+}
+
+// ParetoFront returns n points on the Pareto front in the rows of a matrix.
+func (ZDT4) ParetoFront(n int) *mat.Dense {
+	return ZDT1{}.ParetoFront(n)
+}
+
+// ZDT6 implements the ZDT6 problem with two objectives, a concave Pareto
+// front
+//
+//	f_2 = 1 - f_1², f_1 ∈ [0.2807753191, 1],
+//
+// that is non-uniformly populated, and a low density of solutions near the
+// front. The first objective is f_1 = 1 - exp(-4x_0) sin⁶(6π x_0). The
+// variables are in [0, 1], and the problem is usually solved with 10
+// variables. The Pareto optimal solutions have x_i = 0 for i > 0.
+type ZDT6 struct{}
+
+func (ZDT6) Func(dst, x []float64) {
+	if len(x) < 2 || len(dst) != 2 {
+		panic(badInputDim)
+	}
+	s := math.Sin(6 * math.Pi * x[0])
+	s3 := s * s * s
+	dst[0] = 1 - math.Exp(-4*x[0])*s3*s3
+	var sum float64
+	for _, v := range x[1:] {
+		sum += v
+	}
+	g := 1 + 9*math.Pow(sum/float64(len(x)-1), 0.25)
+	r := dst[0] / g
+	dst[1] = g * (1 - r*r)
+}
+
+func (ZDT6) Bounds(lower, upper []float64) {
+	unitBounds(lower, upper)
+}
+
+// ParetoFront returns n points on the Pareto front in the rows of a matrix.
+func (ZDT6) ParetoFront(n int) *mat.Dense {
+	return zdtFront(n, 0.2807753191, func(f1 float64) float64 { return 1 - f1*f1 })
+}
+
+// zdtG sets the first objective f_1 = x_0 in dst and returns
+//
+//	g(x) = 1 + 9/(n-1) Σ_{i>0} x_i.
+func zdtG(dst, x []float64) float64 {
+	if len(x) < 2 || len(dst) != 2 {
+		panic(badInputDim)
+	}
+	dst[0] = x[0]
+	var sum float64
+	for _, v := range x[1:] {
+		sum += v
+	}
+	return 1 + 9*sum/float64(len(x)-1)
+}
+
+// zdtFront returns n points of the curve f_2 = front(f_1) with f_1 evenly
+// spaced in [lo, 1].
+func zdtFront(n int, lo float64, front func(f1 float64) float64) *mat.Dense {
+	if n < 2 {
+		panic("functions: too few points")
+	}
+	dst := mat.NewDense(n, 2, nil)
+	for i := 0; i < n; i++ {
+		f1 := lo + (1-lo)*float64(i)/float64(n-1)
+		dst.Set(i, 0, f1)
+		dst.Set(i, 1, front(f1))
+	}
+	return dst
+}
+
+// DTLZ1 implements the DTLZ1 problem with a linear Pareto front
+//
+//	Σ_m f_m = 1/2, f_m >= 0,
+//
+// and many local Pareto fronts. The number of objectives M is given by
+// Objectives, which is defaulted to 3 if it is zero. The variables are in
+// [0, 1], and the problem is usually solved with M+4 variables. The Pareto
+// optimal solutions have x_i = 0.5 for i >= M-1.
+type DTLZ1 struct {
+	Objectives int
+}
+
+func (p DTLZ1) Func(dst, x []float64) {
+	m := dtlzCheck(p.Objectives, dst, x)
+	g := dtlzRastrigin(x[m-1:])
+	for i := range dst {
+		f := 0.5 * (1 + g)
+		for _, v := range x[:m-1-i] {
+			f *= v
+		}
+		if i > 0 {
+			f *= 1 - x[m-1-i]
+		}
+		dst[i] = f
+	}
+}
+
+func (DTLZ1) Bounds(lower, upper []float64) {
+	unitBounds(lower, upper)
+}
+
+// ParetoFront returns points on the Pareto front in the rows of a matrix,
+// which lie on a simplex lattice of at most n points, but at least the M
+// extreme points.
+func (p DTLZ1) ParetoFront(n int) *mat.Dense {
+	front := simplexLattice(dtlzObjectives(p.Objectives), n)
+	front.Scale(0.5, front)
+	return front
+}
+
+// DTLZ2 implements the DTLZ2 problem with a spherical Pareto front
+//
+//	Σ_m f_m² = 1, f_m >= 0.
+//
+// The number of objectives M is given by Objectives, which is defaulted to
+// 3 if it is zero. The variables are in [0, 1], and the problem is usually
+// solved with M+9 variables. The Pareto optimal solutions have x_i = 0.5 for
+// i >= M-1.
+type DTLZ2 struct {
+	Objectives int
+}
+
+func (p DTLZ2) Func(dst, x []float64) {
+	m := dtlzCheck(p.Objectives, dst, x)
+	var g float64
+	for _, v := range x[m-1:] {
+		g += (v - 0.5) * (v - 0.5)
+	}
+	dtlzSphere(dst, x, g, 1)
+}
+
+func (DTLZ2) Bounds(lower, upper []float64) {
+	unitBounds(lower, upper)
+}
+
+// ParetoFront returns points on the Pareto front in the rows of a matrix,
+// which are the normalized points of a simplex lattice of at most n points,
+// but at least the M extreme points.
+func (p DTLZ2) ParetoFront(n int) *mat.Dense {
+	return sphereFront(dtlzObjectives(p.Objectives), n)
+}
+
+// DTLZ3 implements the DTLZ3 problem with the spherical Pareto front of
+// DTLZ2 and the many local Pareto fronts of DTLZ1. The number of objectives
+// M is given by Objectives, which is defaulted to 3 if it is zero. The
+// variables are in [0, 1], and the problem is usually solved with M+9
+// variables. The Pareto optimal solutions have x_i = 0.5 for i >= M-1.
+type DTLZ3 struct {
+	Objectives int
+}
+
+func (p DTLZ3) Func(dst, x []float64) {
+	m := dtlzCheck(p.Objectives, dst, x)
+	dtlzSphere(dst, x, dtlzRastrigin(x[m-1:]), 1)
+}
+
+func (DTLZ3) Bounds(lower, upper []float64) {
+	unitBounds(lower, upper)
+}
+
+// ParetoFront returns points on the Pareto front in the rows of a matrix,
+// which are the normalized points of a simplex lattice of at most n points,
+// but at least the M extreme points.
+func (p DTLZ3) ParetoFront(n int) *mat.Dense {
+	return sphereFront(dtlzObjectives(p.Objectives), n)
+}
+
+// DTLZ4 implements the DTLZ4 problem with the spherical Pareto front of
+// DTLZ2, where the variables x_i, i < M-1, enter as x_i^α, which biases the
+// density of solutions towards the edges of the front. The number of
+// objectives M is given by Objectives, which is defaulted to 3 if it is
+// zero. If Alpha is zero, it is defaulted to 100. The variables are in
+// [0, 1], and the problem is usually solved with M+9 variables. The Pareto
+// optimal solutions have x_i = 0.5 for i >= M-1.
+type DTLZ4 struct {
+	Objectives int
+	Alpha      float64
+}
+
+func (p DTLZ4) Func(dst, x []float64) {
+	m := dtlzCheck(p.Objectives, dst, x)
+	var g float64
+	for _, v := range x[m-1:] {
+		g += (v - 0.5) * (v - 0.5)
+	}
+	alpha := p.Alpha
+	if alpha == 0 {
+		alpha = 100
+	}
+	dtlzSphere(dst, x, g, alpha)
+}
+
+func (DTLZ4) Bounds(lower, upper []float64) {
+	unitBounds(lower, upper)
+}
+
+// ParetoFront returns points on the Pareto front in the rows of a matrix,
+// which are the normalized points of a simplex lattice of at most n points,
+// but at least the M extreme points.
+func (p DTLZ4) ParetoFront(n int) *mat.Dense {
+	return sphereFront(dtlzObjectives(p.Objectives), n)
+}
+
+// DTLZ7 implements the DTLZ7 problem with 2^(M-1) disconnected regions in
+// the Pareto front. The objectives are f_m = x_m for m < M-1 and
+//
+//	f_{M-1} = (1+g) (M - Σ_{m<M-1} f_m/(1+g) (1 + sin(3π f_m))),
+//	g = 1 + 9/k Σ_{i>=M-1} x_i,
+//
+// where k = n-M+1. The number of objectives M is given by Objectives,
+// which is defaulted to 3 if it is zero. The variables are in [0, 1], and
+// the problem is usually solved with M+19 variables. The Pareto optimal
+// solutions have x_i = 0 for i >= M-1.
+type DTLZ7 struct {
+	Objectives int
+}
+
+func (p DTLZ7) Func(dst, x []float64) {
+	m := dtlzCheck(p.Objectives, dst, x)
+	var sum float64
+	for _, v := range x[m-1:] {
+		sum += v
+	}
+	g := 1 + 9*sum/float64(len(x)-m+1)
+	dst[m-1] = dtlz7Last(dst, x[:m-1], g)
+}
+
+func (DTLZ7) Bounds(lower, upper []float64) {
+	unitBounds(lower, upper)
+}
+
+// ParetoFront returns the non-dominated points among the points of a
+// regular grid of at most n points in the first M-1 objectives in the rows
+// of a matrix.
+func (p DTLZ7) ParetoFront(n int) *mat.Dense {
+	m := dtlzObjectives(p.Objectives)
+	q := int(math.Floor(math.Pow(float64(n), 1/float64(m-1)) + 1e-9))
+	if q < 2 {
+		panic("functions: too few points")
+	}
+	count := 1
+	for i := 0; i < m-1; i++ {
+		count *= q
+	}
+	front := mat.NewDense(count, m, nil)
+	f := make([]float64, m-1)
+	for k := 0; k < count; k++ {
+		idx := k
+		for i := range f {
+			f[i] = float64(idx%q) / float64(q-1)
+			idx /= q
+		}
+		row := front.RawRowView(k)
+		row[m-1] = dtlz7Last(row, f, 1)
+	}
+	return nonDominated(front)
+}
+
+// dtlz7Last sets the first objectives of DTLZ7 to f and returns the last
+// objective.
+func dtlz7Last(dst, f []float64, g float64) float64 {
+	h := float64(len(dst))
+	for i, v := range f {
+		dst[i] = v
+		h -= v / (1 + g) * (1 + math.Sin(3*math.Pi*v))
+	}
+	return (1 + g) * h
+}
+
+// dtlzObjectives returns the number of objectives of a DTLZ problem.
+func dtlzObjectives(m int) int {
+	if m == 0 {
+		return 3
+	}
+	if m < 2 {
+		panic("functions: too few objectives")
+	}
+	return m
+}
+
+// dtlzCheck checks the dimensions of a DTLZ problem and returns the number
+// of objectives.
+func dtlzCheck(objectives int, dst, x []float64) int {
+	m := dtlzObjectives(objectives)
+	if len(dst) != m || len(x) < m {
+		panic(badInputDim)
+	}
+	return m
+}
+
+// dtlzRastrigin returns the function g of DTLZ1 and DTLZ3,
+//
+//	g(x) = 100 (k + Σ_i ((x_i - 0.5)² - cos(20π(x_i - 0.5)))).
+func dtlzRastrigin(x []float64) float64 {
+	g := float64(len(x))
+	for _, v := range x {
+		g += (v-0.5)*(v-0.5) - math.Cos(20*math.Pi*(v-0.5))
+	}
+	return 100 * g
+}
+
+// dtlzSphere sets the objectives of DTLZ2, DTLZ3 and DTLZ4 with the
+// distance function g and the exponent alpha of the position variables.
+func dtlzSphere(dst, x []float64, g, alpha float64) {
+	m := len(dst)
+	for i := range dst {
+		f := 1 + g
+		for _, v := range x[:m-1-i] {
+			f *= math.Cos(math.Pow(v, alpha) * math.Pi / 2)
+		}
+		if i > 0 {
+			f *= math.Sin(math.Pow(x[m-1-i], alpha) * math.Pi / 2)
+		}
+		dst[i] = f
+	}
+}
+
+// sphereFront returns the points of a simplex lattice with m objectives
+// and at most n points projected onto the unit sphere.
+func sphereFront(m, n int) *mat.Dense {
+	front := simplexLattice(m, n)
+	for i := 0; i < front.RawMatrix().Rows; i++ {
+		row := front.RawRowView(i)
+		var norm float64
+		for _, v := range row {
+			norm += v * v
+		}
+		norm = math.Sqrt(norm)
+		for j := range row {
+			row[j] /= norm
+		}
+	}
+	return front
+}
+
+// simplexLattice returns the points of the simplex lattice of Das and
+// Dennis in m dimensions,
+//
+//	{w : w_j = k_j/h, k_j ∈ ℕ, Σ_j k_j = h},
+//
+// with the largest number of divisions h for which the lattice has at most
+// n points, but at least h = 1.
+func simplexLattice(m, n int) *mat.Dense {
+	h := 1
+	for binomial(h+m, m-1) <= n {
+		h++
+	}
+	count := binomial(h+m-1, m-1)
+	lattice := mat.NewDense(count, m, nil)
+	k := make([]int, m)
+	var row int
+	var fill func(j, left int)
+	fill = func(j, left int) {
+		if j == m-1 {
+			k[j] = left
+			for i, v := range k {
+				lattice.Set(row, i, float64(v)/float64(h))
+			}
+			row++
+			return
+		}
+		for v := 0; v <= left; v++ {
+			k[j] = v
+			fill(j+1, left-v)
+		}
+	}
+	fill(0, h)
+	return lattice
+}
+
+// binomial returns the binomial coefficient n choose k.
+func binomial(n, k int) int {
+	b := 1
+	for i := 1; i <= k; i++ {
+		b = b * (n - k + i) / i
+	}
+	return b
+}
+
+// nonDominated returns the rows of f that are not dominated by other rows
+// of f, where smaller values are better.
+func nonDominated(f *mat.Dense) *mat.Dense {
+	r, c := f.Dims()
+	var keep []int
+	for i := 0; i < r; i++ {
+		dominated := false
+		for j := 0; j < r && !dominated; j++ {
+			if j == i {
+				continue
+			}
+			better, worse := false, false
+			for k := 0; k < c; k++ {
+				switch {
+				case f.At(j, k) < f.At(i, k):
+					better = true
+				case f.At(j, k) > f.At(i, k):
+					worse = true
+				}
+			}
+			dominated = better && !worse
+		}
+		if !dominated {
+			keep = append(keep, i)
+		}
+	}
+	dst := mat.NewDense(len(keep), c, nil)
+	for i, k := range keep {
+		dst.SetRow(i, f.RawRowView(k))
+	}
+	return dst
+}
+
+// unitBounds sets the bounds [0, 1] for all variables.
+func unitBounds(lower, upper []float64) {
+	if len(lower) != len(upper) {
+		panic(badInputDim)
+	}
+	for i := range lower {
+		lower[i], upper[i] = 0, 1
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package functions
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+type multiObjective interface {
+	Func(dst, x []float64)
+	Bounds(lower, upper []float64)
+	ParetoFront(n int) *mat.Dense
+}
+
+func TestMultiObjective(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		name       string
+		p          multiObjective
+		dim        int
+		objectives int
+		// optimal sets the distance variables of a Pareto optimal solution.
+		optimal float64
+		// onFront returns whether f is on the surface containing the
+		// Pareto front.
+		onFront func(f []float64) bool
+	}{
+		{
+			name: "ZDT1", p: ZDT1{}, dim: 30, objectives: 2,
+			onFront: func(f []float64) bool { return scalar.EqualWithinAbs(f[1], 1-math.Sqrt(f[0]), 1e-12) },
+		},
+		{
+			name: "ZDT2", p: ZDT2{}, dim: 30, objectives: 2,
+			onFront: func(f []float64) bool { return scalar.EqualWithinAbs(f[1], 1-f[0]*f[0], 1e-12) },
+		},
+		{
+			name: "ZDT3", p: ZDT3{}, dim: 30, objectives: 2,
+			onFront: func(f []float64) bool {
+				return scalar.EqualWithinAbs(f[1], 1-math.Sqrt(f[0])-f[0]*math.Sin(10*math.Pi*f[0]), 1e-12)
+			},
+		},
+		{
+			name: "ZDT4", p: ZDT4{}, dim: 10, objectives: 2,
+			onFront: func(f []float64) bool { return scalar.EqualWithinAbs(f[1], 1-math.Sqrt(f[0]), 1e-12) },
+		},
+		{
+			name: "ZDT6", p: ZDT6{}, dim: 10, objectives: 2,
+			onFront: func(f []float64) bool { return scalar.EqualWithinAbs(f[1], 1-f[0]*f[0], 1e-12) },
+		},
+		{
+			name: "DTLZ1", p: DTLZ1{}, dim: 7, objectives: 3, optimal: 0.5,
+			onFront: func(f []float64) bool { return scalar.EqualWithinAbs(floats.Sum(f), 0.5, 1e-12) },
+		},
+		{
+			name: "DTLZ1-5", p: DTLZ1{Objectives: 5}, dim: 9, objectives: 5, optimal: 0.5,
+			onFront: func(f []float64) bool { return scalar.EqualWithinAbs(floats.Sum(f), 0.5, 1e-12) },
+		},
+		{
+			name: "DTLZ2", p: DTLZ2{}, dim: 12, objectives: 3, optimal: 0.5,
+			onFront: func(f []float64) bool { return scalar.EqualWithinAbs(floats.Norm(f, 2), 1, 1e-12) },
+		},
+		{
+			name: "DTLZ3", p: DTLZ3{Objectives: 2}, dim: 11, objectives: 2, optimal: 0.5,
+			onFront: func(f []float64) bool { return scalar.EqualWithinAbs(floats.Norm(f, 2), 1, 1e-12) },
+		},
+		{
+			name: "DTLZ4", p: DTLZ4{Alpha: 2}, dim: 12, objectives: 3, optimal: 0.5,
+			onFront: func(f []float64) bool { return scalar.EqualWithinAbs(floats.Norm(f, 2), 1, 1e-12) },
+		},
+		{
+			name: "DTLZ7", p: DTLZ7{}, dim: 22, objectives: 3,
+			onFront: func(f []float64) bool {
+				h := 3 - f[0]/2*(1+math.Sin(3*math.Pi*f[0])) - f[1]/2*(1+math.Sin(3*math.Pi*f[1]))
+				return scalar.EqualWithinAbs(f[2], 2*h, 1e-12)
+			},
+		},
+	} {
+		lower := make([]float64, test.dim)
+		upper := make([]float64, test.dim)
+		test.p.Bounds(lower, upper)
+		for i := range lower {
+			if lower[i] > test.optimal || upper[i] < test.optimal || lower[i] >= upper[i] {
+				t.Errorf("%s: invalid bounds", test.name)
+			}
+		}
+
+		front := test.p.ParetoFront(100)
+		r, c := front.Dims()
+		if r < 2 || r > 100 || c != test.objectives {
+			t.Errorf("%s: unexpected size of the Pareto front: %d×%d", test.name, r, c)
+		}
+		for i := 0; i < r; i++ {
+			if !test.onFront(front.RawRowView(i)) {
+				t.Errorf("%s: point %v not on the Pareto front", test.name, front.RawRowView(i))
+			}
+		}
+
+		// Pareto optimal solutions are on the front, and other solutions
+		// are dominated by a point on the front.
+		f := make([]float64, test.objectives)
+		for trial := 0; trial < 10; trial++ {
+			x := make([]float64, test.dim)
+			for i := range x {
+				x[i] = lower[i] + (upper[i]-lower[i])*rnd.Float64()
+			}
+			xCopy := make([]float64, len(x))
+			copy(xCopy, x)
+			test.p.Func(f, x)
+			if !floats.Equal(x, xCopy) {
+				t.Errorf("%s: location modified", test.name)
+			}
+			if test.onFront(f) {
+				t.Errorf("%s: random location %v is Pareto optimal", test.name, x)
+			}
+			for i := test.objectives - 1; i < test.dim; i++ {
+				x[i] = test.optimal
+			}
+			if test.objectives == 2 {
+				for i := 1; i < test.dim; i++ {
+					x[i] = test.optimal
+				}
+			}
+			test.p.Func(f, x)
+			if !test.onFront(f) {
+				t.Errorf("%s: Pareto optimal location %v not on the front: %v", test.name, x, f)
+			}
+		}
+	}
+}
+
+func TestSimplexLattice(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		m, n int
+		want int
+	}{
+		{m: 2, n: 100, want: 100},
+		{m: 3, n: 100, want: 91},
+		{m: 3, n: 2, want: 3},
+		{m: 5, n: 210, want: 210},
+	} {
+		lattice := simplexLattice(test.m, test.n)
+		r, c := lattice.Dims()
+		if r != test.want || c != test.m {
+			t.Errorf("m=%d n=%d: unexpected size: got %d×%d, want %d×%d", test.m, test.n, r, c, test.want, test.m)
+		}
+		for i := 0; i < r; i++ {
+			if !scalar.EqualWithinAbs(floats.Sum(lattice.RawRowView(i)), 1, 1e-14) {
+				t.Errorf("m=%d n=%d: point not on the simplex", test.m, test.n)
+			}
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"gonum.org/v1/gonum/diff/fd"
 	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
 )
 
 // function represents an objective function.
@@ -21,6 +22,13 @@ type gradient interface {
 	Grad(grad, x []float64) []float64
 }
 
+// hessian is an objective function that can evaluate its gradient and
+// Hessian.
+type hessian interface {
+	Grad(grad, x []float64)
+	Hess(dst *mat.SymDense, x []float64)
+}
+
 // minimumer is an objective function that can also provide information about
 // its minima.
 type minimumer interface {
@@ -57,10 +65,11 @@ const (
 	defaultTol       = 1e-12
 	defaultGradTol   = 1e-9
 	defaultFDGradTol = 1e-5
+	defaultFDHessTol = 1e-5
 )
 
-// testFunction checks that the function can evaluate itself (and its gradient)
-// correctly.
+// testFunction checks that the function can evaluate itself (and its gradient
+// and Hessian) correctly.
 func testFunction(f function, ftests []funcTest, t *testing.T) {
 	// Make a copy of tests because we may append to the slice.
 	tests := make([]funcTest, len(ftests))
@@ -69,6 +78,7 @@ func testFunction(f function, ftests []funcTest, t *testing.T) {
 	// Get information about the function.
 	fMinima, isMinimumer := f.(minimumer)
 	fGradient, isGradient := f.(gradient)
+	fHessian, isHessian := f.(hessian)
 
 	// If the function is a Minimumer, append its minima to the tests.
 	if isMinimumer {
@@ -123,5 +133,22 @@ func testFunction(f function, ftests []funcTest, t *testing.T) {
 					i, dist)
 			}
 		}
+
+		// If the function is a Hessian, check that the Hessian matches the
+		// finite difference Jacobian of the gradient.
+		if isHessian {
+			n := len(test.X)
+			hess := mat.NewSymDense(n, nil)
+			fHessian.Hess(hess, test.X)
+			fdHess := mat.NewDense(n, n, nil)
+			fd.Jacobian(fdHess, fHessian.Grad, test.X, &fd.JacobianSettings{
+				Formula: fd.Central,
+				Step:    1e-6,
+			})
+			if !mat.EqualApprox(hess, fdHess, defaultFDHessTol*math.Max(1, mat.Norm(hess, math.Inf(1)))) {
+				t.Errorf("Test #%d: Hessian given by Hess does not match the numerical Hessian. Want: %v, Got: %v",
+					i, mat.Formatted(fdHess), mat.Formatted(hess))
+			}
+		}
 	}
 }
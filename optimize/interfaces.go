@@ -4,6 +4,8 @@
 
 package optimize
 
+import "gonum.org/v1/gonum/mat"
+
 // A localMethod can optimize an objective function.
 //
 // It uses a reverse-communication interface between the optimization method
@@ -117,6 +119,24 @@ type NextDirectioner interface {
 	NextDirection(loc *Location, dir []float64) (step float64)
 }
 
+// TrustRegionSubproblem is a type that can approximately solve the
+// trust-region subproblem
+//
+//	minimize m(p) := gᵀp + 1/2 pᵀBp subject to |p| <= Δ,
+//
+// where g is the gradient and B is the Hessian of the objective function at
+// the current location, and Δ is the trust-region radius. Typically, a
+// TrustRegionSubproblem will be used in conjunction with TrustRegion for
+// performing Hessian-based optimization.
+type TrustRegionSubproblem interface {
+	// Solve stores the approximate minimizer p of the model in place into
+	// dst. The step must satisfy |p| <= radius, and it should decrease the
+	// model at least by a fixed fraction of the decrease at the Cauchy
+	// point, that is, the minimizer of the model along the negative gradient
+	// within the trust region. Solve must not modify grad or hess.
+	Solve(dst, grad []float64, hess mat.Symmetric, radius float64)
+}
+
 // StepSizer can set the next step size of the optimization given the last Location.
 // Returned step size must be positive.
 type StepSizer interface {
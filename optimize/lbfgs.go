@@ -5,13 +5,17 @@
 package optimize
 
 import (
+	"encoding"
+
 	"gonum.org/v1/gonum/floats"
 )
 
 var (
-	_ Method          = (*LBFGS)(nil)
-	_ localMethod     = (*LBFGS)(nil)
-	_ NextDirectioner = (*LBFGS)(nil)
+	_ Method                     = (*LBFGS)(nil)
+	_ localMethod                = (*LBFGS)(nil)
+	_ NextDirectioner            = (*LBFGS)(nil)
+	_ encoding.BinaryMarshaler   = (*LBFGS)(nil)
+	_ encoding.BinaryUnmarshaler = (*LBFGS)(nil)
 )
 
 // LBFGS implements the limited-memory BFGS method for gradient-based
@@ -24,6 +28,9 @@ var (
 // O(Store * dim) while BFGS scales as O(dim^2). The "forgetful" nature of
 // LBFGS may also make it perform better than BFGS for functions with Hessians
 // that vary rapidly spatially.
+//
+// The state of LBFGS at a major iteration can be saved with MarshalBinary and
+// restored with UnmarshalBinary to continue the optimization with Resume.
 type LBFGS struct {
 	// Linesearcher selects suitable steps along the descent direction.
 	// Accepted steps should satisfy the strong Wolfe conditions.
@@ -52,6 +59,8 @@ type LBFGS struct {
 	s      [][]float64 // Last Store values of s
 	rho    []float64   // Last Store values of rho
 	a      []float64   // Cache of Hessian updates
+
+	restored bool // Indicator of the state restored by UnmarshalBinary.
 }
 
 func (l *LBFGS) Status() (Status, error) {
@@ -65,6 +74,9 @@ func (*LBFGS) Uses(has Available) (uses Available, err error) {
 func (l *LBFGS) Init(dim, tasks int) int {
 	l.status = NotTerminated
 	l.err = nil
+	if !l.restored {
+		l.dim = 0
+	}
 	return 1
 }
 
@@ -95,6 +107,15 @@ func (l *LBFGS) iterateLocal(loc *Location) (Operation, error) {
 }
 
 func (l *LBFGS) InitDirection(loc *Location, dir []float64) (stepSize float64) {
+	if l.restored {
+		// Continue from the major iteration at which the state was saved.
+		l.restored = false
+		if len(loc.X) != l.dim {
+			panic("lbfgs: restored state does not match the problem dimension")
+		}
+		return l.NextDirection(loc, dir)
+	}
+
 	dim := len(loc.X)
 	l.dim = dim
 	l.oldest = 0
@@ -197,3 +218,74 @@ func (*LBFGS) needs() struct {
 		Hessian  bool
 	}{true, false}
 }
+
+// MarshalBinary encodes the state of the method at the last major iteration
+// into a binary form and returns the result.
+func (l *LBFGS) MarshalBinary() ([]byte, error) {
+	var e encoder
+	e.header("lbfgs")
+	e.int(l.dim)
+	if l.dim == 0 {
+		// The optimization has not started.
+		return e.buf, nil
+	}
+	e.int(l.Store)
+	e.floats(l.x)
+	e.floats(l.grad)
+	e.int(l.oldest)
+	e.floats(l.rho)
+	for i := range l.y {
+		e.floats(l.y[i])
+		e.floats(l.s[i])
+	}
+	return e.buf, nil
+}
+
+// UnmarshalBinary restores the state of the method from the binary form
+// returned by MarshalBinary. The next optimization with the method continues
+// from the restored state. UnmarshalBinary returns ErrCheckpointMethod if data
+// is not the state of an LBFGS, and ErrBadCheckpoint if data is malformed or
+// the size of the history does not match a non-zero Store.
+func (l *LBFGS) UnmarshalBinary(data []byte) error {
+	d := decoder{buf: data}
+	d.header("lbfgs")
+	dim := d.int()
+	if d.err == nil && dim == 0 {
+		l.restored = false
+		return d.finish()
+	}
+	store := d.int()
+	if d.err == nil && (dim < 0 || store <= 0 || l.Store != 0 && l.Store != store || store > d.remaining()) {
+		return ErrBadCheckpoint
+	}
+	x := d.floats()
+	grad := d.floats()
+	oldest := d.int()
+	rho := d.floats()
+	y := make([][]float64, store)
+	s := make([][]float64, store)
+	for i := range y {
+		y[i] = d.floats()
+		s[i] = d.floats()
+		if d.err == nil && (len(y[i]) != dim || len(s[i]) != dim) {
+			return ErrBadCheckpoint
+		}
+	}
+	if err := d.finish(); err != nil {
+		return err
+	}
+	if len(x) != dim || len(grad) != dim || len(rho) != store || oldest < 0 || oldest >= store {
+		return ErrBadCheckpoint
+	}
+	l.Store = store
+	l.dim = dim
+	l.x = x
+	l.grad = grad
+	l.oldest = oldest
+	l.rho = rho
+	l.y = y
+	l.s = s
+	l.a = resize(l.a, store)
+	l.restored = true
+	return nil
+}
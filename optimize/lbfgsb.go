@@ -0,0 +1,673 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	_ Method          = (*LBFGSB)(nil)
+	_ localMethod     = (*LBFGSB)(nil)
+	_ NextDirectioner = (*LBFGSB)(nil)
+)
+
+// LBFGSB implements the limited-memory BFGS method for gradient-based
+// minimization subject to simple bounds on the variables
+//
+//	Lower[i] <= x[i] <= Upper[i].
+//
+// At each iteration LBFGSB forms a quadratic model of the objective function
+// using the compact representation of the limited-memory BFGS Hessian
+// approximation. It then computes the generalized Cauchy point, the first
+// local minimizer of the model along the projected steepest descent path,
+// and minimizes the model over the variables that are not at a bound at the
+// Cauchy point. The resulting feasible search direction is used in a line
+// search that satisfies the strong Wolfe conditions and never leaves the
+// feasible region.
+//
+// The initial location is projected onto the feasible region before the
+// optimization starts, and all locations at which the objective function is
+// evaluated are feasible. The optimization is considered converged when the
+// infinity norm of the projected gradient is below GradStopThreshold.
+//
+// References:
+//   - Byrd, R.H., Lu, P., Nocedal, J., Zhu, C.: A limited memory algorithm for
+//     bound constrained optimization. SIAM Journal on Scientific Computing 16(5)
+//     (1995), 1190-1208
+//   - Morales, J.L., Nocedal, J.: Remark on "Algorithm 778: L-BFGS-B: Fortran
+//     subroutines for large-scale bound constrained optimization". ACM
+//     Transactions on Mathematical Software 38(1) (2011), 7:1-7:4
+type LBFGSB struct {
+	// Lower and Upper are the lower and upper bounds on the variables. If
+	// Lower is nil, the variables are unbounded from below, and if Upper is
+	// nil, the variables are unbounded from above. Individual bounds may be
+	// infinite. If not nil, the lengths of Lower and Upper must equal the
+	// problem dimension, and Lower[i] <= Upper[i] must hold for all i,
+	// otherwise LBFGSB will panic.
+	Lower, Upper []float64
+	// Store is the size of the limited-memory storage.
+	// If Store is 0, it will be defaulted to 15.
+	Store int
+	// GradStopThreshold sets the threshold for stopping if the norm of the
+	// projected gradient gets too small. If GradStopThreshold is 0 it is
+	// defaulted to 1e-12, and if it is NaN the setting is not used.
+	GradStopThreshold float64
+
+	status Status
+	err    error
+
+	ls *LinesearchMethod
+	mt *lbfgsbLinesearch
+
+	dim   int
+	lower []float64 // Lower bounds with nil replaced by -Inf.
+	upper []float64 // Upper bounds with nil replaced by +Inf.
+
+	x    []float64 // Location at the last major iteration
+	f    float64   // Function value at the last major iteration
+	grad []float64 // Gradient at the last major iteration
+	pg   []float64 // Projected gradient
+
+	// History, stored from the oldest to the most recent pair.
+	s, y  [][]float64
+	k     int     // Number of stored correction pairs
+	theta float64 // Scaling of the initial Hessian approximation
+
+	w  []float64  // W = [Y θS] stored row-wise, dim×2k
+	m  *mat.Dense // Middle matrix M of the compact representation
+	tk []float64  // Breakpoints of the projected steepest descent path
+	xc []float64  // Generalized Cauchy point
+	d  []float64  // Projected steepest descent direction
+	c  []float64  // c = Wᵀ(xc - x)
+	a  []float64  // Cache for the two-loop recursion
+}
+
+func (l *LBFGSB) Status() (Status, error) {
+	return l.status, l.err
+}
+
+func (*LBFGSB) Uses(has Available) (uses Available, err error) {
+	return has.gradient()
+}
+
+func (l *LBFGSB) Init(dim, tasks int) int {
+	if l.Lower != nil && len(l.Lower) != dim {
+		panic("lbfgsb: lower bound length mismatch")
+	}
+	if l.Upper != nil && len(l.Upper) != dim {
+		panic("lbfgsb: upper bound length mismatch")
+	}
+	l.dim = dim
+	l.lower = resize(l.lower, dim)
+	l.upper = resize(l.upper, dim)
+	for i := range l.lower {
+		l.lower[i] = math.Inf(-1)
+		if l.Lower != nil {
+			l.lower[i] = l.Lower[i]
+		}
+		l.upper[i] = math.Inf(1)
+		if l.Upper != nil {
+			l.upper[i] = l.Upper[i]
+		}
+		if l.lower[i] > l.upper[i] || math.IsNaN(l.lower[i]) || math.IsNaN(l.upper[i]) {
+			panic("lbfgsb: inconsistent bounds")
+		}
+	}
+	l.status = NotTerminated
+	l.err = nil
+	return 1
+}
+
+func (l *LBFGSB) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	// Start from a feasible location. If the projection moves the initial
+	// location, any supplied initial values are no longer valid.
+	x := tasks[0].X
+	for i, v := range x {
+		if p := l.project(i, v); p != v {
+			x[i] = p
+			tasks[0].Op = NoOperation
+		}
+	}
+	l.status, l.err = localOptimizer{}.run(l, l.GradStopThreshold, operation, result, tasks)
+	close(operation)
+}
+
+func (l *LBFGSB) initLocal(loc *Location) (Operation, error) {
+	if l.Store == 0 {
+		l.Store = 15
+	}
+	if l.mt == nil {
+		l.mt = &lbfgsbLinesearch{}
+	}
+	// Parameters of the line search used in the reference implementation.
+	l.mt.DecreaseFactor = 1e-3
+	l.mt.CurvatureFactor = 0.9
+	if l.ls == nil {
+		l.ls = &LinesearchMethod{}
+	}
+	l.ls.Linesearcher = l.mt
+	l.ls.NextDirectioner = l
+
+	op, err := l.ls.Init(loc)
+	l.projectLocation(loc.X)
+	return op, err
+}
+
+func (l *LBFGSB) iterateLocal(loc *Location) (Operation, error) {
+	op, err := l.ls.Iterate(loc)
+	if (err == ErrLinesearcherFailure || err == ErrNoProgress) && l.k > 0 {
+		// The Hessian approximation may be inaccurate. Return to the last
+		// major iteration, discard the history and restart along the
+		// projected steepest descent direction.
+		copy(loc.X, l.x)
+		copy(loc.Gradient, l.grad)
+		loc.F = l.f
+		op, err = l.ls.Init(loc)
+	}
+	if op.isEvaluation() {
+		// Remove rounding errors that could move x out of the feasible region.
+		l.projectLocation(loc.X)
+	}
+	return op, err
+}
+
+// projectedGradient returns the projection of the gradient at loc onto the
+// feasible region, which is used to test convergence.
+func (l *LBFGSB) projectedGradient(loc *Location) []float64 {
+	if loc.Gradient == nil {
+		return nil
+	}
+	l.pg = resize(l.pg, len(loc.X))
+	for i, xi := range loc.X {
+		l.pg[i] = l.project(i, xi-loc.Gradient[i]) - xi
+	}
+	return l.pg
+}
+
+func (l *LBFGSB) InitDirection(loc *Location, dir []float64) (stepSize float64) {
+	dim := len(loc.X)
+	l.x = resize(l.x, dim)
+	copy(l.x, loc.X)
+	l.grad = resize(l.grad, dim)
+	copy(l.grad, loc.Gradient)
+	l.f = loc.F
+
+	l.s = l.initHistory(l.s)
+	l.y = l.initHistory(l.y)
+	l.k = 0
+	l.theta = 1
+	l.formW()
+
+	l.mt.maxStep = l.direction(loc, dir)
+	// There is no curvature information yet, so the first step is limited
+	// in the same way as for the unconstrained LBFGS.
+	return math.Min(1/floats.Norm(dir, 2), l.mt.maxStep)
+}
+
+func (l *LBFGSB) initHistory(hist [][]float64) [][]float64 {
+	if cap(hist) < l.Store {
+		hist = append(hist[:cap(hist)], make([][]float64, l.Store-cap(hist))...)
+	}
+	hist = hist[:l.Store]
+	for i := range hist {
+		hist[i] = resize(hist[i], l.dim)
+	}
+	return hist
+}
+
+func (l *LBFGSB) NextDirection(loc *Location, dir []float64) (stepSize float64) {
+	if len(loc.X) != l.dim {
+		panic("lbfgsb: unexpected size mismatch")
+	}
+	if len(loc.Gradient) != l.dim {
+		panic("lbfgsb: unexpected size mismatch")
+	}
+	if len(dir) != l.dim {
+		panic("lbfgsb: unexpected size mismatch")
+	}
+
+	// Update the correction pairs. The newest pair replaces the oldest one if
+	// the storage is full. Pairs that do not satisfy the curvature condition
+	// sufficiently are skipped to keep the Hessian approximation positive
+	// definite.
+	if l.k == l.Store {
+		s, y := l.s[0], l.y[0]
+		copy(l.s, l.s[1:])
+		copy(l.y, l.y[1:])
+		l.s[l.Store-1], l.y[l.Store-1] = s, y
+		l.k--
+	}
+	s := l.s[l.k]
+	floats.SubTo(s, loc.X, l.x)
+	y := l.y[l.k]
+	floats.SubTo(y, loc.Gradient, l.grad)
+	sDotY := floats.Dot(s, y)
+	yDotY := floats.Dot(y, y)
+	if sDotY > dlamchE*yDotY {
+		l.k++
+		l.theta = yDotY / sDotY
+	}
+
+	copy(l.x, loc.X)
+	copy(l.grad, loc.Gradient)
+	l.f = loc.F
+
+	l.formW()
+	maxStep := l.direction(loc, dir)
+	if floats.Dot(loc.Gradient, dir) >= 0 && l.k > 0 {
+		// The Hessian approximation is not accurate enough to produce a
+		// descent direction. Discard the history and restart from the
+		// projected steepest descent direction.
+		l.k = 0
+		l.theta = 1
+		l.formW()
+		l.mt.maxStep = l.direction(loc, dir)
+		return math.Min(1/floats.Norm(dir, 2), l.mt.maxStep)
+	}
+	l.mt.maxStep = maxStep
+	return 1
+}
+
+// formW forms the matrix W = [Y θS] and the middle matrix M of the compact
+// representation of the limited-memory BFGS matrix
+//
+//	B = θI - W*M*Wᵀ,
+//
+// where
+//
+//	M = [ -D   Lᵀ  ]⁻¹
+//	    [  L  θSᵀS ]
+//
+// with D the diagonal of SᵀY and L its strictly lower triangle. If M cannot
+// be computed, the history is discarded.
+func (l *LBFGSB) formW() {
+	k := l.k
+	l.w = resize(l.w, l.dim*2*k)
+	if k == 0 {
+		l.m = nil
+		return
+	}
+	for i := 0; i < l.dim; i++ {
+		row := l.w[i*2*k : (i+1)*2*k]
+		for j := 0; j < k; j++ {
+			row[j] = l.y[j][i]
+			row[k+j] = l.theta * l.s[j][i]
+		}
+	}
+	mid := mat.NewDense(2*k, 2*k, nil)
+	for i := 0; i < k; i++ {
+		mid.Set(i, i, -floats.Dot(l.s[i], l.y[i]))
+		for j := 0; j < i; j++ {
+			v := floats.Dot(l.s[i], l.y[j])
+			mid.Set(k+i, j, v)
+			mid.Set(j, k+i, v)
+		}
+		for j := 0; j <= i; j++ {
+			v := l.theta * floats.Dot(l.s[i], l.s[j])
+			mid.Set(k+i, k+j, v)
+			mid.Set(k+j, k+i, v)
+		}
+	}
+	if l.m == nil {
+		l.m = &mat.Dense{}
+	}
+	l.m.Reset()
+	if err := l.m.Inverse(mid); isSingular(err) {
+		l.k = 0
+		l.theta = 1
+		l.w = l.w[:0]
+		l.m = nil
+	}
+}
+
+// isSingular returns whether err indicates an exactly singular matrix.
+// Ill-conditioned matrices are accepted.
+func isSingular(err error) bool {
+	if err == nil {
+		return false
+	}
+	c, ok := err.(mat.Condition)
+	return !ok || math.IsInf(float64(c), 1)
+}
+
+// mulM stores M*v into dst.
+func (l *LBFGSB) mulM(dst, v []float64) {
+	if l.k == 0 {
+		return
+	}
+	dv := mat.NewVecDense(len(dst), dst)
+	dv.MulVec(l.m, mat.NewVecDense(len(v), v))
+}
+
+// wRow returns the i-th row of W.
+func (l *LBFGSB) wRow(i int) []float64 {
+	return l.w[i*2*l.k : (i+1)*2*l.k]
+}
+
+// direction computes the search direction at loc by minimizing the quadratic
+// model over the feasible region and returns the largest step along the
+// direction that keeps the location feasible.
+func (l *LBFGSB) direction(loc *Location, dir []float64) (maxStep float64) {
+	l.cauchyPoint(loc.X, loc.Gradient)
+	l.subspaceMinimization(loc.X, loc.Gradient)
+
+	// The search direction points from x to the minimizer of the model.
+	floats.SubTo(dir, l.xc, loc.X)
+	maxStep = math.Inf(1)
+	for i, di := range dir {
+		switch {
+		case di > 0:
+			maxStep = math.Min(maxStep, (l.upper[i]-loc.X[i])/di)
+		case di < 0:
+			maxStep = math.Min(maxStep, (l.lower[i]-loc.X[i])/di)
+		}
+	}
+	return math.Max(1, math.Min(maxStep, lbfgsbMaxStep))
+}
+
+// cauchyPoint computes the generalized Cauchy point, the first local
+// minimizer of the quadratic model along the piecewise linear path obtained
+// by projecting the steepest descent direction onto the feasible region. The
+// Cauchy point is stored in l.xc and the vector c = Wᵀ*(xc - x) in l.c.
+//
+// This is Algorithm CP of Byrd et al. (1995).
+func (l *LBFGSB) cauchyPoint(x, g []float64) {
+	dim := len(x)
+	k2 := 2 * l.k
+	l.xc = resize(l.xc, dim)
+	l.d = resize(l.d, dim)
+	l.tk = resize(l.tk, dim)
+	l.c = resize(l.c, k2)
+	copy(l.xc, x)
+	for i := range l.c {
+		l.c[i] = 0
+	}
+
+	// Compute the breakpoints and the projected steepest descent direction.
+	var free []int
+	var fp float64
+	for i, gi := range g {
+		t := math.Inf(1)
+		switch {
+		case gi < 0:
+			t = (x[i] - l.upper[i]) / gi
+		case gi > 0:
+			t = (x[i] - l.lower[i]) / gi
+		}
+		l.tk[i] = t
+		if t <= 0 {
+			l.d[i] = 0
+			continue
+		}
+		l.d[i] = -gi
+		fp -= gi * gi
+		free = append(free, i)
+	}
+	sort.Slice(free, func(a, b int) bool { return l.tk[free[a]] < l.tk[free[b]] })
+
+	// p = Wᵀd
+	p := make([]float64, k2)
+	for i, di := range l.d {
+		if di != 0 {
+			floats.AddScaled(p, di, l.wRow(i))
+		}
+	}
+	mp := make([]float64, k2)
+	mc := make([]float64, k2)
+	mw := make([]float64, k2)
+	l.mulM(mp, p)
+
+	// First and second derivatives of the model along the path.
+	fpp := -l.theta*fp - floats.Dot(p, mp)
+	tOld := 0.0
+	var dtMin float64
+	if fpp > 0 {
+		dtMin = -fp / fpp
+	}
+
+	// Examine the segments between subsequent breakpoints.
+	j := 0
+	for ; j < len(free); j++ {
+		b := free[j]
+		t := l.tk[b]
+		if math.IsInf(t, 1) || dtMin < t-tOld || fp >= 0 {
+			break
+		}
+		dt := t - tOld
+
+		// Fix variable b at its bound.
+		var zb float64
+		gb := g[b]
+		if l.d[b] > 0 {
+			l.xc[b] = l.upper[b]
+		} else {
+			l.xc[b] = l.lower[b]
+		}
+		zb = l.xc[b] - x[b]
+
+		floats.AddScaled(l.c, dt, p)
+		wb := l.wRow(b)
+		l.mulM(mc, l.c)
+		l.mulM(mw, wb)
+		fp += dt*fpp + gb*gb + l.theta*gb*zb - gb*floats.Dot(wb, mc)
+		fpp -= l.theta*gb*gb + 2*gb*floats.Dot(wb, mp) + gb*gb*floats.Dot(wb, mw)
+		floats.AddScaled(p, gb, wb)
+		l.mulM(mp, p)
+		l.d[b] = 0
+		tOld = t
+		if fpp > 0 {
+			dtMin = -fp / fpp
+		} else {
+			dtMin = 0
+		}
+	}
+	dtMin = math.Max(dtMin, 0)
+	tOld += dtMin
+
+	// Move the remaining free variables along the last segment.
+	for ; j < len(free); j++ {
+		i := free[j]
+		l.xc[i] = x[i] + tOld*l.d[i]
+	}
+	floats.AddScaled(l.c, dtMin, p)
+}
+
+// subspaceMinimization minimizes the quadratic model over the variables that
+// are free at the Cauchy point with the remaining variables fixed at their
+// bounds, using the direct primal method of Byrd et al. (1995). The result,
+// truncated to the feasible region, overwrites l.xc.
+func (l *LBFGSB) subspaceMinimization(x, g []float64) {
+	var free []int
+	for i, xi := range l.xc {
+		if xi != l.lower[i] && xi != l.upper[i] {
+			free = append(free, i)
+		}
+	}
+	if len(free) == 0 {
+		return
+	}
+	k2 := 2 * l.k
+	theta := l.theta
+
+	du := make([]float64, len(free))
+	if len(free) == len(x) {
+		// No variable is fixed at the Cauchy point, so the minimizer of the
+		// model is x - H*g, where H = B⁻¹ is the inverse BFGS matrix. It is
+		// computed with the two-loop recursion, which is more accurate
+		// than the compact representation when B is badly conditioned.
+		l.twoLoop(du, g)
+		for i := range du {
+			du[i] = x[i] - du[i] - l.xc[i]
+		}
+		l.truncate(x, g, free, du)
+		return
+	}
+
+	// Reduced gradient of the model at the Cauchy point
+	//  r = Zᵀ(g + θ(xc - x) - W*M*c).
+	mc := make([]float64, k2)
+	l.mulM(mc, l.c)
+	r := make([]float64, len(free))
+	for j, i := range free {
+		r[j] = g[i] + theta*(l.xc[i]-x[i])
+		if k2 > 0 {
+			r[j] -= floats.Dot(l.wRow(i), mc)
+		}
+	}
+
+	for j := range du {
+		du[j] = -r[j] / theta
+	}
+	if k2 > 0 {
+		// Use the Sherman-Morrison-Woodbury formula to compute
+		//  du = -B̂⁻¹ r = -(1/θ) r - (1/θ²) ZᵀW (I - (1/θ) M WᵀZ ZᵀW)⁻¹ M WᵀZ r.
+		wtz := mat.NewDense(k2, len(free), nil)
+		for j, i := range free {
+			wtz.SetCol(j, l.wRow(i))
+		}
+		v := mat.NewVecDense(k2, nil)
+		v.MulVec(wtz, mat.NewVecDense(len(r), r))
+		v.MulVec(l.m, v)
+
+		var n mat.Dense
+		n.Mul(wtz, wtz.T())
+		n.Mul(l.m, &n)
+		n.Scale(-1/theta, &n)
+		for i := 0; i < k2; i++ {
+			n.Set(i, i, n.At(i, i)+1)
+		}
+		if err := v.SolveVec(&n, v); isSingular(err) {
+			// Keep the Cauchy point.
+			return
+		}
+		var wv mat.VecDense
+		wv.MulVec(wtz.T(), v)
+		for j := range du {
+			du[j] -= wv.AtVec(j) / (theta * theta)
+		}
+	}
+
+	l.truncate(x, g, free, du)
+}
+
+// truncate moves the free variables of the Cauchy point along du. Following
+// Morales and Nocedal (2011), the step is first projected onto the feasible
+// region, and if this does not result in a descent direction from x, the step
+// is instead truncated at the boundary of the feasible region.
+func (l *LBFGSB) truncate(x, g []float64, free []int, du []float64) {
+	var gd float64
+	for i, xi := range x {
+		gd += g[i] * (l.xc[i] - xi)
+	}
+	for j, i := range free {
+		gd += g[i] * (l.project(i, l.xc[i]+du[j]) - l.xc[i])
+	}
+	if gd < 0 {
+		for j, i := range free {
+			l.xc[i] = l.project(i, l.xc[i]+du[j])
+		}
+		return
+	}
+
+	alpha := 1.0
+	for j, i := range free {
+		switch {
+		case du[j] > 0:
+			alpha = math.Min(alpha, (l.upper[i]-l.xc[i])/du[j])
+		case du[j] < 0:
+			alpha = math.Min(alpha, (l.lower[i]-l.xc[i])/du[j])
+		}
+	}
+	for j, i := range free {
+		l.xc[i] = l.project(i, l.xc[i]+alpha*du[j])
+	}
+}
+
+// twoLoop stores H*g into dst, where H is the inverse of the limited-memory
+// BFGS matrix, using the two-loop recursion.
+//
+// See Nocedal, J., Wright, S.: Numerical Optimization (2nd ed). Springer (2006),
+// chapter 7, page 178.
+func (l *LBFGSB) twoLoop(dst, g []float64) {
+	copy(dst, g)
+	l.a = resize(l.a, l.k)
+	for i := l.k - 1; i >= 0; i-- {
+		l.a[i] = floats.Dot(l.s[i], dst) / floats.Dot(l.s[i], l.y[i])
+		floats.AddScaled(dst, -l.a[i], l.y[i])
+	}
+	floats.Scale(1/l.theta, dst)
+	for i := 0; i < l.k; i++ {
+		beta := floats.Dot(l.y[i], dst) / floats.Dot(l.s[i], l.y[i])
+		floats.AddScaled(dst, l.a[i]-beta, l.s[i])
+	}
+}
+
+// project returns v projected onto the feasible interval of the i-th variable.
+func (l *LBFGSB) project(i int, v float64) float64 {
+	return math.Max(l.lower[i], math.Min(v, l.upper[i]))
+}
+
+// projectLocation projects x onto the feasible region in place.
+func (l *LBFGSB) projectLocation(x []float64) {
+	for i, v := range x {
+		x[i] = l.project(i, v)
+	}
+}
+
+func (*LBFGSB) needs() struct {
+	Gradient bool
+	Hessian  bool
+} {
+	return struct {
+		Gradient bool
+		Hessian  bool
+	}{true, false}
+}
+
+const (
+	dlamchE = 1.0 / (1 << 53)
+
+	// lbfgsbMaxStep is the largest step allowed in the line search when the
+	// search direction does not hit a bound.
+	lbfgsbMaxStep = 1e10
+)
+
+// lbfgsbLinesearch is a MoreThuente linesearch that accepts steps that reach
+// the maximum step size with sufficient decrease. For LBFGSB the maximum step
+// corresponds to the boundary of the feasible region.
+type lbfgsbLinesearch struct {
+	MoreThuente
+
+	maxStep float64 // Largest feasible step along the current direction.
+}
+
+func (ls *lbfgsbLinesearch) Init(f, g float64, step float64) Operation {
+	ls.MinimumStep = 0
+	ls.MaximumStep = ls.maxStep
+	return ls.MoreThuente.Init(f, g, step)
+}
+
+func (ls *lbfgsbLinesearch) Iterate(f, g float64) (Operation, float64, error) {
+	if math.IsNaN(f) || math.IsInf(f, 1) || math.IsNaN(g) {
+		// The step left the domain of the objective function. Restart the
+		// linesearch with a shorter step.
+		step := ls.step / 2
+		if step < minimumBacktrackingStepSize {
+			return NoOperation, step, ErrLinesearcherFailure
+		}
+		op := ls.MoreThuente.Init(ls.fInit, ls.gInit, step)
+		return op, ls.step, nil
+	}
+	op, step, err := ls.MoreThuente.Iterate(f, g)
+	if err == ErrLinesearcherBound {
+		return MajorIteration, step, nil
+	}
+	return op, step, err
+}
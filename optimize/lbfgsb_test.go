@@ -0,0 +1,236 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/optimize/functions"
+)
+
+// boxQuadratic is the separable quadratic
+//
+//	f(x) = 1/2 * Σ_i a_i (x_i - c_i)²,
+//
+// whose minimizer subject to bounds is c projected onto the bounds.
+type boxQuadratic struct {
+	a, c []float64
+}
+
+func (q boxQuadratic) Func(x []float64) float64 {
+	var f float64
+	for i, v := range x {
+		d := v - q.c[i]
+		f += 0.5 * q.a[i] * d * d
+	}
+	return f
+}
+
+func (q boxQuadratic) Grad(grad, x []float64) {
+	for i, v := range x {
+		grad[i] = q.a[i] * (v - q.c[i])
+	}
+}
+
+func TestLBFGSBBounds(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	inf := math.Inf(1)
+
+	const dim = 20
+	quad := boxQuadratic{a: make([]float64, dim), c: make([]float64, dim)}
+	for i := range quad.a {
+		quad.a[i] = 1 + 99*rnd.Float64()
+		quad.c[i] = 4*rnd.Float64() - 2
+	}
+	quadLower := make([]float64, dim)
+	quadUpper := make([]float64, dim)
+	quadX := make([]float64, dim)
+	quadWant := make([]float64, dim)
+	for i := range quadLower {
+		quadLower[i] = -1
+		quadUpper[i] = 1
+		if i%5 == 0 {
+			quadUpper[i] = inf
+		}
+		if i%7 == 0 {
+			quadLower[i] = -inf
+		}
+		quadWant[i] = math.Max(quadLower[i], math.Min(quad.c[i], quadUpper[i]))
+	}
+
+	for cas, test := range []struct {
+		name         string
+		p            Problem
+		lower, upper []float64
+		x            []float64
+		want         []float64 // If nil, only the optimality conditions are checked.
+		gradTol      float64
+	}{
+		{
+			name:  "Quadratic",
+			p:     Problem{Func: quad.Func, Grad: quad.Grad},
+			lower: quadLower,
+			upper: quadUpper,
+			x:     quadX,
+			want:  quadWant,
+		},
+		{
+			name:  "QuadraticInfeasibleStart",
+			p:     Problem{Func: quad.Func, Grad: quad.Grad},
+			lower: quadLower,
+			upper: quadUpper,
+			x: func() []float64 {
+				x := make([]float64, dim)
+				for i := range x {
+					x[i] = 5 * float64(1-2*(i%2))
+				}
+				return x
+			}(),
+			want: quadWant,
+		},
+		{
+			name:  "QuadraticLowerOnly",
+			p:     Problem{Func: quad.Func, Grad: quad.Grad},
+			lower: make([]float64, dim),
+			x:     make([]float64, dim),
+			want: func() []float64 {
+				w := make([]float64, dim)
+				for i, v := range quad.c {
+					w[i] = math.Max(0, v)
+				}
+				return w
+			}(),
+		},
+		{
+			name:  "ExtendedRosenbrockActiveUpper",
+			p:     Problem{Func: functions.ExtendedRosenbrock{}.Func, Grad: functions.ExtendedRosenbrock{}.Grad},
+			lower: []float64{-2, -2},
+			upper: []float64{0.5, 2},
+			x:     []float64{-1.2, 1},
+			want:  []float64{0.5, 0.25},
+		},
+		{
+			name:  "ExtendedRosenbrockInactive",
+			p:     Problem{Func: functions.ExtendedRosenbrock{}.Func, Grad: functions.ExtendedRosenbrock{}.Grad},
+			lower: []float64{-2, -2, -2, -2},
+			upper: []float64{2, 2, 2, 2},
+			x:     []float64{-1.2, 1, -1.2, 1},
+			want:  []float64{1, 1, 1, 1},
+		},
+		{
+			name:    "ExtendedRosenbrockMixed",
+			p:       Problem{Func: functions.ExtendedRosenbrock{}.Func, Grad: functions.ExtendedRosenbrock{}.Grad},
+			lower:   []float64{-inf, 1.5, -inf, -0.5, 0, -1},
+			upper:   []float64{inf, inf, 0.8, 0.5, inf, 1},
+			x:       []float64{0, 2, 0, 0, 0.5, 0.5},
+			gradTol: 1e-10,
+		},
+		{
+			name:  "BealeFixedVariable",
+			p:     Problem{Func: functions.Beale{}.Func, Grad: functions.Beale{}.Grad},
+			lower: []float64{2, 0.25},
+			upper: []float64{2, 1},
+			x:     []float64{2, 1},
+		},
+	} {
+		gradTol := test.gradTol
+		if gradTol == 0 {
+			gradTol = 1e-12
+		}
+		x := make([]float64, len(test.x))
+		copy(x, test.x)
+
+		// Check that the objective function is only evaluated at feasible
+		// locations.
+		var infeasible bool
+		feasible := func(x []float64) {
+			for i, v := range x {
+				if test.lower != nil && v < test.lower[i] {
+					infeasible = true
+				}
+				if test.upper != nil && v > test.upper[i] {
+					infeasible = true
+				}
+			}
+		}
+		p := Problem{
+			Func: func(x []float64) float64 {
+				feasible(x)
+				return test.p.Func(x)
+			},
+			Grad: func(grad, x []float64) {
+				feasible(x)
+				test.p.Grad(grad, x)
+			},
+		}
+
+		method := &LBFGSB{
+			Lower:             test.lower,
+			Upper:             test.upper,
+			GradStopThreshold: gradTol,
+		}
+		result, err := Minimize(p, x, &Settings{Converger: NeverTerminate{}}, method)
+		if err != nil {
+			t.Errorf("cas %d (%s): unexpected error: %v", cas, test.name, err)
+			continue
+		}
+		if result.Status != GradientThreshold {
+			t.Errorf("cas %d (%s): unexpected status: got %v, want %v", cas, test.name, result.Status, GradientThreshold)
+		}
+		if infeasible {
+			t.Errorf("cas %d (%s): objective function evaluated at infeasible location", cas, test.name)
+		}
+		if !floats.Equal(x, test.x) {
+			t.Errorf("cas %d (%s): initial location modified", cas, test.name)
+		}
+		feasible(result.X)
+		if infeasible {
+			t.Errorf("cas %d (%s): optimum is not feasible: %v", cas, test.name, result.X)
+		}
+
+		// Check the first-order optimality conditions: the gradient must vanish
+		// for variables strictly between the bounds, and must point into the
+		// feasible region for variables at a bound.
+		pg := method.projectedGradient(&result.Location)
+		if norm := floats.Norm(pg, math.Inf(1)); norm >= gradTol {
+			t.Errorf("cas %d (%s): projected gradient norm %v not below %v", cas, test.name, norm, gradTol)
+		}
+		if test.want != nil && !floats.EqualApprox(result.X, test.want, 1e-8) {
+			t.Errorf("cas %d (%s): unexpected optimum: got %v, want %v", cas, test.name, result.X, test.want)
+		}
+	}
+}
+
+func TestLBFGSBPanics(t *testing.T) {
+	t.Parallel()
+	p := Problem{
+		Func: functions.ExtendedRosenbrock{}.Func,
+		Grad: functions.ExtendedRosenbrock{}.Grad,
+	}
+	for _, method := range []*LBFGSB{
+		{Lower: []float64{0}},
+		{Upper: []float64{0, 0, 0}},
+		{Lower: []float64{1, 0}, Upper: []float64{0, 0}},
+		{Lower: []float64{math.NaN(), 0}},
+	} {
+		if !panics(func() { Minimize(p, []float64{0, 0}, nil, method) }) {
+			t.Errorf("expected panic for bounds %v, %v", method.Lower, method.Upper)
+		}
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		r := recover()
+		panicked = r != nil
+	}()
+	fn()
+	return
+}
@@ -0,0 +1,11 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package leastsq implements routines for solving nonlinear least-squares
+// problems
+//
+//	minimize 1/2 * Σ_i r_i(x)²,
+//
+// where r is a vector-valued residual function.
+package leastsq // import "gonum.org/v1/gonum/optimize/leastsq"
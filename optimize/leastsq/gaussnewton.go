@@ -0,0 +1,108 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package leastsq
+
+import (
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+var _ Method = (*GaussNewton)(nil)
+
+const (
+	defaultGaussNewtonDecrease    = 1e-4
+	defaultGaussNewtonContraction = 0.5
+	minimumGaussNewtonStepSize    = 1e-20
+)
+
+// GaussNewton implements the Gauss-Newton method for nonlinear least-squares
+// problems. At each iteration the search direction p is the minimum-norm
+// solution of the linear least-squares problem
+//
+//	minimize |J*p + r|,
+//
+// so rank-deficient Jacobians are handled. The step along p is found by a
+// backtracking line search that satisfies the Armijo condition.
+//
+// GaussNewton converges quickly for problems with small residuals at the
+// solution, but may fail to converge if the residuals are large or strongly
+// nonlinear, in which case LevenbergMarquardt is more robust.
+type GaussNewton struct {
+	// DecreaseFactor is the constant factor in the sufficient decrease
+	// (Armijo) condition. It must be between zero and one. If
+	// DecreaseFactor is zero, it is defaulted to 1e-4.
+	DecreaseFactor float64
+	// ContractionFactor is the step size multiplier at each iteration of
+	// the line search. It must be between zero and one. If ContractionFactor
+	// is zero, it is defaulted to 0.5.
+	ContractionFactor float64
+
+	negR *mat.VecDense
+	p    mat.Dense
+	grad []float64
+	xNew []float64
+	rNew []float64
+}
+
+// Init initializes the method. It implements the Method interface.
+func (gn *GaussNewton) Init(m int, x []float64) {
+	if gn.DecreaseFactor == 0 {
+		gn.DecreaseFactor = defaultGaussNewtonDecrease
+	}
+	if gn.ContractionFactor == 0 {
+		gn.ContractionFactor = defaultGaussNewtonContraction
+	}
+	if gn.DecreaseFactor <= 0 || gn.DecreaseFactor >= 1 {
+		panic("gaussnewton: DecreaseFactor must be between 0 and 1")
+	}
+	if gn.ContractionFactor <= 0 || gn.ContractionFactor >= 1 {
+		panic("gaussnewton: ContractionFactor must be between 0 and 1")
+	}
+	n := len(x)
+	gn.negR = mat.NewVecDense(m, nil)
+	gn.grad = make([]float64, n)
+	gn.xNew = make([]float64, n)
+	gn.rNew = make([]float64, m)
+}
+
+// Iterate performs an iteration of the method. It implements the Method
+// interface.
+func (gn *GaussNewton) Iterate(loc *Location, eval Evaluator) (optimize.Status, error) {
+	m, n := loc.Jacobian.Dims()
+	for i, ri := range loc.Residuals {
+		gn.negR.SetVec(i, -ri)
+	}
+	if _, err := mat.LstSq(&gn.p, loc.Jacobian, gn.negR, 0); err != nil {
+		return optimize.Failure, err
+	}
+	p := gn.p.RawMatrix().Data
+
+	// The directional derivative of the cost along p is gᵀp with g = Jᵀr.
+	g := mat.NewVecDense(n, gn.grad)
+	g.MulVec(loc.Jacobian.T(), mat.NewVecDense(m, loc.Residuals))
+	slope := floats.Dot(gn.grad, p)
+	if slope >= 0 {
+		return optimize.Failure, optimize.ErrNonDescentDirection
+	}
+
+	for step := 1.0; step >= minimumGaussNewtonStepSize; step *= gn.ContractionFactor {
+		floats.AddScaledTo(gn.xNew, loc.X, step, p)
+		if floats.Equal(gn.xNew, loc.X) {
+			return optimize.StepConvergence, nil
+		}
+		if err := eval(gn.rNew, gn.xNew); err != nil {
+			return optimize.NotTerminated, err
+		}
+		costNew := cost(gn.rNew)
+		if costNew <= loc.Cost+gn.DecreaseFactor*step*slope {
+			copy(loc.X, gn.xNew)
+			copy(loc.Residuals, gn.rNew)
+			loc.Cost = costNew
+			return optimize.NotTerminated, nil
+		}
+	}
+	return optimize.Failure, optimize.ErrLinesearcherFailure
+}
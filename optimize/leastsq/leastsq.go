@@ -0,0 +1,351 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package leastsq
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+const defaultTolerance = 1e-8
+
+// errEvaluationLimit is returned by Evaluator when the limit on the number of
+// residual evaluations has been reached.
+var errEvaluationLimit = errors.New("leastsq: evaluation limit reached")
+
+// Problem describes a nonlinear least-squares problem
+//
+//	minimize 1/2 * Σ_i r_i(x)²
+//
+// with m residuals r_i and n parameters x.
+type Problem struct {
+	// Residuals evaluates the residual vector r at x and stores the result
+	// in dst. Residuals must not modify x.
+	Residuals func(dst, x []float64)
+
+	// Jacobian evaluates the m×n Jacobian matrix of the residuals at x,
+	//  J_{i,j} = ∂r_i/∂x_j,
+	// and stores the result in dst. Jacobian must not modify x.
+	// If Jacobian is nil, the Jacobian is approximated with finite differences
+	// using fd.Jacobian.
+	Jacobian func(dst *mat.Dense, x []float64)
+
+	// M is the number of residuals. It must be positive.
+	M int
+}
+
+// Location represents a location in the parameter space together with the
+// quantities evaluated there.
+type Location struct {
+	// X is the parameter vector.
+	X []float64
+	// Residuals is the residual vector at X.
+	Residuals []float64
+	// Cost is half the squared norm of Residuals.
+	Cost float64
+	// Jacobian is the Jacobian matrix of the residuals at X.
+	Jacobian *mat.Dense
+}
+
+// Evaluator evaluates the residuals at x and stores them in dst. It returns
+// a non-nil error if the evaluation was not performed because a limit on the
+// number of evaluations was reached. Methods must return such an error
+// immediately.
+type Evaluator func(dst, x []float64) error
+
+// Method is an iterative method for solving nonlinear least-squares problems.
+type Method interface {
+	// Init initializes the method for a problem with m residuals and the
+	// initial parameters x. Init may modify x, for example to move it into
+	// the feasible region of the method.
+	Init(m int, x []float64)
+
+	// Iterate performs a single iteration of the method. On entry, all fields
+	// of loc are valid at loc.X. Iterate evaluates the residuals at trial
+	// locations using eval and, if a step is accepted, updates loc.X,
+	// loc.Residuals and loc.Cost to the new location. The Jacobian is
+	// updated by the caller.
+	//
+	// Iterate returns optimize.NotTerminated if a step has been accepted. If
+	// the method cannot make further progress, Iterate returns a different
+	// Status and leaves loc unchanged.
+	Iterate(loc *Location, eval Evaluator) (optimize.Status, error)
+}
+
+// Settings holds the settings for Minimize.
+type Settings struct {
+	// GradientThreshold stops the optimization with GradientThreshold status
+	// if the infinity norm of the gradient Jᵀr of the cost is less than this
+	// value. For bound-constrained methods the projected gradient is used.
+	// If GradientThreshold is zero it is defaulted to 1e-8, and if it is NaN
+	// the setting is not used.
+	GradientThreshold float64
+
+	// FunctionTolerance stops the optimization with FunctionConvergence
+	// status if an iteration decreases the cost by less than
+	// FunctionTolerance times the previous cost. If FunctionTolerance is zero
+	// it is defaulted to 1e-8, and if it is NaN the setting is not used.
+	FunctionTolerance float64
+
+	// StepTolerance stops the optimization with StepConvergence status if the
+	// norm of the step dx taken in an iteration satisfies
+	//  |dx| < StepTolerance * (StepTolerance + |x|).
+	// If StepTolerance is zero it is defaulted to 1e-8, and if it is NaN the
+	// setting is not used.
+	StepTolerance float64
+
+	// MajorIterations is the maximum number of iterations. If it is zero,
+	// the number of iterations is not limited.
+	MajorIterations int
+
+	// FuncEvaluations is the maximum number of evaluations of the residuals.
+	// Evaluations performed by the finite difference approximation of the
+	// Jacobian are not counted. If it is zero, the number of evaluations is
+	// not limited.
+	FuncEvaluations int
+
+	// JacobianSettings holds the settings of the finite difference
+	// approximation of the Jacobian when Problem.Jacobian is nil.
+	JacobianSettings *fd.JacobianSettings
+
+	// Recorder records the progress of the optimization. The Location passed
+	// to the Recorder holds the parameters in X, the cost in F and its
+	// gradient Jᵀr in Gradient.
+	Recorder optimize.Recorder
+}
+
+// Result represents the answer of a nonlinear least-squares optimization.
+type Result struct {
+	// Location is the best location found.
+	Location
+
+	// Covariance is the estimate of the covariance matrix of the parameters
+	//  s² (JᵀJ)⁻¹,
+	// computed from the Jacobian at the solution, where s² = |r|²/(m-n) is
+	// the residual variance. Covariance is nil if m <= n or if the Jacobian
+	// does not have full column rank.
+	Covariance *mat.SymDense
+
+	// Stats holds the statistics of the optimization. FuncEvaluations counts
+	// the evaluations of the residuals and GradEvaluations counts the
+	// evaluations of the Jacobian.
+	optimize.Stats
+
+	// Status is the status of the optimization.
+	Status optimize.Status
+}
+
+// gradientProjector is implemented by methods whose iterates are restricted to
+// a feasible region. Convergence of such methods is tested using the
+// projection of the gradient onto the feasible region.
+type gradientProjector interface {
+	projectGradient(grad, x []float64)
+}
+
+// Minimize finds a local minimizer of the nonlinear least-squares problem p
+// starting at initX using method. If method is nil, LevenbergMarquardt is
+// used. If settings is nil, the zero value is used.
+//
+// Minimize returns the best location found, the covariance estimate of the
+// parameters at that location and the reason for termination. An error is
+// returned if the Recorder fails or if the initial residuals are not finite.
+func Minimize(p Problem, initX []float64, settings *Settings, method Method) (*Result, error) {
+	startTime := time.Now()
+	if p.Residuals == nil {
+		panic("leastsq: residual function is undefined")
+	}
+	if p.M <= 0 {
+		panic("leastsq: non-positive number of residuals")
+	}
+	n := len(initX)
+	if n == 0 {
+		return nil, optimize.ErrZeroDimensional
+	}
+	if settings == nil {
+		settings = &Settings{}
+	}
+	if method == nil {
+		method = &LevenbergMarquardt{}
+	}
+	gradTol := defaultTol(settings.GradientThreshold)
+	funcTol := defaultTol(settings.FunctionTolerance)
+	stepTol := defaultTol(settings.StepTolerance)
+
+	m := p.M
+	loc := &Location{
+		X:         make([]float64, n),
+		Residuals: make([]float64, m),
+		Jacobian:  mat.NewDense(m, n, nil),
+	}
+	copy(loc.X, initX)
+	method.Init(m, loc.X)
+
+	var stats optimize.Stats
+	eval := func(dst, x []float64) error {
+		if settings.FuncEvaluations > 0 && stats.FuncEvaluations >= settings.FuncEvaluations {
+			return errEvaluationLimit
+		}
+		stats.FuncEvaluations++
+		p.Residuals(dst, x)
+		return nil
+	}
+	jacobian := func() {
+		stats.GradEvaluations++
+		if p.Jacobian != nil {
+			p.Jacobian(loc.Jacobian, loc.X)
+			return
+		}
+		var s fd.JacobianSettings
+		if settings.JacobianSettings != nil {
+			s = *settings.JacobianSettings
+		}
+		if s.OriginValue == nil {
+			s.OriginValue = loc.Residuals
+		}
+		fd.Jacobian(loc.Jacobian, p.Residuals, loc.X, &s)
+	}
+
+	grad := make([]float64, n)
+	gradient := func() {
+		g := mat.NewVecDense(n, grad)
+		g.MulVec(loc.Jacobian.T(), mat.NewVecDense(m, loc.Residuals))
+		if gp, ok := method.(gradientProjector); ok {
+			gp.projectGradient(grad, loc.X)
+		}
+	}
+	optLoc := &optimize.Location{X: loc.X, Gradient: grad}
+	record := func(op optimize.Operation) error {
+		if settings.Recorder == nil {
+			return nil
+		}
+		optLoc.F = loc.Cost
+		stats.Runtime = time.Since(startTime)
+		return settings.Recorder.Record(optLoc, op, &stats)
+	}
+	if settings.Recorder != nil {
+		if err := settings.Recorder.Init(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := eval(loc.Residuals, loc.X); err != nil {
+		return nil, err
+	}
+	loc.Cost = cost(loc.Residuals)
+	if math.IsInf(loc.Cost, 1) || math.IsNaN(loc.Cost) {
+		return nil, optimize.ErrFunc(loc.Cost)
+	}
+	jacobian()
+	gradient()
+	if err := record(optimize.InitIteration); err != nil {
+		return nil, err
+	}
+
+	var (
+		status optimize.Status
+		err    error
+	)
+	xOld := make([]float64, n)
+	for {
+		if !math.IsNaN(gradTol) && floats.Norm(grad, math.Inf(1)) < gradTol {
+			status = optimize.GradientThreshold
+			break
+		}
+		if settings.MajorIterations > 0 && stats.MajorIterations >= settings.MajorIterations {
+			status = optimize.IterationLimit
+			break
+		}
+
+		copy(xOld, loc.X)
+		costOld := loc.Cost
+		status, err = method.Iterate(loc, eval)
+		if err == errEvaluationLimit {
+			status, err = optimize.FunctionEvaluationLimit, nil
+		}
+		if status != optimize.NotTerminated || err != nil {
+			break
+		}
+		stats.MajorIterations++
+		jacobian()
+		gradient()
+		if err = record(optimize.MajorIteration); err != nil {
+			status = optimize.Failure
+			break
+		}
+
+		if !math.IsNaN(funcTol) && costOld-loc.Cost < funcTol*costOld {
+			status = optimize.FunctionConvergence
+			break
+		}
+		if !math.IsNaN(stepTol) {
+			dx := floats.Distance(loc.X, xOld, 2)
+			if dx < stepTol*(stepTol+floats.Norm(loc.X, 2)) {
+				status = optimize.StepConvergence
+				break
+			}
+		}
+	}
+	if settings.Recorder != nil && err == nil {
+		err = record(optimize.PostIteration)
+	}
+	stats.Runtime = time.Since(startTime)
+
+	return &Result{
+		Location:   *loc,
+		Covariance: covariance(loc.Jacobian, loc.Residuals),
+		Stats:      stats,
+		Status:     status,
+	}, err
+}
+
+func defaultTol(tol float64) float64 {
+	if tol == 0 {
+		return defaultTolerance
+	}
+	return tol
+}
+
+// cost returns half the squared norm of r.
+func cost(r []float64) float64 {
+	norm := floats.Norm(r, 2)
+	return 0.5 * norm * norm
+}
+
+// covariance returns the estimate of the covariance matrix of the parameters
+// s² (JᵀJ)⁻¹ where s² = |r|²/(m-n). It returns nil if m <= n or if the
+// Jacobian does not have full column rank.
+func covariance(jac *mat.Dense, r []float64) *mat.SymDense {
+	m, n := jac.Dims()
+	if m <= n {
+		return nil
+	}
+	var svd mat.SVD
+	if !svd.Factorize(jac, mat.SVDThin) {
+		return nil
+	}
+	s := svd.Values(nil)
+	if s[n-1] <= float64(m)*s[0]*dlamchE {
+		return nil
+	}
+	var v mat.Dense
+	svd.VTo(&v)
+	for j, sj := range s {
+		col := v.ColView(j).(*mat.VecDense)
+		col.ScaleVec(1/sj, col)
+	}
+	norm := floats.Norm(r, 2)
+	variance := norm * norm / float64(m-n)
+	c := mat.NewSymDense(n, nil)
+	c.SymOuterK(variance, &v)
+	return c
+}
+
+const dlamchE = 1.0 / (1 << 53)
@@ -0,0 +1,335 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package leastsq
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+// rosenbrock returns the Rosenbrock function written as a least-squares
+// problem.
+func rosenbrock() Problem {
+	return Problem{
+		Residuals: func(dst, x []float64) {
+			dst[0] = 10 * (x[1] - x[0]*x[0])
+			dst[1] = 1 - x[0]
+		},
+		Jacobian: func(dst *mat.Dense, x []float64) {
+			dst.Set(0, 0, -20*x[0])
+			dst.Set(0, 1, 10)
+			dst.Set(1, 0, -1)
+			dst.Set(1, 1, 0)
+		},
+		M: 2,
+	}
+}
+
+// exponential returns the problem of fitting the model
+//
+//	y(t) = x_0 * exp(-x_1 * t) + x_2
+//
+// to noise-free data generated with the parameters want.
+func exponential(want []float64) Problem {
+	const m = 20
+	t := make([]float64, m)
+	y := make([]float64, m)
+	for i := range t {
+		t[i] = 0.25 * float64(i)
+		y[i] = want[0]*math.Exp(-want[1]*t[i]) + want[2]
+	}
+	return Problem{
+		Residuals: func(dst, x []float64) {
+			for i, ti := range t {
+				dst[i] = x[0]*math.Exp(-x[1]*ti) + x[2] - y[i]
+			}
+		},
+		Jacobian: func(dst *mat.Dense, x []float64) {
+			for i, ti := range t {
+				e := math.Exp(-x[1] * ti)
+				dst.Set(i, 0, e)
+				dst.Set(i, 1, -x[0]*ti*e)
+				dst.Set(i, 2, 1)
+			}
+		},
+		M: m,
+	}
+}
+
+func methods() []struct {
+	name   string
+	method func() Method
+} {
+	return []struct {
+		name   string
+		method func() Method
+	}{
+		{"GaussNewton", func() Method { return &GaussNewton{} }},
+		{"LevenbergMarquardt", func() Method { return &LevenbergMarquardt{} }},
+		{"LevenbergMarquardtGeodesic", func() Method { return &LevenbergMarquardt{Geodesic: true} }},
+		{"TrustRegionReflective", func() Method { return &TrustRegionReflective{} }},
+	}
+}
+
+func TestMinimize(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name   string
+		p      Problem
+		x      []float64
+		want   []float64
+		skipGN bool
+	}{
+		{
+			name: "Rosenbrock",
+			p:    rosenbrock(),
+			x:    []float64{-1.2, 1},
+			want: []float64{1, 1},
+		},
+		{
+			name: "Exponential",
+			p:    exponential([]float64{5, 1.5, 1}),
+			x:    []float64{1, 1, 0},
+			want: []float64{5, 1.5, 1},
+		},
+		{
+			name:   "ExponentialFarStart",
+			p:      exponential([]float64{5, 1.5, 1}),
+			x:      []float64{1, 10, 0},
+			want:   []float64{5, 1.5, 1},
+			skipGN: true,
+		},
+	} {
+		for _, method := range methods() {
+			if test.skipGN && method.name == "GaussNewton" {
+				continue
+			}
+			for _, fd := range []bool{false, true} {
+				name := fmt.Sprintf("%s/%s/fd=%t", test.name, method.name, fd)
+				p := test.p
+				tol := 1e-8
+				if fd {
+					p.Jacobian = nil
+					tol = 1e-5
+				}
+				x := make([]float64, len(test.x))
+				copy(x, test.x)
+				settings := &Settings{
+					GradientThreshold: 1e-12,
+					FunctionTolerance: math.NaN(),
+					StepTolerance:     1e-12,
+					MajorIterations:   1000,
+				}
+				result, err := Minimize(p, x, settings, method.method())
+				if err != nil {
+					t.Errorf("%s: unexpected error: %v", name, err)
+					continue
+				}
+				if !floats.Equal(x, test.x) {
+					t.Errorf("%s: initial location modified", name)
+				}
+				switch result.Status {
+				case optimize.GradientThreshold, optimize.StepConvergence:
+				default:
+					t.Errorf("%s: unexpected status %v", name, result.Status)
+				}
+				if !floats.EqualApprox(result.X, test.want, tol) {
+					t.Errorf("%s: unexpected solution: got %v, want %v", name, result.X, test.want)
+				}
+				r := make([]float64, p.M)
+				p.Residuals(r, result.X)
+				if !floats.Equal(r, result.Residuals) {
+					t.Errorf("%s: residuals do not match the solution", name)
+				}
+				if c := cost(r); c != result.Cost {
+					t.Errorf("%s: unexpected cost: got %v, want %v", name, result.Cost, c)
+				}
+			}
+		}
+	}
+}
+
+func TestTrustRegionReflectiveBounds(t *testing.T) {
+	t.Parallel()
+	inf := math.Inf(1)
+	for cas, test := range []struct {
+		p            Problem
+		lower, upper []float64
+		x            []float64
+		want         []float64
+		gradTol      float64
+	}{
+		{
+			p:     rosenbrock(),
+			upper: []float64{0.5, inf},
+			x:     []float64{-1.2, 1},
+			want:  []float64{0.5, 0.25},
+		},
+		{
+			p:     rosenbrock(),
+			lower: []float64{-inf, 1.5},
+			x:     []float64{2, 2},
+			want:  []float64{1.224370748736, 1.5},
+		},
+		{
+			// The solution lies in the interior.
+			p:     rosenbrock(),
+			lower: []float64{0, 0},
+			upper: []float64{2, 2},
+			x:     []float64{0, 2},
+			want:  []float64{1, 1},
+		},
+		{
+			// The initial point is infeasible. The residuals are large at
+			// the solution, so the cost can be decreased only until the
+			// gradient is about sqrt(eps) times the cost.
+			p:       exponential([]float64{5, 1.5, 1}),
+			lower:   []float64{0, 0, 2},
+			upper:   []float64{10, 10, 3},
+			x:       []float64{1, 1, 0},
+			gradTol: 1e-6,
+		},
+	} {
+		var infeasible bool
+		p := test.p
+		residuals := p.Residuals
+		p.Residuals = func(dst, x []float64) {
+			for i, v := range x {
+				if test.lower != nil && v <= test.lower[i] {
+					infeasible = true
+				}
+				if test.upper != nil && v >= test.upper[i] {
+					infeasible = true
+				}
+			}
+			residuals(dst, x)
+		}
+		gradTol := test.gradTol
+		if gradTol == 0 {
+			gradTol = 1e-10
+		}
+		settings := &Settings{
+			GradientThreshold: gradTol,
+			FunctionTolerance: math.NaN(),
+			StepTolerance:     math.NaN(),
+			MajorIterations:   1000,
+		}
+		method := &TrustRegionReflective{Lower: test.lower, Upper: test.upper}
+		result, err := Minimize(p, test.x, settings, method)
+		if err != nil {
+			t.Errorf("cas %d: unexpected error: %v", cas, err)
+			continue
+		}
+		if result.Status != optimize.GradientThreshold {
+			t.Errorf("cas %d: unexpected status %v", cas, result.Status)
+		}
+		if infeasible {
+			t.Errorf("cas %d: residuals evaluated at a location that is not strictly feasible", cas)
+		}
+		if test.want != nil && !floats.EqualApprox(result.X, test.want, 1e-8) {
+			t.Errorf("cas %d: unexpected solution: got %v, want %v", cas, result.X, test.want)
+		}
+		// Check the first-order optimality conditions.
+		g := make([]float64, len(result.X))
+		mat.NewVecDense(len(g), g).MulVec(result.Jacobian.T(), mat.NewVecDense(p.M, result.Residuals))
+		method.projectGradient(g, result.X)
+		if norm := floats.Norm(g, math.Inf(1)); norm >= gradTol {
+			t.Errorf("cas %d: projected gradient norm %v too large", cas, norm)
+		}
+	}
+}
+
+func TestCovariance(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	const (
+		m = 50
+		n = 3
+	)
+	// A linear model y = A*x + noise has the exact covariance estimate
+	//  s² (AᵀA)⁻¹.
+	a := mat.NewDense(m, n, nil)
+	y := make([]float64, m)
+	for i := 0; i < m; i++ {
+		ti := float64(i) / m
+		a.SetRow(i, []float64{1, ti, ti * ti})
+		y[i] = 1 + 2*ti - 3*ti*ti + 0.1*rnd.NormFloat64()
+	}
+	p := Problem{
+		Residuals: func(dst, x []float64) {
+			r := mat.NewVecDense(m, dst)
+			r.MulVec(a, mat.NewVecDense(n, x))
+			floats.Sub(dst, y)
+		},
+		M: m,
+	}
+	for _, method := range methods() {
+		result, err := Minimize(p, make([]float64, n), nil, method.method())
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", method.name, err)
+		}
+
+		var want mat.VecDense
+		err = want.SolveVec(a, mat.NewVecDense(m, y))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !floats.EqualApprox(result.X, want.RawVector().Data, 1e-6) {
+			t.Errorf("%s: unexpected solution: got %v, want %v", method.name, result.X, want.RawVector().Data)
+		}
+
+		var ata mat.SymDense
+		ata.SymOuterK(1, a.T())
+		var cov mat.Dense
+		err = cov.Inverse(&ata)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		rss := 2 * result.Cost
+		cov.Scale(rss/(m-n), &cov)
+		if result.Covariance == nil {
+			t.Errorf("%s: missing covariance", method.name)
+			continue
+		}
+		if !mat.EqualApprox(result.Covariance, &cov, 1e-6) {
+			t.Errorf("%s: unexpected covariance:\ngot  %v\nwant %v", method.name,
+				mat.Formatted(result.Covariance), mat.Formatted(&cov))
+		}
+	}
+
+	// The covariance is not available for a square problem.
+	result, err := Minimize(rosenbrock(), []float64{-1.2, 1}, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Covariance != nil {
+		t.Errorf("unexpected covariance for a square problem")
+	}
+}
+
+func TestEvaluationLimit(t *testing.T) {
+	t.Parallel()
+	for _, method := range methods() {
+		settings := &Settings{FuncEvaluations: 5}
+		result, err := Minimize(rosenbrock(), []float64{-1.2, 1}, settings, method.method())
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", method.name, err)
+			continue
+		}
+		if result.Status != optimize.FunctionEvaluationLimit {
+			t.Errorf("%s: unexpected status %v", method.name, result.Status)
+		}
+		if result.FuncEvaluations != 5 {
+			t.Errorf("%s: unexpected number of evaluations: got %d, want 5", method.name, result.FuncEvaluations)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package leastsq
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+var _ Method = (*LevenbergMarquardt)(nil)
+
+// LevenbergMarquardt implements the Levenberg-Marquardt method for nonlinear
+// least-squares problems. At each iteration the step v solves the damped
+// linear least-squares problem
+//
+//	minimize |J*v + r|² + μ |D*v|²,
+//
+// where D is a diagonal scaling matrix formed from the column norms of the
+// Jacobian and μ is the damping parameter that is adjusted depending on the
+// agreement between the actual and the predicted decrease of the cost.
+//
+// If Geodesic is true, the step is corrected with the geodesic acceleration
+// of Transtrum and Sethna, which accounts for the curvature of the residuals
+// along the step and often reduces the number of iterations for problems with
+// large residual curvature.
+//
+// References:
+//   - Nielsen, H.B.: Damping parameter in Marquardt's method. Technical Report
+//     IMM-REP-1999-05, Technical University of Denmark (1999)
+//   - Transtrum, M.K., Sethna, J.P.: Improvements to the Levenberg-Marquardt
+//     algorithm for nonlinear least-squares minimization. arXiv:1201.5885 (2012)
+type LevenbergMarquardt struct {
+	// InitialDamping is the initial damping parameter relative to the largest
+	// squared column norm of the Jacobian. If InitialDamping is zero, it is
+	// defaulted to 1e-3.
+	InitialDamping float64
+
+	// Geodesic specifies whether geodesic acceleration is used.
+	Geodesic bool
+	// AccelerationRatio is the largest allowed ratio 2|D*a|/|D*v| between the
+	// acceleration a and the velocity v. Steps with a larger ratio are
+	// rejected. If AccelerationRatio is zero, it is defaulted to 0.75.
+	AccelerationRatio float64
+	// GeodesicStep is the step size of the finite difference approximation
+	// of the second directional derivative of the residuals along the
+	// velocity. If GeodesicStep is zero, it is defaulted to 0.1.
+	GeodesicStep float64
+
+	mu   float64   // Damping parameter
+	nu   float64   // Growth factor of the damping parameter
+	diag []float64 // Diagonal scaling D
+
+	aug  *mat.Dense // Augmented matrix [J; sqrt(μ)*D]
+	rhs  *mat.VecDense
+	v    mat.Dense
+	a    mat.Dense
+	xNew []float64
+	rNew []float64
+	jv   []float64
+}
+
+// Init initializes the method. It implements the Method interface.
+func (lm *LevenbergMarquardt) Init(m int, x []float64) {
+	n := len(x)
+	if lm.InitialDamping == 0 {
+		lm.InitialDamping = 1e-3
+	}
+	if lm.AccelerationRatio == 0 {
+		lm.AccelerationRatio = 0.75
+	}
+	if lm.GeodesicStep == 0 {
+		lm.GeodesicStep = 0.1
+	}
+	lm.mu = -1 // Set on the first iteration.
+	lm.nu = 2
+	lm.diag = make([]float64, n)
+	lm.aug = mat.NewDense(m+n, n, nil)
+	lm.rhs = mat.NewVecDense(m+n, nil)
+	lm.xNew = make([]float64, n)
+	lm.rNew = make([]float64, m)
+	lm.jv = make([]float64, m)
+}
+
+// Iterate performs an iteration of the method. It implements the Method
+// interface.
+func (lm *LevenbergMarquardt) Iterate(loc *Location, eval Evaluator) (optimize.Status, error) {
+	m, n := loc.Jacobian.Dims()
+	j := loc.Jacobian
+
+	// Update the scaling matrix with the column norms of the Jacobian. The
+	// scaling is never decreased, as in MINPACK.
+	var maxDiag float64
+	for k := range lm.diag {
+		norm := mat.Norm(j.ColView(k), 2)
+		lm.diag[k] = math.Max(lm.diag[k], norm)
+		if lm.diag[k] == 0 {
+			lm.diag[k] = 1
+		}
+		maxDiag = math.Max(maxDiag, lm.diag[k])
+	}
+	if lm.mu < 0 {
+		lm.mu = lm.InitialDamping * maxDiag * maxDiag
+	}
+	lm.aug.Slice(0, m, 0, n).(*mat.Dense).Copy(j)
+
+	for {
+		// Compute the velocity from the damped linear least-squares problem.
+		sqrtMu := math.Sqrt(lm.mu)
+		for k, d := range lm.diag {
+			lm.aug.Set(m+k, k, sqrtMu*d)
+		}
+		for i, ri := range loc.Residuals {
+			lm.rhs.SetVec(i, -ri)
+		}
+		for k := 0; k < n; k++ {
+			lm.rhs.SetVec(m+k, 0)
+		}
+		if _, err := mat.LstSq(&lm.v, lm.aug, lm.rhs, 0); err != nil {
+			return optimize.Failure, err
+		}
+		v := lm.v.RawMatrix().Data
+		step := v
+
+		if lm.Geodesic {
+			// Approximate the second directional derivative of the residuals
+			// along v and compute the acceleration.
+			h := lm.GeodesicStep
+			floats.AddScaledTo(lm.xNew, loc.X, h, v)
+			if err := eval(lm.rNew, lm.xNew); err != nil {
+				return optimize.NotTerminated, err
+			}
+			jvVec := mat.NewVecDense(m, lm.jv)
+			jvVec.MulVec(j, mat.NewVecDense(n, v))
+			for i := range lm.rNew {
+				rvv := 2 / h * ((lm.rNew[i]-loc.Residuals[i])/h - lm.jv[i])
+				lm.rhs.SetVec(i, -rvv)
+			}
+			for k := 0; k < n; k++ {
+				lm.rhs.SetVec(m+k, 0)
+			}
+			if _, err := mat.LstSq(&lm.a, lm.aug, lm.rhs, 0); err != nil {
+				return optimize.Failure, err
+			}
+			a := lm.a.RawMatrix().Data
+			var normA, normV float64
+			for k, d := range lm.diag {
+				normA = math.Hypot(normA, d*a[k])
+				normV = math.Hypot(normV, d*v[k])
+			}
+			if 2*normA <= lm.AccelerationRatio*normV {
+				floats.AddScaled(v, 0.5, a)
+			} else {
+				// Reject the step and increase the damping.
+				if !lm.increaseDamping() {
+					return optimize.StepConvergence, nil
+				}
+				continue
+			}
+		}
+
+		floats.AddTo(lm.xNew, loc.X, step)
+		if floats.Equal(lm.xNew, loc.X) {
+			// The step is too small to change x.
+			return optimize.StepConvergence, nil
+		}
+		if err := eval(lm.rNew, lm.xNew); err != nil {
+			return optimize.NotTerminated, err
+		}
+		costNew := cost(lm.rNew)
+
+		// Predicted reduction of the cost from the linear model.
+		jvVec := mat.NewVecDense(m, lm.jv)
+		jvVec.MulVec(j, mat.NewVecDense(n, step))
+		floats.Add(lm.jv, loc.Residuals)
+		predicted := loc.Cost - cost(lm.jv)
+		actual := loc.Cost - costNew
+
+		if actual > 0 && predicted > 0 && !math.IsNaN(costNew) {
+			rho := actual / predicted
+			lm.mu *= math.Max(1.0/3, 1-math.Pow(2*rho-1, 3))
+			lm.nu = 2
+			copy(loc.X, lm.xNew)
+			copy(loc.Residuals, lm.rNew)
+			loc.Cost = costNew
+			return optimize.NotTerminated, nil
+		}
+		if !lm.increaseDamping() {
+			return optimize.StepConvergence, nil
+		}
+	}
+}
+
+// increaseDamping increases the damping parameter after a rejected step. It
+// returns false if the damping parameter has become too large to produce a
+// meaningful step.
+func (lm *LevenbergMarquardt) increaseDamping() bool {
+	lm.mu *= lm.nu
+	lm.nu *= 2
+	return !math.IsInf(lm.mu, 1) && !math.IsInf(lm.nu, 1)
+}
@@ -0,0 +1,579 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package leastsq
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+var _ Method = (*TrustRegionReflective)(nil)
+
+// TrustRegionReflective implements the trust-region reflective method for
+// nonlinear least-squares problems subject to simple bounds on the parameters
+//
+//	Lower[i] <= x[i] <= Upper[i].
+//
+// The method keeps the iterates strictly feasible. At each iteration it solves
+// a trust-region subproblem in variables scaled with the Coleman-Li scaling,
+// which shrinks the trust region along directions towards nearby bounds. If
+// the resulting step crosses a bound, the best of the step truncated at the
+// bound, the step reflected from the bound and a scaled steepest descent step
+// is taken.
+//
+// The initial parameters are moved into the interior of the feasible region
+// before the optimization starts. The optimization is considered converged
+// when the projected gradient is small, see Settings.GradientThreshold.
+//
+// References:
+//   - Branch, M.A., Coleman, T.F., Li, Y.: A subspace, interior, and conjugate
+//     gradient method for large-scale bound-constrained minimization problems.
+//     SIAM Journal on Scientific Computing 21(1) (1999), 1-23
+//   - Coleman, T.F., Li, Y.: An interior trust region approach for nonlinear
+//     minimization subject to bounds. SIAM Journal on Optimization 6(2) (1996),
+//     418-445
+type TrustRegionReflective struct {
+	// Lower and Upper are the lower and upper bounds on the parameters. If
+	// Lower is nil, the parameters are unbounded from below, and if Upper is
+	// nil, the parameters are unbounded from above. Individual bounds may be
+	// infinite. If not nil, the lengths of Lower and Upper must equal the
+	// number of parameters, and Lower[i] <= Upper[i] must hold for all i,
+	// otherwise Minimize will panic.
+	Lower, Upper []float64
+
+	lower, upper []float64
+
+	delta float64 // Trust-region radius
+	alpha float64 // Levenberg-Marquardt parameter of the last subproblem
+
+	aug  *mat.Dense
+	jh   *mat.Dense // Scaled Jacobian J*diag(d)
+	svd  mat.SVD
+	u, v mat.Dense
+	s    []float64 // Singular values of the augmented matrix
+	uf   []float64
+
+	g, scale, dv []float64
+	d, diagH, gH []float64
+	p, pH        []float64
+	r, rH        []float64
+	ag, agH      []float64
+	xNew, rNew   []float64
+	hits         []float64
+	work         []float64
+	jWork        []float64
+}
+
+// Init initializes the method. It implements the Method interface.
+func (trf *TrustRegionReflective) Init(m int, x []float64) {
+	n := len(x)
+	if trf.Lower != nil && len(trf.Lower) != n {
+		panic("trf: lower bound length mismatch")
+	}
+	if trf.Upper != nil && len(trf.Upper) != n {
+		panic("trf: upper bound length mismatch")
+	}
+	trf.lower = make([]float64, n)
+	trf.upper = make([]float64, n)
+	for i := range x {
+		trf.lower[i] = math.Inf(-1)
+		if trf.Lower != nil {
+			trf.lower[i] = trf.Lower[i]
+		}
+		trf.upper[i] = math.Inf(1)
+		if trf.Upper != nil {
+			trf.upper[i] = trf.Upper[i]
+		}
+		if trf.lower[i] > trf.upper[i] || math.IsNaN(trf.lower[i]) || math.IsNaN(trf.upper[i]) {
+			panic("trf: inconsistent bounds")
+		}
+		x[i] = trf.strictlyFeasible(i, x[i], 1e-10)
+	}
+
+	trf.delta = -1 // Set on the first iteration.
+	trf.alpha = 0
+	trf.aug = mat.NewDense(m+n, n, nil)
+	trf.jh = mat.NewDense(m, n, nil)
+	trf.s = make([]float64, n)
+	trf.uf = make([]float64, n)
+	trf.g = make([]float64, n)
+	trf.scale = make([]float64, n)
+	trf.dv = make([]float64, n)
+	trf.d = make([]float64, n)
+	trf.diagH = make([]float64, n)
+	trf.gH = make([]float64, n)
+	trf.p = make([]float64, n)
+	trf.pH = make([]float64, n)
+	trf.r = make([]float64, n)
+	trf.rH = make([]float64, n)
+	trf.ag = make([]float64, n)
+	trf.agH = make([]float64, n)
+	trf.xNew = make([]float64, n)
+	trf.rNew = make([]float64, m)
+	trf.hits = make([]float64, n)
+	trf.work = make([]float64, n)
+	trf.jWork = make([]float64, m)
+}
+
+// Iterate performs an iteration of the method. It implements the Method
+// interface.
+func (trf *TrustRegionReflective) Iterate(loc *Location, eval Evaluator) (optimize.Status, error) {
+	m, n := loc.Jacobian.Dims()
+	x := loc.X
+
+	g := mat.NewVecDense(n, trf.g)
+	g.MulVec(loc.Jacobian.T(), mat.NewVecDense(m, loc.Residuals))
+	trf.scalingVector(x)
+	if trf.delta < 0 {
+		var norm float64
+		for i, xi := range x {
+			norm = math.Hypot(norm, xi/math.Sqrt(trf.scale[i]))
+		}
+		trf.delta = norm
+		if trf.delta == 0 {
+			trf.delta = 1
+		}
+	}
+
+	var gNorm float64
+	for i, gi := range trf.g {
+		trf.d[i] = math.Sqrt(trf.scale[i])
+		trf.diagH[i] = gi * trf.dv[i]
+		trf.gH[i] = trf.d[i] * gi
+		gNorm = math.Max(gNorm, math.Abs(gi*trf.scale[i]))
+	}
+	theta := math.Max(0.995, 1-gNorm)
+
+	// Form the augmented matrix
+	//  [ J*diag(d)         ]
+	//  [ diag(sqrt(diagH)) ]
+	// and its singular value decomposition.
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			trf.jh.Set(i, j, loc.Jacobian.At(i, j)*trf.d[j])
+		}
+	}
+	trf.aug.Zero()
+	trf.aug.Slice(0, m, 0, n).(*mat.Dense).Copy(trf.jh)
+	for j, h := range trf.diagH {
+		trf.aug.Set(m+j, j, math.Sqrt(h))
+	}
+	if !trf.svd.Factorize(trf.aug, mat.SVDThin) {
+		return optimize.Failure, mat.ErrFailedSVD
+	}
+	trf.svd.UTo(&trf.u)
+	trf.svd.VTo(&trf.v)
+	trf.svd.Values(trf.s)
+	// uf = Uᵀ*[r; 0]
+	uf := mat.NewVecDense(n, trf.uf)
+	uf.MulVec(trf.u.Slice(0, m, 0, n).T(), mat.NewVecDense(m, loc.Residuals))
+
+	for {
+		if trf.delta == 0 {
+			return optimize.StepConvergence, nil
+		}
+		trf.solveSubproblem(m + n)
+		for i := range trf.p {
+			trf.p[i] = trf.d[i] * trf.pH[i]
+		}
+		predicted := trf.selectStep(x, theta)
+
+		for i, xi := range x {
+			trf.xNew[i] = trf.strictlyFeasible(i, xi+trf.p[i], 0)
+		}
+		if floats.Equal(trf.xNew, x) {
+			return optimize.StepConvergence, nil
+		}
+		if err := eval(trf.rNew, trf.xNew); err != nil {
+			return optimize.NotTerminated, err
+		}
+		costNew := cost(trf.rNew)
+		stepNorm := floats.Norm(trf.pH, 2)
+		if math.IsInf(costNew, 1) || math.IsNaN(costNew) {
+			trf.delta = 0.25 * stepNorm
+			continue
+		}
+
+		actual := loc.Cost - costNew
+		deltaNew := trf.updateRadius(actual, predicted, stepNorm, stepNorm > 0.95*trf.delta)
+		if deltaNew > 0 {
+			trf.alpha *= trf.delta / deltaNew
+		}
+		trf.delta = deltaNew
+
+		if actual > 0 {
+			copy(loc.X, trf.xNew)
+			copy(loc.Residuals, trf.rNew)
+			loc.Cost = costNew
+			return optimize.NotTerminated, nil
+		}
+	}
+}
+
+// projectGradient projects the gradient at x onto the feasible region.
+func (trf *TrustRegionReflective) projectGradient(grad, x []float64) {
+	for i, xi := range x {
+		grad[i] = xi - trf.project(i, xi-grad[i])
+	}
+}
+
+// scalingVector computes the Coleman-Li scaling vector v and its derivative
+// dv at x.
+func (trf *TrustRegionReflective) scalingVector(x []float64) {
+	for i, gi := range trf.g {
+		trf.scale[i] = 1
+		trf.dv[i] = 0
+		switch {
+		case gi < 0 && !math.IsInf(trf.upper[i], 1):
+			trf.scale[i] = trf.upper[i] - x[i]
+			trf.dv[i] = -1
+		case gi > 0 && !math.IsInf(trf.lower[i], -1):
+			trf.scale[i] = x[i] - trf.lower[i]
+			trf.dv[i] = 1
+		}
+	}
+}
+
+// solveSubproblem solves the trust-region subproblem
+//
+//	minimize |A*p + f| subject to |p| <= Δ
+//
+// using the singular value decomposition A = U*S*Vᵀ and stores the solution
+// in trf.pH. The Levenberg-Marquardt parameter of the solution is stored in
+// trf.alpha.
+//
+// See Moré, J.J.: The Levenberg-Marquardt algorithm: implementation and
+// theory. In Numerical Analysis, Lecture Notes in Mathematics 630 (1978),
+// 105-116.
+func (trf *TrustRegionReflective) solveSubproblem(rows int) {
+	const (
+		maxIter = 10
+		rtol    = 0.01
+	)
+	s := trf.s
+	n := len(s)
+	delta := trf.delta
+	suf := trf.work
+	for i := range suf {
+		suf[i] = s[i] * trf.uf[i]
+	}
+	phi := func(alpha float64) (phi, dphi float64) {
+		var norm, sum float64
+		for i, si := range s {
+			denom := si*si + alpha
+			norm = math.Hypot(norm, suf[i]/denom)
+			sum += suf[i] * suf[i] / (denom * denom * denom)
+		}
+		return norm - delta, -sum / norm
+	}
+	vMat := trf.v
+
+	// Try the Gauss-Newton step if A has full rank.
+	fullRank := s[n-1] > dlamchE*float64(rows)*s[0]
+	if fullRank {
+		for i := range trf.work {
+			trf.rH[i] = -trf.uf[i] / s[i]
+		}
+		mat.NewVecDense(n, trf.pH).MulVec(&vMat, mat.NewVecDense(n, trf.rH))
+		if floats.Norm(trf.pH, 2) <= delta {
+			trf.alpha = 0
+			return
+		}
+	}
+
+	alphaUpper := floats.Norm(suf, 2) / delta
+	var alphaLower float64
+	if fullRank {
+		f, df := phi(0)
+		alphaLower = -f / df
+	}
+	alpha := trf.alpha
+	if !fullRank && alpha == 0 {
+		alpha = math.Max(0.001*alphaUpper, math.Sqrt(alphaLower*alphaUpper))
+	}
+	for it := 0; it < maxIter; it++ {
+		if alpha < alphaLower || alpha > alphaUpper {
+			alpha = math.Max(0.001*alphaUpper, math.Sqrt(alphaLower*alphaUpper))
+		}
+		f, df := phi(alpha)
+		if f < 0 {
+			alphaUpper = alpha
+		}
+		ratio := f / df
+		alphaLower = math.Max(alphaLower, alpha-ratio)
+		alpha -= (f + delta) * ratio / delta
+		if math.Abs(f) < rtol*delta {
+			break
+		}
+	}
+	for i, si := range s {
+		trf.rH[i] = -suf[i] / (si*si + alpha)
+	}
+	mat.NewVecDense(n, trf.pH).MulVec(&vMat, mat.NewVecDense(n, trf.rH))
+	floats.Scale(delta/floats.Norm(trf.pH, 2), trf.pH)
+	trf.alpha = alpha
+}
+
+// selectStep selects the step from x given the trust-region step in trf.p and
+// trf.pH. If the step leaves the feasible region, the best of the step
+// truncated at the boundary, the reflected step and the scaled steepest
+// descent step is chosen. The selected step is stored in trf.p and trf.pH and
+// selectStep returns the predicted reduction of the cost.
+func (trf *TrustRegionReflective) selectStep(x []float64, theta float64) (predicted float64) {
+	inBounds := true
+	for i, xi := range x {
+		v := xi + trf.p[i]
+		if v < trf.lower[i] || v > trf.upper[i] {
+			inBounds = false
+			break
+		}
+	}
+	if inBounds {
+		return -trf.quadratic(trf.pH, trf.diagH)
+	}
+
+	pStride := trf.stepToBound(x, trf.p, trf.hits)
+
+	// Compute the reflected direction.
+	for i, ph := range trf.pH {
+		trf.rH[i] = ph
+		if trf.hits[i] != 0 {
+			trf.rH[i] = -ph
+		}
+		trf.r[i] = trf.d[i] * trf.rH[i]
+	}
+
+	// Restrict the trust-region step such that it hits the bound.
+	floats.Scale(pStride, trf.p)
+	floats.Scale(pStride, trf.pH)
+	xOnBound := trf.xNew
+	floats.AddTo(xOnBound, x, trf.p)
+
+	// The reflected direction will cross either the boundary of the
+	// feasible region or the boundary of the trust region first.
+	_, toTR := intersectTrustRegion(trf.pH, trf.rH, trf.delta)
+	toBound := trf.stepToBound(xOnBound, trf.r, trf.work)
+
+	// Find the bounds on the step size along the reflected direction,
+	// taking into account the strict feasibility requirement.
+	rStride := math.Min(toBound, toTR)
+	var rStrideLower, rStrideUpper float64
+	if rStride > 0 {
+		rStrideLower = (1 - theta) * pStride / rStride
+		if rStride == toBound {
+			rStrideUpper = theta * toBound
+		} else {
+			rStrideUpper = toTR
+		}
+	} else {
+		rStrideUpper = -1
+	}
+
+	rValue := math.Inf(1)
+	if rStrideLower <= rStrideUpper {
+		a, b, c := trf.quadratic1D(trf.rH, trf.pH)
+		rStride, rValue = minimizeQuadratic1D(a, b, c, rStrideLower, rStrideUpper)
+		for i := range trf.rH {
+			trf.rH[i] = trf.rH[i]*rStride + trf.pH[i]
+			trf.r[i] = trf.rH[i] * trf.d[i]
+		}
+	}
+
+	// Make the truncated step strictly interior.
+	floats.Scale(theta, trf.p)
+	floats.Scale(theta, trf.pH)
+	pValue := trf.quadratic(trf.pH, trf.diagH)
+
+	// Scaled steepest descent step.
+	for i, gh := range trf.gH {
+		trf.agH[i] = -gh
+		trf.ag[i] = trf.d[i] * trf.agH[i]
+	}
+	toTR = trf.delta / floats.Norm(trf.agH, 2)
+	toBound = trf.stepToBound(x, trf.ag, trf.work)
+	agStride := toTR
+	if toBound < toTR {
+		agStride = theta * toBound
+	}
+	a, b, _ := trf.quadratic1D(trf.agH, nil)
+	agStride, agValue := minimizeQuadratic1D(a, b, 0, 0, agStride)
+	floats.Scale(agStride, trf.agH)
+	floats.Scale(agStride, trf.ag)
+
+	switch {
+	case pValue < rValue && pValue < agValue:
+		return -pValue
+	case rValue < pValue && rValue < agValue:
+		copy(trf.p, trf.r)
+		copy(trf.pH, trf.rH)
+		return -rValue
+	default:
+		copy(trf.p, trf.ag)
+		copy(trf.pH, trf.agH)
+		return -agValue
+	}
+}
+
+// quadratic evaluates the quadratic model of the cost reduction
+//
+//	1/2 * (|Jh*s|² + Σ_i diag_i s_i²) + ghᵀs
+//
+// in the scaled variables.
+func (trf *TrustRegionReflective) quadratic(s, diag []float64) float64 {
+	m, n := trf.jh.Dims()
+	js := mat.NewVecDense(m, trf.jWork)
+	js.MulVec(trf.jh, mat.NewVecDense(n, s))
+	q := mat.Dot(js, js)
+	for i, si := range s {
+		q += diag[i] * si * si
+	}
+	return 0.5*q + floats.Dot(trf.gH, s)
+}
+
+// quadratic1D returns the coefficients of the quadratic model along the
+// line s0 + t*s as a function of t,
+//
+//	a*t² + b*t + c.
+//
+// If s0 is nil, it is treated as zero.
+func (trf *TrustRegionReflective) quadratic1D(s, s0 []float64) (a, b, c float64) {
+	m, n := trf.jh.Dims()
+	v := mat.NewVecDense(m, nil)
+	v.MulVec(trf.jh, mat.NewVecDense(n, s))
+	a = mat.Dot(v, v)
+	for i, si := range s {
+		a += trf.diagH[i] * si * si
+	}
+	a *= 0.5
+	b = floats.Dot(trf.gH, s)
+	if s0 != nil {
+		u := mat.NewVecDense(m, nil)
+		u.MulVec(trf.jh, mat.NewVecDense(n, s0))
+		b += mat.Dot(u, v)
+		c = 0.5*mat.Dot(u, u) + floats.Dot(trf.gH, s0)
+		for i, si := range s {
+			b += s0[i] * trf.diagH[i] * si
+			c += 0.5 * trf.diagH[i] * s0[i] * s0[i]
+		}
+	}
+	return a, b, c
+}
+
+// minimizeQuadratic1D minimizes a*t² + b*t + c over lower <= t <= upper and
+// returns the minimizer and the minimum value.
+func minimizeQuadratic1D(a, b, c, lower, upper float64) (t, y float64) {
+	ts := []float64{lower, upper}
+	if a != 0 {
+		extremum := -0.5 * b / a
+		if lower < extremum && extremum < upper {
+			ts = append(ts, extremum)
+		}
+	}
+	y = math.Inf(1)
+	for _, ti := range ts {
+		if yi := a*ti*ti + b*ti + c; yi < y {
+			t, y = ti, yi
+		}
+	}
+	return t, y
+}
+
+// stepToBound returns the smallest step along s from x that reaches a bound.
+// The direction of the bounds that are hit by this step is stored in hits.
+func (trf *TrustRegionReflective) stepToBound(x, s, hits []float64) float64 {
+	minStep := math.Inf(1)
+	for i, si := range s {
+		if si == 0 {
+			continue
+		}
+		step := math.Max((trf.lower[i]-x[i])/si, (trf.upper[i]-x[i])/si)
+		minStep = math.Min(minStep, step)
+	}
+	for i, si := range s {
+		hits[i] = 0
+		if si == 0 {
+			continue
+		}
+		if math.Max((trf.lower[i]-x[i])/si, (trf.upper[i]-x[i])/si) == minStep {
+			hits[i] = math.Copysign(1, si)
+		}
+	}
+	return minStep
+}
+
+// intersectTrustRegion returns the values of t at which x + t*s intersects
+// the boundary of the trust region |x| = Δ in increasing order.
+func intersectTrustRegion(x, s []float64, delta float64) (t1, t2 float64) {
+	a := floats.Dot(s, s)
+	b := floats.Dot(x, s)
+	c := floats.Dot(x, x) - delta*delta
+	d := math.Sqrt(math.Max(0, b*b-a*c))
+	q := -(b + math.Copysign(d, b))
+	t1 = q / a
+	t2 = c / q
+	if t1 > t2 {
+		t1, t2 = t2, t1
+	}
+	return t1, t2
+}
+
+// updateRadius returns the updated trust-region radius given the actual and
+// the predicted reduction of the cost.
+func (trf *TrustRegionReflective) updateRadius(actual, predicted, stepNorm float64, boundHit bool) float64 {
+	var ratio float64
+	switch {
+	case predicted > 0:
+		ratio = actual / predicted
+	case predicted == actual:
+		ratio = 1
+	}
+	switch {
+	case ratio < 0.25:
+		return 0.25 * stepNorm
+	case ratio > 0.75 && boundHit:
+		return 2 * trf.delta
+	}
+	return trf.delta
+}
+
+// project returns v projected onto the feasible interval of the i-th parameter.
+func (trf *TrustRegionReflective) project(i int, v float64) float64 {
+	return math.Max(trf.lower[i], math.Min(v, trf.upper[i]))
+}
+
+// strictlyFeasible returns v moved into the interior of the feasible interval
+// of the i-th parameter. If v is within rstep relative distance to a bound, it
+// is moved away from it by that distance. If rstep is zero, v is moved to the
+// nearest floating-point value inside the interval.
+func (trf *TrustRegionReflective) strictlyFeasible(i int, v, rstep float64) float64 {
+	lb, ub := trf.lower[i], trf.upper[i]
+	v = trf.project(i, v)
+	if rstep == 0 {
+		switch {
+		case v == lb:
+			v = math.Nextafter(lb, ub)
+		case v == ub:
+			v = math.Nextafter(ub, lb)
+		}
+	} else {
+		lowerDist := v - lb
+		upperDist := ub - v
+		lowerThresh := rstep * math.Max(1, math.Abs(lb))
+		upperThresh := rstep * math.Max(1, math.Abs(ub))
+		switch {
+		case !math.IsInf(lb, -1) && lowerDist <= math.Min(lowerThresh, upperDist):
+			v = lb + lowerThresh
+		case !math.IsInf(ub, 1) && upperDist <= math.Min(upperThresh, lowerDist):
+			v = ub - upperThresh
+		}
+	}
+	if v < lb || v > ub {
+		v = 0.5 * (lb + ub)
+	}
+	return v
+}
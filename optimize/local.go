@@ -25,7 +25,7 @@ func (l localOptimizer) run(method localMethod, gradThresh float64, operation ch
 		l.finish(operation, result)
 		return NotTerminated, nil
 	}
-	status, err := l.checkStartingLocation(task, gradThresh)
+	status, err := l.checkStartingLocation(task, method, gradThresh)
 	if err != nil {
 		l.finishMethodDone(operation, result, task)
 		return status, err
@@ -55,7 +55,7 @@ Loop:
 		case MajorIteration:
 			// The last operation was a MajorIteration. Check if the gradient
 			// is below the threshold.
-			if status := l.checkGradientConvergence(r.Gradient, gradThresh); status != NotTerminated {
+			if status := l.checkGradientConvergence(l.convergenceGradient(r.Location, method), gradThresh); status != NotTerminated {
 				l.finishMethodDone(operation, result, task)
 				return GradientThreshold, nil
 			}
@@ -100,7 +100,7 @@ func (l localOptimizer) initialLocation(operation chan<- Task, result <-chan Tas
 	return <-result
 }
 
-func (l localOptimizer) checkStartingLocation(task Task, gradThresh float64) (Status, error) {
+func (l localOptimizer) checkStartingLocation(task Task, method localMethod, gradThresh float64) (Status, error) {
 	if math.IsInf(task.F, 1) || math.IsNaN(task.F) {
 		return Failure, ErrFunc(task.F)
 	}
@@ -109,10 +109,27 @@ func (l localOptimizer) checkStartingLocation(task Task, gradThresh float64) (St
 			return Failure, ErrGrad{Grad: v, Index: i}
 		}
 	}
-	status := l.checkGradientConvergence(task.Gradient, gradThresh)
+	status := l.checkGradientConvergence(l.convergenceGradient(task.Location, method), gradThresh)
 	return status, nil
 }
 
+// gradientProjector is implemented by local methods whose iterates are
+// restricted to a feasible region. For such methods the gradient need not
+// vanish at a minimum, and convergence is tested using the projection of the
+// gradient onto the feasible region instead.
+type gradientProjector interface {
+	projectedGradient(loc *Location) []float64
+}
+
+// convergenceGradient returns the gradient that is used to test the
+// convergence of method at loc.
+func (localOptimizer) convergenceGradient(loc *Location, method localMethod) []float64 {
+	if p, ok := method.(gradientProjector); ok {
+		return p.projectedGradient(loc)
+	}
+	return loc.Gradient
+}
+
 func (localOptimizer) checkGradientConvergence(gradient []float64, gradThresh float64) Status {
 	if gradient == nil || math.IsNaN(gradThresh) {
 		return NotTerminated
@@ -151,14 +151,18 @@ type Method interface {
 // function evaluations. The Settings input struct can be used to limit this,
 // for example by modifying the maximum function evaluations or gradient tolerance.
 func Minimize(p Problem, initX []float64, settings *Settings, method Method) (*Result, error) {
-	startTime := time.Now()
+	return minimizeFrom(p, initX, settings, method, &Stats{}, time.Now())
+}
+
+// minimizeFrom performs the optimization of Minimize with the statistics
+// starting from stats and the runtime measured from startTime.
+func minimizeFrom(p Problem, initX []float64, settings *Settings, method Method, stats *Stats, startTime time.Time) (*Result, error) {
 	if method == nil {
 		method = getDefaultMethod(&p)
 	}
 	if settings == nil {
 		settings = &Settings{}
 	}
-	stats := &Stats{}
 	dim := len(initX)
 	err := checkOptimization(p, dim, settings.Recorder)
 	if err != nil {
@@ -0,0 +1,217 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	defaultMoreSorensenTolerance     = 1e-2
+	defaultMoreSorensenMaxIterations = 50
+)
+
+var _ TrustRegionSubproblem = (*MoreSorensen)(nil)
+
+// MoreSorensen solves the trust-region subproblem nearly exactly with the
+// method of Moré and Sorensen.
+//
+// The solution p of the subproblem satisfies
+//
+//	(B + λI) p = -g,
+//	λ (Δ - |p|) = 0,
+//
+// for some λ >= 0 such that B + λI is positive semidefinite. MoreSorensen
+// finds λ by a safeguarded Newton iteration on the secular equation
+// 1/|p(λ)| = 1/Δ, where each iteration requires a Cholesky factorization of
+// B + λI. In the hard case, when g is orthogonal to the eigenspace of the
+// smallest eigenvalue of B, the step is completed by a multiple of the
+// corresponding eigenvector so that it reaches the boundary of the trust
+// region. The eigenvector is computed with mat.EigenSym.
+//
+// Since it uses the second-order information fully, TrustRegion with
+// MoreSorensen converges to points that satisfy the second-order necessary
+// conditions of optimality, and it moves away from saddle points. The
+// factorizations make it suitable only for problems of moderate size.
+//
+// References:
+//   - Moré, J.J., Sorensen, D.C.: Computing a trust region step. SIAM J. Sci.
+//     Stat. Comput. 4(3) (1983), 553-572
+//   - Nocedal, J., Wright, S.: Numerical Optimization (2nd ed). Springer (2006),
+//     Section 4.3
+type MoreSorensen struct {
+	// Tolerance is the relative accuracy with which the norm of the step
+	// matches the radius of the trust region when the solution lies on the
+	// boundary. It must be between zero and one. If Tolerance is zero, it is
+	// defaulted to 1e-2.
+	Tolerance float64
+	// MaxIterations is the maximum number of iterations for finding λ. If
+	// MaxIterations is zero, it is defaulted to 50.
+	MaxIterations int
+
+	shifted *mat.SymDense
+	chol    mat.Cholesky
+	eigen   mat.EigenSym
+	vecs    mat.Dense
+	p       []float64
+	w       []float64
+	z       []float64
+}
+
+// Solve computes the step. It implements the TrustRegionSubproblem interface.
+func (ms *MoreSorensen) Solve(dst, grad []float64, hess mat.Symmetric, radius float64) {
+	n := len(grad)
+	if len(dst) != n {
+		panic("moresorensen: slice length mismatch")
+	}
+	tol := ms.Tolerance
+	if tol == 0 {
+		tol = defaultMoreSorensenTolerance
+	}
+	if tol < 0 || tol >= 1 {
+		panic("moresorensen: Tolerance must be between 0 and 1")
+	}
+	maxIter := ms.MaxIterations
+	if maxIter == 0 {
+		maxIter = defaultMoreSorensenMaxIterations
+	}
+	if maxIter < 0 {
+		panic("moresorensen: negative MaxIterations")
+	}
+	ms.shifted = resizeSymDense(ms.shifted, n)
+	ms.p = resize(ms.p, n)
+	ms.w = resize(ms.w, n)
+	ms.z = resize(ms.z, n)
+	p := mat.NewVecDense(n, ms.p)
+	w := mat.NewVecDense(n, ms.w)
+	g := mat.NewVecDense(n, grad)
+
+	// Compute the initial safeguards for λ from the Gershgorin bounds on the
+	// eigenvalues of B.
+	gNorm := floats.Norm(grad, 2)
+	var norm1 float64
+	minDiag := math.Inf(1)
+	for j := 0; j < n; j++ {
+		var sum float64
+		for i := 0; i < n; i++ {
+			sum += math.Abs(hess.At(i, j))
+		}
+		norm1 = math.Max(norm1, sum)
+		minDiag = math.Min(minDiag, hess.At(j, j))
+	}
+	lower := math.Max(0, math.Max(-minDiag, gNorm/radius-norm1))
+	upper := gNorm/radius + norm1
+
+	var (
+		haveEigen bool
+		lambda1   float64 // Smallest eigenvalue of B.
+		lambda    float64
+		found     bool // Whether dst holds a step within the trust region.
+	)
+	if lower > 0 {
+		lambda = ms.safeguard(lower, upper)
+	}
+	for iter := 0; iter < maxIter; iter++ {
+		ms.shifted.CopySym(hess)
+		for i := 0; i < n; i++ {
+			ms.shifted.SetSym(i, i, hess.At(i, i)+lambda)
+		}
+		if !ms.chol.Factorize(ms.shifted) || isSingular(ms.chol.SolveVecTo(p, g)) {
+			// B + λI is not positive definite.
+			lower = math.Max(lower, lambda)
+			lambda = ms.safeguard(lower, upper)
+			continue
+		}
+		p.ScaleVec(-1, p)
+		pNorm := floats.Norm(ms.p, 2)
+
+		if pNorm <= radius {
+			copy(dst, ms.p)
+			found = true
+			if lambda == 0 || pNorm >= (1-tol)*radius {
+				return
+			}
+			// The hard case may occur. Add a multiple of the eigenvector of
+			// the smallest eigenvalue of B to reach the boundary.
+			upper = math.Min(upper, lambda)
+			if !haveEigen {
+				if !ms.eigen.Factorize(hess, true) {
+					return
+				}
+				ms.vecs.Reset()
+				ms.eigen.VectorsTo(&ms.vecs)
+				mat.Col(ms.z, 0, &ms.vecs)
+				lambda1 = ms.eigen.Values(nil)[0]
+				haveEigen = true
+			}
+			zBz := lambda1 + lambda
+			lower = math.Max(lower, lambda-zBz)
+			tau := hardCaseStep(ms.p, ms.z, radius)
+			w.MulVec(ms.shifted, p)
+			if tau*tau*zBz <= tol*(2-tol)*(floats.Dot(ms.p, ms.w)+lambda*radius*radius) {
+				floats.AddScaledTo(dst, ms.p, tau, ms.z)
+				return
+			}
+		} else {
+			lower = math.Max(lower, lambda)
+			if pNorm <= (1+tol)*radius {
+				floats.ScaleTo(dst, radius/pNorm, ms.p)
+				return
+			}
+		}
+		if pNorm == 0 {
+			lambda = ms.safeguard(lower, upper)
+			continue
+		}
+
+		// Newton step for 1/|p(λ)| - 1/Δ = 0. With (B + λI) = LLᵀ and
+		// q = L⁻¹p, |q|² = pᵀ(B + λI)⁻¹p.
+		err := ms.chol.SolveVecTo(w, p)
+		if isSingular(err) {
+			lambda = ms.safeguard(lower, upper)
+			continue
+		}
+		qq := floats.Dot(ms.p, ms.w)
+		lambda += pNorm * pNorm / qq * (pNorm - radius) / radius
+		if !(lambda > lower && lambda < upper) {
+			lambda = ms.safeguard(lower, upper)
+		}
+	}
+	if found {
+		return
+	}
+	// The iteration did not converge. Fall back to the Cauchy point.
+	if gNorm == 0 {
+		for i := range dst {
+			dst[i] = 0
+		}
+		return
+	}
+	w.MulVec(hess, g)
+	cauchyPoint(dst, grad, gNorm, floats.Dot(grad, ms.w), radius)
+}
+
+// safeguard returns a value of λ within the interval [lower, upper].
+func (*MoreSorensen) safeguard(lower, upper float64) float64 {
+	return math.Max(math.Sqrt(lower*upper), lower+0.01*(upper-lower))
+}
+
+// hardCaseStep returns τ of the smallest magnitude for which |p + τ*z| equals
+// radius, where |z| = 1 and |p| <= radius.
+func hardCaseStep(p, z []float64, radius float64) float64 {
+	pz := floats.Dot(p, z)
+	c := floats.Dot(p, p) - radius*radius
+	if c >= 0 {
+		return 0
+	}
+	tau := -c / (math.Abs(pz) + math.Sqrt(pz*pz-c))
+	if pz < 0 {
+		return -tau
+	}
+	return tau
+}
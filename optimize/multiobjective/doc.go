@@ -0,0 +1,13 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package multiobjective implements evolutionary methods for multi-objective
+// optimization problems
+//
+//	minimize [f_0(x), ..., f_{m-1}(x)],
+//
+// which find an approximation of the Pareto front, the set of objective
+// vectors that cannot be improved in one objective without being worsened
+// in another, together with metrics of the quality of such approximations.
+package multiobjective // import "gonum.org/v1/gonum/optimize/multiobjective"
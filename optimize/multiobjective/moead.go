@@ -0,0 +1,345 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package multiobjective
+
+import (
+	"math"
+	"sort"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Decomposition is the scalarizing function that MOEAD uses to decompose a
+// multi-objective problem into single-objective subproblems.
+type Decomposition int
+
+const (
+	// Tchebycheff minimizes the weighted Tchebycheff distance to the ideal
+	// point z,
+	//  max_k λ_k |f_k - z_k|.
+	Tchebycheff Decomposition = iota
+	// WeightedSum minimizes the weighted sum of the objectives,
+	//  Σ_k λ_k f_k.
+	// It cannot find points in non-convex parts of the Pareto front.
+	WeightedSum
+	// PBI minimizes the penalty-based boundary intersection
+	//  d_1 + θ d_2,
+	// where d_1 is the distance of f-z along the weight vector λ, d_2 the
+	// distance of f-z from the line along λ, and θ is given by
+	// MOEAD.Penalty.
+	PBI
+)
+
+var _ Method = (*MOEAD)(nil)
+
+// MOEAD implements the multi-objective evolutionary algorithm based on
+// decomposition of Zhang and Li with the differential evolution operator of
+// Li and Zhang. The problem is decomposed into subproblems given by weight
+// vectors evenly spread on the simplex and the Decomposition, and each
+// member of the population is the current solution of one subproblem. In
+// each generation, a child is created for every subproblem from members of
+// its neighborhood, the subproblems with the closest weight vectors, or of
+// the whole population, and it replaces the solutions of at most
+// MaxReplace subproblems in the same set if it is better for them. The
+// children of a generation are evaluated together, and the replacements
+// are made in the order of the subproblems.
+//
+// References:
+//   - Zhang, Q., Li, H.: MOEA/D: A multiobjective evolutionary algorithm
+//     based on decomposition. IEEE Transactions on Evolutionary Computation
+//     11(6) (2007), 712-731
+//   - Li, H., Zhang, Q.: Multiobjective optimization problems with
+//     complicated Pareto sets, MOEA/D and NSGA-II. IEEE Transactions on
+//     Evolutionary Computation 13(2) (2009), 284-302
+type MOEAD struct {
+	// Population is the number of subproblems. The weight vectors are the
+	// points of the simplex lattice of Das and Dennis with the fewest points
+	// that is at least Population, so the number of subproblems may be
+	// larger. If Population is zero, it is defaulted to 100.
+	Population int
+
+	// Decomposition is the scalarizing function of the subproblems.
+	Decomposition Decomposition
+	// Penalty is the penalty θ of the PBI decomposition. If Penalty is
+	// zero, it is defaulted to 5.
+	Penalty float64
+
+	// Neighbors is the size of the neighborhood of each subproblem. If
+	// Neighbors is zero, it is defaulted to 20, and it is limited to the
+	// number of subproblems.
+	Neighbors int
+	// NeighborProb is the probability that the parents of a child are
+	// chosen from the neighborhood instead of the whole population. If
+	// NeighborProb is zero, it is defaulted to 0.9.
+	NeighborProb float64
+	// MaxReplace is the maximum number of solutions replaced by a child. If
+	// MaxReplace is zero, it is defaulted to 2.
+	MaxReplace int
+
+	// DifferentialWeight is the weight F of the differential evolution
+	// operator. If DifferentialWeight is zero, it is defaulted to 0.5.
+	DifferentialWeight float64
+	// Crossover is the crossover probability of the differential evolution
+	// operator. If Crossover is zero, it is defaulted to 1.
+	Crossover float64
+
+	// Mutation is the probability of polynomial mutation of a variable. If
+	// Mutation is zero, it is defaulted to 1/dim.
+	Mutation float64
+	// MutationEta is the distribution index of the polynomial mutation. If
+	// MutationEta is zero, it is defaulted to 20.
+	MutationEta float64
+
+	// Src allows a random number generator to be supplied for generating
+	// samples. If Src is nil the generator in golang.org/x/math/rand is
+	// used.
+	Src rand.Source
+
+	rnd          *rand.Rand
+	lower, upper []float64
+	pop          int
+	penalty      float64
+	neighborProb float64
+	maxReplace   int
+	weight       float64
+	crossover    float64
+	mutation     float64
+	mutationEta  float64
+
+	// weights holds the weight vectors of the subproblems and neighbors
+	// the indices of the closest weight vectors of each subproblem. ideal
+	// is the best value of each objective found so far.
+	weights   *mat.Dense
+	neighbors [][]int
+	ideal     []float64
+
+	// xs and fs hold the solutions of the subproblems. children holds the
+	// generation proposed last, and local whether the parents of each
+	// child were chosen from its neighborhood.
+	xs, fs      *mat.Dense
+	children    *mat.Dense
+	local       []bool
+	perm        []int
+	initialized bool
+}
+
+func (mo *MOEAD) Init(objectives int, lower, upper []float64) {
+	pop := mo.Population
+	if pop == 0 {
+		pop = 100
+	}
+	if pop < 2 {
+		panic("multiobjective: population too small")
+	}
+	if mo.Neighbors < 0 || mo.MaxReplace < 0 {
+		panic("multiobjective: invalid parameter")
+	}
+	mo.penalty = defaultPositive(mo.Penalty, 5)
+	mo.neighborProb = defaultProb(mo.NeighborProb, 0.9)
+	mo.maxReplace = mo.MaxReplace
+	if mo.maxReplace == 0 {
+		mo.maxReplace = 2
+	}
+	mo.weight = defaultPositive(mo.DifferentialWeight, 0.5)
+	mo.crossover = defaultProb(mo.Crossover, 1)
+	mo.mutation = defaultProb(mo.Mutation, 1/float64(len(lower)))
+	mo.mutationEta = defaultPositive(mo.MutationEta, 20)
+	mo.rnd = newRand(mo.Src)
+	mo.lower, mo.upper = lower, upper
+
+	mo.weights = simplexLattice(objectives, pop)
+	mo.pop, _ = mo.weights.Dims()
+	t := mo.Neighbors
+	if t == 0 {
+		t = 20
+	}
+	t = min(max(t, 2), mo.pop)
+	mo.neighbors = make([][]int, mo.pop)
+	dist := make([]float64, mo.pop)
+	for i := range mo.neighbors {
+		wi := mo.weights.RawRowView(i)
+		order := make([]int, mo.pop)
+		for j := range order {
+			order[j] = j
+			dist[j] = floats.Distance(wi, mo.weights.RawRowView(j), 2)
+		}
+		sort.SliceStable(order, func(a, b int) bool { return dist[order[a]] < dist[order[b]] })
+		mo.neighbors[i] = order[:t]
+	}
+	mo.ideal = make([]float64, objectives)
+	for k := range mo.ideal {
+		mo.ideal[k] = math.Inf(1)
+	}
+
+	dim := len(lower)
+	mo.xs = mat.NewDense(mo.pop, dim, nil)
+	mo.fs = mat.NewDense(mo.pop, objectives, nil)
+	mo.children = mat.NewDense(mo.pop, dim, nil)
+	mo.local = make([]bool, mo.pop)
+	mo.perm = make([]int, mo.pop)
+	mo.initialized = false
+	for i := 0; i < mo.pop; i++ {
+		uniform(mo.children.RawRowView(i), lower, upper, mo.rnd)
+	}
+}
+
+func (mo *MOEAD) Generation() *mat.Dense {
+	if !mo.initialized {
+		return mo.children
+	}
+	for i := 0; i < mo.pop; i++ {
+		mo.local[i] = mo.rnd.Float64() < mo.neighborProb
+		pick := func() []float64 {
+			if mo.local[i] {
+				nb := mo.neighbors[i]
+				return mo.xs.RawRowView(nb[mo.rnd.Intn(len(nb))])
+			}
+			return mo.xs.RawRowView(mo.rnd.Intn(mo.pop))
+		}
+		// The DE/rand/1 operator with the solution of the subproblem as the
+		// base vector.
+		x := mo.xs.RawRowView(i)
+		r1, r2 := pick(), pick()
+		child := mo.children.RawRowView(i)
+		jRand := mo.rnd.Intn(len(child))
+		for j := range child {
+			v := x[j]
+			if j == jRand || mo.rnd.Float64() < mo.crossover {
+				v += mo.weight * (r1[j] - r2[j])
+			}
+			child[j] = math.Min(math.Max(v, mo.lower[j]), mo.upper[j])
+		}
+		polynomialMutation(child, mo.lower, mo.upper, mo.mutation, mo.mutationEta, mo.rnd)
+	}
+	return mo.children
+}
+
+func (mo *MOEAD) Update(fs *mat.Dense) {
+	if !mo.initialized {
+		mo.xs.Copy(mo.children)
+		mo.fs.Copy(fs)
+		for i := 0; i < mo.pop; i++ {
+			mo.updateIdeal(fs.RawRowView(i))
+		}
+		mo.initialized = true
+		return
+	}
+	for i := 0; i < mo.pop; i++ {
+		f := fs.RawRowView(i)
+		mo.updateIdeal(f)
+		pool := mo.neighbors[i]
+		if !mo.local[i] {
+			pool = mo.perm
+			for j := range pool {
+				pool[j] = j
+			}
+		}
+		// Visit the subproblems of the pool in random order.
+		order := make([]int, len(pool))
+		copy(order, pool)
+		mo.rnd.Shuffle(len(order), func(a, b int) { order[a], order[b] = order[b], order[a] })
+		var replaced int
+		for _, j := range order {
+			if replaced == mo.maxReplace {
+				break
+			}
+			w := mo.weights.RawRowView(j)
+			if mo.scalarize(f, w) <= mo.scalarize(mo.fs.RawRowView(j), w) {
+				mo.xs.SetRow(j, mo.children.RawRowView(i))
+				mo.fs.SetRow(j, f)
+				replaced++
+			}
+		}
+	}
+}
+
+// updateIdeal updates the ideal point with the objective values f.
+func (mo *MOEAD) updateIdeal(f []float64) {
+	for k, v := range f {
+		mo.ideal[k] = math.Min(mo.ideal[k], v)
+	}
+}
+
+// scalarize returns the value of the scalarizing function of the
+// subproblem with the weight vector w at the objective values f.
+func (mo *MOEAD) scalarize(f, w []float64) float64 {
+	switch mo.Decomposition {
+	case Tchebycheff:
+		var g float64
+		for k, v := range f {
+			// Zero weights are replaced by a small value so that all
+			// objectives are taken into account.
+			g = math.Max(g, math.Max(w[k], 1e-6)*math.Abs(v-mo.ideal[k]))
+		}
+		return g
+	case WeightedSum:
+		return floats.Dot(w, f)
+	case PBI:
+		norm := floats.Norm(w, 2)
+		var d1 float64
+		for k, v := range f {
+			d1 += (v - mo.ideal[k]) * w[k]
+		}
+		d1 = math.Abs(d1) / norm
+		var d2 float64
+		for k, v := range f {
+			d := v - mo.ideal[k] - d1*w[k]/norm
+			d2 += d * d
+		}
+		return d1 + mo.penalty*math.Sqrt(d2)
+	default:
+		panic("multiobjective: unknown decomposition")
+	}
+}
+
+func (mo *MOEAD) Solutions() (xs, fs *mat.Dense) {
+	return mo.xs, mo.fs
+}
+
+// simplexLattice returns the points of the simplex lattice of Das and
+// Dennis in m dimensions,
+//
+//	{w : w_j = k_j/h, k_j ∈ ℕ, Σ_j k_j = h},
+//
+// with the smallest number of divisions h for which the lattice has at
+// least n points.
+func simplexLattice(m, n int) *mat.Dense {
+	h := 1
+	for binomial(h+m-1, m-1) < n {
+		h++
+	}
+	lattice := mat.NewDense(binomial(h+m-1, m-1), m, nil)
+	k := make([]int, m)
+	var row int
+	var fill func(j, left int)
+	fill = func(j, left int) {
+		if j == m-1 {
+			k[j] = left
+			for i, v := range k {
+				lattice.Set(row, i, float64(v)/float64(h))
+			}
+			row++
+			return
+		}
+		for v := 0; v <= left; v++ {
+			k[j] = v
+			fill(j+1, left-v)
+		}
+	}
+	fill(0, h)
+	return lattice
+}
+
+// binomial returns the binomial coefficient n choose k.
+func binomial(n, k int) int {
+	b := 1
+	for i := 1; i <= k; i++ {
+		b = b * (n - k + i) / i
+	}
+	return b
+}
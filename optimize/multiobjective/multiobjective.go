@@ -0,0 +1,247 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package multiobjective
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+const defaultGenerations = 250
+
+// Problem describes a multi-objective optimization problem with bounded
+// variables,
+//
+//	minimize [f_0(x), ..., f_{m-1}(x)] subject to Lower <= x <= Upper.
+type Problem struct {
+	// Func evaluates the objectives at x and stores them in dst. Func must
+	// not modify x. If Settings.Concurrent is greater than one, Func must be
+	// safe for concurrent use. NaN objective values are treated as +Inf.
+	Func func(dst, x []float64)
+
+	// Objectives is the number of objectives. It must be positive.
+	Objectives int
+
+	// Lower and Upper are the bounds on the variables. They must have the
+	// same length, which is the dimension of the problem, and they must be
+	// finite with Lower[i] <= Upper[i].
+	Lower, Upper []float64
+}
+
+// Method is an evolutionary method for multi-objective optimization. The
+// method proposes generations of locations, which are evaluated by
+// Minimize, and maintains a population of locations.
+type Method interface {
+	// Init initializes the method for a problem with the given number of
+	// objectives and bounds on the variables.
+	Init(objectives int, lower, upper []float64)
+
+	// Generation returns the locations to be evaluated next in the rows of
+	// a matrix. The first generation is the initial population.
+	Generation() *mat.Dense
+
+	// Update is called with the objective values of the locations returned
+	// by the last call to Generation, stored in the corresponding rows of
+	// fs. Update selects the population for the next generation.
+	Update(fs *mat.Dense)
+
+	// Solutions returns the locations and the objective values of the
+	// current population in the corresponding rows of xs and fs.
+	Solutions() (xs, fs *mat.Dense)
+}
+
+// Settings holds the settings for Minimize.
+type Settings struct {
+	// Generations is the maximum number of generations including the initial
+	// population. If Generations is zero, it is defaulted to 250.
+	Generations int
+
+	// FuncEvaluations is the maximum number of evaluations of the
+	// objectives. Minimize stops before a generation that would exceed the
+	// limit. If FuncEvaluations is zero, the number of evaluations is not
+	// limited.
+	FuncEvaluations int
+
+	// Concurrent is the number of concurrent evaluations of the objectives.
+	// If Concurrent is less than two, the objectives are evaluated serially.
+	Concurrent int
+}
+
+// Result represents the answer of a multi-objective optimization.
+type Result struct {
+	// X and F hold the non-dominated locations of the final population and
+	// their objective values in the corresponding rows, sorted by the first
+	// objective. Locations with identical objective values are included
+	// once.
+	X, F *mat.Dense
+
+	// Stats holds the statistics of the optimization. MajorIterations is the
+	// number of generations, and FuncEvaluations is the number of
+	// evaluations of the objectives.
+	optimize.Stats
+
+	// Status is the reason for termination.
+	Status optimize.Status
+}
+
+// Minimize approximates the Pareto front of the multi-objective problem p
+// using method. If method is nil, NSGA2 is used. If settings is nil, the
+// zero value is used.
+//
+// Minimize returns the non-dominated locations of the final population.
+func Minimize(p Problem, settings *Settings, method Method) (*Result, error) {
+	startTime := time.Now()
+	if p.Func == nil {
+		panic("multiobjective: objective function is undefined")
+	}
+	if p.Objectives <= 0 {
+		panic("multiobjective: non-positive number of objectives")
+	}
+	if len(p.Lower) != len(p.Upper) {
+		panic("multiobjective: mismatched bounds")
+	}
+	n := len(p.Lower)
+	if n == 0 {
+		return nil, optimize.ErrZeroDimensional
+	}
+	for i, l := range p.Lower {
+		u := p.Upper[i]
+		if math.IsInf(l, 0) || math.IsInf(u, 0) || !(l <= u) {
+			panic("multiobjective: invalid bounds")
+		}
+	}
+	if settings == nil {
+		settings = &Settings{}
+	}
+	if method == nil {
+		method = &NSGA2{}
+	}
+	generations := settings.Generations
+	if generations == 0 {
+		generations = defaultGenerations
+	}
+
+	method.Init(p.Objectives, p.Lower, p.Upper)
+	var (
+		stats  optimize.Stats
+		status optimize.Status
+	)
+	for {
+		if stats.MajorIterations >= generations {
+			status = optimize.IterationLimit
+			break
+		}
+		xs := method.Generation()
+		r, _ := xs.Dims()
+		if settings.FuncEvaluations > 0 && stats.FuncEvaluations+r > settings.FuncEvaluations {
+			status = optimize.FunctionEvaluationLimit
+			break
+		}
+		fs := mat.NewDense(r, p.Objectives, nil)
+		evaluate(p.Func, xs, fs, settings.Concurrent)
+		stats.FuncEvaluations += r
+		method.Update(fs)
+		stats.MajorIterations++
+	}
+	if stats.MajorIterations == 0 {
+		// The initial population has not been evaluated.
+		stats.Runtime = time.Since(startTime)
+		return &Result{
+			X:      &mat.Dense{},
+			F:      &mat.Dense{},
+			Stats:  stats,
+			Status: status,
+		}, nil
+	}
+
+	xs, fs := method.Solutions()
+	front := ParetoFront(fs)
+	// Sort the front by the first objective and remove duplicates.
+	sort.SliceStable(front, func(i, j int) bool {
+		return lessLex(fs.RawRowView(front[i]), fs.RawRowView(front[j]))
+	})
+	unique := front[:0]
+	for i, k := range front {
+		if i > 0 && equalRows(fs.RawRowView(k), fs.RawRowView(unique[len(unique)-1])) {
+			continue
+		}
+		unique = append(unique, k)
+	}
+	resX := mat.NewDense(len(unique), n, nil)
+	resF := mat.NewDense(len(unique), p.Objectives, nil)
+	for i, k := range unique {
+		resX.SetRow(i, xs.RawRowView(k))
+		resF.SetRow(i, fs.RawRowView(k))
+	}
+	stats.Runtime = time.Since(startTime)
+	return &Result{
+		X:      resX,
+		F:      resF,
+		Stats:  stats,
+		Status: status,
+	}, nil
+}
+
+// evaluate evaluates the objectives at the rows of xs and stores them in the
+// rows of fs using the given number of concurrent evaluations.
+func evaluate(f func(dst, x []float64), xs, fs *mat.Dense, concurrent int) {
+	r, _ := xs.Dims()
+	eval := func(i int) {
+		dst := fs.RawRowView(i)
+		f(dst, xs.RawRowView(i))
+		for j, v := range dst {
+			if math.IsNaN(v) {
+				dst[j] = math.Inf(1)
+			}
+		}
+	}
+	if concurrent < 2 {
+		for i := 0; i < r; i++ {
+			eval(i)
+		}
+		return
+	}
+	var wg sync.WaitGroup
+	jobs := make(chan int)
+	for w := 0; w < min(concurrent, r); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				eval(i)
+			}
+		}()
+	}
+	for i := 0; i < r; i++ {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+}
+
+// lessLex returns whether a precedes b in lexicographic order.
+func lessLex(a, b []float64) bool {
+	for i, v := range a {
+		if v != b[i] {
+			return v < b[i]
+		}
+	}
+	return false
+}
+
+// equalRows returns whether a and b are equal.
+func equalRows(a, b []float64) bool {
+	for i, v := range a {
+		if v != b[i] {
+			return false
+		}
+	}
+	return true
+}
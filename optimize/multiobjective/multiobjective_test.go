@@ -0,0 +1,252 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package multiobjective
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+	"gonum.org/v1/gonum/optimize/functions"
+)
+
+type testProblem interface {
+	Func(dst, x []float64)
+	Bounds(lower, upper []float64)
+	ParetoFront(n int) *mat.Dense
+}
+
+func newProblem(p testProblem, dim, objectives int) Problem {
+	lower := make([]float64, dim)
+	upper := make([]float64, dim)
+	p.Bounds(lower, upper)
+	return Problem{
+		Func:       p.Func,
+		Objectives: objectives,
+		Lower:      lower,
+		Upper:      upper,
+	}
+}
+
+func TestMinimize(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name        string
+		p           testProblem
+		dim         int
+		objectives  int
+		method      func(src rand.Source) Method
+		generations int
+		tol         float64
+	}{
+		{
+			name: "NSGA2 ZDT1", p: functions.ZDT1{}, dim: 30, objectives: 2,
+			method:      func(src rand.Source) Method { return &NSGA2{Src: src} },
+			generations: 250,
+			tol:         0.02,
+		},
+		{
+			name: "NSGA2 ZDT3", p: functions.ZDT3{}, dim: 30, objectives: 2,
+			method:      func(src rand.Source) Method { return &NSGA2{Src: src} },
+			generations: 250,
+			tol:         0.02,
+		},
+		{
+			name: "NSGA2 DTLZ2", p: functions.DTLZ2{}, dim: 12, objectives: 3,
+			method:      func(src rand.Source) Method { return &NSGA2{Src: src} },
+			generations: 200,
+			tol:         0.1,
+		},
+		{
+			name: "MOEAD ZDT1", p: functions.ZDT1{}, dim: 30, objectives: 2,
+			method:      func(src rand.Source) Method { return &MOEAD{Src: src} },
+			generations: 250,
+			tol:         0.05,
+		},
+		{
+			name: "MOEAD DTLZ2", p: functions.DTLZ2{}, dim: 12, objectives: 3,
+			method:      func(src rand.Source) Method { return &MOEAD{Src: src} },
+			generations: 200,
+			tol:         0.1,
+		},
+		{
+			name: "MOEAD PBI DTLZ2", p: functions.DTLZ2{}, dim: 12, objectives: 3,
+			method: func(src rand.Source) Method {
+				return &MOEAD{Decomposition: PBI, Src: src}
+			},
+			generations: 200,
+			tol:         0.1,
+		},
+	} {
+		p := newProblem(test.p, test.dim, test.objectives)
+		res, err := Minimize(p, &Settings{Generations: test.generations}, test.method(rand.NewSource(1)))
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", test.name, err)
+			continue
+		}
+		if res.Status != optimize.IterationLimit {
+			t.Errorf("unexpected status for %s: got:%v want:%v", test.name, res.Status, optimize.IterationLimit)
+		}
+		if res.MajorIterations != test.generations {
+			t.Errorf("unexpected number of generations for %s: got:%d want:%d", test.name, res.MajorIterations, test.generations)
+		}
+		r, _ := res.F.Dims()
+		if r == 0 {
+			t.Errorf("empty front for %s", test.name)
+			continue
+		}
+		for i := 0; i < r; i++ {
+			f := make([]float64, test.objectives)
+			p.Func(f, res.X.RawRowView(i))
+			if !mat.Equal(mat.NewVecDense(len(f), f), res.F.RowView(i)) {
+				t.Errorf("objective mismatch for %s at row %d", test.name, i)
+				break
+			}
+		}
+		for i := 1; i < r; i++ {
+			if !lessLex(res.F.RawRowView(i-1), res.F.RawRowView(i)) {
+				t.Errorf("front not sorted for %s at row %d", test.name, i)
+				break
+			}
+		}
+		if front := ParetoFront(res.F); len(front) != r {
+			t.Errorf("result contains dominated points for %s", test.name)
+		}
+		igd := IGD(res.F, test.p.ParetoFront(100))
+		if igd > test.tol {
+			t.Errorf("unexpected IGD for %s: got:%v want:<%v", test.name, igd, test.tol)
+		}
+	}
+}
+
+func TestMinimizeSettings(t *testing.T) {
+	t.Parallel()
+	p := newProblem(functions.ZDT1{}, 10, 2)
+
+	res, err := Minimize(p, &Settings{Generations: 5, FuncEvaluations: 220}, &NSGA2{Population: 50, Src: rand.NewSource(1)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.Status != optimize.FunctionEvaluationLimit {
+		t.Errorf("unexpected status: got:%v want:%v", res.Status, optimize.FunctionEvaluationLimit)
+	}
+	if res.FuncEvaluations != 200 || res.MajorIterations != 4 {
+		t.Errorf("unexpected statistics: got:%d evaluations, %d generations want:200, 4", res.FuncEvaluations, res.MajorIterations)
+	}
+
+	res, err = Minimize(p, &Settings{FuncEvaluations: 10}, &NSGA2{Population: 50})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.MajorIterations != 0 || res.Status != optimize.FunctionEvaluationLimit {
+		t.Errorf("unexpected result without evaluations: got:%d generations, status %v", res.MajorIterations, res.Status)
+	}
+	if r, _ := res.X.Dims(); r != 0 {
+		t.Errorf("unexpected locations without evaluations: got:%d rows", r)
+	}
+
+	// Concurrent evaluation must give the same result as serial evaluation.
+	serial, err := Minimize(p, &Settings{Generations: 20}, &MOEAD{Population: 30, Src: rand.NewSource(2)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	concurrent, err := Minimize(p, &Settings{Generations: 20, Concurrent: 4}, &MOEAD{Population: 30, Src: rand.NewSource(2)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !mat.Equal(serial.F, concurrent.F) {
+		t.Errorf("concurrent evaluation changed the result")
+	}
+
+	// NaN objective values are treated as +Inf.
+	nan := Problem{
+		Func: func(dst, x []float64) {
+			dst[0] = x[0]
+			dst[1] = 1 - x[0]
+			if x[0] > 0.5 {
+				dst[1] = math.NaN()
+			}
+		},
+		Objectives: 2,
+		Lower:      []float64{0},
+		Upper:      []float64{1},
+	}
+	res, err = Minimize(nan, &Settings{Generations: 20}, &NSGA2{Population: 20, Src: rand.NewSource(1)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i := 0; i < res.F.RawMatrix().Rows; i++ {
+		if math.IsNaN(res.F.At(i, 1)) {
+			t.Errorf("NaN objective in result")
+		}
+	}
+}
+
+func TestMinimizeErrors(t *testing.T) {
+	t.Parallel()
+	f := func(dst, x []float64) {}
+	if _, err := Minimize(Problem{Func: f, Objectives: 2}, nil, nil); err != optimize.ErrZeroDimensional {
+		t.Errorf("unexpected error for zero dimension: got:%v want:%v", err, optimize.ErrZeroDimensional)
+	}
+	for _, test := range []struct {
+		name   string
+		p      Problem
+		method Method
+	}{
+		{name: "nil func", p: Problem{Objectives: 2, Lower: []float64{0}, Upper: []float64{1}}},
+		{name: "no objectives", p: Problem{Func: f, Lower: []float64{0}, Upper: []float64{1}}},
+		{name: "mismatched bounds", p: Problem{Func: f, Objectives: 2, Lower: []float64{0}, Upper: []float64{1, 1}}},
+		{name: "inverted bounds", p: Problem{Func: f, Objectives: 2, Lower: []float64{1}, Upper: []float64{0}}},
+		{name: "infinite bounds", p: Problem{Func: f, Objectives: 2, Lower: []float64{0}, Upper: []float64{math.Inf(1)}}},
+		{
+			name:   "small population",
+			p:      Problem{Func: f, Objectives: 2, Lower: []float64{0}, Upper: []float64{1}},
+			method: &NSGA2{Population: 2},
+		},
+		{
+			name:   "invalid probability",
+			p:      Problem{Func: f, Objectives: 2, Lower: []float64{0}, Upper: []float64{1}},
+			method: &MOEAD{NeighborProb: 2},
+		},
+	} {
+		if !panics(func() { Minimize(test.p, nil, test.method) }) {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
+
+func TestSimplexLattice(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		m, n, want int
+	}{
+		{m: 2, n: 100, want: 100},
+		{m: 3, n: 91, want: 91},
+		{m: 3, n: 100, want: 105},
+	} {
+		w := simplexLattice(test.m, test.n)
+		r, c := w.Dims()
+		if r != test.want || c != test.m {
+			t.Errorf("unexpected lattice size for m=%d, n=%d: got:%d×%d want:%d×%d", test.m, test.n, r, c, test.want, test.m)
+		}
+		for i := 0; i < r; i++ {
+			if s := mat.Sum(w.RowView(i)); math.Abs(s-1) > 1e-14 {
+				t.Errorf("lattice point %d not on the simplex: sum=%v", i, s)
+			}
+		}
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		r := recover()
+		panicked = r != nil
+	}()
+	fn()
+	return
+}
@@ -0,0 +1,234 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package multiobjective
+
+import (
+	"sort"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+var _ Method = (*NSGA2)(nil)
+
+// NSGA2 implements the elitist non-dominated sorting genetic algorithm
+// NSGA-II of Deb et al. In each generation, a population of offspring is
+// created by binary tournament selection, simulated binary crossover and
+// polynomial mutation. The parents and the offspring are sorted into Pareto
+// fronts, and the next population is filled with the best fronts, where the
+// points of the last front that fits only partially are chosen by the
+// largest crowding distance to preserve the spread along the front. The
+// tournament prefers the better front and, within a front, the larger
+// crowding distance.
+//
+// References:
+//   - Deb, K., Pratap, A., Agarwal, S., Meyarivan, T.: A fast and elitist
+//     multiobjective genetic algorithm: NSGA-II. IEEE Transactions on
+//     Evolutionary Computation 6(2) (2002), 182-197
+type NSGA2 struct {
+	// Population is the size of the population. If Population is zero, it
+	// is defaulted to 100. Population must be at least 4, otherwise NSGA2
+	// will panic.
+	Population int
+
+	// Crossover is the probability of crossover of a pair of parents. If
+	// Crossover is zero, it is defaulted to 0.9.
+	Crossover float64
+	// CrossoverEta is the distribution index of the simulated binary
+	// crossover. Larger values create children closer to the parents. If
+	// CrossoverEta is zero, it is defaulted to 15.
+	CrossoverEta float64
+
+	// Mutation is the probability of mutation of a variable. If Mutation is
+	// zero, it is defaulted to 1/dim.
+	Mutation float64
+	// MutationEta is the distribution index of the polynomial mutation. If
+	// MutationEta is zero, it is defaulted to 20.
+	MutationEta float64
+
+	// Src allows a random number generator to be supplied for generating
+	// samples. If Src is nil the generator in golang.org/x/math/rand is
+	// used.
+	Src rand.Source
+
+	rnd          *rand.Rand
+	lower, upper []float64
+	pop          int
+	crossover    float64
+	crossoverEta float64
+	mutation     float64
+	mutationEta  float64
+
+	// xs and fs hold the population, and rank and crowd the front and the
+	// crowding distance of its members. offspring holds the generation
+	// proposed last. initialized is false until the initial population has
+	// been evaluated.
+	xs, fs      *mat.Dense
+	rank        []int
+	crowd       []float64
+	offspring   *mat.Dense
+	initialized bool
+}
+
+func (ns *NSGA2) Init(objectives int, lower, upper []float64) {
+	ns.pop = ns.Population
+	if ns.pop == 0 {
+		ns.pop = 100
+	}
+	if ns.pop < 4 {
+		panic("multiobjective: population too small")
+	}
+	ns.crossover = defaultProb(ns.Crossover, 0.9)
+	ns.crossoverEta = defaultPositive(ns.CrossoverEta, 15)
+	ns.mutation = defaultProb(ns.Mutation, 1/float64(len(lower)))
+	ns.mutationEta = defaultPositive(ns.MutationEta, 20)
+	ns.rnd = newRand(ns.Src)
+	ns.lower, ns.upper = lower, upper
+
+	dim := len(lower)
+	ns.xs = mat.NewDense(ns.pop, dim, nil)
+	ns.fs = mat.NewDense(ns.pop, objectives, nil)
+	ns.rank = make([]int, ns.pop)
+	ns.crowd = make([]float64, ns.pop)
+	ns.offspring = mat.NewDense(ns.pop, dim, nil)
+	ns.initialized = false
+	for i := 0; i < ns.pop; i++ {
+		uniform(ns.offspring.RawRowView(i), lower, upper, ns.rnd)
+	}
+}
+
+func (ns *NSGA2) Generation() *mat.Dense {
+	if !ns.initialized {
+		return ns.offspring
+	}
+	for i := 0; i < ns.pop; i += 2 {
+		p1 := ns.xs.RawRowView(ns.tournament())
+		p2 := ns.xs.RawRowView(ns.tournament())
+		c1 := ns.offspring.RawRowView(i)
+		c2 := make([]float64, len(c1))
+		if i+1 < ns.pop {
+			c2 = ns.offspring.RawRowView(i + 1)
+		}
+		if ns.rnd.Float64() < ns.crossover {
+			sbx(c1, c2, p1, p2, ns.lower, ns.upper, ns.crossoverEta, ns.rnd)
+		} else {
+			copy(c1, p1)
+			copy(c2, p2)
+		}
+		polynomialMutation(c1, ns.lower, ns.upper, ns.mutation, ns.mutationEta, ns.rnd)
+		polynomialMutation(c2, ns.lower, ns.upper, ns.mutation, ns.mutationEta, ns.rnd)
+	}
+	return ns.offspring
+}
+
+// tournament returns the winner of a binary tournament between two random
+// members of the population.
+func (ns *NSGA2) tournament() int {
+	a := ns.rnd.Intn(ns.pop)
+	b := ns.rnd.Intn(ns.pop)
+	switch {
+	case ns.rank[a] < ns.rank[b]:
+		return a
+	case ns.rank[b] < ns.rank[a]:
+		return b
+	case ns.crowd[a] > ns.crowd[b]:
+		return a
+	case ns.crowd[b] > ns.crowd[a]:
+		return b
+	}
+	if ns.rnd.Intn(2) == 0 {
+		return a
+	}
+	return b
+}
+
+func (ns *NSGA2) Update(fs *mat.Dense) {
+	if !ns.initialized {
+		ns.xs.Copy(ns.offspring)
+		ns.fs.Copy(fs)
+		ns.initialized = true
+		ns.rankPopulation()
+		return
+	}
+
+	// Combine the population and the offspring and select the best
+	// members.
+	dim := len(ns.lower)
+	_, m := fs.Dims()
+	allX := mat.NewDense(2*ns.pop, dim, nil)
+	allX.Slice(0, ns.pop, 0, dim).(*mat.Dense).Copy(ns.xs)
+	allX.Slice(ns.pop, 2*ns.pop, 0, dim).(*mat.Dense).Copy(ns.offspring)
+	allF := mat.NewDense(2*ns.pop, m, nil)
+	allF.Slice(0, ns.pop, 0, m).(*mat.Dense).Copy(ns.fs)
+	allF.Slice(ns.pop, 2*ns.pop, 0, m).(*mat.Dense).Copy(fs)
+
+	selected := make([]int, 0, ns.pop)
+	for _, front := range NonDominatedSort(allF) {
+		if len(selected)+len(front) <= ns.pop {
+			selected = append(selected, front...)
+			continue
+		}
+		// Choose the members of the last front with the largest crowding
+		// distance.
+		crowd := make([]float64, len(front))
+		CrowdingDistance(crowd, allF, front)
+		order := make([]int, len(front))
+		for i := range order {
+			order[i] = i
+		}
+		sort.SliceStable(order, func(i, j int) bool { return crowd[order[i]] > crowd[order[j]] })
+		for _, i := range order[:ns.pop-len(selected)] {
+			selected = append(selected, front[i])
+		}
+		break
+	}
+	for i, k := range selected {
+		ns.xs.SetRow(i, allX.RawRowView(k))
+		ns.fs.SetRow(i, allF.RawRowView(k))
+	}
+	ns.rankPopulation()
+}
+
+// rankPopulation computes the front and the crowding distance of the
+// members of the population.
+func (ns *NSGA2) rankPopulation() {
+	for r, front := range NonDominatedSort(ns.fs) {
+		crowd := make([]float64, len(front))
+		CrowdingDistance(crowd, ns.fs, front)
+		for i, k := range front {
+			ns.rank[k] = r
+			ns.crowd[k] = crowd[i]
+		}
+	}
+}
+
+func (ns *NSGA2) Solutions() (xs, fs *mat.Dense) {
+	return ns.xs, ns.fs
+}
+
+// defaultProb returns p, or def if p is zero. It panics if p is not a
+// probability.
+func defaultProb(p, def float64) float64 {
+	if p == 0 {
+		return def
+	}
+	if !(p > 0 && p <= 1) {
+		panic("multiobjective: invalid probability")
+	}
+	return p
+}
+
+// defaultPositive returns v, or def if v is zero. It panics if v is not
+// positive.
+func defaultPositive(v, def float64) float64 {
+	if v == 0 {
+		return def
+	}
+	if !(v > 0) {
+		panic("multiobjective: invalid parameter")
+	}
+	return v
+}
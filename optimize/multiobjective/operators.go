@@ -0,0 +1,86 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package multiobjective
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+)
+
+// sbx performs the simulated binary crossover of Deb and Agrawal of the
+// parents p1 and p2 with the distribution index eta and stores the children
+// in c1 and c2. Each variable is crossed over with probability 1/2, and the
+// children are kept within the bounds.
+func sbx(c1, c2, p1, p2, lower, upper []float64, eta float64, rnd *rand.Rand) {
+	copy(c1, p1)
+	copy(c2, p2)
+	for i := range c1 {
+		if rnd.Float64() > 0.5 || math.Abs(p1[i]-p2[i]) <= 1e-14 {
+			continue
+		}
+		y1, y2 := math.Min(p1[i], p2[i]), math.Max(p1[i], p2[i])
+		lo, hi := lower[i], upper[i]
+		r := rnd.Float64()
+		betaq := func(beta float64) float64 {
+			alpha := 2 - math.Pow(beta, -(eta+1))
+			if r <= 1/alpha {
+				return math.Pow(r*alpha, 1/(eta+1))
+			}
+			return math.Pow(1/(2-r*alpha), 1/(eta+1))
+		}
+		v1 := 0.5 * (y1 + y2 - betaq(1+2*(y1-lo)/(y2-y1))*(y2-y1))
+		v2 := 0.5 * (y1 + y2 + betaq(1+2*(hi-y2)/(y2-y1))*(y2-y1))
+		v1 = math.Min(math.Max(v1, lo), hi)
+		v2 = math.Min(math.Max(v2, lo), hi)
+		if rnd.Float64() < 0.5 {
+			v1, v2 = v2, v1
+		}
+		c1[i], c2[i] = v1, v2
+	}
+}
+
+// polynomialMutation performs the polynomial mutation of Deb and Goyal of x
+// in place with the distribution index eta. Each variable is mutated with
+// probability prob and kept within the bounds.
+func polynomialMutation(x, lower, upper []float64, prob, eta float64, rnd *rand.Rand) {
+	for i, y := range x {
+		if rnd.Float64() >= prob {
+			continue
+		}
+		lo, hi := lower[i], upper[i]
+		if hi == lo {
+			continue
+		}
+		d1 := (y - lo) / (hi - lo)
+		d2 := (hi - y) / (hi - lo)
+		r := rnd.Float64()
+		var dq float64
+		if r < 0.5 {
+			v := 2*r + (1-2*r)*math.Pow(1-d1, eta+1)
+			dq = math.Pow(v, 1/(eta+1)) - 1
+		} else {
+			v := 2*(1-r) + 2*(r-0.5)*math.Pow(1-d2, eta+1)
+			dq = 1 - math.Pow(v, 1/(eta+1))
+		}
+		x[i] = math.Min(math.Max(y+dq*(hi-lo), lo), hi)
+	}
+}
+
+// uniform stores a location sampled uniformly within the bounds in x.
+func uniform(x, lower, upper []float64, rnd *rand.Rand) {
+	for i := range x {
+		x[i] = lower[i] + (upper[i]-lower[i])*rnd.Float64()
+	}
+}
+
+// newRand returns a random number generator using src, or a source seeded
+// from the global generator if src is nil.
+func newRand(src rand.Source) *rand.Rand {
+	if src == nil {
+		src = rand.NewSource(rand.Uint64())
+	}
+	return rand.New(src)
+}
@@ -0,0 +1,299 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package multiobjective
+
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// Dominates returns whether the objective vector a Pareto dominates b, that
+// is whether a is not worse than b in all objectives and better in at least
+// one. Dominates panics if the lengths of a and b are not equal.
+func Dominates(a, b []float64) bool {
+	if len(a) != len(b) {
+		panic("multiobjective: mismatched lengths")
+	}
+	better := false
+	for i, v := range a {
+		switch {
+		case v > b[i]:
+			return false
+		case v < b[i]:
+			better = true
+		}
+	}
+	return better
+}
+
+// NonDominatedSort sorts the objective vectors in the rows of f into Pareto
+// fronts using the fast non-dominated sorting of Deb et al. The first front
+// contains the indices of the rows that are not dominated by any other row,
+// and each following front contains the rows that are dominated only by rows
+// in the preceding fronts.
+func NonDominatedSort(f mat.Matrix) [][]int {
+	fs := rows(f)
+	r := len(fs)
+	// dominated[i] holds the rows dominated by row i, and count[i] the
+	// number of rows that dominate row i.
+	dominated := make([][]int, r)
+	count := make([]int, r)
+	for i := 0; i < r; i++ {
+		for j := i + 1; j < r; j++ {
+			switch {
+			case Dominates(fs[i], fs[j]):
+				dominated[i] = append(dominated[i], j)
+				count[j]++
+			case Dominates(fs[j], fs[i]):
+				dominated[j] = append(dominated[j], i)
+				count[i]++
+			}
+		}
+	}
+	var front []int
+	for i, c := range count {
+		if c == 0 {
+			front = append(front, i)
+		}
+	}
+	var fronts [][]int
+	for len(front) > 0 {
+		fronts = append(fronts, front)
+		var next []int
+		for _, i := range front {
+			for _, j := range dominated[i] {
+				count[j]--
+				if count[j] == 0 {
+					next = append(next, j)
+				}
+			}
+		}
+		sort.Ints(next)
+		front = next
+	}
+	return fronts
+}
+
+// ParetoFront returns the indices of the rows of f that are not dominated by
+// any other row in increasing order.
+func ParetoFront(f mat.Matrix) []int {
+	fs := rows(f)
+	var front []int
+	for i, a := range fs {
+		dominated := false
+		for j, b := range fs {
+			if j != i && Dominates(b, a) {
+				dominated = true
+				break
+			}
+		}
+		if !dominated {
+			front = append(front, i)
+		}
+	}
+	return front
+}
+
+// CrowdingDistance computes the crowding distance of the rows of f with the
+// given indices and stores it in dst. The crowding distance of a point is
+// the sum over the objectives of the distance between its two neighbors in
+// the front along the objective, normalized by the range of the objective
+// in the front. The extreme points of each objective have infinite crowding
+// distance. CrowdingDistance panics if the lengths of dst and front are not
+// equal.
+func CrowdingDistance(dst []float64, f mat.Matrix, front []int) {
+	if len(dst) != len(front) {
+		panic("multiobjective: mismatched lengths")
+	}
+	for i := range dst {
+		dst[i] = 0
+	}
+	n := len(front)
+	if n == 0 {
+		return
+	}
+	_, m := f.Dims()
+	order := make([]int, n)
+	for k := 0; k < m; k++ {
+		for i := range order {
+			order[i] = i
+		}
+		sort.SliceStable(order, func(i, j int) bool {
+			return f.At(front[order[i]], k) < f.At(front[order[j]], k)
+		})
+		lo := f.At(front[order[0]], k)
+		hi := f.At(front[order[n-1]], k)
+		dst[order[0]] = math.Inf(1)
+		dst[order[n-1]] = math.Inf(1)
+		if hi == lo || math.IsInf(hi-lo, 0) {
+			continue
+		}
+		for i := 1; i < n-1; i++ {
+			dst[order[i]] += (f.At(front[order[i+1]], k) - f.At(front[order[i-1]], k)) / (hi - lo)
+		}
+	}
+}
+
+// Hypervolume returns the hypervolume indicator of the objective vectors in
+// the rows of f with respect to the reference point ref, which is the volume
+// of the region dominated by the rows of f and bounded by ref. Rows that do
+// not dominate ref strictly in all objectives do not contribute. A larger
+// hypervolume indicates a better approximation of the Pareto front.
+//
+// The hypervolume is computed exactly by slicing the objective space along
+// the objectives, which needs O(n^(m-1)) time for n points and m
+// objectives.
+//
+// Hypervolume panics if the length of ref does not equal the number of
+// columns of f.
+func Hypervolume(f mat.Matrix, ref []float64) float64 {
+	r, m := f.Dims()
+	if len(ref) != m {
+		panic("multiobjective: mismatched reference point")
+	}
+	var points [][]float64
+	for i := 0; i < r; i++ {
+		p := make([]float64, m)
+		inside := true
+		for k := range p {
+			p[k] = f.At(i, k)
+			if !(p[k] < ref[k]) {
+				inside = false
+			}
+		}
+		if inside {
+			points = append(points, p)
+		}
+	}
+	return hypervolume(points, ref, m)
+}
+
+// hypervolume returns the hypervolume of points in the first d objectives.
+// All points dominate ref strictly in the first d objectives.
+func hypervolume(points [][]float64, ref []float64, d int) float64 {
+	if len(points) == 0 {
+		return 0
+	}
+	switch d {
+	case 1:
+		lo := points[0][0]
+		for _, p := range points[1:] {
+			lo = math.Min(lo, p[0])
+		}
+		return ref[0] - lo
+	case 2:
+		sorted := make([][]float64, len(points))
+		copy(sorted, points)
+		sort.Slice(sorted, func(i, j int) bool {
+			if sorted[i][0] != sorted[j][0] {
+				return sorted[i][0] < sorted[j][0]
+			}
+			return sorted[i][1] < sorted[j][1]
+		})
+		var vol float64
+		top := ref[1]
+		for _, p := range sorted {
+			if p[1] < top {
+				vol += (ref[0] - p[0]) * (top - p[1])
+				top = p[1]
+			}
+		}
+		return vol
+	}
+	// Slice the space along the last objective. Between consecutive values
+	// of the last objective, the dominated region is the hypervolume of the
+	// points below the slice in the remaining objectives.
+	sorted := make([][]float64, len(points))
+	copy(sorted, points)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i][d-1] < sorted[j][d-1] })
+	var vol float64
+	for i, p := range sorted {
+		next := ref[d-1]
+		if i+1 < len(sorted) {
+			next = sorted[i+1][d-1]
+		}
+		if h := next - p[d-1]; h > 0 {
+			vol += h * hypervolume(nonDominated(sorted[:i+1], d-1), ref, d-1)
+		}
+	}
+	return vol
+}
+
+// nonDominated returns the points that are not dominated by other points in
+// the first d objectives.
+func nonDominated(points [][]float64, d int) [][]float64 {
+	var nd [][]float64
+	for i, a := range points {
+		dominated := false
+		for j, b := range points {
+			if j != i && Dominates(b[:d], a[:d]) {
+				dominated = true
+				break
+			}
+		}
+		if !dominated {
+			nd = append(nd, a)
+		}
+	}
+	return nd
+}
+
+// IGD returns the inverted generational distance of the objective vectors
+// in the rows of f with respect to the reference points in the rows of ref,
+// which is the mean over the reference points of the Euclidean distance to
+// the nearest row of f. With reference points sampled from the true Pareto
+// front, a smaller IGD indicates an approximation that is both closer to
+// and more evenly spread along the front.
+//
+// IGD panics if f and ref have different numbers of columns or if either
+// has no rows.
+func IGD(f, ref mat.Matrix) float64 {
+	fs := rows(f)
+	refs := rows(ref)
+	_, m := f.Dims()
+	_, mr := ref.Dims()
+	if m != mr {
+		panic("multiobjective: mismatched number of objectives")
+	}
+	if len(fs) == 0 || len(refs) == 0 {
+		panic("multiobjective: empty set of points")
+	}
+	var sum float64
+	for _, q := range refs {
+		best := math.Inf(1)
+		for _, p := range fs {
+			var d float64
+			for k, v := range p {
+				d += (v - q[k]) * (v - q[k])
+			}
+			best = math.Min(best, d)
+		}
+		sum += math.Sqrt(best)
+	}
+	return sum / float64(len(refs))
+}
+
+// rows returns the rows of a.
+func rows(a mat.Matrix) [][]float64 {
+	r, c := a.Dims()
+	if rv, ok := a.(mat.RawMatrixer); ok {
+		raw := rv.RawMatrix()
+		out := make([][]float64, r)
+		for i := range out {
+			out[i] = raw.Data[i*raw.Stride : i*raw.Stride+c]
+		}
+		return out
+	}
+	out := make([][]float64, r)
+	for i := range out {
+		out[i] = make([]float64, c)
+		for j := range out[i] {
+			out[i][j] = a.At(i, j)
+		}
+	}
+	return out
+}
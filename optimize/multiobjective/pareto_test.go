@@ -0,0 +1,194 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package multiobjective
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestDominates(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		a, b []float64
+		want bool
+	}{
+		{a: []float64{1, 2}, b: []float64{2, 3}, want: true},
+		{a: []float64{1, 3}, b: []float64{2, 3}, want: true},
+		{a: []float64{1, 3}, b: []float64{1, 3}, want: false},
+		{a: []float64{1, 4}, b: []float64{2, 3}, want: false},
+		{a: []float64{2, 3}, b: []float64{1, 2}, want: false},
+	} {
+		if got := Dominates(test.a, test.b); got != test.want {
+			t.Errorf("unexpected result for Dominates(%v, %v): got:%t want:%t", test.a, test.b, got, test.want)
+		}
+	}
+	if !panics(func() { Dominates([]float64{1}, []float64{1, 2}) }) {
+		t.Errorf("expected panic for mismatched lengths")
+	}
+}
+
+func TestNonDominatedSort(t *testing.T) {
+	t.Parallel()
+	f := mat.NewDense(7, 2, []float64{
+		1, 5,
+		2, 2,
+		5, 1,
+		3, 3,
+		2, 6,
+		4, 4,
+		6, 6,
+	})
+	want := [][]int{{0, 1, 2}, {3, 4}, {5}, {6}}
+	got := NonDominatedSort(f)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected fronts: got:%v want:%v", got, want)
+	}
+	if front := ParetoFront(f); !reflect.DeepEqual(front, want[0]) {
+		t.Errorf("unexpected Pareto front: got:%v want:%v", front, want[0])
+	}
+}
+
+func TestCrowdingDistance(t *testing.T) {
+	t.Parallel()
+	f := mat.NewDense(4, 2, []float64{
+		0, 4,
+		1, 2,
+		3, 1,
+		4, 0,
+	})
+	dst := make([]float64, 4)
+	CrowdingDistance(dst, f, []int{0, 1, 2, 3})
+	inf := math.Inf(1)
+	want := []float64{inf, 3.0/4 + 3.0/4, 3.0/4 + 2.0/4, inf}
+	for i, v := range dst {
+		if v != want[i] && !scalar.EqualWithinAbs(v, want[i], 1e-14) {
+			t.Errorf("unexpected crowding distance of point %d: got:%v want:%v", i, v, want[i])
+		}
+	}
+}
+
+func TestHypervolume(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		f    *mat.Dense
+		ref  []float64
+		want float64
+	}{
+		{
+			name: "2D",
+			f:    mat.NewDense(3, 2, []float64{1, 3, 2, 2, 3, 1}),
+			ref:  []float64{4, 4},
+			want: 6,
+		},
+		{
+			name: "2D dominated and outside",
+			f:    mat.NewDense(5, 2, []float64{1, 3, 2, 2, 3, 1, 3, 3, 5, 0}),
+			ref:  []float64{4, 4},
+			want: 6,
+		},
+		{
+			name: "3D",
+			f:    mat.NewDense(2, 3, []float64{0.5, 0, 0, 0, 0.5, 0}),
+			ref:  []float64{1, 1, 1},
+			want: 0.75,
+		},
+		{
+			name: "4D single",
+			f:    mat.NewDense(1, 4, []float64{0, 0.5, 0.5, 0.75}),
+			ref:  []float64{1, 1, 1, 1},
+			want: 0.5 * 0.5 * 0.25,
+		},
+	} {
+		got := Hypervolume(test.f, test.ref)
+		if !scalar.EqualWithinAbs(got, test.want, 1e-14) {
+			t.Errorf("unexpected hypervolume for %s: got:%v want:%v", test.name, got, test.want)
+		}
+	}
+
+	// Compare with the inclusion-exclusion formula over all subsets.
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{2, 3, 4} {
+		for trial := 0; trial < 10; trial++ {
+			n := 1 + rnd.Intn(6)
+			f := mat.NewDense(n, m, nil)
+			for i := 0; i < n; i++ {
+				for k := 0; k < m; k++ {
+					f.Set(i, k, rnd.Float64())
+				}
+			}
+			ref := make([]float64, m)
+			for k := range ref {
+				ref[k] = 1
+			}
+			got := Hypervolume(f, ref)
+			want := inclusionExclusion(f, ref)
+			if !scalar.EqualWithinAbs(got, want, 1e-12) {
+				t.Errorf("unexpected hypervolume for m=%d, trial %d: got:%v want:%v", m, trial, got, want)
+			}
+		}
+	}
+
+	if !panics(func() { Hypervolume(mat.NewDense(1, 2, nil), []float64{1}) }) {
+		t.Errorf("expected panic for mismatched reference point")
+	}
+}
+
+// inclusionExclusion returns the hypervolume of the rows of f with respect
+// to ref by the inclusion-exclusion formula over the boxes dominated by the
+// rows.
+func inclusionExclusion(f *mat.Dense, ref []float64) float64 {
+	n, m := f.Dims()
+	var vol float64
+	corner := make([]float64, m)
+	for set := 1; set < 1<<n; set++ {
+		for k := range corner {
+			corner[k] = math.Inf(-1)
+		}
+		var size int
+		for i := 0; i < n; i++ {
+			if set&(1<<i) == 0 {
+				continue
+			}
+			size++
+			for k := range corner {
+				corner[k] = math.Max(corner[k], f.At(i, k))
+			}
+		}
+		box := 1.0
+		for k, v := range corner {
+			box *= math.Max(ref[k]-v, 0)
+		}
+		if size%2 == 1 {
+			vol += box
+		} else {
+			vol -= box
+		}
+	}
+	return vol
+}
+
+func TestIGD(t *testing.T) {
+	t.Parallel()
+	ref := mat.NewDense(3, 2, []float64{0, 1, 0.5, 0.5, 1, 0})
+	if got := IGD(ref, ref); got != 0 {
+		t.Errorf("unexpected IGD of the reference points: got:%v want:0", got)
+	}
+	f := mat.NewDense(1, 2, []float64{0.5, 0.5})
+	want := (2*math.Sqrt(0.5) + 0) / 3
+	if got := IGD(f, ref); !scalar.EqualWithinAbs(got, want, 1e-14) {
+		t.Errorf("unexpected IGD: got:%v want:%v", got, want)
+	}
+	if !panics(func() { IGD(f, mat.NewDense(1, 3, nil)) }) {
+		t.Errorf("expected panic for mismatched number of objectives")
+	}
+}
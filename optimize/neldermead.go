@@ -5,6 +5,7 @@
 package optimize
 
 import (
+	"encoding"
 	"math"
 	"sort"
 
@@ -42,7 +43,11 @@ func (n nmVertexSorter) Swap(i, j int) {
 	n.vertices[i], n.vertices[j] = n.vertices[j], n.vertices[i]
 }
 
-var _ Method = (*NelderMead)(nil)
+var (
+	_ Method                     = (*NelderMead)(nil)
+	_ encoding.BinaryMarshaler   = (*NelderMead)(nil)
+	_ encoding.BinaryUnmarshaler = (*NelderMead)(nil)
+)
 
 // NelderMead is an implementation of the Nelder-Mead simplex algorithm for
 // gradient-free nonlinear optimization (not to be confused with Danzig's
@@ -61,6 +66,10 @@ var _ Method = (*NelderMead)(nil)
 // the recommendations in
 //
 //	http://www.webpages.uidaho.edu/~fuchang/res/ANMS.pdf
+//
+// The state of NelderMead at a major iteration can be saved with
+// MarshalBinary and restored with UnmarshalBinary to continue the
+// optimization with Resume.
 type NelderMead struct {
 	InitialVertices [][]float64
 	InitialValues   []float64
@@ -86,6 +95,8 @@ type NelderMead struct {
 	lastIter       nmIterType // Last iteration
 	reflectedPoint []float64  // Storage of the reflected point location
 	reflectedValue float64    // Value at the last reflection point
+
+	restored bool // Indicator of the state restored by UnmarshalBinary
 }
 
 func (n *NelderMead) Status() (Status, error) {
@@ -99,6 +110,9 @@ func (*NelderMead) Uses(has Available) (uses Available, err error) {
 func (n *NelderMead) Init(dim, tasks int) int {
 	n.status = NotTerminated
 	n.err = nil
+	if !n.restored {
+		n.vertices = n.vertices[:0]
+	}
 	return 1
 }
 
@@ -109,6 +123,16 @@ func (n *NelderMead) Run(operation chan<- Task, result <-chan Task, tasks []Task
 
 func (n *NelderMead) initLocal(loc *Location) (Operation, error) {
 	dim := len(loc.X)
+	if n.restored {
+		// Continue from the major iteration at which the state was saved.
+		n.restored = false
+		if len(n.vertices) != dim+1 {
+			panic("neldermead: restored state does not match the problem dimension")
+		}
+		n.reflectedPoint = resize(n.reflectedPoint, dim)
+		return n.returnNext(nmReflected, loc)
+	}
+
 	if cap(n.vertices) < dim+1 {
 		n.vertices = make([][]float64, dim+1)
 	}
@@ -346,3 +370,73 @@ func (*NelderMead) needs() struct {
 		Hessian  bool
 	}{false, false}
 }
+
+// MarshalBinary encodes the state of the method at the last major iteration
+// into a binary form and returns the result.
+func (n *NelderMead) MarshalBinary() ([]byte, error) {
+	var e encoder
+	e.header("neldermead")
+	if len(n.vertices) == 0 || n.lastIter != nmMajor {
+		// The initial simplex has not been constructed.
+		e.int(0)
+		return e.buf, nil
+	}
+	e.int(len(n.vertices))
+	for i, v := range n.vertices {
+		e.floats(v)
+		e.float64(n.values[i])
+	}
+	e.floats(n.centroid)
+	e.float64(n.reflection)
+	e.float64(n.expansion)
+	e.float64(n.contraction)
+	e.float64(n.shrink)
+	return e.buf, nil
+}
+
+// UnmarshalBinary restores the state of the method from the binary form
+// returned by MarshalBinary. The next optimization with the method continues
+// from the restored state. UnmarshalBinary returns ErrCheckpointMethod if data
+// is not the state of a NelderMead, and ErrBadCheckpoint if data is malformed.
+func (n *NelderMead) UnmarshalBinary(data []byte) error {
+	d := decoder{buf: data}
+	d.header("neldermead")
+	size := d.int()
+	if d.err == nil && size == 0 {
+		n.restored = false
+		return d.finish()
+	}
+	if d.err == nil && (size < 2 || size > d.remaining()) {
+		return ErrBadCheckpoint
+	}
+	vertices := make([][]float64, max(size, 0))
+	values := make([]float64, len(vertices))
+	for i := range vertices {
+		vertices[i] = d.floats()
+		values[i] = d.float64()
+		if d.err == nil && len(vertices[i]) != size-1 {
+			return ErrBadCheckpoint
+		}
+	}
+	centroid := d.floats()
+	reflection := d.float64()
+	expansion := d.float64()
+	contraction := d.float64()
+	shrink := d.float64()
+	if err := d.finish(); err != nil {
+		return err
+	}
+	if len(centroid) != size-1 {
+		return ErrBadCheckpoint
+	}
+	n.vertices = vertices
+	n.values = values
+	n.centroid = centroid
+	n.reflection = reflection
+	n.expansion = expansion
+	n.contraction = contraction
+	n.shrink = shrink
+	n.lastIter = nmMajor
+	n.restored = true
+	return nil
+}
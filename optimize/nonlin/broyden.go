@@ -0,0 +1,121 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nonlin
+
+import (
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+var _ Method = (*Broyden)(nil)
+
+// Broyden implements Broyden's quasi-Newton methods for systems of nonlinear
+// equations. Broyden maintains an approximation H of the inverse Jacobian,
+// which is initialized with the inverse of the Jacobian at the initial
+// location and updated after every step s with the change y of the function
+// values so that H*y = s. Each iteration takes the step -H*F(x) with a
+// backtracking line search on the norm of the function values, so that
+// iterations do not need Jacobian evaluations.
+//
+// The first ("good") method updates the Jacobian approximation by the
+// rank-one correction of least Frobenius norm,
+//
+//	H += (s - H*y) sᵀH / (sᵀH*y),
+//
+// and the second ("bad") method updates the inverse directly,
+//
+//	H += (s - H*y) yᵀ / (yᵀy).
+//
+// If the line search fails, the Jacobian is evaluated at the current
+// location and the approximation is restarted. Broyden returns
+// ErrNoProgress if the line search fails right after a restart, and
+// ErrSingular if the Jacobian is singular.
+//
+// Reference:
+//   - Broyden, C.G.: A class of methods for solving nonlinear simultaneous
+//     equations. Mathematics of Computation 19 (1965), 577-593
+type Broyden struct {
+	// Bad specifies whether Broyden's second method is used.
+	Bad bool
+
+	inv     mat.Dense // Approximation of the inverse Jacobian
+	jac     *mat.Dense
+	restart bool // Whether the inverse is evaluated at the next iteration
+	fresh   bool // Whether the inverse has not been updated
+
+	dir  []float64
+	fOld []float64
+	hy   []float64
+	xNew []float64
+	fNew []float64
+}
+
+func (b *Broyden) Init(n int) {
+	b.inv.Reset()
+	b.jac = mat.NewDense(n, n, nil)
+	b.restart = true
+	b.fresh = false
+	b.dir = make([]float64, n)
+	b.fOld = make([]float64, n)
+	b.hy = make([]float64, n)
+	b.xNew = make([]float64, n)
+	b.fNew = make([]float64, n)
+}
+
+func (b *Broyden) Iterate(loc *Location, sys *System) (optimize.Status, error) {
+	n := len(loc.X)
+	for {
+		if b.restart {
+			sys.Jacobian(b.jac, loc.X, loc.F)
+			if err := b.inv.Inverse(b.jac); err != nil {
+				return optimize.Failure, ErrSingular
+			}
+			b.restart = false
+			b.fresh = true
+		}
+
+		dir := mat.NewVecDense(n, b.dir)
+		dir.MulVec(&b.inv, mat.NewVecDense(n, loc.F))
+		floats.Scale(-1, b.dir)
+		copy(b.fOld, loc.F)
+		t, err := backtrack(loc, sys, b.dir, b.xNew, b.fNew, 0)
+		if err != nil {
+			return optimize.Failure, err
+		}
+		if t == 0 {
+			if b.fresh {
+				return optimize.Failure, ErrNoProgress
+			}
+			b.restart = true
+			continue
+		}
+
+		// Update the inverse with the step s = t*dir and the change of the
+		// function values y, stored in fOld.
+		s := mat.NewVecDense(n, b.dir)
+		s.ScaleVec(t, s)
+		y := mat.NewVecDense(n, b.fOld)
+		floats.SubTo(b.fOld, loc.F, b.fOld)
+		hy := mat.NewVecDense(n, b.hy)
+		hy.MulVec(&b.inv, y)
+		if b.Bad {
+			if yy := mat.Dot(y, y); yy != 0 {
+				hy.SubVec(s, hy)
+				b.inv.RankOne(&b.inv, 1/yy, hy, y)
+			}
+		} else {
+			shy := mat.Dot(s, hy)
+			if shy != 0 {
+				hy.SubVec(s, hy)
+				// Reuse fOld to hold Hᵀs.
+				y.MulVec(b.inv.T(), s)
+				b.inv.RankOne(&b.inv, 1/shy, hy, y)
+			}
+		}
+		b.fresh = false
+		return optimize.NotTerminated, nil
+	}
+}
@@ -0,0 +1,11 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package nonlin implements routines for solving systems of nonlinear
+// equations
+//
+//	F(x) = 0,
+//
+// where F is a vector-valued function of n variables with n components.
+package nonlin // import "gonum.org/v1/gonum/optimize/nonlin"
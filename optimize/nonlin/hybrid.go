@@ -0,0 +1,322 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nonlin
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+const (
+	// maxSlowIterations is the number of consecutive accepted steps with a
+	// small reduction of the norm of the function values after which Hybrid
+	// reports no progress.
+	maxSlowIterations = 10
+	// maxSlowJacobians is the number of consecutive Jacobian evaluations
+	// without an accepted step after which Hybrid reports no progress.
+	maxSlowJacobians = 5
+)
+
+var _ Method = (*Hybrid)(nil)
+
+// Hybrid implements Powell's hybrid method for systems of nonlinear
+// equations in the form of MINPACK's hybrd and hybrj. At each iteration the
+// step is chosen by the dogleg method in a trust region around the current
+// location, combining the Newton step with the steepest descent direction of
+// the norm of the function values. The Jacobian is evaluated at the start
+// and whenever the steps repeatedly fail, and it is updated with Broyden's
+// rank-one formula in between, so that most iterations need a single
+// function evaluation. The variables are scaled by the column norms of the
+// Jacobian.
+//
+// Hybrid returns ErrNoProgress if the iterations do not reduce the norm of
+// the function values sufficiently. This usually indicates that the
+// iterates approach a local minimum of the norm that is not a root.
+//
+// References:
+//   - Powell, M.J.D.: A hybrid method for nonlinear equations. In:
+//     Rabinowitz, P. (ed.) Numerical Methods for Nonlinear Algebraic
+//     Equations, pp. 87-114. Gordon and Breach (1970)
+//   - Moré, J.J., Garbow, B.S., Hillstrom, K.E.: User Guide for MINPACK-1.
+//     Technical Report ANL-80-74, Argonne National Laboratory (1980)
+type Hybrid struct {
+	// InitialRadius determines the initial radius of the trust region, which
+	// is InitialRadius times the scaled norm of the initial location, or
+	// InitialRadius if that norm is zero. If InitialRadius is zero, it is
+	// defaulted to 100.
+	InitialRadius float64
+
+	jac     *mat.Dense
+	lu      mat.LU
+	diag    []float64 // Scaling of the variables
+	delta   float64   // Radius of the trust region
+	needJac bool
+	first   bool
+
+	ncsuc  int // Consecutive successful steps
+	ncfail int // Consecutive failed steps
+	nslow1 int // Consecutive accepted steps with small reduction
+	nslow2 int // Consecutive Jacobian evaluations without accepted step
+
+	newton []float64 // Newton step
+	grad   []float64 // Scaled gradient of the norm
+	step   []float64
+	jp     []float64
+	xNew   []float64
+	fNew   []float64
+	work   []float64
+}
+
+func (h *Hybrid) Init(n int) {
+	if h.InitialRadius < 0 {
+		panic("nonlin: negative initial radius")
+	}
+	h.jac = mat.NewDense(n, n, nil)
+	h.diag = make([]float64, n)
+	h.delta = 0
+	h.needJac = true
+	h.first = true
+	h.ncsuc, h.ncfail, h.nslow1, h.nslow2 = 0, 0, 0, 0
+	h.newton = make([]float64, n)
+	h.grad = make([]float64, n)
+	h.step = make([]float64, n)
+	h.jp = make([]float64, n)
+	h.xNew = make([]float64, n)
+	h.fNew = make([]float64, n)
+	h.work = make([]float64, n)
+}
+
+func (h *Hybrid) Iterate(loc *Location, sys *System) (optimize.Status, error) {
+	if h.nslow1 >= maxSlowIterations {
+		return optimize.Failure, ErrNoProgress
+	}
+	n := len(loc.X)
+	for {
+		if h.needJac {
+			if h.nslow2 >= maxSlowJacobians {
+				return optimize.Failure, ErrNoProgress
+			}
+			h.nslow2++
+			h.needJac = false
+			h.ncfail = 0
+			sys.Jacobian(h.jac, loc.X, loc.F)
+			h.updateScaling(loc.X)
+		}
+
+		if !h.dogleg(loc.F) {
+			return optimize.Failure, ErrNoProgress
+		}
+		pnorm := scaledNorm(h.diag, h.step)
+		if h.first {
+			h.delta = math.Min(h.delta, pnorm)
+		}
+
+		floats.AddTo(h.xNew, loc.X, h.step)
+		if err := sys.Func(h.fNew, h.xNew); err != nil {
+			return optimize.Failure, err
+		}
+		fnorm1 := floats.Norm(h.fNew, 2)
+
+		// Compute the ratio of the actual to the predicted reduction of the
+		// squared norm.
+		actred := -1.0
+		if isFinite(fnorm1) && fnorm1 < loc.Norm {
+			r := fnorm1 / loc.Norm
+			actred = 1 - r*r
+		}
+		jp := mat.NewVecDense(n, h.jp)
+		jp.MulVec(h.jac, mat.NewVecDense(n, h.step))
+		floats.Add(h.jp, loc.F)
+		var prered float64
+		if lin := floats.Norm(h.jp, 2); lin < loc.Norm {
+			r := lin / loc.Norm
+			prered = 1 - r*r
+		}
+		var ratio float64
+		if prered > 0 {
+			ratio = actred / prered
+		}
+
+		// Update the radius of the trust region.
+		if ratio < 0.1 {
+			h.ncsuc = 0
+			h.ncfail++
+			h.delta /= 2
+		} else {
+			h.ncfail = 0
+			h.ncsuc++
+			if ratio >= 0.5 || h.ncsuc > 1 {
+				h.delta = math.Max(h.delta, pnorm/0.5)
+			}
+			if math.Abs(ratio-1) <= 0.1 {
+				h.delta = pnorm / 0.5
+			}
+		}
+
+		// Update the Jacobian with Broyden's formula in the scaled
+		// variables,
+		//  J += (F(x+p) - F(x) - J*p) (D²p)ᵀ / |D*p|².
+		if isFinite(fnorm1) && pnorm > 0 {
+			for i := range h.jp {
+				h.jp[i] = h.fNew[i] - h.jp[i]
+			}
+			for i, d := range h.diag {
+				h.work[i] = d * d * h.step[i] / (pnorm * pnorm)
+			}
+			h.jac.RankOne(h.jac, 1, mat.NewVecDense(n, h.jp), mat.NewVecDense(n, h.work))
+		}
+
+		if ratio >= 1e-4 {
+			// Accept the step.
+			if actred >= 0.001 {
+				h.nslow1 = 0
+			} else {
+				h.nslow1++
+			}
+			h.nslow2 = 0
+			h.first = false
+			copy(loc.X, h.xNew)
+			copy(loc.F, h.fNew)
+			loc.Norm = fnorm1
+			return optimize.NotTerminated, nil
+		}
+
+		if h.delta <= dlamchE*scaledNorm(h.diag, loc.X) || h.delta == 0 {
+			// The trust region cannot be reduced further.
+			return optimize.StepConvergence, nil
+		}
+		if h.ncfail == 2 {
+			// The Broyden updates have not produced a useful model.
+			h.needJac = true
+		}
+	}
+}
+
+// updateScaling updates the scaling of the variables from the column norms
+// of the Jacobian. At the first evaluation, it also sets the initial radius
+// of the trust region.
+func (h *Hybrid) updateScaling(x []float64) {
+	n := len(x)
+	for j := 0; j < n; j++ {
+		norm := mat.Norm(h.jac.ColView(j), 2)
+		if h.first && h.delta == 0 {
+			if norm == 0 {
+				norm = 1
+			}
+			h.diag[j] = norm
+		} else {
+			h.diag[j] = math.Max(h.diag[j], norm)
+		}
+	}
+	if h.first && h.delta == 0 {
+		factor := h.InitialRadius
+		if factor == 0 {
+			factor = 100
+		}
+		h.delta = factor * scaledNorm(h.diag, x)
+		if h.delta == 0 {
+			h.delta = factor
+		}
+	}
+}
+
+// dogleg computes the dogleg step in the trust region of the scaled
+// variables and stores it in h.step. It returns false if no descent
+// direction exists.
+func (h *Hybrid) dogleg(f []float64) bool {
+	n := len(f)
+
+	// Compute the Newton step if the Jacobian is not singular.
+	newtonOK := false
+	h.lu.Factorize(h.jac)
+	if h.lu.Cond() < 1/dlamchE {
+		dst := mat.NewVecDense(n, h.newton)
+		if err := h.lu.SolveVecTo(dst, false, mat.NewVecDense(n, f)); err == nil {
+			floats.Scale(-1, h.newton)
+			newtonOK = true
+		}
+	}
+	if newtonOK && scaledNorm(h.diag, h.newton) <= h.delta {
+		copy(h.step, h.newton)
+		return true
+	}
+
+	// Compute the scaled gradient D⁻¹Jᵀf of the half squared norm and the
+	// Cauchy point along the scaled steepest descent direction.
+	g := mat.NewVecDense(n, h.grad)
+	g.MulVec(h.jac.T(), mat.NewVecDense(n, f))
+	floats.Div(h.grad, h.diag)
+	gnorm := floats.Norm(h.grad, 2)
+	if gnorm == 0 {
+		if newtonOK {
+			// Scale the Newton step back to the trust region.
+			floats.ScaleTo(h.step, h.delta/scaledNorm(h.diag, h.newton), h.newton)
+			return true
+		}
+		return false
+	}
+	for i, d := range h.diag {
+		h.work[i] = h.grad[i] / d
+	}
+	jg := mat.NewVecDense(n, h.jp)
+	jg.MulVec(h.jac, mat.NewVecDense(n, h.work))
+	jgnorm := floats.Norm(h.jp, 2)
+	alpha := gnorm * gnorm / (jgnorm * jgnorm)
+	if jgnorm == 0 || alpha*gnorm >= h.delta || !newtonOK {
+		// Take the steepest descent step to the Cauchy point or to the
+		// boundary of the trust region.
+		scale := -math.Min(alpha, h.delta/gnorm)
+		if jgnorm == 0 {
+			scale = -h.delta / gnorm
+		}
+		for i, d := range h.diag {
+			h.step[i] = scale * h.grad[i] / d
+		}
+		return true
+	}
+
+	// Find the intersection of the segment from the Cauchy point to the
+	// Newton step with the boundary of the trust region in the scaled
+	// variables.
+	var a, b, c float64
+	for i, d := range h.diag {
+		pc := -alpha * h.grad[i]
+		dir := d*h.newton[i] - pc
+		a += dir * dir
+		b += pc * dir
+		c += pc * pc
+	}
+	c -= h.delta * h.delta
+	tau := (-b + math.Sqrt(b*b-a*c)) / a
+	for i, d := range h.diag {
+		pc := -alpha * h.grad[i]
+		h.step[i] = (pc + tau*(d*h.newton[i]-pc)) / d
+	}
+	return true
+}
+
+// scaledNorm returns the Euclidean norm of the elementwise product of d and x.
+func scaledNorm(d, x []float64) float64 {
+	var scale, ssq float64 = 0, 1
+	for i, v := range x {
+		v *= d[i]
+		if v == 0 {
+			continue
+		}
+		absv := math.Abs(v)
+		if scale < absv {
+			ssq = 1 + ssq*(scale/absv)*(scale/absv)
+			scale = absv
+		} else {
+			ssq += (absv / scale) * (absv / scale)
+		}
+	}
+	return scale * math.Sqrt(ssq)
+}
+
+const dlamchE = 1.0 / (1 << 53)
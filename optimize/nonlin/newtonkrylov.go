@@ -0,0 +1,267 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nonlin
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/optimize"
+)
+
+var _ Method = (*NewtonKrylov)(nil)
+
+// NewtonKrylov implements the Jacobian-free Newton–Krylov method for systems
+// of nonlinear equations. At each iteration the Newton equation
+//
+//	J(x) p = -F(x)
+//
+// is solved inexactly by restarted GMRES, where the products of the Jacobian
+// with vectors are approximated by finite differences of F,
+//
+//	J(x) v ≈ (F(x + h*v) - F(x)) / h,
+//
+// so that the Jacobian is never formed and Problem.Jacobian is not used. The
+// linear residual is reduced to the forcing term η times |F(x)|, and the
+// step is globalized by a backtracking line search on the norm of the
+// function values. The evaluations of F in the products are counted as
+// function evaluations.
+//
+// NewtonKrylov returns ErrNoProgress if the line search fails.
+//
+// References:
+//   - Knoll, D.A., Keyes, D.E.: Jacobian-free Newton-Krylov methods: a survey
+//     of approaches and applications. Journal of Computational Physics 193
+//     (2004), 357-397
+//   - Eisenstat, S.C., Walker, H.F.: Choosing the forcing terms in an inexact
+//     Newton method. SIAM Journal on Scientific Computing 17 (1996), 16-32
+type NewtonKrylov struct {
+	// Restart is the number of GMRES iterations after which GMRES is
+	// restarted. If Restart is zero, it is defaulted to min(n, 30).
+	Restart int
+
+	// KrylovIterations is the maximum number of GMRES iterations in a
+	// Newton iteration. If KrylovIterations is zero, it is defaulted to
+	// max(n, 100).
+	KrylovIterations int
+
+	// Forcing is the constant forcing term η in (0, 1). If Forcing is zero,
+	// the forcing term is chosen adaptively by choice 2 of Eisenstat and
+	// Walker, which solves the linear systems more accurately as the
+	// iterates approach a root.
+	Forcing float64
+
+	restart  int
+	maxIter  int
+	eta      float64
+	normOld  float64
+	dir      []float64
+	xNew     []float64
+	fNew     []float64
+	gmres    gmres
+	hasState bool
+}
+
+func (nk *NewtonKrylov) Init(n int) {
+	if nk.Restart < 0 {
+		panic("nonlin: negative restart")
+	}
+	if nk.KrylovIterations < 0 {
+		panic("nonlin: negative Krylov iterations")
+	}
+	if nk.Forcing < 0 || nk.Forcing >= 1 {
+		panic("nonlin: forcing term out of range")
+	}
+	nk.restart = nk.Restart
+	if nk.restart == 0 {
+		nk.restart = min(n, 30)
+	}
+	nk.maxIter = nk.KrylovIterations
+	if nk.maxIter == 0 {
+		nk.maxIter = max(n, 100)
+	}
+	nk.eta = 0
+	nk.hasState = false
+	nk.dir = make([]float64, n)
+	nk.xNew = make([]float64, n)
+	nk.fNew = make([]float64, n)
+	nk.gmres.init(n, nk.restart)
+}
+
+func (nk *NewtonKrylov) Iterate(loc *Location, sys *System) (optimize.Status, error) {
+	const (
+		etaMax = 0.9
+		gamma  = 0.9
+	)
+	eta := nk.Forcing
+	if eta == 0 {
+		if !nk.hasState {
+			eta = 0.5
+		} else {
+			// Choice 2 of Eisenstat and Walker with its safeguard.
+			r := loc.Norm / nk.normOld
+			eta = gamma * r * r
+			if safe := gamma * nk.eta * nk.eta; safe > 0.1 {
+				eta = math.Max(eta, safe)
+			}
+			eta = math.Min(eta, etaMax)
+		}
+	}
+
+	// Approximate the products of the Jacobian with unit vectors v by
+	// forward differences with the step h = √ε (1 + |x|).
+	h := math.Sqrt(dlamchE) * (1 + floats.Norm(loc.X, 2))
+	jv := func(dst, v []float64) error {
+		floats.AddScaledTo(nk.xNew, loc.X, h, v)
+		if err := sys.Func(dst, nk.xNew); err != nil {
+			return err
+		}
+		floats.Sub(dst, loc.F)
+		floats.Scale(1/h, dst)
+		return nil
+	}
+	if err := nk.gmres.solve(nk.dir, jv, loc.F, eta*loc.Norm, nk.maxIter); err != nil {
+		return optimize.Failure, err
+	}
+
+	normOld := loc.Norm
+	t, err := backtrack(loc, sys, nk.dir, nk.xNew, nk.fNew, eta)
+	if err != nil {
+		return optimize.Failure, err
+	}
+	if t == 0 {
+		return optimize.Failure, ErrNoProgress
+	}
+	nk.normOld = normOld
+	nk.eta = eta
+	nk.hasState = true
+	return optimize.NotTerminated, nil
+}
+
+// gmres solves linear systems with the restarted generalized minimal
+// residual method.
+type gmres struct {
+	m    int
+	v    [][]float64 // Orthonormal basis of the Krylov subspace
+	h    [][]float64 // Upper Hessenberg matrix, stored by columns
+	cs   []float64   // Givens rotations
+	sn   []float64
+	g    []float64 // Rotated right-hand side
+	y    []float64
+	work []float64
+}
+
+func (g *gmres) init(n, m int) {
+	g.m = m
+	g.v = make([][]float64, m+1)
+	for i := range g.v {
+		g.v[i] = make([]float64, n)
+	}
+	g.h = make([][]float64, m)
+	for j := range g.h {
+		g.h[j] = make([]float64, m+1)
+	}
+	g.cs = make([]float64, m)
+	g.sn = make([]float64, m)
+	g.g = make([]float64, m+1)
+	g.y = make([]float64, m)
+	g.work = make([]float64, n)
+}
+
+// solve computes an approximate solution p of A p = -f with p = 0 as the
+// initial guess, where the product of A with a unit vector v is computed by
+// av. The iterations stop when the residual norm is at most tol or after
+// maxIter iterations.
+func (g *gmres) solve(p []float64, av func(dst, v []float64) error, f []float64, tol float64, maxIter int) error {
+	for i := range p {
+		p[i] = 0
+	}
+	// The initial residual is -f.
+	floats.ScaleTo(g.work, -1, f)
+	var iter int
+	for {
+		beta := floats.Norm(g.work, 2)
+		if beta <= tol || iter >= maxIter {
+			return nil
+		}
+		floats.ScaleTo(g.v[0], 1/beta, g.work)
+		for i := range g.g {
+			g.g[i] = 0
+		}
+		g.g[0] = beta
+
+		var k int
+		for k < g.m && iter < maxIter {
+			iter++
+			j := k
+			k++
+			w := g.v[j+1]
+			if err := av(w, g.v[j]); err != nil {
+				return err
+			}
+			// Orthogonalize by the modified Gram–Schmidt process.
+			hj := g.h[j]
+			for i := 0; i <= j; i++ {
+				hj[i] = floats.Dot(w, g.v[i])
+				floats.AddScaled(w, -hj[i], g.v[i])
+			}
+			hj[j+1] = floats.Norm(w, 2)
+			breakdown := hj[j+1] <= dlamchE*beta
+			if !breakdown {
+				floats.Scale(1/hj[j+1], w)
+			}
+
+			// Apply the previous rotations and compute a new one that
+			// eliminates the subdiagonal element.
+			for i := 0; i < j; i++ {
+				hj[i], hj[i+1] = g.cs[i]*hj[i]+g.sn[i]*hj[i+1], -g.sn[i]*hj[i]+g.cs[i]*hj[i+1]
+			}
+			r := math.Hypot(hj[j], hj[j+1])
+			if r == 0 {
+				g.cs[j], g.sn[j] = 1, 0
+			} else {
+				g.cs[j], g.sn[j] = hj[j]/r, hj[j+1]/r
+			}
+			hj[j], hj[j+1] = r, 0
+			g.g[j], g.g[j+1] = g.cs[j]*g.g[j], -g.sn[j]*g.g[j]
+			if breakdown || math.Abs(g.g[j+1]) <= tol {
+				break
+			}
+		}
+
+		// Solve the triangular system and update the solution.
+		for i := k - 1; i >= 0; i-- {
+			sum := g.g[i]
+			for l := i + 1; l < k; l++ {
+				sum -= g.h[l][i] * g.y[l]
+			}
+			if g.h[i][i] == 0 {
+				g.y[i] = 0
+				continue
+			}
+			g.y[i] = sum / g.h[i][i]
+		}
+		for i := 0; i < k; i++ {
+			floats.AddScaled(p, g.y[i], g.v[i])
+		}
+		if math.Abs(g.g[k]) <= tol || iter >= maxIter {
+			return nil
+		}
+
+		// Compute the true residual -f - A p for the restart. The product
+		// is formed with the unit vector in the direction of p.
+		pnorm := floats.Norm(p, 2)
+		if pnorm == 0 {
+			return nil
+		}
+		floats.ScaleTo(g.v[0], 1/pnorm, p)
+		if err := av(g.work, g.v[0]); err != nil {
+			return err
+		}
+		floats.Scale(-pnorm, g.work)
+		floats.Sub(g.work, f)
+		iter++
+	}
+}
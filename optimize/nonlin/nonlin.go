@@ -0,0 +1,314 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nonlin
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+const defaultTolerance = 1e-10
+
+var (
+	// ErrNoProgress is returned when a method cannot reduce the norm of the
+	// function values, which usually means that the iterates are near a
+	// local minimum of |F| that is not a root.
+	ErrNoProgress = errors.New("nonlin: no progress")
+
+	// ErrSingular is returned when the Jacobian matrix is singular and the
+	// method cannot compute a step.
+	ErrSingular = errors.New("nonlin: singular Jacobian")
+
+	// errEvaluationLimit is returned by System.Func when the limit on the
+	// number of function evaluations has been reached.
+	errEvaluationLimit = errors.New("nonlin: evaluation limit reached")
+)
+
+// Problem describes a system of n nonlinear equations F(x) = 0 in n
+// variables.
+type Problem struct {
+	// Func evaluates the function F at x and stores the result in dst.
+	// Func must not modify x.
+	Func func(dst, x []float64)
+
+	// Jacobian evaluates the n×n Jacobian matrix of F at x,
+	//  J_{i,j} = ∂F_i/∂x_j,
+	// and stores the result in dst. Jacobian must not modify x.
+	// If Jacobian is nil, the Jacobian is approximated with finite differences
+	// using fd.Jacobian.
+	Jacobian func(dst *mat.Dense, x []float64)
+}
+
+// Location represents a location together with the function values there.
+type Location struct {
+	// X is the location.
+	X []float64
+	// F is the vector of function values at X.
+	F []float64
+	// Norm is the Euclidean norm of F.
+	Norm float64
+}
+
+// System gives a Method access to the function and its Jacobian.
+type System struct {
+	// Func evaluates the function at x and stores the result in dst. It
+	// returns a non-nil error if the evaluation was not performed because a
+	// limit on the number of evaluations was reached. Methods must return
+	// such an error immediately.
+	Func func(dst, x []float64) error
+
+	// Jacobian evaluates the Jacobian matrix at x, where the function value
+	// is fx, and stores the result in dst.
+	Jacobian func(dst *mat.Dense, x, fx []float64)
+}
+
+// Method is an iterative method for solving systems of nonlinear equations.
+type Method interface {
+	// Init initializes the method for a system of dimension n.
+	Init(n int)
+
+	// Iterate performs a single iteration of the method. On entry, all
+	// fields of loc are valid. Iterate evaluates the function and the
+	// Jacobian using sys and, if a step is accepted, updates loc to the new
+	// location.
+	//
+	// Iterate returns optimize.NotTerminated if a step has been accepted. If
+	// the method cannot make further progress, Iterate returns a different
+	// Status and leaves loc unchanged.
+	Iterate(loc *Location, sys *System) (optimize.Status, error)
+}
+
+// Settings holds the settings for Solve.
+type Settings struct {
+	// FunctionThreshold stops the iterations with FunctionThreshold status if
+	// the infinity norm of the function values is at most this value. If
+	// FunctionThreshold is zero it is defaulted to 1e-10, and if it is NaN
+	// the setting is not used.
+	FunctionThreshold float64
+
+	// StepTolerance stops the iterations with StepConvergence status if the
+	// norm of the step dx taken in an iteration satisfies
+	//  |dx| < StepTolerance * (StepTolerance + |x|).
+	// If StepTolerance is zero it is defaulted to 1e-10, and if it is NaN the
+	// setting is not used.
+	StepTolerance float64
+
+	// MajorIterations is the maximum number of iterations. If it is zero,
+	// the number of iterations is not limited.
+	MajorIterations int
+
+	// FuncEvaluations is the maximum number of evaluations of the function.
+	// Evaluations performed by the finite difference approximation of the
+	// Jacobian are not counted. If it is zero, the number of evaluations is
+	// not limited.
+	FuncEvaluations int
+
+	// JacobianSettings holds the settings of the finite difference
+	// approximation of the Jacobian when Problem.Jacobian is nil.
+	JacobianSettings *fd.JacobianSettings
+
+	// Recorder records the progress of the iterations. The Location passed
+	// to the Recorder holds the location in X and the norm of the function
+	// values in F.
+	Recorder optimize.Recorder
+}
+
+// Result represents the answer of solving a system of nonlinear equations.
+type Result struct {
+	// Location is the last location accepted by the method.
+	Location
+
+	// Stats holds the statistics of the iterations. FuncEvaluations counts
+	// the evaluations of the function and GradEvaluations counts the
+	// evaluations of the Jacobian.
+	optimize.Stats
+
+	// Status is the reason for termination. A root has been found if Status
+	// is FunctionThreshold. Convergence of the steps does not imply a root.
+	Status optimize.Status
+}
+
+// Solve finds a root of the system of nonlinear equations p starting at
+// initX using method. If method is nil, Hybrid is used. If settings is nil,
+// the zero value is used.
+//
+// Solve returns the last location accepted by the method and the reason for
+// termination. An error is returned if the Recorder fails, if the initial
+// function values are not finite or if the method fails.
+func Solve(p Problem, initX []float64, settings *Settings, method Method) (*Result, error) {
+	startTime := time.Now()
+	if p.Func == nil {
+		panic("nonlin: function is undefined")
+	}
+	n := len(initX)
+	if n == 0 {
+		return nil, optimize.ErrZeroDimensional
+	}
+	if settings == nil {
+		settings = &Settings{}
+	}
+	if method == nil {
+		method = &Hybrid{}
+	}
+	funcTol := defaultTol(settings.FunctionThreshold)
+	stepTol := defaultTol(settings.StepTolerance)
+
+	loc := &Location{
+		X: make([]float64, n),
+		F: make([]float64, n),
+	}
+	copy(loc.X, initX)
+	method.Init(n)
+
+	var stats optimize.Stats
+	sys := &System{
+		Func: func(dst, x []float64) error {
+			if settings.FuncEvaluations > 0 && stats.FuncEvaluations >= settings.FuncEvaluations {
+				return errEvaluationLimit
+			}
+			stats.FuncEvaluations++
+			p.Func(dst, x)
+			return nil
+		},
+		Jacobian: func(dst *mat.Dense, x, fx []float64) {
+			stats.GradEvaluations++
+			if p.Jacobian != nil {
+				p.Jacobian(dst, x)
+				return
+			}
+			var s fd.JacobianSettings
+			if settings.JacobianSettings != nil {
+				s = *settings.JacobianSettings
+			}
+			if s.OriginValue == nil {
+				s.OriginValue = fx
+			}
+			fd.Jacobian(dst, p.Func, x, &s)
+		},
+	}
+
+	optLoc := &optimize.Location{X: loc.X}
+	record := func(op optimize.Operation) error {
+		if settings.Recorder == nil {
+			return nil
+		}
+		optLoc.F = loc.Norm
+		stats.Runtime = time.Since(startTime)
+		return settings.Recorder.Record(optLoc, op, &stats)
+	}
+	if settings.Recorder != nil {
+		if err := settings.Recorder.Init(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := sys.Func(loc.F, loc.X); err != nil {
+		return nil, err
+	}
+	loc.Norm = floats.Norm(loc.F, 2)
+	if math.IsInf(loc.Norm, 1) || math.IsNaN(loc.Norm) {
+		return nil, optimize.ErrFunc(loc.Norm)
+	}
+	if err := record(optimize.InitIteration); err != nil {
+		return nil, err
+	}
+
+	var (
+		status optimize.Status
+		err    error
+	)
+	xOld := make([]float64, n)
+	for {
+		if !math.IsNaN(funcTol) && floats.Norm(loc.F, math.Inf(1)) <= funcTol {
+			status = optimize.FunctionThreshold
+			break
+		}
+		if settings.MajorIterations > 0 && stats.MajorIterations >= settings.MajorIterations {
+			status = optimize.IterationLimit
+			break
+		}
+
+		copy(xOld, loc.X)
+		status, err = method.Iterate(loc, sys)
+		if err == errEvaluationLimit {
+			status, err = optimize.FunctionEvaluationLimit, nil
+		}
+		if status != optimize.NotTerminated || err != nil {
+			break
+		}
+		stats.MajorIterations++
+		if err = record(optimize.MajorIteration); err != nil {
+			status = optimize.Failure
+			break
+		}
+
+		if !math.IsNaN(stepTol) {
+			dx := floats.Distance(loc.X, xOld, 2)
+			if dx < stepTol*(stepTol+floats.Norm(loc.X, 2)) {
+				status = optimize.StepConvergence
+				break
+			}
+		}
+	}
+	if settings.Recorder != nil && err == nil {
+		err = record(optimize.PostIteration)
+	}
+	stats.Runtime = time.Since(startTime)
+
+	return &Result{
+		Location: *loc,
+		Stats:    stats,
+		Status:   status,
+	}, err
+}
+
+func defaultTol(tol float64) float64 {
+	if tol == 0 {
+		return defaultTolerance
+	}
+	return tol
+}
+
+// isFinite returns whether the norm of function values is finite.
+func isFinite(norm float64) bool {
+	return !math.IsInf(norm, 0) && !math.IsNaN(norm)
+}
+
+// backtrack performs a backtracking line search along the direction dir from
+// loc, halving the step until the norm of the function values satisfies
+//
+//	|F(x + t*dir)| <= (1 - armijo*t*(1-eta)) |F(x)|.
+//
+// If the search succeeds, backtrack updates loc and returns the step length
+// t. It returns zero if no step is accepted within maxBacktracks halvings.
+// The slices xNew and fNew are used as workspace.
+func backtrack(loc *Location, sys *System, dir, xNew, fNew []float64, eta float64) (float64, error) {
+	const (
+		armijo        = 1e-4
+		maxBacktracks = 30
+	)
+	t := 1.0
+	for k := 0; k < maxBacktracks; k++ {
+		floats.AddScaledTo(xNew, loc.X, t, dir)
+		if err := sys.Func(fNew, xNew); err != nil {
+			return 0, err
+		}
+		norm := floats.Norm(fNew, 2)
+		if isFinite(norm) && norm <= (1-armijo*t*(1-eta))*loc.Norm {
+			copy(loc.X, xNew)
+			copy(loc.F, fNew)
+			loc.Norm = norm
+			return t, nil
+		}
+		t /= 2
+	}
+	return 0, nil
+}
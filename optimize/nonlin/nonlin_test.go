@@ -0,0 +1,367 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nonlin
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+// rosenbrock returns the system F(x) = [10(x_1 - x_0²), 1 - x_0] with the
+// root [1, 1].
+func rosenbrock() Problem {
+	return Problem{
+		Func: func(dst, x []float64) {
+			dst[0] = 10 * (x[1] - x[0]*x[0])
+			dst[1] = 1 - x[0]
+		},
+		Jacobian: func(dst *mat.Dense, x []float64) {
+			dst.Set(0, 0, -20*x[0])
+			dst.Set(0, 1, 10)
+			dst.Set(1, 0, -1)
+			dst.Set(1, 1, 0)
+		},
+	}
+}
+
+// powellBadlyScaled returns Powell's badly scaled system from Moré, Garbow
+// and Hillstrom.
+func powellBadlyScaled() Problem {
+	return Problem{
+		Func: func(dst, x []float64) {
+			dst[0] = 1e4*x[0]*x[1] - 1
+			dst[1] = math.Exp(-x[0]) + math.Exp(-x[1]) - 1.0001
+		},
+		Jacobian: func(dst *mat.Dense, x []float64) {
+			dst.Set(0, 0, 1e4*x[1])
+			dst.Set(0, 1, 1e4*x[0])
+			dst.Set(1, 0, -math.Exp(-x[0]))
+			dst.Set(1, 1, -math.Exp(-x[1]))
+		},
+	}
+}
+
+// broydenTridiagonal returns Broyden's tridiagonal system
+//
+//	F_i(x) = (3 - 2x_i) x_i - x_{i-1} - 2x_{i+1} + 1
+//
+// with x_{-1} = x_n = 0.
+func broydenTridiagonal() Problem {
+	return Problem{
+		Func: func(dst, x []float64) {
+			n := len(x)
+			for i := range x {
+				dst[i] = (3-2*x[i])*x[i] + 1
+				if i > 0 {
+					dst[i] -= x[i-1]
+				}
+				if i < n-1 {
+					dst[i] -= 2 * x[i+1]
+				}
+			}
+		},
+		Jacobian: func(dst *mat.Dense, x []float64) {
+			n := len(x)
+			dst.Zero()
+			for i := range x {
+				dst.Set(i, i, 3-4*x[i])
+				if i > 0 {
+					dst.Set(i, i-1, -1)
+				}
+				if i < n-1 {
+					dst.Set(i, i+1, -2)
+				}
+			}
+		},
+	}
+}
+
+// boundaryValue returns the discrete boundary value problem from Moré,
+// Garbow and Hillstrom,
+//
+//	F_i(x) = 2x_i - x_{i-1} - x_{i+1} + h²(x_i + t_i + 1)³/2,
+//
+// with h = 1/(n+1), t_i = (i+1)h and x_{-1} = x_n = 0.
+func boundaryValue() Problem {
+	return Problem{
+		Func: func(dst, x []float64) {
+			n := len(x)
+			h := 1 / float64(n+1)
+			for i := range x {
+				t := float64(i+1) * h
+				v := x[i] + t + 1
+				dst[i] = 2*x[i] + h*h*v*v*v/2
+				if i > 0 {
+					dst[i] -= x[i-1]
+				}
+				if i < n-1 {
+					dst[i] -= x[i+1]
+				}
+			}
+		},
+	}
+}
+
+func methods() []struct {
+	name   string
+	method func() Method
+} {
+	return []struct {
+		name   string
+		method func() Method
+	}{
+		{"Hybrid", func() Method { return &Hybrid{} }},
+		{"BroydenGood", func() Method { return &Broyden{} }},
+		{"BroydenBad", func() Method { return &Broyden{Bad: true} }},
+		{"NewtonKrylov", func() Method { return &NewtonKrylov{} }},
+		{"NewtonKrylovForcing", func() Method { return &NewtonKrylov{Forcing: 1e-3, Restart: 5} }},
+	}
+}
+
+func TestSolve(t *testing.T) {
+	t.Parallel()
+	tridiagonal := make([]float64, 20)
+	for i := range tridiagonal {
+		tridiagonal[i] = -1
+	}
+	boundaryStart := make([]float64, 30)
+	for i := range boundaryStart {
+		t := float64(i+1) / 31
+		boundaryStart[i] = t * (t - 1)
+	}
+	for _, test := range []struct {
+		name    string
+		p       Problem
+		x       []float64
+		want    []float64
+		methods []string // Methods that are not tested.
+	}{
+		{
+			name: "Rosenbrock",
+			p:    rosenbrock(),
+			x:    []float64{-1.2, 1},
+			want: []float64{1, 1},
+		},
+		{
+			name:    "PowellBadlyScaled",
+			p:       powellBadlyScaled(),
+			x:       []float64{0, 1},
+			want:    []float64{1.098159329699e-05, 9.106146739868},
+			methods: []string{"BroydenGood", "BroydenBad", "NewtonKrylov", "NewtonKrylovForcing"},
+		},
+		{
+			name: "BroydenTridiagonal",
+			p:    broydenTridiagonal(),
+			x:    tridiagonal,
+		},
+		{
+			name: "BoundaryValue",
+			p:    boundaryValue(),
+			x:    boundaryStart,
+		},
+	} {
+	outer:
+		for _, method := range methods() {
+			for _, skip := range test.methods {
+				if skip == method.name {
+					continue outer
+				}
+			}
+			for _, numerical := range []bool{false, true} {
+				p := test.p
+				if numerical {
+					p.Jacobian = nil
+				} else if p.Jacobian == nil {
+					continue
+				}
+				x := make([]float64, len(test.x))
+				copy(x, test.x)
+				res, err := Solve(p, x, nil, method.method())
+				if err != nil {
+					t.Errorf("%s %s numerical=%t: unexpected error: %v", test.name, method.name, numerical, err)
+					continue
+				}
+				if !floats.Equal(x, test.x) {
+					t.Errorf("%s %s: initial location modified", test.name, method.name)
+				}
+				if res.Status != optimize.FunctionThreshold && res.Status != optimize.StepConvergence {
+					t.Errorf("%s %s numerical=%t: unexpected status: %v", test.name, method.name, numerical, res.Status)
+				}
+				f := make([]float64, len(x))
+				p.Func(f, res.X)
+				if !floats.Equal(f, res.F) || res.Norm != floats.Norm(f, 2) {
+					t.Errorf("%s %s numerical=%t: function values mismatch", test.name, method.name, numerical)
+				}
+				// Convergence of the steps may stop the iterations slightly
+				// before the function threshold is reached.
+				if floats.Norm(f, math.Inf(1)) > 1e-8 {
+					t.Errorf("%s %s numerical=%t: not a root: |F| = %v", test.name, method.name, numerical, floats.Norm(f, math.Inf(1)))
+				}
+				if test.want != nil && !floats.EqualApprox(res.X, test.want, 1e-8) {
+					t.Errorf("%s %s numerical=%t: unexpected root: got %v, want %v", test.name, method.name, numerical, res.X, test.want)
+				}
+			}
+		}
+	}
+}
+
+func TestSolveLinear(t *testing.T) {
+	t.Parallel()
+	// The Newton step solves a linear system exactly, so that Hybrid and
+	// Broyden converge in one iteration.
+	a := mat.NewDense(3, 3, []float64{
+		4, 1, 0,
+		1, 3, -1,
+		0, -1, 2,
+	})
+	b := []float64{1, -2, 3}
+	p := Problem{
+		Func: func(dst, x []float64) {
+			v := mat.NewVecDense(3, dst)
+			v.MulVec(a, mat.NewVecDense(3, x))
+			floats.Sub(dst, b)
+		},
+		Jacobian: func(dst *mat.Dense, x []float64) {
+			dst.Copy(a)
+		},
+	}
+	var want mat.VecDense
+	err := want.SolveVec(a, mat.NewVecDense(3, b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, method := range []Method{&Hybrid{}, &Broyden{}, &Broyden{Bad: true}} {
+		res, err := Solve(p, []float64{10, 10, 10}, nil, method)
+		if err != nil {
+			t.Errorf("%T: unexpected error: %v", method, err)
+			continue
+		}
+		if res.MajorIterations != 1 {
+			t.Errorf("%T: unexpected number of iterations: got %d, want 1", method, res.MajorIterations)
+		}
+		if !floats.EqualApprox(res.X, want.RawVector().Data, 1e-9) {
+			t.Errorf("%T: unexpected solution: got %v, want %v", method, res.X, want.RawVector().Data)
+		}
+	}
+}
+
+func TestSolveNoRoot(t *testing.T) {
+	t.Parallel()
+	// The system F(x) = [x_0² + 1, x_1] has no root, and the norm of F has
+	// a minimum at the origin where the Jacobian is singular.
+	p := Problem{
+		Func: func(dst, x []float64) {
+			dst[0] = x[0]*x[0] + 1
+			dst[1] = x[1]
+		},
+		Jacobian: func(dst *mat.Dense, x []float64) {
+			dst.Set(0, 0, 2*x[0])
+			dst.Set(0, 1, 0)
+			dst.Set(1, 0, 0)
+			dst.Set(1, 1, 1)
+		},
+	}
+	for _, method := range methods() {
+		res, err := Solve(p, []float64{1, 1}, &Settings{MajorIterations: 1000}, method.method())
+		if res == nil {
+			t.Errorf("%s: unexpected nil result", method.name)
+			continue
+		}
+		if res.Status == optimize.FunctionThreshold || res.Status == optimize.IterationLimit {
+			t.Errorf("%s: unexpected status %v", method.name, res.Status)
+		}
+		if err != nil && !errors.Is(err, ErrNoProgress) && !errors.Is(err, ErrSingular) {
+			t.Errorf("%s: unexpected error: %v", method.name, err)
+		}
+		if res.Norm > math.Sqrt(2) {
+			t.Errorf("%s: norm increased: %v", method.name, res.Norm)
+		}
+	}
+}
+
+func TestSolveSettings(t *testing.T) {
+	t.Parallel()
+	for _, method := range methods() {
+		res, err := Solve(rosenbrock(), []float64{-1.2, 1}, &Settings{MajorIterations: 2}, method.method())
+		if err != nil || res.Status != optimize.IterationLimit || res.MajorIterations != 2 {
+			t.Errorf("%s: unexpected result with iteration limit: %v, %v", method.name, res.Status, err)
+		}
+		res, err = Solve(rosenbrock(), []float64{-1.2, 1}, &Settings{FuncEvaluations: 3}, method.method())
+		if err != nil || res.Status != optimize.FunctionEvaluationLimit || res.FuncEvaluations != 3 {
+			t.Errorf("%s: unexpected result with evaluation limit: %v, %v", method.name, res.Status, err)
+		}
+		res, err = Solve(rosenbrock(), []float64{-1.2, 1}, &Settings{FunctionThreshold: 1e-3}, method.method())
+		if err != nil || res.Status != optimize.FunctionThreshold || floats.Norm(res.F, math.Inf(1)) > 1e-3 {
+			t.Errorf("%s: unexpected result with function threshold: %v, %v", method.name, res.Status, err)
+		}
+	}
+
+	// The Recorder receives the norm of the function values.
+	var ops []optimize.Operation
+	var norms []float64
+	recorder := recorderFunc(func(loc *optimize.Location, op optimize.Operation, _ *optimize.Stats) error {
+		ops = append(ops, op)
+		norms = append(norms, loc.F)
+		return nil
+	})
+	res, err := Solve(rosenbrock(), []float64{-1.2, 1}, &Settings{Recorder: recorder}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(ops) != res.MajorIterations+2 || ops[0] != optimize.InitIteration || ops[len(ops)-1] != optimize.PostIteration {
+		t.Errorf("unexpected operations: %v", ops)
+	}
+	if norms[len(norms)-1] != res.Norm {
+		t.Errorf("unexpected recorded norm: got %v, want %v", norms[len(norms)-1], res.Norm)
+	}
+
+	// Invalid initial function values.
+	_, err = Solve(Problem{Func: func(dst, x []float64) {
+		dst[0] = math.NaN()
+	}}, []float64{1}, nil, nil)
+	if _, ok := err.(optimize.ErrFunc); !ok {
+		t.Errorf("unexpected error for NaN: %v", err)
+	}
+	_, err = Solve(rosenbrock(), nil, nil, nil)
+	if err != optimize.ErrZeroDimensional {
+		t.Errorf("unexpected error for zero dimension: %v", err)
+	}
+}
+
+func TestPanics(t *testing.T) {
+	t.Parallel()
+	for k, fn := range []func(){
+		func() { Solve(Problem{}, []float64{1}, nil, nil) },
+		func() { Solve(rosenbrock(), []float64{1, 1}, nil, &Hybrid{InitialRadius: -1}) },
+		func() { Solve(rosenbrock(), []float64{1, 1}, nil, &NewtonKrylov{Forcing: 1}) },
+		func() { Solve(rosenbrock(), []float64{1, 1}, nil, &NewtonKrylov{Restart: -1}) },
+	} {
+		if !panics(fn) {
+			t.Errorf("case %d: expected panic", k)
+		}
+	}
+}
+
+type recorderFunc func(*optimize.Location, optimize.Operation, *optimize.Stats) error
+
+func (recorderFunc) Init() error { return nil }
+
+func (r recorderFunc) Record(loc *optimize.Location, op optimize.Operation, stats *optimize.Stats) error {
+	return r(loc, op, stats)
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		r := recover()
+		panicked = r != nil
+	}()
+	fn()
+	return
+}
@@ -0,0 +1,216 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	_ Statuser = (*ParticleSwarm)(nil)
+	_ Method   = (*ParticleSwarm)(nil)
+)
+
+// ParticleSwarm implements particle swarm optimization, a derivative-free
+// global optimization method. A swarm of particles moves through the search
+// space, and in each generation the velocity of every particle is updated as
+//
+//	v = w*v + c1*r1*(p - x) + c2*r2*(g - x),
+//
+// where p is the best location found by the particle, g is the best location
+// found by the swarm, and r1 and r2 are uniform random numbers in [0, 1)
+// drawn for each variable. The default parameters are the constriction
+// coefficients of Clerc and Kennedy.
+//
+// The particles of a generation are evaluated concurrently according to
+// Settings.Concurrent. The variables may be bounded by Lower and Upper. A
+// particle that leaves the bounds is stopped at the boundary and the
+// corresponding component of its velocity is set to zero. The initial
+// positions are sampled uniformly for the variables that are bounded on both
+// sides, and from a normal distribution around the initial location with
+// standard deviation InitStepSize for the other variables. The initial
+// location is the position of the first particle.
+//
+// References:
+//   - Kennedy, J., Eberhart, R.: Particle swarm optimization. Proceedings of
+//     the IEEE International Conference on Neural Networks (1995), 1942-1948
+//   - Clerc, M., Kennedy, J.: The particle swarm - explosion, stability, and
+//     convergence in a multidimensional complex space. IEEE Transactions on
+//     Evolutionary Computation 6(1) (2002), 58-73
+type ParticleSwarm struct {
+	// Lower and Upper are the lower and upper bounds on the variables. If
+	// Lower is nil, the variables are unbounded from below, and if Upper is
+	// nil, the variables are unbounded from above. If not nil, the lengths
+	// of Lower and Upper must equal the problem dimension, and
+	// Lower[i] <= Upper[i] must hold for all i, otherwise ParticleSwarm
+	// will panic.
+	Lower, Upper []float64
+	// InitStepSize is the standard deviation of the initial positions for
+	// the variables that are not bounded on both sides. If InitStepSize is
+	// 0, it is defaulted to 1.
+	InitStepSize float64
+
+	// Population is the number of particles. If Population is 0, it is
+	// defaulted to 10 + floor(2*sqrt(dim)). Population cannot be negative,
+	// otherwise ParticleSwarm will panic.
+	Population int
+	// Inertia is the inertia weight w. If Inertia is 0, it is defaulted to
+	// 0.7298.
+	Inertia float64
+	// Cognitive and Social are the acceleration coefficients c1 and c2
+	// towards the best location of the particle and of the swarm. If they
+	// are 0, they are defaulted to 1.49618.
+	Cognitive, Social float64
+
+	// StopSpread sets the threshold for stopping the optimization if the
+	// difference between the largest and the smallest function value at the
+	// best locations of the particles becomes too small. If StopSpread is
+	// 0, it is defaulted to 1e-12, and if it is NaN the stopping criterion
+	// is not used.
+	StopSpread float64
+
+	// Src allows a random number generator to be supplied for generating
+	// samples. If Src is nil the generator in golang.org/x/math/rand is
+	// used.
+	Src rand.Source
+
+	rnd                        *rand.Rand
+	box                        box
+	pop                        int
+	inertia, cognitive, social float64
+	spread                     float64
+
+	x0 []float64
+	// pos, vel and bestPos hold the positions, velocities and best
+	// positions of the particles, and bestF the best function values.
+	pos, vel, bestPos *mat.Dense
+	bestF             []float64
+	best              int
+	initialized       bool
+
+	runner generationRunner
+}
+
+// Status returns the status of the method.
+func (ps *ParticleSwarm) Status() (Status, error) {
+	return ps.runner.status(), nil
+}
+
+func (*ParticleSwarm) Uses(has Available) (uses Available, err error) {
+	return has.function()
+}
+
+func (ps *ParticleSwarm) Init(dim, tasks int) int {
+	if dim <= 0 {
+		panic(nonpositiveDimension)
+	}
+	if tasks < 0 {
+		panic(negativeTasks)
+	}
+	step := ps.InitStepSize
+	if step == 0 {
+		step = 1
+	} else if step < 0 {
+		panic("particle swarm: negative initial step size")
+	}
+	ps.box.init("particle swarm", dim, ps.Lower, ps.Upper, step)
+	ps.pop = ps.Population
+	if ps.pop == 0 {
+		ps.pop = 10 + int(2*math.Sqrt(float64(dim)))
+	} else if ps.pop < 0 {
+		panic("particle swarm: negative population size")
+	}
+	setDefault := func(v, def float64) float64 {
+		if v == 0 {
+			return def
+		}
+		return v
+	}
+	ps.inertia = setDefault(ps.Inertia, 0.7298)
+	ps.cognitive = setDefault(ps.Cognitive, 1.49618)
+	ps.social = setDefault(ps.Social, 1.49618)
+	ps.spread = setDefault(ps.StopSpread, 1e-12)
+
+	ps.rnd = newRand(ps.Src)
+	ps.x0 = resize(ps.x0, dim)
+	ps.pos = mat.NewDense(ps.pop, dim, nil)
+	ps.vel = mat.NewDense(ps.pop, dim, nil)
+	ps.bestPos = mat.NewDense(ps.pop, dim, nil)
+	ps.bestF = resize(ps.bestF, ps.pop)
+	ps.initialized = false
+	ps.runner.init(dim, ps.pop)
+	return min(tasks, ps.pop)
+}
+
+func (ps *ParticleSwarm) Run(operations chan<- Task, results <-chan Task, tasks []Task) {
+	copy(ps.x0, tasks[0].X)
+	ps.box.project(ps.x0)
+	ps.runner.run(ps, operations, results, tasks)
+}
+
+func (ps *ParticleSwarm) generationSize() int {
+	return ps.pop
+}
+
+func (ps *ParticleSwarm) candidate(i int, x []float64) {
+	pos := ps.pos.RawRowView(i)
+	vel := ps.vel.RawRowView(i)
+	if !ps.initialized {
+		if i == 0 {
+			copy(pos, ps.x0)
+		} else {
+			ps.box.sample(pos, ps.x0, ps.rnd)
+		}
+		// The initial velocities are a fraction of the scale of the
+		// variables.
+		for j := range vel {
+			vel[j] = 0.1 * ps.box.scale[j] * (2*ps.rnd.Float64() - 1)
+		}
+		copy(x, pos)
+		return
+	}
+
+	p := ps.bestPos.RawRowView(i)
+	g := ps.bestPos.RawRowView(ps.best)
+	for j := range vel {
+		vel[j] = ps.inertia*vel[j] +
+			ps.cognitive*ps.rnd.Float64()*(p[j]-pos[j]) +
+			ps.social*ps.rnd.Float64()*(g[j]-pos[j])
+		pos[j] += vel[j]
+		if pos[j] < ps.box.lower[j] {
+			pos[j] = ps.box.lower[j]
+			vel[j] = 0
+		} else if pos[j] > ps.box.upper[j] {
+			pos[j] = ps.box.upper[j]
+			vel[j] = 0
+		}
+	}
+	copy(x, pos)
+}
+
+func (ps *ParticleSwarm) update(xs *mat.Dense, fs []float64) bool {
+	if !ps.initialized {
+		ps.bestPos.Copy(xs)
+		copy(ps.bestF, fs)
+		ps.initialized = true
+	} else {
+		for i, f := range fs {
+			if f < ps.bestF[i] {
+				copy(ps.bestPos.RawRowView(i), xs.RawRowView(i))
+				ps.bestF[i] = f
+			}
+		}
+	}
+	ps.best = floats.MinIdx(ps.bestF)
+	if math.IsNaN(ps.spread) {
+		return false
+	}
+	return floats.Max(ps.bestF)-ps.bestF[ps.best] <= ps.spread
+}
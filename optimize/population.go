@@ -0,0 +1,232 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// generational is implemented by the derivative-free global methods that
+// evaluate a generation of locations at a time. The locations of a
+// generation are independent, so they are evaluated concurrently.
+type generational interface {
+	// generationSize returns the number of locations in the current
+	// generation.
+	generationSize() int
+	// candidate stores location i of the current generation into x.
+	candidate(i int, x []float64)
+	// update is called when all locations of the generation have been
+	// evaluated, with the locations in the rows of xs and the function
+	// values in fs. It prepares the next generation and returns whether the
+	// method has converged.
+	update(xs *mat.Dense, fs []float64) bool
+}
+
+// generationRunner implements the Run method of a Method in terms of a
+// generational method. It keeps track of the best location found so far.
+type generationRunner struct {
+	xs *mat.Dense
+	fs []float64
+
+	bestX []float64
+	bestF float64
+
+	sentIdx     int
+	receivedIdx int
+	converged   bool
+}
+
+// init allocates the storage for generations of at most maxSize locations.
+func (r *generationRunner) init(dim, maxSize int) {
+	r.xs = mat.NewDense(maxSize, dim, nil)
+	r.fs = resize(r.fs, maxSize)
+	r.bestX = resize(r.bestX, dim)
+	r.bestF = math.Inf(1)
+	r.sentIdx = 0
+	r.receivedIdx = 0
+	r.converged = false
+}
+
+// status returns the status of the method.
+func (r *generationRunner) status() Status {
+	if r.converged {
+		return MethodConverge
+	}
+	return NotTerminated
+}
+
+func (r *generationRunner) sendTask(g generational, operations chan<- Task, idx int, task Task) {
+	task.ID = idx
+	task.Op = FuncEvaluation
+	g.candidate(idx, r.xs.RawRowView(idx))
+	copy(task.X, r.xs.RawRowView(idx))
+	operations <- task
+}
+
+func (r *generationRunner) sendTasks(g generational, operations chan<- Task, tasks []Task) {
+	n := min(len(tasks), g.generationSize())
+	for i, task := range tasks[:n] {
+		r.sendTask(g, operations, i, task)
+	}
+	r.sentIdx = n
+}
+
+// updateBest updates the best location with the evaluated locations of the
+// current generation.
+func (r *generationRunner) updateBest(n int) bool {
+	improved := false
+	for i, f := range r.fs[:n] {
+		if f < r.bestF {
+			r.bestF = f
+			copy(r.bestX, r.xs.RawRowView(i))
+			improved = true
+		}
+	}
+	return improved
+}
+
+func (r *generationRunner) run(g generational, operations chan<- Task, results <-chan Task, tasks []Task) {
+	r.sendTasks(g, operations, tasks)
+
+Loop:
+	for {
+		result := <-results
+		switch result.Op {
+		default:
+			panic("unknown operation")
+		case PostIteration:
+			break Loop
+		case MajorIteration:
+			r.sendTasks(g, operations, tasks)
+		case FuncEvaluation:
+			r.receivedIdx++
+			r.fs[result.ID] = result.F
+			n := g.generationSize()
+			switch {
+			case r.sentIdx < n:
+				r.sendTask(g, operations, r.sentIdx, result)
+				r.sentIdx++
+			case r.receivedIdx < n:
+				continue Loop
+			default:
+				r.receivedIdx = 0
+				r.sentIdx = 0
+				r.updateBest(n)
+				// NaN function values are treated as the worst possible
+				// value by the methods.
+				for i, f := range r.fs[:n] {
+					if math.IsNaN(f) {
+						r.fs[i] = math.Inf(1)
+					}
+				}
+				r.converged = g.update(r.xs.Slice(0, n, 0, r.xs.RawMatrix().Cols).(*mat.Dense), r.fs[:n])
+				task := result
+				task.ID = -1
+				task.F = r.bestF
+				copy(task.X, r.bestX)
+				task.Op = MajorIteration
+				if r.converged {
+					task.Op = MethodDone
+				}
+				operations <- task
+			}
+		}
+	}
+
+	// Collect the remaining evaluations and send the best of them if it
+	// improves on the best location found so far.
+	n := 0
+	for task := range results {
+		switch task.Op {
+		case MajorIteration:
+		case FuncEvaluation:
+			r.fs[task.ID] = task.F
+			n = max(n, task.ID+1)
+		default:
+			panic("unknown operation")
+		}
+	}
+	bestF := r.bestF
+	if r.updateBest(n) && r.bestF < bestF {
+		task := tasks[0]
+		task.ID = -1
+		task.F = r.bestF
+		copy(task.X, r.bestX)
+		task.Op = MajorIteration
+		operations <- task
+	}
+	close(operations)
+}
+
+// box holds the bounds on the variables of the derivative-free global
+// methods.
+type box struct {
+	lower, upper []float64
+	// scale is the width of the box in each dimension, or the initial step
+	// size if the variable is not bounded on both sides.
+	scale []float64
+}
+
+// init sets the bounds from lower and upper, where nil denotes unbounded
+// variables. It panics if the bounds are inconsistent.
+func (b *box) init(name string, dim int, lower, upper []float64, step float64) {
+	if lower != nil && len(lower) != dim || upper != nil && len(upper) != dim {
+		panic(name + ": bounds do not match the problem dimension")
+	}
+	b.lower = resize(b.lower, dim)
+	b.upper = resize(b.upper, dim)
+	b.scale = resize(b.scale, dim)
+	for i := 0; i < dim; i++ {
+		b.lower[i] = math.Inf(-1)
+		if lower != nil {
+			b.lower[i] = lower[i]
+		}
+		b.upper[i] = math.Inf(1)
+		if upper != nil {
+			b.upper[i] = upper[i]
+		}
+		if !(b.lower[i] <= b.upper[i]) {
+			panic(name + ": inconsistent bounds")
+		}
+		b.scale[i] = b.upper[i] - b.lower[i]
+		if math.IsInf(b.scale[i], 1) {
+			b.scale[i] = step
+		}
+	}
+}
+
+// project projects x onto the box.
+func (b *box) project(x []float64) {
+	for i, v := range x {
+		x[i] = math.Min(math.Max(v, b.lower[i]), b.upper[i])
+	}
+}
+
+// sample stores a random location into x. Variables that are bounded on
+// both sides are sampled uniformly, and the others from a normal
+// distribution centered at x0 with standard deviation equal to the scale.
+func (b *box) sample(x, x0 []float64, rnd *rand.Rand) {
+	for i := range x {
+		if math.IsInf(b.lower[i], -1) || math.IsInf(b.upper[i], 1) {
+			x[i] = x0[i] + b.scale[i]*rnd.NormFloat64()
+		} else {
+			x[i] = b.lower[i] + b.scale[i]*rnd.Float64()
+		}
+	}
+	b.project(x)
+}
+
+// newRand returns a random number generator using src, or a generator seeded
+// from the global source if src is nil.
+func newRand(src rand.Source) *rand.Rand {
+	if src == nil {
+		src = rand.NewSource(rand.Uint64())
+	}
+	return rand.New(src)
+}
@@ -0,0 +1,208 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"fmt"
+	"math"
+	"sync/atomic"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/optimize/functions"
+)
+
+type globalTestCase struct {
+	name         string
+	f            func([]float64) float64
+	lower, upper []float64
+	initX        []float64
+	wantX        []float64
+	wantF        float64
+}
+
+var globalTestCases = []globalTestCase{
+	{
+		name:  "Rastrigin",
+		f:     functions.Rastrigin{}.Func,
+		lower: []float64{-5.12, -5.12, -5.12},
+		upper: []float64{5.12, 5.12, 5.12},
+		initX: []float64{3.1, -2.2, 4.3},
+		wantX: []float64{0, 0, 0},
+		wantF: 0,
+	},
+	{
+		name:  "Eggholder",
+		f:     functions.Eggholder{}.Func,
+		lower: []float64{-512, -512},
+		upper: []float64{512, 512},
+		initX: []float64{0, 0},
+		wantX: []float64{512, 404.2319},
+		wantF: -959.6406627106155,
+	},
+	{
+		name:  "Schwefel",
+		f:     functions.Schwefel{}.Func,
+		lower: []float64{-500, -500},
+		upper: []float64{500, 500},
+		initX: []float64{-300, 100},
+		wantX: []float64{420.9687, 420.9687},
+		wantF: 2.545567497236334e-05,
+	},
+}
+
+func TestGlobalMethods(t *testing.T) {
+	t.Parallel()
+	rastrigin, eggholder, schwefel := globalTestCases[0], globalTestCases[1], globalTestCases[2]
+	ackley := globalTestCase{
+		name:  "Ackley",
+		f:     functions.Ackley{}.Func,
+		lower: []float64{-32.768, -32.768},
+		upper: []float64{32.768, 32.768},
+		initX: []float64{20, -15},
+		wantX: []float64{0, 0},
+		wantF: 0,
+	}
+	for _, test := range []struct {
+		name   string
+		cases  []globalTestCase
+		method func(lower, upper []float64) Method
+		// converger is the Converger used in the test. If it is nil, the
+		// method must converge by itself.
+		converger Converger
+	}{
+		{
+			name:  "DERand1Bin",
+			cases: []globalTestCase{rastrigin, eggholder, schwefel},
+			method: func(lower, upper []float64) Method {
+				return &DifferentialEvolution{Lower: lower, Upper: upper, Population: 50, Src: rand.NewSource(1)}
+			},
+		},
+		{
+			name:  "DEBest1Bin",
+			cases: []globalTestCase{ackley},
+			method: func(lower, upper []float64) Method {
+				return &DifferentialEvolution{Lower: lower, Upper: upper, Strategy: DEBest1Bin, Population: 50, Src: rand.NewSource(1)}
+			},
+		},
+		{
+			// Members of the population may remain in local minima, so the
+			// method is stopped when the best value stalls.
+			name:  "DECurrentToBest1Bin",
+			cases: []globalTestCase{rastrigin, ackley},
+			method: func(lower, upper []float64) Method {
+				return &DifferentialEvolution{Lower: lower, Upper: upper, Strategy: DECurrentToBest1Bin, Src: rand.NewSource(1)}
+			},
+			converger: defaultFunctionConverge(),
+		},
+		{
+			name:  "ParticleSwarm",
+			cases: []globalTestCase{rastrigin, eggholder},
+			method: func(lower, upper []float64) Method {
+				return &ParticleSwarm{Lower: lower, Upper: upper, Population: 50, Src: rand.NewSource(1)}
+			},
+		},
+		{
+			name:  "SimulatedAnnealing",
+			cases: []globalTestCase{ackley},
+			method: func(lower, upper []float64) Method {
+				return &SimulatedAnnealing{Lower: lower, Upper: upper, Cooling: 0.99, Src: rand.NewSource(1)}
+			},
+		},
+	} {
+		for _, c := range test.cases {
+			var results []*Result
+			for _, concurrent := range []int{0, 4} {
+				name := fmt.Sprintf("%s %s concurrent %d", test.name, c.name, concurrent)
+				var outside int32
+				lower, upper := c.lower, c.upper
+				problem := Problem{
+					Func: func(x []float64) float64 {
+						for i, v := range x {
+							if v < lower[i] || upper[i] < v {
+								atomic.StoreInt32(&outside, 1)
+							}
+						}
+						return c.f(x)
+					},
+				}
+				settings := &Settings{
+					Converger:       test.converger,
+					FuncEvaluations: 200000,
+					Concurrent:      concurrent,
+				}
+				if settings.Converger == nil {
+					settings.Converger = NeverTerminate{}
+				}
+				result, err := Minimize(problem, c.initX, settings, test.method(lower, upper))
+				if err != nil {
+					t.Errorf("%s: unexpected error: %v", name, err)
+					continue
+				}
+				results = append(results, result)
+				if outside != 0 {
+					t.Errorf("%s: function evaluated outside the bounds", name)
+				}
+				if test.converger == nil && result.Status != MethodConverge {
+					t.Errorf("%s: unexpected status: got %v, want %v", name, result.Status, MethodConverge)
+				}
+				if math.Abs(result.F-c.wantF) > 1e-4 {
+					t.Errorf("%s: unexpected minimum value: got %v, want %v", name, result.F, c.wantF)
+				}
+				if !floats.EqualApprox(result.X, c.wantX, 1e-3) {
+					t.Errorf("%s: unexpected minimum location: got %v, want %v", name, result.X, c.wantX)
+				}
+			}
+			// The generations of the population methods do not depend on
+			// the number of concurrent evaluations.
+			if len(results) == 2 && test.name != "SimulatedAnnealing" {
+				if results[0].F != results[1].F || results[0].FuncEvaluations != results[1].FuncEvaluations {
+					t.Errorf("%s %s: result depends on concurrency", test.name, c.name)
+				}
+			}
+		}
+	}
+}
+
+func TestGlobalMethodsUnbounded(t *testing.T) {
+	t.Parallel()
+	// The methods work without bounds from the initial location.
+	for k, method := range []Method{
+		&DifferentialEvolution{Src: rand.NewSource(1)},
+		&ParticleSwarm{Src: rand.NewSource(1)},
+		&SimulatedAnnealing{StepSize: 1, Cooling: 0.99, Src: rand.NewSource(1)},
+	} {
+		problem := Problem{Func: functions.Ackley{}.Func}
+		settings := &Settings{
+			Converger:       NeverTerminate{},
+			FuncEvaluations: 200000,
+		}
+		result, err := Minimize(problem, []float64{1.7, -1.2}, settings, method)
+		if err != nil {
+			t.Errorf("method %d: unexpected error: %v", k, err)
+			continue
+		}
+		if !floats.EqualApprox(result.X, []float64{0, 0}, 1e-3) {
+			t.Errorf("method %d: unexpected minimum location: got %v, want [0 0]", k, result.X)
+		}
+	}
+}
+
+func TestGlobalMethodsPanics(t *testing.T) {
+	t.Parallel()
+	for k, method := range []Method{
+		&DifferentialEvolution{Lower: []float64{1}},
+		&DifferentialEvolution{Lower: []float64{1, 1}, Upper: []float64{0, 2}},
+		&DifferentialEvolution{Population: 3},
+		&ParticleSwarm{Upper: []float64{1, 2, 3}},
+		&SimulatedAnnealing{Cooling: 1.5},
+	} {
+		if !panics(func() { method.Init(2, 1) }) {
+			t.Errorf("method %d: expected panic for invalid settings", k)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat"
+)
+
+var (
+	_ Statuser = (*SimulatedAnnealing)(nil)
+	_ Method   = (*SimulatedAnnealing)(nil)
+)
+
+// SimulatedAnnealing implements simulated annealing, a derivative-free global
+// optimization method. From the current location, new locations are proposed
+// by normally distributed steps and accepted according to the Metropolis
+// criterion: a proposal that decreases the function value is always accepted,
+// and one that increases it by Δf is accepted with probability exp(-Δf/T).
+// The temperature T decreases geometrically, so the search gradually turns
+// from exploring the function to descending into a minimum. The standard
+// deviation of the steps decreases with the square root of the temperature.
+//
+// In each generation, as many proposals are made as there are concurrent
+// evaluations in Settings.Concurrent. The proposals of a generation are
+// evaluated concurrently and the best of them is subject to the Metropolis
+// criterion. The variables may be bounded by Lower and Upper, and proposals
+// are projected onto the bounds.
+type SimulatedAnnealing struct {
+	// Lower and Upper are the lower and upper bounds on the variables. If
+	// Lower is nil, the variables are unbounded from below, and if Upper is
+	// nil, the variables are unbounded from above. If not nil, the lengths
+	// of Lower and Upper must equal the problem dimension, and
+	// Lower[i] <= Upper[i] must hold for all i, otherwise SimulatedAnnealing
+	// will panic.
+	Lower, Upper []float64
+
+	// StepSize is the initial standard deviation of the steps relative to
+	// the width of the bounds. For the variables that are not bounded on
+	// both sides, StepSize is the absolute standard deviation. If StepSize
+	// is 0, it is defaulted to 0.1.
+	StepSize float64
+
+	// InitTemperature is the initial temperature. If InitTemperature is 0,
+	// the function is evaluated at 20 random locations around the initial
+	// location in the first generation, and the initial temperature is set
+	// to the standard deviation of the function values.
+	InitTemperature float64
+	// Cooling is the factor by which the temperature decreases in each
+	// generation. If Cooling is 0, it is defaulted to 0.95. Cooling must be
+	// in (0, 1), otherwise SimulatedAnnealing will panic.
+	Cooling float64
+	// StopTemperature sets the threshold for stopping the optimization if
+	// the temperature relative to the initial temperature becomes too
+	// small. If StopTemperature is 0, it is defaulted to 1e-12, and if it is
+	// NaN the stopping criterion is not used.
+	StopTemperature float64
+
+	// Src allows a random number generator to be supplied for generating
+	// samples. If Src is nil the generator in golang.org/x/math/rand is
+	// used.
+	Src rand.Source
+
+	rnd     *rand.Rand
+	box     box
+	step    float64
+	batch   int
+	cooling float64
+	stopT   float64
+
+	// x and f are the current location and function value, and temp and
+	// initTemp the current and initial temperature. initialized is false
+	// until the first generation has been evaluated.
+	x           []float64
+	f           float64
+	temp        float64
+	initTemp    float64
+	initialized bool
+
+	runner generationRunner
+}
+
+// saInitSamples is the number of locations used to estimate the initial
+// temperature.
+const saInitSamples = 20
+
+// Status returns the status of the method.
+func (sa *SimulatedAnnealing) Status() (Status, error) {
+	return sa.runner.status(), nil
+}
+
+func (*SimulatedAnnealing) Uses(has Available) (uses Available, err error) {
+	return has.function()
+}
+
+func (sa *SimulatedAnnealing) Init(dim, tasks int) int {
+	if dim <= 0 {
+		panic(nonpositiveDimension)
+	}
+	if tasks < 0 {
+		panic(negativeTasks)
+	}
+	sa.step = sa.StepSize
+	if sa.step == 0 {
+		sa.step = 0.1
+	} else if sa.step < 0 {
+		panic("simulated annealing: negative step size")
+	}
+	sa.box.init("simulated annealing", dim, sa.Lower, sa.Upper, 1)
+	sa.cooling = sa.Cooling
+	if sa.cooling == 0 {
+		sa.cooling = 0.95
+	} else if sa.cooling <= 0 || sa.cooling >= 1 {
+		panic("simulated annealing: cooling out of range")
+	}
+	if sa.InitTemperature < 0 {
+		panic("simulated annealing: negative initial temperature")
+	}
+	sa.stopT = sa.StopTemperature
+	if sa.stopT == 0 {
+		sa.stopT = 1e-12
+	}
+
+	sa.rnd = newRand(sa.Src)
+	sa.batch = max(tasks, 1)
+	sa.x = resize(sa.x, dim)
+	sa.f = math.Inf(1)
+	sa.temp = sa.InitTemperature
+	sa.initTemp = sa.InitTemperature
+	sa.initialized = false
+	sa.runner.init(dim, max(sa.batch, saInitSamples))
+	return sa.batch
+}
+
+func (sa *SimulatedAnnealing) Run(operations chan<- Task, results <-chan Task, tasks []Task) {
+	copy(sa.x, tasks[0].X)
+	sa.box.project(sa.x)
+	sa.runner.run(sa, operations, results, tasks)
+}
+
+func (sa *SimulatedAnnealing) generationSize() int {
+	if !sa.initialized && sa.initTemp == 0 {
+		return max(sa.batch, saInitSamples)
+	}
+	return sa.batch
+}
+
+func (sa *SimulatedAnnealing) candidate(i int, x []float64) {
+	if !sa.initialized && i == 0 {
+		copy(x, sa.x)
+		return
+	}
+	scale := sa.step
+	if sa.initialized {
+		scale *= math.Sqrt(sa.temp / sa.initTemp)
+	}
+	for j := range x {
+		x[j] = sa.x[j] + scale*sa.box.scale[j]*sa.rnd.NormFloat64()
+	}
+	sa.box.project(x)
+}
+
+func (sa *SimulatedAnnealing) update(xs *mat.Dense, fs []float64) bool {
+	best := floats.MinIdx(fs)
+	if !sa.initialized {
+		if sa.initTemp == 0 {
+			var finite []float64
+			for _, f := range fs {
+				if !math.IsInf(f, 0) {
+					finite = append(finite, f)
+				}
+			}
+			if len(finite) > 1 {
+				sa.initTemp = stat.StdDev(finite, nil)
+			}
+			if !(sa.initTemp > 0) {
+				sa.initTemp = 1
+			}
+			sa.temp = sa.initTemp
+		}
+		sa.initialized = true
+		copy(sa.x, xs.RawRowView(best))
+		sa.f = fs[best]
+		return false
+	}
+
+	// Metropolis criterion for the best proposal.
+	if df := fs[best] - sa.f; df <= 0 || sa.rnd.Float64() < math.Exp(-df/sa.temp) {
+		copy(sa.x, xs.RawRowView(best))
+		sa.f = fs[best]
+	}
+	sa.temp *= sa.cooling
+	if math.IsNaN(sa.stopT) {
+		return false
+	}
+	return sa.temp <= sa.stopT*sa.initTemp
+}
@@ -0,0 +1,103 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+var _ TrustRegionSubproblem = (*SteihaugCG)(nil)
+
+// SteihaugCG solves the trust-region subproblem with the truncated conjugate
+// gradient method of Steihaug and Toint.
+//
+// The conjugate gradient iteration is applied to the Newton equations B p = -g
+// starting from p = 0, and it is terminated when the residual is small enough,
+// when the iterate leaves the trust region or when a direction of negative
+// curvature is encountered. In the latter two cases the step is extended to
+// the boundary of the trust region. The first iterate is the Cauchy point, so
+// the step always decreases the model at least as much as the Cauchy point.
+//
+// SteihaugCG only needs products of the Hessian with vectors, and it does not
+// require the Hessian to be positive definite. It is suitable for large
+// problems where the factorization of the Hessian is expensive.
+//
+// References:
+//   - Steihaug, T.: The conjugate gradient method and trust regions in large
+//     scale optimization. SIAM J. Numer. Anal. 20(3) (1983), 626-637
+//   - Nocedal, J., Wright, S.: Numerical Optimization (2nd ed). Springer (2006),
+//     Algorithm 7.2
+type SteihaugCG struct {
+	// MaxIterations is the maximum number of conjugate gradient iterations.
+	// If MaxIterations is zero, it is defaulted to twice the problem dimension.
+	MaxIterations int
+
+	r  []float64
+	d  []float64
+	bd []float64
+	z  []float64
+}
+
+// Solve computes the truncated conjugate gradient step. It implements the
+// TrustRegionSubproblem interface.
+func (s *SteihaugCG) Solve(dst, grad []float64, hess mat.Symmetric, radius float64) {
+	n := len(grad)
+	if len(dst) != n {
+		panic("steihaugcg: slice length mismatch")
+	}
+	maxIter := s.MaxIterations
+	if maxIter == 0 {
+		maxIter = 2 * n
+	}
+	if maxIter < 0 {
+		panic("steihaugcg: negative MaxIterations")
+	}
+	s.r = resize(s.r, n)
+	s.d = resize(s.d, n)
+	s.bd = resize(s.bd, n)
+	s.z = resize(s.z, n)
+
+	z := s.z
+	for i := range z {
+		z[i] = 0
+	}
+	copy(s.r, grad)
+	floats.ScaleTo(s.d, -1, grad)
+
+	// The residual tolerance yields superlinear convergence of the outer
+	// iteration.
+	gNorm := floats.Norm(grad, 2)
+	tol := math.Min(0.5, math.Sqrt(gNorm)) * gNorm
+	rr := gNorm * gNorm
+	bd := mat.NewVecDense(n, s.bd)
+	for k := 0; k < maxIter && math.Sqrt(rr) > tol; k++ {
+		bd.MulVec(hess, mat.NewVecDense(n, s.d))
+		dBd := floats.Dot(s.d, s.bd)
+		if dBd <= 0 {
+			// Follow the direction of negative curvature to the boundary.
+			tau := trustRegionBoundary(z, s.d, radius)
+			floats.AddScaledTo(dst, z, tau, s.d)
+			return
+		}
+		alpha := rr / dBd
+		floats.AddScaledTo(dst, z, alpha, s.d)
+		if floats.Norm(dst, 2) >= radius {
+			tau := trustRegionBoundary(z, s.d, radius)
+			floats.AddScaledTo(dst, z, tau, s.d)
+			return
+		}
+		copy(z, dst)
+		floats.AddScaled(s.r, alpha, s.bd)
+		rrNew := floats.Dot(s.r, s.r)
+		beta := rrNew / rr
+		rr = rrNew
+		floats.Scale(beta, s.d)
+		floats.Sub(s.d, s.r)
+	}
+	copy(dst, z)
+}
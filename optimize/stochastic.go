@@ -0,0 +1,614 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+)
+
+const (
+	defaultSGDRate     = 0.01
+	defaultAdamRate    = 0.001
+	defaultAdaGradRate = 0.01
+	defaultRMSPropRate = 0.001
+
+	defaultAdamBeta1     = 0.9
+	defaultAdamBeta2     = 0.999
+	defaultRMSPropDecay  = 0.9
+	defaultStochasticEps = 1e-8
+)
+
+var (
+	_ Method      = (*SGD)(nil)
+	_ localMethod = (*SGD)(nil)
+	_ Method      = (*Adam)(nil)
+	_ localMethod = (*Adam)(nil)
+	_ Method      = (*AdaGrad)(nil)
+	_ localMethod = (*AdaGrad)(nil)
+	_ Method      = (*RMSProp)(nil)
+	_ localMethod = (*RMSProp)(nil)
+
+	_ LearningRateSchedule = ConstantSchedule{}
+	_ LearningRateSchedule = StepSchedule{}
+	_ LearningRateSchedule = ExponentialSchedule{}
+	_ LearningRateSchedule = InverseTimeSchedule{}
+	_ LearningRateSchedule = CosineSchedule{}
+	_ LearningRateSchedule = WarmupSchedule{}
+)
+
+// LearningRateSchedule returns the learning rate of a stochastic gradient
+// method at each iteration.
+type LearningRateSchedule interface {
+	// LearningRate returns the learning rate at the iteration iter, which
+	// starts at zero.
+	LearningRate(iter int) float64
+}
+
+// ConstantSchedule is a LearningRateSchedule that returns the same learning
+// rate at every iteration.
+type ConstantSchedule struct {
+	Rate float64
+}
+
+func (c ConstantSchedule) LearningRate(iter int) float64 {
+	return c.Rate
+}
+
+// StepSchedule is a LearningRateSchedule that multiplies the learning rate
+// by Factor every Period iterations,
+//
+//	Initial * Factor^⌊iter/Period⌋.
+//
+// If Period is not positive, the learning rate is constant.
+type StepSchedule struct {
+	Initial float64
+	Factor  float64
+	Period  int
+}
+
+func (s StepSchedule) LearningRate(iter int) float64 {
+	if s.Period <= 0 {
+		return s.Initial
+	}
+	return s.Initial * math.Pow(s.Factor, float64(iter/s.Period))
+}
+
+// ExponentialSchedule is a LearningRateSchedule that decays the learning rate
+// exponentially,
+//
+//	Initial * Decay^iter.
+type ExponentialSchedule struct {
+	Initial float64
+	Decay   float64
+}
+
+func (e ExponentialSchedule) LearningRate(iter int) float64 {
+	return e.Initial * math.Pow(e.Decay, float64(iter))
+}
+
+// InverseTimeSchedule is a LearningRateSchedule that decays the learning
+// rate as a power of the iteration,
+//
+//	Initial / (1 + Decay*iter)^Power.
+//
+// If Power is zero, it is defaulted to 1. The choice Power = 1 satisfies the
+// Robbins-Monro conditions for the convergence of stochastic gradient
+// descent.
+type InverseTimeSchedule struct {
+	Initial float64
+	Decay   float64
+	Power   float64
+}
+
+func (s InverseTimeSchedule) LearningRate(iter int) float64 {
+	power := s.Power
+	if power == 0 {
+		power = 1
+	}
+	return s.Initial / math.Pow(1+s.Decay*float64(iter), power)
+}
+
+// CosineSchedule is a LearningRateSchedule that anneals the learning rate
+// from Max to Min along a half cosine over Period iterations,
+//
+//	Min + (Max - Min) * (1 + cos(π t/Period)) / 2,
+//
+// where t is the iteration. If Restart is true, t is the iteration modulo
+// Period, so that the learning rate is periodically restarted at Max,
+// otherwise the learning rate stays at Min after Period iterations. If Period
+// is not positive, the learning rate is Max.
+//
+// Reference:
+//   - Loshchilov, I., Hutter, F.: SGDR: Stochastic gradient descent with warm
+//     restarts. In: International Conference on Learning Representations
+//     (2017)
+type CosineSchedule struct {
+	Max, Min float64
+	Period   int
+	Restart  bool
+}
+
+func (c CosineSchedule) LearningRate(iter int) float64 {
+	if c.Period <= 0 {
+		return c.Max
+	}
+	t := iter
+	if c.Restart {
+		t %= c.Period
+	} else if t > c.Period {
+		t = c.Period
+	}
+	return c.Min + 0.5*(c.Max-c.Min)*(1+math.Cos(math.Pi*float64(t)/float64(c.Period)))
+}
+
+// WarmupSchedule is a LearningRateSchedule that increases the learning rate
+// linearly from zero over the first Steps iterations before following
+// Schedule, which starts at iteration zero after the warm-up.
+type WarmupSchedule struct {
+	Steps    int
+	Schedule LearningRateSchedule
+}
+
+func (w WarmupSchedule) LearningRate(iter int) float64 {
+	if iter < w.Steps {
+		return w.Schedule.LearningRate(0) * float64(iter+1) / float64(w.Steps+1)
+	}
+	return w.Schedule.LearningRate(iter - w.Steps)
+}
+
+// stochasticUpdater is implemented by stochastic gradient methods. update
+// modifies x using the gradient g and the learning rate at the iteration
+// iter, which starts at zero.
+type stochasticUpdater interface {
+	update(x, g []float64, rate float64, iter int)
+}
+
+// stochasticGradient drives a stochastic gradient method. Each iteration
+// evaluates the objective function and the gradient at the current
+// location, announces the location as a MajorIteration, and updates the
+// location with a step computed from the gradient.
+type stochasticGradient struct {
+	schedule LearningRateSchedule
+	updater  stochasticUpdater
+
+	iter   int
+	lastOp Operation
+}
+
+func (s *stochasticGradient) init(loc *Location, schedule LearningRateSchedule, defaultRate float64, updater stochasticUpdater) (Operation, error) {
+	if schedule == nil {
+		schedule = ConstantSchedule{Rate: defaultRate}
+	}
+	s.schedule = schedule
+	s.updater = updater
+	s.iter = 0
+	return s.step(loc)
+}
+
+func (s *stochasticGradient) iterate(loc *Location) (Operation, error) {
+	if s.lastOp == MajorIteration {
+		return s.step(loc)
+	}
+	s.lastOp = MajorIteration
+	return s.lastOp, nil
+}
+
+// step updates the location and requests the evaluations at the new
+// location.
+func (s *stochasticGradient) step(loc *Location) (Operation, error) {
+	rate := s.schedule.LearningRate(s.iter)
+	if !(rate > 0) || math.IsInf(rate, 1) {
+		return NoOperation, ErrLearningRate
+	}
+	s.updater.update(loc.X, loc.Gradient, rate, s.iter)
+	s.iter++
+	s.lastOp = FuncEvaluation | GradEvaluation
+	return s.lastOp, nil
+}
+
+// stochasticNeeds is the needs of the stochastic gradient methods.
+func stochasticNeeds() struct {
+	Gradient bool
+	Hessian  bool
+} {
+	return struct {
+		Gradient bool
+		Hessian  bool
+	}{true, false}
+}
+
+// SGD implements stochastic gradient descent with optional momentum. At
+// each iteration the location is updated as
+//
+//	v = Momentum*v + g
+//	x = x - η d
+//
+// where g is the gradient, η is the learning rate and d is v, or g +
+// Momentum*v if Nesterov is true.
+//
+// SGD and the other stochastic gradient methods are intended for objective
+// functions whose gradient is only known up to noise, for example when it
+// is computed from a mini-batch of training data. They do not use line
+// searches, and each iteration evaluates the objective function and the
+// gradient once at a new location, which is reported to Minimize as a
+// MajorIteration. The objective function is evaluated before the gradient,
+// so a mini-batch may be sampled in Problem.Func and reused in
+// Problem.Grad. The final location is the last iterate rather than the best
+// one found. Since the function values are noisy, AverageConverge or limits
+// on the number of iterations or evaluations are better suited than
+// FunctionConverge to terminate the optimization.
+//
+// Reference:
+//   - Sutskever, I., Martens, J., Dahl, G., Hinton, G.: On the importance of
+//     initialization and momentum in deep learning. In: International
+//     Conference on Machine Learning, pp. 1139-1147 (2013)
+type SGD struct {
+	// LearningRate is the learning rate schedule. If LearningRate is nil,
+	// it is defaulted to a constant learning rate of 0.01.
+	LearningRate LearningRateSchedule
+	// Momentum is the momentum coefficient, which must be in [0, 1).
+	Momentum float64
+	// Nesterov specifies whether Nesterov momentum is used.
+	Nesterov bool
+
+	v []float64
+
+	sg     stochasticGradient
+	status Status
+	err    error
+}
+
+func (s *SGD) Status() (Status, error) {
+	return s.status, s.err
+}
+
+func (*SGD) Uses(has Available) (uses Available, err error) {
+	return has.gradient()
+}
+
+func (s *SGD) Init(dim, tasks int) int {
+	if s.Momentum < 0 || s.Momentum >= 1 {
+		panic("sgd: momentum out of range")
+	}
+	s.status = NotTerminated
+	s.err = nil
+	return 1
+}
+
+func (s *SGD) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	s.status, s.err = localOptimizer{}.run(s, math.NaN(), operation, result, tasks)
+	close(operation)
+}
+
+func (s *SGD) initLocal(loc *Location) (Operation, error) {
+	s.v = resize(s.v, len(loc.X))
+	for i := range s.v {
+		s.v[i] = 0
+	}
+	return s.sg.init(loc, s.LearningRate, defaultSGDRate, s)
+}
+
+func (s *SGD) iterateLocal(loc *Location) (Operation, error) {
+	return s.sg.iterate(loc)
+}
+
+func (s *SGD) update(x, g []float64, rate float64, _ int) {
+	mu := s.Momentum
+	for i, gi := range g {
+		s.v[i] = mu*s.v[i] + gi
+		d := s.v[i]
+		if s.Nesterov {
+			d = gi + mu*s.v[i]
+		}
+		x[i] -= rate * d
+	}
+}
+
+func (*SGD) needs() struct {
+	Gradient bool
+	Hessian  bool
+} {
+	return stochasticNeeds()
+}
+
+// Adam implements the Adam stochastic gradient method, which scales the
+// steps by estimates of the first and second moments of the gradient. At
+// iteration t, starting at one, the location is updated as
+//
+//	m = β₁ m + (1-β₁) g
+//	v = β₂ v + (1-β₂) g²
+//	x = x - η m̂ / (√v̂ + ε)
+//
+// where m̂ = m/(1-β₁ᵗ) and v̂ = v/(1-β₂ᵗ) are the bias-corrected moment
+// estimates. See the documentation of SGD for how the stochastic gradient
+// methods interact with Minimize.
+//
+// Reference:
+//   - Kingma, D.P., Ba, J.: Adam: A method for stochastic optimization. In:
+//     International Conference on Learning Representations (2015)
+type Adam struct {
+	// LearningRate is the learning rate schedule. If LearningRate is nil,
+	// it is defaulted to a constant learning rate of 0.001.
+	LearningRate LearningRateSchedule
+	// Beta1 and Beta2 are the decay rates of the moment estimates, which
+	// must be in [0, 1). If Beta1 is 0, it is defaulted to 0.9, and if Beta2
+	// is 0, it is defaulted to 0.999.
+	Beta1, Beta2 float64
+	// Epsilon is added to the denominator of the steps for numerical
+	// stability. If Epsilon is 0, it is defaulted to 1e-8.
+	Epsilon float64
+	// AMSGrad specifies whether the maximum of the second moment estimates
+	// over the iterations is used in the denominator of the steps, which
+	// makes the effective learning rates non-increasing.
+	AMSGrad bool
+
+	beta1, beta2, eps float64
+	m, v, vmax        []float64
+
+	sg     stochasticGradient
+	status Status
+	err    error
+}
+
+func (a *Adam) Status() (Status, error) {
+	return a.status, a.err
+}
+
+func (*Adam) Uses(has Available) (uses Available, err error) {
+	return has.gradient()
+}
+
+func (a *Adam) Init(dim, tasks int) int {
+	a.beta1 = a.Beta1
+	if a.beta1 == 0 {
+		a.beta1 = defaultAdamBeta1
+	}
+	a.beta2 = a.Beta2
+	if a.beta2 == 0 {
+		a.beta2 = defaultAdamBeta2
+	}
+	a.eps = a.Epsilon
+	if a.eps == 0 {
+		a.eps = defaultStochasticEps
+	}
+	if a.beta1 < 0 || a.beta1 >= 1 || a.beta2 < 0 || a.beta2 >= 1 {
+		panic("adam: decay rate out of range")
+	}
+	if a.eps < 0 {
+		panic("adam: negative epsilon")
+	}
+	a.status = NotTerminated
+	a.err = nil
+	return 1
+}
+
+func (a *Adam) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	a.status, a.err = localOptimizer{}.run(a, math.NaN(), operation, result, tasks)
+	close(operation)
+}
+
+func (a *Adam) initLocal(loc *Location) (Operation, error) {
+	dim := len(loc.X)
+	a.m = resize(a.m, dim)
+	a.v = resize(a.v, dim)
+	a.vmax = resize(a.vmax, dim)
+	for i := range a.m {
+		a.m[i] = 0
+		a.v[i] = 0
+		a.vmax[i] = 0
+	}
+	return a.sg.init(loc, a.LearningRate, defaultAdamRate, a)
+}
+
+func (a *Adam) iterateLocal(loc *Location) (Operation, error) {
+	return a.sg.iterate(loc)
+}
+
+func (a *Adam) update(x, g []float64, rate float64, iter int) {
+	t := float64(iter + 1)
+	c1 := 1 - math.Pow(a.beta1, t)
+	c2 := 1 - math.Pow(a.beta2, t)
+	for i, gi := range g {
+		a.m[i] = a.beta1*a.m[i] + (1-a.beta1)*gi
+		a.v[i] = a.beta2*a.v[i] + (1-a.beta2)*gi*gi
+		v := a.v[i]
+		if a.AMSGrad {
+			a.vmax[i] = math.Max(a.vmax[i], v)
+			v = a.vmax[i]
+		}
+		x[i] -= rate * (a.m[i] / c1) / (math.Sqrt(v/c2) + a.eps)
+	}
+}
+
+func (*Adam) needs() struct {
+	Gradient bool
+	Hessian  bool
+} {
+	return stochasticNeeds()
+}
+
+// AdaGrad implements the AdaGrad stochastic gradient method, which scales
+// the steps of each variable by the accumulated squared gradients. At each
+// iteration the location is updated as
+//
+//	s = s + g²
+//	x = x - η g / (√s + ε).
+//
+// AdaGrad is suited to sparse gradients, where rarely updated variables
+// take larger steps. See the documentation of SGD for how the stochastic
+// gradient methods interact with Minimize.
+//
+// Reference:
+//   - Duchi, J., Hazan, E., Singer, Y.: Adaptive subgradient methods for
+//     online learning and stochastic optimization. Journal of Machine
+//     Learning Research 12 (2011), 2121-2159
+type AdaGrad struct {
+	// LearningRate is the learning rate schedule. If LearningRate is nil,
+	// it is defaulted to a constant learning rate of 0.01.
+	LearningRate LearningRateSchedule
+	// Epsilon is added to the denominator of the steps for numerical
+	// stability. If Epsilon is 0, it is defaulted to 1e-8.
+	Epsilon float64
+
+	eps float64
+	s   []float64
+
+	sg     stochasticGradient
+	status Status
+	err    error
+}
+
+func (a *AdaGrad) Status() (Status, error) {
+	return a.status, a.err
+}
+
+func (*AdaGrad) Uses(has Available) (uses Available, err error) {
+	return has.gradient()
+}
+
+func (a *AdaGrad) Init(dim, tasks int) int {
+	a.eps = a.Epsilon
+	if a.eps == 0 {
+		a.eps = defaultStochasticEps
+	}
+	if a.eps < 0 {
+		panic("adagrad: negative epsilon")
+	}
+	a.status = NotTerminated
+	a.err = nil
+	return 1
+}
+
+func (a *AdaGrad) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	a.status, a.err = localOptimizer{}.run(a, math.NaN(), operation, result, tasks)
+	close(operation)
+}
+
+func (a *AdaGrad) initLocal(loc *Location) (Operation, error) {
+	a.s = resize(a.s, len(loc.X))
+	for i := range a.s {
+		a.s[i] = 0
+	}
+	return a.sg.init(loc, a.LearningRate, defaultAdaGradRate, a)
+}
+
+func (a *AdaGrad) iterateLocal(loc *Location) (Operation, error) {
+	return a.sg.iterate(loc)
+}
+
+func (a *AdaGrad) update(x, g []float64, rate float64, _ int) {
+	for i, gi := range g {
+		a.s[i] += gi * gi
+		x[i] -= rate * gi / (math.Sqrt(a.s[i]) + a.eps)
+	}
+}
+
+func (*AdaGrad) needs() struct {
+	Gradient bool
+	Hessian  bool
+} {
+	return stochasticNeeds()
+}
+
+// RMSProp implements the RMSProp stochastic gradient method, which scales
+// the steps of each variable by a moving average of the squared gradients.
+// At each iteration the location is updated as
+//
+//	s = ρ s + (1-ρ) g²
+//	v = Momentum*v + g / (√s + ε)
+//	x = x - η v.
+//
+// See the documentation of SGD for how the stochastic gradient methods
+// interact with Minimize.
+//
+// Reference:
+//   - Tieleman, T., Hinton, G.: Lecture 6.5 - RMSProp. COURSERA: Neural
+//     Networks for Machine Learning (2012)
+type RMSProp struct {
+	// LearningRate is the learning rate schedule. If LearningRate is nil,
+	// it is defaulted to a constant learning rate of 0.001.
+	LearningRate LearningRateSchedule
+	// Decay is the decay rate ρ of the moving average, which must be in
+	// [0, 1). If Decay is 0, it is defaulted to 0.9.
+	Decay float64
+	// Momentum is the momentum coefficient, which must be in [0, 1).
+	Momentum float64
+	// Epsilon is added to the denominator of the steps for numerical
+	// stability. If Epsilon is 0, it is defaulted to 1e-8.
+	Epsilon float64
+
+	decay, eps float64
+	s, v       []float64
+
+	sg     stochasticGradient
+	status Status
+	err    error
+}
+
+func (r *RMSProp) Status() (Status, error) {
+	return r.status, r.err
+}
+
+func (*RMSProp) Uses(has Available) (uses Available, err error) {
+	return has.gradient()
+}
+
+func (r *RMSProp) Init(dim, tasks int) int {
+	r.decay = r.Decay
+	if r.decay == 0 {
+		r.decay = defaultRMSPropDecay
+	}
+	r.eps = r.Epsilon
+	if r.eps == 0 {
+		r.eps = defaultStochasticEps
+	}
+	if r.decay < 0 || r.decay >= 1 {
+		panic("rmsprop: decay rate out of range")
+	}
+	if r.Momentum < 0 || r.Momentum >= 1 {
+		panic("rmsprop: momentum out of range")
+	}
+	if r.eps < 0 {
+		panic("rmsprop: negative epsilon")
+	}
+	r.status = NotTerminated
+	r.err = nil
+	return 1
+}
+
+func (r *RMSProp) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	r.status, r.err = localOptimizer{}.run(r, math.NaN(), operation, result, tasks)
+	close(operation)
+}
+
+func (r *RMSProp) initLocal(loc *Location) (Operation, error) {
+	dim := len(loc.X)
+	r.s = resize(r.s, dim)
+	r.v = resize(r.v, dim)
+	for i := range r.s {
+		r.s[i] = 0
+		r.v[i] = 0
+	}
+	return r.sg.init(loc, r.LearningRate, defaultRMSPropRate, r)
+}
+
+func (r *RMSProp) iterateLocal(loc *Location) (Operation, error) {
+	return r.sg.iterate(loc)
+}
+
+func (r *RMSProp) update(x, g []float64, rate float64, _ int) {
+	for i, gi := range g {
+		r.s[i] = r.decay*r.s[i] + (1-r.decay)*gi*gi
+		r.v[i] = r.Momentum*r.v[i] + gi/(math.Sqrt(r.s[i])+r.eps)
+		x[i] -= rate * r.v[i]
+	}
+}
+
+func (*RMSProp) needs() struct {
+	Gradient bool
+	Hessian  bool
+} {
+	return stochasticNeeds()
+}
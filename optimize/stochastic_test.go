@@ -0,0 +1,235 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestStochasticQuadratic(t *testing.T) {
+	t.Parallel()
+	// f(x) = Σ (i+1)/2 (x_i - i)², which is minimized at x_i = i.
+	problem := Problem{
+		Func: func(x []float64) float64 {
+			var f float64
+			for i, v := range x {
+				f += float64(i+1) / 2 * (v - float64(i)) * (v - float64(i))
+			}
+			return f
+		},
+		Grad: func(grad, x []float64) {
+			for i, v := range x {
+				grad[i] = float64(i+1) * (v - float64(i))
+			}
+		},
+	}
+	want := []float64{0, 1, 2, 3}
+	for _, method := range []Method{
+		&SGD{LearningRate: ConstantSchedule{Rate: 0.1}},
+		&SGD{LearningRate: ConstantSchedule{Rate: 0.05}, Momentum: 0.9},
+		&SGD{LearningRate: ConstantSchedule{Rate: 0.05}, Momentum: 0.9, Nesterov: true},
+		&Adam{LearningRate: ExponentialSchedule{Initial: 0.1, Decay: 0.999}},
+		&Adam{LearningRate: ExponentialSchedule{Initial: 0.1, Decay: 0.999}, AMSGrad: true},
+		&AdaGrad{LearningRate: ConstantSchedule{Rate: 1}},
+		&RMSProp{LearningRate: ExponentialSchedule{Initial: 0.01, Decay: 0.999}},
+		&RMSProp{LearningRate: ExponentialSchedule{Initial: 0.01, Decay: 0.999}, Momentum: 0.5},
+	} {
+		settings := &Settings{
+			Converger:         NeverTerminate{},
+			GradientThreshold: 1e-6,
+			MajorIterations:   20000,
+		}
+		result, err := Minimize(problem, make([]float64, 4), settings, method)
+		if err != nil {
+			t.Errorf("%#v: unexpected error: %v", method, err)
+			continue
+		}
+		if result.Status != GradientThreshold {
+			t.Errorf("%#v: unexpected status: got %v, want %v", method, result.Status, GradientThreshold)
+		}
+		if !floats.EqualApprox(result.X, want, 1e-6) {
+			t.Errorf("%#v: unexpected minimum location: got %v, want %v", method, result.X, want)
+		}
+		// Each iteration evaluates the function and the gradient once.
+		if result.FuncEvaluations != result.MajorIterations || result.GradEvaluations != result.MajorIterations {
+			t.Errorf("%#v: unexpected number of evaluations: got %d and %d for %d iterations",
+				method, result.FuncEvaluations, result.GradEvaluations, result.MajorIterations)
+		}
+	}
+}
+
+func TestStochasticRegression(t *testing.T) {
+	t.Parallel()
+	// Fit a linear model to noisy data by minimizing the mean squared error
+	// with the gradient of mini-batches.
+	const (
+		n     = 1000
+		batch = 10
+	)
+	coef := []float64{1, -2, 0.5}
+	rnd := rand.New(rand.NewSource(1))
+	xs := make([][]float64, n)
+	ys := make([]float64, n)
+	for i := range xs {
+		xs[i] = []float64{1, rnd.NormFloat64(), rnd.NormFloat64()}
+		ys[i] = floats.Dot(coef, xs[i]) + 0.1*rnd.NormFloat64()
+	}
+	for _, method := range []Method{
+		&SGD{LearningRate: InverseTimeSchedule{Initial: 0.05, Decay: 0.01}},
+		&SGD{LearningRate: StepSchedule{Initial: 0.01, Factor: 0.5, Period: 1000}, Momentum: 0.9, Nesterov: true},
+		&Adam{LearningRate: CosineSchedule{Max: 0.05, Min: 0.001, Period: 2000}},
+		&AdaGrad{LearningRate: WarmupSchedule{Steps: 10, Schedule: ConstantSchedule{Rate: 0.2}}},
+		&RMSProp{LearningRate: InverseTimeSchedule{Initial: 0.01, Decay: 0.01}},
+	} {
+		// The function value is the error of a mini-batch that is sampled in
+		// Func and reused in Grad.
+		rnd := rand.New(rand.NewSource(1))
+		idx := make([]int, batch)
+		problem := Problem{
+			Func: func(c []float64) float64 {
+				var f float64
+				for k := range idx {
+					idx[k] = rnd.Intn(n)
+					r := floats.Dot(c, xs[idx[k]]) - ys[idx[k]]
+					f += r * r
+				}
+				return f / batch
+			},
+			Grad: func(grad, c []float64) {
+				for i := range grad {
+					grad[i] = 0
+				}
+				for _, i := range idx {
+					r := floats.Dot(c, xs[i]) - ys[i]
+					floats.AddScaled(grad, 2*r/batch, xs[i])
+				}
+			},
+		}
+		settings := &Settings{
+			Converger: &AverageConverge{
+				Window:     100,
+				Relative:   1e-3,
+				Iterations: 10,
+			},
+			MajorIterations: 100000,
+		}
+		result, err := Minimize(problem, make([]float64, 3), settings, method)
+		if err != nil {
+			t.Errorf("%#v: unexpected error: %v", method, err)
+			continue
+		}
+		if result.Status != FunctionConvergence {
+			t.Errorf("%#v: unexpected status: got %v, want %v", method, result.Status, FunctionConvergence)
+		}
+		if !floats.EqualApprox(result.X, coef, 0.05) {
+			t.Errorf("%#v: unexpected coefficients: got %v, want %v", method, result.X, coef)
+		}
+	}
+}
+
+func TestLearningRateSchedule(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		schedule LearningRateSchedule
+		want     []float64 // Learning rates at iterations 0 to len(want)-1.
+	}{
+		{
+			schedule: ConstantSchedule{Rate: 0.5},
+			want:     []float64{0.5, 0.5, 0.5},
+		},
+		{
+			schedule: StepSchedule{Initial: 1, Factor: 0.1, Period: 2},
+			want:     []float64{1, 1, 0.1, 0.1, 0.01},
+		},
+		{
+			schedule: StepSchedule{Initial: 1, Factor: 0.1},
+			want:     []float64{1, 1, 1},
+		},
+		{
+			schedule: ExponentialSchedule{Initial: 2, Decay: 0.5},
+			want:     []float64{2, 1, 0.5, 0.25},
+		},
+		{
+			schedule: InverseTimeSchedule{Initial: 1, Decay: 1},
+			want:     []float64{1, 1.0 / 2, 1.0 / 3, 1.0 / 4},
+		},
+		{
+			schedule: InverseTimeSchedule{Initial: 1, Decay: 1, Power: 0.5},
+			want:     []float64{1, 1 / math.Sqrt(2), 1 / math.Sqrt(3), 0.5},
+		},
+		{
+			schedule: CosineSchedule{Max: 1, Min: 0, Period: 2},
+			want:     []float64{1, 0.5, 0, 0},
+		},
+		{
+			schedule: CosineSchedule{Max: 1, Min: 0.5, Period: 2, Restart: true},
+			want:     []float64{1, 0.75, 1, 0.75},
+		},
+		{
+			schedule: WarmupSchedule{Steps: 3, Schedule: ExponentialSchedule{Initial: 1, Decay: 0.5}},
+			want:     []float64{0.25, 0.5, 0.75, 1, 0.5},
+		},
+	} {
+		for iter, want := range test.want {
+			got := test.schedule.LearningRate(iter)
+			if !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+				t.Errorf("%#v: unexpected learning rate at iteration %d: got %v, want %v", test.schedule, iter, got, want)
+			}
+		}
+	}
+}
+
+func TestAverageConverge(t *testing.T) {
+	t.Parallel()
+	ac := &AverageConverge{Window: 2, Absolute: 0.1, Iterations: 3}
+	ac.Init(1)
+	// The window averages are 2, 1, 0.95, 1.5, 0.5, 0.5, 0.5 and 0.45. The
+	// average 0.5 is a significant decrease, after which there is none in
+	// three windows.
+	for i, f := range []float64{3, 1, 0, 2, 1, 0.9, 1, 2, 0, 1, 0.5, 0.5, 1, 0, 0.4, 0.5} {
+		got := ac.Converged(&Location{F: f})
+		want := NotTerminated
+		if i == 15 {
+			want = FunctionConvergence
+		}
+		if got != want {
+			t.Errorf("unexpected status at iteration %d: got %v, want %v", i, got, want)
+		}
+	}
+}
+
+func TestStochasticPanics(t *testing.T) {
+	t.Parallel()
+	for k, method := range []Method{
+		&SGD{Momentum: 1},
+		&SGD{Momentum: -0.1},
+		&Adam{Beta1: 1},
+		&Adam{Beta2: -0.5},
+		&Adam{Epsilon: -1},
+		&AdaGrad{Epsilon: -1},
+		&RMSProp{Decay: 1},
+		&RMSProp{Momentum: 1},
+		&RMSProp{Epsilon: -1},
+	} {
+		if !panics(func() { method.Init(2, 1) }) {
+			t.Errorf("case %d: expected panic for %s", k, fmt.Sprintf("%#v", method))
+		}
+	}
+	problem := Problem{
+		Func: func(x []float64) float64 { return x[0] * x[0] },
+		Grad: func(grad, x []float64) { grad[0] = 2 * x[0] },
+	}
+	_, err := Minimize(problem, []float64{1}, nil, &SGD{LearningRate: ConstantSchedule{}})
+	if err != ErrLearningRate {
+		t.Errorf("unexpected error for zero learning rate: got %v, want %v", err, ErrLearningRate)
+	}
+}
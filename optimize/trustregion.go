@@ -0,0 +1,242 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	defaultTrustRegionRadius = 1
+
+	// The ratio of the actual to the predicted reduction below which the
+	// trust region is shrunk, and above which it may be expanded.
+	trustRegionShrinkRatio = 0.25
+	trustRegionExpandRatio = 0.75
+)
+
+var (
+	_ Method      = (*TrustRegion)(nil)
+	_ localMethod = (*TrustRegion)(nil)
+)
+
+// TrustRegion implements a trust-region method for Hessian-based unconstrained
+// minimization.
+//
+// At each iteration TrustRegion forms a quadratic model of the objective
+// function f around the current location x_k,
+//
+//	m_k(p) = f_k + ∇f_kᵀp + 1/2 pᵀH_k p,
+//
+// where H_k is the Hessian of f at x_k, and finds a step p_k that
+// approximately minimizes the model within the trust region |p| <= Δ_k. The
+// step is computed by Subproblem. The ratio
+//
+//	ρ_k = (f(x_k) - f(x_k + p_k)) / (m_k(0) - m_k(p_k))
+//
+// of the actual to the predicted reduction of f decides whether the step is
+// accepted and how the radius Δ_k is updated. Unlike the line search methods,
+// the trust-region method does not require H_k to be positive definite and
+// with a suitable Subproblem it can escape saddle points.
+//
+// Each trial step costs one function evaluation, and the gradient and the
+// Hessian are evaluated only at accepted steps.
+//
+// References:
+//   - Nocedal, J., Wright, S.: Numerical Optimization (2nd ed). Springer (2006),
+//     Chapter 4
+//   - Conn, A.R., Gould, N.I.M., Toint, P.L.: Trust-Region Methods. SIAM (2000)
+type TrustRegion struct {
+	// Subproblem is used for computing the steps within the trust region.
+	// If Subproblem is nil, it is defaulted to MoreSorensen.
+	Subproblem TrustRegionSubproblem
+	// InitialRadius is the initial radius of the trust region. If
+	// InitialRadius is zero, it is defaulted to 1.
+	InitialRadius float64
+	// MaxRadius is the upper bound on the radius of the trust region. If
+	// MaxRadius is zero, the radius is not bounded.
+	MaxRadius float64
+	// AcceptRatio is the smallest ratio of the actual to the predicted
+	// reduction of the objective function for which a step is accepted.
+	// AcceptRatio must be in the interval [0, 0.25).
+	AcceptRatio float64
+	// GradStopThreshold sets the threshold for stopping if the gradient norm
+	// gets too small. If GradStopThreshold is 0 it is defaulted to 1e-12, and
+	// if it is NaN the setting is not used.
+	GradStopThreshold float64
+
+	status Status
+	err    error
+
+	x    []float64     // Location of the current major iteration.
+	f    float64       // Function value at x.
+	grad []float64     // Gradient at x.
+	hess *mat.SymDense // Hessian at x.
+
+	radius float64
+	step   []float64
+	pred   float64 // Predicted reduction of the trial step.
+	hp     []float64
+	iter   trustRegionIteration
+}
+
+type trustRegionIteration int
+
+const (
+	trustRegionTrial  trustRegionIteration = iota // A trial step is being evaluated.
+	trustRegionDerivs                             // Derivatives at an accepted step are being evaluated.
+	trustRegionMajor                              // A major iteration has been announced.
+)
+
+func (tr *TrustRegion) Status() (Status, error) {
+	return tr.status, tr.err
+}
+
+func (*TrustRegion) Uses(has Available) (uses Available, err error) {
+	return has.hessian()
+}
+
+func (tr *TrustRegion) Init(dim, tasks int) int {
+	if tr.InitialRadius == 0 {
+		tr.InitialRadius = defaultTrustRegionRadius
+	}
+	if tr.InitialRadius < 0 {
+		panic("optimize: TrustRegion.InitialRadius must be positive")
+	}
+	if tr.MaxRadius < 0 {
+		panic("optimize: TrustRegion.MaxRadius must not be negative")
+	}
+	if tr.AcceptRatio < 0 || tr.AcceptRatio >= trustRegionShrinkRatio {
+		panic("optimize: TrustRegion.AcceptRatio must be in [0, 0.25)")
+	}
+	tr.status = NotTerminated
+	tr.err = nil
+	return 1
+}
+
+func (tr *TrustRegion) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	tr.status, tr.err = localOptimizer{}.run(tr, tr.GradStopThreshold, operation, result, tasks)
+	close(operation)
+}
+
+func (tr *TrustRegion) initLocal(loc *Location) (Operation, error) {
+	if tr.Subproblem == nil {
+		tr.Subproblem = &MoreSorensen{}
+	}
+	dim := len(loc.X)
+	tr.x = resize(tr.x, dim)
+	tr.grad = resize(tr.grad, dim)
+	tr.hess = resizeSymDense(tr.hess, dim)
+	tr.step = resize(tr.step, dim)
+	tr.hp = resize(tr.hp, dim)
+	tr.radius = tr.InitialRadius
+	if tr.MaxRadius != 0 {
+		tr.radius = math.Min(tr.radius, tr.MaxRadius)
+	}
+	return tr.nextMajor(loc)
+}
+
+func (tr *TrustRegion) iterateLocal(loc *Location) (Operation, error) {
+	switch tr.iter {
+	case trustRegionDerivs:
+		// The derivatives at the accepted step are available.
+		tr.iter = trustRegionMajor
+		return MajorIteration, nil
+	case trustRegionMajor:
+		return tr.nextMajor(loc)
+	}
+
+	// Compare the actual reduction of f with the reduction predicted by the
+	// model and update the trust region.
+	actual := tr.f - loc.F
+	rho := actual / tr.pred
+	if math.Abs(actual-tr.pred) <= 10*dlamchE*math.Max(1, math.Abs(tr.f)) {
+		// The reductions agree to within rounding error, which happens
+		// close to a minimizer where both are tiny and their ratio is not
+		// meaningful.
+		rho = 1
+	}
+	if math.IsNaN(loc.F) || math.IsInf(loc.F, 0) {
+		rho = math.Inf(-1)
+	}
+	stepNorm := floats.Norm(tr.step, 2)
+	switch {
+	case rho < trustRegionShrinkRatio:
+		tr.radius = trustRegionShrinkRatio * stepNorm
+	case rho > trustRegionExpandRatio && stepNorm >= (1-1e-3)*tr.radius:
+		tr.radius *= 2
+		if tr.MaxRadius != 0 {
+			tr.radius = math.Min(tr.radius, tr.MaxRadius)
+		}
+	}
+	if rho > tr.AcceptRatio {
+		tr.iter = trustRegionDerivs
+		return GradEvaluation | HessEvaluation, nil
+	}
+	// Reject the step and try again with the smaller trust region.
+	return tr.trialStep(loc)
+}
+
+// nextMajor stores the location of the new major iteration and computes the
+// first trial step from it.
+func (tr *TrustRegion) nextMajor(loc *Location) (Operation, error) {
+	copy(tr.x, loc.X)
+	tr.f = loc.F
+	copy(tr.grad, loc.Gradient)
+	tr.hess.CopySym(loc.Hessian)
+	return tr.trialStep(loc)
+}
+
+// trialStep computes a step within the current trust region and stores the
+// trial location in loc.X.
+func (tr *TrustRegion) trialStep(loc *Location) (Operation, error) {
+	tr.Subproblem.Solve(tr.step, tr.grad, tr.hess, tr.radius)
+
+	dim := len(tr.x)
+	hp := mat.NewVecDense(dim, tr.hp)
+	hp.MulVec(tr.hess, mat.NewVecDense(dim, tr.step))
+	tr.pred = -floats.Dot(tr.grad, tr.step) - 0.5*floats.Dot(tr.step, tr.hp)
+	if !(tr.pred > 0) {
+		return NoOperation, ErrTrustRegionNoProgress
+	}
+
+	floats.AddTo(loc.X, tr.x, tr.step)
+	if floats.Equal(loc.X, tr.x) {
+		return NoOperation, ErrTrustRegionNoProgress
+	}
+	tr.iter = trustRegionTrial
+	return FuncEvaluation, nil
+}
+
+func (tr *TrustRegion) needs() struct {
+	Gradient bool
+	Hessian  bool
+} {
+	return struct {
+		Gradient bool
+		Hessian  bool
+	}{true, true}
+}
+
+// trustRegionBoundary returns the non-negative τ for which |z + τ*d| equals
+// radius. z must lie within the trust region and d must be non-zero.
+func trustRegionBoundary(z, d []float64, radius float64) float64 {
+	a := floats.Dot(d, d)
+	b := floats.Dot(z, d)
+	c := floats.Dot(z, z) - radius*radius
+	if c >= 0 {
+		return 0
+	}
+	// Solve a τ² + 2 b τ + c = 0 for the positive root avoiding
+	// cancellation.
+	q := math.Sqrt(b*b - a*c)
+	if b > 0 {
+		return -c / (b + q)
+	}
+	return (q - b) / a
+}
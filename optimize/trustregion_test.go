@@ -0,0 +1,143 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestTrustRegionSubproblem(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 5, 10} {
+		for _, shift := range []float64{-2, 0, 2, 10} {
+			for _, radius := range []float64{1e-3, 0.1, 1, 100} {
+				// Form a random symmetric matrix whose definiteness is
+				// controlled by the shift.
+				a := mat.NewDense(n, n, nil)
+				for i := 0; i < n; i++ {
+					for j := 0; j < n; j++ {
+						a.Set(i, j, rnd.NormFloat64())
+					}
+				}
+				hess := mat.NewSymDense(n, nil)
+				hess.SymOuterK(1/float64(n), a)
+				for i := 0; i < n; i++ {
+					hess.SetSym(i, i, hess.At(i, i)+shift)
+				}
+				grad := make([]float64, n)
+				for i := range grad {
+					grad[i] = rnd.NormFloat64()
+				}
+
+				work := make([]float64, n)
+				pc := make([]float64, n)
+				hg := mat.NewVecDense(n, work)
+				hg.MulVec(hess, mat.NewVecDense(n, grad))
+				cauchyPoint(pc, grad, floats.Norm(grad, 2), floats.Dot(grad, work), radius)
+				mc := quadModel(pc, grad, hess, work)
+
+				for _, sp := range []TrustRegionSubproblem{&Dogleg{}, &SteihaugCG{}, &MoreSorensen{}} {
+					name := fmt.Sprintf("%T/n=%d/shift=%v/radius=%v", sp, n, shift, radius)
+					p := make([]float64, n)
+					sp.Solve(p, grad, hess, radius)
+					if norm := floats.Norm(p, 2); norm > radius*(1+1e-12) {
+						t.Errorf("%s: step outside the trust region: |p|=%v", name, norm)
+					}
+					// The decrease of the model must be at least a fraction
+					// of the decrease at the Cauchy point.
+					if m := quadModel(p, grad, hess, work); m > 0.9*mc {
+						t.Errorf("%s: insufficient model decrease: %v > 0.9*%v", name, m, mc)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestMoreSorensenHardCase(t *testing.T) {
+	t.Parallel()
+	// The gradient is orthogonal to the eigenvector of the negative
+	// eigenvalue, so the solution lies on the boundary and it is not given
+	// by (B + λI) p = -g alone.
+	hess := mat.NewSymDense(2, []float64{
+		2, 0,
+		0, -1,
+	})
+	grad := []float64{2, 0}
+	const radius = 2.0
+	var ms MoreSorensen
+	p := make([]float64, 2)
+	ms.Solve(p, grad, hess, radius)
+	// The solution is λ = 1, p = (-2/3, ±sqrt(4-4/9)).
+	want := []float64{-2.0 / 3, math.Sqrt(radius*radius - 4.0/9)}
+	if p[1] < 0 {
+		want[1] *= -1
+	}
+	if !floats.EqualApprox(p, want, 2e-2) {
+		t.Errorf("unexpected step: got %v, want %v", p, want)
+	}
+}
+
+func TestTrustRegionSaddle(t *testing.T) {
+	t.Parallel()
+	// f has a saddle point at the origin and minima at (0, ±1). Starting
+	// from y = 0, the gradient never has a component in the y direction
+	// and only the negative curvature of the Hessian leads away from the
+	// saddle point.
+	p := Problem{
+		Func: func(x []float64) float64 {
+			return x[0]*x[0] + x[1]*x[1]*x[1]*x[1]/4 - x[1]*x[1]/2
+		},
+		Grad: func(grad, x []float64) {
+			grad[0] = 2 * x[0]
+			grad[1] = x[1]*x[1]*x[1] - x[1]
+		},
+		Hess: func(hess *mat.SymDense, x []float64) {
+			hess.SetSym(0, 0, 2)
+			hess.SetSym(0, 1, 0)
+			hess.SetSym(1, 1, 3*x[1]*x[1]-1)
+		},
+	}
+	result, err := Minimize(p, []float64{1, 0}, nil, &TrustRegion{Subproblem: &MoreSorensen{}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Status != GradientThreshold {
+		t.Errorf("unexpected status: %v", result.Status)
+	}
+	if math.Abs(result.F+0.25) > 1e-12 {
+		t.Errorf("unexpected minimum value: got %v, want -0.25", result.F)
+	}
+	if !floats.EqualApprox([]float64{result.X[0], math.Abs(result.X[1])}, []float64{0, 1}, 1e-8) {
+		t.Errorf("unexpected minimizer: got %v", result.X)
+	}
+}
+
+func TestTrustRegionPanics(t *testing.T) {
+	t.Parallel()
+	p := Problem{
+		Func: func(x []float64) float64 { return x[0] * x[0] },
+		Grad: func(grad, x []float64) { grad[0] = 2 * x[0] },
+		Hess: func(hess *mat.SymDense, x []float64) { hess.SetSym(0, 0, 2) },
+	}
+	for _, method := range []*TrustRegion{
+		{InitialRadius: -1},
+		{MaxRadius: -1},
+		{AcceptRatio: 0.5},
+		{AcceptRatio: -0.1},
+	} {
+		if !panics(func() { _, _ = Minimize(p, []float64{1}, nil, method) }) {
+			t.Errorf("expected panic for %+v", method)
+		}
+	}
+}
@@ -1171,11 +1171,31 @@ func TestLBFGS(t *testing.T) {
 	testLocal(t, tests, &LBFGS{})
 }
 
+func TestLBFGSB(t *testing.T) {
+	t.Parallel()
+	testLocal(t, gradientDescentTests, &LBFGSB{})
+}
+
 func TestNewton(t *testing.T) {
 	t.Parallel()
 	testLocal(t, newtonTests, &Newton{})
 }
 
+func TestTrustRegionDogleg(t *testing.T) {
+	t.Parallel()
+	testLocal(t, newtonTests, &TrustRegion{Subproblem: &Dogleg{}})
+}
+
+func TestTrustRegionSteihaugCG(t *testing.T) {
+	t.Parallel()
+	testLocal(t, newtonTests, &TrustRegion{Subproblem: &SteihaugCG{}})
+}
+
+func TestTrustRegionMoreSorensen(t *testing.T) {
+	t.Parallel()
+	testLocal(t, newtonTests, &TrustRegion{Subproblem: &MoreSorensen{}})
+}
+
 func testLocal(t *testing.T, tests []unconstrainedTest, method Method) {
 	for cas, test := range tests {
 		if test.long && testing.Short() {
@@ -0,0 +1,157 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package univariate
+
+import "math"
+
+const (
+	// bracketGrowth is the factor by which the bracketing interval grows at
+	// each iteration.
+	bracketGrowth = 1.6
+	// maxParabolicGrowth limits the parabolic extrapolation in
+	// BracketMinimum relative to the last interval.
+	maxParabolicGrowth = 100
+	// maxBracketIterations is the maximum number of iterations of
+	// BracketRoot and BracketMinimum.
+	maxBracketIterations = 100
+)
+
+// BracketRoot searches for an interval on which f changes sign by expanding
+// the interval [a, b] geometrically in the direction of the end with the
+// smaller magnitude of the function value. It returns the interval [lo, hi]
+// with f(lo) and f(hi) of opposite signs or zero, which can be passed to
+// the bracketing root finders. If no such interval is found within 100
+// expansions, BracketRoot returns ErrNoBracket. ErrNaN is returned if f
+// returns NaN.
+//
+// BracketRoot panics if a and b are not finite and distinct.
+func BracketRoot(f func(x float64) float64, a, b float64) (lo, hi float64, err error) {
+	a, b = checkInterval(a, b)
+	fa, fb := f(a), f(b)
+	for i := 0; ; i++ {
+		if math.IsNaN(fa) || math.IsNaN(fb) {
+			return a, b, ErrNaN
+		}
+		if !sameSign(fa, fb) {
+			return a, b, nil
+		}
+		if i == maxBracketIterations || math.IsInf(a, 0) || math.IsInf(b, 0) {
+			return a, b, ErrNoBracket
+		}
+		if math.Abs(fa) < math.Abs(fb) {
+			a += bracketGrowth * (a - b)
+			fa = f(a)
+		} else {
+			b += bracketGrowth * (b - a)
+			fb = f(b)
+		}
+	}
+}
+
+// BracketMinimum searches for a bracket of a minimum of f starting from the
+// locations a and b. It steps downhill from a and b with growing steps and
+// parabolic extrapolation until the function value increases, and returns
+// locations lo < mid < hi with f(mid) <= f(lo) and f(mid) <= f(hi), so that
+// a local minimum of f lies in [lo, hi]. If no bracket is found within 100
+// iterations, for example because f decreases without bound, BracketMinimum
+// returns ErrNoBracket. ErrNaN is returned if f returns NaN.
+//
+// BracketMinimum panics if a and b are not finite and distinct.
+//
+// Reference:
+//   - Press, W.H., Teukolsky, S.A., Vetterling, W.T., Flannery, B.P.:
+//     Numerical Recipes, section 10.1. Cambridge University Press (2007)
+func BracketMinimum(f func(x float64) float64, a, b float64) (lo, mid, hi float64, err error) {
+	if math.IsNaN(a) || math.IsNaN(b) || math.IsInf(a, 0) || math.IsInf(b, 0) || a == b {
+		panic("univariate: invalid interval")
+	}
+	fa, fb := f(a), f(b)
+	if math.IsNaN(fa) || math.IsNaN(fb) {
+		return a, a, b, ErrNaN
+	}
+	if fb > fa {
+		// Step downhill from a to b.
+		a, b = b, a
+		fa, fb = fb, fa
+	}
+	c := b + bracketGrowth*(b-a)
+	fc := f(c)
+	for i := 0; ; i++ {
+		if math.IsNaN(fc) {
+			return a, b, c, ErrNaN
+		}
+		if fb <= fc {
+			if a > c {
+				a, c = c, a
+			}
+			return a, b, c, nil
+		}
+		if i == maxBracketIterations || math.IsInf(c, 0) || math.IsInf(fc, -1) {
+			lo, hi := a, c
+			if lo > hi {
+				lo, hi = hi, lo
+			}
+			return lo, b, hi, ErrNoBracket
+		}
+
+		// Extrapolate the parabola through a, b and c, with the minimum at
+		// u limited to ulim.
+		r := (b - a) * (fb - fc)
+		q := (b - c) * (fb - fa)
+		denom := 2 * math.Max(math.Abs(q-r), math.SmallestNonzeroFloat64)
+		if q < r {
+			denom = -denom
+		}
+		u := b - ((b-c)*q-(b-a)*r)/denom
+		ulim := b + maxParabolicGrowth*(c-b)
+		var fu float64
+		switch {
+		case (b-u)*(u-c) > 0:
+			// The parabolic minimum is between b and c.
+			fu = f(u)
+			if fu < fc {
+				// The minimum is between b and c.
+				a, b = b, u
+				if a > c {
+					a, c = c, a
+				}
+				if math.IsNaN(fu) {
+					return a, b, c, ErrNaN
+				}
+				return a, b, c, nil
+			}
+			if fu > fb {
+				// The minimum is between a and u.
+				c = u
+				if a > c {
+					a, c = c, a
+				}
+				return a, b, c, nil
+			}
+			// The parabolic step did not help, so take a default step.
+			u = c + bracketGrowth*(c-b)
+			fu = f(u)
+		case (c-u)*(u-ulim) > 0:
+			// The parabolic minimum is between c and its limit.
+			fu = f(u)
+			if fu < fc {
+				b, c, u = c, u, u+bracketGrowth*(u-c)
+				fb, fc, fu = fc, fu, f(u)
+			}
+		case (u-ulim)*(ulim-c) >= 0:
+			// Limit the parabolic step.
+			u = ulim
+			fu = f(u)
+		default:
+			u = c + bracketGrowth*(c-b)
+			fu = f(u)
+		}
+		if math.IsNaN(fu) {
+			return a, b, c, ErrNaN
+		}
+		a, b, c = b, c, u
+		fa, fb, fc = fb, fc, fu
+	}
+}
@@ -0,0 +1,15 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package univariate implements routines for finding roots and minima of
+// functions of a single variable.
+//
+// The bracketing root finders Bisection, Brent, Illinois and ITP require an
+// interval at whose ends the function has opposite signs, and they always
+// keep the root bracketed. Newton and Halley use derivatives and converge
+// faster near a simple root, and they fall back to bisection when their
+// iterates bracket a root. BrentMinimize and GoldenSection find a local
+// minimum within an interval. BracketRoot and BracketMinimum search for
+// intervals suitable for these routines.
+package univariate // import "gonum.org/v1/gonum/optimize/univariate"
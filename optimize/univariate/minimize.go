@@ -0,0 +1,179 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package univariate
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/optimize"
+)
+
+// goldenRatio is (√5-1)/2, the fraction of the interval kept at each
+// iteration of golden-section search.
+var goldenRatio = (math.Sqrt(5) - 1) / 2
+
+// BrentMinimize finds a local minimum of f in the interval [a, b] using
+// Brent's method, which combines golden-section search with successive
+// parabolic interpolation. BrentMinimize converges superlinearly to a
+// minimum where f is smooth, and it never needs many more evaluations than
+// golden-section search. If f is unimodal in [a, b], the minimum found is
+// global in the interval. If settings is nil, the zero value is used.
+//
+// BrentMinimize panics if a and b are not finite and distinct.
+//
+// Reference:
+//   - Brent, R.P.: Algorithms for Minimization without Derivatives, chapter 5.
+//     Prentice-Hall (1973)
+func BrentMinimize(f func(x float64) float64, a, b float64, settings *Settings) (*Result, error) {
+	a, b = checkInterval(a, b)
+	s := newSolver(settings, minXRelTol)
+	c := 1 - goldenRatio
+
+	// x is the best location, w the second best and v the previous value
+	// of w. d is the current step and e the step before the last.
+	x := a + c*(b-a)
+	fx, err := s.eval(f, x)
+	if err != nil {
+		return s.fail(x, fx, err)
+	}
+	v, w := x, x
+	fv, fw := fx, fx
+	var d, e float64
+	for {
+		m := a + (b-a)/2
+		tol := s.tol(x) / 2
+		tol2 := 2 * tol
+		if math.Abs(x-m) <= tol2-(b-a)/2 {
+			return s.done(x, fx, optimize.StepConvergence)
+		}
+		if !s.iterate() {
+			return s.done(x, fx, optimize.IterationLimit)
+		}
+
+		golden := true
+		if math.Abs(e) > tol {
+			// Fit a parabola through x, v and w.
+			r := (x - w) * (fx - fv)
+			q := (x - v) * (fx - fw)
+			p := (x-v)*q - (x-w)*r
+			q = 2 * (q - r)
+			if q > 0 {
+				p = -p
+			} else {
+				q = -q
+			}
+			r, e = e, d
+			if math.Abs(p) < math.Abs(q*r/2) && p > q*(a-x) && p < q*(b-x) {
+				// Take the parabolic interpolation step, which must not be
+				// too close to the ends.
+				d = p / q
+				golden = false
+				if u := x + d; u-a < tol2 || b-u < tol2 {
+					d = tol
+					if x >= m {
+						d = -tol
+					}
+				}
+			}
+		}
+		if golden {
+			if x < m {
+				e = b - x
+			} else {
+				e = a - x
+			}
+			d = c * e
+		}
+
+		// The function is not evaluated closer than tol to x.
+		u := x + d
+		if math.Abs(d) < tol {
+			u = x + math.Copysign(tol, d)
+		}
+		fu, err := s.eval(f, u)
+		if err != nil {
+			return s.fail(x, fx, err)
+		}
+
+		if fu <= fx {
+			if u < x {
+				b = x
+			} else {
+				a = x
+			}
+			v, fv = w, fw
+			w, fw = x, fx
+			x, fx = u, fu
+			continue
+		}
+		if u < x {
+			a = u
+		} else {
+			b = u
+		}
+		switch {
+		case fu <= fw || w == x:
+			v, fv = w, fw
+			w, fw = u, fu
+		case fu <= fv || v == x || v == w:
+			v, fv = u, fu
+		}
+	}
+}
+
+// GoldenSection finds a local minimum of f in the interval [a, b] using
+// golden-section search, which shrinks the interval by the golden ratio at
+// every iteration. GoldenSection needs more evaluations than BrentMinimize
+// for smooth functions but is not affected by the lack of smoothness. If f
+// is unimodal in [a, b], the minimum found is global in the interval. If
+// settings is nil, the zero value is used.
+//
+// GoldenSection panics if a and b are not finite and distinct.
+func GoldenSection(f func(x float64) float64, a, b float64, settings *Settings) (*Result, error) {
+	a, b = checkInterval(a, b)
+	s := newSolver(settings, minXRelTol)
+	x1 := b - goldenRatio*(b-a)
+	x2 := a + goldenRatio*(b-a)
+	f1, err := s.eval(f, x1)
+	if err != nil {
+		return s.fail(x1, f1, err)
+	}
+	f2, err := s.eval(f, x2)
+	if err != nil {
+		return s.fail(x1, f1, err)
+	}
+	for {
+		x, fx := x1, f1
+		if f2 < f1 {
+			x, fx = x2, f2
+		}
+		if b-a <= s.tol(x) {
+			return s.done(x, fx, optimize.StepConvergence)
+		}
+		if !s.iterate() {
+			return s.done(x, fx, optimize.IterationLimit)
+		}
+		if f1 <= f2 {
+			// The minimum is in [a, x2].
+			b = x2
+			x2, f2 = x1, f1
+			x1 = b - goldenRatio*(b-a)
+			f1, err = s.eval(f, x1)
+		} else {
+			// The minimum is in [x1, b].
+			a = x1
+			x1, f1 = x2, f2
+			x2 = a + goldenRatio*(b-a)
+			f2, err = s.eval(f, x2)
+		}
+		if err != nil {
+			return s.fail(x, fx, err)
+		}
+		if x1 >= x2 {
+			// The interval cannot be divided in floating point.
+			return s.done(x, fx, optimize.StepConvergence)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package univariate
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/optimize"
+)
+
+// maxBacktracks is the largest number of times a step of Newton or Halley
+// is halved when the function value does not decrease.
+const maxBacktracks = 50
+
+// Newton finds a root of f using Newton's method starting from x0. The
+// function fdf returns the function value and the derivative at x.
+//
+// The iterates are safeguarded in two ways. Until the iterates bracket a
+// root, a step that leaves the interval [lower, upper] is shortened to half
+// the distance to the violated bound, and a step that does not decrease the
+// magnitude of the function value is halved repeatedly. Once two iterates
+// have function values of opposite signs, the root remains bracketed and a
+// bisection step is taken whenever the Newton step leaves the bracket or
+// the bracket does not shrink fast enough. The bounds may be infinite.
+//
+// Newton returns ErrNoProgress if the derivative vanishes or no step
+// decreases the magnitude of the function value before a root is
+// bracketed. If settings is nil, the zero value is used.
+//
+// Newton panics if x0 is not in [lower, upper].
+func Newton(fdf func(x float64) (f, df float64), x0, lower, upper float64, settings *Settings) (*Result, error) {
+	return newtonLike(func(x float64) (fx, step float64) {
+		fx, df := fdf(x)
+		if math.IsNaN(df) {
+			return fx, math.NaN()
+		}
+		return fx, fx / df
+	}, x0, lower, upper, settings)
+}
+
+// Halley finds a root of f using Halley's method starting from x0. The
+// function fdf returns the function value and the first and second
+// derivatives at x. Halley's method converges cubically to a simple root.
+// The iterates are safeguarded as in Newton, and a Newton step is taken
+// where the step of Halley's method is not defined. If settings is nil, the
+// zero value is used.
+//
+// Halley panics if x0 is not in [lower, upper].
+func Halley(fdf func(x float64) (f, df, d2f float64), x0, lower, upper float64, settings *Settings) (*Result, error) {
+	return newtonLike(func(x float64) (fx, step float64) {
+		fx, df, d2f := fdf(x)
+		if math.IsNaN(df) || math.IsNaN(d2f) {
+			return fx, math.NaN()
+		}
+		newton := fx / df
+		step = newton / (1 - newton*d2f/(2*df))
+		// The Halley step must be in the direction of the Newton step.
+		if math.IsNaN(step) || math.IsInf(step, 0) || step*newton <= 0 {
+			step = newton
+		}
+		return fx, step
+	}, x0, lower, upper, settings)
+}
+
+// newtonLike finds a root using the steps x - step(x) returned by fstep
+// together with the function value, safeguarded as described for Newton.
+func newtonLike(fstep func(x float64) (fx, step float64), x0, lower, upper float64, settings *Settings) (*Result, error) {
+	if math.IsNaN(lower) || math.IsNaN(upper) || !(lower <= x0 && x0 <= upper) {
+		panic("univariate: initial location out of bounds")
+	}
+	s := newSolver(settings, rootXRelTol)
+	// step is the step at the location last evaluated.
+	var step float64
+	eval := func(x float64) (float64, error) {
+		return s.eval(func(x float64) float64 {
+			var f float64
+			f, step = fstep(x)
+			return f
+		}, x)
+	}
+
+	x := x0
+	fx, err := eval(x)
+	if err != nil {
+		return s.fail(x, fx, err)
+	}
+	// lo and hi bracket a root if bracketed is true, with f(lo) having the
+	// sign of flo.
+	bracketed := false
+	var lo, hi, flo float64
+	dxOld := math.Inf(1)
+	for {
+		if math.Abs(fx) <= s.fTol {
+			return s.done(x, fx, optimize.FunctionThreshold)
+		}
+		if bracketed && hi-lo <= s.tol(x) {
+			return s.done(x, fx, optimize.StepConvergence)
+		}
+		if !s.iterate() {
+			return s.done(x, fx, optimize.IterationLimit)
+		}
+
+		xNew := x - step
+		if bracketed {
+			// Bisect if the step leaves the bracket or the steps do not
+			// decrease fast enough.
+			if !(xNew > lo && xNew < hi) || math.Abs(step) > math.Abs(dxOld)/2 {
+				xNew = lo + (hi-lo)/2
+			}
+		} else {
+			if math.IsNaN(step) || math.IsInf(step, 0) || step == 0 {
+				return s.fail(x, fx, ErrNoProgress)
+			}
+			if xNew < lower {
+				xNew = x + (lower-x)/2
+			} else if xNew > upper {
+				xNew = x + (upper-x)/2
+			}
+		}
+		stepOld := step
+		fNew, err := eval(xNew)
+		if err != nil {
+			return s.fail(x, fx, err)
+		}
+
+		if !bracketed && !sameSign(fx, -fNew) {
+			// Backtrack until the magnitude of the function value decreases
+			// or a root is bracketed.
+			for k := 0; math.Abs(fNew) >= math.Abs(fx) && fNew != 0; k++ {
+				if k == maxBacktracks {
+					return s.fail(x, fx, ErrNoProgress)
+				}
+				xNew = x + (xNew-x)/2
+				fNew, err = eval(xNew)
+				if err != nil {
+					return s.fail(x, fx, err)
+				}
+				if sameSign(fx, -fNew) {
+					break
+				}
+			}
+		}
+
+		switch {
+		case !bracketed && sameSign(fx, -fNew):
+			bracketed = true
+			lo, hi, flo = x, xNew, fx
+			if lo > hi {
+				lo, hi, flo = hi, lo, fNew
+			}
+		case bracketed && sameSign(fNew, flo):
+			lo, flo = xNew, fNew
+		case bracketed:
+			hi = xNew
+		}
+
+		dx := xNew - x
+		dxOld = dx
+		x, fx = xNew, fNew
+		if math.Abs(dx) <= s.tol(x) && math.Abs(stepOld) <= s.tol(x) {
+			return s.done(x, fx, optimize.StepConvergence)
+		}
+	}
+}
@@ -0,0 +1,334 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package univariate
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/optimize"
+)
+
+// start evaluates f at the ends of the interval [a, b] and checks that the
+// function values bracket a root. If a root has been found or the function
+// values are invalid, start returns a non-nil Result.
+func (s *solver) start(f func(float64) float64, a, b float64) (fa, fb float64, res *Result, err error) {
+	fa, err = s.eval(f, a)
+	if err != nil {
+		res, err = s.fail(a, fa, err)
+		return fa, fb, res, err
+	}
+	if math.Abs(fa) <= s.fTol {
+		res, err = s.done(a, fa, optimize.FunctionThreshold)
+		return fa, fb, res, err
+	}
+	fb, err = s.eval(f, b)
+	if err != nil {
+		res, err = s.fail(a, fa, err)
+		return fa, fb, res, err
+	}
+	if math.Abs(fb) <= s.fTol {
+		res, err = s.done(b, fb, optimize.FunctionThreshold)
+		return fa, fb, res, err
+	}
+	if sameSign(fa, fb) {
+		x, fx := a, fa
+		if math.Abs(fb) < math.Abs(fa) {
+			x, fx = b, fb
+		}
+		res, err = s.fail(x, fx, ErrNoBracket)
+		return fa, fb, res, err
+	}
+	return fa, fb, nil, nil
+}
+
+// best returns the end of a bracket with the smaller magnitude of the
+// function value.
+func best(a, fa, b, fb float64) (float64, float64) {
+	if math.Abs(fb) < math.Abs(fa) {
+		return b, fb
+	}
+	return a, fa
+}
+
+// Bisection finds a root of f in the interval [a, b] by bisection. The
+// function values at a and b must have opposite signs, otherwise Bisection
+// returns ErrNoBracket. Bisection halves the bracket at every iteration and
+// is the most robust but slowest of the bracketing root finders. If settings
+// is nil, the zero value is used.
+//
+// Bisection panics if a and b are not finite and distinct.
+func Bisection(f func(x float64) float64, a, b float64, settings *Settings) (*Result, error) {
+	a, b = checkInterval(a, b)
+	s := newSolver(settings, rootXRelTol)
+	fa, fb, res, err := s.start(f, a, b)
+	if res != nil {
+		return res, err
+	}
+	for {
+		x, fx := best(a, fa, b, fb)
+		if b-a <= s.tol(x) {
+			return s.done(x, fx, optimize.StepConvergence)
+		}
+		if !s.iterate() {
+			return s.done(x, fx, optimize.IterationLimit)
+		}
+		m := a + (b-a)/2
+		if m <= a || m >= b {
+			// The bracket cannot be divided in floating point.
+			return s.done(x, fx, optimize.StepConvergence)
+		}
+		fm, err := s.eval(f, m)
+		if err != nil {
+			return s.fail(x, fx, err)
+		}
+		if math.Abs(fm) <= s.fTol {
+			return s.done(m, fm, optimize.FunctionThreshold)
+		}
+		if sameSign(fm, fa) {
+			a, fa = m, fm
+		} else {
+			b, fb = m, fm
+		}
+	}
+}
+
+// Brent finds a root of f in the interval [a, b] using the Brent–Dekker
+// method, which combines inverse quadratic interpolation and the secant
+// method with bisection. The function values at a and b must have opposite
+// signs, otherwise Brent returns ErrNoBracket. Brent converges
+// superlinearly to a simple root and never needs many more evaluations
+// than bisection. If settings is nil, the zero value is used.
+//
+// Brent panics if a and b are not finite and distinct.
+//
+// Reference:
+//   - Brent, R.P.: Algorithms for Minimization without Derivatives, chapter 4.
+//     Prentice-Hall (1973)
+func Brent(f func(x float64) float64, a, b float64, settings *Settings) (*Result, error) {
+	a, b = checkInterval(a, b)
+	s := newSolver(settings, rootXRelTol)
+	fa, fb, res, err := s.start(f, a, b)
+	if res != nil {
+		return res, err
+	}
+
+	// cur is the best approximation, pre the previous one and blk the other
+	// end of the bracket. spre and scur are the previous two steps.
+	xpre, fpre := a, fa
+	xcur, fcur := b, fb
+	var xblk, fblk, spre, scur float64
+	for {
+		if sameSign(fpre, -fcur) {
+			xblk, fblk = xpre, fpre
+			spre = xcur - xpre
+			scur = spre
+		}
+		if math.Abs(fblk) < math.Abs(fcur) {
+			xpre, xcur, xblk = xcur, xblk, xcur
+			fpre, fcur, fblk = fcur, fblk, fcur
+		}
+
+		delta := s.tol(xcur) / 2
+		sbis := (xblk - xcur) / 2
+		if math.Abs(sbis) <= delta {
+			return s.done(xcur, fcur, optimize.StepConvergence)
+		}
+		if !s.iterate() {
+			return s.done(xcur, fcur, optimize.IterationLimit)
+		}
+
+		if math.Abs(spre) > delta && math.Abs(fcur) < math.Abs(fpre) {
+			var stry float64
+			if xpre == xblk {
+				// Secant step.
+				stry = -fcur * (xcur - xpre) / (fcur - fpre)
+			} else {
+				// Inverse quadratic interpolation.
+				dpre := (fpre - fcur) / (xpre - xcur)
+				dblk := (fblk - fcur) / (xblk - xcur)
+				stry = -fcur * (fblk*dblk - fpre*dpre) / (dblk * dpre * (fblk - fpre))
+			}
+			if 2*math.Abs(stry) < math.Min(math.Abs(spre), 3*math.Abs(sbis)-delta) {
+				// Accept the interpolation.
+				spre, scur = scur, stry
+			} else {
+				spre, scur = sbis, sbis
+			}
+		} else {
+			spre, scur = sbis, sbis
+		}
+
+		xpre, fpre = xcur, fcur
+		if math.Abs(scur) > delta {
+			xcur += scur
+		} else if sbis > 0 {
+			xcur += delta
+		} else {
+			xcur -= delta
+		}
+		fcur, err = s.eval(f, xcur)
+		if err != nil {
+			x, fx := best(xpre, fpre, xblk, fblk)
+			return s.fail(x, fx, err)
+		}
+		if math.Abs(fcur) <= s.fTol {
+			return s.done(xcur, fcur, optimize.FunctionThreshold)
+		}
+	}
+}
+
+// Illinois finds a root of f in the interval [a, b] using the Illinois
+// variant of the method of false position. The method of false position
+// replaces an end of the bracket by the root of the secant through the
+// ends, and the Illinois variant halves the function value kept at an end
+// that is retained twice in a row, which avoids the slow convergence of the
+// plain method. The function values at a and b must have opposite signs,
+// otherwise Illinois returns ErrNoBracket. If settings is nil, the zero
+// value is used.
+//
+// Illinois panics if a and b are not finite and distinct.
+//
+// Reference:
+//   - Dowell, M., Jarratt, P.: A modified regula falsi method for computing
+//     the root of an equation. BIT 11 (1971), 168-174
+func Illinois(f func(x float64) float64, a, b float64, settings *Settings) (*Result, error) {
+	a, b = checkInterval(a, b)
+	s := newSolver(settings, rootXRelTol)
+	fa, fb, res, err := s.start(f, a, b)
+	if res != nil {
+		return res, err
+	}
+	// ga and gb are the possibly scaled function values used for the
+	// secant, and side is the end replaced at the previous iteration.
+	ga, gb := fa, fb
+	side := 0
+	for {
+		x, fx := best(a, fa, b, fb)
+		if b-a <= s.tol(x) {
+			return s.done(x, fx, optimize.StepConvergence)
+		}
+		if !s.iterate() {
+			return s.done(x, fx, optimize.IterationLimit)
+		}
+		c := (a*gb - b*ga) / (gb - ga)
+		if !(c > a && c < b) {
+			c = a + (b-a)/2
+			if c <= a || c >= b {
+				return s.done(x, fx, optimize.StepConvergence)
+			}
+		}
+		fc, err := s.eval(f, c)
+		if err != nil {
+			return s.fail(x, fx, err)
+		}
+		if math.Abs(fc) <= s.fTol {
+			return s.done(c, fc, optimize.FunctionThreshold)
+		}
+		if sameSign(fc, fb) {
+			b, fb, gb = c, fc, fc
+			if side == 1 {
+				ga /= 2
+			}
+			side = 1
+		} else {
+			a, fa, ga = c, fc, fc
+			if side == -1 {
+				gb /= 2
+			}
+			side = -1
+		}
+	}
+}
+
+// ITP finds a root of f in the interval [a, b] using the ITP
+// (Interpolate, Truncate and Project) method. ITP takes steps of the method
+// of false position that are truncated towards the midpoint and projected
+// into an interval around the midpoint, so that it needs at most one more
+// evaluation than bisection, while it converges superlinearly to a simple
+// root. The function values at a and b must have opposite signs, otherwise
+// ITP returns ErrNoBracket. If settings is nil, the zero value is used.
+//
+// The tolerance on the location of the root is fixed at the start using the
+// smaller magnitude of a and b.
+//
+// ITP panics if a and b are not finite and distinct.
+//
+// Reference:
+//   - Oliveira, I.F.D., Takahashi, R.H.C.: An enhancement of the bisection
+//     method average performance preserving minmax optimality. ACM
+//     Transactions on Mathematical Software 47(1) (2020), 5:1-5:24
+func ITP(f func(x float64) float64, a, b float64, settings *Settings) (*Result, error) {
+	const (
+		// The parameters of the method recommended by Oliveira and
+		// Takahashi. κ₁ is scaled by the width of the initial interval.
+		kappa1 = 0.2
+		kappa2 = 2
+		n0     = 1
+	)
+	a, b = checkInterval(a, b)
+	s := newSolver(settings, rootXRelTol)
+	fa, fb, res, err := s.start(f, a, b)
+	if res != nil {
+		return res, err
+	}
+	// The method is formulated for f(a) < 0 < f(b).
+	sign := 1.0
+	if fa > 0 {
+		sign = -1
+	}
+	ya, yb := sign*fa, sign*fb
+
+	epsilon := s.tol(math.Min(math.Abs(a), math.Abs(b))) / 2
+	k1 := kappa1 / (b - a)
+	nHalf := math.Max(math.Ceil(math.Log2((b-a)/(2*epsilon))), 0)
+	nMax := nHalf + n0
+	for j := 0; ; j++ {
+		x, fx := best(a, fa, b, fb)
+		if b-a <= 2*epsilon {
+			return s.done(x, fx, optimize.StepConvergence)
+		}
+		if !s.iterate() {
+			return s.done(x, fx, optimize.IterationLimit)
+		}
+		// Interpolation.
+		xHalf := a + (b-a)/2
+		xf := (yb*a - ya*b) / (yb - ya)
+		// Truncation.
+		sigma := 1.0
+		if xHalf < xf {
+			sigma = -1
+		}
+		delta := k1 * math.Pow(b-a, kappa2)
+		xt := xHalf
+		if delta <= math.Abs(xHalf-xf) {
+			xt = xf + sigma*delta
+		}
+		// Projection.
+		r := epsilon*math.Exp2(nMax-float64(j)) - (b-a)/2
+		xITP := xt
+		if math.Abs(xt-xHalf) > r {
+			xITP = xHalf - sigma*r
+		}
+		if !(xITP > a && xITP < b) {
+			xITP = xHalf
+			if xITP <= a || xITP >= b {
+				return s.done(x, fx, optimize.StepConvergence)
+			}
+		}
+
+		fITP, err := s.eval(f, xITP)
+		if err != nil {
+			return s.fail(x, fx, err)
+		}
+		if math.Abs(fITP) <= s.fTol {
+			return s.done(xITP, fITP, optimize.FunctionThreshold)
+		}
+		if y := sign * fITP; y > 0 {
+			b, fb, yb = xITP, fITP, y
+		} else {
+			a, fa, ya = xITP, fITP, y
+		}
+	}
+}
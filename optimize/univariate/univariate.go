@@ -0,0 +1,192 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package univariate
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/optimize"
+)
+
+const (
+	defaultXAbsTol       = 1e-12
+	defaultMaxIterations = 1000
+
+	// eps is the machine epsilon.
+	eps = 1.0 / (1 << 52)
+)
+
+var (
+	// rootXRelTol and minXRelTol are the default relative tolerances on the
+	// location of a root and of a minimum. A minimum cannot be located more
+	// accurately than to about the square root of the machine epsilon
+	// relative to its location.
+	rootXRelTol = 4 * eps
+	minXRelTol  = math.Sqrt(eps)
+)
+
+var (
+	// ErrNoBracket signifies that the function values at the ends of an
+	// interval do not bracket a root, or that no bracketing interval was
+	// found.
+	ErrNoBracket = errors.New("univariate: no bracket")
+
+	// ErrNaN signifies that the function or its derivatives returned NaN.
+	ErrNaN = errors.New("univariate: function value is NaN")
+
+	// ErrNoProgress signifies that a derivative-based method cannot make
+	// progress because the derivative vanishes or the function value does
+	// not decrease along the step.
+	ErrNoProgress = errors.New("univariate: no progress possible")
+)
+
+// errEvaluationLimit is returned by eval when the limit on the number of
+// function evaluations has been reached.
+var errEvaluationLimit = errors.New("univariate: evaluation limit reached")
+
+// Settings holds the settings of the root finders and minimizers.
+type Settings struct {
+	// XAbsTol and XRelTol are the absolute and relative tolerances on the
+	// location x of the root or minimum. The methods terminate with
+	// StepConvergence status when x is known to within
+	//  XAbsTol + XRelTol*|x|,
+	// which for the bracketing methods is the width of the final bracket.
+	// If XAbsTol is zero, it is defaulted to 1e-12. If XRelTol is zero, it
+	// is defaulted to 4ε for the root finders and √ε for the minimizers,
+	// where ε is the machine epsilon.
+	XAbsTol, XRelTol float64
+
+	// FTol is the tolerance on the function value of the root finders,
+	// which terminate with FunctionThreshold status when |f(x)| <= FTol.
+	// The zero value terminates only at exact zeros. FTol is not used by the
+	// minimizers.
+	FTol float64
+
+	// MaxIterations is the maximum number of iterations. If MaxIterations
+	// is zero, it is defaulted to 1000.
+	MaxIterations int
+
+	// FuncEvaluations is the maximum number of function evaluations. If it
+	// is zero, the number of evaluations is not limited.
+	FuncEvaluations int
+}
+
+// Result holds the result of a root finder or minimizer.
+type Result struct {
+	// X is the location of the root or minimum and F is the function value
+	// at X.
+	X, F float64
+
+	// Iterations is the number of iterations and FuncEvaluations is the
+	// number of function evaluations.
+	Iterations      int
+	FuncEvaluations int
+
+	// Status is the reason for the termination.
+	Status optimize.Status
+}
+
+// solver holds the settings and the progress of a method.
+type solver struct {
+	absTol, relTol, fTol float64
+	maxIter, maxEval     int
+
+	res Result
+}
+
+// newSolver returns a solver with the settings, where relTol is the default
+// relative tolerance of the method.
+func newSolver(settings *Settings, relTol float64) *solver {
+	if settings == nil {
+		settings = &Settings{}
+	}
+	s := &solver{
+		absTol:  settings.XAbsTol,
+		relTol:  settings.XRelTol,
+		fTol:    settings.FTol,
+		maxIter: settings.MaxIterations,
+		maxEval: settings.FuncEvaluations,
+	}
+	if s.absTol == 0 {
+		s.absTol = defaultXAbsTol
+	}
+	if s.relTol == 0 {
+		s.relTol = relTol
+	}
+	if s.maxIter == 0 {
+		s.maxIter = defaultMaxIterations
+	}
+	if s.absTol < 0 || s.relTol < 0 || s.fTol < 0 || s.maxIter < 0 || s.maxEval < 0 {
+		panic("univariate: invalid settings")
+	}
+	return s
+}
+
+// tol returns the tolerance on the location x.
+func (s *solver) tol(x float64) float64 {
+	return s.absTol + s.relTol*math.Abs(x)
+}
+
+// eval evaluates f at x. It returns errEvaluationLimit if the limit on the
+// number of evaluations has been reached and ErrNaN if the value is NaN.
+func (s *solver) eval(f func(float64) float64, x float64) (float64, error) {
+	if s.maxEval > 0 && s.res.FuncEvaluations >= s.maxEval {
+		return math.NaN(), errEvaluationLimit
+	}
+	s.res.FuncEvaluations++
+	fx := f(x)
+	if math.IsNaN(fx) {
+		return fx, ErrNaN
+	}
+	return fx, nil
+}
+
+// iterate starts a new iteration and returns false if the iteration limit
+// has been reached.
+func (s *solver) iterate() bool {
+	if s.res.Iterations >= s.maxIter {
+		return false
+	}
+	s.res.Iterations++
+	return true
+}
+
+// done returns the result at x with the function value f and the status.
+func (s *solver) done(x, f float64, status optimize.Status) (*Result, error) {
+	s.res.X = x
+	s.res.F = f
+	s.res.Status = status
+	return &s.res, nil
+}
+
+// fail returns the result at x with the function value f after the error
+// err. The evaluation limit is not reported as an error.
+func (s *solver) fail(x, f float64, err error) (*Result, error) {
+	if err == errEvaluationLimit {
+		return s.done(x, f, optimize.FunctionEvaluationLimit)
+	}
+	s.res.X = x
+	s.res.F = f
+	s.res.Status = optimize.Failure
+	return &s.res, err
+}
+
+// checkInterval panics if a and b do not form a valid interval, and returns
+// them in increasing order.
+func checkInterval(a, b float64) (float64, float64) {
+	if math.IsNaN(a) || math.IsNaN(b) || math.IsInf(a, 0) || math.IsInf(b, 0) || a == b {
+		panic("univariate: invalid interval")
+	}
+	if a > b {
+		a, b = b, a
+	}
+	return a, b
+}
+
+// sameSign returns whether a and b are both positive or both negative.
+func sameSign(a, b float64) bool {
+	return (a > 0 && b > 0) || (a < 0 && b < 0)
+}
@@ -0,0 +1,488 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package univariate
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/optimize"
+)
+
+type rootFinder func(f func(float64) float64, a, b float64, settings *Settings) (*Result, error)
+
+var rootFinders = []struct {
+	name   string
+	method rootFinder
+}{
+	{name: "Bisection", method: Bisection},
+	{name: "Brent", method: Brent},
+	{name: "Illinois", method: Illinois},
+	{name: "ITP", method: ITP},
+}
+
+var rootTests = []struct {
+	name string
+	f    func(float64) float64
+	df   func(float64) float64
+	d2f  func(float64) float64
+	a, b float64
+	x0   float64
+	root float64
+	// maxEval is the maximum number of evaluations of the superlinear
+	// methods.
+	maxEval int
+}{
+	{
+		name:    "Cubic",
+		f:       func(x float64) float64 { return x*x*x - 2*x - 5 },
+		df:      func(x float64) float64 { return 3*x*x - 2 },
+		d2f:     func(x float64) float64 { return 6 * x },
+		a:       2,
+		b:       3,
+		x0:      3,
+		root:    2.0945514815423265,
+		maxEval: 15,
+	},
+	{
+		name:    "Cosine",
+		f:       func(x float64) float64 { return math.Cos(x) - x },
+		df:      func(x float64) float64 { return -math.Sin(x) - 1 },
+		d2f:     func(x float64) float64 { return -math.Cos(x) },
+		a:       0,
+		b:       1,
+		x0:      0,
+		root:    0.7390851332151607,
+		maxEval: 15,
+	},
+	{
+		name:    "Exp",
+		f:       func(x float64) float64 { return math.Exp(x) - 2 },
+		df:      math.Exp,
+		d2f:     math.Exp,
+		a:       -4,
+		b:       4,
+		x0:      4,
+		root:    math.Ln2,
+		maxEval: 20,
+	},
+	{
+		// Newton's method diverges without safeguards.
+		name:    "Atan",
+		f:       func(x float64) float64 { return math.Atan(x - 1) },
+		df:      func(x float64) float64 { return 1 / (1 + (x-1)*(x-1)) },
+		d2f:     func(x float64) float64 { return -2 * (x - 1) / ((1 + (x-1)*(x-1)) * (1 + (x-1)*(x-1))) },
+		a:       -10,
+		b:       20,
+		x0:      20,
+		root:    1,
+		maxEval: 25,
+	},
+	{
+		// The root is of multiplicity three, where the methods converge
+		// only linearly.
+		name:    "Multiple",
+		f:       func(x float64) float64 { return (x - 1) * (x - 1) * (x - 1) },
+		df:      func(x float64) float64 { return 3 * (x - 1) * (x - 1) },
+		d2f:     func(x float64) float64 { return 6 * (x - 1) },
+		a:       0,
+		b:       3,
+		x0:      3,
+		root:    1,
+		maxEval: 200,
+	},
+}
+
+func TestBracketingRootFinders(t *testing.T) {
+	t.Parallel()
+	for _, finder := range rootFinders {
+		for _, test := range rootTests {
+			for _, settings := range []*Settings{nil, {XAbsTol: 1e-6}} {
+				tol := defaultXAbsTol
+				if settings != nil {
+					tol = settings.XAbsTol
+				}
+				tol += rootXRelTol * math.Abs(test.root)
+				// Bisection is guaranteed to need only about log2((b-a)/tol)
+				// evaluations, and ITP at most one more.
+				maxEval := test.maxEval
+				if finder.name == "Bisection" || finder.name == "ITP" {
+					maxEval = int(math.Ceil(math.Log2((test.b-test.a)/tol))) + 4
+				}
+				res, err := finder.method(test.f, test.a, test.b, settings)
+				if err != nil {
+					t.Errorf("%s %s: unexpected error: %v", finder.name, test.name, err)
+					continue
+				}
+				if res.Status != optimize.StepConvergence && res.Status != optimize.FunctionThreshold {
+					t.Errorf("%s %s: unexpected status: %v", finder.name, test.name, res.Status)
+				}
+				// The tolerance on the location does not apply to multiple
+				// roots, where the function vanishes in floating point
+				// around the root.
+				if test.name == "Multiple" {
+					tol = 1e-5
+				}
+				if math.Abs(res.X-test.root) > tol {
+					t.Errorf("%s %s: unexpected root: got %v, want %v", finder.name, test.name, res.X, test.root)
+				}
+				if res.F != test.f(res.X) {
+					t.Errorf("%s %s: function value mismatch", finder.name, test.name)
+				}
+				if finder.name != "Illinois" && res.FuncEvaluations > maxEval {
+					t.Errorf("%s %s: too many evaluations: got %d, want at most %d", finder.name, test.name, res.FuncEvaluations, maxEval)
+				}
+			}
+		}
+	}
+}
+
+func TestBracketingRootFindersSpecial(t *testing.T) {
+	t.Parallel()
+	f := func(x float64) float64 { return x*x - 4 }
+	for _, finder := range rootFinders {
+		// The root is at an end of the interval and the interval is given
+		// in decreasing order.
+		res, err := finder.method(f, 2, 0, nil)
+		if err != nil || res.X != 2 || res.Status != optimize.FunctionThreshold || res.FuncEvaluations != 2 {
+			t.Errorf("%s: unexpected result for root at the end: %+v, %v", finder.name, res, err)
+		}
+
+		// The function values do not bracket a root.
+		res, err = finder.method(f, 3, 4, nil)
+		if err != ErrNoBracket || res.Status != optimize.Failure || res.X != 3 {
+			t.Errorf("%s: unexpected result without bracket: %+v, %v", finder.name, res, err)
+		}
+
+		// The tolerance on the function value.
+		res, err = finder.method(f, 0, 10, &Settings{FTol: 1e-3})
+		if err != nil || res.Status != optimize.FunctionThreshold || math.Abs(res.F) > 1e-3 {
+			t.Errorf("%s: unexpected result with function tolerance: %+v, %v", finder.name, res, err)
+		}
+
+		// The limits on the iterations and the evaluations.
+		res, err = finder.method(f, 0, 10, &Settings{MaxIterations: 3})
+		if err != nil || res.Status != optimize.IterationLimit || res.Iterations != 3 {
+			t.Errorf("%s: unexpected result with iteration limit: %+v, %v", finder.name, res, err)
+		}
+		res, err = finder.method(f, 0, 10, &Settings{FuncEvaluations: 4})
+		if err != nil || res.Status != optimize.FunctionEvaluationLimit || res.FuncEvaluations != 4 || res.F != f(res.X) {
+			t.Errorf("%s: unexpected result with evaluation limit: %+v, %v", finder.name, res, err)
+		}
+
+		// NaN function values.
+		_, err = finder.method(func(x float64) float64 {
+			if x > 1 && x < 9 {
+				return math.NaN()
+			}
+			return f(x)
+		}, 0, 10, nil)
+		if err != ErrNaN {
+			t.Errorf("%s: unexpected error for NaN: got %v, want %v", finder.name, err, ErrNaN)
+		}
+
+		// A step function changes sign without a root, and the bracket
+		// shrinks to the discontinuity.
+		res, err = finder.method(func(x float64) float64 {
+			if x < 1.5 {
+				return -1
+			}
+			return 1
+		}, 0, 10, nil)
+		if err != nil || res.Status != optimize.StepConvergence || math.Abs(res.X-1.5) > 1e-11 {
+			t.Errorf("%s: unexpected result for discontinuity: %+v, %v", finder.name, res, err)
+		}
+	}
+}
+
+func TestNewtonHalley(t *testing.T) {
+	t.Parallel()
+	for _, test := range rootTests {
+		for _, method := range []struct {
+			name string
+			find func() (*Result, error)
+		}{
+			{
+				name: "Newton",
+				find: func() (*Result, error) {
+					return Newton(func(x float64) (float64, float64) {
+						return test.f(x), test.df(x)
+					}, test.x0, math.Inf(-1), math.Inf(1), nil)
+				},
+			},
+			{
+				name: "NewtonBounded",
+				find: func() (*Result, error) {
+					return Newton(func(x float64) (float64, float64) {
+						return test.f(x), test.df(x)
+					}, test.x0, test.a, test.b, nil)
+				},
+			},
+			{
+				name: "Halley",
+				find: func() (*Result, error) {
+					return Halley(func(x float64) (float64, float64, float64) {
+						return test.f(x), test.df(x), test.d2f(x)
+					}, test.x0, math.Inf(-1), math.Inf(1), nil)
+				},
+			},
+		} {
+			res, err := method.find()
+			if err != nil {
+				t.Errorf("%s %s: unexpected error: %v", method.name, test.name, err)
+				continue
+			}
+			if res.Status != optimize.StepConvergence && res.Status != optimize.FunctionThreshold {
+				t.Errorf("%s %s: unexpected status: %v", method.name, test.name, res.Status)
+			}
+			tol := 1e-12
+			if test.name == "Multiple" {
+				tol = 1e-5
+			}
+			if math.Abs(res.X-test.root) > tol {
+				t.Errorf("%s %s: unexpected root: got %v, want %v", method.name, test.name, res.X, test.root)
+			}
+			if res.F != test.f(res.X) {
+				t.Errorf("%s %s: function value mismatch", method.name, test.name)
+			}
+			if res.FuncEvaluations > test.maxEval {
+				t.Errorf("%s %s: too many evaluations: got %d, want at most %d", method.name, test.name, res.FuncEvaluations, test.maxEval)
+			}
+		}
+	}
+}
+
+func TestNewtonErrors(t *testing.T) {
+	t.Parallel()
+	// The derivative vanishes at the initial location.
+	res, err := Newton(func(x float64) (float64, float64) {
+		return x*x + 1, 2 * x
+	}, 0, math.Inf(-1), math.Inf(1), nil)
+	if err != ErrNoProgress || res.Status != optimize.Failure {
+		t.Errorf("unexpected result for zero derivative: %+v, %v", res, err)
+	}
+	// The function has no root and its magnitude has a positive minimum.
+	res, err = Newton(func(x float64) (float64, float64) {
+		return x*x + 1, 2 * x
+	}, 3, math.Inf(-1), math.Inf(1), nil)
+	if err != ErrNoProgress || res.Status != optimize.Failure || math.Abs(res.X) > 1e-6 {
+		t.Errorf("unexpected result without root: %+v, %v", res, err)
+	}
+	// The bounds keep the iterates away from the NaN values of the
+	// logarithm.
+	res, err = Newton(func(x float64) (float64, float64) {
+		return math.Log(x) + 5, 1 / x
+	}, 1, 0, math.Inf(1), nil)
+	if err != nil || math.Abs(res.X-math.Exp(-5)) > 1e-12 {
+		t.Errorf("unexpected result with bounds: %+v, %v", res, err)
+	}
+	if !panics(func() {
+		Newton(func(x float64) (float64, float64) { return x, 1 }, 2, 0, 1, nil)
+	}) {
+		t.Errorf("expected panic for initial location out of bounds")
+	}
+}
+
+func TestMinimize(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		f    func(float64) float64
+		a, b float64
+		want float64
+	}{
+		{
+			name: "Quadratic",
+			f:    func(x float64) float64 { return (x - 2) * (x - 2) },
+			a:    0,
+			b:    5,
+			want: 2,
+		},
+		{
+			name: "Sine",
+			f:    func(x float64) float64 { return -math.Sin(x) },
+			a:    0,
+			b:    3,
+			want: math.Pi / 2,
+		},
+		{
+			name: "Entropy",
+			f:    func(x float64) float64 { return x * math.Log(x) },
+			a:    0.01,
+			b:    2,
+			want: 1 / math.E,
+		},
+		{
+			name: "Quartic",
+			f:    func(x float64) float64 { return math.Pow(x-1, 4) },
+			a:    -3,
+			b:    2,
+			want: 1,
+		},
+		{
+			// The minimum is at the end of the interval.
+			name: "Boundary",
+			f:    func(x float64) float64 { return x },
+			a:    1,
+			b:    3,
+			want: 1,
+		},
+		{
+			name: "Kink",
+			f:    func(x float64) float64 { return math.Abs(x - 0.3) },
+			a:    -1,
+			b:    1,
+			want: 0.3,
+		},
+	} {
+		for _, method := range []struct {
+			name   string
+			method func(f func(float64) float64, a, b float64, settings *Settings) (*Result, error)
+		}{
+			{name: "BrentMinimize", method: BrentMinimize},
+			{name: "GoldenSection", method: GoldenSection},
+		} {
+			settings := &Settings{XAbsTol: 1e-10, XRelTol: 1e-10}
+			res, err := method.method(test.f, test.a, test.b, settings)
+			if err != nil {
+				t.Errorf("%s %s: unexpected error: %v", method.name, test.name, err)
+				continue
+			}
+			if res.Status != optimize.StepConvergence {
+				t.Errorf("%s %s: unexpected status: %v", method.name, test.name, res.Status)
+			}
+			// The function values resolve the location of a smooth minimum
+			// only to about the square root of the machine epsilon, and
+			// the quartic is even flatter around the minimum.
+			tol := 2e-10 + 1e-10*math.Abs(test.want)
+			switch test.name {
+			case "Quadratic", "Sine", "Entropy":
+				tol = 1e-7
+			case "Quartic":
+				tol = 1e-3
+			}
+			if math.Abs(res.X-test.want) > tol {
+				t.Errorf("%s %s: unexpected minimum: got %v, want %v", method.name, test.name, res.X, test.want)
+			}
+			if res.F != test.f(res.X) {
+				t.Errorf("%s %s: function value mismatch", method.name, test.name)
+			}
+		}
+	}
+}
+
+func TestBrentMinimizeEvaluations(t *testing.T) {
+	t.Parallel()
+	// Parabolic interpolation needs far fewer evaluations than golden
+	// section search for a smooth function.
+	f := func(x float64) float64 { return math.Exp(x) - 3*x }
+	brent, err := BrentMinimize(f, -2, 4, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	golden, err := GoldenSection(f, -2, 4, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := math.Log(3)
+	for _, res := range []*Result{brent, golden} {
+		if math.Abs(res.X-want) > 1e-7 {
+			t.Errorf("unexpected minimum: got %v, want %v", res.X, want)
+		}
+	}
+	if brent.FuncEvaluations > golden.FuncEvaluations/2 {
+		t.Errorf("too many evaluations of BrentMinimize: got %d, GoldenSection needs %d",
+			brent.FuncEvaluations, golden.FuncEvaluations)
+	}
+}
+
+func TestBracketRoot(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		f       func(float64) float64
+		a, b    float64
+		wantErr error
+	}{
+		{f: func(x float64) float64 { return x - 100 }, a: 0, b: 1},
+		{f: func(x float64) float64 { return x + 100 }, a: 0, b: 1},
+		{f: func(x float64) float64 { return math.Exp(x) - 1e-3 }, a: 1, b: 2},
+		{f: func(x float64) float64 { return x*x + 1 }, a: 0, b: 1, wantErr: ErrNoBracket},
+		{f: func(x float64) float64 { return math.Log(x) + 10 }, a: 2, b: 3, wantErr: ErrNaN},
+	} {
+		lo, hi, err := BracketRoot(test.f, test.a, test.b)
+		if err != test.wantErr {
+			t.Errorf("unexpected error: got %v, want %v", err, test.wantErr)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if lo >= hi || sameSign(test.f(lo), test.f(hi)) {
+			t.Errorf("not a bracket: [%v, %v]", lo, hi)
+		}
+		if _, err := Brent(test.f, lo, hi, nil); err != nil {
+			t.Errorf("unexpected error of Brent: %v", err)
+		}
+	}
+}
+
+func TestBracketMinimum(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		f       func(float64) float64
+		a, b    float64
+		want    float64
+		wantErr error
+	}{
+		{f: func(x float64) float64 { return (x - 10) * (x - 10) }, a: 0, b: 1, want: 10},
+		{f: func(x float64) float64 { return (x + 10) * (x + 10) }, a: 0, b: 1, want: -10},
+		{f: func(x float64) float64 { return math.Cosh((x - 1000) / 100) }, a: 0, b: 1, want: 1000},
+		{f: func(x float64) float64 { return -math.Sin(x) }, a: 0.1, b: 0.2, want: math.Pi / 2},
+		{f: func(x float64) float64 { return x * x * x }, a: 0, b: 1, wantErr: ErrNoBracket},
+	} {
+		lo, mid, hi, err := BracketMinimum(test.f, test.a, test.b)
+		if err != test.wantErr {
+			t.Errorf("unexpected error: got %v, want %v", err, test.wantErr)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if !(lo < mid && mid < hi) || test.f(mid) > test.f(lo) || test.f(mid) > test.f(hi) {
+			t.Errorf("not a bracket: %v, %v, %v", lo, mid, hi)
+			continue
+		}
+		res, err := BrentMinimize(test.f, lo, hi, nil)
+		if err != nil || math.Abs(res.X-test.want) > 1e-6*math.Max(1, math.Abs(test.want)) {
+			t.Errorf("unexpected minimum in the bracket: %+v, %v", res, err)
+		}
+	}
+}
+
+func TestPanics(t *testing.T) {
+	t.Parallel()
+	f := func(x float64) float64 { return x }
+	for k, fn := range []func(){
+		func() { Brent(f, 1, 1, nil) },
+		func() { Bisection(f, math.NaN(), 1, nil) },
+		func() { ITP(f, 0, math.Inf(1), nil) },
+		func() { GoldenSection(f, 0, 1, &Settings{XAbsTol: -1}) },
+		func() { BracketRoot(f, 1, 1) },
+		func() { BracketMinimum(f, 0, math.Inf(-1)) },
+	} {
+		if !panics(fn) {
+			t.Errorf("case %d: expected panic", k)
+		}
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		r := recover()
+		panicked = r != nil
+	}()
+	fn()
+	return
+}
@@ -0,0 +1,215 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distmv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Mixture is a finite mixture of multivariate distributions. The probability
+// density of a point x is
+//
+//	f(x) = \sum_i w_i f_i(x)
+//
+// where f_i are the densities of the components and w_i are the mixing
+// weights, which sum to one.
+//
+// For more information see https://en.wikipedia.org/wiki/Mixture_distribution
+type Mixture struct {
+	components []RandLogProber
+	weights    []float64
+	logWeights []float64
+	dim        int
+	src        rand.Source
+}
+
+// NewMixture returns a mixture of the given components where component i is
+// chosen with probability proportional to weights[i]. All of the components
+// must have a Dim method returning the same dimension. NewMixture panics if
+// the lengths of components and weights differ, if any weight is negative, or
+// if all of the weights are zero.
+func NewMixture(components []RandLogProber, weights []float64, src rand.Source) *Mixture {
+	if len(components) == 0 {
+		panic(badZeroDimension)
+	}
+	if len(components) != len(weights) {
+		panic(badInputLength)
+	}
+	dim := -1
+	for _, c := range components {
+		d, ok := c.(interface{ Dim() int })
+		if !ok {
+			panic("mixture: component does not implement Dim")
+		}
+		if dim == -1 {
+			dim = d.Dim()
+		} else if d.Dim() != dim {
+			panic(badSizeMismatch)
+		}
+	}
+	var sum float64
+	for _, w := range weights {
+		if w < 0 || math.IsNaN(w) {
+			panic("mixture: negative weight")
+		}
+		sum += w
+	}
+	if !(sum > 0) || math.IsInf(sum, 1) {
+		panic("mixture: weights must have a positive finite sum")
+	}
+	m := &Mixture{
+		components: make([]RandLogProber, len(components)),
+		weights:    make([]float64, len(weights)),
+		logWeights: make([]float64, len(weights)),
+		dim:        dim,
+		src:        src,
+	}
+	copy(m.components, components)
+	for i, w := range weights {
+		m.weights[i] = w / sum
+		m.logWeights[i] = math.Log(m.weights[i])
+	}
+	return m
+}
+
+// Component returns the i-th component of the mixture and its weight.
+func (m *Mixture) Component(i int) (RandLogProber, float64) {
+	return m.components[i], m.weights[i]
+}
+
+// CovarianceMatrix calculates the covariance matrix of the distribution,
+// storing the result in dst. Upon return, the value at element {i, j} of the
+// covariance matrix is equal to the covariance of the i^th and j^th variables.
+// The covariance of the mixture is
+//
+//	Σ = \sum_i w_i (Σ_i + μ_i μ_iᵀ) - μ μᵀ
+//
+// where μ_i and Σ_i are the mean and covariance of component i and μ is the
+// mean of the mixture. CovarianceMatrix panics if a component does not
+// implement Mean and CovarianceMatrix.
+//
+// If the dst matrix is empty it will be resized to the correct dimensions,
+// otherwise dst must match the dimension of the receiver or CovarianceMatrix
+// will panic.
+func (m *Mixture) CovarianceMatrix(dst *mat.SymDense) {
+	if dst.IsEmpty() {
+		*dst = *(dst.GrowSym(m.dim).(*mat.SymDense))
+	} else if dst.SymmetricDim() != m.dim {
+		panic("mixture: input matrix size mismatch")
+	}
+	type covarianceMatrixer interface {
+		CovarianceMatrix(dst *mat.SymDense)
+	}
+	dst.Zero()
+	mean := m.Mean(nil)
+	mu := make([]float64, m.dim)
+	cov := mat.NewSymDense(m.dim, nil)
+	for i, c := range m.components {
+		w := m.weights[i]
+		if w == 0 {
+			continue
+		}
+		cc, ok := c.(covarianceMatrixer)
+		if !ok {
+			panic("mixture: component does not implement CovarianceMatrix")
+		}
+		cc.CovarianceMatrix(cov)
+		c.(meaner).Mean(mu)
+		cov.ScaleSym(w, cov)
+		dst.AddSym(dst, cov)
+		floats.Sub(mu, mean)
+		dst.SymRankOne(dst, w, mat.NewVecDense(m.dim, mu))
+	}
+}
+
+// Dim returns the dimension of the distribution.
+func (m *Mixture) Dim() int {
+	return m.dim
+}
+
+// Len returns the number of components in the mixture.
+func (m *Mixture) Len() int {
+	return len(m.components)
+}
+
+// LogProb computes the log of the pdf of the point x.
+func (m *Mixture) LogProb(x []float64) float64 {
+	if len(x) != m.dim {
+		panic(badSizeMismatch)
+	}
+	lp := make([]float64, len(m.components))
+	for i, c := range m.components {
+		lp[i] = m.logWeights[i] + c.LogProb(x)
+	}
+	return floats.LogSumExp(lp)
+}
+
+// Mean returns the mean of the probability distribution. Mean panics if a
+// component does not implement Mean.
+//
+// If dst is not nil, the mean will be stored in-place into dst and returned,
+// otherwise a new slice will be allocated first. If dst is not nil, it must
+// have length equal to the dimension of the distribution.
+func (m *Mixture) Mean(dst []float64) []float64 {
+	dst = reuseAs(dst, m.dim)
+	for i := range dst {
+		dst[i] = 0
+	}
+	mu := make([]float64, m.dim)
+	for i, c := range m.components {
+		if m.weights[i] == 0 {
+			continue
+		}
+		cm, ok := c.(meaner)
+		if !ok {
+			panic("mixture: component does not implement Mean")
+		}
+		floats.AddScaled(dst, m.weights[i], cm.Mean(mu))
+	}
+	return dst
+}
+
+// Prob computes the value of the probability density function at x.
+func (m *Mixture) Prob(x []float64) float64 {
+	return math.Exp(m.LogProb(x))
+}
+
+// Rand generates a random sample according to the distribution. A component
+// is chosen according to the weights, and a sample is drawn from it.
+//
+// If dst is not nil, the sample will be stored in-place into dst and returned,
+// otherwise a new slice will be allocated first. If dst is not nil, it must
+// have length equal to the dimension of the distribution.
+func (m *Mixture) Rand(dst []float64) []float64 {
+	dst = reuseAs(dst, m.dim)
+	var u float64
+	if m.src == nil {
+		u = rand.Float64()
+	} else {
+		u = rand.New(m.src).Float64()
+	}
+	last := 0
+	for i, w := range m.weights {
+		if w == 0 {
+			continue
+		}
+		last = i
+		u -= w
+		if u < 0 {
+			break
+		}
+	}
+	return m.components[last].Rand(dst)
+}
+
+// meaner is a distribution with a mean.
+type meaner interface {
+	Mean(dst []float64) []float64
+}
@@ -0,0 +1,119 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distmv
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestMixtureProb(t *testing.T) {
+	t.Parallel()
+	n1, ok := NewNormal([]float64{0, 0}, mat.NewSymDense(2, []float64{1, 0, 0, 1}), nil)
+	if !ok {
+		t.Fatal("bad test, covariance is not positive definite")
+	}
+	n2, ok := NewNormal([]float64{3, -1}, mat.NewSymDense(2, []float64{4, 0, 0, 0.25}), nil)
+	if !ok {
+		t.Fatal("bad test, covariance is not positive definite")
+	}
+	m := NewMixture([]RandLogProber{n1, n2}, []float64{1, 3}, nil)
+
+	// The densities of the components with diagonal covariance.
+	p1 := func(x, y float64) float64 {
+		return math.Exp(-(x*x+y*y)/2) / (2 * math.Pi)
+	}
+	p2 := func(x, y float64) float64 {
+		dx, dy := x-3, y+1
+		return math.Exp(-(dx*dx/4+dy*dy/0.25)/2) / (2 * math.Pi * 2 * 0.5)
+	}
+	var cases []probCase
+	for _, x := range [][]float64{{0, 0}, {3, -1}, {1, 0.5}, {-2, 4}} {
+		cases = append(cases, probCase{
+			dist:    m,
+			loc:     x,
+			logProb: math.Log(0.25*p1(x[0], x[1]) + 0.75*p2(x[0], x[1])),
+		})
+	}
+	testProbability(t, cases)
+
+	if got, want := m.Mean(nil), []float64{2.25, -0.75}; !floats.EqualApprox(got, want, 1e-14) {
+		t.Errorf("Mean mismatch: got %v, want %v", got, want)
+	}
+	// Σ = Σ w_i (Σ_i + μ_i μ_iᵀ) - μ μᵀ.
+	want := mat.NewSymDense(2, []float64{
+		0.25*1 + 0.75*(4+9) - 2.25*2.25, 0.75*(3*-1) - 2.25*-0.75,
+		0.75*(3*-1) - 2.25*-0.75, 0.25*1 + 0.75*(0.25+1) - 0.75*0.75,
+	})
+	var cov mat.SymDense
+	m.CovarianceMatrix(&cov)
+	if !mat.EqualApprox(&cov, want, 1e-14) {
+		t.Errorf("CovarianceMatrix mismatch: got %v, want %v", mat.Formatted(&cov), mat.Formatted(want))
+	}
+	if m.Dim() != 2 {
+		t.Errorf("Dim mismatch: got %v, want 2", m.Dim())
+	}
+}
+
+func TestMixtureMoments(t *testing.T) {
+	t.Parallel()
+	src := rand.New(rand.NewSource(1))
+	n1, ok := NewNormal([]float64{1, 2, 3}, mat.NewSymDense(3, []float64{
+		2, 0.5, 0.1,
+		0.5, 1, -0.3,
+		0.1, -0.3, 1.5,
+	}), src)
+	if !ok {
+		t.Fatal("bad test, covariance is not positive definite")
+	}
+	n2, ok := NewNormal([]float64{-2, 0, 4}, mat.NewSymDense(3, []float64{
+		1, -0.2, 0,
+		-0.2, 0.5, 0.1,
+		0, 0.1, 0.8,
+	}), src)
+	if !ok {
+		t.Fatal("bad test, covariance is not positive definite")
+	}
+	for i, m := range []*Mixture{
+		NewMixture([]RandLogProber{n1, n2}, []float64{0.3, 0.7}, src),
+		NewMixture([]RandLogProber{
+			n1,
+			NewDirichlet([]float64{2, 3, 5}, src),
+			n2,
+		}, []float64{1, 2, 1}, src),
+	} {
+		const n = 1e6
+		x := mat.NewDense(n, m.Dim(), nil)
+		generateSamples(x, m)
+		checkMean(t, i, x, m, 1e-2)
+		checkCov(t, i, x, m, 1e-2)
+	}
+}
+
+func TestMixturePanics(t *testing.T) {
+	t.Parallel()
+	n1, _ := NewNormal([]float64{0, 0}, mat.NewSymDense(2, []float64{1, 0, 0, 1}), nil)
+	n2, _ := NewNormal([]float64{0, 0, 0}, mat.NewSymDense(3, []float64{1, 0, 0, 0, 1, 0, 0, 0, 1}), nil)
+	for _, test := range []struct {
+		name       string
+		components []RandLogProber
+		weights    []float64
+	}{
+		{"empty", nil, nil},
+		{"length mismatch", []RandLogProber{n1}, []float64{1, 1}},
+		{"dimension mismatch", []RandLogProber{n1, n2}, []float64{1, 1}},
+		{"negative weight", []RandLogProber{n1, n1}, []float64{1, -1}},
+		{"zero sum", []RandLogProber{n1, n1}, []float64{0, 0}},
+	} {
+		if !panics(func() { NewMixture(test.components, test.weights, nil) }) {
+			t.Errorf("NewMixture did not panic for %s", test.name)
+		}
+	}
+}
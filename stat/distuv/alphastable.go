@@ -8,6 +8,8 @@ import (
 	"math"
 
 	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/stat"
 )
 
 // AlphaStable represents an α-stable distribution with four parameters.
@@ -36,6 +38,128 @@ func (a AlphaStable) ExKurtosis() float64 {
 	return math.NaN()
 }
 
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The α-stable distribution has no closed-form density, so Fit estimates
+// the parameters by the iterated regressions on the empirical
+// characteristic function of Koutrouvelis (1980), starting from the
+// weighted median and interquartile range of the samples, rather than by
+// maximum likelihood. The estimates of Beta are imprecise when Alpha is
+// close to 1, and Beta is set to zero when Alpha is estimated to be close
+// to 2. Since the distribution has no LogProb, standard errors of the
+// estimates are not available from FitMLE.
+//
+// References:
+//   - Koutrouvelis, I. A. (1980). Regression-type estimation of the
+//     parameters of stable laws. Journal of the American Statistical
+//     Association, 75(372), 918–928.
+func (a *AlphaStable) Fit(samples, weights []float64) {
+	checkFit(samples, weights)
+	x := make([]float64, len(samples))
+	copy(x, samples)
+	var w []float64
+	if weights != nil {
+		w = make([]float64, len(weights))
+		copy(w, weights)
+	}
+	stat.SortWeighted(x, w)
+	mu := stat.Quantile(0.5, stat.Empirical, x, w)
+	c := (stat.Quantile(0.75, stat.Empirical, x, w) - stat.Quantile(0.25, stat.Empirical, x, w)) / 2
+	if c == 0 {
+		c = 1
+	}
+
+	// The characteristic function of the distribution is
+	//  φ(t) = exp(iμt - |ct|^α (1 - iβ sign(t) tan(πα/2))),
+	// so that for t > 0
+	//  log(-log|φ(t)|²) = log(2c^α) + α log t,
+	//  arg φ(t) = μt + β c^α tan(πα/2) t^α.
+	// The regressions are on the samples standardized by the current
+	// estimates of the location and scale, so the points t lie in the
+	// region where φ is well estimated.
+	const nt = 10
+	var t [nt]float64
+	for k := range t {
+		t[k] = 0.1 + 0.9*float64(k)/(nt-1)
+	}
+	alpha, beta := 2.0, 0.0
+	for iter := 0; iter < 20; iter++ {
+		var re, im [nt]float64
+		var sumWeights float64
+		for i, v := range samples {
+			wi := sampleWeight(weights, i)
+			if wi == 0 {
+				continue
+			}
+			z := (v - mu) / c
+			for k, tk := range t {
+				sin, cos := math.Sincos(tk * z)
+				re[k] += wi * cos
+				im[k] += wi * sin
+			}
+			sumWeights += wi
+		}
+
+		// Regress log(-log|φ|²) on log t.
+		var sx, sy, sxx, sxy, m float64
+		for k, tk := range t {
+			mod2 := (re[k]*re[k] + im[k]*im[k]) / (sumWeights * sumWeights)
+			if mod2 <= 0 || mod2 >= 1 {
+				continue
+			}
+			lx, ly := math.Log(tk), math.Log(-math.Log(mod2))
+			sx += lx
+			sy += ly
+			sxx += lx * lx
+			sxy += lx * ly
+			m++
+		}
+		if m < 2 {
+			break
+		}
+		alpha = (m*sxy - sx*sy) / (m*sxx - sx*sx)
+		alpha = math.Max(0.1, math.Min(2, alpha))
+		cs := math.Pow(math.Exp((sy-alpha*sx)/m)/2, 1/alpha)
+
+		// Regress arg φ on t and cs^α tan(πα/2) t^α.
+		var s11, s12, s22, s1y, s2y float64
+		tan := math.Tan(math.Pi * alpha / 2)
+		for k, tk := range t {
+			u1 := tk
+			u2 := math.Pow(cs, alpha) * tan * math.Pow(tk, alpha)
+			y := math.Atan2(im[k], re[k])
+			s11 += u1 * u1
+			s12 += u1 * u2
+			s22 += u2 * u2
+			s1y += u1 * y
+			s2y += u2 * y
+		}
+		var ms float64
+		det := s11*s22 - s12*s12
+		if math.Abs(tan) < 0.1 || math.IsInf(tan, 0) || math.Abs(det) <= 1e-12*s11*s22 {
+			// β is not identifiable when α is close to 2, where it has
+			// little effect on the distribution, or when the regressors
+			// are collinear.
+			beta = 0
+			ms = s1y / s11
+		} else {
+			ms = (s22*s1y - s12*s2y) / det
+			beta = (s11*s2y - s12*s1y) / det
+			beta = math.Max(-1, math.Min(1, beta))
+		}
+
+		mu += c * ms
+		c *= cs
+		if math.Abs(cs-1) < 1e-10 && math.Abs(ms) < 1e-10 {
+			break
+		}
+	}
+	a.Alpha, a.Beta, a.C, a.Mu = alpha, beta, c, mu
+}
+
 // Mean returns the mean of the probability distribution.
 // Mean returns NaN when Alpha <= 1.
 func (a AlphaStable) Mean() float64 {
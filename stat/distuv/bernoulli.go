@@ -44,6 +44,18 @@ func (b Bernoulli) ExKurtosis() float64 {
 	return (1 - 6*pq) / pq
 }
 
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The maximum likelihood estimate of P is the weighted mean of the samples,
+// which must be 0 or 1.
+func (b *Bernoulli) Fit(samples, weights []float64) {
+	checkFit(samples, weights)
+	b.P, _ = weightedMean(identity, samples, weights)
+}
+
 // LogProb computes the natural logarithm of the value of the probability density function at x.
 func (b Bernoulli) LogProb(x float64) float64 {
 	if x == 0 {
@@ -78,6 +90,21 @@ func (Bernoulli) NumParameters() int {
 	return 1
 }
 
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in place into p, which must have length
+// NumParameters, and returned.
+func (b Bernoulli) Parameters(p []Parameter) []Parameter {
+	nParam := b.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("bernoulli: improper parameter length")
+	}
+	p[0].Name = "P"
+	p[0].Value = b.P
+	return p
+}
+
 // Prob computes the value of the probability distribution at x.
 func (b Bernoulli) Prob(x float64) float64 {
 	if x == 0 {
@@ -114,6 +141,18 @@ func (b Bernoulli) Rand() float64 {
 	return 0
 }
 
+// SetParameters sets the parameters of the distribution to p. It panics if
+// the length or the names of p do not match those returned by Parameters.
+func (b *Bernoulli) SetParameters(p []Parameter) {
+	if len(p) != 1 {
+		panic("bernoulli: incorrect number of parameters to set")
+	}
+	if p[0].Name != "P" {
+		panic("bernoulli: " + panicNameMismatch)
+	}
+	b.P = p[0].Value
+}
+
 // Skewness returns the skewness of the distribution.
 func (b Bernoulli) Skewness() float64 {
 	return (1 - 2*b.P) / math.Sqrt(b.P*(1-b.P))
@@ -0,0 +1,84 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import "math"
+
+// besselIScaled stores into dst the exponentially scaled modified Bessel
+// functions of the first kind e^{-x} I_k(x) for k = 0, ..., len(dst)-1 and
+// x ≥ 0, and returns dst.
+//
+// The values are computed by Miller's backward recurrence
+//
+//	I_{k-1}(x) = 2k/x I_k(x) + I_{k+1}(x)
+//
+// normalized by the identity e^x = I_0(x) + 2 Σ_{k≥1} I_k(x).
+func besselIScaled(dst []float64, x float64) []float64 {
+	if x < 0 {
+		panic("distuv: negative Bessel function argument")
+	}
+	n := len(dst) - 1
+	if x < 1e-8 {
+		// Use the leading term of the series (x/2)^k/k!.
+		term := 1.0
+		for k := range dst {
+			if k > 0 {
+				term *= x / (2 * float64(k))
+			}
+			dst[k] = term
+		}
+		return dst
+	}
+	const big = 1e250
+	top := float64(max(n, int(x)))
+	m := int(top+30+10*math.Sqrt(top)) + 1
+	for k := range dst {
+		dst[k] = 0
+	}
+	var sum float64
+	next, cur := 0.0, 1e-280
+	for k := m; k > 0; k-- {
+		prev := 2*float64(k)/x*cur + next
+		next, cur = cur, prev
+		// next is now I_k and cur is I_{k-1}.
+		sum += 2 * next
+		if k-1 <= n {
+			dst[k-1] = cur
+		}
+		if cur > big {
+			next /= big
+			cur /= big
+			sum /= big
+			for i := k - 1; i <= n; i++ {
+				dst[i] /= big
+			}
+		}
+	}
+	sum += cur
+	for k := range dst {
+		dst[k] /= sum
+	}
+	return dst
+}
+
+// besselI0Scaled returns e^{-x} I_0(x) for x ≥ 0.
+func besselI0Scaled(x float64) float64 {
+	if x < 700 {
+		var dst [1]float64
+		return besselIScaled(dst[:], x)[0]
+	}
+	// Use the asymptotic expansion
+	//  e^{-x} I_0(x) ~ 1/√(2πx) Σ_k ((2k-1)!!)² / (k! (8x)^k).
+	sum, term := 1.0, 1.0
+	for k := 1; k < 20; k++ {
+		f := float64(2*k - 1)
+		term *= f * f / (float64(k) * 8 * x)
+		sum += term
+		if term < 1e-17*sum {
+			break
+		}
+	}
+	return sum / math.Sqrt(2*math.Pi*x)
+}
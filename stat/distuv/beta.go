@@ -58,15 +58,37 @@ func (b Beta) ExKurtosis() float64 {
 	return num / den
 }
 
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The maximum likelihood estimates are found numerically, starting from
+// the method of moments estimates.
+func (b *Beta) Fit(samples, weights []float64) {
+	checkFit(samples, weights)
+	mean, _ := weightedMean(identity, samples, weights)
+	variance, _ := weightedMean(func(x float64) float64 { return (x - mean) * (x - mean) }, samples, weights)
+	alpha, beta := 1.0, 1.0
+	if c := mean*(1-mean)/variance - 1; c > 0 {
+		alpha, beta = mean*c, (1-mean)*c
+	}
+	p := []float64{math.Log(alpha), math.Log(beta)}
+	maximize(func(p []float64) float64 {
+		return logLikelihood(Beta{Alpha: math.Exp(p[0]), Beta: math.Exp(p[1])}, samples, weights)
+	}, p)
+	b.Alpha, b.Beta = math.Exp(p[0]), math.Exp(p[1])
+}
+
 // LogProb computes the natural logarithm of the value of the probability
-// density function at x.
+// density function at x. It returns NaN if Alpha or Beta is not positive.
 func (b Beta) LogProb(x float64) float64 {
 	if x < 0 || x > 1 {
 		return math.Inf(-1)
 	}
 
 	if b.Alpha <= 0 || b.Beta <= 0 {
-		panic("beta: negative parameters")
+		return math.NaN()
 	}
 
 	lab, _ := math.Lgamma(b.Alpha + b.Beta)
@@ -110,6 +132,23 @@ func (b Beta) NumParameters() int {
 	return 2
 }
 
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in place into p, which must have length
+// NumParameters, and returned.
+func (b Beta) Parameters(p []Parameter) []Parameter {
+	nParam := b.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("beta: improper parameter length")
+	}
+	p[0].Name = "Alpha"
+	p[0].Value = b.Alpha
+	p[1].Name = "Beta"
+	p[1].Value = b.Beta
+	return p
+}
+
 // Prob computes the value of the probability density function at x.
 func (b Beta) Prob(x float64) float64 {
 	return math.Exp(b.LogProb(x))
@@ -130,6 +169,22 @@ func (b Beta) Rand() float64 {
 	return ga / (ga + gb)
 }
 
+// SetParameters sets the parameters of the distribution to p. It panics if
+// the length or the names of p do not match those returned by Parameters.
+func (b *Beta) SetParameters(p []Parameter) {
+	if len(p) != b.NumParameters() {
+		panic("beta: incorrect number of parameters to set")
+	}
+	if p[0].Name != "Alpha" {
+		panic("beta: " + panicNameMismatch)
+	}
+	if p[1].Name != "Beta" {
+		panic("beta: " + panicNameMismatch)
+	}
+	b.Alpha = p[0].Value
+	b.Beta = p[1].Value
+}
+
 // StdDev returns the standard deviation of the probability distribution.
 func (b Beta) StdDev() float64 {
 	return math.Sqrt(b.Variance())
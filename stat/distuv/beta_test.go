@@ -113,8 +113,8 @@ func testBetaBadParams(t *testing.T, alpha float64, beta float64, src rand.Sourc
 	if !panics(func() { b.Entropy() }) {
 		t.Errorf("Entropy did not panic for Beta(%g, %g)", alpha, beta)
 	}
-	if !panics(func() { b.LogProb(0.5) }) {
-		t.Errorf("LogProb did not panic for Beta(%g, %g)", alpha, beta)
+	if lp := b.LogProb(0.5); !math.IsNaN(lp) {
+		t.Errorf("LogProb is not NaN for Beta(%g, %g): got %v", alpha, beta, lp)
 	}
 }
 
@@ -0,0 +1,202 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/mathext"
+	"gonum.org/v1/gonum/stat/combin"
+)
+
+// BetaBinomial implements the beta-binomial distribution, a discrete
+// probability distribution that expresses the number of successes in n
+// Bernoulli trials whose success probability is drawn from a beta
+// distribution with shape parameters α and β.
+// The beta-binomial distribution has the density function:
+//
+//	f(k) = (n choose k) B(k+α, n-k+β) / B(α, β)
+//
+// where B is the beta function.
+//
+// For more information, see https://en.wikipedia.org/wiki/Beta-binomial_distribution.
+type BetaBinomial struct {
+	// N is the total number of Bernoulli trials. N must be a non-negative
+	// integer.
+	N float64
+	// Alpha and Beta are the shape parameters of the distribution of the
+	// success probability. They must be greater than 0.
+	Alpha float64
+	Beta  float64
+
+	Src rand.Source
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (b BetaBinomial) CDF(x float64) float64 {
+	switch {
+	case x < 0:
+		return 0
+	case x >= b.N:
+		return 1
+	}
+	x = math.Floor(x)
+	if x < b.Mean() {
+		return b.sum(0, x)
+	}
+	return 1 - b.sum(x+1, b.N)
+}
+
+// ExKurtosis returns the excess kurtosis of the distribution.
+func (b BetaBinomial) ExKurtosis() float64 {
+	n, a, c := b.N, b.Alpha, b.Beta
+	s := a + c
+	ab := a * c
+	f := s * s * (1 + s) / (n * ab * (s + 2) * (s + 3) * (s + n))
+	return f*(s*(s-1+6*n)+3*ab*(n-2)+6*n*n-3*ab*n*(6-n)/s-18*ab*n*n/(s*s)) - 3
+}
+
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// N is not fitted and must be set before calling Fit. The maximum likelihood
+// estimates of Alpha and Beta are found numerically, starting from the method
+// of moments estimates.
+func (b *BetaBinomial) Fit(samples, weights []float64) {
+	checkFit(samples, weights)
+	mean, _ := weightedMean(identity, samples, weights)
+	variance, _ := weightedMean(func(x float64) float64 { return (x - mean) * (x - mean) }, samples, weights)
+	// The variance is n p (1-p) (s+n)/(s+1) where p = α/s and s = α+β.
+	n := b.N
+	p := mean / n
+	alpha, beta := 1.0, 1.0
+	if v := n * p * (1 - p); p > 0 && p < 1 && variance > v {
+		if s := (n*v - variance) / (variance - v); s > 0 {
+			alpha, beta = p*s, (1-p)*s
+		}
+	}
+	x := []float64{math.Log(alpha), math.Log(beta)}
+	maximize(func(x []float64) float64 {
+		return logLikelihood(BetaBinomial{N: n, Alpha: math.Exp(x[0]), Beta: math.Exp(x[1])}, samples, weights)
+	}, x)
+	b.Alpha, b.Beta = math.Exp(x[0]), math.Exp(x[1])
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (b BetaBinomial) LogProb(x float64) float64 {
+	if x < 0 || x > b.N || math.Floor(x) != x {
+		return math.Inf(-1)
+	}
+	return combin.LogGeneralizedBinomial(b.N, x) + mathext.Lbeta(x+b.Alpha, b.N-x+b.Beta) - mathext.Lbeta(b.Alpha, b.Beta)
+}
+
+// Mean returns the mean of the probability distribution.
+func (b BetaBinomial) Mean() float64 {
+	return b.N * b.Alpha / (b.Alpha + b.Beta)
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (BetaBinomial) NumParameters() int {
+	return 3
+}
+
+// Parameters returns the shape parameters of the distribution, Alpha and
+// Beta. The number of trials N is not included. If p is not nil, the
+// parameters are stored in place into p, which must have length 2, and
+// returned.
+func (b BetaBinomial) Parameters(p []Parameter) []Parameter {
+	nParam := 2
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("betabinomial: improper parameter length")
+	}
+	p[0].Name = "Alpha"
+	p[0].Value = b.Alpha
+	p[1].Name = "Beta"
+	p[1].Value = b.Beta
+	return p
+}
+
+// Prob computes the value of the probability density function at x.
+func (b BetaBinomial) Prob(x float64) float64 {
+	return math.Exp(b.LogProb(x))
+}
+
+// Quantile returns the smallest k such that CDF(k) ≥ p.
+// Quantile panics if p is not in the interval [0, 1].
+func (b BetaBinomial) Quantile(p float64) float64 {
+	return discreteQuantile(b.CDF, p, 0, b.N, b.Mean())
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (b BetaBinomial) Rand() float64 {
+	p := Beta{Alpha: b.Alpha, Beta: b.Beta, Src: b.Src}.Rand()
+	return Binomial{N: b.N, P: p, Src: b.Src}.Rand()
+}
+
+// SetParameters sets the parameters of the distribution to p. It panics if
+// the length or the names of p do not match those returned by Parameters.
+func (b *BetaBinomial) SetParameters(p []Parameter) {
+	if len(p) != 2 {
+		panic("betabinomial: incorrect number of parameters to set")
+	}
+	if p[0].Name != "Alpha" {
+		panic("betabinomial: " + panicNameMismatch)
+	}
+	if p[1].Name != "Beta" {
+		panic("betabinomial: " + panicNameMismatch)
+	}
+	b.Alpha = p[0].Value
+	b.Beta = p[1].Value
+}
+
+// Skewness returns the skewness of the distribution.
+func (b BetaBinomial) Skewness() float64 {
+	n, a, c := b.N, b.Alpha, b.Beta
+	s := a + c
+	return (s + 2*n) * (c - a) / (s + 2) * math.Sqrt((1+s)/(n*a*c*(n+s)))
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (b BetaBinomial) StdDev() float64 {
+	return math.Sqrt(b.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (b BetaBinomial) Survival(x float64) float64 {
+	switch {
+	case x < 0:
+		return 1
+	case x >= b.N:
+		return 0
+	}
+	x = math.Floor(x)
+	if x < b.Mean() {
+		return 1 - b.sum(0, x)
+	}
+	return b.sum(x+1, b.N)
+}
+
+// Variance returns the variance of the probability distribution.
+func (b BetaBinomial) Variance() float64 {
+	n, a, c := b.N, b.Alpha, b.Beta
+	s := a + c
+	return n * a * c * (s + n) / (s * s * (s + 1))
+}
+
+// sum returns the sum of the probabilities of the integers in [lo, hi].
+func (b BetaBinomial) sum(lo, hi float64) float64 {
+	var s float64
+	for k := lo; k <= hi; k++ {
+		s += b.Prob(k)
+	}
+	return s
+}
@@ -0,0 +1,79 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestBetaBinomialProbCDF(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		x, wantProb, wantCDF float64
+	}{
+		// Values calculated from the beta functions in exact rational
+		// arithmetic.
+		{0, 0.06593406593406594, 0.06593406593406594},
+		{3, 0.14385614385614387, 0.45454545454545453},
+		{7, 0.07992007992007992, 0.9050949050949051},
+		{10, 0.01098901098901099, 1},
+		{3.5, 0, 0.45454545454545453},
+		{-1, 0, 0},
+	} {
+		b := BetaBinomial{N: 10, Alpha: 2, Beta: 3}
+		if got := b.Prob(test.x); !scalar.EqualWithinAbsOrRel(got, test.wantProb, 1e-13, 1e-13) {
+			t.Errorf("Prob mismatch, x = %v: got %v, want %v", test.x, got, test.wantProb)
+		}
+		if got := b.CDF(test.x); !scalar.EqualWithinAbsOrRel(got, test.wantCDF, 1e-13, 1e-13) {
+			t.Errorf("CDF mismatch, x = %v: got %v, want %v", test.x, got, test.wantCDF)
+		}
+	}
+
+	// With α = β = 1 the distribution is uniform.
+	b := BetaBinomial{N: 7, Alpha: 1, Beta: 1}
+	for k := 0.0; k <= 7; k++ {
+		if got := b.Prob(k); !scalar.EqualWithinAbsOrRel(got, 1.0/8, 1e-14, 1e-14) {
+			t.Errorf("Prob mismatch for uniform case at %v: got %v, want 1/8", k, got)
+		}
+	}
+}
+
+func TestBetaBinomial(t *testing.T) {
+	t.Parallel()
+	src := rand.New(rand.NewSource(1))
+	for i, b := range []BetaBinomial{
+		{10, 2, 3, src},
+		{25, 0.5, 0.5, src},
+		{40, 10, 2, src},
+	} {
+		testBetaBinomial(t, b, i)
+	}
+}
+
+func testBetaBinomial(t *testing.T, b BetaBinomial, i int) {
+	const (
+		tol = 2e-2
+		n   = 2e5
+	)
+	x := make([]float64, n)
+	generateSamples(x, b)
+	sort.Float64s(x)
+
+	checkProbDiscrete(t, i, x, b, 5e-3)
+	checkMean(t, i, x, b, tol)
+	checkVarAndStd(t, i, x, b, tol)
+
+	checkCDFDiscrete(t, i, 0, b.N, b, 1e-12)
+	checkMomentsDiscrete(t, i, 0, b.N, b, 1e-8)
+
+	if b.NumParameters() != 3 {
+		t.Errorf("Mismatch in NumParameters: got %v, want 3", b.NumParameters())
+	}
+}
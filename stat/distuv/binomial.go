@@ -48,6 +48,20 @@ func (b Binomial) ExKurtosis() float64 {
 	return (1 - 6*v) / (b.N * v)
 }
 
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// N is not fitted and must be set before calling Fit. The maximum
+// likelihood estimate of P is the weighted mean of the samples divided
+// by N.
+func (b *Binomial) Fit(samples, weights []float64) {
+	checkFit(samples, weights)
+	mean, _ := weightedMean(identity, samples, weights)
+	b.P = mean / b.N
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x.
 func (b Binomial) LogProb(x float64) float64 {
@@ -68,6 +82,22 @@ func (Binomial) NumParameters() int {
 	return 2
 }
 
+// Parameters returns the success probability of the distribution, P. The
+// number of trials N is not included, since Fit does not estimate it. If p
+// is not nil, the parameter is stored in place into p, which must have
+// length 1, and returned.
+func (b Binomial) Parameters(p []Parameter) []Parameter {
+	nParam := 1
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("binomial: improper parameter length")
+	}
+	p[0].Name = "P"
+	p[0].Value = b.P
+	return p
+}
+
 // Prob computes the value of the probability density function at x.
 func (b Binomial) Prob(x float64) float64 {
 	return math.Exp(b.LogProb(x))
@@ -169,6 +199,18 @@ func (b Binomial) Rand() float64 {
 	}
 }
 
+// SetParameters sets the parameters of the distribution to p. It panics if
+// the length or the names of p do not match those returned by Parameters.
+func (b *Binomial) SetParameters(p []Parameter) {
+	if len(p) != 1 {
+		panic("binomial: incorrect number of parameters to set")
+	}
+	if p[0].Name != "P" {
+		panic("binomial: " + panicNameMismatch)
+	}
+	b.P = p[0].Value
+}
+
 // Skewness returns the skewness of the distribution.
 func (b Binomial) Skewness() float64 {
 	return (1 - 2*b.P) / b.StdDev()
@@ -151,3 +151,24 @@ func testBinomial(t *testing.T, b Binomial, i int) {
 		t.Errorf("Wrong number of parameters")
 	}
 }
+
+func TestBinomialParameters(t *testing.T) {
+	t.Parallel()
+	want := Binomial{N: 10, P: 0.3}
+	p := want.Parameters(nil)
+	if len(p) != 1 || p[0].Name != "P" {
+		t.Fatalf("unexpected parameters: got %v, want only P", p)
+	}
+	got := Binomial{N: 10}
+	got.SetParameters(p)
+	if got != want {
+		t.Errorf("unexpected parameters after round trip: got %+v, want %+v", got, want)
+	}
+	p[0].Name = "N"
+	if !panics(func() { got.SetParameters(p) }) {
+		t.Error("expected panic for mismatched parameter name")
+	}
+	if !panics(func() { got.Parameters(make([]Parameter, 2)) }) {
+		t.Error("expected panic for wrong parameter slice length")
+	}
+}
@@ -74,6 +74,27 @@ func (c Categorical) Entropy() float64 {
 	return -ent
 }
 
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The maximum likelihood estimates of the probabilities are proportional to
+// the weighted counts of the categories. Fit panics if a sample is not one
+// of the categories {0, 1, ..., c.Len()-1}.
+func (c *Categorical) Fit(samples, weights []float64) {
+	checkFit(samples, weights)
+	w := make([]float64, len(c.weights))
+	for i, x := range samples {
+		k := int(x)
+		if float64(k) != x || k < 0 || k >= len(w) {
+			panic("categorical: sample out of range")
+		}
+		w[k] += sampleWeight(weights, i)
+	}
+	c.ReweightAll(w)
+}
+
 // Len returns the number of values x could possibly take (the length of the
 // initial supplied weight vector).
 func (c Categorical) Len() int {
@@ -0,0 +1,182 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+)
+
+// Cauchy implements the Cauchy distribution, a continuous probability
+// distribution with location μ and scale γ. The mean, variance and higher
+// moments of the distribution are undefined.
+// The Cauchy distribution has density function:
+//
+//	f(x) = 1 / (π γ (1 + ((x-μ)/γ)^2))
+//
+// For more information, see https://en.wikipedia.org/wiki/Cauchy_distribution.
+type Cauchy struct {
+	// Mu is the location of the distribution.
+	Mu float64
+	// Scale is the half width at half maximum of the distribution.
+	// Scale must be greater than 0.
+	Scale float64
+
+	Src rand.Source
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (c Cauchy) CDF(x float64) float64 {
+	return 0.5 - math.Atan((c.Mu-x)/c.Scale)/math.Pi
+}
+
+// Entropy returns the entropy of the distribution.
+func (c Cauchy) Entropy() float64 {
+	return math.Log(4 * math.Pi * c.Scale)
+}
+
+// ExKurtosis returns the excess kurtosis of the distribution, which is
+// undefined and returned as NaN.
+func (Cauchy) ExKurtosis() float64 {
+	return math.NaN()
+}
+
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The maximum likelihood estimates are found numerically, starting from
+// the weighted median and half the weighted interquartile range.
+func (c *Cauchy) Fit(samples, weights []float64) {
+	checkFit(samples, weights)
+	mu := weightedMedian(samples, weights)
+	dev := make([]float64, len(samples))
+	for i, x := range samples {
+		dev[i] = math.Abs(x - mu)
+	}
+	scale := weightedMedian(dev, weights)
+	if scale == 0 {
+		scale = 1
+	}
+	p := []float64{mu, math.Log(scale)}
+	maximize(func(p []float64) float64 {
+		return logLikelihood(Cauchy{Mu: p[0], Scale: math.Exp(p[1])}, samples, weights)
+	}, p)
+	c.Mu, c.Scale = p[0], math.Exp(p[1])
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (c Cauchy) LogProb(x float64) float64 {
+	z := (x - c.Mu) / c.Scale
+	return -logPi - math.Log(c.Scale) - math.Log1p(z*z)
+}
+
+// Mean returns the mean of the probability distribution, which is undefined
+// and returned as NaN.
+func (Cauchy) Mean() float64 {
+	return math.NaN()
+}
+
+// Median returns the median of the probability distribution.
+func (c Cauchy) Median() float64 {
+	return c.Mu
+}
+
+// Mode returns the mode of the probability distribution.
+func (c Cauchy) Mode() float64 {
+	return c.Mu
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (Cauchy) NumParameters() int {
+	return 2
+}
+
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in place into p, which must have length
+// NumParameters, and returned.
+func (c Cauchy) Parameters(p []Parameter) []Parameter {
+	nParam := c.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("cauchy: improper parameter length")
+	}
+	p[0].Name = "Mu"
+	p[0].Value = c.Mu
+	p[1].Name = "Scale"
+	p[1].Value = c.Scale
+	return p
+}
+
+// Prob computes the value of the probability density function at x.
+func (c Cauchy) Prob(x float64) float64 {
+	return math.Exp(c.LogProb(x))
+}
+
+// Quantile returns the inverse of the cumulative distribution function.
+func (c Cauchy) Quantile(p float64) float64 {
+	if p < 0 || p > 1 {
+		panic(badPercentile)
+	}
+	switch p {
+	case 0:
+		return math.Inf(-1)
+	case 1:
+		return math.Inf(1)
+	}
+	return c.Mu + c.Scale*math.Tan(math.Pi*(p-0.5))
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (c Cauchy) Rand() float64 {
+	rnd := rand.NormFloat64
+	if c.Src != nil {
+		rnd = rand.New(c.Src).NormFloat64
+	}
+	return c.Mu + c.Scale*rnd()/rnd()
+}
+
+// SetParameters sets the parameters of the distribution to p. It panics if
+// the length or the names of p do not match those returned by Parameters.
+func (c *Cauchy) SetParameters(p []Parameter) {
+	if len(p) != c.NumParameters() {
+		panic("cauchy: incorrect number of parameters to set")
+	}
+	if p[0].Name != "Mu" {
+		panic("cauchy: " + panicNameMismatch)
+	}
+	if p[1].Name != "Scale" {
+		panic("cauchy: " + panicNameMismatch)
+	}
+	c.Mu = p[0].Value
+	c.Scale = p[1].Value
+}
+
+// Skewness returns the skewness of the distribution, which is undefined
+// and returned as NaN.
+func (Cauchy) Skewness() float64 {
+	return math.NaN()
+}
+
+// StdDev returns the standard deviation of the probability distribution,
+// which is undefined and returned as NaN.
+func (Cauchy) StdDev() float64 {
+	return math.NaN()
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (c Cauchy) Survival(x float64) float64 {
+	return 0.5 - math.Atan((x-c.Mu)/c.Scale)/math.Pi
+}
+
+// Variance returns the variance of the probability distribution, which is
+// undefined and returned as NaN.
+func (Cauchy) Variance() float64 {
+	return math.NaN()
+}
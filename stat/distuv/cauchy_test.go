@@ -0,0 +1,87 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestCauchyProbCDF(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		mu, scale float64
+	}{
+		{0, 1},
+		{-3, 0.5},
+		{10, 4},
+	} {
+		// The Cauchy distribution is Student's t distribution with
+		// one degree of freedom.
+		c := Cauchy{Mu: test.mu, Scale: test.scale}
+		s := StudentsT{Mu: test.mu, Sigma: test.scale, Nu: 1}
+		for _, z := range []float64{-100, -3, -0.5, 0, 0.2, 1, 7} {
+			x := test.mu + z*test.scale
+			if got, want := c.Prob(x), s.Prob(x); !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+				t.Errorf("Prob mismatch, x = %v, mu = %v, scale = %v: got %v, want %v", x, test.mu, test.scale, got, want)
+			}
+			if got, want := c.CDF(x), s.CDF(x); !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+				t.Errorf("CDF mismatch, x = %v, mu = %v, scale = %v: got %v, want %v", x, test.mu, test.scale, got, want)
+			}
+		}
+		if got, want := c.Prob(test.mu), 1/(math.Pi*test.scale); !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+			t.Errorf("Prob mismatch at mode: got %v, want %v", got, want)
+		}
+	}
+}
+
+func TestCauchy(t *testing.T) {
+	t.Parallel()
+	src := rand.New(rand.NewSource(1))
+	for i, c := range []Cauchy{
+		{0, 1, src},
+		{-3, 0.5, src},
+		{10, 4, src},
+	} {
+		testCauchy(t, c, i)
+	}
+}
+
+func testCauchy(t *testing.T, c Cauchy, i int) {
+	const (
+		tol  = 1e-2
+		n    = 2e5
+		bins = 50
+	)
+	x := make([]float64, n)
+	generateSamples(x, c)
+	sort.Float64s(x)
+
+	testRandLogProbContinuous(t, i, math.Inf(-1), x, c, tol, bins)
+	checkProbContinuous(t, i, x, math.Inf(-1), math.Inf(1), c, 1e-6)
+	checkMedian(t, i, x, c, tol)
+	checkQuantileCDFSurvival(t, i, x, c, tol)
+
+	// The entropy is the mean negative log probability, whose sample mean
+	// converges because the tails of the log probability are logarithmic.
+	checkEntropy(t, i, x, c, tol)
+
+	for _, v := range []float64{c.Mean(), c.Variance(), c.StdDev(), c.Skewness(), c.ExKurtosis()} {
+		if !math.IsNaN(v) {
+			t.Errorf("Undefined moment case %v is not NaN: got %v", i, v)
+		}
+	}
+	if c.Mode() != c.Mu {
+		t.Errorf("Mismatch in Mode: got %v, want %v", c.Mode(), c.Mu)
+	}
+	if c.NumParameters() != 2 {
+		t.Errorf("Mismatch in NumParameters: got %v, want 2", c.NumParameters())
+	}
+}
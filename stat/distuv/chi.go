@@ -46,6 +46,24 @@ func (c Chi) ExKurtosis() float64 {
 	return 2 / v * (1 - c.Mean()*s*c.Skewness() - v)
 }
 
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The maximum likelihood estimate of K is the root of
+//
+//	ψ(K/2) = 2 mean(log(x)) - log(2),
+//
+// where ψ is the digamma function and the mean is weighted.
+func (c *Chi) Fit(samples, weights []float64) {
+	checkFit(samples, weights)
+	meanLog, _ := weightedMean(math.Log, samples, weights)
+	c.K = findRoot(func(k float64) float64 {
+		return mathext.Digamma(k/2) - 2*meanLog + math.Ln2
+	}, 1)
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x.
 func (c Chi) LogProb(x float64) float64 {
@@ -80,6 +98,21 @@ func (c Chi) NumParameters() int {
 	return 1
 }
 
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in place into p, which must have length
+// NumParameters, and returned.
+func (c Chi) Parameters(p []Parameter) []Parameter {
+	nParam := c.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("chi: improper parameter length")
+	}
+	p[0].Name = "K"
+	p[0].Value = c.K
+	return p
+}
+
 // Prob computes the value of the probability density function at x.
 func (c Chi) Prob(x float64) float64 {
 	return math.Exp(c.LogProb(x))
@@ -98,6 +131,18 @@ func (c Chi) Quantile(p float64) float64 {
 	return math.Sqrt(2 * mathext.GammaIncRegInv(0.5*c.K, p))
 }
 
+// SetParameters sets the parameters of the distribution to p. It panics if
+// the length or the names of p do not match those returned by Parameters.
+func (c *Chi) SetParameters(p []Parameter) {
+	if len(p) != c.NumParameters() {
+		panic("chi: incorrect number of parameters to set")
+	}
+	if p[0].Name != "K" {
+		panic("chi: " + panicNameMismatch)
+	}
+	c.K = p[0].Value
+}
+
 // Skewness returns the skewness of the distribution.
 func (c Chi) Skewness() float64 {
 	v := c.Variance()
@@ -40,6 +40,24 @@ func (c ChiSquared) ExKurtosis() float64 {
 	return 12 / c.K
 }
 
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The maximum likelihood estimate of K is the root of
+//
+//	ψ(K/2) = mean(log(x)) - log(2),
+//
+// where ψ is the digamma function and the mean is weighted.
+func (c *ChiSquared) Fit(samples, weights []float64) {
+	checkFit(samples, weights)
+	meanLog, _ := weightedMean(math.Log, samples, weights)
+	c.K = findRoot(func(k float64) float64 {
+		return mathext.Digamma(k/2) - meanLog + math.Ln2
+	}, 1)
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x.
 func (c ChiSquared) LogProb(x float64) float64 {
@@ -65,6 +83,21 @@ func (c ChiSquared) NumParameters() int {
 	return 1
 }
 
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in place into p, which must have length
+// NumParameters, and returned.
+func (c ChiSquared) Parameters(p []Parameter) []Parameter {
+	nParam := c.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("chisquared: improper parameter length")
+	}
+	p[0].Name = "K"
+	p[0].Value = c.K
+	return p
+}
+
 // Prob computes the value of the probability density function at x.
 func (c ChiSquared) Prob(x float64) float64 {
 	return math.Exp(c.LogProb(x))
@@ -83,6 +116,18 @@ func (c ChiSquared) Quantile(p float64) float64 {
 	return mathext.GammaIncRegInv(0.5*c.K, p) * 2
 }
 
+// SetParameters sets the parameters of the distribution to p. It panics if
+// the length or the names of p do not match those returned by Parameters.
+func (c *ChiSquared) SetParameters(p []Parameter) {
+	if len(p) != c.NumParameters() {
+		panic("chisquared: incorrect number of parameters to set")
+	}
+	if p[0].Name != "K" {
+		panic("chisquared: " + panicNameMismatch)
+	}
+	c.K = p[0].Value
+}
+
 // StdDev returns the standard deviation of the probability distribution.
 func (c ChiSquared) StdDev() float64 {
 	return math.Sqrt(c.Variance())
@@ -0,0 +1,31 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import "math"
+
+// circularMoments returns the second and fourth moments about the mean of a
+// symmetric circular distribution on [μ-π, μ+π] with the trigonometric
+// moments r(n) = E[cos(n(θ-μ))], which must decrease to zero. The moments
+// follow from integrating the Fourier series of the density
+//
+//	f(θ) = 1/(2π) (1 + 2 Σ_{n≥1} r(n) cos(n(θ-μ))).
+func circularMoments(r func(n int) float64) (m2, m4 float64) {
+	const pi2 = math.Pi * math.Pi
+	m2 = pi2 / 3
+	m4 = pi2 * pi2 / 5
+	sign := -1.0
+	for n := 1; ; n++ {
+		rn := r(n)
+		if rn < 1e-18 {
+			break
+		}
+		n2 := float64(n * n)
+		m2 += 4 * sign * rn / n2
+		m4 += sign * rn * (8*pi2/n2 - 48/(n2*n2))
+		sign = -sign
+	}
+	return m2, m4
+}
@@ -263,6 +263,91 @@ func checkProbDiscrete(t *testing.T, cas int, xs []float64, p probLogprober, tol
 	}
 }
 
+type discreteCumulanter interface {
+	cumulanter
+	Prob(x float64) float64
+}
+
+// checkCDFDiscrete checks that CDF, Survival and Quantile are consistent
+// with Prob for a discrete distribution on the integers whose probability
+// mass outside [lo, hi] is negligible.
+func checkCDFDiscrete(t *testing.T, cas int, lo, hi float64, d discreteCumulanter, tol float64) {
+	t.Helper()
+	if cdf := d.CDF(lo - 1); cdf > tol {
+		t.Errorf("CDF below lower bound case %v: got %v, want 0", cas, cdf)
+	}
+	var sum, prev float64
+	for k := lo; k <= hi; k++ {
+		p := d.Prob(k)
+		sum += p
+		cdf := d.CDF(k)
+		if !scalar.EqualWithinAbsOrRel(cdf, sum, tol, tol) {
+			t.Errorf("CDF mismatch case %v at %v: want %v, got %v", cas, k, sum, cdf)
+		}
+		if d.CDF(k+0.5) != cdf {
+			t.Errorf("CDF not constant between integers case %v at %v", cas, k)
+		}
+		if math.Abs(1-cdf-d.Survival(k)) > tol {
+			t.Errorf("Survival/CDF mismatch case %v at %v: want %v, got %v", cas, k, 1-cdf, d.Survival(k))
+		}
+		if p > 1e-10 {
+			if q := d.Quantile(cdf); q != k {
+				t.Errorf("Quantile mismatch case %v at CDF(%v): got %v", cas, k, q)
+			}
+			if q := d.Quantile(prev + p/2); q != k {
+				t.Errorf("Quantile mismatch case %v inside step at %v: got %v", cas, k, q)
+			}
+		}
+		prev = cdf
+	}
+	if !panics(func() { d.Quantile(-0.0001) }) {
+		t.Errorf("Expected panic with negative argument to Quantile")
+	}
+	if !panics(func() { d.Quantile(1.0001) }) {
+		t.Errorf("Expected panic with Quantile argument above 1")
+	}
+}
+
+type discreteMomenter interface {
+	Prob(x float64) float64
+	Mean() float64
+	Variance() float64
+	Skewness() float64
+	ExKurtosis() float64
+}
+
+// checkMomentsDiscrete checks the moments of a discrete distribution against
+// the moments computed from Prob on the integers in [lo, hi], outside of
+// which the probability mass must be negligible.
+func checkMomentsDiscrete(t *testing.T, cas int, lo, hi float64, d discreteMomenter, tol float64) {
+	t.Helper()
+	var mean float64
+	for k := lo; k <= hi; k++ {
+		mean += k * d.Prob(k)
+	}
+	var m2, m3, m4 float64
+	for k := lo; k <= hi; k++ {
+		p := d.Prob(k)
+		dk := k - mean
+		m2 += dk * dk * p
+		m3 += dk * dk * dk * p
+		m4 += dk * dk * dk * dk * p
+	}
+	for _, test := range []struct {
+		name      string
+		got, want float64
+	}{
+		{"Mean", d.Mean(), mean},
+		{"Variance", d.Variance(), m2},
+		{"Skewness", d.Skewness(), m3 / math.Pow(m2, 1.5)},
+		{"ExKurtosis", d.ExKurtosis(), m4/(m2*m2) - 3},
+	} {
+		if !scalar.EqualWithinAbsOrRel(test.got, test.want, tol, tol) {
+			t.Errorf("%s mismatch case %v: want %v, got %v", test.name, cas, test.want, test.got)
+		}
+	}
+}
+
 // testRandLogProb tests that LogProb and Rand give consistent results. This
 // can be used when the distribution does not implement CDF.
 func testRandLogProbContinuous(t *testing.T, cas int, min float64, x []float64, f LogProber, tol float64, bins int) {
@@ -237,8 +237,9 @@ func (e Exponential) Survival(x float64) float64 {
 	return math.Exp(-e.Rate * x)
 }
 
-// setParameters modifies the parameters of the distribution.
-func (e *Exponential) setParameters(p []Parameter) {
+// SetParameters sets the parameters of the distribution to p. It panics if
+// the length or the names of p do not match those returned by Parameters.
+func (e *Exponential) SetParameters(p []Parameter) {
 	if len(p) != e.NumParameters() {
 		panic("exponential: incorrect number of parameters to set")
 	}
@@ -253,8 +254,10 @@ func (e Exponential) Variance() float64 {
 	return 1 / (e.Rate * e.Rate)
 }
 
-// parameters returns the parameters of the distribution.
-func (e Exponential) parameters(p []Parameter) []Parameter {
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in place into p, which must have length
+// NumParameters, and returned.
+func (e Exponential) Parameters(p []Parameter) []Parameter {
 	nParam := e.NumParameters()
 	if p == nil {
 		p = make([]Parameter, nParam)
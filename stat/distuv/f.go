@@ -43,6 +43,33 @@ func (f F) ExKurtosis() float64 {
 	return (12 / (f.D2 - 6)) * ((5*f.D2-22)/(f.D2-8) + ((f.D2-4)/f.D1)*((f.D2-2)/(f.D2-8))*((f.D2-2)/(f.D1+f.D2-2)))
 }
 
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The maximum likelihood estimates are found numerically, starting from
+// the method of moments estimates where they exist.
+func (f *F) Fit(samples, weights []float64) {
+	checkFit(samples, weights)
+	mean, _ := weightedMean(identity, samples, weights)
+	variance, _ := weightedMean(func(x float64) float64 { return (x - mean) * (x - mean) }, samples, weights)
+	d1, d2 := 5.0, 10.0
+	if mean > 1 {
+		d2 = 2 * mean / (mean - 1)
+		if d2 > 4 {
+			if d := variance*(d2-2)*(d2-2)*(d2-4) - 2*d2*d2; d > 0 {
+				d1 = 2 * d2 * d2 * (d2 - 2) / d
+			}
+		}
+	}
+	p := []float64{math.Log(d1), math.Log(d2)}
+	maximize(func(p []float64) float64 {
+		return logLikelihood(F{D1: math.Exp(p[0]), D2: math.Exp(p[1])}, samples, weights)
+	}, p)
+	f.D1, f.D2 = math.Exp(p[0]), math.Exp(p[1])
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x.
 func (f F) LogProb(x float64) float64 {
@@ -74,6 +101,23 @@ func (f F) NumParameters() int {
 	return 2
 }
 
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in place into p, which must have length
+// NumParameters, and returned.
+func (f F) Parameters(p []Parameter) []Parameter {
+	nParam := f.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("f: improper parameter length")
+	}
+	p[0].Name = "D1"
+	p[0].Value = f.D1
+	p[1].Name = "D2"
+	p[1].Value = f.D2
+	return p
+}
+
 // Prob computes the value of the probability density function at x.
 func (f F) Prob(x float64) float64 {
 	return math.Exp(f.LogProb(x))
@@ -95,6 +139,22 @@ func (f F) Rand() float64 {
 	return (u1 / f.D1) / (u2 / f.D2)
 }
 
+// SetParameters sets the parameters of the distribution to p. It panics if
+// the length or the names of p do not match those returned by Parameters.
+func (f *F) SetParameters(p []Parameter) {
+	if len(p) != f.NumParameters() {
+		panic("f: incorrect number of parameters to set")
+	}
+	if p[0].Name != "D1" {
+		panic("f: " + panicNameMismatch)
+	}
+	if p[1].Name != "D2" {
+		panic("f: " + panicNameMismatch)
+	}
+	f.D1 = p[0].Value
+	f.D2 = p[1].Value
+}
+
 // Skewness returns the skewness of the distribution.
 //
 // Skewness returns NaN if the D2 parameter is less than or equal to 6.
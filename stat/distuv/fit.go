@@ -0,0 +1,303 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat"
+)
+
+var (
+	// ErrNotConverged is returned by FitMLE when the maximization of the
+	// likelihood does not converge.
+	ErrNotConverged = errors.New("distuv: maximum likelihood estimation did not converge")
+
+	// ErrZeroLikelihood is returned by FitMLE when the likelihood of the
+	// initial parameters is zero.
+	ErrZeroLikelihood = errors.New("distuv: zero likelihood at initial parameters")
+)
+
+// ParameterLogProber is a distribution with a log probability and a vector
+// of named parameters that can be read and set. It is implemented by most
+// of the distributions of this package that have continuous parameters.
+type ParameterLogProber interface {
+	LogProber
+
+	// Parameters returns the parameters of the distribution. If p is not
+	// nil, the parameters are stored in place into p, which is returned.
+	Parameters(p []Parameter) []Parameter
+
+	// SetParameters sets the parameters of the distribution to p. It
+	// must accept any finite values, with LogProb returning -Inf or NaN
+	// for invalid parameters.
+	SetParameters(p []Parameter)
+}
+
+// FitMLE sets the parameters of dist to their maximum likelihood estimates
+// from the samples with the given weights, and returns the standard errors
+// of the estimates. If weights is nil, then all the weights are 1. If
+// weights is not nil, then len(weights) must equal len(samples).
+//
+// The log-likelihood Σ weights[i]·LogProb(samples[i]) is maximized by a
+// damped Newton method using finite-difference derivatives, starting from
+// the current parameters of dist. The standard errors are the square roots
+// of the diagonal of the inverse of the observed Fisher information, the
+// negative Hessian of the log-likelihood at the estimates, treating the
+// weights as frequencies. They are NaN if the observed information is not
+// positive definite.
+//
+// The standard errors of the estimates of the Fit method of a distribution
+// of this package are given by calling FitMLE after Fit, which leaves the
+// estimates unchanged:
+//
+//	var g distuv.Gamma
+//	g.Fit(x, weights)
+//	stdErr, err := distuv.FitMLE(&g, x, weights)
+//
+// This holds for all the distributions with a Fit method except AlphaStable,
+// which has no LogProb, and Categorical, whose probabilities are constrained
+// to sum to one. The likelihood is not differentiable in parameters that
+// bound the support of the distribution, such as Xm of Pareto and those of
+// Triangle and Uniform, so for these distributions FitMLE returns
+// ErrNotConverged and the standard errors of such parameters are NaN or not
+// meaningful.
+//
+// If the log-likelihood of the initial parameters is not finite, FitMLE
+// returns ErrZeroLikelihood and leaves dist unchanged. If the maximization
+// does not converge, FitMLE sets the parameters to the best estimates found
+// and returns them with ErrNotConverged.
+func FitMLE(dist ParameterLogProber, samples, weights []float64) (stdErr []float64, err error) {
+	checkFit(samples, weights)
+	p := dist.Parameters(nil)
+	init := make([]float64, len(p))
+	// Maximize over parameters scaled by their initial magnitudes so that
+	// the finite-difference steps are relative.
+	scale := make([]float64, len(p))
+	for i, v := range p {
+		init[i] = v.Value
+		scale[i] = math.Abs(v.Value)
+		if scale[i] == 0 {
+			scale[i] = 1
+		}
+	}
+	loglik := func(u []float64) float64 {
+		for i := range p {
+			p[i].Value = u[i] * scale[i]
+		}
+		dist.SetParameters(p)
+		return logLikelihood(dist, samples, weights)
+	}
+	u := make([]float64, len(p))
+	for i := range u {
+		u[i] = init[i] / scale[i]
+	}
+	if l := loglik(u); math.IsNaN(l) || math.IsInf(l, 0) {
+		for i := range p {
+			p[i].Value = init[i]
+		}
+		dist.SetParameters(p)
+		return nil, ErrZeroLikelihood
+	}
+
+	hess, ok := maximize(loglik, u)
+	loglik(u)
+
+	stdErr = make([]float64, len(p))
+	var chol mat.Cholesky
+	hess.ScaleSym(-1, hess)
+	if chol.Factorize(hess) {
+		var cov mat.SymDense
+		err := chol.InverseTo(&cov)
+		if err == nil || isConditionError(err) {
+			for i := range stdErr {
+				stdErr[i] = scale[i] * math.Sqrt(cov.At(i, i))
+			}
+		}
+	}
+	for i, v := range stdErr {
+		if v == 0 {
+			stdErr[i] = math.NaN()
+		}
+	}
+	if !ok {
+		return stdErr, ErrNotConverged
+	}
+	return stdErr, nil
+}
+
+// logLikelihood returns the weighted log-likelihood of the samples under
+// dist.
+func logLikelihood(dist LogProber, samples, weights []float64) float64 {
+	var l float64
+	for i, x := range samples {
+		w := sampleWeight(weights, i)
+		if w == 0 {
+			continue
+		}
+		l += w * dist.LogProb(x)
+	}
+	return l
+}
+
+// maximize maximizes f starting from x, which is updated in place to the
+// best point found, by Newton's method with Levenberg–Marquardt damping and
+// central finite-difference derivatives. It returns the Hessian of f at x
+// and whether the maximization converged.
+func maximize(f func([]float64) float64, x []float64) (hess *mat.SymDense, converged bool) {
+	const (
+		maxIter   = 200
+		maxDamp   = 40
+		gradTol   = 1e-8
+		changeTol = 1e-14
+	)
+	n := len(x)
+	settings := &fd.Settings{Formula: fd.Central}
+	grad := make([]float64, n)
+	hess = mat.NewSymDense(n, nil)
+	neg := mat.NewSymDense(n, nil)
+	step := mat.NewVecDense(n, nil)
+	next := make([]float64, n)
+	var chol mat.Cholesky
+
+	fx := f(x)
+	for iter := 0; iter < maxIter; iter++ {
+		fd.Gradient(grad, f, x, settings)
+		fd.Hessian(hess, f, x, settings)
+		var gmax float64
+		for _, g := range grad {
+			gmax = math.Max(gmax, math.Abs(g))
+		}
+		if gmax <= gradTol*(1+math.Abs(fx)) {
+			return hess, true
+		}
+
+		// Find the smallest damping of the Newton step in powers of ten
+		// that increases f.
+		var damp, maxDiag float64
+		for i := 0; i < n; i++ {
+			maxDiag = math.Max(maxDiag, math.Abs(hess.At(i, i)))
+		}
+		improved := false
+		for k := 0; k < maxDamp; k++ {
+			neg.ScaleSym(-1, hess)
+			for i := 0; i < n; i++ {
+				neg.SetSym(i, i, neg.At(i, i)+damp)
+			}
+			if chol.Factorize(neg) {
+				err := chol.SolveVecTo(step, mat.NewVecDense(n, grad))
+				if err == nil || isConditionError(err) {
+					for i := range next {
+						next[i] = x[i] + step.AtVec(i)
+					}
+					fn := f(next)
+					if fn > fx {
+						change := fn - fx
+						copy(x, next)
+						fx = fn
+						improved = true
+						if change <= changeTol*(1+math.Abs(fx)) {
+							fd.Hessian(hess, f, x, settings)
+							return hess, true
+						}
+						break
+					}
+				}
+			}
+			if damp == 0 {
+				damp = 1e-6 * math.Max(maxDiag, 1)
+			} else {
+				damp *= 10
+			}
+		}
+		if !improved {
+			fd.Hessian(hess, f, x, settings)
+			return hess, false
+		}
+	}
+	fd.Hessian(hess, f, x, settings)
+	return hess, false
+}
+
+// findRoot returns the root of the increasing function f of a positive
+// variable, searching outwards from x by bisection on a logarithmic scale.
+func findRoot(f func(float64) float64, x float64) float64 {
+	lo, hi := x, x
+	for i := 0; f(lo) > 0 && i < 2000; i++ {
+		lo /= 2
+	}
+	for i := 0; f(hi) < 0 && i < 2000; i++ {
+		hi *= 2
+	}
+	for i := 0; i < 200 && hi-lo > 4e-16*hi; i++ {
+		mid := math.Sqrt(lo * hi)
+		if mid <= lo || mid >= hi {
+			mid = lo + (hi-lo)/2
+		}
+		if f(mid) < 0 {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return lo + (hi-lo)/2
+}
+
+// checkFit panics if samples is empty or if weights is not nil and its
+// length differs from that of samples.
+func checkFit(samples, weights []float64) {
+	if weights != nil && len(samples) != len(weights) {
+		panic(badLength)
+	}
+	if len(samples) == 0 {
+		panic(errNoSamples)
+	}
+}
+
+// sampleWeight returns weights[i], or 1 if weights is nil.
+func sampleWeight(weights []float64, i int) float64 {
+	if weights == nil {
+		return 1
+	}
+	return weights[i]
+}
+
+// weightedMean returns the weighted mean of f applied to the samples, and
+// the sum of the weights.
+func weightedMean(f func(float64) float64, samples, weights []float64) (mean, sumWeights float64) {
+	for i, x := range samples {
+		w := sampleWeight(weights, i)
+		if w == 0 {
+			continue
+		}
+		mean += w * f(x)
+		sumWeights += w
+	}
+	return mean / sumWeights, sumWeights
+}
+
+// weightedMedian returns the weighted median of the samples.
+func weightedMedian(samples, weights []float64) float64 {
+	x := make([]float64, len(samples))
+	copy(x, samples)
+	var w []float64
+	if weights != nil {
+		w = make([]float64, len(weights))
+		copy(w, weights)
+	}
+	stat.SortWeighted(x, w)
+	return stat.Quantile(0.5, stat.Empirical, x, w)
+}
+
+func identity(x float64) float64 { return x }
+
+// isConditionError returns whether err is a mat.Condition error.
+func isConditionError(err error) bool {
+	var c mat.Condition
+	return errors.As(err, &c)
+}
@@ -0,0 +1,902 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+// fitTest is a distribution fitted by maximum likelihood.
+type fitTest struct {
+	name string
+	// want is the distribution the samples are drawn from, with the
+	// parameters wantParams.
+	want       func(src rand.Source) Rander
+	wantParams []float64
+	// fit fits the distribution and returns its parameters.
+	fit func(samples, weights []float64) []float64
+	// logProb returns the log probability at x of the distribution with
+	// the parameters p.
+	logProb func(p []float64, x float64) float64
+	// free is the number of leading parameters that are maximum likelihood
+	// estimates at a stationary point of the likelihood. If it is zero
+	// all the parameters are.
+	free int
+	// tol is the relative tolerance of the recovered parameters.
+	tol float64
+}
+
+var fitTests = []fitTest{
+	{
+		name:       "Bernoulli",
+		want:       func(src rand.Source) Rander { return Bernoulli{P: 0.3, Src: src} },
+		wantParams: []float64{0.3},
+		fit: func(x, w []float64) []float64 {
+			var d Bernoulli
+			d.Fit(x, w)
+			return []float64{d.P}
+		},
+		logProb: func(p []float64, x float64) float64 { return Bernoulli{P: p[0]}.LogProb(x) },
+		tol:     0.1,
+	},
+	{
+		name:       "Beta",
+		want:       func(src rand.Source) Rander { return Beta{Alpha: 2, Beta: 5, Src: src} },
+		wantParams: []float64{2, 5},
+		fit: func(x, w []float64) []float64 {
+			var d Beta
+			d.Fit(x, w)
+			return []float64{d.Alpha, d.Beta}
+		},
+		logProb: func(p []float64, x float64) float64 { return Beta{Alpha: p[0], Beta: p[1]}.LogProb(x) },
+		tol:     0.15,
+	},
+	{
+		name:       "BetaBinomial",
+		want:       func(src rand.Source) Rander { return BetaBinomial{N: 20, Alpha: 2, Beta: 3, Src: src} },
+		wantParams: []float64{2, 3},
+		fit: func(x, w []float64) []float64 {
+			d := BetaBinomial{N: 20}
+			d.Fit(x, w)
+			return []float64{d.Alpha, d.Beta}
+		},
+		logProb: func(p []float64, x float64) float64 { return BetaBinomial{N: 20, Alpha: p[0], Beta: p[1]}.LogProb(x) },
+		tol:     0.2,
+	},
+	{
+		name:       "Binomial",
+		want:       func(src rand.Source) Rander { return Binomial{N: 10, P: 0.4, Src: src} },
+		wantParams: []float64{0.4},
+		fit: func(x, w []float64) []float64 {
+			d := Binomial{N: 10}
+			d.Fit(x, w)
+			return []float64{d.P}
+		},
+		logProb: func(p []float64, x float64) float64 { return Binomial{N: 10, P: p[0]}.LogProb(x) },
+		tol:     0.05,
+	},
+	{
+		name:       "Cauchy",
+		want:       func(src rand.Source) Rander { return Cauchy{Mu: 2, Scale: 0.5, Src: src} },
+		wantParams: []float64{2, 0.5},
+		fit: func(x, w []float64) []float64 {
+			var d Cauchy
+			d.Fit(x, w)
+			return []float64{d.Mu, d.Scale}
+		},
+		logProb: func(p []float64, x float64) float64 { return Cauchy{Mu: p[0], Scale: p[1]}.LogProb(x) },
+		tol:     0.1,
+	},
+	{
+		name:       "Chi",
+		want:       func(src rand.Source) Rander { return Chi{K: 3.5, Src: src} },
+		wantParams: []float64{3.5},
+		fit: func(x, w []float64) []float64 {
+			var d Chi
+			d.Fit(x, w)
+			return []float64{d.K}
+		},
+		logProb: func(p []float64, x float64) float64 { return Chi{K: p[0]}.LogProb(x) },
+		tol:     0.05,
+	},
+	{
+		name:       "ChiSquared",
+		want:       func(src rand.Source) Rander { return ChiSquared{K: 4, Src: src} },
+		wantParams: []float64{4},
+		fit: func(x, w []float64) []float64 {
+			var d ChiSquared
+			d.Fit(x, w)
+			return []float64{d.K}
+		},
+		logProb: func(p []float64, x float64) float64 { return ChiSquared{K: p[0]}.LogProb(x) },
+		tol:     0.05,
+	},
+	{
+		name:       "Exponential",
+		want:       func(src rand.Source) Rander { return Exponential{Rate: 2, Src: src} },
+		wantParams: []float64{2},
+		fit: func(x, w []float64) []float64 {
+			var d Exponential
+			d.Fit(x, w)
+			return []float64{d.Rate}
+		},
+		logProb: func(p []float64, x float64) float64 { return Exponential{Rate: p[0]}.LogProb(x) },
+		tol:     0.1,
+	},
+	{
+		name:       "F",
+		want:       func(src rand.Source) Rander { return F{D1: 6, D2: 12, Src: src} },
+		wantParams: []float64{6, 12},
+		fit: func(x, w []float64) []float64 {
+			var d F
+			d.Fit(x, w)
+			return []float64{d.D1, d.D2}
+		},
+		logProb: func(p []float64, x float64) float64 { return F{D1: p[0], D2: p[1]}.LogProb(x) },
+		tol:     0.3,
+	},
+	{
+		name:       "Gamma",
+		want:       func(src rand.Source) Rander { return Gamma{Alpha: 2.5, Beta: 0.5, Src: src} },
+		wantParams: []float64{2.5, 0.5},
+		fit: func(x, w []float64) []float64 {
+			var d Gamma
+			d.Fit(x, w)
+			return []float64{d.Alpha, d.Beta}
+		},
+		logProb: func(p []float64, x float64) float64 { return Gamma{Alpha: p[0], Beta: p[1]}.LogProb(x) },
+		tol:     0.1,
+	},
+	{
+		name:       "GeneralizedExtremeValue",
+		want:       func(src rand.Source) Rander { return GeneralizedExtremeValue{Mu: 1, Sigma: 2, Xi: 0.2, Src: src} },
+		wantParams: []float64{1, 2, 0.2},
+		fit: func(x, w []float64) []float64 {
+			var d GeneralizedExtremeValue
+			d.Fit(x, w)
+			return []float64{d.Mu, d.Sigma, d.Xi}
+		},
+		logProb: func(p []float64, x float64) float64 {
+			return GeneralizedExtremeValue{Mu: p[0], Sigma: p[1], Xi: p[2]}.LogProb(x)
+		},
+		tol: 0.3,
+	},
+	{
+		name:       "GeneralizedPareto",
+		want:       func(src rand.Source) Rander { return GeneralizedPareto{Mu: 1, Sigma: 2, Xi: 0.2, Src: src} },
+		wantParams: []float64{2, 0.2},
+		fit: func(x, w []float64) []float64 {
+			d := GeneralizedPareto{Mu: 1}
+			d.Fit(x, w)
+			return []float64{d.Sigma, d.Xi}
+		},
+		logProb: func(p []float64, x float64) float64 {
+			return GeneralizedPareto{Mu: 1, Sigma: p[0], Xi: p[1]}.LogProb(x)
+		},
+		tol: 0.3,
+	},
+	{
+		name:       "Geometric",
+		want:       func(src rand.Source) Rander { return Geometric{P: 0.3, Src: src} },
+		wantParams: []float64{0.3},
+		fit: func(x, w []float64) []float64 {
+			var d Geometric
+			d.Fit(x, w)
+			return []float64{d.P}
+		},
+		logProb: func(p []float64, x float64) float64 { return Geometric{P: p[0]}.LogProb(x) },
+		tol:     0.05,
+	},
+	{
+		name:       "GumbelRight",
+		want:       func(src rand.Source) Rander { return GumbelRight{Mu: 3, Beta: 2, Src: src} },
+		wantParams: []float64{3, 2},
+		fit: func(x, w []float64) []float64 {
+			var d GumbelRight
+			d.Fit(x, w)
+			return []float64{d.Mu, d.Beta}
+		},
+		logProb: func(p []float64, x float64) float64 { return GumbelRight{Mu: p[0], Beta: p[1]}.LogProb(x) },
+		tol:     0.1,
+	},
+	{
+		name:       "InverseGamma",
+		want:       func(src rand.Source) Rander { return InverseGamma{Alpha: 3, Beta: 2, Src: src} },
+		wantParams: []float64{3, 2},
+		fit: func(x, w []float64) []float64 {
+			var d InverseGamma
+			d.Fit(x, w)
+			return []float64{d.Alpha, d.Beta}
+		},
+		logProb: func(p []float64, x float64) float64 { return InverseGamma{Alpha: p[0], Beta: p[1]}.LogProb(x) },
+		tol:     0.1,
+	},
+	{
+		name:       "Logistic",
+		want:       func(src rand.Source) Rander { return logisticRander{Logistic{Mu: -1, S: 0.5}, src} },
+		wantParams: []float64{-1, 0.5},
+		fit: func(x, w []float64) []float64 {
+			var d Logistic
+			d.Fit(x, w)
+			return []float64{d.Mu, d.S}
+		},
+		logProb: func(p []float64, x float64) float64 { return Logistic{Mu: p[0], S: p[1]}.LogProb(x) },
+		tol:     0.1,
+	},
+	{
+		name:       "LogNormal",
+		want:       func(src rand.Source) Rander { return LogNormal{Mu: 0.5, Sigma: 0.8, Src: src} },
+		wantParams: []float64{0.5, 0.8},
+		fit: func(x, w []float64) []float64 {
+			var d LogNormal
+			d.Fit(x, w)
+			return []float64{d.Mu, d.Sigma}
+		},
+		logProb: func(p []float64, x float64) float64 { return LogNormal{Mu: p[0], Sigma: p[1]}.LogProb(x) },
+		tol:     0.1,
+	},
+	{
+		name:       "Nakagami",
+		want:       func(src rand.Source) Rander { return Nakagami{M: 2, Omega: 3, Src: src} },
+		wantParams: []float64{2, 3},
+		fit: func(x, w []float64) []float64 {
+			var d Nakagami
+			d.Fit(x, w)
+			return []float64{d.M, d.Omega}
+		},
+		logProb: func(p []float64, x float64) float64 { return Nakagami{M: p[0], Omega: p[1]}.LogProb(x) },
+		tol:     0.1,
+	},
+	{
+		name:       "NegativeBinomial",
+		want:       func(src rand.Source) Rander { return NegativeBinomial{R: 3, P: 0.4, Src: src} },
+		wantParams: []float64{3, 0.4},
+		fit: func(x, w []float64) []float64 {
+			var d NegativeBinomial
+			d.Fit(x, w)
+			return []float64{d.R, d.P}
+		},
+		logProb: func(p []float64, x float64) float64 { return NegativeBinomial{R: p[0], P: p[1]}.LogProb(x) },
+		tol:     0.15,
+	},
+	{
+		name:       "Normal",
+		want:       func(src rand.Source) Rander { return Normal{Mu: 2, Sigma: 3, Src: src} },
+		wantParams: []float64{2, 3},
+		fit: func(x, w []float64) []float64 {
+			var d Normal
+			d.Fit(x, w)
+			return []float64{d.Mu, d.Sigma}
+		},
+		logProb: func(p []float64, x float64) float64 { return Normal{Mu: p[0], Sigma: p[1]}.LogProb(x) },
+		tol:     0.1,
+	},
+	{
+		name:       "Pareto",
+		want:       func(src rand.Source) Rander { return Pareto{Xm: 1.5, Alpha: 3, Src: src} },
+		wantParams: []float64{3, 1.5},
+		fit: func(x, w []float64) []float64 {
+			var d Pareto
+			d.Fit(x, w)
+			return []float64{d.Alpha, d.Xm}
+		},
+		logProb: func(p []float64, x float64) float64 { return Pareto{Alpha: p[0], Xm: p[1]}.LogProb(x) },
+		free:    1,
+		tol:     0.1,
+	},
+	{
+		name:       "Poisson",
+		want:       func(src rand.Source) Rander { return Poisson{Lambda: 4, Src: src} },
+		wantParams: []float64{4},
+		fit: func(x, w []float64) []float64 {
+			var d Poisson
+			d.Fit(x, w)
+			return []float64{d.Lambda}
+		},
+		logProb: func(p []float64, x float64) float64 { return Poisson{Lambda: p[0]}.LogProb(x) },
+		tol:     0.05,
+	},
+	{
+		name:       "Rice",
+		want:       func(src rand.Source) Rander { return Rice{Nu: 2, Sigma: 1, Src: src} },
+		wantParams: []float64{2, 1},
+		fit: func(x, w []float64) []float64 {
+			var d Rice
+			d.Fit(x, w)
+			return []float64{d.Nu, d.Sigma}
+		},
+		logProb: func(p []float64, x float64) float64 { return Rice{Nu: p[0], Sigma: p[1]}.LogProb(x) },
+		tol:     0.1,
+	},
+	{
+		name:       "Skellam",
+		want:       func(src rand.Source) Rander { return Skellam{Mu1: 4, Mu2: 2, Src: src} },
+		wantParams: []float64{4, 2},
+		fit: func(x, w []float64) []float64 {
+			var d Skellam
+			d.Fit(x, w)
+			return []float64{d.Mu1, d.Mu2}
+		},
+		logProb: func(p []float64, x float64) float64 { return Skellam{Mu1: p[0], Mu2: p[1]}.LogProb(x) },
+		tol:     0.15,
+	},
+	{
+		name:       "StudentsT",
+		want:       func(src rand.Source) Rander { return StudentsT{Mu: 1, Sigma: 2, Nu: 4, Src: src} },
+		wantParams: []float64{1, 2, 4},
+		fit: func(x, w []float64) []float64 {
+			var d StudentsT
+			d.Fit(x, w)
+			return []float64{d.Mu, d.Sigma, d.Nu}
+		},
+		logProb: func(p []float64, x float64) float64 {
+			return StudentsT{Mu: p[0], Sigma: p[1], Nu: p[2]}.LogProb(x)
+		},
+		tol: 0.3,
+	},
+	{
+		name:       "VonMises",
+		want:       func(src rand.Source) Rander { return VonMises{Mu: 1, Kappa: 2, Src: src} },
+		wantParams: []float64{1, 2},
+		fit: func(x, w []float64) []float64 {
+			var d VonMises
+			d.Fit(x, w)
+			return []float64{d.Mu, d.Kappa}
+		},
+		logProb: func(p []float64, x float64) float64 { return VonMises{Mu: p[0], Kappa: p[1]}.LogProb(x) },
+		tol:     0.1,
+	},
+	{
+		name:       "Weibull",
+		want:       func(src rand.Source) Rander { return Weibull{K: 1.5, Lambda: 10, Src: src} },
+		wantParams: []float64{1.5, 10},
+		fit: func(x, w []float64) []float64 {
+			var d Weibull
+			d.Fit(x, w)
+			return []float64{d.K, d.Lambda}
+		},
+		logProb: func(p []float64, x float64) float64 { return Weibull{K: p[0], Lambda: p[1]}.LogProb(x) },
+		tol:     0.1,
+	},
+	{
+		name:       "WrappedCauchy",
+		want:       func(src rand.Source) Rander { return WrappedCauchy{Mu: 1, Scale: 0.5, Src: src} },
+		wantParams: []float64{1, 0.5},
+		fit: func(x, w []float64) []float64 {
+			var d WrappedCauchy
+			d.Fit(x, w)
+			return []float64{d.Mu, d.Scale}
+		},
+		logProb: func(p []float64, x float64) float64 { return WrappedCauchy{Mu: p[0], Scale: p[1]}.LogProb(x) },
+		tol:     0.1,
+	},
+	{
+		name:       "ZeroInflatedPoisson",
+		want:       func(src rand.Source) Rander { return ZeroInflatedPoisson{Lambda: 3, Pi: 0.3, Src: src} },
+		wantParams: []float64{3, 0.3},
+		fit: func(x, w []float64) []float64 {
+			var d ZeroInflatedPoisson
+			d.Fit(x, w)
+			return []float64{d.Lambda, d.Pi}
+		},
+		logProb: func(p []float64, x float64) float64 { return ZeroInflatedPoisson{Lambda: p[0], Pi: p[1]}.LogProb(x) },
+		tol:     0.1,
+	},
+}
+
+// logisticRander draws samples from a logistic distribution, which has no
+// Rand method, by inversion.
+type logisticRander struct {
+	Logistic
+	src rand.Source
+}
+
+func (l logisticRander) Rand() float64 {
+	return l.Quantile(rand.New(l.src).Float64())
+}
+
+func TestFitMaximumLikelihood(t *testing.T) {
+	t.Parallel()
+	for _, test := range fitTests {
+		src := rand.NewSource(1)
+		rnd := rand.New(src)
+		dist := test.want(src)
+		const n = 2000
+		x := make([]float64, n)
+		w := make([]float64, n)
+		for i := range x {
+			x[i] = dist.Rand()
+			w[i] = 0.5 + rnd.Float64()
+		}
+
+		// The unweighted estimates are close to the parameters.
+		got := test.fit(x, nil)
+		for i, v := range got {
+			if !scalar.EqualWithinRel(v, test.wantParams[i], test.tol) {
+				t.Errorf("%s: parameter %d far from truth: got %v, want %v", test.name, i, v, test.wantParams[i])
+			}
+		}
+
+		// The weighted estimates are stationary points of the weighted
+		// log-likelihood.
+		p := test.fit(x, w)
+		free := test.free
+		if free == 0 {
+			free = len(p)
+		}
+		loglik := func(q []float64) float64 {
+			params := append(q[:free:free], p[free:]...)
+			var l, sum float64
+			for i, v := range x {
+				l += w[i] * test.logProb(params, v)
+				sum += w[i]
+			}
+			return l / sum
+		}
+		grad := fd.Gradient(nil, loglik, p[:free], &fd.Settings{Formula: fd.Central})
+		for i, g := range grad {
+			if math.Abs(g*p[i]) > 1e-6 {
+				t.Errorf("%s: log-likelihood not stationary in parameter %d: gradient %v", test.name, i, g)
+			}
+		}
+
+		// Integer weights are equivalent to repeated samples.
+		var xRep []float64
+		wInt := make([]float64, 50)
+		for i := range wInt {
+			wInt[i] = float64(1 + i%3)
+			for k := 0; k < int(wInt[i]); k++ {
+				xRep = append(xRep, x[i])
+			}
+		}
+		weighted := test.fit(x[:50], wInt)
+		repeated := test.fit(xRep, nil)
+		for i := range weighted {
+			if !scalar.EqualWithinAbsOrRel(weighted[i], repeated[i], 1e-6, 1e-6) {
+				t.Errorf("%s: weighted and repeated fits differ in parameter %d: %v and %v", test.name, i, weighted[i], repeated[i])
+			}
+		}
+	}
+}
+
+func TestFitBounded(t *testing.T) {
+	t.Parallel()
+	x := []float64{0.5, 2, 1.5, 3, 0.2}
+	w := []float64{1, 1, 1, 0, 2}
+
+	var u Uniform
+	u.Fit(x, w)
+	if u.Min != 0.2 || u.Max != 2 {
+		t.Errorf("unexpected Uniform fit: got [%v, %v], want [0.2, 2]", u.Min, u.Max)
+	}
+
+	c := NewCategorical([]float64{1, 1, 1, 1}, nil)
+	c.Fit([]float64{0, 1, 1, 3, 3, 3}, []float64{2, 1, 1, 1, 0, 0})
+	for i, want := range []float64{0.4, 0.4, 0, 0.2} {
+		if got := c.Prob(float64(i)); !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+			t.Errorf("unexpected Categorical probability of %d: got %v, want %v", i, got, want)
+		}
+	}
+	if !panics(func() { c.Fit([]float64{4}, nil) }) {
+		t.Error("expected panic for Categorical sample out of range")
+	}
+
+	// The fitted mode of the triangle distribution maximizes the
+	// likelihood over the bounds and the samples.
+	rnd := rand.New(rand.NewSource(1))
+	tri := NewTriangle(-1, 4, 2.5, rand.NewSource(2))
+	samples := make([]float64, 200)
+	weights := make([]float64, len(samples))
+	for i := range samples {
+		samples[i] = tri.Rand()
+		weights[i] = rnd.Float64()
+	}
+	fitted := NewTriangle(-1, 4, 0, nil)
+	fitted.Fit(samples, weights)
+	best := logLikelihood(fitted, samples, weights)
+	for _, c := range append([]float64{-1, 4}, samples...) {
+		if l := logLikelihood(NewTriangle(-1, 4, c, nil), samples, weights); l > best+1e-9*math.Abs(best) {
+			t.Errorf("Triangle mode %v has greater likelihood than fitted mode %v: %v > %v", c, fitted.Mode(), l, best)
+		}
+	}
+	if math.Abs(fitted.Mode()-2.5) > 0.5 {
+		t.Errorf("Triangle mode far from truth: got %v, want 2.5", fitted.Mode())
+	}
+	if !panics(func() { fitted.Fit([]float64{5}, nil) }) {
+		t.Error("expected panic for Triangle sample out of bounds")
+	}
+}
+
+func TestAlphaStableFit(t *testing.T) {
+	t.Parallel()
+	for _, want := range []AlphaStable{
+		{Alpha: 1.5, Beta: 0.5, C: 2, Mu: 1},
+		{Alpha: 0.8, Beta: -0.3, C: 0.5, Mu: -2},
+		{Alpha: 2, Beta: 0, C: 1, Mu: 0},
+	} {
+		want.Src = rand.NewSource(1)
+		x := make([]float64, 5000)
+		for i := range x {
+			x[i] = want.Rand()
+		}
+		var got AlphaStable
+		got.Fit(x, nil)
+		if math.Abs(got.Alpha-want.Alpha) > 0.1 {
+			t.Errorf("unexpected Alpha: got %v, want %v", got.Alpha, want.Alpha)
+		}
+		if math.Abs(got.Beta-want.Beta) > 0.25 {
+			t.Errorf("unexpected Beta: got %v, want %v", got.Beta, want.Beta)
+		}
+		if !scalar.EqualWithinRel(got.C, want.C, 0.1) {
+			t.Errorf("unexpected C: got %v, want %v", got.C, want.C)
+		}
+		if math.Abs(got.Mu-want.Mu) > 0.2*want.C {
+			t.Errorf("unexpected Mu: got %v, want %v", got.Mu, want.Mu)
+		}
+	}
+}
+
+func TestFitMLE(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	x := make([]float64, 500)
+	w := make([]float64, len(x))
+	for i := range x {
+		x[i] = 3*rnd.NormFloat64() + 10
+		w[i] = float64(1 + rnd.Intn(3))
+	}
+
+	var want Normal
+	want.Fit(x, w)
+	var sumWeights float64
+	for _, v := range w {
+		sumWeights += v
+	}
+
+	got := &Normal{Mu: 5, Sigma: 1}
+	stdErr, err := FitMLE(got, x, w)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !scalar.EqualWithinAbsOrRel(got.Mu, want.Mu, 1e-6, 1e-6) {
+		t.Errorf("unexpected Mu: got %v, want %v", got.Mu, want.Mu)
+	}
+	if !scalar.EqualWithinAbsOrRel(got.Sigma, want.Sigma, 1e-6, 1e-6) {
+		t.Errorf("unexpected Sigma: got %v, want %v", got.Sigma, want.Sigma)
+	}
+	// The inverse Fisher information of the normal distribution is
+	// diag(σ², σ²/2)/n.
+	wantErr := []float64{want.Sigma / math.Sqrt(sumWeights), want.Sigma / math.Sqrt(2*sumWeights)}
+	for i, v := range stdErr {
+		if !scalar.EqualWithinRel(v, wantErr[i], 1e-4) {
+			t.Errorf("unexpected standard error of parameter %d: got %v, want %v", i, v, wantErr[i])
+		}
+	}
+
+	bad := &Normal{Mu: 5, Sigma: -1}
+	if _, err := FitMLE(bad, x, w); !errors.Is(err, ErrZeroLikelihood) {
+		t.Errorf("unexpected error for invalid initial parameters: got %v, want %v", err, ErrZeroLikelihood)
+	}
+	if bad.Mu != 5 || bad.Sigma != -1 {
+		t.Errorf("parameters changed after failure: got %+v", *bad)
+	}
+
+	for _, f := range []func(){
+		func() { FitMLE(got, nil, nil) },
+		func() { FitMLE(got, x, w[1:]) },
+	} {
+		if !panics(f) {
+			t.Error("expected panic for invalid samples")
+		}
+	}
+}
+
+func TestFitMLEInvalidTrial(t *testing.T) {
+	t.Parallel()
+	// Newton steps from initial parameters far from the estimates leave the
+	// valid parameter space, where LogProb of Beta is NaN. The samples are
+	// kept inside (0, 1) so that the initial likelihood is not zero.
+	b := Beta{Alpha: 0.05, Beta: 0.05, Src: rand.NewSource(1)}
+	x := make([]float64, 1000)
+	for i := range x {
+		for x[i] == 0 || x[i] == 1 {
+			x[i] = b.Rand()
+		}
+	}
+	var want Beta
+	want.Fit(x, nil)
+
+	got := &Beta{Alpha: 3, Beta: 3}
+	if _, err := FitMLE(got, x, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !scalar.EqualWithinRel(got.Alpha, want.Alpha, 1e-6) {
+		t.Errorf("unexpected Alpha: got %v, want %v", got.Alpha, want.Alpha)
+	}
+	if !scalar.EqualWithinRel(got.Beta, want.Beta, 1e-6) {
+		t.Errorf("unexpected Beta: got %v, want %v", got.Beta, want.Beta)
+	}
+}
+
+func TestFitStdErrSupportBound(t *testing.T) {
+	t.Parallel()
+	const n = 2000
+	src := rand.NewSource(1)
+	want := Pareto{Xm: 1, Alpha: 3, Src: src}
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = want.Rand()
+	}
+	var got Pareto
+	got.Fit(x, nil)
+	fit := got
+	// The likelihood is not differentiable in Xm, which bounds the support,
+	// but the standard error of Alpha is still given.
+	stdErr, err := FitMLE(&got, x, nil)
+	if !errors.Is(err, ErrNotConverged) {
+		t.Errorf("unexpected error: got %v, want %v", err, ErrNotConverged)
+	}
+	if got != fit {
+		t.Errorf("parameters changed by FitMLE: got %+v, want %+v", got, fit)
+	}
+	if !math.IsNaN(stdErr[0]) {
+		t.Errorf("unexpected standard error of Xm: got %v, want NaN", stdErr[0])
+	}
+	if se := got.Alpha / math.Sqrt(n); !scalar.EqualWithinRel(stdErr[1], se, 0.1) {
+		t.Errorf("standard error of Alpha far from expected information: got %v, want %v", stdErr[1], se)
+	}
+}
+
+// stdErrFitter is a distribution fitted by Fit with standard errors given
+// by FitMLE.
+type stdErrFitter interface {
+	ParameterLogProber
+	Fit(samples, weights []float64)
+}
+
+func TestFitStdErr(t *testing.T) {
+	t.Parallel()
+	const n = 2000
+	for _, test := range []struct {
+		name string
+		want func(src rand.Source) Rander
+		fit  stdErrFitter
+		// stdErr returns the standard errors of the parameters p from the
+		// expected Fisher information, or nil if it is not known.
+		stdErr func(p []Parameter) []float64
+	}{
+		{
+			name: "Bernoulli",
+			want: func(src rand.Source) Rander { return Bernoulli{P: 0.3, Src: src} },
+			fit:  &Bernoulli{},
+			stdErr: func(p []Parameter) []float64 {
+				q := p[0].Value
+				return []float64{math.Sqrt(q * (1 - q) / n)}
+			},
+		},
+		{
+			name: "Beta",
+			want: func(src rand.Source) Rander { return Beta{Alpha: 2, Beta: 5, Src: src} },
+			fit:  &Beta{},
+		},
+		{
+			name: "BetaBinomial",
+			want: func(src rand.Source) Rander { return BetaBinomial{N: 20, Alpha: 2, Beta: 3, Src: src} },
+			fit:  &BetaBinomial{N: 20},
+		},
+		{
+			name: "Binomial",
+			want: func(src rand.Source) Rander { return Binomial{N: 10, P: 0.3, Src: src} },
+			fit:  &Binomial{N: 10},
+			stdErr: func(p []Parameter) []float64 {
+				q := p[0].Value
+				return []float64{math.Sqrt(q * (1 - q) / (10 * n))}
+			},
+		},
+		{
+			name: "Cauchy",
+			want: func(src rand.Source) Rander { return Cauchy{Mu: 2, Scale: 0.5, Src: src} },
+			fit:  &Cauchy{},
+			stdErr: func(p []Parameter) []float64 {
+				se := p[1].Value * math.Sqrt(2.0/n)
+				return []float64{se, se}
+			},
+		},
+		{
+			name: "Chi",
+			want: func(src rand.Source) Rander { return Chi{K: 3, Src: src} },
+			fit:  &Chi{},
+		},
+		{
+			name: "ChiSquared",
+			want: func(src rand.Source) Rander { return ChiSquared{K: 4, Src: src} },
+			fit:  &ChiSquared{},
+		},
+		{
+			name: "Exponential",
+			want: func(src rand.Source) Rander { return Exponential{Rate: 2, Src: src} },
+			fit:  &Exponential{},
+			stdErr: func(p []Parameter) []float64 {
+				return []float64{p[0].Value / math.Sqrt(n)}
+			},
+		},
+		{
+			name: "F",
+			want: func(src rand.Source) Rander { return F{D1: 6, D2: 12, Src: src} },
+			fit:  &F{},
+		},
+		{
+			name: "Gamma",
+			want: func(src rand.Source) Rander { return Gamma{Alpha: 3, Beta: 2, Src: src} },
+			fit:  &Gamma{},
+			stdErr: func(p []Parameter) []float64 {
+				// The Fisher information is
+				//  [ψ'(α) -1/β; -1/β α/β²]
+				// per sample, with ψ'(3) = π²/6 - 5/4.
+				const trigamma3 = math.Pi*math.Pi/6 - 1.25
+				alpha, beta := p[0].Value, p[1].Value
+				det := alpha*trigamma3 - 1
+				return []float64{
+					math.Sqrt(alpha / det / n),
+					beta * math.Sqrt(trigamma3/det/n),
+				}
+			},
+		},
+		{
+			name: "GeneralizedExtremeValue",
+			want: func(src rand.Source) Rander { return GeneralizedExtremeValue{Mu: 1, Sigma: 2, Xi: 0.1, Src: src} },
+			fit:  &GeneralizedExtremeValue{},
+		},
+		{
+			name: "GeneralizedPareto",
+			want: func(src rand.Source) Rander { return GeneralizedPareto{Mu: 0, Sigma: 2, Xi: 0.2, Src: src} },
+			fit:  &GeneralizedPareto{},
+		},
+		{
+			name: "Geometric",
+			want: func(src rand.Source) Rander { return Geometric{P: 0.3, Src: src} },
+			fit:  &Geometric{},
+			stdErr: func(p []Parameter) []float64 {
+				// The Fisher information is 1/(p²(1-p)) per sample.
+				q := p[0].Value
+				return []float64{q * math.Sqrt((1-q)/n)}
+			},
+		},
+		{
+			name: "GumbelRight",
+			want: func(src rand.Source) Rander { return GumbelRight{Mu: 1, Beta: 2, Src: src} },
+			fit:  &GumbelRight{},
+		},
+		{
+			name: "InverseGamma",
+			want: func(src rand.Source) Rander { return InverseGamma{Alpha: 4, Beta: 3, Src: src} },
+			fit:  &InverseGamma{},
+		},
+		{
+			name: "LogNormal",
+			want: func(src rand.Source) Rander { return LogNormal{Mu: 1, Sigma: 0.5, Src: src} },
+			fit:  &LogNormal{},
+			stdErr: func(p []Parameter) []float64 {
+				s := p[1].Value
+				return []float64{s / math.Sqrt(n), s / math.Sqrt(2*n)}
+			},
+		},
+		{
+			name: "Logistic",
+			want: func(src rand.Source) Rander { return logisticRander{Logistic{Mu: 1, S: 2}, src} },
+			fit:  &Logistic{},
+			stdErr: func(p []Parameter) []float64 {
+				s := p[1].Value
+				return []float64{
+					s * math.Sqrt(3.0/n),
+					3 * s / math.Sqrt((math.Pi*math.Pi+3)*n),
+				}
+			},
+		},
+		{
+			name: "Nakagami",
+			want: func(src rand.Source) Rander { return Nakagami{M: 2, Omega: 3, Src: src} },
+			fit:  &Nakagami{},
+		},
+		{
+			name: "NegativeBinomial",
+			want: func(src rand.Source) Rander { return NegativeBinomial{R: 3, P: 0.4, Src: src} },
+			fit:  &NegativeBinomial{},
+		},
+		{
+			name: "Normal",
+			want: func(src rand.Source) Rander { return Normal{Mu: 1, Sigma: 2, Src: src} },
+			fit:  &Normal{},
+			stdErr: func(p []Parameter) []float64 {
+				s := p[1].Value
+				return []float64{s / math.Sqrt(n), s / math.Sqrt(2*n)}
+			},
+		},
+		{
+			name: "Poisson",
+			want: func(src rand.Source) Rander { return Poisson{Lambda: 3, Src: src} },
+			fit:  &Poisson{},
+			stdErr: func(p []Parameter) []float64 {
+				return []float64{math.Sqrt(p[0].Value / n)}
+			},
+		},
+		{
+			name: "Rice",
+			want: func(src rand.Source) Rander { return Rice{Nu: 2, Sigma: 1, Src: src} },
+			fit:  &Rice{},
+		},
+		{
+			name: "Skellam",
+			want: func(src rand.Source) Rander { return Skellam{Mu1: 4, Mu2: 2, Src: src} },
+			fit:  &Skellam{},
+		},
+		{
+			name: "StudentsT",
+			want: func(src rand.Source) Rander { return StudentsT{Mu: 1, Sigma: 2, Nu: 5, Src: src} },
+			fit:  &StudentsT{},
+		},
+		{
+			name: "VonMises",
+			want: func(src rand.Source) Rander { return VonMises{Mu: 1, Kappa: 3, Src: src} },
+			fit:  &VonMises{},
+		},
+		{
+			name: "Weibull",
+			want: func(src rand.Source) Rander { return Weibull{K: 1.5, Lambda: 2, Src: src} },
+			fit:  &Weibull{},
+		},
+		{
+			name: "WrappedCauchy",
+			want: func(src rand.Source) Rander { return WrappedCauchy{Mu: 1, Scale: 0.5, Src: src} },
+			fit:  &WrappedCauchy{},
+		},
+		{
+			name: "ZeroInflatedPoisson",
+			want: func(src rand.Source) Rander { return ZeroInflatedPoisson{Lambda: 3, Pi: 0.3, Src: src} },
+			fit:  &ZeroInflatedPoisson{},
+		},
+	} {
+		src := rand.NewSource(1)
+		x := make([]float64, n)
+		dist := test.want(src)
+		for i := range x {
+			x[i] = dist.Rand()
+		}
+		test.fit.Fit(x, nil)
+		want := test.fit.Parameters(nil)
+
+		// Starting from the estimates of Fit, FitMLE leaves them unchanged
+		// and computes their standard errors.
+		stdErr, err := FitMLE(test.fit, x, nil)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		got := test.fit.Parameters(nil)
+		for i, p := range got {
+			if !scalar.EqualWithinAbsOrRel(p.Value, want[i].Value, 1e-6, 1e-6) {
+				t.Errorf("%s: parameter %s changed by FitMLE: got %v, want %v", test.name, p.Name, p.Value, want[i].Value)
+			}
+			if !(stdErr[i] > 0) || math.IsInf(stdErr[i], 0) {
+				t.Errorf("%s: invalid standard error of %s: %v", test.name, p.Name, stdErr[i])
+			}
+		}
+		if test.stdErr == nil {
+			continue
+		}
+		for i, se := range test.stdErr(got) {
+			if !scalar.EqualWithinRel(stdErr[i], se, 0.1) {
+				t.Errorf("%s: standard error of %s far from expected information: got %v, want %v", test.name, got[i].Name, stdErr[i], se)
+			}
+		}
+	}
+}
@@ -44,6 +44,24 @@ func (g Gamma) ExKurtosis() float64 {
 	return 6 / g.Alpha
 }
 
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The maximum likelihood estimate of Alpha is the root of
+//
+//	log(Alpha) - ψ(Alpha) = log(mean(x)) - mean(log(x)),
+//
+// where ψ is the digamma function and the means are weighted, and that of
+// Beta is Alpha/mean(x).
+func (g *Gamma) Fit(samples, weights []float64) {
+	checkFit(samples, weights)
+	mean, _ := weightedMean(identity, samples, weights)
+	meanLog, _ := weightedMean(math.Log, samples, weights)
+	g.Alpha, g.Beta = gammaMLE(mean, meanLog)
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x.
 func (g Gamma) LogProb(x float64) float64 {
@@ -80,6 +98,23 @@ func (Gamma) NumParameters() int {
 	return 2
 }
 
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in place into p, which must have length
+// NumParameters, and returned.
+func (g Gamma) Parameters(p []Parameter) []Parameter {
+	nParam := g.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("gamma: improper parameter length")
+	}
+	p[0].Name = "Alpha"
+	p[0].Value = g.Alpha
+	p[1].Name = "Beta"
+	p[1].Value = g.Beta
+	return p
+}
+
 // Prob computes the value of the probability density function at x.
 func (g Gamma) Prob(x float64) float64 {
 	return math.Exp(g.LogProb(x))
@@ -185,6 +220,27 @@ func (g Gamma) Rand() float64 {
 	panic("unreachable")
 }
 
+// SetParameters sets the parameters of the distribution to p. It panics if
+// the length or the names of p do not match those returned by Parameters.
+func (g *Gamma) SetParameters(p []Parameter) {
+	if len(p) != g.NumParameters() {
+		panic("gamma: incorrect number of parameters to set")
+	}
+	if p[0].Name != "Alpha" {
+		panic("gamma: " + panicNameMismatch)
+	}
+	if p[1].Name != "Beta" {
+		panic("gamma: " + panicNameMismatch)
+	}
+	g.Alpha = p[0].Value
+	g.Beta = p[1].Value
+}
+
+// Skewness returns the skewness of the distribution.
+func (g Gamma) Skewness() float64 {
+	return 2 / math.Sqrt(g.Alpha)
+}
+
 // Survival returns the survival function (complementary CDF) at x.
 func (g Gamma) Survival(x float64) float64 {
 	if x < 0 {
@@ -202,3 +258,17 @@ func (g Gamma) StdDev() float64 {
 func (g Gamma) Variance() float64 {
 	return g.Alpha / g.Beta / g.Beta
 }
+
+// gammaMLE returns the maximum likelihood estimates of the shape and rate
+// of a gamma distribution with the given mean and mean logarithm of the
+// samples.
+func gammaMLE(mean, meanLog float64) (alpha, beta float64) {
+	s := math.Log(mean) - meanLog
+	// Start from the approximation of Minka (2002), Estimating a Gamma
+	// distribution.
+	init := (3 - s + math.Sqrt((s-3)*(s-3)+24*s)) / (12 * s)
+	alpha = findRoot(func(a float64) float64 {
+		return s - math.Log(a) + mathext.Digamma(a)
+	}, init)
+	return alpha, alpha / mean
+}
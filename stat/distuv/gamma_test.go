@@ -72,6 +72,7 @@ func testGamma(t *testing.T, f Gamma, i int) {
 	checkMean(t, i, x, f, tol)
 	checkVarAndStd(t, i, x, f, 2e-2)
 	checkExKurtosis(t, i, x, f, 2e-1)
+	checkSkewness(t, i, x, f, 5e-2)
 	switch {
 	case f.Alpha < 0.3:
 		quadTol = 0.1
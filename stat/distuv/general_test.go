@@ -72,7 +72,7 @@ func testDistributionProbs(t *testing.T, dist UniProbDist, name string, pts []un
 
 type ConjugateUpdater interface {
 	NumParameters() int
-	parameters([]Parameter) []Parameter
+	Parameters([]Parameter) []Parameter
 
 	NumSuffStat() int
 	SuffStat([]float64, []float64, []float64) float64
@@ -115,7 +115,7 @@ func testConjugateUpdate(t *testing.T, newFittable func() ConjugateUpdater) {
 			allDist := newFittable()
 			nsAll := allDist.SuffStat(stats, test.samps[0:j+1], allWeights)
 			allDist.ConjugateUpdate(stats, nsAll, make([]float64, allDist.NumParameters()))
-			if !parametersEqual(incDist.parameters(nil), allDist.parameters(nil), 1e-12) {
+			if !parametersEqual(incDist.Parameters(nil), allDist.Parameters(nil), 1e-12) {
 				t.Errorf("prior doesn't match after incremental update for (%d, %d). Incremental is %v, all at once is %v", i, j, incDist, allDist)
 			}
 
@@ -123,10 +123,10 @@ func testConjugateUpdate(t *testing.T, newFittable func() ConjugateUpdater) {
 				onesDist := newFittable()
 				nsOnes := onesDist.SuffStat(stats, test.samps[0:j+1], ones(j+1))
 				onesDist.ConjugateUpdate(stats, nsOnes, make([]float64, onesDist.NumParameters()))
-				if !parametersEqual(onesDist.parameters(nil), incDist.parameters(nil), 1e-14) {
+				if !parametersEqual(onesDist.Parameters(nil), incDist.Parameters(nil), 1e-14) {
 					t.Errorf("nil and uniform weighted prior doesn't match for incremental update for (%d, %d). Uniform weighted is %v, nil is %v", i, j, onesDist, incDist)
 				}
-				if !parametersEqual(onesDist.parameters(nil), allDist.parameters(nil), 1e-14) {
+				if !parametersEqual(onesDist.Parameters(nil), allDist.Parameters(nil), 1e-14) {
 					t.Errorf("nil and uniform weighted prior doesn't match for all at once update for (%d, %d). Uniform weighted is %v, nil is %v", i, j, onesDist, incDist)
 				}
 			}
@@ -196,8 +196,8 @@ type derivParamTester interface {
 	ScoreInput(x float64) float64
 	Quantile(p float64) float64
 	NumParameters() int
-	parameters([]Parameter) []Parameter
-	setParameters([]Parameter)
+	Parameters([]Parameter) []Parameter
+	SetParameters([]Parameter)
 }
 
 func testDerivParam(t *testing.T, d derivParamTester) {
@@ -213,14 +213,14 @@ func testDerivParam(t *testing.T, d derivParamTester) {
 	if !panics(func() { d.Score(make([]float64, d.NumParameters()+1), 0) }) {
 		t.Errorf("Expected panic for wrong derivative slice length")
 	}
-	if !panics(func() { d.parameters(make([]Parameter, d.NumParameters()+1)) }) {
+	if !panics(func() { d.Parameters(make([]Parameter, d.NumParameters()+1)) }) {
 		t.Errorf("Expected panic for wrong parameter slice length")
 	}
 
-	initParams := d.parameters(nil)
+	initParams := d.Parameters(nil)
 	tooLongParams := make([]Parameter, len(initParams)+1)
 	copy(tooLongParams, initParams)
-	if !panics(func() { d.setParameters(tooLongParams) }) {
+	if !panics(func() { d.SetParameters(tooLongParams) }) {
 		t.Errorf("Expected panic for wrong parameter slice length")
 	}
 	badNameParams := make([]Parameter, len(initParams))
@@ -228,7 +228,7 @@ func testDerivParam(t *testing.T, d derivParamTester) {
 	const badName = "__badName__"
 	for i := 0; i < len(initParams); i++ {
 		badNameParams[i].Name = badName
-		if !panics(func() { d.setParameters(badNameParams) }) {
+		if !panics(func() { d.SetParameters(badNameParams) }) {
 			t.Errorf("Expected panic for wrong %d-th parameter name", i)
 		}
 		badNameParams[i].Name = initParams[i].Name
@@ -239,25 +239,25 @@ func testDerivParam(t *testing.T, d derivParamTester) {
 		init[i] = v.Value
 	}
 	for _, v := range quantiles {
-		d.setParameters(initParams)
+		d.SetParameters(initParams)
 		x := d.Quantile(v)
 		score := d.Score(scoreInPlace, x)
 		if &score[0] != &scoreInPlace[0] {
 			t.Errorf("Returned a different derivative slice than passed in. Got %v, want %v", score, scoreInPlace)
 		}
 		logProbParams := func(p []float64) float64 {
-			params := d.parameters(nil)
+			params := d.Parameters(nil)
 			for i, v := range p {
 				params[i].Value = v
 			}
-			d.setParameters(params)
+			d.SetParameters(params)
 			return d.LogProb(x)
 		}
 		fd.Gradient(fdDerivParam, logProbParams, init, nil)
 		if !floats.EqualApprox(scoreInPlace, fdDerivParam, 1e-6) {
 			t.Errorf("Score mismatch at x = %g. Want %v, got %v", x, fdDerivParam, scoreInPlace)
 		}
-		d.setParameters(initParams)
+		d.SetParameters(initParams)
 		score2 := d.Score(nil, x)
 		if !floats.EqualApprox(score2, scoreInPlace, 1e-14) {
 			t.Errorf("Score mismatch when input nil Want %v, got %v", score2, scoreInPlace)
@@ -0,0 +1,251 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+)
+
+// GeneralizedExtremeValue implements the generalized extreme value
+// distribution, a continuous probability distribution that unifies the
+// Gumbel (ξ = 0), Fréchet (ξ > 0) and reversed Weibull (ξ < 0)
+// distributions of the maxima of samples.
+// The generalized extreme value distribution has density function:
+//
+//	f(x) = 1/σ t(x)^(ξ+1) exp(-t(x))
+//
+// where t(x) = (1 + ξ(x-μ)/σ)^(-1/ξ) if ξ ≠ 0 and t(x) = exp(-(x-μ)/σ)
+// if ξ = 0. The support is x ≥ μ - σ/ξ if ξ > 0, x ≤ μ - σ/ξ if ξ < 0,
+// and the whole real line if ξ = 0.
+//
+// For more information, see https://en.wikipedia.org/wiki/Generalized_extreme_value_distribution.
+type GeneralizedExtremeValue struct {
+	// Mu is the location of the distribution.
+	Mu float64
+	// Sigma is the scale of the distribution. Sigma must be greater than 0.
+	Sigma float64
+	// Xi is the shape of the distribution.
+	Xi float64
+
+	Src rand.Source
+}
+
+// logT returns the logarithm of t(x) and whether x is in the interior of
+// the support of the distribution.
+func (g GeneralizedExtremeValue) logT(x float64) (logt float64, ok bool) {
+	z := (x - g.Mu) / g.Sigma
+	if g.Xi == 0 {
+		return -z, true
+	}
+	s := g.Xi * z
+	if s <= -1 {
+		return math.Inf(int(g.Xi / math.Abs(g.Xi))), false
+	}
+	return -math.Log1p(s) / g.Xi, true
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (g GeneralizedExtremeValue) CDF(x float64) float64 {
+	logt, _ := g.logT(x)
+	return math.Exp(-math.Exp(logt))
+}
+
+// Entropy returns the differential entropy of the distribution.
+func (g GeneralizedExtremeValue) Entropy() float64 {
+	return math.Log(g.Sigma) + eulerMascheroni*(g.Xi+1) + 1
+}
+
+// ExKurtosis returns the excess kurtosis of the distribution. It is NaN
+// if ξ ≥ 1/4.
+func (g GeneralizedExtremeValue) ExKurtosis() float64 {
+	if g.Xi == 0 {
+		return 12.0 / 5
+	}
+	if g.Xi >= 0.25 {
+		return math.NaN()
+	}
+	g1, g2, g3, g4 := g.gammas(4)
+	v := g2 - g1*g1
+	return (g4-4*g1*g3+6*g2*g1*g1-3*g1*g1*g1*g1)/(v*v) - 3
+}
+
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The maximum likelihood estimates are found numerically, starting from
+// the maximum likelihood estimates of the Gumbel distribution.
+func (g *GeneralizedExtremeValue) Fit(samples, weights []float64) {
+	checkFit(samples, weights)
+	var gumbel GumbelRight
+	gumbel.Fit(samples, weights)
+	p := []float64{gumbel.Mu, math.Log(gumbel.Beta), 0}
+	maximize(func(p []float64) float64 {
+		return logLikelihood(GeneralizedExtremeValue{Mu: p[0], Sigma: math.Exp(p[1]), Xi: p[2]}, samples, weights)
+	}, p)
+	g.Mu, g.Sigma, g.Xi = p[0], math.Exp(p[1]), p[2]
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (g GeneralizedExtremeValue) LogProb(x float64) float64 {
+	logt, ok := g.logT(x)
+	if !ok {
+		return math.Inf(-1)
+	}
+	return -math.Log(g.Sigma) + (g.Xi+1)*logt - math.Exp(logt)
+}
+
+// Mean returns the mean of the probability distribution. It is +Inf if
+// ξ ≥ 1.
+func (g GeneralizedExtremeValue) Mean() float64 {
+	if g.Xi == 0 {
+		return g.Mu + g.Sigma*eulerMascheroni
+	}
+	if g.Xi >= 1 {
+		return math.Inf(1)
+	}
+	return g.Mu + g.Sigma*(math.Gamma(1-g.Xi)-1)/g.Xi
+}
+
+// Median returns the median of the probability distribution.
+func (g GeneralizedExtremeValue) Median() float64 {
+	return g.Quantile(0.5)
+}
+
+// Mode returns the mode of the probability distribution. It is the upper
+// bound of the support if ξ < -1.
+func (g GeneralizedExtremeValue) Mode() float64 {
+	if g.Xi == 0 {
+		return g.Mu
+	}
+	if g.Xi < -1 {
+		return g.Mu - g.Sigma/g.Xi
+	}
+	return g.Mu + g.Sigma*math.Expm1(-g.Xi*math.Log1p(g.Xi))/g.Xi
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (GeneralizedExtremeValue) NumParameters() int {
+	return 3
+}
+
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in place into p, which must have length
+// NumParameters, and returned.
+func (g GeneralizedExtremeValue) Parameters(p []Parameter) []Parameter {
+	nParam := g.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("generalizedextremevalue: improper parameter length")
+	}
+	p[0].Name = "Mu"
+	p[0].Value = g.Mu
+	p[1].Name = "Sigma"
+	p[1].Value = g.Sigma
+	p[2].Name = "Xi"
+	p[2].Value = g.Xi
+	return p
+}
+
+// Prob computes the value of the probability density function at x.
+func (g GeneralizedExtremeValue) Prob(x float64) float64 {
+	return math.Exp(g.LogProb(x))
+}
+
+// Quantile returns the inverse of the cumulative distribution function.
+func (g GeneralizedExtremeValue) Quantile(p float64) float64 {
+	if p < 0 || p > 1 {
+		panic(badPercentile)
+	}
+	return g.quantile(-math.Log(p))
+}
+
+// quantile returns the value of x at which t(x) = e.
+func (g GeneralizedExtremeValue) quantile(e float64) float64 {
+	if g.Xi == 0 {
+		return g.Mu - g.Sigma*math.Log(e)
+	}
+	return g.Mu + g.Sigma*math.Expm1(-g.Xi*math.Log(e))/g.Xi
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (g GeneralizedExtremeValue) Rand() float64 {
+	rnd := rand.ExpFloat64
+	if g.Src != nil {
+		rnd = rand.New(g.Src).ExpFloat64
+	}
+	return g.quantile(rnd())
+}
+
+// SetParameters sets the parameters of the distribution to p. It panics if
+// the length or the names of p do not match those returned by Parameters.
+func (g *GeneralizedExtremeValue) SetParameters(p []Parameter) {
+	if len(p) != g.NumParameters() {
+		panic("generalizedextremevalue: incorrect number of parameters to set")
+	}
+	if p[0].Name != "Mu" {
+		panic("generalizedextremevalue: " + panicNameMismatch)
+	}
+	if p[1].Name != "Sigma" {
+		panic("generalizedextremevalue: " + panicNameMismatch)
+	}
+	if p[2].Name != "Xi" {
+		panic("generalizedextremevalue: " + panicNameMismatch)
+	}
+	g.Mu = p[0].Value
+	g.Sigma = p[1].Value
+	g.Xi = p[2].Value
+}
+
+// Skewness returns the skewness of the distribution. It is NaN if ξ ≥ 1/3.
+func (g GeneralizedExtremeValue) Skewness() float64 {
+	if g.Xi == 0 {
+		return 12 * math.Sqrt(6) * apery / (math.Pi * math.Pi * math.Pi)
+	}
+	if g.Xi >= 1.0/3 {
+		return math.NaN()
+	}
+	g1, g2, g3, _ := g.gammas(3)
+	v := g2 - g1*g1
+	return math.Copysign((g3-3*g1*g2+2*g1*g1*g1)/(v*math.Sqrt(v)), g.Xi)
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (g GeneralizedExtremeValue) StdDev() float64 {
+	return math.Sqrt(g.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (g GeneralizedExtremeValue) Survival(x float64) float64 {
+	logt, _ := g.logT(x)
+	return -math.Expm1(-math.Exp(logt))
+}
+
+// Variance returns the variance of the probability distribution. It is
+// +Inf if ξ ≥ 1/2.
+func (g GeneralizedExtremeValue) Variance() float64 {
+	if g.Xi == 0 {
+		return g.Sigma * g.Sigma * math.Pi * math.Pi / 6
+	}
+	if g.Xi >= 0.5 {
+		return math.Inf(1)
+	}
+	g1, g2, _, _ := g.gammas(2)
+	return g.Sigma * g.Sigma * (g2 - g1*g1) / (g.Xi * g.Xi)
+}
+
+// gammas returns Γ(1-kξ) for k = 1, ..., n, with the rest zero.
+func (g GeneralizedExtremeValue) gammas(n int) (g1, g2, g3, g4 float64) {
+	var v [4]float64
+	for k := 0; k < n; k++ {
+		v[k] = math.Gamma(1 - float64(k+1)*g.Xi)
+	}
+	return v[0], v[1], v[2], v[3]
+}
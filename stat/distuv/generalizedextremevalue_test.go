@@ -0,0 +1,130 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestGeneralizedExtremeValueProbCDF(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		x, mu, sigma, xi, wantProb, wantCDF float64
+	}{
+		// Values calculated from the closed forms.
+		{0.5, 0, 1, 0.3, 0.2913523331055269, 0.5338785534487672},
+		{2, 1, 2, -0.4, 0.20183678368062774, 0.5641509608372548},
+		{-3, 1, 2, -0.4, 0.015632810574845248, 0.012946676712863533},
+		{4, -1, 0.5, 0.8, 0.013369782599040002, 0.9378642812813465},
+		// Outside the support.
+		{-4, 0, 1, 0.3, 0, 0},
+		{7, 1, 2, -0.4, 0, 1},
+	} {
+		g := GeneralizedExtremeValue{Mu: test.mu, Sigma: test.sigma, Xi: test.xi}
+		if got := g.Prob(test.x); !scalar.EqualWithinAbsOrRel(got, test.wantProb, 1e-13, 1e-13) {
+			t.Errorf("Prob mismatch, x = %v, %+v: got %v, want %v", test.x, g, got, test.wantProb)
+		}
+		if got := g.CDF(test.x); !scalar.EqualWithinAbsOrRel(got, test.wantCDF, 1e-13, 1e-13) {
+			t.Errorf("CDF mismatch, x = %v, %+v: got %v, want %v", test.x, g, got, test.wantCDF)
+		}
+	}
+
+	// With ξ = 0 the distribution is the Gumbel distribution.
+	g := GeneralizedExtremeValue{Mu: 2, Sigma: 3}
+	gumbel := GumbelRight{Mu: 2, Beta: 3}
+	for _, x := range []float64{-5, 0, 2, 7, 20} {
+		if got, want := g.Prob(x), gumbel.Prob(x); !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+			t.Errorf("Prob mismatch with Gumbel at %v: got %v, want %v", x, got, want)
+		}
+		if got, want := g.CDF(x), gumbel.CDF(x); !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+			t.Errorf("CDF mismatch with Gumbel at %v: got %v, want %v", x, got, want)
+		}
+	}
+	for _, test := range []struct {
+		name      string
+		got, want float64
+	}{
+		{"Mean", g.Mean(), gumbel.Mean()},
+		{"Variance", g.Variance(), gumbel.Variance()},
+		{"Skewness", g.Skewness(), gumbel.Skewness()},
+		{"ExKurtosis", g.ExKurtosis(), gumbel.ExKurtosis()},
+		{"Entropy", g.Entropy(), gumbel.Entropy()},
+		{"Median", g.Median(), gumbel.Median()},
+	} {
+		if !scalar.EqualWithinAbsOrRel(test.got, test.want, 1e-14, 1e-14) {
+			t.Errorf("%s mismatch with Gumbel: got %v, want %v", test.name, test.got, test.want)
+		}
+	}
+}
+
+func TestGeneralizedExtremeValue(t *testing.T) {
+	t.Parallel()
+	src := rand.New(rand.NewSource(1))
+	for i, g := range []GeneralizedExtremeValue{
+		{0, 1, 0.1, src},
+		{1, 2, -0.4, src},
+		{-3, 0.5, 0, src},
+		{5, 3, -1.2, src},
+	} {
+		testGeneralizedExtremeValue(t, g, i)
+	}
+}
+
+func testGeneralizedExtremeValue(t *testing.T, g GeneralizedExtremeValue, i int) {
+	const (
+		tol  = 1e-2
+		n    = 5e5
+		bins = 50
+	)
+	x := make([]float64, n)
+	generateSamples(x, g)
+	sort.Float64s(x)
+
+	lo, hi := g.Quantile(0), g.Quantile(1)
+	testRandLogProbContinuous(t, i, lo, x, g, tol, bins)
+	checkProbContinuous(t, i, x, lo, hi, g, 1e-6)
+	checkProbQuantContinuous(t, i, x, g, tol)
+	checkEntropy(t, i, x, g, tol)
+	checkMean(t, i, x, g, tol)
+	checkMedian(t, i, x, g, tol)
+	checkVarAndStd(t, i, x, g, tol)
+	if g.Xi < 0.2 {
+		checkSkewness(t, i, x, g, 5e-2)
+		checkExKurtosis(t, i, x, g, 2e-1)
+	}
+	checkQuantileCDFSurvival(t, i, x, g, tol)
+	mode := g.Mode()
+	if g.Xi < -1 {
+		if mode != hi {
+			t.Errorf("Mismatch in Mode: got %v, want %v", mode, hi)
+		}
+	} else if g.LogProb(mode) < g.LogProb(mode-1e-3) || g.LogProb(mode) < g.LogProb(mode+1e-3) {
+		t.Errorf("Mode %v is not a maximum of Prob case %v", mode, i)
+	}
+	if g.NumParameters() != 3 {
+		t.Errorf("Mismatch in NumParameters: got %v, want 3", g.NumParameters())
+	}
+}
+
+func TestGeneralizedExtremeValueMoments(t *testing.T) {
+	t.Parallel()
+	g := GeneralizedExtremeValue{Mu: 1, Sigma: 2, Xi: 0.6}
+	if !math.IsInf(g.Variance(), 1) {
+		t.Errorf("Variance for ξ ≥ 1/2 is not +Inf: got %v", g.Variance())
+	}
+	if !math.IsNaN(g.Skewness()) || !math.IsNaN(g.ExKurtosis()) {
+		t.Errorf("Undefined moments are not NaN: got %v and %v", g.Skewness(), g.ExKurtosis())
+	}
+	g.Xi = 1
+	if !math.IsInf(g.Mean(), 1) {
+		t.Errorf("Mean for ξ ≥ 1 is not +Inf: got %v", g.Mean())
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+)
+
+// GeneralizedPareto implements the generalized Pareto distribution, a
+// continuous probability distribution of the excesses over a high threshold
+// that includes the exponential (ξ = 0) and Pareto (ξ > 0) distributions.
+// The generalized Pareto distribution has density function:
+//
+//	f(x) = 1/σ (1 + ξ(x-μ)/σ)^(-1/ξ - 1)
+//
+// for x ≥ μ if ξ ≥ 0 and μ ≤ x ≤ μ - σ/ξ if ξ < 0. If ξ = 0 the density
+// is 1/σ exp(-(x-μ)/σ).
+//
+// For more information, see https://en.wikipedia.org/wiki/Generalized_Pareto_distribution.
+type GeneralizedPareto struct {
+	// Mu is the location of the distribution, the lower bound of its
+	// support.
+	Mu float64
+	// Sigma is the scale of the distribution. Sigma must be greater than 0.
+	Sigma float64
+	// Xi is the shape of the distribution.
+	Xi float64
+
+	Src rand.Source
+}
+
+// logSurvival returns the logarithm of the survival function at x and
+// whether x is in the support of the distribution.
+func (g GeneralizedPareto) logSurvival(x float64) (logs float64, ok bool) {
+	z := (x - g.Mu) / g.Sigma
+	if z < 0 {
+		return 0, false
+	}
+	if g.Xi == 0 {
+		return -z, true
+	}
+	s := g.Xi * z
+	if s <= -1 {
+		return math.Inf(-1), false
+	}
+	return -math.Log1p(s) / g.Xi, true
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (g GeneralizedPareto) CDF(x float64) float64 {
+	logs, _ := g.logSurvival(x)
+	return -math.Expm1(logs)
+}
+
+// Entropy returns the differential entropy of the distribution.
+func (g GeneralizedPareto) Entropy() float64 {
+	return math.Log(g.Sigma) + g.Xi + 1
+}
+
+// ExKurtosis returns the excess kurtosis of the distribution. It is NaN
+// if ξ ≥ 1/4.
+func (g GeneralizedPareto) ExKurtosis() float64 {
+	xi := g.Xi
+	if xi >= 0.25 {
+		return math.NaN()
+	}
+	return 3*(1-2*xi)*(2*xi*xi+xi+3)/((1-3*xi)*(1-4*xi)) - 3
+}
+
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// Mu is not fitted and must be set before calling Fit. The maximum
+// likelihood estimates of Sigma and Xi are found numerically, starting
+// from the method of moments estimates constrained to ξ ≥ 0.
+func (g *GeneralizedPareto) Fit(samples, weights []float64) {
+	checkFit(samples, weights)
+	mean, _ := weightedMean(func(x float64) float64 { return x - g.Mu }, samples, weights)
+	variance, _ := weightedMean(func(x float64) float64 { return (x - g.Mu - mean) * (x - g.Mu - mean) }, samples, weights)
+	xi := math.Max(0, (1-mean*mean/variance)/2)
+	p := []float64{math.Log(mean * (1 - xi)), xi}
+	maximize(func(p []float64) float64 {
+		return logLikelihood(GeneralizedPareto{Mu: g.Mu, Sigma: math.Exp(p[0]), Xi: p[1]}, samples, weights)
+	}, p)
+	g.Sigma, g.Xi = math.Exp(p[0]), p[1]
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (g GeneralizedPareto) LogProb(x float64) float64 {
+	logs, ok := g.logSurvival(x)
+	if !ok {
+		return math.Inf(-1)
+	}
+	return -math.Log(g.Sigma) + (g.Xi+1)*logs
+}
+
+// Mean returns the mean of the probability distribution. It is +Inf if
+// ξ ≥ 1.
+func (g GeneralizedPareto) Mean() float64 {
+	if g.Xi >= 1 {
+		return math.Inf(1)
+	}
+	return g.Mu + g.Sigma/(1-g.Xi)
+}
+
+// Median returns the median of the probability distribution.
+func (g GeneralizedPareto) Median() float64 {
+	return g.Quantile(0.5)
+}
+
+// Mode returns the mode of the probability distribution. It is the upper
+// bound of the support if ξ < -1.
+func (g GeneralizedPareto) Mode() float64 {
+	if g.Xi < -1 {
+		return g.Mu - g.Sigma/g.Xi
+	}
+	return g.Mu
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (GeneralizedPareto) NumParameters() int {
+	return 3
+}
+
+// Parameters returns the scale and shape parameters of the distribution,
+// Sigma and Xi. The location Mu is a bound of the support and is not
+// included. If p is not nil, the parameters are stored in place into p,
+// which must have length 2, and returned.
+func (g GeneralizedPareto) Parameters(p []Parameter) []Parameter {
+	nParam := 2
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("generalizedpareto: improper parameter length")
+	}
+	p[0].Name = "Sigma"
+	p[0].Value = g.Sigma
+	p[1].Name = "Xi"
+	p[1].Value = g.Xi
+	return p
+}
+
+// Prob computes the value of the probability density function at x.
+func (g GeneralizedPareto) Prob(x float64) float64 {
+	return math.Exp(g.LogProb(x))
+}
+
+// Quantile returns the inverse of the cumulative distribution function.
+func (g GeneralizedPareto) Quantile(p float64) float64 {
+	if p < 0 || p > 1 {
+		panic(badPercentile)
+	}
+	return g.quantile(-math.Log1p(-p))
+}
+
+// quantile returns the value of x at which the logarithm of the survival
+// function is -e.
+func (g GeneralizedPareto) quantile(e float64) float64 {
+	if g.Xi == 0 {
+		return g.Mu + g.Sigma*e
+	}
+	return g.Mu + g.Sigma*math.Expm1(g.Xi*e)/g.Xi
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (g GeneralizedPareto) Rand() float64 {
+	rnd := rand.ExpFloat64
+	if g.Src != nil {
+		rnd = rand.New(g.Src).ExpFloat64
+	}
+	return g.quantile(rnd())
+}
+
+// SetParameters sets the parameters of the distribution to p. It panics if
+// the length or the names of p do not match those returned by Parameters.
+func (g *GeneralizedPareto) SetParameters(p []Parameter) {
+	if len(p) != 2 {
+		panic("generalizedpareto: incorrect number of parameters to set")
+	}
+	if p[0].Name != "Sigma" {
+		panic("generalizedpareto: " + panicNameMismatch)
+	}
+	if p[1].Name != "Xi" {
+		panic("generalizedpareto: " + panicNameMismatch)
+	}
+	g.Sigma = p[0].Value
+	g.Xi = p[1].Value
+}
+
+// Skewness returns the skewness of the distribution. It is NaN if ξ ≥ 1/3.
+func (g GeneralizedPareto) Skewness() float64 {
+	xi := g.Xi
+	if xi >= 1.0/3 {
+		return math.NaN()
+	}
+	return 2 * (1 + xi) * math.Sqrt(1-2*xi) / (1 - 3*xi)
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (g GeneralizedPareto) StdDev() float64 {
+	return math.Sqrt(g.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (g GeneralizedPareto) Survival(x float64) float64 {
+	logs, _ := g.logSurvival(x)
+	return math.Exp(logs)
+}
+
+// Variance returns the variance of the probability distribution. It is
+// +Inf if ξ ≥ 1/2.
+func (g GeneralizedPareto) Variance() float64 {
+	xi := g.Xi
+	if xi >= 0.5 {
+		return math.Inf(1)
+	}
+	return g.Sigma * g.Sigma / ((1 - xi) * (1 - xi) * (1 - 2*xi))
+}
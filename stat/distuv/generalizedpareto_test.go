@@ -0,0 +1,106 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestGeneralizedParetoProbCDF(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		x, mu, sigma, xi, wantProb, wantCDF float64
+	}{
+		// Values calculated from the closed forms.
+		{0.5, 0, 1, 0.3, 0.5457277338140649, 0.3724131061138254},
+		{2, 1, 2, -0.4, 0.3577708763999664, 0.4275665977600538},
+		{5.5, 1, 2, -0.4, 0.015811388300841892, 0.9968377223398316},
+		{4, -1, 0.5, 0.8, 0.014255562202212982, 0.9358499700900416},
+		// Outside the support.
+		{-0.5, 0, 1, 0.3, 0, 0},
+		{7, 1, 2, -0.4, 0, 1},
+	} {
+		g := GeneralizedPareto{Mu: test.mu, Sigma: test.sigma, Xi: test.xi}
+		if got := g.Prob(test.x); !scalar.EqualWithinAbsOrRel(got, test.wantProb, 1e-13, 1e-13) {
+			t.Errorf("Prob mismatch, x = %v, %+v: got %v, want %v", test.x, g, got, test.wantProb)
+		}
+		if got := g.CDF(test.x); !scalar.EqualWithinAbsOrRel(got, test.wantCDF, 1e-13, 1e-13) {
+			t.Errorf("CDF mismatch, x = %v, %+v: got %v, want %v", test.x, g, got, test.wantCDF)
+		}
+	}
+
+	for _, test := range []struct {
+		gpd  GeneralizedPareto
+		want cumulantProber
+	}{
+		// With ξ = 0 the distribution is the exponential distribution.
+		{GeneralizedPareto{Sigma: 0.5}, Exponential{Rate: 2}},
+		// With μ = x_m, σ = x_m/α and ξ = 1/α the distribution is the
+		// Pareto distribution.
+		{GeneralizedPareto{Mu: 2, Sigma: 2.0 / 3, Xi: 1.0 / 3}, Pareto{Xm: 2, Alpha: 3}},
+	} {
+		for _, x := range []float64{2.1, 3, 5, 10} {
+			if got, want := test.gpd.Prob(x), test.want.Prob(x); !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+				t.Errorf("Prob mismatch, x = %v, %+v: got %v, want %v", x, test.gpd, got, want)
+			}
+			if got, want := test.gpd.CDF(x), test.want.CDF(x); !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+				t.Errorf("CDF mismatch, x = %v, %+v: got %v, want %v", x, test.gpd, got, want)
+			}
+		}
+	}
+}
+
+func TestGeneralizedPareto(t *testing.T) {
+	t.Parallel()
+	src := rand.New(rand.NewSource(1))
+	for i, g := range []GeneralizedPareto{
+		{0, 1, 0.1, src},
+		{1, 2, -0.4, src},
+		{-3, 0.5, 0, src},
+		{5, 3, -1.2, src},
+	} {
+		testGeneralizedPareto(t, g, i)
+	}
+}
+
+func testGeneralizedPareto(t *testing.T, g GeneralizedPareto, i int) {
+	const (
+		tol  = 1e-2
+		n    = 5e5
+		bins = 50
+	)
+	x := make([]float64, n)
+	generateSamples(x, g)
+	sort.Float64s(x)
+
+	lo, hi := g.Quantile(0), g.Quantile(1)
+	testRandLogProbContinuous(t, i, lo, x, g, tol, bins)
+	checkProbContinuous(t, i, x, lo, hi, g, 1e-6)
+	checkProbQuantContinuous(t, i, x, g, tol)
+	checkEntropy(t, i, x, g, tol)
+	checkMean(t, i, x, g, tol)
+	checkMedian(t, i, x, g, tol)
+	checkVarAndStd(t, i, x, g, tol)
+	if g.Xi < 0.2 {
+		checkSkewness(t, i, x, g, 5e-2)
+		checkExKurtosis(t, i, x, g, 2e-1)
+	}
+	checkQuantileCDFSurvival(t, i, x, g, tol)
+	wantMode := g.Mu
+	if g.Xi < -1 {
+		wantMode = hi
+	}
+	if g.Mode() != wantMode {
+		t.Errorf("Mismatch in Mode: got %v, want %v", g.Mode(), wantMode)
+	}
+	if g.NumParameters() != 3 {
+		t.Errorf("Mismatch in NumParameters: got %v, want 3", g.NumParameters())
+	}
+}
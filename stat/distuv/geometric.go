@@ -0,0 +1,182 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+)
+
+// Geometric implements the geometric distribution, a discrete probability
+// distribution that expresses the number of failures before the first success
+// in a sequence of Bernoulli trials, each with success probability p.
+// The geometric distribution has the density function:
+//
+//	f(k) = p (1-p)^k
+//
+// For more information, see https://en.wikipedia.org/wiki/Geometric_distribution.
+type Geometric struct {
+	// P is the probability of success in any given trial. P must be in (0, 1].
+	P float64
+
+	Src rand.Source
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (g Geometric) CDF(x float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	return -math.Expm1((math.Floor(x) + 1) * math.Log1p(-g.P))
+}
+
+// Entropy returns the entropy of the distribution.
+func (g Geometric) Entropy() float64 {
+	if g.P == 1 {
+		return 0
+	}
+	return -((1-g.P)*math.Log1p(-g.P) + g.P*math.Log(g.P)) / g.P
+}
+
+// ExKurtosis returns the excess kurtosis of the distribution.
+func (g Geometric) ExKurtosis() float64 {
+	return 6 + g.P*g.P/(1-g.P)
+}
+
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The maximum likelihood estimate of P is 1/(1+m), where m is the weighted
+// mean of the samples.
+func (g *Geometric) Fit(samples, weights []float64) {
+	checkFit(samples, weights)
+	mean, _ := weightedMean(identity, samples, weights)
+	g.P = 1 / (1 + mean)
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (g Geometric) LogProb(x float64) float64 {
+	if x < 0 || math.Floor(x) != x {
+		return math.Inf(-1)
+	}
+	if x == 0 {
+		return math.Log(g.P)
+	}
+	return math.Log(g.P) + x*math.Log1p(-g.P)
+}
+
+// Mean returns the mean of the probability distribution.
+func (g Geometric) Mean() float64 {
+	return (1 - g.P) / g.P
+}
+
+// Median returns the median of the probability distribution.
+func (g Geometric) Median() float64 {
+	return g.Quantile(0.5)
+}
+
+// Mode returns the mode of the probability distribution.
+func (Geometric) Mode() float64 {
+	return 0
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (Geometric) NumParameters() int {
+	return 1
+}
+
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in place into p, which must have length
+// NumParameters, and returned.
+func (g Geometric) Parameters(p []Parameter) []Parameter {
+	nParam := g.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("geometric: improper parameter length")
+	}
+	p[0].Name = "P"
+	p[0].Value = g.P
+	return p
+}
+
+// Prob computes the value of the probability density function at x.
+func (g Geometric) Prob(x float64) float64 {
+	return math.Exp(g.LogProb(x))
+}
+
+// Quantile returns the smallest k such that CDF(k) ≥ p.
+// Quantile panics if p is not in the interval [0, 1].
+func (g Geometric) Quantile(p float64) float64 {
+	if p < 0 || p > 1 {
+		panic(badPercentile)
+	}
+	if p == 1 {
+		if g.P == 1 {
+			return 0
+		}
+		return math.Inf(1)
+	}
+	k := math.Max(0, math.Ceil(math.Log1p(-p)/math.Log1p(-g.P))-1)
+	// Correct for rounding in the logarithms.
+	for k > 0 && g.CDF(k-1) >= p {
+		k--
+	}
+	for g.CDF(k) < p {
+		k++
+	}
+	return k
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (g Geometric) Rand() float64 {
+	if g.P == 1 {
+		return 0
+	}
+	rnd := rand.ExpFloat64
+	if g.Src != nil {
+		rnd = rand.New(g.Src).ExpFloat64
+	}
+	return math.Floor(-rnd() / math.Log1p(-g.P))
+}
+
+// SetParameters sets the parameters of the distribution to p. It panics if
+// the length or the names of p do not match those returned by Parameters.
+func (g *Geometric) SetParameters(p []Parameter) {
+	if len(p) != 1 {
+		panic("geometric: incorrect number of parameters to set")
+	}
+	if p[0].Name != "P" {
+		panic("geometric: " + panicNameMismatch)
+	}
+	g.P = p[0].Value
+}
+
+// Skewness returns the skewness of the distribution.
+func (g Geometric) Skewness() float64 {
+	return (2 - g.P) / math.Sqrt(1-g.P)
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (g Geometric) StdDev() float64 {
+	return math.Sqrt(g.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (g Geometric) Survival(x float64) float64 {
+	if x < 0 {
+		return 1
+	}
+	return math.Exp((math.Floor(x) + 1) * math.Log1p(-g.P))
+}
+
+// Variance returns the variance of the probability distribution.
+func (g Geometric) Variance() float64 {
+	return (1 - g.P) / (g.P * g.P)
+}
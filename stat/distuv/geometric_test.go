@@ -0,0 +1,85 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestGeometricProbCDF(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		x, p, wantProb, wantCDF float64
+	}{
+		{0, 0.3, 0.3, 0.3},
+		{2, 0.3, 0.147, 0.657},
+		{2.5, 0.3, 0, 0.657},
+		{-1, 0.3, 0, 0},
+		{4, 0.9, 0.9e-4, 0.99999},
+		{3, 1, 0, 1},
+	} {
+		g := Geometric{P: test.p}
+		if got := g.Prob(test.x); !scalar.EqualWithinAbsOrRel(got, test.wantProb, 1e-14, 1e-14) {
+			t.Errorf("Prob mismatch, x = %v, p = %v: got %v, want %v", test.x, test.p, got, test.wantProb)
+		}
+		if got := g.CDF(test.x); !scalar.EqualWithinAbsOrRel(got, test.wantCDF, 1e-14, 1e-14) {
+			t.Errorf("CDF mismatch, x = %v, p = %v: got %v, want %v", test.x, test.p, got, test.wantCDF)
+		}
+		want := NegativeBinomial{R: 1, P: test.p}.Prob(test.x)
+		if got := g.Prob(test.x); !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+			t.Errorf("Prob mismatch with negative binomial, x = %v, p = %v: got %v, want %v", test.x, test.p, got, want)
+		}
+	}
+}
+
+func TestGeometric(t *testing.T) {
+	t.Parallel()
+	src := rand.New(rand.NewSource(1))
+	for i, g := range []Geometric{
+		{0.5, src},
+		{0.05, src},
+		{0.9, src},
+	} {
+		testGeometric(t, g, i)
+	}
+}
+
+func testGeometric(t *testing.T, g Geometric, i int) {
+	const (
+		tol = 2e-2
+		n   = 1e6
+	)
+	x := make([]float64, n)
+	generateSamples(x, g)
+	sort.Float64s(x)
+
+	checkProbDiscrete(t, i, x, g, 2e-3)
+	checkMean(t, i, x, g, tol)
+	checkVarAndStd(t, i, x, g, tol)
+	checkEntropy(t, i, x, g, tol)
+	checkSkewness(t, i, x, g, 5e-2)
+	checkExKurtosis(t, i, x, g, 2e-1)
+	checkMedian(t, i, x, g, tol)
+
+	hi := g.Quantile(1 - 1e-15)
+	checkCDFDiscrete(t, i, 0, hi, g, 1e-12)
+	checkMomentsDiscrete(t, i, 0, 4*hi, g, 1e-8)
+
+	if q := g.Quantile(1); !math.IsInf(q, 1) {
+		t.Errorf("Mismatch in Quantile(1): got %v, want +Inf", q)
+	}
+	if g.Mode() != 0 {
+		t.Errorf("Mismatch in Mode: got %v, want 0", g.Mode())
+	}
+	if g.NumParameters() != 1 {
+		t.Errorf("Mismatch in NumParameters: got %v, want 1", g.NumParameters())
+	}
+}
@@ -48,6 +48,53 @@ func (g GumbelRight) ExKurtosis() float64 {
 	return 12.0 / 5
 }
 
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The maximum likelihood estimate of Beta is the root of
+//
+//	Beta = mean(x) - Σ w x exp(-x/Beta) / Σ w exp(-x/Beta),
+//
+// where the mean is weighted, and that of Mu is
+// -Beta log(mean(exp(-x/Beta))).
+func (g *GumbelRight) Fit(samples, weights []float64) {
+	checkFit(samples, weights)
+	// Shift the samples by the smallest so that the exponentials do not
+	// overflow.
+	xMin := math.Inf(1)
+	for i, x := range samples {
+		if sampleWeight(weights, i) != 0 {
+			xMin = math.Min(xMin, x)
+		}
+	}
+	mean, sumWeights := weightedMean(func(x float64) float64 { return x - xMin }, samples, weights)
+	sumExp := func(beta float64) (s0, s1 float64) {
+		for i, x := range samples {
+			w := sampleWeight(weights, i)
+			if w == 0 {
+				continue
+			}
+			y := x - xMin
+			e := math.Exp(-y / beta)
+			s0 += w * e
+			s1 += w * y * e
+		}
+		return s0, s1
+	}
+	variance, _ := weightedMean(func(x float64) float64 {
+		d := x - xMin - mean
+		return d * d
+	}, samples, weights)
+	g.Beta = findRoot(func(beta float64) float64 {
+		s0, s1 := sumExp(beta)
+		return beta - mean + s1/s0
+	}, math.Sqrt(6*variance)/math.Pi)
+	s0, _ := sumExp(g.Beta)
+	g.Mu = xMin - g.Beta*math.Log(s0/sumWeights)
+}
+
 // LogProb computes the natural logarithm of the value of the probability density function at x.
 func (g GumbelRight) LogProb(x float64) float64 {
 	z := g.z(x)
@@ -74,6 +121,23 @@ func (GumbelRight) NumParameters() int {
 	return 2
 }
 
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in place into p, which must have length
+// NumParameters, and returned.
+func (g GumbelRight) Parameters(p []Parameter) []Parameter {
+	nParam := g.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("gumbelright: improper parameter length")
+	}
+	p[0].Name = "Mu"
+	p[0].Value = g.Mu
+	p[1].Name = "Beta"
+	p[1].Value = g.Beta
+	return p
+}
+
 // Prob computes the value of the probability density function at x.
 func (g GumbelRight) Prob(x float64) float64 {
 	return math.Exp(g.LogProb(x))
@@ -98,6 +162,22 @@ func (g GumbelRight) Rand() float64 {
 	return g.Mu - g.Beta*math.Log(rnd)
 }
 
+// SetParameters sets the parameters of the distribution to p. It panics if
+// the length or the names of p do not match those returned by Parameters.
+func (g *GumbelRight) SetParameters(p []Parameter) {
+	if len(p) != g.NumParameters() {
+		panic("gumbelright: incorrect number of parameters to set")
+	}
+	if p[0].Name != "Mu" {
+		panic("gumbelright: " + panicNameMismatch)
+	}
+	if p[1].Name != "Beta" {
+		panic("gumbelright: " + panicNameMismatch)
+	}
+	g.Mu = p[0].Value
+	g.Beta = p[1].Value
+}
+
 // Skewness returns the skewness of the distribution.
 func (GumbelRight) Skewness() float64 {
 	return 12 * math.Sqrt(6) * apery / (math.Pi * math.Pi * math.Pi)
@@ -0,0 +1,270 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/stat/combin"
+)
+
+// Hypergeometric implements the hypergeometric distribution, a discrete
+// probability distribution that expresses the number of successes in n draws
+// without replacement from a population of size N that contains K successes.
+// The hypergeometric distribution has the density function:
+//
+//	f(k) = (K choose k) (N-K choose n-k) / (N choose n)
+//
+// for max(0, n+K-N) ≤ k ≤ min(n, K).
+//
+// For more information, see https://en.wikipedia.org/wiki/Hypergeometric_distribution.
+type Hypergeometric struct {
+	// Population is the size of the population, N. Population must be
+	// a non-negative integer.
+	Population float64
+	// Successes is the number of successes in the population, K.
+	// Successes must be an integer in [0, Population].
+	Successes float64
+	// Draws is the number of draws, n. Draws must be an integer in
+	// [0, Population].
+	Draws float64
+
+	Src rand.Source
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (h Hypergeometric) CDF(x float64) float64 {
+	lo, hi := h.bounds()
+	switch {
+	case x < lo:
+		return 0
+	case x >= hi:
+		return 1
+	}
+	x = math.Floor(x)
+	if x < h.Mean() {
+		return h.sum(lo, x)
+	}
+	return 1 - h.sum(x+1, hi)
+}
+
+// ExKurtosis returns the excess kurtosis of the distribution.
+func (h Hypergeometric) ExKurtosis() float64 {
+	N, K, n := h.Population, h.Successes, h.Draws
+	v := n * K * (N - K) * (N - n)
+	return ((N-1)*N*N*(N*(N+1)-6*K*(N-K)-6*n*(N-n)) + 6*v*(5*N-6)) / (v * (N - 2) * (N - 3))
+}
+
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// Only Successes is fitted. Population and Draws must be set before calling
+// Fit, and Fit panics if a sample lies outside [0, Draws]. Successes is set
+// to the integer that maximizes the likelihood, which is found by searching
+// from the method of moments estimate. Fit panics if no number of successes
+// is consistent with the samples.
+func (h *Hypergeometric) Fit(samples, weights []float64) {
+	checkFit(samples, weights)
+	N, n := h.Population, h.Draws
+	// The samples must lie in [max(0, n+K-N), min(n, K)].
+	lo, hi := 0.0, N
+	for i, x := range samples {
+		if sampleWeight(weights, i) == 0 {
+			continue
+		}
+		if x < 0 || x > n || math.Floor(x) != x {
+			panic("hypergeometric: sample out of range")
+		}
+		lo = math.Max(lo, x)
+		hi = math.Min(hi, N-n+x)
+	}
+	if lo > hi {
+		panic("hypergeometric: no feasible number of successes")
+	}
+	loglik := func(k float64) float64 {
+		return logLikelihood(Hypergeometric{Population: N, Successes: k, Draws: n}, samples, weights)
+	}
+	// The likelihood is unimodal in K.
+	mean, _ := weightedMean(identity, samples, weights)
+	k := lo
+	if n > 0 {
+		k = math.Max(lo, math.Min(hi, math.Round(N*mean/n)))
+	}
+	l := loglik(k)
+	for k < hi {
+		next := loglik(k + 1)
+		if next <= l {
+			break
+		}
+		k, l = k+1, next
+	}
+	for k > lo {
+		next := loglik(k - 1)
+		if next <= l {
+			break
+		}
+		k, l = k-1, next
+	}
+	h.Successes = k
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (h Hypergeometric) LogProb(x float64) float64 {
+	lo, hi := h.bounds()
+	if x < lo || x > hi || math.Floor(x) != x {
+		return math.Inf(-1)
+	}
+	N, K, n := h.Population, h.Successes, h.Draws
+	return combin.LogGeneralizedBinomial(K, x) + combin.LogGeneralizedBinomial(N-K, n-x) - combin.LogGeneralizedBinomial(N, n)
+}
+
+// Mean returns the mean of the probability distribution.
+func (h Hypergeometric) Mean() float64 {
+	return h.Draws * h.Successes / h.Population
+}
+
+// Mode returns the mode of the probability distribution.
+func (h Hypergeometric) Mode() float64 {
+	return math.Floor((h.Draws + 1) * (h.Successes + 1) / (h.Population + 2))
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (Hypergeometric) NumParameters() int {
+	return 3
+}
+
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in place into p, which must have length
+// NumParameters, and returned.
+func (h Hypergeometric) Parameters(p []Parameter) []Parameter {
+	nParam := h.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("hypergeometric: improper parameter length")
+	}
+	p[0].Name = "Population"
+	p[0].Value = h.Population
+	p[1].Name = "Successes"
+	p[1].Value = h.Successes
+	p[2].Name = "Draws"
+	p[2].Value = h.Draws
+	return p
+}
+
+// Prob computes the value of the probability density function at x.
+func (h Hypergeometric) Prob(x float64) float64 {
+	return math.Exp(h.LogProb(x))
+}
+
+// Quantile returns the smallest k such that CDF(k) ≥ p.
+// Quantile panics if p is not in the interval [0, 1].
+func (h Hypergeometric) Quantile(p float64) float64 {
+	lo, hi := h.bounds()
+	return discreteQuantile(h.CDF, p, lo, hi, h.Mean())
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (h Hypergeometric) Rand() float64 {
+	rnd := rand.Float64
+	if h.Src != nil {
+		rnd = rand.New(h.Src).Float64
+	}
+	N, K, n := h.Population, h.Successes, h.Draws
+	lo, hi := h.bounds()
+	if p := h.Prob(lo); p > 0 {
+		// Invert the distribution function, computing the probabilities
+		// by the recurrence f(k+1)/f(k) = (K-k)(n-k)/((k+1)(N-K-n+k+1)).
+		u := rnd()
+		k := lo
+		for k < hi && u > p {
+			u -= p
+			p *= (K - k) * (n - k) / ((k + 1) * (N - K - n + k + 1))
+			k++
+		}
+		return k
+	}
+	// Simulate the draws.
+	var k float64
+	for i := 0.0; i < n; i++ {
+		if rnd()*(N-i) < K-k {
+			k++
+		}
+	}
+	return k
+}
+
+// SetParameters sets the parameters of the distribution to p. It panics if
+// the length or the names of p do not match those returned by Parameters.
+func (h *Hypergeometric) SetParameters(p []Parameter) {
+	if len(p) != 3 {
+		panic("hypergeometric: incorrect number of parameters to set")
+	}
+	if p[0].Name != "Population" {
+		panic("hypergeometric: " + panicNameMismatch)
+	}
+	if p[1].Name != "Successes" {
+		panic("hypergeometric: " + panicNameMismatch)
+	}
+	if p[2].Name != "Draws" {
+		panic("hypergeometric: " + panicNameMismatch)
+	}
+	h.Population = p[0].Value
+	h.Successes = p[1].Value
+	h.Draws = p[2].Value
+}
+
+// Skewness returns the skewness of the distribution.
+func (h Hypergeometric) Skewness() float64 {
+	N, K, n := h.Population, h.Successes, h.Draws
+	return (N - 2*K) * math.Sqrt(N-1) * (N - 2*n) / (math.Sqrt(n*K*(N-K)*(N-n)) * (N - 2))
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (h Hypergeometric) StdDev() float64 {
+	return math.Sqrt(h.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (h Hypergeometric) Survival(x float64) float64 {
+	lo, hi := h.bounds()
+	switch {
+	case x < lo:
+		return 1
+	case x >= hi:
+		return 0
+	}
+	x = math.Floor(x)
+	if x < h.Mean() {
+		return 1 - h.sum(lo, x)
+	}
+	return h.sum(x+1, hi)
+}
+
+// Variance returns the variance of the probability distribution.
+func (h Hypergeometric) Variance() float64 {
+	N, K, n := h.Population, h.Successes, h.Draws
+	return n * K * (N - K) * (N - n) / (N * N * (N - 1))
+}
+
+// bounds returns the smallest and largest values in the support of the
+// distribution.
+func (h Hypergeometric) bounds() (lo, hi float64) {
+	return math.Max(0, h.Draws+h.Successes-h.Population), math.Min(h.Draws, h.Successes)
+}
+
+// sum returns the sum of the probabilities of the integers in [a, b].
+func (h Hypergeometric) sum(a, b float64) float64 {
+	var s float64
+	for k := a; k <= b; k++ {
+		s += h.Prob(k)
+	}
+	return s
+}
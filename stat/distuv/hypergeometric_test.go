@@ -0,0 +1,141 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestHypergeometricProbCDF(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		x, wantProb, wantCDF float64
+	}{
+		// Values calculated from the binomial coefficients in exact
+		// rational arithmetic.
+		{0, 0.04602034214577739, 0.04602034214577739},
+		{2, 0.31420785327116973, 0.5506571973994743},
+		{5, 0.038700087499674865, 0.992675161073836},
+		{8, 3.387612701302071e-05, 0.9999991797340512},
+		{2.5, 0, 0.5506571973994743},
+		{11, 0, 1},
+		{-1, 0, 0},
+	} {
+		h := Hypergeometric{Population: 50, Successes: 12, Draws: 10}
+		if got := h.Prob(test.x); !scalar.EqualWithinAbsOrRel(got, test.wantProb, 1e-13, 1e-13) {
+			t.Errorf("Prob mismatch, x = %v: got %v, want %v", test.x, got, test.wantProb)
+		}
+		if got := h.CDF(test.x); !scalar.EqualWithinAbsOrRel(got, test.wantCDF, 1e-13, 1e-13) {
+			t.Errorf("CDF mismatch, x = %v: got %v, want %v", test.x, got, test.wantCDF)
+		}
+	}
+}
+
+func TestHypergeometric(t *testing.T) {
+	t.Parallel()
+	src := rand.New(rand.NewSource(1))
+	for i, h := range []Hypergeometric{
+		{50, 12, 10, src},
+		{20, 15, 12, src},
+		{1000, 300, 500, src},
+	} {
+		testHypergeometric(t, h, i)
+	}
+}
+
+func testHypergeometric(t *testing.T, h Hypergeometric, i int) {
+	const (
+		tol = 2e-2
+		n   = 2e5
+	)
+	x := make([]float64, n)
+	generateSamples(x, h)
+	sort.Float64s(x)
+
+	checkProbDiscrete(t, i, x, h, 5e-3)
+	checkMean(t, i, x, h, tol)
+	checkVarAndStd(t, i, x, h, tol)
+
+	lo, hi := h.bounds()
+	checkCDFDiscrete(t, i, lo, hi, h, 1e-12)
+	checkMomentsDiscrete(t, i, lo, hi, h, 1e-8)
+
+	mode := h.Mode()
+	if h.Prob(mode) < h.Prob(mode-1) || h.Prob(mode) < h.Prob(mode+1) {
+		t.Errorf("Mode %v is not a maximum of Prob case %v", mode, i)
+	}
+	if h.NumParameters() != 3 {
+		t.Errorf("Mismatch in NumParameters: got %v, want 3", h.NumParameters())
+	}
+}
+
+func TestHypergeometricFit(t *testing.T) {
+	t.Parallel()
+	src := rand.NewSource(1)
+	rnd := rand.New(src)
+	for _, want := range []Hypergeometric{
+		{Population: 50, Successes: 20, Draws: 10},
+		{Population: 100, Successes: 3, Draws: 40},
+		{Population: 30, Successes: 28, Draws: 25},
+	} {
+		want.Src = src
+		x := make([]float64, 200)
+		w := make([]float64, len(x))
+		for i := range x {
+			x[i] = want.Rand()
+			w[i] = rnd.Float64()
+		}
+		got := Hypergeometric{Population: want.Population, Draws: want.Draws}
+		got.Fit(x, w)
+
+		// The fitted number of successes maximizes the likelihood over
+		// all possible numbers of successes.
+		best := logLikelihood(got, x, w)
+		for k := 0.0; k <= want.Population; k++ {
+			h := Hypergeometric{Population: want.Population, Successes: k, Draws: want.Draws}
+			if l := logLikelihood(h, x, w); l > best {
+				t.Errorf("%v: Successes %v has greater likelihood than fitted %v: %v > %v", want, k, got.Successes, l, best)
+			}
+		}
+		if d := got.Successes - want.Successes; d < -3 || d > 3 {
+			t.Errorf("%v: Successes far from truth: got %v", want, got.Successes)
+		}
+	}
+
+	h := Hypergeometric{Population: 10, Draws: 5}
+	if !panics(func() { h.Fit([]float64{6}, nil) }) {
+		t.Error("expected panic for sample greater than Draws")
+	}
+	h.Population = 8
+	if !panics(func() { h.Fit([]float64{0, 5}, nil) }) {
+		t.Error("expected panic for samples with no feasible number of successes")
+	}
+}
+
+func TestHypergeometricParameters(t *testing.T) {
+	t.Parallel()
+	want := Hypergeometric{Population: 50, Successes: 12, Draws: 10}
+	p := want.Parameters(nil)
+	if len(p) != want.NumParameters() {
+		t.Fatalf("unexpected number of parameters: got %v, want %v", len(p), want.NumParameters())
+	}
+	var got Hypergeometric
+	got.SetParameters(p)
+	if got != want {
+		t.Errorf("unexpected parameters after round trip: got %+v, want %+v", got, want)
+	}
+	p[1].Name = "K"
+	if !panics(func() { got.SetParameters(p) }) {
+		t.Error("expected panic for mismatched parameter name")
+	}
+	if !panics(func() { got.Parameters(make([]Parameter, 2)) }) {
+		t.Error("expected panic for wrong parameter slice length")
+	}
+}
@@ -50,6 +50,21 @@ func (g InverseGamma) ExKurtosis() float64 {
 	return (30*g.Alpha - 66) / (g.Alpha - 3) / (g.Alpha - 4)
 }
 
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The reciprocals of the samples are gamma distributed with shape Alpha and
+// rate Beta, so the maximum likelihood estimates are those of the gamma
+// distribution fitted to the reciprocals.
+func (g *InverseGamma) Fit(samples, weights []float64) {
+	checkFit(samples, weights)
+	mean, _ := weightedMean(func(x float64) float64 { return 1 / x }, samples, weights)
+	meanLog, _ := weightedMean(math.Log, samples, weights)
+	g.Alpha, g.Beta = gammaMLE(mean, -meanLog)
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x.
 func (g InverseGamma) LogProb(x float64) float64 {
@@ -80,6 +95,23 @@ func (InverseGamma) NumParameters() int {
 	return 2
 }
 
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in place into p, which must have length
+// NumParameters, and returned.
+func (g InverseGamma) Parameters(p []Parameter) []Parameter {
+	nParam := g.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("inversegamma: improper parameter length")
+	}
+	p[0].Name = "Alpha"
+	p[0].Value = g.Alpha
+	p[1].Name = "Beta"
+	p[1].Value = g.Beta
+	return p
+}
+
 // Prob computes the value of the probability density function at x.
 func (g InverseGamma) Prob(x float64) float64 {
 	return math.Exp(g.LogProb(x))
@@ -101,6 +133,22 @@ func (g InverseGamma) Rand() float64 {
 	return 1 / Gamma(g).Rand()
 }
 
+// SetParameters sets the parameters of the distribution to p. It panics if
+// the length or the names of p do not match those returned by Parameters.
+func (g *InverseGamma) SetParameters(p []Parameter) {
+	if len(p) != g.NumParameters() {
+		panic("inversegamma: incorrect number of parameters to set")
+	}
+	if p[0].Name != "Alpha" {
+		panic("inversegamma: " + panicNameMismatch)
+	}
+	if p[1].Name != "Beta" {
+		panic("inversegamma: " + panicNameMismatch)
+	}
+	g.Alpha = p[0].Value
+	g.Beta = p[1].Value
+}
+
 // Survival returns the survival function (complementary CDF) at x.
 func (g InverseGamma) Survival(x float64) float64 {
 	if x < 0 {
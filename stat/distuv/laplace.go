@@ -58,27 +58,14 @@ func (l *Laplace) Fit(samples, weights []float64) {
 		return
 	}
 
-	var (
-		sortedSamples []float64
-		sortedWeights []float64
-	)
-	if sort.Float64sAreSorted(samples) {
-		sortedSamples = samples
-		sortedWeights = weights
-	} else {
-		// Need to copy variables so the input variables aren't effected by the sorting
-		sortedSamples = make([]float64, len(samples))
-		copy(sortedSamples, samples)
-		sortedWeights := make([]float64, len(samples))
-		copy(sortedWeights, weights)
-
-		stat.SortWeighted(sortedSamples, sortedWeights)
-	}
-
 	// The (weighted) median of the samples is the maximum likelihood estimate
 	// of the mean parameter
 	// TODO: Rethink quantile type when stat has more options
-	l.Mu = stat.Quantile(0.5, stat.Empirical, sortedSamples, sortedWeights)
+	if sort.Float64sAreSorted(samples) {
+		l.Mu = stat.Quantile(0.5, stat.Empirical, samples, weights)
+	} else {
+		l.Mu = weightedMedian(samples, weights)
+	}
 
 	// The scale parameter is the average absolute distance
 	// between the sample and the mean
@@ -104,8 +91,10 @@ func (l Laplace) LogProb(x float64) float64 {
 	return -math.Ln2 - math.Log(l.Scale) - math.Abs(x-l.Mu)/l.Scale
 }
 
-// parameters returns the parameters of the distribution.
-func (l Laplace) parameters(p []Parameter) []Parameter {
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in place into p, which must have length
+// NumParameters, and returned.
+func (l Laplace) Parameters(p []Parameter) []Parameter {
 	nParam := l.NumParameters()
 	if p == nil {
 		p = make([]Parameter, nParam)
@@ -246,8 +235,9 @@ func (l Laplace) Survival(x float64) float64 {
 	return 0.5 * math.Exp(-(x-l.Mu)/l.Scale)
 }
 
-// setParameters modifies the parameters of the distribution.
-func (l *Laplace) setParameters(p []Parameter) {
+// SetParameters sets the parameters of the distribution to p. It panics if
+// the length or the names of p do not match those returned by Parameters.
+func (l *Laplace) SetParameters(p []Parameter) {
 	if len(p) != l.NumParameters() {
 		panic(badLength)
 	}
@@ -32,10 +32,31 @@ func (l Logistic) ExKurtosis() float64 {
 	return 6.0 / 5.0
 }
 
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The maximum likelihood estimates are found numerically, starting from
+// the method of moments estimates.
+func (l *Logistic) Fit(samples, weights []float64) {
+	checkFit(samples, weights)
+	mean, _ := weightedMean(identity, samples, weights)
+	variance, _ := weightedMean(func(x float64) float64 { return (x - mean) * (x - mean) }, samples, weights)
+	p := []float64{mean, math.Log(math.Sqrt(3*variance) / math.Pi)}
+	maximize(func(p []float64) float64 {
+		return logLikelihood(Logistic{Mu: p[0], S: math.Exp(p[1])}, samples, weights)
+	}, p)
+	l.Mu, l.S = p[0], math.Exp(p[1])
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x.
 func (l Logistic) LogProb(x float64) float64 {
-	return x - 2*math.Log(math.Exp(x)+1)
+	// The density is symmetric about Mu, so it is evaluated in the lower
+	// tail where exp(-|z|) does not overflow.
+	z := math.Abs(x-l.Mu) / l.S
+	return -z - 2*math.Log1p(math.Exp(-z)) - math.Log(l.S)
 }
 
 // Mean returns the mean of the probability distribution.
@@ -64,6 +85,23 @@ func (l Logistic) NumParameters() int {
 	return 2
 }
 
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in place into p, which must have length
+// NumParameters, and returned.
+func (l Logistic) Parameters(p []Parameter) []Parameter {
+	nParam := l.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("logistic: improper parameter length")
+	}
+	p[0].Name = "Mu"
+	p[0].Value = l.Mu
+	p[1].Name = "S"
+	p[1].Value = l.S
+	return p
+}
+
 // Prob computes the value of the probability density function at x.
 func (l Logistic) Prob(x float64) float64 {
 	E := math.Exp(-(x - l.Mu) / l.S)
@@ -75,6 +113,22 @@ func (l Logistic) Quantile(p float64) float64 {
 	return l.Mu + l.S*math.Log(p/(1-p))
 }
 
+// SetParameters sets the parameters of the distribution to p. It panics if
+// the length or the names of p do not match those returned by Parameters.
+func (l *Logistic) SetParameters(p []Parameter) {
+	if len(p) != l.NumParameters() {
+		panic("logistic: incorrect number of parameters to set")
+	}
+	if p[0].Name != "Mu" {
+		panic("logistic: " + panicNameMismatch)
+	}
+	if p[1].Name != "S" {
+		panic("logistic: " + panicNameMismatch)
+	}
+	l.Mu = p[0].Value
+	l.S = p[1].Value
+}
+
 // Skewness returns the skewness of the distribution.
 //
 // Always 0 for Logistic distribution.
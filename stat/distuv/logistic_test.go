@@ -144,6 +144,26 @@ func TestLogisticLogProb(t *testing.T) {
 	if result := l.LogProb(input); result != want {
 		t.Errorf("Wrong LogProb(%f) with Mu=%f, S=%f: %f != %f", input, l.Mu, l.S, result, want)
 	}
+
+	for _, v := range []struct {
+		mu, s, input float64
+	}{
+		{-0.5, 1.0, 0.0},
+		{69.0, 420.0, 42.0},
+		{2.0, 0.5, -3.0},
+		{2.0, 0.5, 7.0},
+	} {
+		l := Logistic{Mu: v.mu, S: v.s}
+		want := math.Log(l.Prob(v.input))
+		if result := l.LogProb(v.input); !scalar.EqualWithinAbsOrRel(result, want, 1e-14, 1e-14) {
+			t.Errorf("Wrong LogProb(%f) with Mu=%f, S=%f: %v != %v", v.input, l.Mu, l.S, result, want)
+		}
+	}
+	// The log density is finite far in the tails.
+	l = Logistic{Mu: 0, S: 1}
+	if result := l.LogProb(1000); result != -1000 {
+		t.Errorf("Wrong LogProb(1000) with Mu=0, S=1: %v != -1000", result)
+	}
 }
 
 func TestQuantile(t *testing.T) {
@@ -36,6 +36,24 @@ func (l LogNormal) ExKurtosis() float64 {
 	return math.Exp(4*s2) + 2*math.Exp(3*s2) + 3*math.Exp(2*s2) - 6
 }
 
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The maximum likelihood estimates of Mu and Sigma are the weighted mean
+// and the biased weighted standard deviation of the logarithms of the
+// samples.
+func (l *LogNormal) Fit(samples, weights []float64) {
+	checkFit(samples, weights)
+	l.Mu, _ = weightedMean(math.Log, samples, weights)
+	variance, _ := weightedMean(func(x float64) float64 {
+		d := math.Log(x) - l.Mu
+		return d * d
+	}, samples, weights)
+	l.Sigma = math.Sqrt(variance)
+}
+
 // LogProb computes the natural logarithm of the value of the probability density function at x.
 func (l LogNormal) LogProb(x float64) float64 {
 	if x < 0 {
@@ -66,6 +84,23 @@ func (LogNormal) NumParameters() int {
 	return 2
 }
 
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in place into p, which must have length
+// NumParameters, and returned.
+func (l LogNormal) Parameters(p []Parameter) []Parameter {
+	nParam := l.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("lognormal: improper parameter length")
+	}
+	p[0].Name = "Mu"
+	p[0].Value = l.Mu
+	p[1].Name = "Sigma"
+	p[1].Value = l.Sigma
+	return p
+}
+
 // Prob computes the value of the probability density function at x.
 func (l LogNormal) Prob(x float64) float64 {
 	return math.Exp(l.LogProb(x))
@@ -91,6 +126,22 @@ func (l LogNormal) Rand() float64 {
 	return math.Exp(rnd*l.Sigma + l.Mu)
 }
 
+// SetParameters sets the parameters of the distribution to p. It panics if
+// the length or the names of p do not match those returned by Parameters.
+func (l *LogNormal) SetParameters(p []Parameter) {
+	if len(p) != 2 {
+		panic("lognormal: incorrect number of parameters to set")
+	}
+	if p[0].Name != "Mu" {
+		panic("lognormal: " + panicNameMismatch)
+	}
+	if p[1].Name != "Sigma" {
+		panic("lognormal: " + panicNameMismatch)
+	}
+	l.Mu = p[0].Value
+	l.Sigma = p[1].Value
+}
+
 // Skewness returns the skewness of the distribution.
 func (l LogNormal) Skewness() float64 {
 	s2 := l.Sigma * l.Sigma
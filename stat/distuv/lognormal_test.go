@@ -62,3 +62,24 @@ func TestLognormalIssue577(t *testing.T) {
 		t.Errorf("LogNormal{0,1}.CDF(%e) is greater than %e. got: %e", x, max, cdf)
 	}
 }
+
+func TestLogNormalParameters(t *testing.T) {
+	t.Parallel()
+	want := LogNormal{Mu: 1, Sigma: 2}
+	p := want.Parameters(nil)
+	if len(p) != want.NumParameters() {
+		t.Fatalf("unexpected number of parameters: got %v, want %v", len(p), want.NumParameters())
+	}
+	var got LogNormal
+	got.SetParameters(p)
+	if got != want {
+		t.Errorf("unexpected parameters after round trip: got %+v, want %+v", got, want)
+	}
+	p[1].Name = "S"
+	if !panics(func() { got.SetParameters(p) }) {
+		t.Error("expected panic for mismatched parameter name")
+	}
+	if !panics(func() { got.Parameters(make([]Parameter, 3)) }) {
+		t.Error("expected panic for wrong parameter slice length")
+	}
+}
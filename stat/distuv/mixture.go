@@ -0,0 +1,281 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+// Mixture is a finite mixture of univariate distributions. Its density is
+//
+//	f(x) = \sum_i w_i f_i(x)
+//
+// where f_i are the densities of the components and w_i are the mixing
+// weights, which sum to one. Mixture must be initialized with NewMixture.
+//
+// CDF, Survival, Quantile and the moments of a Mixture are available when
+// all of the components implement the corresponding methods, and the
+// methods panic otherwise.
+//
+// For more information, see https://en.wikipedia.org/wiki/Mixture_distribution.
+type Mixture struct {
+	components []RandLogProber
+	weights    []float64
+	logWeights []float64
+
+	src rand.Source
+}
+
+// NewMixture returns a mixture of the given components where component i is
+// chosen with probability proportional to weights[i]. All of the weights
+// must be nonnegative, and at least one of the weights must be positive.
+// NewMixture panics if the lengths of components and weights differ.
+func NewMixture(components []RandLogProber, weights []float64, src rand.Source) Mixture {
+	if len(components) != len(weights) {
+		panic("distuv: mixture component and weight length mismatch")
+	}
+	var sum float64
+	for _, w := range weights {
+		if w < 0 || math.IsNaN(w) {
+			panic("distuv: negative mixture weight")
+		}
+		sum += w
+	}
+	if !(sum > 0) || math.IsInf(sum, 1) {
+		panic("distuv: mixture weights must have a positive finite sum")
+	}
+	m := Mixture{
+		components: make([]RandLogProber, len(components)),
+		weights:    make([]float64, len(weights)),
+		logWeights: make([]float64, len(weights)),
+		src:        src,
+	}
+	copy(m.components, components)
+	for i, w := range weights {
+		m.weights[i] = w / sum
+		m.logWeights[i] = math.Log(m.weights[i])
+	}
+	return m
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (m Mixture) CDF(x float64) float64 {
+	var cdf float64
+	for i, c := range m.components {
+		cc, ok := c.(cdfer)
+		if !ok {
+			panic("distuv: mixture component does not implement CDF")
+		}
+		cdf += m.weights[i] * cc.CDF(x)
+	}
+	return math.Min(cdf, 1)
+}
+
+// Component returns the i-th component of the mixture and its weight.
+func (m Mixture) Component(i int) (RandLogProber, float64) {
+	return m.components[i], m.weights[i]
+}
+
+// ExKurtosis returns the excess kurtosis of the distribution.
+func (m Mixture) ExKurtosis() float64 {
+	_, m2, _, m4 := m.moments(true, true)
+	return m4/(m2*m2) - 3
+}
+
+// Len returns the number of components in the mixture.
+func (m Mixture) Len() int {
+	return len(m.components)
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (m Mixture) LogProb(x float64) float64 {
+	lp := make([]float64, len(m.components))
+	for i, c := range m.components {
+		lp[i] = m.logWeights[i] + c.LogProb(x)
+	}
+	return floats.LogSumExp(lp)
+}
+
+// Mean returns the mean of the probability distribution.
+func (m Mixture) Mean() float64 {
+	mean, _, _, _ := m.moments(false, false)
+	return mean
+}
+
+// Median returns the median of the probability distribution.
+func (m Mixture) Median() float64 {
+	return m.Quantile(0.5)
+}
+
+// NumParameters returns the number of parameters in the distribution,
+// which is the total number of parameters of the components plus the
+// number of free weights.
+func (m Mixture) NumParameters() int {
+	n := len(m.components) - 1
+	for _, c := range m.components {
+		p, ok := c.(parameterCounter)
+		if !ok {
+			panic("distuv: mixture component does not implement NumParameters")
+		}
+		n += p.NumParameters()
+	}
+	return n
+}
+
+// Prob computes the value of the probability density function at x.
+func (m Mixture) Prob(x float64) float64 {
+	return math.Exp(m.LogProb(x))
+}
+
+// Quantile returns the inverse of the cumulative distribution function.
+// It is computed by bisection between the quantiles of the components.
+func (m Mixture) Quantile(p float64) float64 {
+	if p < 0 || p > 1 {
+		panic(badPercentile)
+	}
+	lo := math.Inf(1)
+	hi := math.Inf(-1)
+	for i, c := range m.components {
+		if m.weights[i] == 0 {
+			continue
+		}
+		q, ok := c.(Quantiler)
+		if !ok {
+			panic("distuv: mixture component does not implement Quantile")
+		}
+		x := q.Quantile(p)
+		lo = math.Min(lo, x)
+		hi = math.Max(hi, x)
+	}
+	if lo == hi || p == 0 || p == 1 {
+		return lo
+	}
+	for i := 0; i < 200; i++ {
+		mid := lo + (hi-lo)/2
+		if mid <= lo || mid >= hi {
+			break
+		}
+		if m.CDF(mid) < p {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return lo + (hi-lo)/2
+}
+
+// Rand returns a random sample drawn from the distribution. A component is
+// chosen according to the weights, and a sample is drawn from it.
+func (m Mixture) Rand() float64 {
+	var u float64
+	if m.src == nil {
+		u = rand.Float64()
+	} else {
+		u = rand.New(m.src).Float64()
+	}
+	last := 0
+	for i, w := range m.weights {
+		if w == 0 {
+			continue
+		}
+		last = i
+		u -= w
+		if u < 0 {
+			break
+		}
+	}
+	return m.components[last].Rand()
+}
+
+// Skewness returns the skewness of the distribution.
+func (m Mixture) Skewness() float64 {
+	_, m2, m3, _ := m.moments(true, false)
+	return m3 / (m2 * math.Sqrt(m2))
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (m Mixture) StdDev() float64 {
+	return math.Sqrt(m.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (m Mixture) Survival(x float64) float64 {
+	var s float64
+	for i, c := range m.components {
+		cs, ok := c.(survivaler)
+		if !ok {
+			panic("distuv: mixture component does not implement Survival")
+		}
+		s += m.weights[i] * cs.Survival(x)
+	}
+	return math.Min(s, 1)
+}
+
+// Variance returns the variance of the probability distribution.
+func (m Mixture) Variance() float64 {
+	_, m2, _, _ := m.moments(false, false)
+	return m2
+}
+
+// cdfer is a distribution with a cumulative distribution function.
+type cdfer interface {
+	CDF(x float64) float64
+}
+
+// moments returns the mean and the second, third and fourth moments about
+// the mean of the mixture. The third moment is only computed if third is
+// true and the fourth moment only if fourth is true.
+func (m Mixture) moments(third, fourth bool) (mean, m2, m3, m4 float64) {
+	type moment interface {
+		Mean() float64
+		Variance() float64
+	}
+	means := make([]float64, len(m.components))
+	for i, c := range m.components {
+		cm, ok := c.(moment)
+		if !ok {
+			panic("distuv: mixture component does not implement Mean and Variance")
+		}
+		means[i] = cm.Mean()
+		if m.weights[i] != 0 {
+			mean += m.weights[i] * means[i]
+		}
+	}
+	for i, c := range m.components {
+		w := m.weights[i]
+		if w == 0 {
+			continue
+		}
+		v := c.(moment).Variance()
+		d := means[i] - mean
+		d2 := d * d
+		m2 += w * (v + d2)
+		var c3 float64
+		if third || fourth {
+			cs, ok := c.(interface{ Skewness() float64 })
+			if !ok {
+				panic("distuv: mixture component does not implement Skewness")
+			}
+			c3 = cs.Skewness() * v * math.Sqrt(v)
+		}
+		if third {
+			m3 += w * (c3 + 3*v*d + d2*d)
+		}
+		if fourth {
+			ck, ok := c.(interface{ ExKurtosis() float64 })
+			if !ok {
+				panic("distuv: mixture component does not implement ExKurtosis")
+			}
+			c4 := (ck.ExKurtosis() + 3) * v * v
+			m4 += w * (c4 + 4*c3*d + 6*v*d2 + d2*d2)
+		}
+	}
+	return mean, m2, m3, m4
+}
@@ -0,0 +1,151 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestMixtureSingle(t *testing.T) {
+	t.Parallel()
+	g := Gamma{Alpha: 3, Beta: 2}
+	m := NewMixture([]RandLogProber{g, Normal{Mu: 1, Sigma: 1}}, []float64{5, 0}, nil)
+	for _, x := range []float64{0.1, 0.5, 1, 2, 5} {
+		if got, want := m.LogProb(x), g.LogProb(x); !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+			t.Errorf("LogProb mismatch at %v: got %v, want %v", x, got, want)
+		}
+		if got, want := m.CDF(x), g.CDF(x); !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+			t.Errorf("CDF mismatch at %v: got %v, want %v", x, got, want)
+		}
+	}
+	for _, p := range []float64{0.01, 0.3, 0.5, 0.99} {
+		if got, want := m.Quantile(p), g.Quantile(p); !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+			t.Errorf("Quantile mismatch at %v: got %v, want %v", p, got, want)
+		}
+	}
+	for _, test := range []struct {
+		name      string
+		got, want float64
+	}{
+		{"Mean", m.Mean(), g.Mean()},
+		{"Variance", m.Variance(), g.Variance()},
+		{"Skewness", m.Skewness(), 2 / math.Sqrt(g.Alpha)},
+		{"ExKurtosis", m.ExKurtosis(), 6 / g.Alpha},
+	} {
+		if !scalar.EqualWithinAbsOrRel(test.got, test.want, 1e-14, 1e-14) {
+			t.Errorf("%s mismatch: got %v, want %v", test.name, test.got, test.want)
+		}
+	}
+}
+
+func TestMixtureNormalScale(t *testing.T) {
+	t.Parallel()
+	// A scale mixture of centered normal distributions with weights w and
+	// variances v has variance Σ w v and fourth moment 3 Σ w v².
+	const w1, w2, v1, v2 = 0.3, 0.7, 1.0, 4.0
+	m := NewMixture([]RandLogProber{
+		Normal{Mu: 0, Sigma: math.Sqrt(v1)},
+		Normal{Mu: 0, Sigma: math.Sqrt(v2)},
+	}, []float64{3, 7}, nil)
+	variance := w1*v1 + w2*v2
+	exKurt := 3*(w1*v1*v1+w2*v2*v2)/(variance*variance) - 3
+	if got := m.Variance(); !scalar.EqualWithinAbsOrRel(got, variance, 1e-14, 1e-14) {
+		t.Errorf("Variance mismatch: got %v, want %v", got, variance)
+	}
+	if got := m.ExKurtosis(); !scalar.EqualWithinAbsOrRel(got, exKurt, 1e-14, 1e-14) {
+		t.Errorf("ExKurtosis mismatch: got %v, want %v", got, exKurt)
+	}
+	if got := m.Skewness(); got != 0 {
+		t.Errorf("Skewness mismatch: got %v, want 0", got)
+	}
+	if got := m.Median(); got != 0 {
+		t.Errorf("Median mismatch: got %v, want 0", got)
+	}
+	x := 1.5
+	want := math.Log(w1*math.Exp(-x*x/(2*v1))/math.Sqrt(2*math.Pi*v1) + w2*math.Exp(-x*x/(2*v2))/math.Sqrt(2*math.Pi*v2))
+	if got := m.LogProb(x); !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+		t.Errorf("LogProb mismatch: got %v, want %v", got, want)
+	}
+	if got := m.NumParameters(); got != 5 {
+		t.Errorf("NumParameters mismatch: got %v, want 5", got)
+	}
+}
+
+func TestMixture(t *testing.T) {
+	t.Parallel()
+	src := rand.New(rand.NewSource(1))
+	for i, test := range []struct {
+		m   Mixture
+		min float64
+	}{
+		{NewMixture([]RandLogProber{
+			Gamma{Alpha: 2, Beta: 1, Src: src},
+			Gamma{Alpha: 10, Beta: 1, Src: src},
+		}, []float64{0.4, 0.6}, src), 0},
+		{NewMixture([]RandLogProber{
+			Gamma{Alpha: 1, Beta: 2, Src: src},
+			Gamma{Alpha: 5, Beta: 0.5, Src: src},
+			Gamma{Alpha: 30, Beta: 1, Src: src},
+		}, []float64{1, 2, 1}, src), 0},
+		{NewMixture([]RandLogProber{
+			Normal{Mu: -2, Sigma: 1, Src: src},
+			GumbelRight{Mu: 1, Beta: 0.5, Src: src},
+		}, []float64{0.5, 0.5}, src), math.Inf(-1)},
+	} {
+		testMixture(t, test.m, test.min, i)
+	}
+}
+
+func testMixture(t *testing.T, m Mixture, min float64, i int) {
+	const (
+		tol  = 1e-2
+		n    = 1e5
+		bins = 50
+	)
+	x := make([]float64, n)
+	generateSamples(x, m)
+	sort.Float64s(x)
+
+	testRandLogProbContinuous(t, i, min, x, m, tol, bins)
+	checkProbContinuous(t, i, x, min, math.Inf(1), m, 1e-3)
+	checkMean(t, i, x, m, tol)
+	checkVarAndStd(t, i, x, m, tol)
+	checkSkewness(t, i, x, m, 5e-2)
+	checkExKurtosis(t, i, x, m, 1e-1)
+	checkMedian(t, i, x, m, tol)
+	checkQuantileCDFSurvival(t, i, x, m, tol)
+	checkProbQuantContinuous(t, i, x, m, tol)
+}
+
+func TestMixturePanics(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name       string
+		components []RandLogProber
+		weights    []float64
+	}{
+		{"length mismatch", []RandLogProber{UnitNormal}, []float64{1, 1}},
+		{"negative weight", []RandLogProber{UnitNormal, UnitNormal}, []float64{1, -1}},
+		{"zero sum", []RandLogProber{UnitNormal}, []float64{0}},
+		{"empty", nil, nil},
+	} {
+		if !panics(func() { NewMixture(test.components, test.weights, nil) }) {
+			t.Errorf("NewMixture did not panic for %s", test.name)
+		}
+	}
+	m := NewMixture([]RandLogProber{UnitNormal, NewCategorical([]float64{1, 1}, nil)}, []float64{1, 1}, nil)
+	if !panics(func() { m.Survival(0) }) {
+		t.Errorf("Survival did not panic for a component without Survival")
+	}
+	if !panics(func() { m.Mean() }) {
+		t.Errorf("Mean did not panic for a component without Variance")
+	}
+}
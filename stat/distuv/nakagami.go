@@ -0,0 +1,186 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/mathext"
+)
+
+// Nakagami implements the Nakagami distribution, a continuous probability
+// distribution of the square root of a gamma distributed random variable
+// with shape m and mean Ω.
+// The Nakagami distribution has density function:
+//
+//	f(x) = 2 m^m / (Γ(m) Ω^m) x^(2m-1) exp(-m x^2 / Ω)
+//
+// for x ≥ 0.
+//
+// For more information, see https://en.wikipedia.org/wiki/Nakagami_distribution.
+type Nakagami struct {
+	// M is the shape parameter of the distribution. M must be at least 0.5.
+	M float64
+	// Omega is the spread parameter of the distribution, the mean of the
+	// square of the random variable. Omega must be greater than 0.
+	Omega float64
+
+	Src rand.Source
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (n Nakagami) CDF(x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	return mathext.GammaIncReg(n.M, n.M*x*x/n.Omega)
+}
+
+// Entropy returns the entropy of the distribution.
+func (n Nakagami) Entropy() float64 {
+	m := n.M
+	lg, _ := math.Lgamma(m)
+	return lg - math.Ln2 + 0.5*math.Log(n.Omega/m) + m - (m-0.5)*mathext.Digamma(m)
+}
+
+// ExKurtosis returns the excess kurtosis of the distribution.
+func (n Nakagami) ExKurtosis() float64 {
+	m1, m2, m3, m4 := n.moment(1), n.moment(2), n.moment(3), n.moment(4)
+	v := m2 - m1*m1
+	return (m4-4*m1*m3+6*m1*m1*m2-3*m1*m1*m1*m1)/(v*v) - 3
+}
+
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The squares of the samples are gamma distributed, so the maximum
+// likelihood estimate of Omega is the weighted mean of the squares of the
+// samples, and M is the maximum likelihood estimate of the gamma shape.
+func (n *Nakagami) Fit(samples, weights []float64) {
+	checkFit(samples, weights)
+	mean, _ := weightedMean(func(x float64) float64 { return x * x }, samples, weights)
+	meanLog, _ := weightedMean(func(x float64) float64 { return 2 * math.Log(x) }, samples, weights)
+	n.M, _ = gammaMLE(mean, meanLog)
+	n.Omega = mean
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (n Nakagami) LogProb(x float64) float64 {
+	if x < 0 {
+		return math.Inf(-1)
+	}
+	m := n.M
+	lg, _ := math.Lgamma(m)
+	return math.Ln2 + m*math.Log(m/n.Omega) - lg + (2*m-1)*math.Log(x) - m*x*x/n.Omega
+}
+
+// Mean returns the mean of the probability distribution.
+func (n Nakagami) Mean() float64 {
+	return n.moment(1)
+}
+
+// Median returns the median of the probability distribution.
+func (n Nakagami) Median() float64 {
+	return n.Quantile(0.5)
+}
+
+// Mode returns the mode of the probability distribution.
+func (n Nakagami) Mode() float64 {
+	return math.Sqrt((2*n.M - 1) * n.Omega / (2 * n.M))
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (Nakagami) NumParameters() int {
+	return 2
+}
+
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in place into p, which must have length
+// NumParameters, and returned.
+func (n Nakagami) Parameters(p []Parameter) []Parameter {
+	nParam := n.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("nakagami: improper parameter length")
+	}
+	p[0].Name = "M"
+	p[0].Value = n.M
+	p[1].Name = "Omega"
+	p[1].Value = n.Omega
+	return p
+}
+
+// Prob computes the value of the probability density function at x.
+func (n Nakagami) Prob(x float64) float64 {
+	return math.Exp(n.LogProb(x))
+}
+
+// Quantile returns the inverse of the cumulative distribution function.
+func (n Nakagami) Quantile(p float64) float64 {
+	if p < 0 || p > 1 {
+		panic(badPercentile)
+	}
+	return math.Sqrt(n.Omega / n.M * mathext.GammaIncRegInv(n.M, p))
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (n Nakagami) Rand() float64 {
+	return math.Sqrt(Gamma{Alpha: n.M, Beta: n.M / n.Omega, Src: n.Src}.Rand())
+}
+
+// SetParameters sets the parameters of the distribution to p. It panics if
+// the length or the names of p do not match those returned by Parameters.
+func (n *Nakagami) SetParameters(p []Parameter) {
+	if len(p) != n.NumParameters() {
+		panic("nakagami: incorrect number of parameters to set")
+	}
+	if p[0].Name != "M" {
+		panic("nakagami: " + panicNameMismatch)
+	}
+	if p[1].Name != "Omega" {
+		panic("nakagami: " + panicNameMismatch)
+	}
+	n.M = p[0].Value
+	n.Omega = p[1].Value
+}
+
+// Skewness returns the skewness of the distribution.
+func (n Nakagami) Skewness() float64 {
+	m1, m2, m3 := n.moment(1), n.moment(2), n.moment(3)
+	v := m2 - m1*m1
+	return (m3 - 3*m1*v - m1*m1*m1) / (v * math.Sqrt(v))
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (n Nakagami) StdDev() float64 {
+	return math.Sqrt(n.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (n Nakagami) Survival(x float64) float64 {
+	if x <= 0 {
+		return 1
+	}
+	return mathext.GammaIncRegComp(n.M, n.M*x*x/n.Omega)
+}
+
+// Variance returns the variance of the probability distribution.
+func (n Nakagami) Variance() float64 {
+	m1 := n.moment(1)
+	return n.Omega - m1*m1
+}
+
+// moment returns the k-th moment about zero, Γ(m+k/2)/Γ(m) (Ω/m)^(k/2).
+func (n Nakagami) moment(k float64) float64 {
+	lg1, _ := math.Lgamma(n.M + k/2)
+	lg2, _ := math.Lgamma(n.M)
+	return math.Exp(lg1 - lg2 + k/2*math.Log(n.Omega/n.M))
+}
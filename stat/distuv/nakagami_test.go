@@ -0,0 +1,81 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestNakagamiProbCDF(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		nakagami Nakagami
+		want     cumulantProber
+	}{
+		// With m = 1 the distribution is the Rayleigh distribution.
+		{Nakagami{M: 1, Omega: 2}, Weibull{K: 2, Lambda: math.Sqrt2}},
+		{Nakagami{M: 1, Omega: 0.5}, Weibull{K: 2, Lambda: math.Sqrt(0.5)}},
+		// With m = k/2 and Ω = k the distribution is the chi distribution
+		// with k degrees of freedom.
+		{Nakagami{M: 1.5, Omega: 3}, Chi{K: 3}},
+		{Nakagami{M: 5, Omega: 10}, Chi{K: 10}},
+	} {
+		for _, x := range []float64{0.1, 0.5, 1, 2, 4} {
+			if got, want := test.nakagami.Prob(x), test.want.Prob(x); !scalar.EqualWithinAbsOrRel(got, want, 1e-13, 1e-13) {
+				t.Errorf("Prob mismatch, x = %v, %+v: got %v, want %v", x, test.nakagami, got, want)
+			}
+			if got, want := test.nakagami.CDF(x), test.want.CDF(x); !scalar.EqualWithinAbsOrRel(got, want, 1e-13, 1e-13) {
+				t.Errorf("CDF mismatch, x = %v, %+v: got %v, want %v", x, test.nakagami, got, want)
+			}
+		}
+	}
+}
+
+func TestNakagami(t *testing.T) {
+	t.Parallel()
+	src := rand.New(rand.NewSource(1))
+	for i, nk := range []Nakagami{
+		{1, 2, src},
+		{0.6, 1, src},
+		{4, 0.3, src},
+	} {
+		testNakagami(t, nk, i)
+	}
+}
+
+func testNakagami(t *testing.T, nk Nakagami, i int) {
+	const (
+		tol  = 1e-2
+		n    = 5e5
+		bins = 50
+	)
+	x := make([]float64, n)
+	generateSamples(x, nk)
+	sort.Float64s(x)
+
+	testRandLogProbContinuous(t, i, 0, x, nk, tol, bins)
+	checkProbContinuous(t, i, x, 0, math.Inf(1), nk, 1e-10)
+	checkProbQuantContinuous(t, i, x, nk, tol)
+	checkEntropy(t, i, x, nk, tol)
+	checkMean(t, i, x, nk, tol)
+	checkMedian(t, i, x, nk, tol)
+	checkVarAndStd(t, i, x, nk, tol)
+	checkSkewness(t, i, x, nk, 5e-2)
+	checkExKurtosis(t, i, x, nk, 1e-1)
+	checkQuantileCDFSurvival(t, i, x, nk, tol)
+	mode := nk.Mode()
+	if nk.LogProb(mode) < nk.LogProb(mode-1e-3) || nk.LogProb(mode) < nk.LogProb(mode+1e-3) {
+		t.Errorf("Mode %v is not a maximum of Prob case %v", mode, i)
+	}
+	if nk.NumParameters() != 2 {
+		t.Errorf("Mismatch in NumParameters: got %v, want 2", nk.NumParameters())
+	}
+}
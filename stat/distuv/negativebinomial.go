@@ -0,0 +1,226 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"sort"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/mathext"
+)
+
+// negativeBinomialMaxR is the estimate of R returned by NegativeBinomial.Fit
+// when the samples are not overdispersed.
+const negativeBinomialMaxR = 1e6
+
+// NegativeBinomial implements the negative binomial distribution, a discrete
+// probability distribution that expresses the number of failures before the
+// r-th success in a sequence of Bernoulli trials, each with success
+// probability p. R may be any positive real number, in which case the
+// distribution is a gamma mixture of Poisson distributions.
+// The negative binomial distribution has the density function:
+//
+//	f(k) = Γ(k+r)/(k! Γ(r)) p^r (1-p)^k
+//
+// For more information, see https://en.wikipedia.org/wiki/Negative_binomial_distribution.
+type NegativeBinomial struct {
+	// R is the number of successes. R must be greater than 0.
+	R float64
+	// P is the probability of success in any given trial. P must be in (0, 1].
+	P float64
+
+	Src rand.Source
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (n NegativeBinomial) CDF(x float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	if n.P == 1 {
+		return 1
+	}
+	return mathext.RegIncBeta(n.R, math.Floor(x)+1, n.P)
+}
+
+// ExKurtosis returns the excess kurtosis of the distribution.
+func (n NegativeBinomial) ExKurtosis() float64 {
+	return 6/n.R + n.P*n.P/((1-n.P)*n.R)
+}
+
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The maximum likelihood estimate of R is the root of
+//
+//	log(r/(r+m)) + Σ_i w_i (ψ(x_i+r) - ψ(r)) / Σ_i w_i = 0,
+//
+// where m is the weighted mean of the samples and ψ is the digamma function,
+// found starting from the method of moments estimate m²/(v-m), where v is the
+// weighted variance of the samples. The estimate of P is then R/(R+m).
+//
+// The likelihood has a maximum only if v > m. Otherwise the likelihood
+// increases with R towards that of the Poisson distribution with mean m, and
+// Fit sets R to 1e6 and P to R/(R+m), whose distribution has mean m and is
+// practically indistinguishable from that Poisson distribution.
+func (n *NegativeBinomial) Fit(samples, weights []float64) {
+	checkFit(samples, weights)
+	mean, sumWeights := weightedMean(identity, samples, weights)
+	variance, _ := weightedMean(func(x float64) float64 { return (x - mean) * (x - mean) }, samples, weights)
+	if variance <= mean {
+		n.R = negativeBinomialMaxR
+		n.P = n.R / (n.R + mean)
+		return
+	}
+	// The samples are counts, so evaluate the score once per distinct value.
+	counts := make(map[float64]float64)
+	for i, x := range samples {
+		if w := sampleWeight(weights, i); w != 0 {
+			counts[x] += w
+		}
+	}
+	values := make([]float64, 0, len(counts))
+	for x := range counts {
+		values = append(values, x)
+	}
+	sort.Float64s(values)
+	r := findRoot(func(r float64) float64 {
+		var score float64
+		psi := mathext.Digamma(r)
+		for _, x := range values {
+			score += counts[x] * (mathext.Digamma(x+r) - psi)
+		}
+		return -math.Log(r/(r+mean)) - score/sumWeights
+	}, mean*mean/(variance-mean))
+	n.R = r
+	n.P = r / (r + mean)
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (n NegativeBinomial) LogProb(x float64) float64 {
+	if x < 0 || math.Floor(x) != x {
+		return math.Inf(-1)
+	}
+	lg1, _ := math.Lgamma(x + n.R)
+	lg2, _ := math.Lgamma(x + 1)
+	lg3, _ := math.Lgamma(n.R)
+	l := lg1 - lg2 - lg3 + n.R*math.Log(n.P)
+	if x == 0 {
+		return l
+	}
+	return l + x*math.Log1p(-n.P)
+}
+
+// Mean returns the mean of the probability distribution.
+func (n NegativeBinomial) Mean() float64 {
+	return n.R * (1 - n.P) / n.P
+}
+
+// Mode returns the mode of the probability distribution.
+func (n NegativeBinomial) Mode() float64 {
+	if n.R <= 1 {
+		return 0
+	}
+	return math.Floor((n.R - 1) * (1 - n.P) / n.P)
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (NegativeBinomial) NumParameters() int {
+	return 2
+}
+
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in place into p, which must have length
+// NumParameters, and returned.
+func (n NegativeBinomial) Parameters(p []Parameter) []Parameter {
+	nParam := n.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("negativebinomial: improper parameter length")
+	}
+	p[0].Name = "R"
+	p[0].Value = n.R
+	p[1].Name = "P"
+	p[1].Value = n.P
+	return p
+}
+
+// Prob computes the value of the probability density function at x.
+func (n NegativeBinomial) Prob(x float64) float64 {
+	return math.Exp(n.LogProb(x))
+}
+
+// Quantile returns the smallest k such that CDF(k) ≥ p.
+// Quantile panics if p is not in the interval [0, 1].
+func (n NegativeBinomial) Quantile(p float64) float64 {
+	if n.P == 1 {
+		if p < 0 || p > 1 {
+			panic(badPercentile)
+		}
+		return 0
+	}
+	return discreteQuantile(n.CDF, p, 0, math.Inf(1), n.Mean())
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (n NegativeBinomial) Rand() float64 {
+	if n.P == 1 {
+		return 0
+	}
+	// Draw from the Poisson distribution with a gamma distributed rate.
+	lambda := Gamma{Alpha: n.R, Beta: n.P / (1 - n.P), Src: n.Src}.Rand()
+	if lambda == 0 {
+		return 0
+	}
+	return Poisson{Lambda: lambda, Src: n.Src}.Rand()
+}
+
+// SetParameters sets the parameters of the distribution to p. It panics if
+// the length or the names of p do not match those returned by Parameters.
+func (n *NegativeBinomial) SetParameters(p []Parameter) {
+	if len(p) != 2 {
+		panic("negativebinomial: incorrect number of parameters to set")
+	}
+	if p[0].Name != "R" {
+		panic("negativebinomial: " + panicNameMismatch)
+	}
+	if p[1].Name != "P" {
+		panic("negativebinomial: " + panicNameMismatch)
+	}
+	n.R = p[0].Value
+	n.P = p[1].Value
+}
+
+// Skewness returns the skewness of the distribution.
+func (n NegativeBinomial) Skewness() float64 {
+	return (2 - n.P) / math.Sqrt((1-n.P)*n.R)
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (n NegativeBinomial) StdDev() float64 {
+	return math.Sqrt(n.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (n NegativeBinomial) Survival(x float64) float64 {
+	if x < 0 {
+		return 1
+	}
+	if n.P == 1 {
+		return 0
+	}
+	return mathext.RegIncBeta(math.Floor(x)+1, n.R, 1-n.P)
+}
+
+// Variance returns the variance of the probability distribution.
+func (n NegativeBinomial) Variance() float64 {
+	return n.R * (1 - n.P) / (n.P * n.P)
+}
@@ -0,0 +1,121 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestNegativeBinomialProbCDF(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		x, r, p, wantProb, wantCDF float64
+	}{
+		// Values calculated from the binomial coefficients and products.
+		{0, 3, 0.4, 0.064, 0.064},
+		{2, 3, 0.4, 0.13824, 0.31744},
+		{5, 3, 0.4, 0.10450944, 0.68460544},
+		{10, 3, 0.4, 0.025540912742399995, 0.9420975898624},
+		{0, 2.5, 0.7, 0.409963413001697, 0.409963413001697},
+		{1, 2.5, 0.7, 0.3074725597512725, 0.7174359727529696},
+		{4, 2.5, 0.7, 0.0299641617995107, 0.9814636206631367},
+		{1.5, 2.5, 0.7, 0, 0.7174359727529696},
+		{-1, 2.5, 0.7, 0, 0},
+	} {
+		nb := NegativeBinomial{R: test.r, P: test.p}
+		if got := nb.Prob(test.x); !scalar.EqualWithinAbsOrRel(got, test.wantProb, 1e-13, 1e-13) {
+			t.Errorf("Prob mismatch, x = %v, r = %v, p = %v: got %v, want %v", test.x, test.r, test.p, got, test.wantProb)
+		}
+		if got := nb.CDF(test.x); !scalar.EqualWithinAbsOrRel(got, test.wantCDF, 1e-13, 1e-13) {
+			t.Errorf("CDF mismatch, x = %v, r = %v, p = %v: got %v, want %v", test.x, test.r, test.p, got, test.wantCDF)
+		}
+	}
+}
+
+func TestNegativeBinomial(t *testing.T) {
+	t.Parallel()
+	src := rand.New(rand.NewSource(1))
+	for i, nb := range []NegativeBinomial{
+		{3, 0.4, src},
+		{0.5, 0.2, src},
+		{20, 0.8, src},
+		{1, 0.5, src},
+	} {
+		testNegativeBinomial(t, nb, i)
+	}
+}
+
+func testNegativeBinomial(t *testing.T, nb NegativeBinomial, i int) {
+	const (
+		tol = 2e-2
+		n   = 1e6
+	)
+	x := make([]float64, n)
+	generateSamples(x, nb)
+	sort.Float64s(x)
+
+	checkProbDiscrete(t, i, x, nb, 2e-3)
+	checkMean(t, i, x, nb, tol)
+	checkVarAndStd(t, i, x, nb, tol)
+	checkSkewness(t, i, x, nb, 5e-2)
+	checkExKurtosis(t, i, x, nb, 2e-1)
+
+	hi := nb.Quantile(1 - 1e-15)
+	checkCDFDiscrete(t, i, 0, hi, nb, 1e-12)
+	checkMomentsDiscrete(t, i, 0, 4*hi, nb, 1e-8)
+
+	mode := nb.Mode()
+	if nb.Prob(mode) < nb.Prob(mode-1) || nb.Prob(mode) < nb.Prob(mode+1) {
+		t.Errorf("Mode %v is not a maximum of Prob case %v", mode, i)
+	}
+	if q := nb.Quantile(1); !math.IsInf(q, 1) {
+		t.Errorf("Mismatch in Quantile(1): got %v, want +Inf", q)
+	}
+	if nb.NumParameters() != 2 {
+		t.Errorf("Mismatch in NumParameters: got %v, want 2", nb.NumParameters())
+	}
+}
+
+func TestNegativeBinomialFit(t *testing.T) {
+	t.Parallel()
+	want := NegativeBinomial{R: 3.5, P: 0.4, Src: rand.NewSource(1)}
+	x := make([]float64, 20000)
+	for i := range x {
+		x[i] = want.Rand()
+	}
+	var got NegativeBinomial
+	got.Fit(x, nil)
+	if !scalar.EqualWithinRel(got.R, want.R, 0.05) {
+		t.Errorf("unexpected R: got %v, want %v", got.R, want.R)
+	}
+	if !scalar.EqualWithinRel(got.P, want.P, 0.05) {
+		t.Errorf("unexpected P: got %v, want %v", got.P, want.P)
+	}
+
+	// Underdispersed samples have the Poisson limit as the estimate.
+	got.Fit([]float64{1, 2, 3}, nil)
+	if got.R != negativeBinomialMaxR {
+		t.Errorf("unexpected R for underdispersed samples: got %v, want %v", got.R, negativeBinomialMaxR)
+	}
+	if !scalar.EqualWithinRel(got.Mean(), 2, 1e-9) {
+		t.Errorf("unexpected mean for underdispersed samples: got %v, want 2", got.Mean())
+	}
+	if !scalar.EqualWithinRel(got.Variance(), 2, 1e-5) {
+		t.Errorf("unexpected variance for underdispersed samples: got %v, want 2", got.Variance())
+	}
+	if !scalar.EqualWithinRel(got.Prob(2), Poisson{Lambda: 2}.Prob(2), 1e-5) {
+		t.Errorf("unexpected Prob for underdispersed samples: got %v, want %v", got.Prob(2), Poisson{Lambda: 2}.Prob(2))
+	}
+	got.Fit([]float64{0, 0, 0}, nil)
+	if got.P != 1 {
+		t.Errorf("unexpected P for zero samples: got %v, want 1", got.P)
+	}
+}
@@ -228,8 +228,9 @@ func (n Normal) Survival(x float64) float64 {
 	return 0.5 * (1 - math.Erf((x-n.Mu)/(n.Sigma*math.Sqrt2)))
 }
 
-// setParameters modifies the parameters of the distribution.
-func (n *Normal) setParameters(p []Parameter) {
+// SetParameters sets the parameters of the distribution to p. It panics if
+// the length or the names of p do not match those returned by Parameters.
+func (n *Normal) SetParameters(p []Parameter) {
 	if len(p) != n.NumParameters() {
 		panic("normal: incorrect number of parameters to set")
 	}
@@ -248,8 +249,10 @@ func (n Normal) Variance() float64 {
 	return n.Sigma * n.Sigma
 }
 
-// parameters returns the parameters of the distribution.
-func (n Normal) parameters(p []Parameter) []Parameter {
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in place into p, which must have length
+// NumParameters, and returned.
+func (n Normal) Parameters(p []Parameter) []Parameter {
 	nParam := n.NumParameters()
 	if p == nil {
 		p = make([]Parameter, nParam)
@@ -52,6 +52,26 @@ func (p Pareto) ExKurtosis() float64 {
 
 }
 
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The maximum likelihood estimate of Xm is the smallest sample with
+// non-zero weight, and that of Alpha is the reciprocal of the weighted
+// mean of log(x/Xm).
+func (p *Pareto) Fit(samples, weights []float64) {
+	checkFit(samples, weights)
+	p.Xm = math.Inf(1)
+	for i, x := range samples {
+		if sampleWeight(weights, i) != 0 {
+			p.Xm = math.Min(p.Xm, x)
+		}
+	}
+	meanLog, _ := weightedMean(func(x float64) float64 { return math.Log(x / p.Xm) }, samples, weights)
+	p.Alpha = 1 / meanLog
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x.
 func (p Pareto) LogProb(x float64) float64 {
@@ -84,6 +104,23 @@ func (p Pareto) NumParameters() int {
 	return 2
 }
 
+// Parameters returns the parameters of the distribution. If params is not
+// nil, the parameters are stored in place into params, which must have
+// length NumParameters, and returned.
+func (p Pareto) Parameters(params []Parameter) []Parameter {
+	nParam := p.NumParameters()
+	if params == nil {
+		params = make([]Parameter, nParam)
+	} else if len(params) != nParam {
+		panic("pareto: improper parameter length")
+	}
+	params[0].Name = "Xm"
+	params[0].Value = p.Xm
+	params[1].Name = "Alpha"
+	params[1].Value = p.Alpha
+	return params
+}
+
 // Prob computes the value of the probability density function at x.
 func (p Pareto) Prob(x float64) float64 {
 	return math.Exp(p.LogProb(x))
@@ -108,6 +145,23 @@ func (p Pareto) Rand() float64 {
 	return p.Xm * math.Exp(rnd/p.Alpha)
 }
 
+// SetParameters sets the parameters of the distribution to params. It
+// panics if the length or the names of params do not match those returned
+// by Parameters.
+func (p *Pareto) SetParameters(params []Parameter) {
+	if len(params) != 2 {
+		panic("pareto: incorrect number of parameters to set")
+	}
+	if params[0].Name != "Xm" {
+		panic("pareto: " + panicNameMismatch)
+	}
+	if params[1].Name != "Alpha" {
+		panic("pareto: " + panicNameMismatch)
+	}
+	p.Xm = params[0].Value
+	p.Alpha = params[1].Value
+}
+
 // StdDev returns the standard deviation of the probability distribution.
 func (p Pareto) StdDev() float64 {
 	return math.Sqrt(p.Variance())
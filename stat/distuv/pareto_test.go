@@ -212,3 +212,24 @@ func BenchmarkParetoRand(b *testing.B) {
 		p.Rand()
 	}
 }
+
+func TestParetoParameters(t *testing.T) {
+	t.Parallel()
+	want := Pareto{Xm: 1, Alpha: 3}
+	p := want.Parameters(nil)
+	if len(p) != want.NumParameters() {
+		t.Fatalf("unexpected number of parameters: got %v, want %v", len(p), want.NumParameters())
+	}
+	var got Pareto
+	got.SetParameters(p)
+	if got != want {
+		t.Errorf("unexpected parameters after round trip: got %+v, want %+v", got, want)
+	}
+	p[1].Name = "Shape"
+	if !panics(func() { got.SetParameters(p) }) {
+		t.Error("expected panic for mismatched parameter name")
+	}
+	if !panics(func() { got.Parameters(make([]Parameter, 3)) }) {
+		t.Error("expected panic for wrong parameter slice length")
+	}
+}
@@ -41,6 +41,18 @@ func (p Poisson) ExKurtosis() float64 {
 	return 1 / p.Lambda
 }
 
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The maximum likelihood estimate of Lambda is the weighted mean of the
+// samples.
+func (p *Poisson) Fit(samples, weights []float64) {
+	checkFit(samples, weights)
+	p.Lambda, _ = weightedMean(identity, samples, weights)
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x.
 func (p Poisson) LogProb(x float64) float64 {
@@ -61,6 +73,21 @@ func (Poisson) NumParameters() int {
 	return 1
 }
 
+// Parameters returns the parameters of the distribution. If params is not
+// nil, the parameters are stored in place into params, which must have
+// length NumParameters, and returned.
+func (p Poisson) Parameters(params []Parameter) []Parameter {
+	nParam := p.NumParameters()
+	if params == nil {
+		params = make([]Parameter, nParam)
+	} else if len(params) != nParam {
+		panic("poisson: improper parameter length")
+	}
+	params[0].Name = "Lambda"
+	params[0].Value = p.Lambda
+	return params
+}
+
 // Prob computes the value of the probability density function at x.
 func (p Poisson) Prob(x float64) float64 {
 	return math.Exp(p.LogProb(x))
@@ -124,6 +151,19 @@ func (p Poisson) Rand() float64 {
 	}
 }
 
+// SetParameters sets the parameters of the distribution to params. It
+// panics if the length or the names of params do not match those returned
+// by Parameters.
+func (p *Poisson) SetParameters(params []Parameter) {
+	if len(params) != 1 {
+		panic("poisson: incorrect number of parameters to set")
+	}
+	if params[0].Name != "Lambda" {
+		panic("poisson: " + panicNameMismatch)
+	}
+	p.Lambda = params[0].Value
+}
+
 // Skewness returns the skewness of the distribution.
 func (p Poisson) Skewness() float64 {
 	return 1 / math.Sqrt(p.Lambda)
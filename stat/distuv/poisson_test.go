@@ -179,3 +179,24 @@ func BenchmarkPoissonRand(b *testing.B) {
 		})
 	}
 }
+
+func TestPoissonParameters(t *testing.T) {
+	t.Parallel()
+	want := Poisson{Lambda: 3}
+	p := want.Parameters(nil)
+	if len(p) != want.NumParameters() {
+		t.Fatalf("unexpected number of parameters: got %v, want %v", len(p), want.NumParameters())
+	}
+	var got Poisson
+	got.SetParameters(p)
+	if got != want {
+		t.Errorf("unexpected parameters after round trip: got %+v, want %+v", got, want)
+	}
+	p[0].Name = "Rate"
+	if !panics(func() { got.SetParameters(p) }) {
+		t.Error("expected panic for mismatched parameter name")
+	}
+	if !panics(func() { got.Parameters(make([]Parameter, 2)) }) {
+		t.Error("expected panic for wrong parameter slice length")
+	}
+}
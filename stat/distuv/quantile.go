@@ -0,0 +1,113 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import "math"
+
+// discreteQuantile returns the smallest integer k in [lo, hi] such that
+// cdf(k) ≥ p for a distribution on the integers in [lo, hi], where lo and
+// hi may be infinite. The search starts from the integer nearest to guess.
+func discreteQuantile(cdf func(float64) float64, p, lo, hi, guess float64) float64 {
+	if p < 0 || p > 1 {
+		panic(badPercentile)
+	}
+	if p == 0 {
+		return lo
+	}
+	if p == 1 {
+		return hi
+	}
+	k := math.Max(lo, math.Min(hi, math.Round(guess)))
+	if math.IsNaN(k) || math.IsInf(k, 0) {
+		k = 0
+	}
+	// Find an interval (a, b] with cdf(a) < p ≤ cdf(b) by doubling steps.
+	var a, b float64
+	if cdf(k) >= p {
+		b = k
+		step := 1.0
+		for {
+			a = b - step
+			if a < lo {
+				return discreteBisect(cdf, p, lo-1, b)
+			}
+			if cdf(a) < p {
+				break
+			}
+			b = a
+			step *= 2
+		}
+	} else {
+		a = k
+		step := 1.0
+		for {
+			b = a + step
+			if b >= hi {
+				b = hi
+				break
+			}
+			if cdf(b) >= p {
+				break
+			}
+			a = b
+			step *= 2
+		}
+	}
+	return discreteBisect(cdf, p, a, b)
+}
+
+// discreteBisect returns the smallest integer k in (a, b] such that
+// cdf(k) ≥ p, given that cdf(b) ≥ p and that a is below the quantile.
+func discreteBisect(cdf func(float64) float64, p, a, b float64) float64 {
+	for b-a > 1 {
+		mid := math.Floor(a + (b-a)/2)
+		if cdf(mid) >= p {
+			b = mid
+		} else {
+			a = mid
+		}
+	}
+	return b
+}
+
+// continuousQuantile returns x in [lo, hi] such that cdf(x) = p for an
+// increasing continuous distribution function cdf on [lo, hi], where hi may
+// be infinite. The search for an upper bound starts from guess, which must
+// be greater than lo, when hi is infinite.
+func continuousQuantile(cdf func(float64) float64, p, lo, hi, guess float64) float64 {
+	if p < 0 || p > 1 {
+		panic(badPercentile)
+	}
+	if p == 0 {
+		return lo
+	}
+	if p == 1 {
+		return hi
+	}
+	if math.IsInf(hi, 1) {
+		width := guess - lo
+		hi = guess
+		for cdf(hi) < p {
+			lo = hi
+			width *= 2
+			hi = lo + width
+			if math.IsInf(hi, 1) {
+				return hi
+			}
+		}
+	}
+	for i := 0; i < 200; i++ {
+		mid := lo + (hi-lo)/2
+		if mid <= lo || mid >= hi {
+			break
+		}
+		if cdf(mid) < p {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return lo + (hi-lo)/2
+}
@@ -0,0 +1,222 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/mathext"
+)
+
+// Rice implements the Rice distribution, a continuous probability distribution
+// of the magnitude of a bivariate normal random variable with independent
+// components of standard deviation σ whose mean is at distance ν from the
+// origin.
+// The Rice distribution has density function:
+//
+//	f(x) = x/σ^2 exp(-(x^2+ν^2)/(2σ^2)) I_0(xν/σ^2)
+//
+// for x ≥ 0, where I_0 is the modified Bessel function of the first kind of
+// order zero.
+//
+// For more information, see https://en.wikipedia.org/wiki/Rice_distribution.
+type Rice struct {
+	// Nu is the distance of the mean from the origin. Nu must be at least 0.
+	Nu float64
+	// Sigma is the standard deviation of the components. Sigma must be
+	// greater than 0.
+	Sigma float64
+
+	Src rand.Source
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (r Rice) CDF(x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	y := x * x / (2 * r.Sigma * r.Sigma)
+	return r.mixture(func(j float64) float64 {
+		return mathext.GammaIncReg(j+1, y)
+	})
+}
+
+// ExKurtosis returns the excess kurtosis of the distribution.
+func (r Rice) ExKurtosis() float64 {
+	m1, m2, m3, m4 := r.moment(1), r.moment(2), r.moment(3), r.moment(4)
+	v := m2 - m1*m1
+	return (m4-4*m1*m3+6*m1*m1*m2-3*m1*m1*m1*m1)/(v*v) - 3
+}
+
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The maximum likelihood estimates are found numerically, starting from the
+// estimates given by the second and fourth sample moments.
+func (r *Rice) Fit(samples, weights []float64) {
+	checkFit(samples, weights)
+	m2, _ := weightedMean(func(x float64) float64 { return x * x }, samples, weights)
+	m4, _ := weightedMean(func(x float64) float64 { return x * x * x * x }, samples, weights)
+	// The moments are E[x^2] = ν^2 + 2σ^2 and E[x^4] = ν^4 + 8σ^2ν^2 + 8σ^4,
+	// so ν^4 = 2E[x^2]^2 - E[x^4].
+	var nu float64
+	if nu4 := 2*m2*m2 - m4; nu4 > 0 {
+		nu = math.Min(math.Sqrt(math.Sqrt(nu4)), 0.9*math.Sqrt(m2))
+	}
+	x := []float64{nu, 0.5 * math.Log((m2-nu*nu)/2)}
+	maximize(func(x []float64) float64 {
+		return logLikelihood(Rice{Nu: math.Abs(x[0]), Sigma: math.Exp(x[1])}, samples, weights)
+	}, x)
+	r.Nu, r.Sigma = math.Abs(x[0]), math.Exp(x[1])
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (r Rice) LogProb(x float64) float64 {
+	if x < 0 {
+		return math.Inf(-1)
+	}
+	s2 := r.Sigma * r.Sigma
+	d := x - r.Nu
+	return math.Log(x/s2) - d*d/(2*s2) + math.Log(besselI0Scaled(x*r.Nu/s2))
+}
+
+// Mean returns the mean of the probability distribution.
+func (r Rice) Mean() float64 {
+	return r.moment(1)
+}
+
+// Median returns the median of the probability distribution.
+func (r Rice) Median() float64 {
+	return r.Quantile(0.5)
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (Rice) NumParameters() int {
+	return 2
+}
+
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in place into p, which must have length
+// NumParameters, and returned.
+func (r Rice) Parameters(p []Parameter) []Parameter {
+	nParam := r.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("rice: improper parameter length")
+	}
+	p[0].Name = "Nu"
+	p[0].Value = r.Nu
+	p[1].Name = "Sigma"
+	p[1].Value = r.Sigma
+	return p
+}
+
+// Prob computes the value of the probability density function at x.
+func (r Rice) Prob(x float64) float64 {
+	return math.Exp(r.LogProb(x))
+}
+
+// Quantile returns the inverse of the cumulative distribution function.
+func (r Rice) Quantile(p float64) float64 {
+	return continuousQuantile(r.CDF, p, 0, math.Inf(1), r.Nu+r.Sigma)
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (r Rice) Rand() float64 {
+	rnd := rand.NormFloat64
+	if r.Src != nil {
+		rnd = rand.New(r.Src).NormFloat64
+	}
+	return math.Hypot(r.Sigma*rnd()+r.Nu, r.Sigma*rnd())
+}
+
+// SetParameters sets the parameters of the distribution to p. It panics if
+// the length or the names of p do not match those returned by Parameters.
+func (r *Rice) SetParameters(p []Parameter) {
+	if len(p) != 2 {
+		panic("rice: incorrect number of parameters to set")
+	}
+	if p[0].Name != "Nu" {
+		panic("rice: " + panicNameMismatch)
+	}
+	if p[1].Name != "Sigma" {
+		panic("rice: " + panicNameMismatch)
+	}
+	r.Nu = p[0].Value
+	r.Sigma = p[1].Value
+}
+
+// Skewness returns the skewness of the distribution.
+func (r Rice) Skewness() float64 {
+	m1, m2, m3 := r.moment(1), r.moment(2), r.moment(3)
+	v := m2 - m1*m1
+	return (m3 - 3*m1*v - m1*m1*m1) / (v * math.Sqrt(v))
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (r Rice) StdDev() float64 {
+	return math.Sqrt(r.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (r Rice) Survival(x float64) float64 {
+	if x <= 0 {
+		return 1
+	}
+	y := x * x / (2 * r.Sigma * r.Sigma)
+	return r.mixture(func(j float64) float64 {
+		return mathext.GammaIncRegComp(j+1, y)
+	})
+}
+
+// Variance returns the variance of the probability distribution.
+func (r Rice) Variance() float64 {
+	m1 := r.moment(1)
+	return 2*r.Sigma*r.Sigma + r.Nu*r.Nu - m1*m1
+}
+
+// mixture returns Σ_j P(j) f(j), where P is the Poisson distribution with
+// rate a = ν²/(2σ²). The square of a Rice distributed random variable
+// divided by 2σ² is the mixture of the gamma distributions with shape j+1
+// and unit rate with these weights.
+func (r Rice) mixture(f func(j float64) float64) float64 {
+	a := r.Nu * r.Nu / (2 * r.Sigma * r.Sigma)
+	if a == 0 {
+		return f(0)
+	}
+	// Sum outwards from the mode with the weights relative to the weight of
+	// the mode, and normalize by their sum.
+	const tol = 1e-20
+	mode := math.Floor(a)
+	var sum, sumWeights float64
+	w := 1.0
+	for j := mode; j >= 0 && w > tol; j-- {
+		sum += w * f(j)
+		sumWeights += w
+		w *= j / a
+	}
+	w = 1.0
+	for j := mode + 1; w > tol; j++ {
+		w *= a / j
+		sum += w * f(j)
+		sumWeights += w
+	}
+	return sum / sumWeights
+}
+
+// moment returns the k-th moment about zero of the distribution.
+func (r Rice) moment(k float64) float64 {
+	return math.Pow(2*r.Sigma*r.Sigma, k/2) * r.mixture(func(j float64) float64 {
+		lg1, _ := math.Lgamma(j + 1 + k/2)
+		lg2, _ := math.Lgamma(j + 1)
+		return math.Exp(lg1 - lg2)
+	})
+}
@@ -0,0 +1,89 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestRiceProbCDF(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		x, nu, sigma, wantProb, wantCDF float64
+	}{
+		// Values calculated from the series of I_0 and Simpson's rule.
+		{1.5, 2, 1, 0.32167058981215596, 0.2092322206032321},
+		{3.5, 2, 1, 0.17468968346955613, 0.9047346500041683},
+		{1, 0.5, 2, 0.2146724460730565, 0.1141058519390417},
+		{5.2, 5, 0.7, 0.5592877709753377, 0.585841783950839},
+		{-1, 5, 0.7, 0, 0},
+	} {
+		r := Rice{Nu: test.nu, Sigma: test.sigma}
+		if got := r.Prob(test.x); !scalar.EqualWithinAbsOrRel(got, test.wantProb, 1e-12, 1e-12) {
+			t.Errorf("Prob mismatch, x = %v, nu = %v, sigma = %v: got %v, want %v", test.x, test.nu, test.sigma, got, test.wantProb)
+		}
+		if got := r.CDF(test.x); !scalar.EqualWithinAbsOrRel(got, test.wantCDF, 1e-12, 1e-12) {
+			t.Errorf("CDF mismatch, x = %v, nu = %v, sigma = %v: got %v, want %v", test.x, test.nu, test.sigma, got, test.wantCDF)
+		}
+	}
+
+	// With ν = 0 the distribution is the Rayleigh distribution.
+	r := Rice{Sigma: 1.5}
+	rayleigh := Weibull{K: 2, Lambda: 1.5 * math.Sqrt2}
+	for _, x := range []float64{0.1, 1, 2, 5} {
+		if got, want := r.Prob(x), rayleigh.Prob(x); !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+			t.Errorf("Prob mismatch with Rayleigh at %v: got %v, want %v", x, got, want)
+		}
+		if got, want := r.CDF(x), rayleigh.CDF(x); !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+			t.Errorf("CDF mismatch with Rayleigh at %v: got %v, want %v", x, got, want)
+		}
+	}
+	if got, want := r.Mean(), rayleigh.Mean(); !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+		t.Errorf("Mean mismatch with Rayleigh: got %v, want %v", got, want)
+	}
+}
+
+func TestRice(t *testing.T) {
+	t.Parallel()
+	src := rand.New(rand.NewSource(1))
+	for i, r := range []Rice{
+		{2, 1, src},
+		{0.5, 2, src},
+		{5, 0.7, src},
+		{100, 1, src},
+	} {
+		testRice(t, r, i)
+	}
+}
+
+func testRice(t *testing.T, r Rice, i int) {
+	const (
+		tol  = 1e-2
+		n    = 2e5
+		bins = 50
+	)
+	x := make([]float64, n)
+	generateSamples(x, r)
+	sort.Float64s(x)
+
+	testRandLogProbContinuous(t, i, 0, x, r, tol, bins)
+	checkProbContinuous(t, i, x, 0, math.Inf(1), r, 1e-10)
+	checkProbQuantContinuous(t, i, x, r, tol)
+	checkMean(t, i, x, r, tol)
+	checkMedian(t, i, x, r, tol)
+	checkVarAndStd(t, i, x, r, 2e-2)
+	checkSkewness(t, i, x, r, 5e-2)
+	checkExKurtosis(t, i, x, r, 1e-1)
+	checkQuantileCDFSurvival(t, i, x, r, tol)
+	if r.NumParameters() != 2 {
+		t.Errorf("Mismatch in NumParameters: got %v, want 2", r.NumParameters())
+	}
+}
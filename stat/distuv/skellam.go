@@ -0,0 +1,199 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+)
+
+// Skellam implements the Skellam distribution, a discrete probability
+// distribution that expresses the difference of two independent Poisson
+// distributed random variables with rates μ1 and μ2.
+// The Skellam distribution has the density function:
+//
+//	f(k) = e^(-(μ1+μ2)) (μ1/μ2)^(k/2) I_|k|(2√(μ1 μ2))
+//
+// where I_k is the modified Bessel function of the first kind.
+//
+// For more information, see https://en.wikipedia.org/wiki/Skellam_distribution.
+type Skellam struct {
+	// Mu1 and Mu2 are the rates of the two Poisson distributions.
+	// They must be greater than 0.
+	Mu1 float64
+	Mu2 float64
+
+	Src rand.Source
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (s Skellam) CDF(x float64) float64 {
+	x = math.Floor(x)
+	lo, hi := s.bounds()
+	switch {
+	case x < lo:
+		return 0
+	case x >= hi:
+		return 1
+	}
+	if x < s.Mean() {
+		return s.sum(lo, x)
+	}
+	return 1 - s.sum(x+1, hi)
+}
+
+// ExKurtosis returns the excess kurtosis of the distribution.
+func (s Skellam) ExKurtosis() float64 {
+	return 1 / (s.Mu1 + s.Mu2)
+}
+
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The maximum likelihood estimates are found numerically, starting from the
+// method of moments estimates.
+func (s *Skellam) Fit(samples, weights []float64) {
+	checkFit(samples, weights)
+	mean, _ := weightedMean(identity, samples, weights)
+	variance, _ := weightedMean(func(x float64) float64 { return (x - mean) * (x - mean) }, samples, weights)
+	// The mean is μ1-μ2 and the variance is μ1+μ2. Keep both rates positive
+	// when the sample variance is smaller than the magnitude of the mean.
+	variance = math.Max(variance, math.Abs(mean)+1)
+	x := []float64{math.Log((variance + mean) / 2), math.Log((variance - mean) / 2)}
+	maximize(func(x []float64) float64 {
+		return logLikelihood(Skellam{Mu1: math.Exp(x[0]), Mu2: math.Exp(x[1])}, samples, weights)
+	}, x)
+	s.Mu1, s.Mu2 = math.Exp(x[0]), math.Exp(x[1])
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (s Skellam) LogProb(x float64) float64 {
+	if math.Floor(x) != x || math.IsInf(x, 0) {
+		return math.Inf(-1)
+	}
+	k := math.Abs(x)
+	i := besselIScaled(make([]float64, int(k)+1), 2*math.Sqrt(s.Mu1*s.Mu2))
+	return s.logProb(x, i[int(k)])
+}
+
+// Mean returns the mean of the probability distribution.
+func (s Skellam) Mean() float64 {
+	return s.Mu1 - s.Mu2
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (Skellam) NumParameters() int {
+	return 2
+}
+
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in place into p, which must have length
+// NumParameters, and returned.
+func (s Skellam) Parameters(p []Parameter) []Parameter {
+	nParam := s.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("skellam: improper parameter length")
+	}
+	p[0].Name = "Mu1"
+	p[0].Value = s.Mu1
+	p[1].Name = "Mu2"
+	p[1].Value = s.Mu2
+	return p
+}
+
+// Prob computes the value of the probability density function at x.
+func (s Skellam) Prob(x float64) float64 {
+	return math.Exp(s.LogProb(x))
+}
+
+// Quantile returns the smallest k such that CDF(k) ≥ p.
+// Quantile panics if p is not in the interval [0, 1].
+func (s Skellam) Quantile(p float64) float64 {
+	return discreteQuantile(s.CDF, p, math.Inf(-1), math.Inf(1), s.Mean())
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (s Skellam) Rand() float64 {
+	return Poisson{Lambda: s.Mu1, Src: s.Src}.Rand() - Poisson{Lambda: s.Mu2, Src: s.Src}.Rand()
+}
+
+// SetParameters sets the parameters of the distribution to p. It panics if
+// the length or the names of p do not match those returned by Parameters.
+func (s *Skellam) SetParameters(p []Parameter) {
+	if len(p) != 2 {
+		panic("skellam: incorrect number of parameters to set")
+	}
+	if p[0].Name != "Mu1" {
+		panic("skellam: " + panicNameMismatch)
+	}
+	if p[1].Name != "Mu2" {
+		panic("skellam: " + panicNameMismatch)
+	}
+	s.Mu1 = p[0].Value
+	s.Mu2 = p[1].Value
+}
+
+// Skewness returns the skewness of the distribution.
+func (s Skellam) Skewness() float64 {
+	v := s.Mu1 + s.Mu2
+	return (s.Mu1 - s.Mu2) / (v * math.Sqrt(v))
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (s Skellam) StdDev() float64 {
+	return math.Sqrt(s.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (s Skellam) Survival(x float64) float64 {
+	x = math.Floor(x)
+	lo, hi := s.bounds()
+	switch {
+	case x < lo:
+		return 1
+	case x >= hi:
+		return 0
+	}
+	if x < s.Mean() {
+		return 1 - s.sum(lo, x)
+	}
+	return s.sum(x+1, hi)
+}
+
+// Variance returns the variance of the probability distribution.
+func (s Skellam) Variance() float64 {
+	return s.Mu1 + s.Mu2
+}
+
+// bounds returns the integers outside of which the probabilities of the
+// distribution are negligible.
+func (s Skellam) bounds() (lo, hi float64) {
+	w := 20*s.StdDev() + 30
+	return math.Floor(s.Mean() - w), math.Ceil(s.Mean() + w)
+}
+
+// logProb returns the log probability of the integer k given the scaled
+// Bessel function e^{-x} I_|k|(x) at x = 2√(μ1 μ2).
+func (s Skellam) logProb(k, bessel float64) float64 {
+	d := math.Sqrt(s.Mu1) - math.Sqrt(s.Mu2)
+	return -d*d + k/2*math.Log(s.Mu1/s.Mu2) + math.Log(bessel)
+}
+
+// sum returns the sum of the probabilities of the integers in [lo, hi].
+func (s Skellam) sum(lo, hi float64) float64 {
+	m := int(math.Max(math.Abs(lo), math.Abs(hi)))
+	i := besselIScaled(make([]float64, m+1), 2*math.Sqrt(s.Mu1*s.Mu2))
+	var p float64
+	for k := lo; k <= hi; k++ {
+		p += math.Exp(s.logProb(k, i[int(math.Abs(k))]))
+	}
+	return p
+}
@@ -0,0 +1,107 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestSkellamProbCDF(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		x, mu1, mu2, wantProb, wantCDF float64
+	}{
+		// Values calculated from the convolution of the Poisson
+		// distributions.
+		{-3, 1, 2, 0.10700541809741397, 0.18227897318378142},
+		{0, 1, 2, 0.21171208396194355, 0.8174152250696121},
+		{2, 1, 2, 0.04624018235939751, 0.9828871266721582},
+		{7, 1, 2, 1.2641536118488752e-05, 0.9999982763127722},
+		{-3, 4.5, 0.5, 0.0002395099662120005, 0.00026934766526578004},
+		{0, 4.5, 0.5, 0.03288652175708784, 0.043715971578635694},
+		{2, 4.5, 0.5, 0.13615310185805993, 0.25978187041456097},
+		{7, 4.5, 0.5, 0.0659006500605505, 0.9338899009136774},
+		{-3, 30, 25, 0.030126335447609252, 0.155374979985728},
+		{0, 30, 25, 0.04302511534512124, 0.2718733196624674},
+		{2, 30, 25, 0.04976216239264947, 0.3683348825925175},
+		{7, 30, 25, 0.05188744337223141, 0.633041471923673},
+		{0.5, 30, 25, 0, 0.2718733196624674},
+	} {
+		s := Skellam{Mu1: test.mu1, Mu2: test.mu2}
+		if got := s.Prob(test.x); !scalar.EqualWithinAbsOrRel(got, test.wantProb, 1e-12, 1e-12) {
+			t.Errorf("Prob mismatch, x = %v, mu1 = %v, mu2 = %v: got %v, want %v", test.x, test.mu1, test.mu2, got, test.wantProb)
+		}
+		if got := s.CDF(test.x); !scalar.EqualWithinAbsOrRel(got, test.wantCDF, 1e-12, 1e-12) {
+			t.Errorf("CDF mismatch, x = %v, mu1 = %v, mu2 = %v: got %v, want %v", test.x, test.mu1, test.mu2, got, test.wantCDF)
+		}
+	}
+}
+
+func TestBesselIScaled(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		x    float64
+		want []float64
+	}{
+		// Values of I_k(x) from Abramowitz and Stegun, Table 9.8 and 9.11.
+		{1, []float64{1.266065877752008, 0.565159103992485, 0.135747669767038, 0.022168424924332}},
+		{10, []float64{2815.716628466254, 2670.988303701255, 2281.518967726004, 1758.380716610853}},
+	} {
+		got := besselIScaled(make([]float64, len(test.want)), test.x)
+		for k, v := range test.want {
+			if !scalar.EqualWithinRel(got[k]*math.Exp(test.x), v, 1e-13) {
+				t.Errorf("Bessel I_%d(%v) mismatch: got %v, want %v", k, test.x, got[k]*math.Exp(test.x), v)
+			}
+		}
+	}
+	for _, x := range []float64{0.1, 5, 100, 699, 701, 5000} {
+		want := besselIScaled(make([]float64, 1), x)[0]
+		got := besselI0Scaled(x)
+		if !scalar.EqualWithinRel(got, want, 1e-13) {
+			t.Errorf("Scaled Bessel I_0(%v) mismatch: got %v, want %v", x, got, want)
+		}
+	}
+}
+
+func TestSkellam(t *testing.T) {
+	t.Parallel()
+	src := rand.New(rand.NewSource(1))
+	for i, s := range []Skellam{
+		{1, 2, src},
+		{4.5, 0.5, src},
+		{30, 25, src},
+		{200, 300, src},
+	} {
+		testSkellam(t, s, i)
+	}
+}
+
+func testSkellam(t *testing.T, s Skellam, i int) {
+	const (
+		tol = 2e-2
+		n   = 5e5
+	)
+	x := make([]float64, n)
+	generateSamples(x, s)
+	sort.Float64s(x)
+
+	checkProbDiscrete(t, i, x, s, 3e-3)
+	checkMean(t, i, x, s, tol)
+	checkVarAndStd(t, i, x, s, tol)
+
+	lo, hi := s.bounds()
+	checkCDFDiscrete(t, i, lo, hi, s, 1e-12)
+	checkMomentsDiscrete(t, i, lo, hi, s, 1e-8)
+
+	if s.NumParameters() != 2 {
+		t.Errorf("Mismatch in NumParameters: got %v, want 2", s.NumParameters())
+	}
+}
@@ -62,6 +62,32 @@ func (s StudentsT) CDF(x float64) float64 {
 	return 0.5 * mathext.RegIncBeta(s.Nu/2, 0.5, t)
 }
 
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The maximum likelihood estimates are found numerically, starting from
+// the weighted median, a scale proportional to the weighted median
+// absolute deviation and Nu = 5.
+func (s *StudentsT) Fit(samples, weights []float64) {
+	checkFit(samples, weights)
+	mu := weightedMedian(samples, weights)
+	dev := make([]float64, len(samples))
+	for i, x := range samples {
+		dev[i] = math.Abs(x - mu)
+	}
+	sigma := 1.4826 * weightedMedian(dev, weights)
+	if sigma == 0 {
+		sigma = 1
+	}
+	p := []float64{mu, math.Log(sigma), math.Log(5)}
+	maximize(func(p []float64) float64 {
+		return logLikelihood(StudentsT{Mu: p[0], Sigma: math.Exp(p[1]), Nu: math.Exp(p[2])}, samples, weights)
+	}, p)
+	s.Mu, s.Sigma, s.Nu = p[0], math.Exp(p[1]), math.Exp(p[2])
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x.
 func (s StudentsT) LogProb(x float64) float64 {
@@ -86,6 +112,25 @@ func (StudentsT) NumParameters() int {
 	return 3
 }
 
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in place into p, which must have length
+// NumParameters, and returned.
+func (s StudentsT) Parameters(p []Parameter) []Parameter {
+	nParam := s.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("studentst: improper parameter length")
+	}
+	p[0].Name = "Mu"
+	p[0].Value = s.Mu
+	p[1].Name = "Sigma"
+	p[1].Value = s.Sigma
+	p[2].Name = "Nu"
+	p[2].Value = s.Nu
+	return p
+}
+
 // Prob computes the value of the probability density function at x.
 func (s StudentsT) Prob(x float64) float64 {
 	return math.Exp(s.LogProb(x))
@@ -123,6 +168,26 @@ func (s StudentsT) Rand() float64 {
 	return z*s.Sigma + s.Mu
 }
 
+// SetParameters sets the parameters of the distribution to p. It panics if
+// the length or the names of p do not match those returned by Parameters.
+func (s *StudentsT) SetParameters(p []Parameter) {
+	if len(p) != s.NumParameters() {
+		panic("studentst: incorrect number of parameters to set")
+	}
+	if p[0].Name != "Mu" {
+		panic("studentst: " + panicNameMismatch)
+	}
+	if p[1].Name != "Sigma" {
+		panic("studentst: " + panicNameMismatch)
+	}
+	if p[2].Name != "Nu" {
+		panic("studentst: " + panicNameMismatch)
+	}
+	s.Mu = p[0].Value
+	s.Sigma = p[1].Value
+	s.Nu = p[2].Value
+}
+
 // StdDev returns the standard deviation of the probability distribution.
 //
 // The standard deviation is undefined for ν <= 1, and this returns math.NaN().
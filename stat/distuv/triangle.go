@@ -8,6 +8,8 @@ import (
 	"math"
 
 	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/stat"
 )
 
 // Triangle represents a triangle distribution (https://en.wikipedia.org/wiki/Triangular_distribution).
@@ -62,7 +64,74 @@ func (Triangle) ExKurtosis() float64 {
 	return -3.0 / 5.0
 }
 
-// Fit is not appropriate for Triangle, because the distribution is generally used when there is little data.
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The bounds of the distribution are not fitted and must contain the
+// samples. Fit sets the mode to its maximum likelihood estimate, which is
+// one of the bounds or one of the samples. Fit panics if a sample with
+// non-zero weight lies outside the bounds.
+func (t *Triangle) Fit(samples, weights []float64) {
+	checkFit(samples, weights)
+	checkTriangleParameters(t.a, t.b, t.c)
+	var x, w []float64
+	for i, v := range samples {
+		wi := sampleWeight(weights, i)
+		if wi == 0 {
+			continue
+		}
+		if v < t.a || t.b < v {
+			panic("triangle: sample out of bounds")
+		}
+		x = append(x, v)
+		w = append(w, wi)
+	}
+	stat.SortWeighted(x, w)
+
+	// With the mode at c, the log-likelihood is, up to a constant,
+	//  Σ_{x≤c} w (log(x-a) - log(c-a)) + Σ_{x>c} w (log(b-x) - log(b-c)).
+	// The sums over x > c are accumulated from the right.
+	n := len(x)
+	rightLog := make([]float64, n+1)
+	rightWeight := make([]float64, n+1)
+	for i := n - 1; i >= 0; i-- {
+		rightLog[i] = rightLog[i+1] + w[i]*math.Log(t.b-x[i])
+		rightWeight[i] = rightWeight[i+1] + w[i]
+	}
+	best := math.Inf(-1)
+	bestC := t.c
+	var leftLog, leftWeight float64
+	loglik := func(c float64, i int) float64 {
+		// The samples x[:i] are at most c and x[i:] are greater than c.
+		var l float64
+		if leftWeight > 0 {
+			l += leftLog - leftWeight*math.Log(c-t.a)
+		}
+		if rightWeight[i] > 0 {
+			l += rightLog[i] - rightWeight[i]*math.Log(t.b-c)
+		}
+		return l
+	}
+	if l := loglik(t.a, 0); l > best {
+		best, bestC = l, t.a
+	}
+	for i := 0; i < n; {
+		c := x[i]
+		for ; i < n && x[i] == c; i++ {
+			leftLog += w[i] * math.Log(x[i]-t.a)
+			leftWeight += w[i]
+		}
+		if l := loglik(c, i); l > best {
+			best, bestC = l, c
+		}
+	}
+	if l := loglik(t.b, n); l > best {
+		bestC = t.b
+	}
+	t.c = bestC
+}
 
 // LogProb computes the natural logarithm of the value of the probability density function at x.
 func (t Triangle) LogProb(x float64) float64 {
@@ -234,8 +303,10 @@ func (t Triangle) Survival(x float64) float64 {
 	return 1 - t.CDF(x)
 }
 
-// parameters returns the parameters of the distribution.
-func (t Triangle) parameters(p []Parameter) []Parameter {
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in place into p, which must have length
+// NumParameters, and returned.
+func (t Triangle) Parameters(p []Parameter) []Parameter {
 	nParam := t.NumParameters()
 	if p == nil {
 		p = make([]Parameter, nParam)
@@ -251,8 +322,10 @@ func (t Triangle) parameters(p []Parameter) []Parameter {
 	return p
 }
 
-// setParameters modifies the parameters of the distribution.
-func (t *Triangle) setParameters(p []Parameter) {
+// SetParameters sets the parameters of the distribution to p. It panics if
+// the length or the names of p do not match those returned by Parameters,
+// or if the parameters are not valid.
+func (t *Triangle) SetParameters(p []Parameter) {
 	if len(p) != t.NumParameters() {
 		panic("triangle: incorrect number of parameters to set")
 	}
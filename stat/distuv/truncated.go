@@ -0,0 +1,225 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+)
+
+// Truncatable is a continuous univariate distribution that can be truncated.
+type Truncatable interface {
+	LogProber
+	Quantiler
+	CDF(x float64) float64
+}
+
+// Truncated is a continuous univariate distribution Dist truncated to the
+// interval [Min, Max]. Its density is
+//
+//	f(x) = g(x) / (G(Max) - G(Min))
+//
+// for Min ≤ x ≤ Max and zero elsewhere, where g and G are the density and
+// distribution function of Dist. Min and Max may be infinite, and the
+// probability of [Min, Max] under Dist must be positive.
+//
+// If Dist has a Survival method, it is used to compute the probabilities of
+// intervals in the upper tail of Dist accurately.
+//
+// For more information, see https://en.wikipedia.org/wiki/Truncated_distribution.
+type Truncated struct {
+	// Dist is the distribution that is truncated.
+	Dist Truncatable
+	// Min and Max are the bounds of the truncation interval.
+	Min, Max float64
+
+	Src rand.Source
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (t Truncated) CDF(x float64) float64 {
+	switch {
+	case x <= t.Min:
+		return 0
+	case x >= t.Max:
+		return 1
+	}
+	if s, ok := t.upperTail(); ok {
+		return (s.Survival(t.Min) - s.Survival(x)) / t.mass()
+	}
+	return (t.Dist.CDF(x) - t.Dist.CDF(t.Min)) / t.mass()
+}
+
+// ExKurtosis returns the excess kurtosis of the distribution. It is
+// computed by numerical integration.
+func (t Truncated) ExKurtosis() float64 {
+	_, m2, _, m4 := t.moments()
+	return m4/(m2*m2) - 3
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (t Truncated) LogProb(x float64) float64 {
+	if x < t.Min || x > t.Max {
+		return math.Inf(-1)
+	}
+	return t.Dist.LogProb(x) - math.Log(t.mass())
+}
+
+// Mean returns the mean of the probability distribution. It is computed by
+// numerical integration.
+func (t Truncated) Mean() float64 {
+	mean, _, _, _ := t.moments()
+	return mean
+}
+
+// Median returns the median of the probability distribution.
+func (t Truncated) Median() float64 {
+	return t.Quantile(0.5)
+}
+
+// NumParameters returns the number of parameters in the distribution,
+// which is the number of parameters of Dist plus the two bounds. It panics
+// if Dist does not have a NumParameters method.
+func (t Truncated) NumParameters() int {
+	p, ok := t.Dist.(parameterCounter)
+	if !ok {
+		panic("distuv: truncated distribution does not implement NumParameters")
+	}
+	return p.NumParameters() + 2
+}
+
+// Prob computes the value of the probability density function at x.
+func (t Truncated) Prob(x float64) float64 {
+	return math.Exp(t.LogProb(x))
+}
+
+// Quantile returns the inverse of the cumulative distribution function.
+func (t Truncated) Quantile(p float64) float64 {
+	if p < 0 || p > 1 {
+		panic(badPercentile)
+	}
+	switch p {
+	case 0:
+		return t.Min
+	case 1:
+		return t.Max
+	}
+	if _, ok := t.upperTail(); ok {
+		// The quantile of Dist is inaccurate in the upper tail, so
+		// invert the distribution function numerically.
+		return continuousQuantile(t.CDF, p, t.Min, t.Max, t.Min+1)
+	}
+	lo := t.Dist.CDF(t.Min)
+	hi := t.Dist.CDF(t.Max)
+	x := t.Dist.Quantile(lo + p*(hi-lo))
+	return math.Max(t.Min, math.Min(t.Max, x))
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (t Truncated) Rand() float64 {
+	rnd := rand.Float64
+	if t.Src != nil {
+		rnd = rand.New(t.Src).Float64
+	}
+	return t.Quantile(rnd())
+}
+
+// Skewness returns the skewness of the distribution. It is computed by
+// numerical integration.
+func (t Truncated) Skewness() float64 {
+	_, m2, m3, _ := t.moments()
+	return m3 / (m2 * math.Sqrt(m2))
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+// It is computed by numerical integration.
+func (t Truncated) StdDev() float64 {
+	return math.Sqrt(t.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (t Truncated) Survival(x float64) float64 {
+	switch {
+	case x <= t.Min:
+		return 1
+	case x >= t.Max:
+		return 0
+	}
+	if s, ok := t.upperTail(); ok {
+		return (s.Survival(x) - s.Survival(t.Max)) / t.mass()
+	}
+	return (t.Dist.CDF(t.Max) - t.Dist.CDF(x)) / t.mass()
+}
+
+// Variance returns the variance of the probability distribution. It is
+// computed by numerical integration.
+func (t Truncated) Variance() float64 {
+	_, m2, _, _ := t.moments()
+	return m2
+}
+
+// parameterCounter is a distribution with a number of parameters.
+type parameterCounter interface {
+	NumParameters() int
+}
+
+// survivaler is a distribution with a survival function.
+type survivaler interface {
+	Survival(x float64) float64
+}
+
+// upperTail returns Dist as a survivaler and whether the truncation
+// interval is in the upper tail of Dist and Dist has a Survival method.
+func (t Truncated) upperTail() (survivaler, bool) {
+	s, ok := t.Dist.(survivaler)
+	if !ok || t.Dist.CDF(t.Min) <= 0.5 {
+		return nil, false
+	}
+	return s, true
+}
+
+// mass returns the probability of the truncation interval under Dist.
+func (t Truncated) mass() float64 {
+	if s, ok := t.upperTail(); ok {
+		return s.Survival(t.Min) - s.Survival(t.Max)
+	}
+	return t.Dist.CDF(t.Max) - t.Dist.CDF(t.Min)
+}
+
+// moments returns the mean and the second, third and fourth moments about
+// the mean. They are computed by integrating the powers of the quantile
+// function over (0, 1) using tanh-sinh quadrature, which is insensitive to
+// the singularities of the quantile function at the ends of the interval
+// when the support is unbounded.
+func (t Truncated) moments() (mean, m2, m3, m4 float64) {
+	const h = 1.0 / 32
+	var x, w []float64
+	for k := -256; k <= 256; k++ {
+		s := float64(k) * h
+		p := 1 / (1 + math.Exp(-math.Pi*math.Sinh(s)))
+		if p <= 0 || p >= 1 {
+			continue
+		}
+		wk := h * math.Pi * math.Cosh(s) * p * (1 - p)
+		if wk == 0 {
+			continue
+		}
+		x = append(x, t.Quantile(p))
+		w = append(w, wk)
+	}
+	for i, v := range x {
+		mean += w[i] * v
+	}
+	for i, v := range x {
+		d := v - mean
+		d2 := d * d
+		m2 += w[i] * d2
+		m3 += w[i] * d2 * d
+		m4 += w[i] * d2 * d2
+	}
+	return mean, m2, m3, m4
+}
@@ -0,0 +1,151 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestTruncatedNormalMoments(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		mu, sigma, min, max float64
+	}{
+		{0, 1, -1, 2},
+		{3, 2, -math.Inf(1), 4},
+		{3, 2, 4, math.Inf(1)},
+		{0, 1, 5, math.Inf(1)},
+		{0, 1, -8, -6},
+		{-2, 0.5, -2.5, -1},
+	} {
+		d := Truncated{
+			Dist: Normal{Mu: test.mu, Sigma: test.sigma},
+			Min:  test.min,
+			Max:  test.max,
+		}
+
+		// Closed form moments of the truncated normal distribution.
+		a := (test.min - test.mu) / test.sigma
+		b := (test.max - test.mu) / test.sigma
+		phiA := UnitNormal.Prob(a)
+		phiB := UnitNormal.Prob(b)
+		z := UnitNormal.CDF(b) - UnitNormal.CDF(a)
+		if a > 0 {
+			z = UnitNormal.Survival(a) - UnitNormal.Survival(b)
+		}
+		aPhiA, bPhiB := a*phiA, b*phiB
+		if math.IsInf(a, 0) {
+			aPhiA = 0
+		}
+		if math.IsInf(b, 0) {
+			bPhiB = 0
+		}
+		r := (phiA - phiB) / z
+		mean := test.mu + test.sigma*r
+		variance := test.sigma * test.sigma * (1 + (aPhiA-bPhiB)/z - r*r)
+
+		if got := d.Mean(); !scalar.EqualWithinAbsOrRel(got, mean, 1e-10, 1e-10) {
+			t.Errorf("Mean mismatch for %+v: got %v, want %v", test, got, mean)
+		}
+		if got := d.Variance(); !scalar.EqualWithinAbsOrRel(got, variance, 1e-8, 1e-8) {
+			t.Errorf("Variance mismatch for %+v: got %v, want %v", test, got, variance)
+		}
+		for _, x := range []float64{test.mu - 1, test.mu, test.mu + 0.5, a + 0.1, b - 0.1} {
+			x = math.Max(test.min, math.Min(test.max, x))
+			zx := (x - test.mu) / test.sigma
+			want := math.Log(UnitNormal.Prob(zx) / (test.sigma * z))
+			if got := d.LogProb(x); !scalar.EqualWithinAbsOrRel(got, want, 1e-10, 1e-10) {
+				t.Errorf("LogProb mismatch for %+v at %v: got %v, want %v", test, x, got, want)
+			}
+		}
+		if !math.IsInf(d.LogProb(test.min-1), -1) || !math.IsInf(d.LogProb(test.max+1), -1) {
+			t.Errorf("LogProb outside of the support for %+v is not -Inf", test)
+		}
+	}
+}
+
+func TestTruncatedExponential(t *testing.T) {
+	t.Parallel()
+	// The exponential distribution is memoryless, so truncating it below
+	// shifts it.
+	const rate, min = 2.0, 3.0
+	d := Truncated{Dist: Exponential{Rate: rate}, Min: min, Max: math.Inf(1)}
+	e := Exponential{Rate: rate}
+	for _, x := range []float64{3.01, 3.5, 4, 10, 20} {
+		if got, want := d.CDF(x), e.CDF(x-min); !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+			t.Errorf("CDF mismatch at %v: got %v, want %v", x, got, want)
+		}
+		if got, want := d.Survival(x), e.Survival(x-min); !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+			t.Errorf("Survival mismatch at %v: got %v, want %v", x, got, want)
+		}
+	}
+	for _, p := range []float64{0.01, 0.25, 0.5, 0.9, 0.999} {
+		if got, want := d.Quantile(p), e.Quantile(p)+min; !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+			t.Errorf("Quantile mismatch at %v: got %v, want %v", p, got, want)
+		}
+	}
+	for _, test := range []struct {
+		name      string
+		got, want float64
+	}{
+		{"Mean", d.Mean(), e.Mean() + min},
+		{"Variance", d.Variance(), e.Variance()},
+		{"Skewness", d.Skewness(), e.Skewness()},
+		{"ExKurtosis", d.ExKurtosis(), e.ExKurtosis()},
+		{"Median", d.Median(), e.Median() + min},
+	} {
+		if !scalar.EqualWithinAbsOrRel(test.got, test.want, 1e-6, 1e-6) {
+			t.Errorf("%s mismatch: got %v, want %v", test.name, test.got, test.want)
+		}
+	}
+}
+
+func TestTruncated(t *testing.T) {
+	t.Parallel()
+	src := rand.New(rand.NewSource(1))
+	for i, d := range []Truncated{
+		{Normal{Mu: 0, Sigma: 1}, -1, 2, src},
+		{Normal{Mu: 0, Sigma: 1}, 1, math.Inf(1), src},
+		{Gamma{Alpha: 2, Beta: 1}, 0.5, 4, src},
+		{Beta{Alpha: 2, Beta: 5}, 0.1, 0.5, src},
+		{Weibull{K: 1.5, Lambda: 2}, 0, 3, src},
+	} {
+		testTruncated(t, d, i)
+	}
+}
+
+func testTruncated(t *testing.T, d Truncated, i int) {
+	const (
+		tol  = 1e-2
+		n    = 1e5
+		bins = 50
+	)
+	x := make([]float64, n)
+	generateSamples(x, d)
+	sort.Float64s(x)
+	if x[0] < d.Min || x[len(x)-1] > d.Max {
+		t.Errorf("Sample outside of the support for case %v: got [%v, %v]", i, x[0], x[len(x)-1])
+	}
+
+	testRandLogProbContinuous(t, i, d.Min, x, d, tol, bins)
+	checkProbContinuous(t, i, x, d.Min, d.Max, d, 1e-3)
+	checkMean(t, i, x, d, tol)
+	checkVarAndStd(t, i, x, d, tol)
+	checkSkewness(t, i, x, d, 5e-2)
+	checkExKurtosis(t, i, x, d, 5e-2)
+	checkMedian(t, i, x, d, tol)
+	checkQuantileCDFSurvival(t, i, x, d, tol)
+	checkProbQuantContinuous(t, i, x, d, tol)
+
+	if d.NumParameters() != d.Dist.(interface{ NumParameters() int }).NumParameters()+2 {
+		t.Errorf("Mismatch in NumParameters for case %v", i)
+	}
+}
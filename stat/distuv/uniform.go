@@ -45,7 +45,24 @@ func (Uniform) ExKurtosis() float64 {
 	return -6.0 / 5.0
 }
 
-// Uniform doesn't have Fit because it's a bad idea to fit a uniform from data.
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The maximum likelihood estimates of Min and Max are the smallest and
+// largest samples with non-zero weight.
+func (u *Uniform) Fit(samples, weights []float64) {
+	checkFit(samples, weights)
+	u.Min, u.Max = math.Inf(1), math.Inf(-1)
+	for i, x := range samples {
+		if sampleWeight(weights, i) == 0 {
+			continue
+		}
+		u.Min = math.Min(u.Min, x)
+		u.Max = math.Max(u.Max, x)
+	}
+}
 
 // LogProb computes the natural logarithm of the value of the probability density function at x.
 func (u Uniform) LogProb(x float64) float64 {
@@ -58,8 +75,10 @@ func (u Uniform) LogProb(x float64) float64 {
 	return -math.Log(u.Max - u.Min)
 }
 
-// parameters returns the parameters of the distribution.
-func (u Uniform) parameters(p []Parameter) []Parameter {
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in place into p, which must have length
+// NumParameters, and returned.
+func (u Uniform) Parameters(p []Parameter) []Parameter {
 	nParam := u.NumParameters()
 	if p == nil {
 		p = make([]Parameter, nParam)
@@ -189,8 +208,9 @@ func (u Uniform) Survival(x float64) float64 {
 	return (u.Max - x) / (u.Max - u.Min)
 }
 
-// setParameters modifies the parameters of the distribution.
-func (u *Uniform) setParameters(p []Parameter) {
+// SetParameters sets the parameters of the distribution to p. It panics if
+// the length or the names of p do not match those returned by Parameters.
+func (u *Uniform) SetParameters(p []Parameter) {
 	if len(p) != u.NumParameters() {
 		panic("uniform: incorrect number of parameters to set")
 	}
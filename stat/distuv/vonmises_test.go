@@ -0,0 +1,89 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestVonMisesProbCDF(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		x, mu, kappa, wantProb, wantCDF float64
+	}{
+		// Values calculated from the series of I_0 and Simpson's rule.
+		{0.5, 0, 1, 0.30233824765314843, 0.6640764746049863},
+		{-2, 0, 1, 0.08291508547317152, 0.06575904411001672},
+		{1.3, 1, 4, 0.64306857917103, 0.7175888633937846},
+		{0, -2, 0.3, 0.13736745286092591, 0.8598946043468173},
+		{-4, 0, 1, 0.06538678888245533, 0},
+		{4, 0, 1, 0.06538678888245533, 1},
+	} {
+		v := VonMises{Mu: test.mu, Kappa: test.kappa}
+		if got := v.Prob(test.x); !scalar.EqualWithinAbsOrRel(got, test.wantProb, 1e-12, 1e-12) {
+			t.Errorf("Prob mismatch, x = %v, mu = %v, kappa = %v: got %v, want %v", test.x, test.mu, test.kappa, got, test.wantProb)
+		}
+		if got := v.CDF(test.x); !scalar.EqualWithinAbsOrRel(got, test.wantCDF, 1e-12, 1e-12) {
+			t.Errorf("CDF mismatch, x = %v, mu = %v, kappa = %v: got %v, want %v", test.x, test.mu, test.kappa, got, test.wantCDF)
+		}
+	}
+}
+
+func TestVonMises(t *testing.T) {
+	t.Parallel()
+	src := rand.New(rand.NewSource(1))
+	for i, v := range []VonMises{
+		{0, 1, src},
+		{1, 4, src},
+		{-2, 0.3, src},
+		{3, 200, src},
+		{0, 1e-6, src},
+	} {
+		testVonMises(t, v, i)
+	}
+}
+
+func testVonMises(t *testing.T, v VonMises, i int) {
+	const (
+		tol  = 1e-2
+		n    = 2e5
+		bins = 50
+	)
+	x := make([]float64, n)
+	generateSamples(x, v)
+	sort.Float64s(x)
+
+	lo, hi := v.Mu-math.Pi, v.Mu+math.Pi
+	testRandLogProbContinuous(t, i, lo, x, v, tol, bins)
+	checkProbContinuous(t, i, x, lo, hi, v, 1e-10)
+	checkProbQuantContinuous(t, i, x, v, tol)
+	checkEntropy(t, i, x, v, tol)
+	checkMean(t, i, x, v, tol)
+	checkMedian(t, i, x, v, tol)
+	checkVarAndStd(t, i, x, v, tol)
+	checkExKurtosis(t, i, x, v, 1e-1)
+	checkQuantileCDFSurvival(t, i, x, v, tol)
+
+	var c, s float64
+	for _, xx := range x {
+		c += math.Cos(xx - v.Mu)
+		s += math.Sin(xx - v.Mu)
+	}
+	if got, want := v.CircularVariance(), 1-math.Hypot(c, s)/n; !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+		t.Errorf("CircularVariance mismatch case %v: got %v, want %v", i, got, want)
+	}
+	if got := v.Prob(v.Mu + 1 + 2*math.Pi); !scalar.EqualWithinAbsOrRel(got, v.Prob(v.Mu+1), 1e-14, 1e-14) {
+		t.Errorf("Prob is not periodic case %v", i)
+	}
+	if v.NumParameters() != 2 {
+		t.Errorf("Mismatch in NumParameters: got %v, want 2", v.NumParameters())
+	}
+}
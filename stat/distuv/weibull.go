@@ -40,6 +40,48 @@ func (w Weibull) ExKurtosis() float64 {
 	return (-6*w.gammaIPow(1, 4) + 12*w.gammaIPow(1, 2)*math.Gamma(1+2/w.K) - 3*w.gammaIPow(2, 2) - 4*math.Gamma(1+1/w.K)*math.Gamma(1+3/w.K) + math.Gamma(1+4/w.K)) / math.Pow(math.Gamma(1+2/w.K)-w.gammaIPow(1, 2), 2)
 }
 
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The maximum likelihood estimate of K is the root of
+//
+//	Σ w x^K log(x) / Σ w x^K - 1/K - mean(log(x)) = 0,
+//
+// where the mean is weighted, and that of Lambda is mean(x^K)^(1/K).
+func (w *Weibull) Fit(samples, weights []float64) {
+	checkFit(samples, weights)
+	// Scale the samples by the largest so that their powers do not
+	// overflow.
+	var xMax float64
+	for i, x := range samples {
+		if sampleWeight(weights, i) != 0 {
+			xMax = math.Max(xMax, x)
+		}
+	}
+	meanLog, sumWeights := weightedMean(func(x float64) float64 { return math.Log(x / xMax) }, samples, weights)
+	sumPow := func(k float64) (s0, s1 float64) {
+		for i, x := range samples {
+			wi := sampleWeight(weights, i)
+			if wi == 0 {
+				continue
+			}
+			y := x / xMax
+			yk := math.Pow(y, k)
+			s0 += wi * yk
+			s1 += wi * yk * math.Log(y)
+		}
+		return s0, s1
+	}
+	w.K = findRoot(func(k float64) float64 {
+		s0, s1 := sumPow(k)
+		return s1/s0 - 1/k - meanLog
+	}, 1)
+	s0, _ := sumPow(w.K)
+	w.Lambda = xMax * math.Pow(s0/sumWeights, 1/w.K)
+}
+
 // gammIPow is a shortcut for computing the gamma function to a power.
 func (w Weibull) gammaIPow(i, pow float64) float64 {
 	return math.Pow(math.Gamma(1+i/w.K), pow)
@@ -195,8 +237,9 @@ func (w Weibull) Survival(x float64) float64 {
 	return math.Exp(w.LogSurvival(x))
 }
 
-// setParameters modifies the parameters of the distribution.
-func (w *Weibull) setParameters(p []Parameter) {
+// SetParameters sets the parameters of the distribution to p. It panics if
+// the length or the names of p do not match those returned by Parameters.
+func (w *Weibull) SetParameters(p []Parameter) {
 	if len(p) != w.NumParameters() {
 		panic("weibull: incorrect number of parameters to set")
 	}
@@ -215,8 +258,10 @@ func (w Weibull) Variance() float64 {
 	return math.Pow(w.Lambda, 2) * (math.Gamma(1+2/w.K) - w.gammaIPow(1, 2))
 }
 
-// parameters returns the parameters of the distribution.
-func (w Weibull) parameters(p []Parameter) []Parameter {
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in place into p, which must have length
+// NumParameters, and returned.
+func (w Weibull) Parameters(p []Parameter) []Parameter {
 	nParam := w.NumParameters()
 	if p == nil {
 		p = make([]Parameter, nParam)
@@ -0,0 +1,200 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+)
+
+// WrappedCauchy implements the wrapped Cauchy distribution, a continuous
+// probability distribution of angles that results from wrapping the Cauchy
+// distribution with location μ and scale γ around the unit circle.
+// The wrapped Cauchy distribution has density function:
+//
+//	f(x) = 1/(2π) sinh(γ) / (cosh(γ) - cos(x-μ))
+//
+// for x in [μ-π, μ+π]. Prob and LogProb are periodic with period 2π so that
+// they give the density of angles that have not been reduced to [μ-π, μ+π],
+// and the moments are those of the distribution on [μ-π, μ+π].
+//
+// For more information, see https://en.wikipedia.org/wiki/Wrapped_Cauchy_distribution.
+type WrappedCauchy struct {
+	// Mu is the location of the distribution in radians.
+	Mu float64
+	// Scale is the scale of the wrapped Cauchy distribution. Scale must be
+	// greater than 0.
+	Scale float64
+
+	Src rand.Source
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (w WrappedCauchy) CDF(x float64) float64 {
+	return w.cdf(x - w.Mu)
+}
+
+// CircularVariance returns the circular variance of the distribution,
+// 1 - e^(-γ).
+func (w WrappedCauchy) CircularVariance() float64 {
+	return -math.Expm1(-w.Scale)
+}
+
+// Entropy returns the differential entropy of the distribution.
+func (w WrappedCauchy) Entropy() float64 {
+	return math.Log(-2 * math.Pi * math.Expm1(-2*w.Scale))
+}
+
+// ExKurtosis returns the excess kurtosis of the distribution.
+func (w WrappedCauchy) ExKurtosis() float64 {
+	m2, m4 := w.moments()
+	return m4/(m2*m2) - 3
+}
+
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The maximum likelihood estimates are found numerically, starting from the
+// weighted circular mean of the samples for Mu and from -log R for Scale,
+// where R is the weighted mean resultant length of the samples.
+func (w *WrappedCauchy) Fit(samples, weights []float64) {
+	checkFit(samples, weights)
+	c, _ := weightedMean(math.Cos, samples, weights)
+	s, _ := weightedMean(math.Sin, samples, weights)
+	x := []float64{math.Atan2(s, c), math.Log(-math.Log(math.Hypot(c, s)))}
+	maximize(func(x []float64) float64 {
+		return logLikelihood(WrappedCauchy{Mu: x[0], Scale: math.Exp(x[1])}, samples, weights)
+	}, x)
+	w.Mu, w.Scale = math.Remainder(x[0], 2*math.Pi), math.Exp(x[1])
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (w WrappedCauchy) LogProb(x float64) float64 {
+	return math.Log(math.Sinh(w.Scale)/(math.Cosh(w.Scale)-math.Cos(x-w.Mu))) - log2Pi
+}
+
+// Mean returns the mean of the probability distribution.
+func (w WrappedCauchy) Mean() float64 {
+	return w.Mu
+}
+
+// Median returns the median of the probability distribution.
+func (w WrappedCauchy) Median() float64 {
+	return w.Mu
+}
+
+// Mode returns the mode of the probability distribution.
+func (w WrappedCauchy) Mode() float64 {
+	return w.Mu
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (WrappedCauchy) NumParameters() int {
+	return 2
+}
+
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in place into p, which must have length
+// NumParameters, and returned.
+func (w WrappedCauchy) Parameters(p []Parameter) []Parameter {
+	nParam := w.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("wrappedcauchy: improper parameter length")
+	}
+	p[0].Name = "Mu"
+	p[0].Value = w.Mu
+	p[1].Name = "Scale"
+	p[1].Value = w.Scale
+	return p
+}
+
+// Prob computes the value of the probability density function at x.
+func (w WrappedCauchy) Prob(x float64) float64 {
+	return math.Exp(w.LogProb(x))
+}
+
+// Quantile returns the inverse of the cumulative distribution function.
+func (w WrappedCauchy) Quantile(p float64) float64 {
+	if p < 0 || p > 1 {
+		panic(badPercentile)
+	}
+	switch p {
+	case 0:
+		return w.Mu - math.Pi
+	case 1:
+		return w.Mu + math.Pi
+	}
+	return w.Mu + 2*math.Atan(math.Tanh(w.Scale/2)*math.Tan(math.Pi*(p-0.5)))
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (w WrappedCauchy) Rand() float64 {
+	rnd := rand.Float64
+	if w.Src != nil {
+		rnd = rand.New(w.Src).Float64
+	}
+	return w.Mu + 2*math.Atan(math.Tanh(w.Scale/2)*math.Tan(math.Pi*(rnd()-0.5)))
+}
+
+// SetParameters sets the parameters of the distribution to p. It panics if
+// the length or the names of p do not match those returned by Parameters.
+func (w *WrappedCauchy) SetParameters(p []Parameter) {
+	if len(p) != 2 {
+		panic("wrappedcauchy: incorrect number of parameters to set")
+	}
+	if p[0].Name != "Mu" {
+		panic("wrappedcauchy: " + panicNameMismatch)
+	}
+	if p[1].Name != "Scale" {
+		panic("wrappedcauchy: " + panicNameMismatch)
+	}
+	w.Mu = p[0].Value
+	w.Scale = p[1].Value
+}
+
+// Skewness returns the skewness of the distribution.
+func (WrappedCauchy) Skewness() float64 {
+	return 0
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (w WrappedCauchy) StdDev() float64 {
+	return math.Sqrt(w.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (w WrappedCauchy) Survival(x float64) float64 {
+	return w.cdf(w.Mu - x)
+}
+
+// Variance returns the variance of the probability distribution.
+func (w WrappedCauchy) Variance() float64 {
+	m2, _ := w.moments()
+	return m2
+}
+
+// cdf returns the value of the distribution function at μ+θ.
+func (w WrappedCauchy) cdf(theta float64) float64 {
+	switch {
+	case theta <= -math.Pi:
+		return 0
+	case theta >= math.Pi:
+		return 1
+	}
+	return 0.5 + math.Atan(math.Tan(theta/2)/math.Tanh(w.Scale/2))/math.Pi
+}
+
+// moments returns the second and fourth moments about the mean.
+func (w WrappedCauchy) moments() (m2, m4 float64) {
+	return circularMoments(func(n int) float64 {
+		return math.Exp(-float64(n) * w.Scale)
+	})
+}
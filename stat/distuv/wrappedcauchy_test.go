@@ -0,0 +1,89 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestWrappedCauchyProb(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		mu, scale float64
+	}{
+		{0, 1},
+		{2, 0.3},
+		{-1, 3},
+	} {
+		// The density is the sum of the densities of the wrapped Cauchy
+		// distribution over all the windings.
+		w := WrappedCauchy{Mu: test.mu, Scale: test.scale}
+		c := Cauchy{Mu: test.mu, Scale: test.scale}
+		for _, x := range []float64{test.mu - 3, test.mu - 0.5, test.mu, test.mu + 1, test.mu + 3} {
+			const windings = 1e6
+			want := c.Prob(x)
+			for k := 1.0; k <= windings; k++ {
+				want += c.Prob(x+2*math.Pi*k) + c.Prob(x-2*math.Pi*k)
+			}
+			// Add the integral of the tails beyond the windings.
+			want += 2 * (0.5 - math.Atan(2*math.Pi*(windings+0.5)/test.scale)/math.Pi) / (2 * math.Pi)
+			if got := w.Prob(x); !scalar.EqualWithinAbsOrRel(got, want, 1e-11, 1e-11) {
+				t.Errorf("Prob mismatch, x = %v, mu = %v, scale = %v: got %v, want %v", x, test.mu, test.scale, got, want)
+			}
+		}
+	}
+}
+
+func TestWrappedCauchy(t *testing.T) {
+	t.Parallel()
+	src := rand.New(rand.NewSource(1))
+	for i, w := range []WrappedCauchy{
+		{0, 1, src},
+		{2, 0.3, src},
+		{-1, 3, src},
+	} {
+		testWrappedCauchy(t, w, i)
+	}
+}
+
+func testWrappedCauchy(t *testing.T, w WrappedCauchy, i int) {
+	const (
+		tol  = 1e-2
+		n    = 2e5
+		bins = 50
+	)
+	x := make([]float64, n)
+	generateSamples(x, w)
+	sort.Float64s(x)
+
+	lo, hi := w.Mu-math.Pi, w.Mu+math.Pi
+	testRandLogProbContinuous(t, i, lo, x, w, tol, bins)
+	checkProbContinuous(t, i, x, lo, hi, w, 1e-10)
+	checkProbQuantContinuous(t, i, x, w, tol)
+	checkEntropy(t, i, x, w, tol)
+	checkMean(t, i, x, w, tol)
+	checkMedian(t, i, x, w, tol)
+	checkVarAndStd(t, i, x, w, tol)
+	checkExKurtosis(t, i, x, w, 1e-1)
+	checkQuantileCDFSurvival(t, i, x, w, tol)
+
+	var c, s float64
+	for _, xx := range x {
+		c += math.Cos(xx - w.Mu)
+		s += math.Sin(xx - w.Mu)
+	}
+	if got, want := w.CircularVariance(), 1-math.Hypot(c, s)/n; !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+		t.Errorf("CircularVariance mismatch case %v: got %v, want %v", i, got, want)
+	}
+	if w.NumParameters() != 2 {
+		t.Errorf("Mismatch in NumParameters: got %v, want 2", w.NumParameters())
+	}
+}
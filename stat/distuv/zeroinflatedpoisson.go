@@ -0,0 +1,198 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+)
+
+// ZeroInflatedPoisson implements the zero-inflated Poisson distribution, a
+// discrete probability distribution that is a mixture of a point mass at zero
+// with probability π and a Poisson distribution with rate λ with probability
+// 1-π.
+// The zero-inflated Poisson distribution has the density function:
+//
+//	f(0) = π + (1-π) e^(-λ)
+//	f(k) = (1-π) λ^k / k! e^(-λ), k > 0
+//
+// For more information, see https://en.wikipedia.org/wiki/Zero-inflated_model.
+type ZeroInflatedPoisson struct {
+	// Lambda is the rate of the Poisson component. Lambda must be greater
+	// than 0.
+	Lambda float64
+	// Pi is the probability of the point mass at zero. Pi must be in [0, 1).
+	Pi float64
+
+	Src rand.Source
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (z ZeroInflatedPoisson) CDF(x float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	return z.Pi + (1-z.Pi)*z.poisson().CDF(x)
+}
+
+// ExKurtosis returns the excess kurtosis of the distribution.
+func (z ZeroInflatedPoisson) ExKurtosis() float64 {
+	m1, m2, m3, m4 := z.rawMoments()
+	v := m2 - m1*m1
+	return (m4-4*m1*m3+6*m1*m1*m2-3*m1*m1*m1*m1)/(v*v) - 3
+}
+
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The maximum likelihood estimate of Lambda is the root of
+// λ/(1-e^(-λ)) = m, where m is the weighted mean of the positive samples,
+// and that of Pi is 1 - x̄/λ, where x̄ is the weighted mean of the samples.
+// If there are fewer zeros than a Poisson distribution would give, or if all
+// the positive samples are one, Pi is set to 0 and Lambda to x̄. If all the samples are zero, Pi is set to 1 and
+// Lambda is left unchanged.
+func (z *ZeroInflatedPoisson) Fit(samples, weights []float64) {
+	checkFit(samples, weights)
+	mean, sumWeights := weightedMean(identity, samples, weights)
+	if mean == 0 {
+		z.Pi = 1
+		return
+	}
+	var sumPos float64
+	for i, x := range samples {
+		if x > 0 {
+			sumPos += sampleWeight(weights, i)
+		}
+	}
+	m := mean * sumWeights / sumPos
+	if m <= 1 {
+		// All the positive samples are one, so the equation has no root.
+		z.Lambda, z.Pi = mean, 0
+		return
+	}
+	lambda := findRoot(func(l float64) float64 {
+		return -l/math.Expm1(-l) - m
+	}, m)
+	pi := 1 - mean/lambda
+	if pi < 0 {
+		lambda, pi = mean, 0
+	}
+	z.Lambda, z.Pi = lambda, pi
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (z ZeroInflatedPoisson) LogProb(x float64) float64 {
+	if x == 0 {
+		return math.Log(z.Pi + (1-z.Pi)*math.Exp(-z.Lambda))
+	}
+	return math.Log1p(-z.Pi) + z.poisson().LogProb(x)
+}
+
+// Mean returns the mean of the probability distribution.
+func (z ZeroInflatedPoisson) Mean() float64 {
+	return (1 - z.Pi) * z.Lambda
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (ZeroInflatedPoisson) NumParameters() int {
+	return 2
+}
+
+// Parameters returns the parameters of the distribution. If p is not nil,
+// the parameters are stored in place into p, which must have length
+// NumParameters, and returned.
+func (z ZeroInflatedPoisson) Parameters(p []Parameter) []Parameter {
+	nParam := z.NumParameters()
+	if p == nil {
+		p = make([]Parameter, nParam)
+	} else if len(p) != nParam {
+		panic("zeroinflatedpoisson: improper parameter length")
+	}
+	p[0].Name = "Lambda"
+	p[0].Value = z.Lambda
+	p[1].Name = "Pi"
+	p[1].Value = z.Pi
+	return p
+}
+
+// Prob computes the value of the probability density function at x.
+func (z ZeroInflatedPoisson) Prob(x float64) float64 {
+	return math.Exp(z.LogProb(x))
+}
+
+// Quantile returns the smallest k such that CDF(k) ≥ p.
+// Quantile panics if p is not in the interval [0, 1].
+func (z ZeroInflatedPoisson) Quantile(p float64) float64 {
+	return discreteQuantile(z.CDF, p, 0, math.Inf(1), z.Lambda)
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (z ZeroInflatedPoisson) Rand() float64 {
+	rnd := rand.Float64
+	if z.Src != nil {
+		rnd = rand.New(z.Src).Float64
+	}
+	if rnd() < z.Pi {
+		return 0
+	}
+	return z.poisson().Rand()
+}
+
+// SetParameters sets the parameters of the distribution to p. It panics if
+// the length or the names of p do not match those returned by Parameters.
+func (z *ZeroInflatedPoisson) SetParameters(p []Parameter) {
+	if len(p) != 2 {
+		panic("zeroinflatedpoisson: incorrect number of parameters to set")
+	}
+	if p[0].Name != "Lambda" {
+		panic("zeroinflatedpoisson: " + panicNameMismatch)
+	}
+	if p[1].Name != "Pi" {
+		panic("zeroinflatedpoisson: " + panicNameMismatch)
+	}
+	z.Lambda = p[0].Value
+	z.Pi = p[1].Value
+}
+
+// Skewness returns the skewness of the distribution.
+func (z ZeroInflatedPoisson) Skewness() float64 {
+	m1, m2, m3, _ := z.rawMoments()
+	v := m2 - m1*m1
+	return (m3 - 3*m1*v - m1*m1*m1) / (v * math.Sqrt(v))
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (z ZeroInflatedPoisson) StdDev() float64 {
+	return math.Sqrt(z.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (z ZeroInflatedPoisson) Survival(x float64) float64 {
+	if x < 0 {
+		return 1
+	}
+	return (1 - z.Pi) * z.poisson().Survival(x)
+}
+
+// Variance returns the variance of the probability distribution.
+func (z ZeroInflatedPoisson) Variance() float64 {
+	return z.Lambda * (1 - z.Pi) * (1 + z.Pi*z.Lambda)
+}
+
+// poisson returns the Poisson component of the distribution.
+func (z ZeroInflatedPoisson) poisson() Poisson {
+	return Poisson{Lambda: z.Lambda, Src: z.Src}
+}
+
+// rawMoments returns the first four moments about zero of the distribution.
+func (z ZeroInflatedPoisson) rawMoments() (m1, m2, m3, m4 float64) {
+	l := z.Lambda
+	q := 1 - z.Pi
+	return q * l, q * l * (1 + l), q * l * (1 + l*(3+l)), q * l * (1 + l*(7+l*(6+l)))
+}
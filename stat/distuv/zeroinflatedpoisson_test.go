@@ -0,0 +1,78 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestZeroInflatedPoissonProbCDF(t *testing.T) {
+	t.Parallel()
+	z := ZeroInflatedPoisson{Lambda: 2.5, Pi: 0.3}
+	for _, test := range []struct {
+		x, wantProb, wantCDF float64
+	}{
+		{0, 0.35745949903672913, 0.35745949903672913},
+		{3, 0.14963411207481547, 0.8303032931931462},
+		{-1, 0, 0},
+	} {
+		if got := z.Prob(test.x); !scalar.EqualWithinAbsOrRel(got, test.wantProb, 1e-13, 1e-13) {
+			t.Errorf("Prob mismatch, x = %v: got %v, want %v", test.x, got, test.wantProb)
+		}
+		if got := z.CDF(test.x); !scalar.EqualWithinAbsOrRel(got, test.wantCDF, 1e-13, 1e-13) {
+			t.Errorf("CDF mismatch, x = %v: got %v, want %v", test.x, got, test.wantCDF)
+		}
+	}
+
+	// With no inflation the distribution is Poisson.
+	z = ZeroInflatedPoisson{Lambda: 4}
+	p := Poisson{Lambda: 4}
+	for k := 0.0; k < 15; k++ {
+		if got, want := z.Prob(k), p.Prob(k); !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+			t.Errorf("Prob mismatch with Poisson at %v: got %v, want %v", k, got, want)
+		}
+	}
+}
+
+func TestZeroInflatedPoisson(t *testing.T) {
+	t.Parallel()
+	src := rand.New(rand.NewSource(1))
+	for i, z := range []ZeroInflatedPoisson{
+		{2.5, 0.3, src},
+		{20, 0.1, src},
+		{0.5, 0.8, src},
+	} {
+		testZeroInflatedPoisson(t, z, i)
+	}
+}
+
+func testZeroInflatedPoisson(t *testing.T, z ZeroInflatedPoisson, i int) {
+	const (
+		tol = 2e-2
+		n   = 1e6
+	)
+	x := make([]float64, n)
+	generateSamples(x, z)
+	sort.Float64s(x)
+
+	checkProbDiscrete(t, i, x, z, 2e-3)
+	checkMean(t, i, x, z, tol)
+	checkVarAndStd(t, i, x, z, tol)
+	checkSkewness(t, i, x, z, 5e-2)
+	checkExKurtosis(t, i, x, z, 2e-1)
+
+	hi := z.Quantile(1 - 1e-15)
+	checkCDFDiscrete(t, i, 0, hi, z, 1e-12)
+	checkMomentsDiscrete(t, i, 0, 3*hi, z, 1e-8)
+
+	if z.NumParameters() != 2 {
+		t.Errorf("Mismatch in NumParameters: got %v, want 2", z.NumParameters())
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package gmm provides Gaussian mixture models fitted by the
+// expectation-maximization algorithm.
+//
+// A Gaussian mixture model is a distribution whose density is
+//
+//	p(x) = Σ_k π_k N(x; μ_k, Σ_k),
+//
+// where the weights π_k sum to one and N is the density of the multivariate
+// normal distribution. The covariances Σ_k may be full, diagonal, shared by
+// all of the components or spherical. The parameters are initialized by
+// k-means clustering with k-means++ seeding and are fitted by maximizing
+// the likelihood of the observations with the expectation-maximization
+// algorithm.
+//
+// The number of components may be selected by comparing the Akaike and
+// Bayesian information criteria of models with different numbers of
+// components, which GMM.Select does.
+//
+// See Bishop, C. M.: Pattern Recognition and Machine Learning, chapter 9.
+// Springer (2006) for an introduction.
+package gmm // import "gonum.org/v1/gonum/stat/gmm"
@@ -0,0 +1,528 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gmm
+
+import (
+	"errors"
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/distmv"
+)
+
+var (
+	// ErrNotPositiveDefinite is returned when a fitted covariance matrix is
+	// not positive definite. Increasing the regularization of the
+	// covariances may avoid it.
+	ErrNotPositiveDefinite = errors.New("gmm: covariance matrix is not positive definite")
+
+	// ErrNotConverged is returned when the fitting does not converge within
+	// the maximum number of iterations.
+	ErrNotConverged = errors.New("gmm: fitting did not converge")
+)
+
+const badLength = "gmm: length mismatch"
+
+// eps is the machine epsilon of float64.
+const eps = 0x1p-52
+
+const log2Pi = 1.8378770664093454835606594728112352797227949472755668
+
+// CovarianceType is the form of the covariance matrices of the components
+// of a Gaussian mixture model.
+type CovarianceType int
+
+const (
+	// Full is an unconstrained covariance matrix for each component.
+	Full CovarianceType = iota
+	// Diagonal is a diagonal covariance matrix for each component.
+	Diagonal
+	// Tied is a single unconstrained covariance matrix shared by all of the
+	// components.
+	Tied
+	// Spherical is a multiple of the identity matrix for each component.
+	Spherical
+)
+
+// String returns the name of the covariance type.
+func (c CovarianceType) String() string {
+	switch c {
+	case Full:
+		return "Full"
+	case Diagonal:
+		return "Diagonal"
+	case Tied:
+		return "Tied"
+	case Spherical:
+		return "Spherical"
+	default:
+		return "CovarianceType(invalid)"
+	}
+}
+
+// GMM specifies a Gaussian mixture model and how it is fitted.
+//
+// The parameters are initialized by weighted k-means clustering of the
+// observations with k-means++ seeding, and are then fitted by the
+// expectation-maximization algorithm, which increases the weighted log
+// likelihood
+//
+//	Σ_i w_i log p(x_i)
+//
+// of the observations at each iteration.
+type GMM struct {
+	// Components is the number of components of the mixture. It must be
+	// positive.
+	Components int
+
+	// Covariance is the form of the covariance matrices.
+	Covariance CovarianceType
+
+	// Regularization is added to the diagonal of the fitted covariance
+	// matrices to keep them positive definite when components collapse
+	// onto few observations. It must not be negative.
+	Regularization float64
+
+	// MaxIterations is the maximum number of iterations of the
+	// expectation-maximization algorithm. If MaxIterations is zero, it is
+	// defaulted to 100.
+	MaxIterations int
+
+	// Tolerance is the convergence tolerance of the fitting, which stops
+	// when the change of the log likelihood divided by the sum of the
+	// weights is less than Tolerance. If Tolerance is zero, it is
+	// defaulted to 1e-6.
+	Tolerance float64
+
+	// Initializations is the number of initializations from which the
+	// model is fitted. The fit with the largest log likelihood is
+	// returned. If Initializations is zero, it is defaulted to 1.
+	Initializations int
+
+	// Src is the source of randomness of the initialization. If Src is
+	// nil, the global source is used.
+	Src rand.Source
+}
+
+// Fit fits the model to the observations, which are the rows of x, with
+// the given weights. If weights is nil, all the weights are one.
+// Observations with zero weight do not contribute to the fit.
+//
+// Fit returns ErrNotPositiveDefinite if a covariance matrix is not positive
+// definite in all of the initializations. If the fitting does not converge,
+// Fit returns the fitted model and ErrNotConverged.
+//
+// Fit panics if the length of weights does not match the number of rows of
+// x, if a weight or the regularization is negative, if the covariance type
+// is not valid, or if there are fewer observations with positive weight
+// than components.
+func (g *GMM) Fit(x mat.Matrix, weights []float64) (*Model, error) {
+	n, d := x.Dims()
+	if weights != nil && len(weights) != n {
+		panic(badLength)
+	}
+	if g.Components <= 0 {
+		panic("gmm: non-positive number of components")
+	}
+	if g.Regularization < 0 {
+		panic("gmm: negative regularization")
+	}
+	if g.Covariance < Full || Spherical < g.Covariance {
+		panic("gmm: invalid covariance type")
+	}
+	maxIter := g.MaxIterations
+	if maxIter == 0 {
+		maxIter = 100
+	}
+	tol := g.Tolerance
+	if tol == 0 {
+		tol = 1e-6
+	}
+	inits := g.Initializations
+	if inits == 0 {
+		inits = 1
+	}
+
+	w := make([]float64, n)
+	var (
+		sumW     float64
+		positive int
+	)
+	for i := range w {
+		w[i] = 1
+		if weights != nil {
+			if weights[i] < 0 {
+				panic("gmm: negative weight")
+			}
+			w[i] = weights[i]
+		}
+		if w[i] > 0 {
+			positive++
+		}
+		sumW += w[i]
+	}
+	if positive < g.Components {
+		panic("gmm: fewer observations than components")
+	}
+
+	uniform := rand.Float64
+	if g.Src != nil {
+		uniform = rand.New(g.Src).Float64
+	}
+	f := fitter{
+		x:    mat.DenseCopyOf(x),
+		w:    w,
+		sumW: sumW,
+		k:    g.Components,
+		typ:  g.Covariance,
+		reg:  g.Regularization,
+	}
+	var (
+		best      *params
+		bestLL    float64
+		bestIter  int
+		converged bool
+		err       error
+	)
+	for init := 0; init < inits; init++ {
+		p := newParams(g.Components, d)
+		resp := mat.NewDense(n, g.Components, nil)
+		f.kmeans(resp, uniform)
+		err = f.mStep(p, resp)
+		if err != nil {
+			continue
+		}
+		ll := math.Inf(-1)
+		var (
+			iter int
+			conv bool
+		)
+		for iter = 1; iter <= maxIter; iter++ {
+			newLL := f.eStep(p, resp)
+			conv = math.Abs(newLL-ll) < tol*sumW
+			ll = newLL
+			if conv {
+				break
+			}
+			err = f.mStep(p, resp)
+			if err != nil {
+				break
+			}
+		}
+		if err != nil {
+			continue
+		}
+		if !conv {
+			ll = f.eStep(p, resp)
+		}
+		if best == nil || ll > bestLL {
+			best, bestLL, bestIter, converged = p, ll, min(iter, maxIter), conv
+		}
+	}
+	if best == nil {
+		return nil, err
+	}
+
+	m := &Model{
+		Weights:       best.weights,
+		Means:         make([][]float64, g.Components),
+		Covariances:   best.covs,
+		Covariance:    g.Covariance,
+		LogLikelihood: bestLL,
+		Iterations:    bestIter,
+		chols:         best.chols,
+		means:         best.means,
+	}
+	for k := range m.Means {
+		m.Means[k] = best.means.RawRowView(k)
+	}
+	p := float64(m.NumParameters())
+	m.AIC = 2*p - 2*bestLL
+	m.BIC = p*math.Log(sumW) - 2*bestLL
+	if !converged {
+		return m, ErrNotConverged
+	}
+	return m, nil
+}
+
+// Model is a fitted Gaussian mixture model.
+type Model struct {
+	// Weights are the weights of the components, which sum to one.
+	Weights []float64
+
+	// Means are the means of the components.
+	Means [][]float64
+
+	// Covariances are the covariance matrices of the components,
+	// including the regularization. With the Tied covariance type, all of
+	// the components share the same covariance matrix.
+	Covariances []*mat.SymDense
+
+	// Covariance is the form of the covariance matrices.
+	Covariance CovarianceType
+
+	// LogLikelihood is the weighted log likelihood of the observations
+	// under the model.
+	LogLikelihood float64
+
+	// AIC and BIC are the Akaike and Bayesian information criteria of the
+	// model. The number of observations of the BIC is the sum of the
+	// weights.
+	AIC float64
+	BIC float64
+
+	// Iterations is the number of iterations of the fitting.
+	Iterations int
+
+	chols []mat.Cholesky
+	means *mat.Dense
+}
+
+// Dim returns the dimension of the observations of the model.
+func (m *Model) Dim() int {
+	return len(m.Means[0])
+}
+
+// LogProb returns the log of the density of the model at x.
+func (m *Model) LogProb(x []float64) float64 {
+	return floats.LogSumExp(m.logJoint(x))
+}
+
+// Mixture returns the model as a mixture of multivariate normal
+// distributions. The input src is passed to the components and the
+// mixture.
+func (m *Model) Mixture(src rand.Source) *distmv.Mixture {
+	components := make([]distmv.RandLogProber, len(m.Weights))
+	for k := range components {
+		components[k] = m.Normal(k, src)
+	}
+	return distmv.NewMixture(components, m.Weights, src)
+}
+
+// Normal returns the normal distribution of the k-th component. The input
+// src is passed to the call to distmv.NewNormal.
+func (m *Model) Normal(k int, src rand.Source) *distmv.Normal {
+	n, ok := distmv.NewNormal(m.Means[k], m.Covariances[k], src)
+	if !ok {
+		panic("gmm: covariance matrix is not positive definite")
+	}
+	return n
+}
+
+// NumParameters returns the number of free parameters of the model.
+func (m *Model) NumParameters() int {
+	k := len(m.Weights)
+	d := m.Dim()
+	p := k - 1 + k*d
+	switch m.Covariance {
+	case Full:
+		p += k * d * (d + 1) / 2
+	case Diagonal:
+		p += k * d
+	case Tied:
+		p += d * (d + 1) / 2
+	case Spherical:
+		p += k
+	}
+	return p
+}
+
+// Predict returns the index of the component with the largest posterior
+// probability for x.
+func (m *Model) Predict(x []float64) int {
+	return floats.MaxIdx(m.logJoint(x))
+}
+
+// PredictProb returns the posterior probabilities of the components for x,
+// the probabilities that x is drawn from each of the components.
+//
+// If dst is not nil, the probabilities are stored in-place into dst and
+// returned, otherwise a new slice is allocated first. If dst is not nil, it
+// must have length equal to the number of components.
+func (m *Model) PredictProb(dst, x []float64) []float64 {
+	if dst == nil {
+		dst = make([]float64, len(m.Weights))
+	}
+	if len(dst) != len(m.Weights) {
+		panic(badLength)
+	}
+	lp := m.logJoint(x)
+	lse := floats.LogSumExp(lp)
+	for k, v := range lp {
+		dst[k] = math.Exp(v - lse)
+	}
+	return dst
+}
+
+// logJoint returns the log of the weighted densities of the components at
+// x.
+func (m *Model) logJoint(x []float64) []float64 {
+	if len(x) != m.Dim() {
+		panic(badLength)
+	}
+	dst := mat.NewDense(1, len(m.Weights), nil)
+	logJoint(dst, mat.NewDense(1, len(x), x), m.Weights, m.means, m.chols)
+	return dst.RawRowView(0)
+}
+
+// params are the parameters of a Gaussian mixture model during fitting.
+type params struct {
+	weights []float64
+	means   *mat.Dense
+	covs    []*mat.SymDense
+	chols   []mat.Cholesky
+}
+
+func newParams(k, d int) *params {
+	p := &params{
+		weights: make([]float64, k),
+		means:   mat.NewDense(k, d, nil),
+		covs:    make([]*mat.SymDense, k),
+		chols:   make([]mat.Cholesky, k),
+	}
+	for i := range p.covs {
+		p.covs[i] = mat.NewSymDense(d, nil)
+	}
+	return p
+}
+
+// fitter holds the data of the fitting of a Gaussian mixture model.
+type fitter struct {
+	x    *mat.Dense
+	w    []float64
+	sumW float64
+	k    int
+	typ  CovarianceType
+	reg  float64
+}
+
+// eStep stores the posterior probabilities of the components for each
+// observation in resp and returns the weighted log likelihood of the
+// observations.
+func (f *fitter) eStep(p *params, resp *mat.Dense) float64 {
+	logJoint(resp, f.x, p.weights, p.means, p.chols)
+	n, _ := f.x.Dims()
+	var ll float64
+	for i := 0; i < n; i++ {
+		row := resp.RawRowView(i)
+		lse := floats.LogSumExp(row)
+		if f.w[i] != 0 {
+			ll += f.w[i] * lse
+		}
+		for k, v := range row {
+			row[k] = math.Exp(v - lse)
+		}
+	}
+	return ll
+}
+
+// mStep stores the maximum likelihood estimates of the parameters given the
+// posterior probabilities of the components resp in p.
+func (f *fitter) mStep(p *params, resp *mat.Dense) error {
+	n, d := f.x.Dims()
+
+	// The effective weights of the components are kept positive so that
+	// components without observations have finite parameters.
+	nk := make([]float64, f.k)
+	for i := 0; i < n; i++ {
+		floats.AddScaled(nk, f.w[i], resp.RawRowView(i))
+	}
+	for k := range nk {
+		nk[k] += 10 * eps * f.sumW
+		p.weights[k] = nk[k] / f.sumW
+	}
+	p.means.Zero()
+	for i := 0; i < n; i++ {
+		xi := f.x.RawRowView(i)
+		for k := 0; k < f.k; k++ {
+			floats.AddScaled(p.means.RawRowView(k), f.w[i]*resp.At(i, k), xi)
+		}
+	}
+	for k := 0; k < f.k; k++ {
+		floats.Scale(1/nk[k], p.means.RawRowView(k))
+	}
+
+	diff := mat.NewDense(n, d, nil)
+	var tied *mat.SymDense
+	if f.typ == Tied {
+		tied = mat.NewSymDense(d, nil)
+	}
+	for k := 0; k < f.k; k++ {
+		mu := p.means.RawRowView(k)
+		for i := 0; i < n; i++ {
+			row := diff.RawRowView(i)
+			floats.SubTo(row, f.x.RawRowView(i), mu)
+			floats.Scale(math.Sqrt(f.w[i]*resp.At(i, k)), row)
+		}
+		cov := p.covs[k]
+		switch f.typ {
+		case Full:
+			cov.SymOuterK(1/nk[k], diff.T())
+		case Tied:
+			cov.SymOuterK(1, diff.T())
+			tied.AddSym(tied, cov)
+		case Diagonal, Spherical:
+			cov.Zero()
+			var sum float64
+			for j := 0; j < d; j++ {
+				col := mat.Col(nil, j, diff)
+				v := floats.Dot(col, col) / nk[k]
+				cov.SetSym(j, j, v)
+				sum += v
+			}
+			if f.typ == Spherical {
+				for j := 0; j < d; j++ {
+					cov.SetSym(j, j, sum/float64(d))
+				}
+			}
+		}
+	}
+	if f.typ == Tied {
+		tied.ScaleSym(1/f.sumW, tied)
+		for k := range p.covs {
+			p.covs[k].CopySym(tied)
+		}
+	}
+	for k, cov := range p.covs {
+		for j := 0; j < d; j++ {
+			cov.SetSym(j, j, cov.At(j, j)+f.reg)
+		}
+		if !p.chols[k].Factorize(cov) {
+			return ErrNotPositiveDefinite
+		}
+	}
+	return nil
+}
+
+// logJoint stores the log of the weighted densities of the components at
+// the rows of x in the corresponding rows of dst.
+func logJoint(dst, x *mat.Dense, weights []float64, means *mat.Dense, chols []mat.Cholesky) {
+	n, d := x.Dims()
+	diff := mat.NewDense(n, d, nil)
+	var (
+		u, uInv mat.TriDense
+		y       mat.Dense
+	)
+	for k, w := range weights {
+		mu := means.RawRowView(k)
+		for i := 0; i < n; i++ {
+			floats.SubTo(diff.RawRowView(i), x.RawRowView(i), mu)
+		}
+		// With Σ = UᵀU, the squared Mahalanobis distance of x is the
+		// squared norm of (x-μ)ᵀU⁻¹. The Cholesky factor is not singular,
+		// so the error of InverseTri only reports poor conditioning.
+		chols[k].UTo(&u)
+		_ = uInv.InverseTri(&u)
+		y.Mul(diff, &uInv)
+		c := math.Log(w) - 0.5*float64(d)*log2Pi - 0.5*chols[k].LogDet()
+		for i := 0; i < n; i++ {
+			r := y.RawRowView(i)
+			dst.Set(i, k, c-0.5*floats.Dot(r, r))
+		}
+	}
+}
@@ -0,0 +1,362 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gmm
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/distmv"
+)
+
+var (
+	trueWeights = []float64{0.5, 0.3, 0.2}
+	trueMeans   = [][]float64{{0, 0}, {8, 1}, {1, 9}}
+	trueCovs    = []*mat.SymDense{
+		mat.NewSymDense(2, []float64{1, 0.5, 0.5, 2}),
+		mat.NewSymDense(2, []float64{0.5, 0, 0, 0.5}),
+		mat.NewSymDense(2, []float64{2, -0.8, -0.8, 1}),
+	}
+)
+
+// samples returns n observations drawn from the mixture with the true
+// parameters.
+func samples(n int, src rand.Source) *mat.Dense {
+	components := make([]distmv.RandLogProber, len(trueWeights))
+	for k := range components {
+		nrm, ok := distmv.NewNormal(trueMeans[k], trueCovs[k], src)
+		if !ok {
+			panic("bad test: covariance is not positive definite")
+		}
+		components[k] = nrm
+	}
+	mix := distmv.NewMixture(components, trueWeights, src)
+	x := mat.NewDense(n, 2, nil)
+	for i := 0; i < n; i++ {
+		mix.Rand(x.RawRowView(i))
+	}
+	return x
+}
+
+// match returns the index of the fitted component whose mean is nearest to
+// each of the true means.
+func match(m *Model) []int {
+	idx := make([]int, len(trueMeans))
+	for k, mu := range trueMeans {
+		best := math.Inf(1)
+		for j, v := range m.Means {
+			if d := floats.Distance(mu, v, 2); d < best {
+				best = d
+				idx[k] = j
+			}
+		}
+	}
+	return idx
+}
+
+func TestFit(t *testing.T) {
+	t.Parallel()
+	x := samples(5000, rand.NewSource(1))
+	for _, typ := range []CovarianceType{Full, Diagonal, Tied, Spherical} {
+		g := GMM{
+			Components:     3,
+			Covariance:     typ,
+			Regularization: 1e-6,
+			Src:            rand.NewSource(1),
+		}
+		m, err := g.Fit(x, nil)
+		if err != nil {
+			t.Errorf("unexpected error for %v: %v", typ, err)
+			continue
+		}
+		idx := match(m)
+		for k, j := range idx {
+			if !scalar.EqualWithinAbs(m.Weights[j], trueWeights[k], 0.03) {
+				t.Errorf("weight mismatch for %v component %d: got %v, want %v", typ, k, m.Weights[j], trueWeights[k])
+			}
+			if !floats.EqualApprox(m.Means[j], trueMeans[k], 0.15) {
+				t.Errorf("mean mismatch for %v component %d: got %v, want %v", typ, k, m.Means[j], trueMeans[k])
+			}
+		}
+		if !scalar.EqualWithinAbs(floats.Sum(m.Weights), 1, 1e-12) {
+			t.Errorf("weights of %v do not sum to one: got %v", typ, floats.Sum(m.Weights))
+		}
+
+		for k, cov := range m.Covariances {
+			switch typ {
+			case Full:
+				j := idx[k]
+				if !mat.EqualApprox(m.Covariances[j], trueCovs[k], 0.2) {
+					t.Errorf("covariance mismatch for component %d: got %v, want %v", k, mat.Formatted(m.Covariances[j]), mat.Formatted(trueCovs[k]))
+				}
+			case Diagonal:
+				if cov.At(0, 1) != 0 {
+					t.Errorf("covariance of %v component %d is not diagonal", typ, k)
+				}
+			case Tied:
+				if !mat.Equal(cov, m.Covariances[0]) {
+					t.Errorf("covariances of %v components are not equal", typ)
+				}
+			case Spherical:
+				if cov.At(0, 1) != 0 || cov.At(0, 0) != cov.At(1, 1) {
+					t.Errorf("covariance of %v component %d is not spherical", typ, k)
+				}
+			}
+		}
+
+		// The log likelihood matches the densities of the model.
+		n, _ := x.Dims()
+		mix := m.Mixture(nil)
+		var ll float64
+		for i := 0; i < n; i++ {
+			xi := x.RawRowView(i)
+			lp := m.LogProb(xi)
+			if want := mix.LogProb(xi); !scalar.EqualWithinAbsOrRel(lp, want, 1e-10, 1e-10) {
+				t.Errorf("LogProb mismatch for %v: got %v, want %v", typ, lp, want)
+				break
+			}
+			ll += lp
+		}
+		if !scalar.EqualWithinAbsOrRel(m.LogLikelihood, ll, 1e-8, 1e-8) {
+			t.Errorf("log likelihood mismatch for %v: got %v, want %v", typ, m.LogLikelihood, ll)
+		}
+		p := float64(m.NumParameters())
+		if !scalar.EqualWithinAbsOrRel(m.AIC, 2*p-2*ll, 1e-8, 1e-8) {
+			t.Errorf("AIC mismatch for %v: got %v, want %v", typ, m.AIC, 2*p-2*ll)
+		}
+		if !scalar.EqualWithinAbsOrRel(m.BIC, p*math.Log(float64(n))-2*ll, 1e-8, 1e-8) {
+			t.Errorf("BIC mismatch for %v: got %v, want %v", typ, m.BIC, p*math.Log(float64(n))-2*ll)
+		}
+	}
+}
+
+func TestPredict(t *testing.T) {
+	t.Parallel()
+	x := samples(2000, rand.NewSource(2))
+	m, err := (&GMM{Components: 3, Src: rand.NewSource(1)}).Fit(x, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	idx := match(m)
+	for k, mu := range trueMeans {
+		if got := m.Predict(mu); got != idx[k] {
+			t.Errorf("Predict mismatch at the mean of component %d: got %v, want %v", k, got, idx[k])
+		}
+	}
+	prob := make([]float64, 3)
+	for _, v := range [][]float64{{0, 0}, {4, 0.5}, {3, 5}, {-2, 20}} {
+		m.PredictProb(prob, v)
+		if !scalar.EqualWithinAbs(floats.Sum(prob), 1, 1e-12) {
+			t.Errorf("posterior probabilities at %v do not sum to one: got %v", v, floats.Sum(prob))
+		}
+		if floats.MaxIdx(prob) != m.Predict(v) {
+			t.Errorf("Predict at %v is not the most probable component: got %v, probabilities %v", v, m.Predict(v), prob)
+		}
+
+		// Bayes' rule with the densities of the components.
+		for k := range prob {
+			want := m.Weights[k] * m.Normal(k, nil).Prob(v) / math.Exp(m.LogProb(v))
+			if !scalar.EqualWithinAbsOrRel(prob[k], want, 1e-10, 1e-10) {
+				t.Errorf("PredictProb mismatch at %v for component %d: got %v, want %v", v, k, prob[k], want)
+			}
+		}
+	}
+}
+
+func TestModelSelection(t *testing.T) {
+	t.Parallel()
+	x := samples(2000, rand.NewSource(3))
+	for _, c := range []Criterion{BIC, AIC} {
+		g := GMM{
+			Regularization:  1e-6,
+			MaxIterations:   500,
+			Initializations: 3,
+			Src:             rand.NewSource(1),
+		}
+		m, err := g.Select(x, nil, 1, 5, c)
+		if err != nil {
+			t.Fatalf("unexpected error for %v: %v", c, err)
+		}
+		if len(m.Weights) != 3 {
+			t.Errorf("unexpected number of components selected by %v: got %d, want 3", c, len(m.Weights))
+		}
+
+		// The selected model has the smallest criterion of the models
+		// fitted with each number of components.
+		for k := 1; k <= 5; k++ {
+			g.Components = k
+			g.Src = rand.NewSource(1)
+			other, err := g.Fit(x, nil)
+			if err != nil {
+				t.Fatalf("unexpected error for %d components: %v", k, err)
+			}
+			if c.value(other) < c.value(m)-1e-6*math.Abs(c.value(m)) {
+				t.Errorf("%v of %d components less than that of the selected model: %v < %v", c, k, c.value(other), c.value(m))
+			}
+		}
+	}
+
+	g := GMM{}
+	for _, test := range []struct {
+		name     string
+		min, max int
+		c        Criterion
+	}{
+		{name: "non-positive minimum", min: 0, max: 2, c: BIC},
+		{name: "maximum less than minimum", min: 3, max: 2, c: BIC},
+		{name: "invalid criterion", min: 1, max: 2, c: Criterion(-1)},
+		{name: "too many components", min: 1, max: 3000, c: AIC},
+	} {
+		if !panics(func() { g.Select(x, nil, test.min, test.max, test.c) }) {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
+
+func TestFitWeights(t *testing.T) {
+	t.Parallel()
+	// Integer weights are equivalent to repeated observations.
+	src := rand.New(rand.NewSource(4))
+	x := samples(500, src)
+	n, d := x.Dims()
+	weights := make([]float64, n)
+	var rows [][]float64
+	for i := range weights {
+		weights[i] = float64(src.Intn(4))
+		for j := 0; j < int(weights[i]); j++ {
+			rows = append(rows, x.RawRowView(i))
+		}
+	}
+	repeated := mat.NewDense(len(rows), d, nil)
+	for i, r := range rows {
+		repeated.SetRow(i, r)
+	}
+
+	for _, typ := range []CovarianceType{Full, Diagonal, Tied, Spherical} {
+		g := GMM{Components: 3, Covariance: typ, Tolerance: 1e-12, MaxIterations: 1000, Src: rand.NewSource(1)}
+		mw, err := g.Fit(x, weights)
+		if err != nil {
+			t.Fatalf("unexpected error for weighted %v: %v", typ, err)
+		}
+		g.Src = rand.NewSource(1)
+		mr, err := g.Fit(repeated, nil)
+		if err != nil {
+			t.Fatalf("unexpected error for repeated %v: %v", typ, err)
+		}
+		if !scalar.EqualWithinAbsOrRel(mw.LogLikelihood, mr.LogLikelihood, 1e-8, 1e-8) {
+			t.Errorf("log likelihood mismatch for %v: weighted %v, repeated %v", typ, mw.LogLikelihood, mr.LogLikelihood)
+		}
+		if !scalar.EqualWithinAbsOrRel(mw.BIC, mr.BIC, 1e-8, 1e-8) {
+			t.Errorf("BIC mismatch for %v: weighted %v, repeated %v", typ, mw.BIC, mr.BIC)
+		}
+		for k, j := range match(mr) {
+			i := match(mw)[k]
+			if !floats.EqualApprox(mw.Means[i], mr.Means[j], 1e-6) {
+				t.Errorf("mean mismatch for %v: weighted %v, repeated %v", typ, mw.Means[i], mr.Means[j])
+			}
+			if !mat.EqualApprox(mw.Covariances[i], mr.Covariances[j], 1e-6) {
+				t.Errorf("covariance mismatch for %v: weighted %v, repeated %v", typ, mat.Formatted(mw.Covariances[i]), mat.Formatted(mr.Covariances[j]))
+			}
+		}
+	}
+}
+
+func TestRegularization(t *testing.T) {
+	t.Parallel()
+	// Two distinct observations and two components give covariance
+	// matrices of zero without regularization.
+	x := mat.NewDense(6, 2, []float64{
+		0, 0,
+		0, 0,
+		0, 0,
+		1, 2,
+		1, 2,
+		1, 2,
+	})
+	g := GMM{Components: 2, Src: rand.NewSource(1)}
+	_, err := g.Fit(x, nil)
+	if err != ErrNotPositiveDefinite {
+		t.Errorf("unexpected error without regularization: got %v, want %v", err, ErrNotPositiveDefinite)
+	}
+	g.Regularization = 1e-3
+	m, err := g.Fit(x, nil)
+	if err != nil {
+		t.Fatalf("unexpected error with regularization: %v", err)
+	}
+	for k, cov := range m.Covariances {
+		want := mat.NewSymDense(2, []float64{1e-3, 0, 0, 1e-3})
+		if !mat.EqualApprox(cov, want, 1e-12) {
+			t.Errorf("covariance mismatch for component %d: got %v, want %v", k, mat.Formatted(cov), mat.Formatted(want))
+		}
+		if !scalar.EqualWithinAbs(m.Weights[k], 0.5, 1e-12) {
+			t.Errorf("weight mismatch for component %d: got %v, want 0.5", k, m.Weights[k])
+		}
+	}
+}
+
+func TestNumParameters(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		typ  CovarianceType
+		k, d int
+		want int
+	}{
+		{Full, 3, 2, 2 + 6 + 9},
+		{Diagonal, 3, 2, 2 + 6 + 6},
+		{Tied, 3, 2, 2 + 6 + 3},
+		{Spherical, 3, 2, 2 + 6 + 3},
+		{Full, 1, 4, 0 + 4 + 10},
+		{Spherical, 4, 5, 3 + 20 + 4},
+	} {
+		m := &Model{
+			Weights:    make([]float64, test.k),
+			Means:      make([][]float64, test.k),
+			Covariance: test.typ,
+		}
+		for k := range m.Means {
+			m.Means[k] = make([]float64, test.d)
+		}
+		if got := m.NumParameters(); got != test.want {
+			t.Errorf("NumParameters mismatch for %v with %d components of dimension %d: got %d, want %d", test.typ, test.k, test.d, got, test.want)
+		}
+	}
+}
+
+func TestFitPanics(t *testing.T) {
+	t.Parallel()
+	x := mat.NewDense(3, 2, []float64{0, 0, 1, 1, 2, 2})
+	for _, test := range []struct {
+		name    string
+		g       GMM
+		weights []float64
+	}{
+		{"zero components", GMM{}, nil},
+		{"too many components", GMM{Components: 4}, nil},
+		{"too few positive weights", GMM{Components: 2}, []float64{1, 0, 0}},
+		{"weight length", GMM{Components: 1}, []float64{1, 1}},
+		{"negative weight", GMM{Components: 1}, []float64{1, -1, 1}},
+		{"negative regularization", GMM{Components: 1, Regularization: -1}, nil},
+		{"covariance type", GMM{Components: 1, Covariance: 4}, nil},
+	} {
+		if !panics(func() { test.g.Fit(x, test.weights) }) {
+			t.Errorf("Fit did not panic for %s", test.name)
+		}
+	}
+}
+
+func panics(fn func()) (b bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			b = true
+		}
+	}()
+	fn()
+	return
+}
@@ -0,0 +1,126 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gmm
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// kmeans clusters the observations by weighted k-means with k-means++
+// seeding and stores the assignments of the observations to the clusters
+// in resp as indicator rows.
+//
+// See Arthur, D., Vassilvitskii, S.: k-means++: The Advantages of Careful
+// Seeding. SODA (2007).
+func (f *fitter) kmeans(resp *mat.Dense, uniform func() float64) {
+	const maxIter = 100
+
+	n, d := f.x.Dims()
+	centers := mat.NewDense(f.k, d, nil)
+
+	// The first center is drawn with probability proportional to the
+	// weights, and the subsequent centers with probability proportional to
+	// the weighted squared distance to the nearest chosen center.
+	dist := make([]float64, n)
+	p := make([]float64, n)
+	copy(p, f.w)
+	for i := range dist {
+		dist[i] = math.Inf(1)
+	}
+	for k := 0; k < f.k; k++ {
+		sum := floats.Sum(p)
+		if sum == 0 {
+			// All of the observations coincide with the chosen centers.
+			copy(p, f.w)
+			sum = f.sumW
+		}
+		c := centers.RawRowView(k)
+		copy(c, f.x.RawRowView(sample(p, sum, uniform)))
+		for i := range dist {
+			dist[i] = math.Min(dist[i], sqDist(f.x.RawRowView(i), c))
+			p[i] = f.w[i] * dist[i]
+		}
+	}
+
+	// Lloyd's algorithm.
+	assign := make([]int, n)
+	for i := range assign {
+		assign[i] = -1
+	}
+	clusterW := make([]float64, f.k)
+	for iter := 0; iter < maxIter; iter++ {
+		changed := false
+		for i := range assign {
+			xi := f.x.RawRowView(i)
+			best, bestDist := 0, math.Inf(1)
+			for k := 0; k < f.k; k++ {
+				if dk := sqDist(xi, centers.RawRowView(k)); dk < bestDist {
+					best, bestDist = k, dk
+				}
+			}
+			if assign[i] != best {
+				assign[i] = best
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+		for k := range clusterW {
+			clusterW[k] = 0
+		}
+		for i, k := range assign {
+			clusterW[k] += f.w[i]
+		}
+		for k, cw := range clusterW {
+			if cw > 0 {
+				// Clusters without weight keep their centers.
+				for j := 0; j < d; j++ {
+					centers.Set(k, j, 0)
+				}
+			}
+		}
+		for i, k := range assign {
+			if clusterW[k] > 0 {
+				floats.AddScaled(centers.RawRowView(k), f.w[i]/clusterW[k], f.x.RawRowView(i))
+			}
+		}
+	}
+
+	resp.Zero()
+	for i, k := range assign {
+		resp.Set(i, k, 1)
+	}
+}
+
+// sample returns an index drawn with probability proportional to p, whose
+// elements sum to sum.
+func sample(p []float64, sum float64, uniform func() float64) int {
+	u := uniform() * sum
+	last := 0
+	for i, v := range p {
+		if v <= 0 {
+			continue
+		}
+		last = i
+		u -= v
+		if u < 0 {
+			break
+		}
+	}
+	return last
+}
+
+// sqDist returns the squared Euclidean distance between x and y.
+func sqDist(x, y []float64) float64 {
+	var d float64
+	for i, v := range x {
+		d += (v - y[i]) * (v - y[i])
+	}
+	return d
+}
@@ -0,0 +1,104 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gmm
+
+import "gonum.org/v1/gonum/mat"
+
+// Criterion is an information criterion by which models with different
+// numbers of components are compared.
+type Criterion int
+
+const (
+	// BIC is the Bayesian information criterion, which penalizes the
+	// number of parameters by the logarithm of the sum of the weights.
+	BIC Criterion = iota
+	// AIC is the Akaike information criterion, which penalizes the number
+	// of parameters less than BIC and tends to select more components.
+	AIC
+)
+
+// String returns the name of the criterion.
+func (c Criterion) String() string {
+	switch c {
+	case BIC:
+		return "BIC"
+	case AIC:
+		return "AIC"
+	default:
+		return "Criterion(invalid)"
+	}
+}
+
+// value returns the value of the criterion for the model m.
+func (c Criterion) value(m *Model) float64 {
+	if c == AIC {
+		return m.AIC
+	}
+	return m.BIC
+}
+
+// Select fits models with each number of components from minComponents to
+// maxComponents inclusive to the observations, which are the rows of x,
+// with the given weights, and returns the model with the smallest value of
+// the criterion c. All the other fields of g are used for each fit and
+// g.Components is ignored.
+//
+// A number of components for which Fit returns ErrNotPositiveDefinite is
+// skipped, and Select returns ErrNotPositiveDefinite if this happens for
+// all of them. If the selected model did not converge, Select returns it
+// and ErrNotConverged.
+//
+// Select panics if minComponents is not positive, if maxComponents is less
+// than minComponents, if there are fewer observations with positive weight
+// than maxComponents, if the criterion is not valid, or under the other
+// conditions that Fit panics.
+func (g *GMM) Select(x mat.Matrix, weights []float64, minComponents, maxComponents int, c Criterion) (*Model, error) {
+	if minComponents <= 0 {
+		panic("gmm: non-positive number of components")
+	}
+	if maxComponents < minComponents {
+		panic("gmm: maximum number of components less than minimum")
+	}
+	if c != BIC && c != AIC {
+		panic("gmm: invalid criterion")
+	}
+	// Check the number of observations before fitting the smaller models.
+	positive, _ := x.Dims()
+	if weights != nil {
+		if len(weights) != positive {
+			panic(badLength)
+		}
+		positive = 0
+		for _, w := range weights {
+			if w > 0 {
+				positive++
+			}
+		}
+	}
+	if positive < maxComponents {
+		panic("gmm: fewer observations than components")
+	}
+	fit := *g
+	var (
+		best    *Model
+		bestErr error
+		err     error
+	)
+	for k := minComponents; k <= maxComponents; k++ {
+		fit.Components = k
+		var m *Model
+		m, err = fit.Fit(x, weights)
+		if m == nil {
+			continue
+		}
+		if best == nil || c.value(m) < c.value(best) {
+			best, bestErr = m, err
+		}
+	}
+	if best == nil {
+		return nil, err
+	}
+	return best, bestErr
+}
@@ -0,0 +1,328 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gp
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+// Classifier is a binary Gaussian process classifier. The probability of
+// the class true at x is σ(f(x)), where σ is the logistic function and f is
+// a latent process with zero mean and covariance given by Kernel.
+//
+// The posterior of the latent process is approximated by a normal
+// distribution with the Laplace approximation, and the predicted
+// probabilities average the logistic function over the approximate
+// posterior with the probit approximation of MacKay.
+//
+// See Rasmussen, C. E., Williams, C. K. I.: Gaussian Processes for Machine
+// Learning. MIT Press (2006), chapter 3.4 for the algorithm.
+type Classifier struct {
+	// Kernel is the covariance function of the latent process.
+	Kernel Kernel
+
+	// MaxIterations is the maximum number of Newton iterations used to
+	// find the mode of the posterior of the latent process. If
+	// MaxIterations is zero, it is defaulted to 100.
+	MaxIterations int
+
+	rows [][]float64
+	t    []float64
+
+	// f is the mode of the posterior of the latent values, a its
+	// representation f = K a, dlp the gradient of the log likelihood at f,
+	// and sw the square root of the negative Hessian of the log
+	// likelihood. chol is the Cholesky factorization of
+	// B = I + sw K sw.
+	k      *mat.SymDense
+	f, a   []float64
+	dlp    []float64
+	sw     []float64
+	chol   mat.Cholesky
+	lml    float64
+	fitted bool
+}
+
+// Fit conditions the model on the classes y of the inputs given by the rows
+// of x. Fit returns an error if the mode of the posterior cannot be found.
+//
+// Fit panics if the length of y is not the number of rows of x, or if x has
+// no rows.
+func (c *Classifier) Fit(x mat.Matrix, y []bool) error {
+	c.setData(x, y)
+	return c.condition()
+}
+
+// FitParameters fits the parameters of Kernel to the classes y of the
+// inputs given by the rows of x by maximizing the approximate log marginal
+// likelihood, starting from the current values. It then conditions the
+// model on the classes as Fit. The maximization uses the given method and
+// settings of optimize.Minimize, where a nil method or settings choose the
+// defaults of Minimize, except that a zero GradientThreshold is defaulted
+// to 1e-8. The evaluations are not concurrent and settings.Concurrent is
+// ignored.
+//
+// The parameters are set to the best values found, and the model
+// conditioned on them, also when the maximization returns an error. Such an
+// error is returned.
+func (c *Classifier) FitParameters(x mat.Matrix, y []bool, settings *optimize.Settings, method optimize.Method) error {
+	c.setData(x, y)
+	best, err := maximize(c.Kernel.Parameters(nil), func(p []float64) float64 {
+		c.Kernel.SetParameters(p)
+		if c.condition() != nil {
+			return math.Inf(-1)
+		}
+		return c.lml
+	}, c.gradient, settings, method)
+	if best == nil {
+		return err
+	}
+	c.Kernel.SetParameters(best)
+	cerr := c.condition()
+	if err != nil {
+		return err
+	}
+	return cerr
+}
+
+// LogMarginalLikelihood returns the Laplace approximation of the log
+// marginal likelihood of the classes the model is conditioned on.
+func (c *Classifier) LogMarginalLikelihood() float64 {
+	c.checkFitted()
+	return c.lml
+}
+
+// Predict returns the probability that the class at x is true.
+func (c *Classifier) Predict(x []float64) float64 {
+	mean, variance := c.PredictLatent(x)
+	return sigmoid(mean / math.Sqrt(1+math.Pi*variance/8))
+}
+
+// PredictLatent returns the mean and variance of the approximate posterior
+// of the latent process at x.
+func (c *Classifier) PredictLatent(x []float64) (mean, variance float64) {
+	c.checkFitted()
+	n := len(c.rows)
+	k := make([]float64, n)
+	v := mat.NewVecDense(n, nil)
+	for i, row := range c.rows {
+		k[i] = c.Kernel.Cov(x, row)
+		mean += k[i] * c.dlp[i]
+		v.SetVec(i, c.sw[i]*k[i])
+	}
+	var s mat.VecDense
+	err := c.chol.SolveVecTo(&s, v)
+	if err != nil && !isConditionError(err) {
+		panic(err)
+	}
+	variance = c.Kernel.Cov(x, x) - mat.Dot(v, &s)
+	return mean, math.Max(variance, 0)
+}
+
+func (c *Classifier) checkFitted() {
+	if !c.fitted {
+		panic("gp: model not fitted")
+	}
+}
+
+func (c *Classifier) setData(x mat.Matrix, y []bool) {
+	n, _ := x.Dims()
+	if n == 0 {
+		panic("gp: no observations")
+	}
+	if len(y) != n {
+		panic(badLength)
+	}
+	c.rows = rowsOf(x)
+	c.t = make([]float64, n)
+	for i, v := range y {
+		if v {
+			c.t[i] = 1
+		}
+	}
+}
+
+// condition finds the mode of the posterior of the latent values and the
+// Laplace approximation at the mode.
+func (c *Classifier) condition() error {
+	c.fitted = false
+	n := len(c.rows)
+	c.k = covariance(c.Kernel, c.rows)
+	c.f = make([]float64, n)
+	c.a = make([]float64, n)
+	c.dlp = make([]float64, n)
+	c.sw = make([]float64, n)
+
+	maxIter := c.MaxIterations
+	if maxIter == 0 {
+		maxIter = 100
+	}
+	psi := c.objective(c.a, c.f)
+	var (
+		b     = mat.NewVecDense(n, nil)
+		kb    mat.VecDense
+		z     mat.VecDense
+		aNew  = make([]float64, n)
+		aTry  = make([]float64, n)
+		fTry  = mat.NewVecDense(n, nil)
+		fTryS = fTry.RawVector().Data
+	)
+	for iter := 0; iter < maxIter; iter++ {
+		// Newton step for the mode, following Algorithm 3.1 of Rasmussen
+		// and Williams.
+		if err := c.factorize(); err != nil {
+			return err
+		}
+		for i, f := range c.f {
+			b.SetVec(i, c.sw[i]*c.sw[i]*f+c.dlp[i])
+		}
+		kb.MulVec(c.k, b)
+		for i := range c.sw {
+			kb.SetVec(i, c.sw[i]*kb.AtVec(i))
+		}
+		err := c.chol.SolveVecTo(&z, &kb)
+		if err != nil && !isConditionError(err) {
+			return ErrNotPositiveDefinite
+		}
+		for i := range aNew {
+			aNew[i] = b.AtVec(i) - c.sw[i]*z.AtVec(i)
+		}
+
+		// The step is halved while it does not increase the objective.
+		step := 1.0
+		var psiTry float64
+		for halvings := 0; ; halvings++ {
+			for i, v := range aNew {
+				aTry[i] = c.a[i] + step*(v-c.a[i])
+			}
+			fTry.MulVec(c.k, mat.NewVecDense(n, aTry))
+			psiTry = c.objective(aTry, fTryS)
+			if psiTry >= psi || halvings == 20 {
+				break
+			}
+			step /= 2
+		}
+		if psiTry < psi {
+			break
+		}
+		copy(c.a, aTry)
+		copy(c.f, fTryS)
+		converged := psiTry-psi <= 1e-10*(1+math.Abs(psi))
+		psi = psiTry
+		if converged {
+			break
+		}
+	}
+	if err := c.factorize(); err != nil {
+		return err
+	}
+	c.lml = psi - 0.5*c.chol.LogDet()
+	c.fitted = true
+	return nil
+}
+
+// gradient stores in grad the gradient of the approximate log marginal
+// likelihood with respect to the logarithms of the parameters, using the
+// Laplace approximation computed by condition.
+func (c *Classifier) gradient(grad []float64) error {
+	c.checkFitted()
+	n := len(c.rows)
+	_, grads := covarianceGrads(c.Kernel, c.rows)
+
+	// The gradient of the approximate log marginal likelihood follows
+	// Algorithm 5.1 of Rasmussen and Williams, with R = sw B^-1 sw.
+	var r mat.SymDense
+	err := c.chol.InverseTo(&r)
+	if err != nil && !isConditionError(err) {
+		return ErrNotPositiveDefinite
+	}
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			r.SetSym(i, j, c.sw[i]*r.At(i, j)*c.sw[j])
+		}
+	}
+	var kr mat.Dense
+	kr.Mul(c.k, &r)
+	// s2 is the derivative of the log determinant term with respect to
+	// the mode, 1/2 diag((K^-1 + W)^-1) ∂³ log p(y|f), where
+	// ∂³ log p(y|f) = -π(1-π)(1-2π).
+	s2 := make([]float64, n)
+	for i := range s2 {
+		var krk float64
+		for j := 0; j < n; j++ {
+			krk += kr.At(i, j) * c.k.At(j, i)
+		}
+		p := sigmoid(c.f[i])
+		s2[i] = -0.5 * (c.k.At(i, i) - krk) * p * (1 - p) * (1 - 2*p)
+	}
+	// The explicit term of the derivative is 1/2 tr((aa^T - R) ∂K/∂θ).
+	aa := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			aa.SetSym(i, j, 0.5*(c.a[i]*c.a[j]-r.At(i, j)))
+		}
+	}
+	dlp := mat.NewVecDense(n, c.dlp)
+	var cb, s3 mat.VecDense
+	for j, g := range grads {
+		s1 := traceProduct(aa, g)
+		cb.MulVec(g, dlp)
+		s3.MulVec(&kr, &cb)
+		s3.SubVec(&cb, &s3)
+		grad[j] = s1 + mat.Dot(mat.NewVecDense(n, s2), &s3)
+	}
+	return nil
+}
+
+// factorize computes the gradient and the negative Hessian of the log
+// likelihood at the current latent values, and the Cholesky factorization
+// of B.
+func (c *Classifier) factorize() error {
+	n := len(c.f)
+	for i, f := range c.f {
+		p := sigmoid(f)
+		c.dlp[i] = c.t[i] - p
+		c.sw[i] = math.Sqrt(p * (1 - p))
+	}
+	b := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			b.SetSym(i, j, c.sw[i]*c.k.At(i, j)*c.sw[j])
+		}
+		b.SetSym(i, i, b.At(i, i)+1)
+	}
+	if !c.chol.Factorize(b) {
+		return ErrNotPositiveDefinite
+	}
+	return nil
+}
+
+// objective returns the objective of the mode search,
+//
+//	-1/2 a^T f + log p(y|f).
+func (c *Classifier) objective(a, f []float64) float64 {
+	var psi float64
+	for i, v := range f {
+		// log σ(±f) for the classes true and false.
+		if c.t[i] == 0 {
+			v = -v
+		}
+		psi += -0.5*a[i]*f[i] - softplus(-v)
+	}
+	return psi
+}
+
+// sigmoid returns the logistic function 1/(1+exp(-x)).
+func sigmoid(x float64) float64 {
+	return 1 / (1 + math.Exp(-x))
+}
+
+// softplus returns log(1+exp(x)).
+func softplus(x float64) float64 {
+	return math.Max(x, 0) + math.Log1p(math.Exp(-math.Abs(x)))
+}
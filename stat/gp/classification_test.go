@@ -0,0 +1,146 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gp
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// classData returns n inputs on [-3, 3]² with classes drawn with the
+// probability σ(2 x_0 x_1).
+func classData(n int, src rand.Source) (*mat.Dense, []bool) {
+	rnd := rand.New(src)
+	x := mat.NewDense(n, 2, nil)
+	y := make([]bool, n)
+	for i := range y {
+		x0, x1 := 6*rnd.Float64()-3, 6*rnd.Float64()-3
+		x.Set(i, 0, x0)
+		x.Set(i, 1, x1)
+		y[i] = rnd.Float64() < sigmoid(2*x0*x1)
+	}
+	return x, y
+}
+
+func TestClassifierMode(t *testing.T) {
+	t.Parallel()
+	x, y := classData(30, rand.NewSource(1))
+	c := Classifier{Kernel: &RBF{LengthScale: 1.5, Variance: 4}}
+	if err := c.Fit(x, y); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// At the mode, f = K ∇log p(y|f).
+	var kd mat.VecDense
+	kd.MulVec(c.k, mat.NewVecDense(len(c.dlp), c.dlp))
+	if !floats.EqualApprox(kd.RawVector().Data, c.f, 1e-8) {
+		t.Errorf("latent values not at the mode: got %v, want %v", c.f, kd.RawVector().Data)
+	}
+
+	// The predicted latent mean at an input is the mode there.
+	for i := 0; i < 30; i++ {
+		mean, _ := c.PredictLatent(x.RawRowView(i))
+		if math.Abs(mean-c.f[i]) > 1e-8 {
+			t.Errorf("unexpected latent mean at input %d: got %v, want %v", i, mean, c.f[i])
+		}
+	}
+
+	// Far from the inputs the prior is recovered.
+	if m, v := c.PredictLatent([]float64{100, 100}); math.Abs(m) > 1e-12 || math.Abs(v-4) > 1e-12 {
+		t.Errorf("unexpected latent prediction far from inputs: got (%v, %v), want (0, 4)", m, v)
+	}
+	if p := c.Predict([]float64{100, 100}); math.Abs(p-0.5) > 1e-12 {
+		t.Errorf("unexpected probability far from inputs: got %v, want 0.5", p)
+	}
+}
+
+func TestClassifierGrad(t *testing.T) {
+	t.Parallel()
+	x, y := classData(25, rand.NewSource(2))
+	for k, kernel := range []Kernel{
+		&RBF{LengthScale: 1, Variance: 2},
+		Product{&Constant{Variance: 3}, &Matern{Nu: 2.5, LengthScale: 2, Variance: 1}},
+	} {
+		c := Classifier{Kernel: kernel}
+		c.setData(x, y)
+		p := kernel.Parameters(nil)
+		lml := func(p []float64) float64 {
+			kernel.SetParameters(p)
+			if err := c.condition(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			return c.lml
+		}
+		want := fd.Gradient(nil, lml, p, &fd.Settings{Formula: fd.Central, Step: 1e-5})
+		got := make([]float64, len(p))
+		kernel.SetParameters(p)
+		if err := c.condition(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := c.gradient(got); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !floats.EqualApprox(got, want, 1e-5) {
+			t.Errorf("kernel %d: unexpected gradient: got %v, want %v", k, got, want)
+		}
+	}
+}
+
+func TestClassifierFitParameters(t *testing.T) {
+	t.Parallel()
+	x, y := classData(80, rand.NewSource(1))
+	c := Classifier{Kernel: &RBF{LengthScale: 0.3, Variance: 1}}
+	if err := c.Fit(x, y); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	initial := c.LogMarginalLikelihood()
+	if err := c.FitParameters(x, y, nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if lml := c.LogMarginalLikelihood(); lml <= initial {
+		t.Errorf("log marginal likelihood not increased: got %v, initial %v", lml, initial)
+	}
+
+	// The classes are predicted well away from the boundary x_0 x_1 = 0.
+	for _, test := range []struct {
+		x    []float64
+		want bool
+	}{
+		{[]float64{2, 2}, true},
+		{[]float64{-2, -1.5}, true},
+		{[]float64{2, -2}, false},
+		{[]float64{-1.5, 2}, false},
+	} {
+		p := c.Predict(test.x)
+		if (p > 0.5) != test.want {
+			t.Errorf("unexpected probability at %v: got %v", test.x, p)
+		}
+	}
+}
+
+func TestClassifierPanics(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		f    func()
+	}{
+		{"not fitted", func() { (&Classifier{Kernel: &RBF{LengthScale: 1, Variance: 1}}).Predict([]float64{0}) }},
+		{"length mismatch", func() {
+			(&Classifier{Kernel: &RBF{LengthScale: 1, Variance: 1}}).Fit(mat.NewDense(2, 1, nil), []bool{true})
+		}},
+		{"no observations", func() {
+			(&Classifier{Kernel: &RBF{LengthScale: 1, Variance: 1}}).Fit(&mat.Dense{}, nil)
+		}},
+	} {
+		if !panics(test.f) {
+			t.Errorf("%s: expected panic", test.name)
+		}
+	}
+}
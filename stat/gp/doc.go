@@ -0,0 +1,18 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package gp provides Gaussian process regression and classification.
+//
+// A Gaussian process is a distribution over functions whose values at any
+// finite set of points are jointly normally distributed. The process is
+// specified by a Kernel, the covariance between the values of the function
+// at two points. Kernels may be composed by the Sum and Product kernels.
+//
+// The parameters of the kernels may be fitted to the data by maximizing the
+// marginal likelihood of the observations with the methods of the optimize
+// package.
+//
+// See Rasmussen, C. E., Williams, C. K. I.: Gaussian Processes for Machine
+// Learning. MIT Press (2006) for an introduction.
+package gp // import "gonum.org/v1/gonum/stat/gp"
@@ -0,0 +1,95 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gp
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+// ErrNotPositiveDefinite is returned when a covariance matrix of a model is
+// not positive definite.
+var ErrNotPositiveDefinite = errors.New("gp: covariance matrix not positive definite")
+
+// maximize maximizes the log marginal likelihood of a model, a function of
+// the logarithms of its parameters, from p using method, and returns the
+// best parameters found. condition conditions the model on the parameters p
+// and returns the log marginal likelihood, or -Inf if it cannot be
+// evaluated. gradient stores the gradient of the log marginal likelihood at
+// the parameters the model is conditioned on in grad. The model is
+// conditioned once for each point at which the objective and its gradient
+// are evaluated.
+func maximize(p []float64, condition func(p []float64) float64, gradient func(grad []float64) error, settings *optimize.Settings, method optimize.Method) ([]float64, error) {
+	var s optimize.Settings
+	if settings != nil {
+		s = *settings
+	}
+	// The evaluations share the state of the model.
+	s.Concurrent = 0
+	// The log marginal likelihood is rarely accurate enough for the
+	// default threshold of Minimize, and the line searches fail close to
+	// the maximum.
+	if s.GradientThreshold == 0 {
+		s.GradientThreshold = 1e-8
+	}
+	var (
+		conditioned bool
+		last        = make([]float64, len(p))
+		lml         float64
+	)
+	eval := func(p []float64) float64 {
+		if !conditioned || !floats.Equal(p, last) {
+			lml = condition(p)
+			copy(last, p)
+			conditioned = true
+		}
+		return lml
+	}
+	problem := optimize.Problem{
+		Func: func(p []float64) float64 {
+			return -eval(p)
+		},
+		Grad: func(grad, p []float64) {
+			if math.IsInf(eval(p), -1) || gradient(grad) != nil {
+				for i := range grad {
+					grad[i] = 0
+				}
+				return
+			}
+			for i, v := range grad {
+				grad[i] = -v
+			}
+		},
+	}
+	result, err := optimize.Minimize(problem, p, &s, method)
+	if result == nil {
+		return nil, err
+	}
+	return result.X, err
+}
+
+// isConditionError returns whether err only reports an ill-conditioned
+// matrix, in which case the result of the operation is usable.
+func isConditionError(err error) bool {
+	_, ok := err.(mat.Condition)
+	return ok
+}
+
+// traceProduct returns the trace of the product of the symmetric matrices a
+// and b.
+func traceProduct(a, b mat.Symmetric) float64 {
+	n := a.SymmetricDim()
+	var t float64
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			t += a.At(i, j) * b.At(i, j)
+		}
+	}
+	return t
+}
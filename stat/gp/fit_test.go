@@ -0,0 +1,44 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gp
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/optimize"
+)
+
+func TestMaximizeConditionsOnce(t *testing.T) {
+	t.Parallel()
+	// The model is a concave quadratic whose state is the point it was
+	// last conditioned on.
+	var (
+		state   []float64
+		repeats int
+	)
+	condition := func(p []float64) float64 {
+		if state != nil && floats.Equal(p, state) {
+			repeats++
+		}
+		state = append(state[:0], p...)
+		return -(p[0]-1)*(p[0]-1) - 2*(p[1]+0.5)*(p[1]+0.5)
+	}
+	gradient := func(grad []float64) error {
+		grad[0] = -2 * (state[0] - 1)
+		grad[1] = -4 * (state[1] + 0.5)
+		return nil
+	}
+	best, err := maximize([]float64{3, 2}, condition, gradient, nil, &optimize.BFGS{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repeats != 0 {
+		t.Errorf("model conditioned %d times on the parameters it was already conditioned on", repeats)
+	}
+	if want := []float64{1, -0.5}; !floats.EqualApprox(best, want, 1e-6) {
+		t.Errorf("unexpected maximum: got %v, want %v", best, want)
+	}
+}
@@ -0,0 +1,492 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gp
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// Kernel is the covariance function of a Gaussian process. The covariance
+// must be symmetric in its arguments, and the matrix of the covariances
+// between the points of any finite set must be positive semi-definite.
+//
+// The parameters of a kernel are handled as their logarithms, so that they
+// are unconstrained when they are fitted. The methods of a Kernel are not
+// safe for concurrent use when SetParameters is called.
+type Kernel interface {
+	// Cov returns the covariance between the values of the process at x
+	// and at y.
+	Cov(x, y []float64) float64
+
+	// CovGrad stores the derivatives of Cov(x, y) with respect to the
+	// logarithms of the parameters of the kernel in dst and returns
+	// Cov(x, y). The length of dst must be NumParameters.
+	CovGrad(dst, x, y []float64) float64
+
+	// NumParameters returns the number of parameters of the kernel.
+	NumParameters() int
+
+	// Parameters stores the logarithms of the parameters of the kernel in
+	// dst and returns it. If dst is nil, a new slice is allocated. If dst
+	// is not nil, its length must be NumParameters.
+	Parameters(dst []float64) []float64
+
+	// SetParameters sets the parameters of the kernel from their
+	// logarithms in p. The length of p must be NumParameters.
+	SetParameters(p []float64)
+}
+
+// CovarianceMatrix stores the covariances given by kernel between the rows
+// of x in dst. If dst is empty, it is resized to the number of rows of x,
+// otherwise CovarianceMatrix panics if the sizes do not match.
+func CovarianceMatrix(dst *mat.SymDense, kernel Kernel, x mat.Matrix) {
+	n, _ := x.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAsSym(n)
+	} else if dst.SymmetricDim() != n {
+		panic(mat.ErrShape)
+	}
+	dst.CopySym(covariance(kernel, rowsOf(x)))
+}
+
+// covariance returns the covariance matrix of kernel between rows.
+func covariance(kernel Kernel, rows [][]float64) *mat.SymDense {
+	n := len(rows)
+	k := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			k.SetSym(i, j, kernel.Cov(rows[i], rows[j]))
+		}
+	}
+	return k
+}
+
+// covarianceGrads returns the covariance matrix of kernel between rows, and
+// its derivatives with respect to the logarithms of the parameters of
+// kernel.
+func covarianceGrads(kernel Kernel, rows [][]float64) (*mat.SymDense, []*mat.SymDense) {
+	n := len(rows)
+	k := mat.NewSymDense(n, nil)
+	grads := make([]*mat.SymDense, kernel.NumParameters())
+	for i := range grads {
+		grads[i] = mat.NewSymDense(n, nil)
+	}
+	g := make([]float64, len(grads))
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			k.SetSym(i, j, kernel.CovGrad(g, rows[i], rows[j]))
+			for p, v := range g {
+				grads[p].SetSym(i, j, v)
+			}
+		}
+	}
+	return k, grads
+}
+
+// rowsOf returns the rows of x as slices.
+func rowsOf(x mat.Matrix) [][]float64 {
+	r, _ := x.Dims()
+	rows := make([][]float64, r)
+	for i := range rows {
+		rows[i] = mat.Row(nil, i, x)
+	}
+	return rows
+}
+
+// sqDist returns the squared Euclidean distance between x and y.
+func sqDist(x, y []float64) float64 {
+	if len(x) != len(y) {
+		panic(badLength)
+	}
+	var d float64
+	for i, v := range x {
+		d += (v - y[i]) * (v - y[i])
+	}
+	return d
+}
+
+// parameters returns dst, or a new slice of length n if dst is nil.
+func parameters(dst []float64, n int) []float64 {
+	if dst == nil {
+		return make([]float64, n)
+	}
+	if len(dst) != n {
+		panic(badLength)
+	}
+	return dst
+}
+
+// checkParameters panics if the length of p is not n.
+func checkParameters(p []float64, n int) {
+	if len(p) != n {
+		panic(badLength)
+	}
+}
+
+const badLength = "gp: slice length mismatch"
+
+var (
+	_ Kernel = (*Constant)(nil)
+	_ Kernel = (*RBF)(nil)
+	_ Kernel = (*Matern)(nil)
+	_ Kernel = (*Periodic)(nil)
+	_ Kernel = (*RationalQuadratic)(nil)
+	_ Kernel = Sum(nil)
+	_ Kernel = Product(nil)
+)
+
+// Constant is the constant kernel
+//
+//	k(x, y) = σ²,
+//
+// where σ² is the Variance, which must be positive. The parameter of the
+// kernel is log σ².
+type Constant struct {
+	Variance float64
+}
+
+func (c *Constant) Cov(x, y []float64) float64 {
+	return c.Variance
+}
+
+func (c *Constant) CovGrad(dst, x, y []float64) float64 {
+	checkParameters(dst, 1)
+	dst[0] = c.Variance
+	return c.Variance
+}
+
+func (*Constant) NumParameters() int { return 1 }
+
+func (c *Constant) Parameters(dst []float64) []float64 {
+	dst = parameters(dst, 1)
+	dst[0] = math.Log(c.Variance)
+	return dst
+}
+
+func (c *Constant) SetParameters(p []float64) {
+	checkParameters(p, 1)
+	c.Variance = math.Exp(p[0])
+}
+
+// RBF is the radial basis function, or squared exponential, kernel
+//
+//	k(x, y) = σ² exp(-|x-y|²/(2ℓ²)),
+//
+// where σ² is the Variance and ℓ the LengthScale, which must both be
+// positive. The parameters of the kernel are log ℓ and log σ².
+type RBF struct {
+	LengthScale float64
+	Variance    float64
+}
+
+func (r *RBF) Cov(x, y []float64) float64 {
+	return r.Variance * math.Exp(-sqDist(x, y)/(2*r.LengthScale*r.LengthScale))
+}
+
+func (r *RBF) CovGrad(dst, x, y []float64) float64 {
+	checkParameters(dst, 2)
+	s := sqDist(x, y) / (r.LengthScale * r.LengthScale)
+	k := r.Variance * math.Exp(-s/2)
+	dst[0] = k * s
+	dst[1] = k
+	return k
+}
+
+func (*RBF) NumParameters() int { return 2 }
+
+func (r *RBF) Parameters(dst []float64) []float64 {
+	dst = parameters(dst, 2)
+	dst[0] = math.Log(r.LengthScale)
+	dst[1] = math.Log(r.Variance)
+	return dst
+}
+
+func (r *RBF) SetParameters(p []float64) {
+	checkParameters(p, 2)
+	r.LengthScale = math.Exp(p[0])
+	r.Variance = math.Exp(p[1])
+}
+
+// Matern is the Matérn kernel with half-integer smoothness ν,
+//
+//	ν = 1/2: k(x, y) = σ² exp(-s),           s = |x-y|/ℓ,
+//	ν = 3/2: k(x, y) = σ² (1+s) exp(-s),      s = √3 |x-y|/ℓ,
+//	ν = 5/2: k(x, y) = σ² (1+s+s²/3) exp(-s), s = √5 |x-y|/ℓ,
+//
+// where σ² is the Variance and ℓ the LengthScale, which must both be
+// positive. The functions of a process with the kernel are ⌈ν⌉-1 times
+// differentiable. Nu must be 0.5, 1.5 or 2.5. The parameters of the kernel
+// are log ℓ and log σ²; Nu is not a parameter.
+type Matern struct {
+	Nu          float64
+	LengthScale float64
+	Variance    float64
+}
+
+func (m *Matern) Cov(x, y []float64) float64 {
+	k, _ := m.cov(x, y)
+	return k
+}
+
+func (m *Matern) CovGrad(dst, x, y []float64) float64 {
+	checkParameters(dst, 2)
+	k, dl := m.cov(x, y)
+	dst[0] = dl
+	dst[1] = k
+	return k
+}
+
+// cov returns the covariance between x and y and its derivative with
+// respect to the logarithm of the length scale.
+func (m *Matern) cov(x, y []float64) (k, dl float64) {
+	r := math.Sqrt(sqDist(x, y)) / m.LengthScale
+	switch m.Nu {
+	case 0.5:
+		e := m.Variance * math.Exp(-r)
+		return e, e * r
+	case 1.5:
+		s := math.Sqrt(3) * r
+		e := m.Variance * math.Exp(-s)
+		return e * (1 + s), e * s * s
+	case 2.5:
+		s := math.Sqrt(5) * r
+		e := m.Variance * math.Exp(-s)
+		return e * (1 + s + s*s/3), e * s * s * (1 + s) / 3
+	default:
+		panic("gp: unsupported Matérn smoothness")
+	}
+}
+
+func (*Matern) NumParameters() int { return 2 }
+
+func (m *Matern) Parameters(dst []float64) []float64 {
+	dst = parameters(dst, 2)
+	dst[0] = math.Log(m.LengthScale)
+	dst[1] = math.Log(m.Variance)
+	return dst
+}
+
+func (m *Matern) SetParameters(p []float64) {
+	checkParameters(p, 2)
+	m.LengthScale = math.Exp(p[0])
+	m.Variance = math.Exp(p[1])
+}
+
+// Periodic is the periodic kernel of MacKay,
+//
+//	k(x, y) = σ² exp(-2 Σ_i sin²(π(x_i-y_i)/p)/ℓ²),
+//
+// where σ² is the Variance, p the Period and ℓ the LengthScale, which must
+// all be positive. The kernel is periodic with period p along each
+// coordinate. The parameters of the kernel are log ℓ, log p and log σ².
+type Periodic struct {
+	LengthScale float64
+	Period      float64
+	Variance    float64
+}
+
+func (p *Periodic) Cov(x, y []float64) float64 {
+	return p.CovGrad(make([]float64, 3), x, y)
+}
+
+func (p *Periodic) CovGrad(dst, x, y []float64) float64 {
+	checkParameters(dst, 3)
+	if len(x) != len(y) {
+		panic(badLength)
+	}
+	var s, ds float64
+	for i, v := range x {
+		u := math.Pi * (v - y[i]) / p.Period
+		sin := math.Sin(u)
+		s += sin * sin
+		ds += u * math.Sin(2*u)
+	}
+	l2 := p.LengthScale * p.LengthScale
+	k := p.Variance * math.Exp(-2*s/l2)
+	dst[0] = k * 4 * s / l2
+	dst[1] = k * 2 * ds / l2
+	dst[2] = k
+	return k
+}
+
+func (*Periodic) NumParameters() int { return 3 }
+
+func (p *Periodic) Parameters(dst []float64) []float64 {
+	dst = parameters(dst, 3)
+	dst[0] = math.Log(p.LengthScale)
+	dst[1] = math.Log(p.Period)
+	dst[2] = math.Log(p.Variance)
+	return dst
+}
+
+func (p *Periodic) SetParameters(params []float64) {
+	checkParameters(params, 3)
+	p.LengthScale = math.Exp(params[0])
+	p.Period = math.Exp(params[1])
+	p.Variance = math.Exp(params[2])
+}
+
+// RationalQuadratic is the rational quadratic kernel
+//
+//	k(x, y) = σ² (1 + |x-y|²/(2αℓ²))^-α,
+//
+// a scale mixture of RBF kernels with different length scales. σ² is the
+// Variance, α the Alpha and ℓ the LengthScale, which must all be positive.
+// The kernel tends to the RBF kernel as α tends to infinity. The parameters
+// of the kernel are log ℓ, log α and log σ².
+type RationalQuadratic struct {
+	LengthScale float64
+	Alpha       float64
+	Variance    float64
+}
+
+func (r *RationalQuadratic) Cov(x, y []float64) float64 {
+	b := 1 + sqDist(x, y)/(2*r.Alpha*r.LengthScale*r.LengthScale)
+	return r.Variance * math.Pow(b, -r.Alpha)
+}
+
+func (r *RationalQuadratic) CovGrad(dst, x, y []float64) float64 {
+	checkParameters(dst, 3)
+	d := sqDist(x, y) / (r.LengthScale * r.LengthScale)
+	b := 1 + d/(2*r.Alpha)
+	k := r.Variance * math.Pow(b, -r.Alpha)
+	dst[0] = k * d / b
+	dst[1] = k * r.Alpha * ((b-1)/b - math.Log(b))
+	dst[2] = k
+	return k
+}
+
+func (*RationalQuadratic) NumParameters() int { return 3 }
+
+func (r *RationalQuadratic) Parameters(dst []float64) []float64 {
+	dst = parameters(dst, 3)
+	dst[0] = math.Log(r.LengthScale)
+	dst[1] = math.Log(r.Alpha)
+	dst[2] = math.Log(r.Variance)
+	return dst
+}
+
+func (r *RationalQuadratic) SetParameters(p []float64) {
+	checkParameters(p, 3)
+	r.LengthScale = math.Exp(p[0])
+	r.Alpha = math.Exp(p[1])
+	r.Variance = math.Exp(p[2])
+}
+
+// Sum is the sum of kernels. The parameters of the kernel are the
+// parameters of the summands in order.
+type Sum []Kernel
+
+func (s Sum) Cov(x, y []float64) float64 {
+	var k float64
+	for _, kernel := range s {
+		k += kernel.Cov(x, y)
+	}
+	return k
+}
+
+func (s Sum) CovGrad(dst, x, y []float64) float64 {
+	checkParameters(dst, s.NumParameters())
+	var k float64
+	for _, kernel := range s {
+		n := kernel.NumParameters()
+		k += kernel.CovGrad(dst[:n], x, y)
+		dst = dst[n:]
+	}
+	return k
+}
+
+func (s Sum) NumParameters() int {
+	return numParameters(s)
+}
+
+func (s Sum) Parameters(dst []float64) []float64 {
+	return kernelParameters(dst, s)
+}
+
+func (s Sum) SetParameters(p []float64) {
+	setKernelParameters(s, p)
+}
+
+// Product is the product of kernels. The parameters of the kernel are the
+// parameters of the factors in order.
+type Product []Kernel
+
+func (p Product) Cov(x, y []float64) float64 {
+	k := 1.0
+	for _, kernel := range p {
+		k *= kernel.Cov(x, y)
+	}
+	return k
+}
+
+func (p Product) CovGrad(dst, x, y []float64) float64 {
+	checkParameters(dst, p.NumParameters())
+	ks := make([]float64, len(p))
+	grads := dst
+	for i, kernel := range p {
+		n := kernel.NumParameters()
+		ks[i] = kernel.CovGrad(grads[:n], x, y)
+		grads = grads[n:]
+	}
+	// The derivative of the product with respect to a parameter of a
+	// factor is the derivative of the factor times the other factors.
+	k := 1.0
+	for i, kernel := range p {
+		k *= ks[i]
+		n := kernel.NumParameters()
+		for j, v := range ks {
+			if j != i {
+				for l := range dst[:n] {
+					dst[l] *= v
+				}
+			}
+		}
+		dst = dst[n:]
+	}
+	return k
+}
+
+func (p Product) NumParameters() int {
+	return numParameters(p)
+}
+
+func (p Product) Parameters(dst []float64) []float64 {
+	return kernelParameters(dst, p)
+}
+
+func (p Product) SetParameters(params []float64) {
+	setKernelParameters(p, params)
+}
+
+func numParameters(kernels []Kernel) int {
+	var n int
+	for _, kernel := range kernels {
+		n += kernel.NumParameters()
+	}
+	return n
+}
+
+func kernelParameters(dst []float64, kernels []Kernel) []float64 {
+	dst = parameters(dst, numParameters(kernels))
+	p := dst
+	for _, kernel := range kernels {
+		n := kernel.NumParameters()
+		kernel.Parameters(p[:n])
+		p = p[n:]
+	}
+	return dst
+}
+
+func setKernelParameters(kernels []Kernel, p []float64) {
+	checkParameters(p, numParameters(kernels))
+	for _, kernel := range kernels {
+		n := kernel.NumParameters()
+		kernel.SetParameters(p[:n])
+		p = p[n:]
+	}
+}
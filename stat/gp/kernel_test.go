@@ -0,0 +1,149 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gp
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+func testKernels() []Kernel {
+	return []Kernel{
+		&Constant{Variance: 1.5},
+		&RBF{LengthScale: 0.7, Variance: 2},
+		&Matern{Nu: 0.5, LengthScale: 1.3, Variance: 0.8},
+		&Matern{Nu: 1.5, LengthScale: 0.9, Variance: 1.2},
+		&Matern{Nu: 2.5, LengthScale: 0.6, Variance: 1},
+		&Periodic{LengthScale: 1.1, Period: 2.3, Variance: 0.5},
+		&RationalQuadratic{LengthScale: 0.8, Alpha: 1.7, Variance: 1.4},
+		Sum{&RBF{LengthScale: 0.5, Variance: 1}, &Matern{Nu: 1.5, LengthScale: 2, Variance: 0.3}},
+		Product{&Constant{Variance: 2}, &Periodic{LengthScale: 1, Period: 1.5, Variance: 1}, &RBF{LengthScale: 3, Variance: 1}},
+		Sum{Product{&RBF{LengthScale: 1, Variance: 1}, &RationalQuadratic{LengthScale: 2, Alpha: 0.5, Variance: 1}}, &Constant{Variance: 0.1}},
+	}
+}
+
+func TestKernelGrad(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for k, kernel := range testKernels() {
+		for trial := 0; trial < 10; trial++ {
+			x := []float64{rnd.NormFloat64(), rnd.NormFloat64()}
+			y := []float64{rnd.NormFloat64(), rnd.NormFloat64()}
+			if trial == 0 {
+				copy(y, x)
+			}
+			np := kernel.NumParameters()
+			p := kernel.Parameters(nil)
+			if len(p) != np {
+				t.Fatalf("kernel %d: unexpected number of parameters: got %d, want %d", k, len(p), np)
+			}
+			want := fd.Gradient(nil, func(q []float64) float64 {
+				kernel.SetParameters(q)
+				return kernel.Cov(x, y)
+			}, p, &fd.Settings{Formula: fd.Central})
+			kernel.SetParameters(p)
+
+			got := make([]float64, np)
+			cov := kernel.CovGrad(got, x, y)
+			if c := kernel.Cov(x, y); !scalar.EqualWithinRel(cov, c, 1e-14) {
+				t.Errorf("kernel %d: CovGrad and Cov differ: %v != %v", k, cov, c)
+			}
+			if c := kernel.Cov(y, x); math.Abs(cov-c) > 1e-14 {
+				t.Errorf("kernel %d: covariance not symmetric: %v != %v", k, cov, c)
+			}
+			if !floats.EqualApprox(got, want, 1e-6) {
+				t.Errorf("kernel %d: unexpected gradient: got %v, want %v", k, got, want)
+			}
+			if q := kernel.Parameters(nil); !floats.EqualApprox(q, p, 1e-14) {
+				t.Errorf("kernel %d: parameters changed by round trip: got %v, want %v", k, q, p)
+			}
+		}
+	}
+}
+
+func TestCovarianceMatrix(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	x := mat.NewDense(20, 3, nil)
+	for i := 0; i < 20; i++ {
+		for j := 0; j < 3; j++ {
+			x.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	for k, kernel := range testKernels() {
+		var cov mat.SymDense
+		CovarianceMatrix(&cov, kernel, x)
+		for i := 0; i < 20; i++ {
+			for j := 0; j < 20; j++ {
+				if want := kernel.Cov(x.RawRowView(i), x.RawRowView(j)); math.Abs(cov.At(i, j)-want) > 1e-14 {
+					t.Fatalf("kernel %d: unexpected covariance at (%d,%d): got %v, want %v", k, i, j, cov.At(i, j), want)
+				}
+			}
+		}
+		var eig mat.EigenSym
+		if !eig.Factorize(&cov, false) {
+			t.Fatalf("kernel %d: eigendecomposition failed", k)
+		}
+		if min := floats.Min(eig.Values(nil)); min < -1e-10 {
+			t.Errorf("kernel %d: covariance matrix not positive semi-definite: smallest eigenvalue %v", k, min)
+		}
+	}
+}
+
+func TestKernelPanics(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		f    func()
+	}{
+		{"dimension mismatch", func() { (&RBF{LengthScale: 1, Variance: 1}).Cov([]float64{1}, []float64{1, 2}) }},
+		{"Matérn smoothness", func() { (&Matern{Nu: 1, LengthScale: 1, Variance: 1}).Cov([]float64{1}, []float64{2}) }},
+		{"parameter length", func() { Sum{&RBF{}, &Constant{}}.SetParameters(make([]float64, 2)) }},
+		{"gradient length", func() { (&Periodic{}).CovGrad(make([]float64, 2), []float64{1}, []float64{2}) }},
+		{"covariance size", func() {
+			CovarianceMatrix(mat.NewSymDense(2, nil), &Constant{Variance: 1}, mat.NewDense(3, 1, nil))
+		}},
+	} {
+		if !panics(test.f) {
+			t.Errorf("%s: expected panic", test.name)
+		}
+	}
+}
+
+func panics(f func()) (b bool) {
+	defer func() {
+		err := recover()
+		if err != nil {
+			b = true
+		}
+	}()
+	f()
+	return
+}
+
+func ExampleSum() {
+	// A process with a smooth periodic component and a slowly varying
+	// trend.
+	kernel := Sum{
+		Product{&Periodic{LengthScale: 1, Period: 12, Variance: 1}, &RBF{LengthScale: 50, Variance: 1}},
+		&RBF{LengthScale: 100, Variance: 4},
+	}
+	fmt.Printf("parameters: %d\n", kernel.NumParameters())
+	fmt.Printf("k(0, 12) = %.4f\n", kernel.Cov([]float64{0}, []float64{12}))
+	fmt.Printf("k(0, 6) = %.4f\n", kernel.Cov([]float64{0}, []float64{6}))
+
+	// Output:
+	// parameters: 7
+	// k(0, 12) = 4.9429
+	// k(0, 6) = 4.1272
+}
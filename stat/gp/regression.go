@@ -0,0 +1,244 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gp
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+// Regression is an exact Gaussian process regression model. The observations
+// are the values of a process with zero mean and covariance given by Kernel
+// at the inputs, with added independent normal noise.
+//
+// Observations with a non-zero mean should be centered before fitting, and
+// the mean added to the predictions.
+type Regression struct {
+	// Kernel is the covariance function of the process.
+	Kernel Kernel
+
+	// Noise is the variance of the noise of the observations. Noise must
+	// not be negative, and it must be positive for FitParameters to fit it.
+	Noise float64
+
+	// FitNoise specifies whether FitParameters fits Noise in addition to
+	// the parameters of Kernel.
+	FitNoise bool
+
+	rows  [][]float64
+	y     []float64
+	chol  mat.Cholesky
+	alpha *mat.VecDense
+	lml   float64
+}
+
+// Fit conditions the model on the observations y at the inputs given by the
+// rows of x. Fit returns ErrNotPositiveDefinite if the covariance matrix of
+// the observations cannot be factorized, in which case the model must not
+// be used for prediction.
+//
+// Fit panics if the length of y is not the number of rows of x, or if x
+// has no rows.
+func (r *Regression) Fit(x mat.Matrix, y []float64) error {
+	r.setData(x, y)
+	return r.condition()
+}
+
+// FitParameters fits the parameters of Kernel, and Noise if FitNoise is
+// true, to the observations y at the inputs given by the rows of x by
+// maximizing the log marginal likelihood, starting from the current values.
+// It then conditions the model on the observations as Fit. The maximization
+// uses the given method and settings of optimize.Minimize, where a nil
+// method or settings choose the defaults of Minimize, except that a zero
+// GradientThreshold is defaulted to 1e-8. The evaluations are not
+// concurrent and settings.Concurrent is ignored.
+//
+// The parameters are set to the best values found, and the model
+// conditioned on them, also when the maximization returns an error. Such an
+// error is returned.
+func (r *Regression) FitParameters(x mat.Matrix, y []float64, settings *optimize.Settings, method optimize.Method) error {
+	r.setData(x, y)
+	if r.FitNoise && r.Noise <= 0 {
+		panic("gp: non-positive noise to fit")
+	}
+	p := r.Kernel.Parameters(nil)
+	if r.FitNoise {
+		p = append(p, math.Log(r.Noise))
+	}
+	best, err := maximize(p, func(p []float64) float64 {
+		r.setParameters(p)
+		if r.condition() != nil {
+			return math.Inf(-1)
+		}
+		return r.lml
+	}, r.gradient, settings, method)
+	if best == nil {
+		return err
+	}
+	r.setParameters(best)
+	cerr := r.condition()
+	if err != nil {
+		return err
+	}
+	return cerr
+}
+
+// LogMarginalLikelihood returns the log marginal likelihood of the
+// observations the model is conditioned on.
+func (r *Regression) LogMarginalLikelihood() float64 {
+	r.checkFitted()
+	return r.lml
+}
+
+// Predict returns the mean and variance of the process at x conditioned on
+// the observations. The variance is that of the value of the process, and
+// it does not include the noise of an observation.
+func (r *Regression) Predict(x []float64) (mean, variance float64) {
+	r.checkFitted()
+	k := mat.NewVecDense(len(r.rows), nil)
+	for i, row := range r.rows {
+		k.SetVec(i, r.Kernel.Cov(x, row))
+	}
+	mean = mat.Dot(k, r.alpha)
+	var v mat.VecDense
+	err := r.chol.SolveVecTo(&v, k)
+	if err != nil && !isConditionError(err) {
+		panic(err)
+	}
+	variance = r.Kernel.Cov(x, x) - mat.Dot(k, &v)
+	return mean, math.Max(variance, 0)
+}
+
+// PredictCov returns the mean of the process at the rows of x conditioned
+// on the observations, and stores their covariance in cov if cov is not
+// nil. If mean is nil, a new slice is allocated, otherwise its length must
+// be the number of rows of x. If cov is empty, it is resized to the number
+// of rows of x, otherwise PredictCov panics if the sizes do not match.
+func (r *Regression) PredictCov(mean []float64, cov *mat.SymDense, x mat.Matrix) []float64 {
+	r.checkFitted()
+	m, _ := x.Dims()
+	if mean == nil {
+		mean = make([]float64, m)
+	} else if len(mean) != m {
+		panic(badLength)
+	}
+	rows := rowsOf(x)
+	k := mat.NewDense(len(r.rows), m, nil)
+	for i, row := range r.rows {
+		for j, xj := range rows {
+			k.Set(i, j, r.Kernel.Cov(row, xj))
+		}
+	}
+	mat.NewVecDense(m, mean).MulVec(k.T(), r.alpha)
+	if cov == nil {
+		return mean
+	}
+	if cov.IsEmpty() {
+		cov.ReuseAsSym(m)
+	} else if cov.SymmetricDim() != m {
+		panic(mat.ErrShape)
+	}
+	var v, kv mat.Dense
+	err := r.chol.SolveTo(&v, k)
+	if err != nil && !isConditionError(err) {
+		panic(err)
+	}
+	kv.Mul(k.T(), &v)
+	for i := 0; i < m; i++ {
+		for j := i; j < m; j++ {
+			c := r.Kernel.Cov(rows[i], rows[j]) - (kv.At(i, j)+kv.At(j, i))/2
+			if i == j {
+				c = math.Max(c, 0)
+			}
+			cov.SetSym(i, j, c)
+		}
+	}
+	return mean
+}
+
+func (r *Regression) checkFitted() {
+	if r.alpha == nil {
+		panic("gp: model not fitted")
+	}
+}
+
+func (r *Regression) setData(x mat.Matrix, y []float64) {
+	n, _ := x.Dims()
+	if n == 0 {
+		panic("gp: no observations")
+	}
+	if len(y) != n {
+		panic(badLength)
+	}
+	if r.Noise < 0 {
+		panic("gp: negative noise")
+	}
+	r.rows = rowsOf(x)
+	r.y = append(r.y[:0], y...)
+}
+
+// setParameters sets the parameters of the kernel and, if FitNoise is
+// true, the noise from their logarithms in p.
+func (r *Regression) setParameters(p []float64) {
+	n := r.Kernel.NumParameters()
+	r.Kernel.SetParameters(p[:n])
+	if r.FitNoise {
+		r.Noise = math.Exp(p[n])
+	}
+}
+
+// condition conditions the model on the stored observations.
+func (r *Regression) condition() error {
+	r.alpha = nil
+	n := len(r.rows)
+	k := covariance(r.Kernel, r.rows)
+	for i := 0; i < n; i++ {
+		k.SetSym(i, i, k.At(i, i)+r.Noise)
+	}
+	if !r.chol.Factorize(k) {
+		return ErrNotPositiveDefinite
+	}
+	alpha := mat.NewVecDense(n, nil)
+	err := r.chol.SolveVecTo(alpha, mat.NewVecDense(n, r.y))
+	if err != nil && !isConditionError(err) {
+		return ErrNotPositiveDefinite
+	}
+	r.alpha = alpha
+	r.lml = -0.5*mat.Dot(mat.NewVecDense(n, r.y), alpha) - 0.5*r.chol.LogDet() - float64(n)/2*math.Log(2*math.Pi)
+	return nil
+}
+
+// gradient stores in grad the gradient of the log marginal likelihood with
+// respect to the logarithms of the parameters, using the factorization of
+// the covariance matrix computed by condition.
+func (r *Regression) gradient(grad []float64) error {
+	r.checkFitted()
+	n := len(r.rows)
+	_, grads := covarianceGrads(r.Kernel, r.rows)
+
+	// The derivative of the log marginal likelihood with respect to a
+	// parameter θ is
+	//  1/2 tr((αα^T - K^-1) ∂K/∂θ).
+	var w mat.SymDense
+	err := r.chol.InverseTo(&w)
+	if err != nil && !isConditionError(err) {
+		return ErrNotPositiveDefinite
+	}
+	w.SymRankOne(&w, -1, r.alpha)
+	w.ScaleSym(-0.5, &w)
+	for i, g := range grads {
+		grad[i] = traceProduct(&w, g)
+	}
+	if r.FitNoise {
+		var t float64
+		for i := 0; i < n; i++ {
+			t += w.At(i, i)
+		}
+		grad[len(grads)] = r.Noise * t
+	}
+	return nil
+}
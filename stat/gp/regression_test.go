@@ -0,0 +1,187 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gp
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+// sineData returns n noisy observations of sin(3x) on [0, 3].
+func sineData(n int, noise float64, src rand.Source) (*mat.Dense, []float64) {
+	rnd := rand.New(src)
+	x := mat.NewDense(n, 1, nil)
+	y := make([]float64, n)
+	for i := range y {
+		v := 3 * rnd.Float64()
+		x.Set(i, 0, v)
+		y[i] = math.Sin(3*v) + noise*rnd.NormFloat64()
+	}
+	return x, y
+}
+
+func TestRegressionTwoPoints(t *testing.T) {
+	t.Parallel()
+	kernel := &RBF{LengthScale: 0.8, Variance: 1.5}
+	x := mat.NewDense(2, 1, []float64{0, 1})
+	y := []float64{1, -0.5}
+	const noise = 0.1
+	r := Regression{Kernel: kernel, Noise: noise}
+	if err := r.Fit(x, y); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The posterior of a process with two observations in closed form.
+	k11 := kernel.Variance + noise
+	k12 := kernel.Cov([]float64{0}, []float64{1})
+	det := k11*k11 - k12*k12
+	inv := [2][2]float64{{k11 / det, -k12 / det}, {-k12 / det, k11 / det}}
+	for _, xs := range []float64{-0.5, 0, 0.3, 2} {
+		ks := []float64{kernel.Cov([]float64{xs}, []float64{0}), kernel.Cov([]float64{xs}, []float64{1})}
+		var wantMean, quad float64
+		for i := 0; i < 2; i++ {
+			for j := 0; j < 2; j++ {
+				wantMean += ks[i] * inv[i][j] * y[j]
+				quad += ks[i] * inv[i][j] * ks[j]
+			}
+		}
+		wantVar := kernel.Variance - quad
+		mean, variance := r.Predict([]float64{xs})
+		if !scalar.EqualWithinAbsOrRel(mean, wantMean, 1e-12, 1e-12) || !scalar.EqualWithinAbsOrRel(variance, wantVar, 1e-12, 1e-12) {
+			t.Errorf("unexpected prediction at %v: got (%v, %v), want (%v, %v)", xs, mean, variance, wantMean, wantVar)
+		}
+	}
+	wantLML := -0.5*(y[0]*(inv[0][0]*y[0]+inv[0][1]*y[1])+y[1]*(inv[1][0]*y[0]+inv[1][1]*y[1])) - 0.5*math.Log(det) - math.Log(2*math.Pi)
+	if lml := r.LogMarginalLikelihood(); !scalar.EqualWithinAbsOrRel(lml, wantLML, 1e-12, 1e-12) {
+		t.Errorf("unexpected log marginal likelihood: got %v, want %v", lml, wantLML)
+	}
+}
+
+func TestRegressionPredictCov(t *testing.T) {
+	t.Parallel()
+	x, y := sineData(15, 0.1, rand.NewSource(1))
+	r := Regression{Kernel: &Matern{Nu: 2.5, LengthScale: 0.5, Variance: 1}, Noise: 0.01}
+	if err := r.Fit(x, y); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	xs := mat.NewDense(4, 1, []float64{-1, 0.5, 1.5, 4})
+	var cov mat.SymDense
+	mean := r.PredictCov(nil, &cov, xs)
+	for i := 0; i < 4; i++ {
+		m, v := r.Predict(xs.RawRowView(i))
+		if !scalar.EqualWithinAbsOrRel(mean[i], m, 1e-12, 1e-12) || !scalar.EqualWithinAbsOrRel(cov.At(i, i), v, 1e-10, 1e-10) {
+			t.Errorf("PredictCov and Predict differ at %d: (%v, %v) != (%v, %v)", i, mean[i], cov.At(i, i), m, v)
+		}
+	}
+	// Far from the observations the prior is recovered.
+	if m, v := r.Predict([]float64{100}); math.Abs(m) > 1e-12 || math.Abs(v-1) > 1e-12 {
+		t.Errorf("unexpected prediction far from observations: got (%v, %v), want (0, 1)", m, v)
+	}
+}
+
+func TestRegressionGrad(t *testing.T) {
+	t.Parallel()
+	x, y := sineData(20, 0.1, rand.NewSource(1))
+	for k, fitNoise := range []bool{false, true} {
+		kernels := []Kernel{
+			&RBF{LengthScale: 0.5, Variance: 1},
+			Sum{&Matern{Nu: 1.5, LengthScale: 0.4, Variance: 0.8}, &Periodic{LengthScale: 1, Period: 2, Variance: 0.5}},
+		}
+		for j, kernel := range kernels {
+			r := Regression{Kernel: kernel, Noise: 0.05, FitNoise: fitNoise}
+			r.setData(x, y)
+			p := kernel.Parameters(nil)
+			if fitNoise {
+				p = append(p, math.Log(r.Noise))
+			}
+			lml := func(p []float64) float64 {
+				r.setParameters(p)
+				if err := r.condition(); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return r.lml
+			}
+			want := fd.Gradient(nil, lml, p, &fd.Settings{Formula: fd.Central, Step: 1e-5})
+			got := make([]float64, len(p))
+			r.setParameters(p)
+			if err := r.condition(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err := r.gradient(got); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !floats.EqualApprox(got, want, 1e-5) {
+				t.Errorf("case %d kernel %d: unexpected gradient: got %v, want %v", k, j, got, want)
+			}
+		}
+	}
+}
+
+func TestRegressionFitParameters(t *testing.T) {
+	t.Parallel()
+	const noise = 0.1
+	x, y := sineData(40, noise, rand.NewSource(1))
+	kernel := &RBF{LengthScale: 1, Variance: 1}
+	r := Regression{Kernel: kernel, Noise: 1, FitNoise: true}
+	if err := r.Fit(x, y); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	initial := r.LogMarginalLikelihood()
+	if err := r.FitParameters(x, y, nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if lml := r.LogMarginalLikelihood(); lml <= initial {
+		t.Errorf("log marginal likelihood not increased: got %v, initial %v", lml, initial)
+	}
+	grad := make([]float64, 3)
+	if err := r.gradient(grad); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if norm := floats.Norm(grad, math.Inf(1)); norm > 1e-4 {
+		t.Errorf("gradient not zero at the fitted parameters: %v", grad)
+	}
+	if r.Noise < noise*noise/3 || noise*noise*3 < r.Noise {
+		t.Errorf("unexpected fitted noise: got %v, want about %v", r.Noise, noise*noise)
+	}
+	for _, v := range []float64{0.5, 1, 2, 2.5} {
+		mean, variance := r.Predict([]float64{v})
+		if math.Abs(mean-math.Sin(3*v)) > 3*math.Sqrt(variance)+0.05 {
+			t.Errorf("unexpected prediction at %v: got %v±%v, want %v", v, mean, math.Sqrt(variance), math.Sin(3*v))
+		}
+	}
+}
+
+func TestRegressionPanics(t *testing.T) {
+	t.Parallel()
+	x := mat.NewDense(2, 1, []float64{0, 1})
+	for _, test := range []struct {
+		name string
+		f    func()
+	}{
+		{"not fitted", func() { (&Regression{Kernel: &RBF{LengthScale: 1, Variance: 1}}).Predict([]float64{0}) }},
+		{"length mismatch", func() { (&Regression{Kernel: &RBF{LengthScale: 1, Variance: 1}}).Fit(x, []float64{1}) }},
+		{"negative noise", func() { (&Regression{Kernel: &RBF{LengthScale: 1, Variance: 1}, Noise: -1}).Fit(x, []float64{1, 2}) }},
+		{"zero noise to fit", func() {
+			(&Regression{Kernel: &RBF{LengthScale: 1, Variance: 1}, FitNoise: true}).FitParameters(x, []float64{1, 2}, nil, nil)
+		}},
+	} {
+		if !panics(test.f) {
+			t.Errorf("%s: expected panic", test.name)
+		}
+	}
+
+	// Coincident inputs without noise give a singular covariance matrix.
+	r := Regression{Kernel: &RBF{LengthScale: 1, Variance: 1}}
+	if err := r.Fit(mat.NewDense(2, 1, []float64{1, 1}), []float64{1, 2}); err != ErrNotPositiveDefinite {
+		t.Errorf("unexpected error for singular covariance: got %v, want %v", err, ErrNotPositiveDefinite)
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hypothesis
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// ChiSquare performs Pearson's chi-squared goodness of fit test of the null
+// hypothesis that the observed counts obs are a sample from the categorical
+// distribution with the expected counts exp. The statistic is
+// Σ (obs_i - exp_i)²/exp_i, and its p-value is computed from the
+// chi-squared distribution with k-1-ddof degrees of freedom, where k is the
+// number of categories and ddof is the number of parameters of the
+// distribution estimated from obs. The effect size is Cohen's w,
+// the square root of the statistic divided by the total of obs.
+//
+// ChiSquare panics if the lengths of obs and exp differ or if the degrees
+// of freedom are not positive.
+func ChiSquare(obs, exp []float64, ddof int) Result {
+	if len(obs) != len(exp) {
+		panic(badLength)
+	}
+	dof := len(obs) - 1 - ddof
+	if dof < 1 {
+		panic(tooFew)
+	}
+	var stat, total float64
+	for i, o := range obs {
+		d := o - exp[i]
+		stat += d * d / exp[i]
+		total += o
+	}
+	return Result{
+		Statistic:  stat,
+		PValue:     distuv.ChiSquared{K: float64(dof)}.Survival(stat),
+		DoF:        float64(dof),
+		DoF2:       math.NaN(),
+		EffectSize: math.Sqrt(stat / total),
+	}
+}
+
+// ChiSquareIndependence performs Pearson's chi-squared test of the null
+// hypothesis that the row and column variables of the contingency table of
+// counts are independent. The statistic is Σ (O_ij - E_ij)²/E_ij, where E
+// holds the counts expected from the row and column totals, and its p-value
+// is computed from the chi-squared distribution with (r-1)(c-1) degrees of
+// freedom for a table with r rows and c columns. No continuity correction
+// is applied. The effect size is Cramér's V, √(X²/(N(min(r,c)-1))), where
+// N is the total count.
+//
+// ChiSquareIndependence panics if the table has fewer than two rows or two
+// columns.
+func ChiSquareIndependence(table mat.Matrix) Result {
+	r, c := table.Dims()
+	if r < 2 || c < 2 {
+		panic(tooFew)
+	}
+	rows := make([]float64, r)
+	cols := make([]float64, c)
+	var total float64
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			v := table.At(i, j)
+			rows[i] += v
+			cols[j] += v
+			total += v
+		}
+	}
+	var stat float64
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			e := rows[i] * cols[j] / total
+			d := table.At(i, j) - e
+			stat += d * d / e
+		}
+	}
+	dof := float64((r - 1) * (c - 1))
+	return Result{
+		Statistic:  stat,
+		PValue:     distuv.ChiSquared{K: dof}.Survival(stat),
+		DoF:        dof,
+		DoF2:       math.NaN(),
+		EffectSize: math.Sqrt(stat / (total * float64(min(r, c)-1))),
+	}
+}
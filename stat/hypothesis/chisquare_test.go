@@ -0,0 +1,52 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hypothesis
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestChiSquare(t *testing.T) {
+	t.Parallel()
+	nan := math.NaN()
+	// Reference values from R's chisq.test.
+	table := mat.NewDense(2, 3, []float64{
+		762, 327, 468,
+		484, 239, 477,
+	})
+	checkResults(t, []resultTest{
+		{
+			name: "goodness of fit",
+			got:  ChiSquare([]float64{20, 15, 25}, []float64{20, 20, 20}, 0),
+			want: Result{Statistic: 2.5, PValue: 0.2865048, DoF: 2, DoF2: nan, EffectSize: math.Sqrt(2.5 / 60)},
+			tol:  1e-6,
+		},
+		{
+			name: "goodness of fit with estimated parameter",
+			got:  ChiSquare([]float64{20, 15, 25}, []float64{20, 20, 20}, 1),
+			want: Result{Statistic: 2.5, PValue: 0.1138463, DoF: 1, DoF2: nan, EffectSize: math.Sqrt(2.5 / 60)},
+			tol:  1e-6,
+		},
+		{
+			name: "independence",
+			got:  ChiSquareIndependence(table),
+			want: Result{Statistic: 30.07015, PValue: 2.953589e-07, DoF: 2, DoF2: nan, EffectSize: math.Sqrt(30.07015 / 2757)},
+			tol:  1e-6,
+		},
+	})
+
+	for _, f := range []func(){
+		func() { ChiSquare([]float64{1, 2}, []float64{1}, 0) },
+		func() { ChiSquare([]float64{1, 2}, []float64{1, 2}, 1) },
+		func() { ChiSquareIndependence(mat.NewDense(1, 3, nil)) },
+	} {
+		if !panics(f) {
+			t.Error("expected panic for invalid argument")
+		}
+	}
+}
@@ -0,0 +1,11 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package hypothesis provides statistical hypothesis tests.
+//
+// The tests return a Result holding the test statistic, its p-value, the
+// degrees of freedom of its distribution and an effect size. The
+// alternative hypothesis of the tests of a location or a distribution is
+// given by an Alternative.
+package hypothesis // import "gonum.org/v1/gonum/stat/hypothesis"
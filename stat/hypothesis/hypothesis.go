@@ -0,0 +1,103 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hypothesis
+
+import (
+	"math"
+	"sort"
+)
+
+// Alternative is the alternative hypothesis of a test.
+type Alternative int
+
+const (
+	// TwoSided is the alternative that the location or the distribution
+	// differs from that of the null hypothesis in either direction.
+	TwoSided Alternative = iota
+	// Less is the alternative that the location of the first sample is
+	// less than that of the null hypothesis or of the second sample.
+	Less
+	// Greater is the alternative that the location of the first sample is
+	// greater than that of the null hypothesis or of the second sample.
+	Greater
+)
+
+// Result is the result of a hypothesis test.
+type Result struct {
+	// Statistic is the test statistic.
+	Statistic float64
+
+	// PValue is the probability under the null hypothesis of a statistic
+	// at least as extreme as Statistic in the direction of the
+	// alternative.
+	PValue float64
+
+	// DoF is the degrees of freedom of the distribution of the statistic,
+	// and DoF2 the denominator degrees of freedom of an F distribution.
+	// They are NaN if the distribution has no degrees of freedom.
+	DoF, DoF2 float64
+
+	// EffectSize is the effect size of the test. Its measure is given in
+	// the documentation of each test, and it is NaN if the test has none.
+	EffectSize float64
+}
+
+// pValue returns the p-value for the alternative of a statistic with the
+// given lower and upper tail probabilities, P(T ≤ t) and P(T ≥ t).
+func pValue(lower, upper float64, alt Alternative) float64 {
+	switch alt {
+	case TwoSided:
+		return math.Min(1, 2*math.Min(lower, upper))
+	case Less:
+		return lower
+	case Greater:
+		return upper
+	default:
+		panic(badAlternative)
+	}
+}
+
+// ranks returns the ranks of x, with ties given their average rank, and the
+// tie correction Σ(t³-t) over the sizes t of the groups of ties.
+func ranks(x []float64) (r []float64, ties float64) {
+	idx := make([]int, len(x))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(a, b int) bool { return x[idx[a]] < x[idx[b]] })
+	r = make([]float64, len(x))
+	for i := 0; i < len(idx); {
+		j := i + 1
+		for j < len(idx) && x[idx[j]] == x[idx[i]] {
+			j++
+		}
+		avg := float64(i+j+1) / 2
+		for _, k := range idx[i:j] {
+			r[k] = avg
+		}
+		t := float64(j - i)
+		ties += t*t*t - t
+		i = j
+	}
+	return r, ties
+}
+
+// meanVar returns the mean and the unbiased variance of x.
+func meanVar(x []float64) (mean, variance float64) {
+	for _, v := range x {
+		mean += v
+	}
+	mean /= float64(len(x))
+	for _, v := range x {
+		variance += (v - mean) * (v - mean)
+	}
+	return mean, variance / float64(len(x)-1)
+}
+
+const (
+	badAlternative = "hypothesis: unknown alternative"
+	badLength      = "hypothesis: slice length mismatch"
+	tooFew         = "hypothesis: too few observations"
+)
@@ -0,0 +1,340 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hypothesis
+
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// KolmogorovSmirnov performs the one-sample Kolmogorov–Smirnov test of the
+// null hypothesis that x is a sample from the continuous distribution with
+// the distribution function cdf.
+//
+// For the TwoSided alternative the statistic is D = sup|Fₙ(t) - F(t)|,
+// where Fₙ is the empirical distribution function of x and F is cdf. For
+// the Less alternative, that x tends to be less than under the null
+// hypothesis, the statistic is D⁺ = sup(Fₙ(t) - F(t)), and for the Greater
+// alternative it is D⁻ = sup(F(t) - Fₙ(t)).
+//
+// The p-value of D is exact for samples with at most 1000 elements, and is
+// computed from the limiting Kolmogorov distribution of √n D for larger
+// samples. The p-values of D⁺ and D⁻ are exact. The effect size is NaN.
+//
+// KolmogorovSmirnov panics if x is empty.
+//
+// References:
+//   - Marsaglia, G., Tsang, W. W. and Wang, J. (2003). Evaluating
+//     Kolmogorov's distribution. Journal of Statistical Software, 8(18).
+//   - Birnbaum, Z. W. and Tingey, F. H. (1951). One-sided confidence
+//     contours for probability distribution functions. The Annals of
+//     Mathematical Statistics, 22(4), 592–596.
+func KolmogorovSmirnov(x []float64, cdf func(float64) float64, alt Alternative) Result {
+	n := len(x)
+	if n == 0 {
+		panic(tooFew)
+	}
+	s := make([]float64, n)
+	copy(s, x)
+	sort.Float64s(s)
+	fn := float64(n)
+	var dPlus, dMinus float64
+	for i, v := range s {
+		f := cdf(v)
+		dPlus = math.Max(dPlus, float64(i+1)/fn-f)
+		dMinus = math.Max(dMinus, f-float64(i)/fn)
+	}
+	res := Result{
+		DoF:        math.NaN(),
+		DoF2:       math.NaN(),
+		EffectSize: math.NaN(),
+	}
+	switch alt {
+	case TwoSided:
+		res.Statistic = math.Max(dPlus, dMinus)
+		if n <= 1000 {
+			res.PValue = 1 - kolmogorovCDF(n, res.Statistic)
+		} else {
+			res.PValue = kolmogorovSurvival(math.Sqrt(fn) * res.Statistic)
+		}
+	case Less:
+		res.Statistic = dPlus
+		res.PValue = smirnovSurvival(n, dPlus)
+	case Greater:
+		res.Statistic = dMinus
+		res.PValue = smirnovSurvival(n, dMinus)
+	default:
+		panic(badAlternative)
+	}
+	res.PValue = math.Max(0, math.Min(1, res.PValue))
+	return res
+}
+
+// kolmogorovCDF returns P(D < d) for the two-sided statistic D of a sample
+// of size n using the algorithm of Marsaglia, Tsang and Wang.
+func kolmogorovCDF(n int, d float64) float64 {
+	if d <= 0 {
+		return 0
+	}
+	if d >= 1 {
+		return 1
+	}
+	fn := float64(n)
+	s := d * d * fn
+	if s > 7.24 || (s > 3.76 && n > 99) {
+		return 1 - 2*math.Exp(-(2.000071+0.331/math.Sqrt(fn)+1.409/fn)*s)
+	}
+	k := int(fn*d) + 1
+	m := 2*k - 1
+	h := float64(k) - fn*d
+	H := mat.NewDense(m, m, nil)
+	for i := 0; i < m; i++ {
+		for j := 0; j < m; j++ {
+			if i-j+1 >= 0 {
+				H.Set(i, j, 1)
+			}
+		}
+	}
+	for i := 0; i < m; i++ {
+		H.Set(i, 0, H.At(i, 0)-math.Pow(h, float64(i+1)))
+		H.Set(m-1, i, H.At(m-1, i)-math.Pow(h, float64(m-i)))
+	}
+	if 2*h-1 > 0 {
+		H.Set(m-1, 0, H.At(m-1, 0)+math.Pow(2*h-1, float64(m)))
+	}
+	for i := 0; i < m; i++ {
+		for j := 0; j <= i; j++ {
+			// Divide by (i-j+1)!.
+			v := H.At(i, j)
+			for g := 2; g <= i-j+1; g++ {
+				v /= float64(g)
+			}
+			H.Set(i, j, v)
+		}
+	}
+	q, e := matPow(H, n)
+	v := q.At(k-1, k-1)
+	for i := 1; i <= n; i++ {
+		v *= float64(i) / fn
+		if v < 1e-140 {
+			v *= 1e140
+			e -= 140
+		}
+	}
+	return v * math.Pow(10, float64(e))
+}
+
+// matPow returns a and e such that aⁿ·10ᵉ is the nth power of h, keeping
+// the elements of a within the range of float64.
+func matPow(h *mat.Dense, n int) (a *mat.Dense, e int) {
+	if n == 1 {
+		a = mat.DenseCopyOf(h)
+		return a, 0
+	}
+	v, ev := matPow(h, n/2)
+	a = &mat.Dense{}
+	a.Mul(v, v)
+	e = 2 * ev
+	if n%2 == 1 {
+		var b mat.Dense
+		b.Mul(h, a)
+		a = &b
+	}
+	m, _ := a.Dims()
+	if a.At(m/2, m/2) > 1e140 {
+		a.Scale(1e-140, a)
+		e += 140
+	}
+	return a, e
+}
+
+// kolmogorovSurvival returns the probability that the limiting Kolmogorov
+// distribution is greater than t.
+func kolmogorovSurvival(t float64) float64 {
+	if t <= 0 {
+		return 1
+	}
+	if t < 1 {
+		// Use the series that converges rapidly for small t.
+		z := -math.Pi * math.Pi / (8 * t * t)
+		var s float64
+		for k := 1; k < 40; k += 2 {
+			s += math.Exp(float64(k*k) * z)
+		}
+		return 1 - math.Sqrt(2*math.Pi)/t*s
+	}
+	var s float64
+	sign := 1.0
+	for k := 1; k < 100; k++ {
+		term := math.Exp(-2 * float64(k*k) * t * t)
+		s += sign * term
+		if term < 1e-17*s {
+			break
+		}
+		sign = -sign
+	}
+	return 2 * s
+}
+
+// smirnovSurvival returns P(D⁺ ≥ d) for the one-sided statistic D⁺ of a
+// sample of size n using the formula of Birnbaum and Tingey.
+func smirnovSurvival(n int, d float64) float64 {
+	if d <= 0 {
+		return 1
+	}
+	if d >= 1 {
+		return 0
+	}
+	fn := float64(n)
+	lgn, _ := math.Lgamma(fn + 1)
+	// The j = 0 term.
+	p := math.Exp(fn * math.Log1p(-d))
+	for j := 1; j <= int(math.Floor(fn*(1-d))); j++ {
+		fj := float64(j)
+		c := 1 - d - fj/fn
+		if c <= 0 {
+			break
+		}
+		lgj, _ := math.Lgamma(fj + 1)
+		lgnj, _ := math.Lgamma(fn - fj + 1)
+		p += math.Exp(lgn - lgj - lgnj + (fn-fj)*math.Log(c) + (fj-1)*math.Log(d+fj/fn) + math.Log(d))
+	}
+	return p
+}
+
+// KolmogorovSmirnov2 performs the two-sample Kolmogorov–Smirnov test of the
+// null hypothesis that x and y are samples from the same continuous
+// distribution.
+//
+// For the TwoSided alternative the statistic is D = sup|Fₘ(t) - Gₙ(t)|,
+// where Fₘ and Gₙ are the empirical distribution functions of x and y. For
+// the Less alternative, that x tends to be less than y, the statistic is
+// D⁺ = sup(Fₘ(t) - Gₙ(t)), and for the Greater alternative it is
+// D⁻ = sup(Gₙ(t) - Fₘ(t)).
+//
+// The p-value is exact if the product of the sample sizes is less than
+// 10000 and there are no ties. Otherwise it is computed from the limiting
+// distribution of the statistic. The effect size is NaN.
+//
+// KolmogorovSmirnov2 panics if x or y is empty.
+func KolmogorovSmirnov2(x, y []float64, alt Alternative) Result {
+	m, n := len(x), len(y)
+	if m == 0 || n == 0 {
+		panic(tooFew)
+	}
+	sx := make([]float64, m)
+	copy(sx, x)
+	sort.Float64s(sx)
+	sy := make([]float64, n)
+	copy(sy, y)
+	sort.Float64s(sy)
+
+	fm, fn := float64(m), float64(n)
+	var dPlus, dMinus float64
+	var ties bool
+	var i, j int
+	for i < m || j < n {
+		var v float64
+		switch {
+		case j == n || (i < m && sx[i] < sy[j]):
+			v = sx[i]
+		default:
+			v = sy[j]
+		}
+		var cx, cy int
+		for i < m && sx[i] == v {
+			i++
+			cx++
+		}
+		for j < n && sy[j] == v {
+			j++
+			cy++
+		}
+		if cx+cy > 1 {
+			ties = true
+		}
+		diff := float64(i)/fm - float64(j)/fn
+		dPlus = math.Max(dPlus, diff)
+		dMinus = math.Max(dMinus, -diff)
+	}
+
+	res := Result{
+		DoF:        math.NaN(),
+		DoF2:       math.NaN(),
+		EffectSize: math.NaN(),
+	}
+	switch alt {
+	case TwoSided:
+		res.Statistic = math.Max(dPlus, dMinus)
+	case Less:
+		res.Statistic = dPlus
+	case Greater:
+		res.Statistic = dMinus
+	default:
+		panic(badAlternative)
+	}
+	if m*n < 10000 && !ties {
+		res.PValue = 1 - smirnov2CDF(m, n, res.Statistic, alt)
+	} else {
+		en := math.Sqrt(fm * fn / (fm + fn))
+		if alt == TwoSided {
+			res.PValue = kolmogorovSurvival(en * res.Statistic)
+		} else {
+			res.PValue = math.Exp(-2 * en * en * res.Statistic * res.Statistic)
+		}
+	}
+	res.PValue = math.Max(0, math.Min(1, res.PValue))
+	return res
+}
+
+// smirnov2CDF returns the probability under the null hypothesis that the
+// two-sample statistic of samples of sizes m and n without ties is less
+// than d.
+func smirnov2CDF(m, n int, d float64, alt Alternative) float64 {
+	fm, fn := float64(m), float64(n)
+	// The statistic is a multiple of 1/(mn), so q lies between d and the
+	// largest smaller value the statistic can take.
+	q := (0.5 + math.Floor(d*fm*fn-1e-7)) / (fm * fn)
+	outside := func(i, j int) bool {
+		diff := float64(i)/fm - float64(j)/fn
+		switch alt {
+		case Less:
+			return diff > q
+		case Greater:
+			return -diff > q
+		default:
+			return math.Abs(diff) > q
+		}
+	}
+	// u[j] is the probability of the lattice paths from (0, 0) to (i, j)
+	// staying inside the band, scaled by 1/binomial(i+j, j).
+	u := make([]float64, n+1)
+	for j := range u {
+		if !outside(0, j) {
+			u[j] = 1
+		} else {
+			// Paths along the edge cannot re-enter the band.
+			break
+		}
+	}
+	for i := 1; i <= m; i++ {
+		w := float64(i) / float64(i+n)
+		if outside(i, 0) {
+			u[0] = 0
+		} else {
+			u[0] *= w
+		}
+		for j := 1; j <= n; j++ {
+			if outside(i, j) {
+				u[j] = 0
+			} else {
+				u[j] = w*u[j] + u[j-1]
+			}
+		}
+	}
+	return u[n]
+}
@@ -0,0 +1,198 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hypothesis
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+func TestKolmogorovDistribution(t *testing.T) {
+	t.Parallel()
+	// Reference values from Marsaglia, Tsang and Wang (2003).
+	for _, test := range []struct {
+		n    int
+		d    float64
+		want float64
+		tol  float64
+	}{
+		{n: 10, d: 0.274, want: 0.6284796154565043, tol: 1e-14},
+		{n: 1, d: 0.75, want: 0.5, tol: 1e-14},
+	} {
+		got := kolmogorovCDF(test.n, test.d)
+		if !scalar.EqualWithinAbsOrRel(got, test.want, test.tol, test.tol) {
+			t.Errorf("unexpected K(%d, %v): got %v, want %v", test.n, test.d, got, test.want)
+		}
+	}
+
+	// Compare with simulation.
+	rnd := rand.New(rand.NewSource(1))
+	const trials = 20000
+	x := make([]float64, 20)
+	for _, d := range []float64{0.15, 0.25, 0.35} {
+		var count int
+		for k := 0; k < trials; k++ {
+			for i := range x {
+				x[i] = rnd.Float64()
+			}
+			if KolmogorovSmirnov(x, func(v float64) float64 { return v }, TwoSided).Statistic < d {
+				count++
+			}
+		}
+		if got, want := kolmogorovCDF(len(x), d), float64(count)/trials; math.Abs(got-want) > 0.01 {
+			t.Errorf("unexpected K(%d, %v): got %v, simulated %v", len(x), d, got, want)
+		}
+	}
+
+	// The two series of the limiting distribution agree.
+	if got, want := kolmogorovSurvival(1-1e-12), kolmogorovSurvival(1); !scalar.EqualWithinAbsOrRel(got, want, 1e-10, 1e-10) {
+		t.Errorf("limiting distribution discontinuous: got %v and %v", got, want)
+	}
+	if got, want := kolmogorovSurvival(1), 0.27; !scalar.EqualWithinAbsOrRel(got, want, 1e-6, 1e-6) {
+		t.Errorf("unexpected limiting survival at 1: got %v, want %v", got, want)
+	}
+	// The exact distribution for large samples is close to the limit.
+	n := 1000
+	for _, d := range []float64{0.02, 0.03, 0.05} {
+		got := 1 - kolmogorovCDF(n, d)
+		want := kolmogorovSurvival(math.Sqrt(float64(n)) * d)
+		if !scalar.EqualWithinAbsOrRel(got, want, 1e-2, 5e-2) {
+			t.Errorf("exact distribution far from limit at d=%v: got %v, want %v", d, got, want)
+		}
+	}
+
+	// The one-sided distribution for a single observation is uniform.
+	for _, d := range []float64{0.1, 0.5, 0.9} {
+		if got := smirnovSurvival(1, d); !scalar.EqualWithinAbsOrRel(got, 1-d, 1e-14, 1e-14) {
+			t.Errorf("unexpected P(D⁺ ≥ %v) for n=1: got %v, want %v", d, got, 1-d)
+		}
+	}
+}
+
+func TestKolmogorovSmirnov(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	cdf := distuv.UnitNormal.CDF
+
+	// Compare the one-sided p-values with simulation.
+	const trials = 20000
+	x := make([]float64, 5)
+	d := 0.4
+	var count int
+	for k := 0; k < trials; k++ {
+		for i := range x {
+			x[i] = rnd.NormFloat64()
+		}
+		if KolmogorovSmirnov(x, cdf, Less).Statistic >= d {
+			count++
+		}
+	}
+	if got, want := smirnovSurvival(len(x), d), float64(count)/trials; math.Abs(got-want) > 0.01 {
+		t.Errorf("unexpected one-sided p-value: got %v, simulated %v", got, want)
+	}
+
+	x = []float64{-0.5, 0.1, 0.4, 1.2, 2.5}
+	res := KolmogorovSmirnov(x, cdf, TwoSided)
+	var want float64
+	for i, v := range x {
+		f := cdf(v)
+		want = math.Max(want, math.Max(float64(i+1)/5-f, f-float64(i)/5))
+	}
+	if res.Statistic != want {
+		t.Errorf("unexpected statistic: got %v, want %v", res.Statistic, want)
+	}
+	if want := 1 - kolmogorovCDF(5, want); res.PValue != want {
+		t.Errorf("unexpected p-value: got %v, want %v", res.PValue, want)
+	}
+	less := KolmogorovSmirnov(x, cdf, Less)
+	greater := KolmogorovSmirnov(x, cdf, Greater)
+	if math.Max(less.Statistic, greater.Statistic) != res.Statistic {
+		t.Errorf("one-sided statistics %v and %v do not match two-sided statistic %v", less.Statistic, greater.Statistic, res.Statistic)
+	}
+
+	// A shifted sample is detected in the direction of the shift.
+	y := make([]float64, 100)
+	for i := range y {
+		y[i] = rnd.NormFloat64() + 0.5
+	}
+	if p := KolmogorovSmirnov(y, cdf, Greater).PValue; p > 0.01 {
+		t.Errorf("unexpected p-value for greater alternative: %v", p)
+	}
+	if p := KolmogorovSmirnov(y, cdf, Less).PValue; p < 0.5 {
+		t.Errorf("unexpected p-value for less alternative: %v", p)
+	}
+
+	if !panics(func() { KolmogorovSmirnov(nil, cdf, TwoSided) }) {
+		t.Error("expected panic for empty sample")
+	}
+}
+
+func TestKolmogorovSmirnov2(t *testing.T) {
+	t.Parallel()
+	// The exact p-values match those found by enumerating the assignments
+	// of the ordered pooled sample to the two samples.
+	for _, test := range []struct{ m, n int }{{1, 1}, {2, 3}, {3, 3}, {4, 2}, {5, 4}} {
+		N := test.m + test.n
+		for _, alt := range []Alternative{TwoSided, Less, Greater} {
+			var results []Result
+			for mask := 0; mask < 1<<N; mask++ {
+				if popcount(mask) != test.m {
+					continue
+				}
+				var x, y []float64
+				for k := 0; k < N; k++ {
+					if mask&(1<<k) != 0 {
+						x = append(x, float64(k))
+					} else {
+						y = append(y, float64(k))
+					}
+				}
+				results = append(results, KolmogorovSmirnov2(x, y, alt))
+			}
+			for _, res := range results {
+				var count int
+				for _, other := range results {
+					if other.Statistic >= res.Statistic-1e-12 {
+						count++
+					}
+				}
+				want := float64(count) / float64(len(results))
+				if !scalar.EqualWithinAbsOrRel(res.PValue, want, 1e-12, 1e-12) {
+					t.Errorf("m=%d n=%d alt=%d: unexpected p-value for D=%v: got %v, want %v",
+						test.m, test.n, alt, res.Statistic, res.PValue, want)
+				}
+			}
+		}
+	}
+
+	// With ties, and for large samples, the limiting distribution is used.
+	x := []float64{1, 2, 2, 3, 4}
+	y := []float64{2, 3, 5, 6, 7, 8}
+	res := KolmogorovSmirnov2(x, y, TwoSided)
+	if !scalar.EqualWithinAbsOrRel(res.Statistic, 2.0/3, 1e-14, 1e-14) {
+		t.Errorf("unexpected statistic with ties: got %v, want 2/3", res.Statistic)
+	}
+	if want := kolmogorovSurvival(math.Sqrt(30.0/11) * res.Statistic); res.PValue != want {
+		t.Errorf("unexpected p-value with ties: got %v, want %v", res.PValue, want)
+	}
+	res = KolmogorovSmirnov2(x, y, Less)
+	if want := math.Exp(-2 * 30.0 / 11 * res.Statistic * res.Statistic); !scalar.EqualWithinAbsOrRel(res.PValue, want, 1e-14, 1e-14) {
+		t.Errorf("unexpected one-sided p-value with ties: got %v, want %v", res.PValue, want)
+	}
+
+	for _, f := range []func(){
+		func() { KolmogorovSmirnov2(nil, y, TwoSided) },
+		func() { KolmogorovSmirnov2(x, y, 3) },
+	} {
+		if !panics(f) {
+			t.Error("expected panic for invalid argument")
+		}
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hypothesis
+
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// ShapiroWilk performs the Shapiro–Wilk test of the null hypothesis that x
+// is a sample from a normal distribution. The statistic is W, and its
+// p-value is computed by the approximations of Royston. The effect size is
+// NaN.
+//
+// ShapiroWilk panics if x has fewer than 3 or more than 5000 elements, or
+// if all the elements of x are equal.
+//
+// References:
+//   - Royston, P. (1992). Approximating the Shapiro–Wilk W-test for
+//     non-normality. Statistics and Computing, 2, 117–119.
+//   - Royston, P. (1995). Remark AS R94: A remark on algorithm AS 181: The
+//     W-test for normality. Applied Statistics, 44, 547–551.
+func ShapiroWilk(x []float64) Result {
+	n := len(x)
+	if n < 3 {
+		panic(tooFew)
+	}
+	if n > 5000 {
+		panic("hypothesis: too many observations")
+	}
+	s := make([]float64, n)
+	copy(s, x)
+	sort.Float64s(s)
+	if s[0] == s[n-1] {
+		panic("hypothesis: all observations equal")
+	}
+
+	// Compute the coefficients a of the first half of the ordered sample,
+	// which are negative, as in algorithm AS R94. The coefficients of the
+	// second half are their negations in reverse order.
+	half := n / 2
+	a := make([]float64, half)
+	fn := float64(n)
+	if n == 3 {
+		a[0] = -math.Sqrt(0.5)
+	} else {
+		var sum2 float64
+		for i := range a {
+			m := distuv.UnitNormal.Quantile((float64(i+1) - 0.375) / (fn + 0.25))
+			a[i] = m
+			sum2 += m * m
+		}
+		sum2 *= 2
+		ssum2 := math.Sqrt(sum2)
+		rsn := 1 / math.Sqrt(fn)
+		a0 := a[0]/ssum2 - poly(swC1[:], rsn)
+		i1 := 1
+		var fac float64
+		if n > 5 {
+			i1 = 2
+			a1 := a[1]/ssum2 - poly(swC2[:], rsn)
+			fac = math.Sqrt((sum2 - 2*a[0]*a[0] - 2*a[1]*a[1]) / (1 - 2*a0*a0 - 2*a1*a1))
+			a[1] = a1
+		} else {
+			fac = math.Sqrt((sum2 - 2*a[0]*a[0]) / (1 - 2*a0*a0))
+		}
+		a[0] = a0
+		for i := i1; i < half; i++ {
+			a[i] /= fac
+		}
+	}
+
+	// W is the squared correlation of the sample and the coefficients.
+	// The coefficients sum to zero and their squares to one.
+	var mean float64
+	for _, v := range s {
+		mean += v
+	}
+	mean /= fn
+	var sax, ssx float64
+	for i, v := range s {
+		d := v - mean
+		ssx += d * d
+		switch {
+		case i < half:
+			sax += a[i] * d
+		case n-1-i < half:
+			sax -= a[n-1-i] * d
+		}
+	}
+	var ssa float64
+	for _, v := range a {
+		ssa += 2 * v * v
+	}
+	ssassx := math.Sqrt(ssa * ssx)
+	w1 := (ssassx - sax) * (ssassx + sax) / (ssa * ssx)
+	w := 1 - w1
+
+	res := Result{
+		Statistic:  w,
+		DoF:        math.NaN(),
+		DoF2:       math.NaN(),
+		EffectSize: math.NaN(),
+	}
+	if n == 3 {
+		res.PValue = math.Max(0, 6/math.Pi*(math.Asin(math.Sqrt(w))-math.Pi/3))
+		return res
+	}
+	y := math.Log(w1)
+	var mu, sigma float64
+	if n <= 11 {
+		gamma := poly(swG[:], fn)
+		if y >= gamma {
+			res.PValue = 0
+			return res
+		}
+		y = -math.Log(gamma - y)
+		mu = poly(swC3[:], fn)
+		sigma = math.Exp(poly(swC4[:], fn))
+	} else {
+		ln := math.Log(fn)
+		mu = poly(swC5[:], ln)
+		sigma = math.Exp(poly(swC6[:], ln))
+	}
+	res.PValue = distuv.Normal{Mu: mu, Sigma: sigma}.Survival(y)
+	return res
+}
+
+// Polynomial coefficients of the Shapiro–Wilk approximations of AS R94.
+var (
+	swG  = [...]float64{-2.273, 0.459}
+	swC1 = [...]float64{0, 0.221157, -0.147981, -2.07119, 4.434685, -2.706056}
+	swC2 = [...]float64{0, 0.042981, -0.293762, -1.752461, 5.682633, -3.582633}
+	swC3 = [...]float64{0.544, -0.39978, 0.025054, -6.714e-4}
+	swC4 = [...]float64{1.3822, -0.77857, 0.062767, -0.0020322}
+	swC5 = [...]float64{-1.5861, -0.31082, -0.083751, 0.0038915}
+	swC6 = [...]float64{-0.4803, -0.082676, 0.0030302}
+)
+
+// poly returns the value at x of the polynomial with coefficients c in
+// order of increasing degree.
+func poly(c []float64, x float64) float64 {
+	var v float64
+	for i := len(c) - 1; i >= 0; i-- {
+		v = v*x + c[i]
+	}
+	return v
+}
+
+// AndersonDarling performs the Anderson–Darling test of the null hypothesis
+// that x is a sample from a normal distribution with unknown mean and
+// variance. The statistic is A², and its p-value is computed from the
+// approximations of D'Agostino and Stephens for the statistic adjusted
+// for the sample size, A²(1 + 0.75/n + 2.25/n²). The effect size is NaN.
+//
+// AndersonDarling panics if x has fewer than 8 elements or if all the
+// elements of x are equal.
+//
+// References:
+//   - D'Agostino, R. B. and Stephens, M. A. (1986). Goodness-of-Fit
+//     Techniques. Marcel Dekker, New York. Table 4.9.
+func AndersonDarling(x []float64) Result {
+	n := len(x)
+	if n < 8 {
+		panic(tooFew)
+	}
+	s := make([]float64, n)
+	copy(s, x)
+	sort.Float64s(s)
+	if s[0] == s[n-1] {
+		panic("hypothesis: all observations equal")
+	}
+	mean, variance := meanVar(s)
+	sd := math.Sqrt(variance)
+	fn := float64(n)
+	var sum float64
+	for i, v := range s {
+		lo := logNormalCDF((v - mean) / sd)
+		hi := logNormalCDF(-(s[n-1-i] - mean) / sd)
+		sum += float64(2*i+1) * (lo + hi)
+	}
+	a2 := -fn - sum/fn
+
+	z := a2 * (1 + 0.75/fn + 2.25/(fn*fn))
+	var p float64
+	switch {
+	case z < 0.2:
+		p = -math.Expm1(-13.436 + 101.14*z - 223.73*z*z)
+	case z < 0.34:
+		p = -math.Expm1(-8.318 + 42.796*z - 59.938*z*z)
+	case z < 0.6:
+		p = math.Exp(0.9177 - 4.279*z - 1.38*z*z)
+	case z < 10:
+		p = math.Exp(1.2937 - 5.709*z + 0.0186*z*z)
+	default:
+		p = 3.7e-24
+	}
+	return Result{
+		Statistic:  a2,
+		PValue:     p,
+		DoF:        math.NaN(),
+		DoF2:       math.NaN(),
+		EffectSize: math.NaN(),
+	}
+}
+
+// logNormalCDF returns the logarithm of the distribution function of the
+// standard normal distribution at z.
+func logNormalCDF(z float64) float64 {
+	return math.Log(0.5 * math.Erfc(-z/math.Sqrt2))
+}
@@ -0,0 +1,131 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hypothesis
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+func TestShapiroWilk(t *testing.T) {
+	t.Parallel()
+	nan := math.NaN()
+	// Reference values from R's shapiro.test.
+	checkResults(t, []resultTest{
+		{
+			name: "n=3",
+			got:  ShapiroWilk([]float64{4, 1, 2}),
+			want: Result{Statistic: 0.9642857, PValue: 0.6368868, DoF: nan, DoF2: nan, EffectSize: nan},
+			tol:  1e-6,
+		},
+		{
+			name: "n=11",
+			got:  ShapiroWilk([]float64{148, 154, 158, 160, 161, 162, 166, 170, 182, 195, 236}),
+			want: Result{Statistic: 0.7888147, PValue: 0.006703814, DoF: nan, DoF2: nan, EffectSize: nan},
+			tol:  1e-6,
+		},
+	})
+
+	testNormalityPValues(t, "ShapiroWilk", ShapiroWilk)
+
+	for _, f := range []func(){
+		func() { ShapiroWilk([]float64{1, 2}) },
+		func() { ShapiroWilk([]float64{1, 1, 1, 1}) },
+		func() { ShapiroWilk(make([]float64, 5001)) },
+	} {
+		if !panics(f) {
+			t.Error("expected panic for invalid argument")
+		}
+	}
+}
+
+func TestAndersonDarling(t *testing.T) {
+	t.Parallel()
+	x := []float64{148, 154, 158, 160, 161, 162, 166, 170, 182, 195, 236}
+	res := AndersonDarling(x)
+
+	// Compute A² directly from its definition.
+	s := append([]float64(nil), x...)
+	sort.Float64s(s)
+	var mean, ss float64
+	for _, v := range s {
+		mean += v
+	}
+	mean /= float64(len(s))
+	for _, v := range s {
+		ss += (v - mean) * (v - mean)
+	}
+	dist := distuv.Normal{Mu: mean, Sigma: math.Sqrt(ss / float64(len(s)-1))}
+	n := float64(len(s))
+	var sum float64
+	for i, v := range s {
+		sum += float64(2*i+1) * (math.Log(dist.CDF(v)) + math.Log(1-dist.CDF(s[len(s)-1-i])))
+	}
+	want := -n - sum/n
+	if !scalar.EqualWithinAbsOrRel(res.Statistic, want, 1e-12, 1e-12) {
+		t.Errorf("unexpected statistic: got %v, want %v", res.Statistic, want)
+	}
+	z := want * (1 + 0.75/n + 2.25/(n*n))
+	wantP := math.Exp(1.2937 - 5.709*z + 0.0186*z*z)
+	if !scalar.EqualWithinAbsOrRel(res.PValue, wantP, 1e-12, 1e-12) {
+		t.Errorf("unexpected p-value: got %v, want %v", res.PValue, wantP)
+	}
+
+	testNormalityPValues(t, "AndersonDarling", AndersonDarling)
+
+	for _, f := range []func(){
+		func() { AndersonDarling([]float64{1, 2, 3, 4, 5, 6, 7}) },
+		func() { AndersonDarling(make([]float64, 10)) },
+	} {
+		if !panics(f) {
+			t.Error("expected panic for invalid argument")
+		}
+	}
+}
+
+// testNormalityPValues checks that the p-values of a normality test are
+// approximately uniform for normal samples and small for exponential
+// samples.
+func testNormalityPValues(t *testing.T, name string, test func([]float64) Result) {
+	t.Helper()
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{10, 30, 200} {
+		const trials = 2000
+		var below5, below50 int
+		x := make([]float64, n)
+		for k := 0; k < trials; k++ {
+			for i := range x {
+				x[i] = 3*rnd.NormFloat64() + 10
+			}
+			p := test(x).PValue
+			if p < 0.05 {
+				below5++
+			}
+			if p < 0.5 {
+				below50++
+			}
+		}
+		if f := float64(below5) / trials; math.Abs(f-0.05) > 0.015 {
+			t.Errorf("%s: n=%d: unexpected fraction of p-values below 0.05: %v", name, n, f)
+		}
+		if f := float64(below50) / trials; math.Abs(f-0.5) > 0.05 {
+			t.Errorf("%s: n=%d: unexpected fraction of p-values below 0.5: %v", name, n, f)
+		}
+	}
+
+	x := make([]float64, 100)
+	for i := range x {
+		x[i] = rnd.ExpFloat64()
+	}
+	if p := test(x).PValue; p > 1e-3 {
+		t.Errorf("%s: unexpected p-value for exponential sample: %v", name, p)
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hypothesis
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// exactLimit is the sample size below which the rank tests compute exact
+// p-values when there are no ties.
+const exactLimit = 50
+
+// MannWhitneyU performs the Mann–Whitney U test, also known as the Wilcoxon
+// rank-sum test, of the null hypothesis that the distributions of x and y
+// are equal. The statistic is the U statistic of x, the number of pairs of
+// an element of x and an element of y in which the element of x is the
+// greater, with ties counted as one half. The effect size is the
+// rank-biserial correlation, 2U/(mn) - 1, where m and n are the lengths of
+// x and y.
+//
+// The p-value is exact if both samples have fewer than 50 elements and
+// there are no ties. Otherwise it is computed from the normal approximation
+// of the distribution of U with corrections for ties and continuity.
+//
+// MannWhitneyU panics if x or y is empty.
+func MannWhitneyU(x, y []float64, alt Alternative) Result {
+	if len(x) == 0 || len(y) == 0 {
+		panic(tooFew)
+	}
+	m, n := len(x), len(y)
+	all := make([]float64, 0, m+n)
+	all = append(all, x...)
+	all = append(all, y...)
+	r, ties := ranks(all)
+	var sum float64
+	for _, v := range r[:m] {
+		sum += v
+	}
+	fm, fn := float64(m), float64(n)
+	u := sum - fm*(fm+1)/2
+
+	var lower, upper float64
+	if m < exactLimit && n < exactLimit && ties == 0 {
+		lower, upper = mannWhitneyExact(m, n, int(u))
+	} else {
+		mean := fm * fn / 2
+		N := fm + fn
+		sd := math.Sqrt(fm * fn / 12 * (N + 1 - ties/(N*(N-1))))
+		lower = distuv.UnitNormal.CDF((u - mean + 0.5) / sd)
+		upper = distuv.UnitNormal.Survival((u - mean - 0.5) / sd)
+	}
+	return Result{
+		Statistic:  u,
+		PValue:     pValue(lower, upper, alt),
+		DoF:        math.NaN(),
+		DoF2:       math.NaN(),
+		EffectSize: 2*u/(fm*fn) - 1,
+	}
+}
+
+// mannWhitneyExact returns P(U ≤ u) and P(U ≥ u) for the U statistic of
+// samples of sizes m and n without ties.
+func mannWhitneyExact(m, n, u int) (lower, upper float64) {
+	// c[j][v] is the probability that j of the first k elements of the
+	// ordered pooled sample belong to the first sample with v pairs in
+	// which they are the greater. The probabilities are accumulated
+	// without the common factor 1/binomial(m+n, m).
+	c := make([][]float64, m+1)
+	for j := range c {
+		c[j] = make([]float64, m*n+1)
+	}
+	c[0][0] = 1
+	for k := 0; k < m+n; k++ {
+		for j := min(k, m-1); j >= 0; j-- {
+			// The element k belongs to the first sample and is greater
+			// than the k-j elements of the second sample before it.
+			d := k - j
+			if d > n {
+				continue
+			}
+			src, dst := c[j], c[j+1]
+			for v := len(src) - 1 - d; v >= 0; v-- {
+				dst[v+d] += src[v]
+			}
+		}
+	}
+	var total float64
+	for v, p := range c[m] {
+		total += p
+		if v <= u {
+			lower += p
+		}
+		if v >= u {
+			upper += p
+		}
+	}
+	return lower / total, upper / total
+}
+
+// WilcoxonSignedRank performs the Wilcoxon signed-rank test of the null
+// hypothesis that the distribution of the differences x-y is symmetric
+// about zero. If y is nil the differences are the elements of x. Zero
+// differences are discarded. The statistic is the sum W⁺ of the ranks of
+// the absolute values of the positive differences. The effect size is the
+// matched-pairs rank-biserial correlation, 2W⁺/S - 1, where S = n(n+1)/2 is
+// the sum of all the ranks of the n non-zero differences.
+//
+// The p-value is exact if there are fewer than 50 non-zero differences and
+// no ties. Otherwise it is computed from the normal approximation of the
+// distribution of W⁺ with corrections for ties and continuity. If all the
+// differences are zero, the p-value and the effect size are NaN.
+//
+// WilcoxonSignedRank panics if y is not nil and its length differs from
+// that of x, or if x is empty.
+func WilcoxonSignedRank(x, y []float64, alt Alternative) Result {
+	if y != nil && len(x) != len(y) {
+		panic(badLength)
+	}
+	if len(x) == 0 {
+		panic(tooFew)
+	}
+	var d, abs []float64
+	for i, v := range x {
+		if y != nil {
+			v -= y[i]
+		}
+		if v == 0 {
+			continue
+		}
+		d = append(d, v)
+		abs = append(abs, math.Abs(v))
+	}
+	n := len(d)
+	if n == 0 {
+		return Result{
+			Statistic:  0,
+			PValue:     math.NaN(),
+			DoF:        math.NaN(),
+			DoF2:       math.NaN(),
+			EffectSize: math.NaN(),
+		}
+	}
+	r, ties := ranks(abs)
+	var w float64
+	for i, v := range d {
+		if v > 0 {
+			w += r[i]
+		}
+	}
+	fn := float64(n)
+	s := fn * (fn + 1) / 2
+
+	var lower, upper float64
+	if n < exactLimit && ties == 0 {
+		lower, upper = signedRankExact(n, int(w))
+	} else {
+		mean := s / 2
+		sd := math.Sqrt(fn*(fn+1)*(2*fn+1)/24 - ties/48)
+		lower = distuv.UnitNormal.CDF((w - mean + 0.5) / sd)
+		upper = distuv.UnitNormal.Survival((w - mean - 0.5) / sd)
+	}
+	return Result{
+		Statistic:  w,
+		PValue:     pValue(lower, upper, alt),
+		DoF:        math.NaN(),
+		DoF2:       math.NaN(),
+		EffectSize: 2*w/s - 1,
+	}
+}
+
+// signedRankExact returns P(W ≤ w) and P(W ≥ w) for the signed-rank
+// statistic of n differences without ties.
+func signedRankExact(n, w int) (lower, upper float64) {
+	// c[v] is the number of subsets of {1, ..., k} summing to v.
+	c := make([]float64, n*(n+1)/2+1)
+	c[0] = 1
+	for k := 1; k <= n; k++ {
+		for v := k * (k + 1) / 2; v >= k; v-- {
+			c[v] += c[v-k]
+		}
+	}
+	var total float64
+	for v, p := range c {
+		total += p
+		if v <= w {
+			lower += p
+		}
+		if v >= w {
+			upper += p
+		}
+	}
+	return lower / total, upper / total
+}
+
+// KruskalWallis performs the Kruskal–Wallis H test of the null hypothesis
+// that the distributions of the groups are equal. The statistic is the H
+// statistic corrected for ties, and its p-value is computed from the
+// chi-squared distribution with k-1 degrees of freedom, where k is the
+// number of groups. The effect size is ε² = H/(N-1), where N is the total
+// number of observations.
+//
+// KruskalWallis panics if there are fewer than two groups or if a group is
+// empty.
+func KruskalWallis(groups ...[]float64) Result {
+	k := len(groups)
+	if k < 2 {
+		panic(tooFew)
+	}
+	var all []float64
+	for _, g := range groups {
+		if len(g) == 0 {
+			panic(tooFew)
+		}
+		all = append(all, g...)
+	}
+	r, ties := ranks(all)
+	N := float64(len(all))
+	var h float64
+	for _, g := range groups {
+		var sum float64
+		for _, v := range r[:len(g)] {
+			sum += v
+		}
+		r = r[len(g):]
+		h += sum * sum / float64(len(g))
+	}
+	h = 12/(N*(N+1))*h - 3*(N+1)
+	h /= 1 - ties/(N*N*N-N)
+	dof := float64(k - 1)
+	return Result{
+		Statistic:  h,
+		PValue:     distuv.ChiSquared{K: dof}.Survival(h),
+		DoF:        dof,
+		DoF2:       math.NaN(),
+		EffectSize: h / (N - 1),
+	}
+}
@@ -0,0 +1,227 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hypothesis
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestMannWhitneyU(t *testing.T) {
+	t.Parallel()
+	nan := math.NaN()
+	x := []float64{0.80, 0.83, 1.89, 1.04, 1.45, 1.38, 1.91, 1.64, 0.73, 1.46}
+	y := []float64{1.15, 0.88, 0.90, 0.74, 1.21}
+	// Reference values from R's wilcox.test.
+	checkResults(t, []resultTest{
+		{
+			name: "greater",
+			got:  MannWhitneyU(x, y, Greater),
+			want: Result{Statistic: 35, PValue: 0.1272061, DoF: nan, DoF2: nan, EffectSize: 2*35.0/50 - 1},
+			tol:  1e-6,
+		},
+		{
+			name: "two-sided",
+			got:  MannWhitneyU(x, y, TwoSided),
+			want: Result{Statistic: 35, PValue: 0.2544122, DoF: nan, DoF2: nan, EffectSize: 2*35.0/50 - 1},
+			tol:  1e-6,
+		},
+		{
+			name: "less",
+			got:  MannWhitneyU(y, x, Less),
+			want: Result{Statistic: 15, PValue: 0.1272061, DoF: nan, DoF2: nan, EffectSize: 2*15.0/50 - 1},
+			tol:  1e-6,
+		},
+	})
+
+	// The exact distribution of U matches that found by enumerating the
+	// assignments of the ranks to the samples.
+	for _, test := range []struct{ m, n int }{{1, 1}, {1, 4}, {3, 2}, {4, 4}, {5, 3}} {
+		count := make([]int, test.m*test.n+1)
+		var total int
+		N := test.m + test.n
+		for mask := 0; mask < 1<<N; mask++ {
+			if popcount(mask) != test.m {
+				continue
+			}
+			var u, below int
+			for k := 0; k < N; k++ {
+				if mask&(1<<k) != 0 {
+					u += below
+				} else {
+					below++
+				}
+			}
+			count[u]++
+			total++
+		}
+		var cum int
+		for u := range count {
+			cum += count[u]
+			lower, upper := mannWhitneyExact(test.m, test.n, u)
+			if !scalar.EqualWithinAbsOrRel(lower, float64(cum)/float64(total), 1e-14, 1e-14) {
+				t.Errorf("m=%d n=%d: unexpected P(U ≤ %d): got %v, want %v", test.m, test.n, u, lower, float64(cum)/float64(total))
+			}
+			want := float64(total-cum+count[u]) / float64(total)
+			if !scalar.EqualWithinAbsOrRel(upper, want, 1e-14, 1e-14) {
+				t.Errorf("m=%d n=%d: unexpected P(U ≥ %d): got %v, want %v", test.m, test.n, u, upper, want)
+			}
+		}
+	}
+
+	// The normal approximation used for large samples is close to the
+	// exact distribution.
+	rnd := rand.New(rand.NewSource(1))
+	a := make([]float64, 60)
+	b := make([]float64, 55)
+	for i := range a {
+		a[i] = rnd.NormFloat64() + 0.3
+	}
+	for i := range b {
+		b[i] = rnd.NormFloat64()
+	}
+	res := MannWhitneyU(a, b, Greater)
+	_, want := mannWhitneyExact(len(a), len(b), int(res.Statistic))
+	if !scalar.EqualWithinAbsOrRel(res.PValue, want, 1e-3, 2e-2) {
+		t.Errorf("unexpected approximate p-value: got %v, want %v", res.PValue, want)
+	}
+
+	if !panics(func() { MannWhitneyU(nil, y, TwoSided) }) {
+		t.Error("expected panic for empty sample")
+	}
+}
+
+func TestWilcoxonSignedRank(t *testing.T) {
+	t.Parallel()
+	nan := math.NaN()
+	x := []float64{1.83, 0.50, 1.62, 2.48, 1.68, 1.88, 1.55, 3.06, 1.30}
+	y := []float64{0.878, 0.647, 0.598, 2.05, 1.06, 1.29, 1.06, 3.14, 1.29}
+	d := make([]float64, len(x))
+	for i := range d {
+		d[i] = x[i] - y[i]
+	}
+	// Reference values from R's wilcox.test.
+	checkResults(t, []resultTest{
+		{
+			name: "greater",
+			got:  WilcoxonSignedRank(x, y, Greater),
+			want: Result{Statistic: 40, PValue: 0.01953125, DoF: nan, DoF2: nan, EffectSize: 2*40.0/45 - 1},
+			tol:  1e-12,
+		},
+		{
+			name: "two-sided",
+			got:  WilcoxonSignedRank(x, y, TwoSided),
+			want: Result{Statistic: 40, PValue: 0.0390625, DoF: nan, DoF2: nan, EffectSize: 2*40.0/45 - 1},
+			tol:  1e-12,
+		},
+		{
+			name: "differences",
+			got:  WilcoxonSignedRank(d, nil, Greater),
+			want: Result{Statistic: 40, PValue: 0.01953125, DoF: nan, DoF2: nan, EffectSize: 2*40.0/45 - 1},
+			tol:  1e-12,
+		},
+		{
+			// Zero differences are discarded.
+			name: "zeros",
+			got:  WilcoxonSignedRank(append([]float64{0, 0}, d...), nil, Less),
+			want: Result{Statistic: 40, PValue: 1 - 7.0/512, DoF: nan, DoF2: nan, EffectSize: 2*40.0/45 - 1},
+			tol:  1e-12,
+		},
+	})
+
+	// The exact distribution of W⁺ matches that found by enumerating the
+	// signs of the ranks.
+	for n := 1; n <= 10; n++ {
+		count := make([]int, n*(n+1)/2+1)
+		for mask := 0; mask < 1<<n; mask++ {
+			var w int
+			for k := 0; k < n; k++ {
+				if mask&(1<<k) != 0 {
+					w += k + 1
+				}
+			}
+			count[w]++
+		}
+		var cum int
+		for w := range count {
+			cum += count[w]
+			lower, _ := signedRankExact(n, w)
+			want := float64(cum) / float64(int(1)<<n)
+			if !scalar.EqualWithinAbsOrRel(lower, want, 1e-14, 1e-14) {
+				t.Errorf("n=%d: unexpected P(W ≤ %d): got %v, want %v", n, w, lower, want)
+			}
+		}
+	}
+
+	res := WilcoxonSignedRank([]float64{1, 2}, []float64{1, 2}, TwoSided)
+	if !math.IsNaN(res.PValue) {
+		t.Errorf("unexpected p-value for zero differences: got %v, want NaN", res.PValue)
+	}
+	for _, f := range []func(){
+		func() { WilcoxonSignedRank(nil, nil, TwoSided) },
+		func() { WilcoxonSignedRank(x, y[1:], TwoSided) },
+	} {
+		if !panics(f) {
+			t.Error("expected panic for invalid argument")
+		}
+	}
+}
+
+func TestKruskalWallis(t *testing.T) {
+	t.Parallel()
+	nan := math.NaN()
+	// Reference values from R's kruskal.test.
+	a := []float64{2.9, 3.0, 2.5, 2.6, 3.2}
+	b := []float64{3.8, 2.7, 4.0, 2.4}
+	c := []float64{2.8, 3.4, 3.7, 2.2, 2.0}
+	checkResults(t, []resultTest{
+		{
+			name: "no ties",
+			got:  KruskalWallis(a, b, c),
+			want: Result{Statistic: 0.7714286, PValue: 0.6799648, DoF: 2, DoF2: nan, EffectSize: 0.7714286 / 13},
+			tol:  1e-6,
+		},
+	})
+
+	// With two groups and no ties H is the square of the uncorrected
+	// normal approximation of the Mann–Whitney statistic.
+	u := MannWhitneyU(a, b, TwoSided).Statistic
+	m, n := float64(len(a)), float64(len(b))
+	z := (u - m*n/2) / math.Sqrt(m*n*(m+n+1)/12)
+	res := KruskalWallis(a, b)
+	if !scalar.EqualWithinAbsOrRel(res.Statistic, z*z, 1e-12, 1e-12) {
+		t.Errorf("unexpected statistic for two groups: got %v, want %v", res.Statistic, z*z)
+	}
+
+	// The tie correction scales H.
+	tied := KruskalWallis([]float64{1, 1, 2}, []float64{2, 3, 3})
+	// Ranks are 1.5, 1.5, 3.5 and 3.5, 5.5, 5.5 with three pairs of ties.
+	h := 12.0/42*(6.5*6.5/3+14.5*14.5/3) - 21
+	h /= 1 - 18.0/210
+	if !scalar.EqualWithinAbsOrRel(tied.Statistic, h, 1e-12, 1e-12) {
+		t.Errorf("unexpected statistic with ties: got %v, want %v", tied.Statistic, h)
+	}
+
+	for _, f := range []func(){
+		func() { KruskalWallis(a) },
+		func() { KruskalWallis(a, nil) },
+	} {
+		if !panics(f) {
+			t.Error("expected panic for invalid argument")
+		}
+	}
+}
+
+func popcount(v int) int {
+	var n int
+	for ; v != 0; v &= v - 1 {
+		n++
+	}
+	return n
+}
@@ -0,0 +1,148 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hypothesis
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// TTest performs the one-sample Student's t-test of the null hypothesis
+// that the mean of the normal distribution of x is mu. The effect size is
+// Cohen's d, (mean(x) - mu)/sd(x).
+//
+// TTest panics if x has fewer than two elements.
+func TTest(x []float64, mu float64, alt Alternative) Result {
+	if len(x) < 2 {
+		panic(tooFew)
+	}
+	mean, variance := meanVar(x)
+	sd := math.Sqrt(variance)
+	n := float64(len(x))
+	t := (mean - mu) / (sd / math.Sqrt(n))
+	return tResult(t, n-1, (mean-mu)/sd, alt)
+}
+
+// TwoSampleTTest performs the two-sample Student's t-test of the null
+// hypothesis that the means of the normal distributions of x and y with
+// equal variances are equal. The effect size is Cohen's d, the difference
+// of the means divided by the pooled standard deviation.
+//
+// TwoSampleTTest panics if x or y has fewer than two elements.
+func TwoSampleTTest(x, y []float64, alt Alternative) Result {
+	if len(x) < 2 || len(y) < 2 {
+		panic(tooFew)
+	}
+	mx, vx := meanVar(x)
+	my, vy := meanVar(y)
+	nx, ny := float64(len(x)), float64(len(y))
+	dof := nx + ny - 2
+	pooled := math.Sqrt(((nx-1)*vx + (ny-1)*vy) / dof)
+	t := (mx - my) / (pooled * math.Sqrt(1/nx+1/ny))
+	return tResult(t, dof, (mx-my)/pooled, alt)
+}
+
+// WelchTTest performs Welch's t-test of the null hypothesis that the means
+// of the normal distributions of x and y are equal, without assuming equal
+// variances. The degrees of freedom are given by the Welch–Satterthwaite
+// equation. The effect size is the difference of the means divided by the
+// root mean square of the standard deviations.
+//
+// WelchTTest panics if x or y has fewer than two elements.
+func WelchTTest(x, y []float64, alt Alternative) Result {
+	if len(x) < 2 || len(y) < 2 {
+		panic(tooFew)
+	}
+	mx, vx := meanVar(x)
+	my, vy := meanVar(y)
+	nx, ny := float64(len(x)), float64(len(y))
+	sx, sy := vx/nx, vy/ny
+	t := (mx - my) / math.Sqrt(sx+sy)
+	dof := (sx + sy) * (sx + sy) / (sx*sx/(nx-1) + sy*sy/(ny-1))
+	return tResult(t, dof, (mx-my)/math.Sqrt((vx+vy)/2), alt)
+}
+
+// PairedTTest performs the paired Student's t-test of the null hypothesis
+// that the mean of the normal distribution of the differences x-y is zero.
+// The effect size is Cohen's d of the differences, mean(x-y)/sd(x-y).
+//
+// PairedTTest panics if the lengths of x and y differ or if they have
+// fewer than two elements.
+func PairedTTest(x, y []float64, alt Alternative) Result {
+	if len(x) != len(y) {
+		panic(badLength)
+	}
+	d := make([]float64, len(x))
+	for i, v := range x {
+		d[i] = v - y[i]
+	}
+	return TTest(d, 0, alt)
+}
+
+// tResult returns the Result of the t statistic t with dof degrees of
+// freedom.
+func tResult(t, dof, effect float64, alt Alternative) Result {
+	dist := distuv.StudentsT{Mu: 0, Sigma: 1, Nu: dof}
+	return Result{
+		Statistic:  t,
+		PValue:     pValue(dist.CDF(t), dist.Survival(t), alt),
+		DoF:        dof,
+		DoF2:       math.NaN(),
+		EffectSize: effect,
+	}
+}
+
+// OneWayANOVA performs the one-way analysis of variance of the null
+// hypothesis that the means of the normal distributions of the groups with
+// equal variances are equal. The statistic is the F statistic with k-1 and
+// N-k degrees of freedom, where k is the number of groups and N the total
+// number of observations. The effect size is η², the ratio of the sum of
+// squares between the groups to the total sum of squares.
+//
+// OneWayANOVA panics if there are fewer than two groups, if a group is
+// empty, or if N is not greater than k.
+func OneWayANOVA(groups ...[]float64) Result {
+	k := len(groups)
+	if k < 2 {
+		panic(tooFew)
+	}
+	var n int
+	var total float64
+	for _, g := range groups {
+		if len(g) == 0 {
+			panic(tooFew)
+		}
+		n += len(g)
+		for _, v := range g {
+			total += v
+		}
+	}
+	if n <= k {
+		panic(tooFew)
+	}
+	grand := total / float64(n)
+	var between, within float64
+	for _, g := range groups {
+		var mean float64
+		for _, v := range g {
+			mean += v
+		}
+		mean /= float64(len(g))
+		between += float64(len(g)) * (mean - grand) * (mean - grand)
+		for _, v := range g {
+			within += (v - mean) * (v - mean)
+		}
+	}
+	d1, d2 := float64(k-1), float64(n-k)
+	f := (between / d1) / (within / d2)
+	return Result{
+		Statistic:  f,
+		PValue:     distuv.F{D1: d1, D2: d2}.Survival(f),
+		DoF:        d1,
+		DoF2:       d2,
+		EffectSize: between / (between + within),
+	}
+}
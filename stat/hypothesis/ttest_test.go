@@ -0,0 +1,162 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hypothesis
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+// Student's sleep data.
+var (
+	sleep1 = []float64{0.7, -1.6, -0.2, -1.2, -0.1, 3.4, 3.7, 0.8, 0.0, 2.0}
+	sleep2 = []float64{1.9, 0.8, 1.1, 0.1, -0.1, 4.4, 5.5, 1.6, 4.6, 3.4}
+)
+
+type resultTest struct {
+	name string
+	got  Result
+	want Result
+	tol  float64
+}
+
+// checkResults checks the fields of the results that are not NaN in want.
+func checkResults(t *testing.T, tests []resultTest) {
+	t.Helper()
+	for _, test := range tests {
+		for _, f := range []struct {
+			field     string
+			got, want float64
+		}{
+			{"statistic", test.got.Statistic, test.want.Statistic},
+			{"p-value", test.got.PValue, test.want.PValue},
+			{"degrees of freedom", test.got.DoF, test.want.DoF},
+			{"denominator degrees of freedom", test.got.DoF2, test.want.DoF2},
+			{"effect size", test.got.EffectSize, test.want.EffectSize},
+		} {
+			if math.IsNaN(f.want) {
+				if !math.IsNaN(f.got) {
+					t.Errorf("%s: unexpected %s: got %v, want NaN", test.name, f.field, f.got)
+				}
+				continue
+			}
+			if !scalar.EqualWithinAbsOrRel(f.got, f.want, test.tol, test.tol) {
+				t.Errorf("%s: unexpected %s: got %v, want %v", test.name, f.field, f.got, f.want)
+			}
+		}
+	}
+}
+
+func TestTTest(t *testing.T) {
+	t.Parallel()
+	nan := math.NaN()
+	d := make([]float64, len(sleep1))
+	for i := range d {
+		d[i] = sleep1[i] - sleep2[i]
+	}
+	paired := PairedTTest(sleep1, sleep2, TwoSided)
+	// Reference values from R's t.test.
+	checkResults(t, []resultTest{
+		{
+			name: "Welch",
+			got:  WelchTTest(sleep1, sleep2, TwoSided),
+			want: Result{Statistic: -1.860813, PValue: 0.07939414, DoF: 17.77647, DoF2: nan, EffectSize: -1.860813 * math.Sqrt(0.2)},
+			tol:  1e-6,
+		},
+		{
+			name: "pooled",
+			got:  TwoSampleTTest(sleep1, sleep2, TwoSided),
+			want: Result{Statistic: -1.860813, PValue: 0.07918671, DoF: 18, DoF2: nan, EffectSize: -1.860813 * math.Sqrt(0.2)},
+			tol:  1e-6,
+		},
+		{
+			name: "pooled less",
+			got:  TwoSampleTTest(sleep1, sleep2, Less),
+			want: Result{Statistic: -1.860813, PValue: 0.07918671 / 2, DoF: 18, DoF2: nan, EffectSize: -1.860813 * math.Sqrt(0.2)},
+			tol:  1e-6,
+		},
+		{
+			name: "paired",
+			got:  paired,
+			want: Result{Statistic: -4.062128, PValue: 0.002832890, DoF: 9, DoF2: nan, EffectSize: -4.062128 / math.Sqrt(10)},
+			tol:  1e-6,
+		},
+		{
+			name: "one-sample",
+			got:  TTest(d, 0, Greater),
+			want: Result{Statistic: paired.Statistic, PValue: 1 - paired.PValue/2, DoF: 9, DoF2: nan, EffectSize: paired.EffectSize},
+			tol:  1e-12,
+		},
+		{
+			name: "one-sample mu",
+			got:  TTest(sleep1, -0.5, TwoSided),
+			want: Result{Statistic: 2.209517, PValue: 0.054488, DoF: 9, DoF2: nan, EffectSize: 2.209517 / math.Sqrt(10)},
+			tol:  1e-4,
+		},
+	})
+
+	for _, f := range []func(){
+		func() { TTest([]float64{1}, 0, TwoSided) },
+		func() { WelchTTest(sleep1, []float64{1}, TwoSided) },
+		func() { PairedTTest(sleep1, sleep2[1:], TwoSided) },
+		func() { TTest(sleep1, 0, 5) },
+	} {
+		if !panics(f) {
+			t.Error("expected panic for invalid argument")
+		}
+	}
+}
+
+func TestOneWayANOVA(t *testing.T) {
+	t.Parallel()
+	// PlantGrowth data, with reference values from R's anova.
+	ctrl := []float64{4.17, 5.58, 5.18, 6.11, 4.50, 4.61, 5.17, 4.53, 5.33, 5.14}
+	trt1 := []float64{4.81, 4.17, 4.41, 3.59, 5.87, 3.83, 6.03, 4.89, 4.32, 4.69}
+	trt2 := []float64{6.31, 5.12, 5.54, 5.50, 5.37, 5.29, 4.92, 6.15, 5.80, 5.26}
+	checkResults(t, []resultTest{
+		{
+			name: "PlantGrowth",
+			got:  OneWayANOVA(ctrl, trt1, trt2),
+			want: Result{Statistic: 4.846088, PValue: 0.01590996, DoF: 2, DoF2: 27, EffectSize: 3.76634 / (3.76634 + 10.49209)},
+			tol:  1e-5,
+		},
+	})
+
+	// With two groups the F statistic is the square of the pooled t
+	// statistic.
+	f := OneWayANOVA(sleep1, sleep2)
+	tt := TwoSampleTTest(sleep1, sleep2, TwoSided)
+	checkResults(t, []resultTest{
+		{
+			name: "two groups",
+			got:  f,
+			want: Result{Statistic: tt.Statistic * tt.Statistic, PValue: tt.PValue, DoF: 1, DoF2: 18, EffectSize: tt.Statistic * tt.Statistic / (tt.Statistic*tt.Statistic + 18)},
+			tol:  1e-10,
+		},
+	})
+
+	for _, f := range []func(){
+		func() { OneWayANOVA(ctrl) },
+		func() { OneWayANOVA(ctrl, nil) },
+		func() { OneWayANOVA([]float64{1}, []float64{2}) },
+	} {
+		if !panics(f) {
+			t.Error("expected panic for invalid argument")
+		}
+	}
+}
+
+func panics(f func()) (b bool) {
+	defer func() {
+		err := recover()
+		if err != nil {
+			b = true
+		}
+	}()
+	f()
+	return
+}
@@ -0,0 +1,17 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package regression provides linear regression by least squares and
+// generalized linear models.
+//
+// A generalized linear model relates the mean μ of the response to the
+// linear predictor η = x^T β of the predictors x through a Link,
+// g(μ) = η, and the response has a distribution of an exponential Family
+// with the variance V(μ)φ/w, where φ is the dispersion and w the prior
+// weight of the observation. The coefficients β are fitted by iteratively
+// reweighted least squares.
+//
+// See McCullagh, P., Nelder, J. A.: Generalized Linear Models, 2nd edition.
+// Chapman & Hall (1989) for an introduction.
+package regression // import "gonum.org/v1/gonum/stat/regression"
@@ -0,0 +1,193 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package regression
+
+import (
+	"math"
+)
+
+// Family is the distribution of the response of a generalized linear model,
+// a member of the exponential family with the mean μ, the dispersion φ and
+// the prior weight w of an observation.
+type Family interface {
+	// Variance returns the variance function V(μ). The variance of an
+	// observation is V(μ)φ/w.
+	Variance(mu float64) float64
+
+	// UnitDeviance returns the unit deviance d(y, μ), twice the difference
+	// of the log likelihoods of the observation y with the mean y and
+	// with the mean μ when φ/w is one.
+	UnitDeviance(y, mu float64) float64
+
+	// LogProb returns the log probability, or log density, of the
+	// observation y with the mean μ, the dispersion φ and the prior weight
+	// w.
+	LogProb(y, mu, dispersion, weight float64) float64
+
+	// CanonicalLink returns the canonical link of the family.
+	CanonicalLink() Link
+
+	// InitialMean returns the starting value of the mean of the
+	// observation y with the prior weight w in the fitting.
+	InitialMean(y, weight float64) float64
+
+	// ValidMean returns whether μ is a valid mean of the family.
+	ValidMean(mu float64) bool
+
+	// FixedDispersion returns whether the dispersion of the family is
+	// fixed to one. Otherwise the dispersion is estimated.
+	FixedDispersion() bool
+}
+
+var (
+	_ Family = Gaussian{}
+	_ Family = Binomial{}
+	_ Family = Poisson{}
+	_ Family = Gamma{}
+)
+
+// Gaussian is the normal family, with the variance function V(μ) = 1. The
+// dispersion is the variance σ² of an observation with unit weight.
+type Gaussian struct{}
+
+// Variance returns 1, the variance function of the Gaussian family.
+func (Gaussian) Variance(mu float64) float64 { return 1 }
+
+// UnitDeviance returns the squared residual (y-μ)².
+func (Gaussian) UnitDeviance(y, mu float64) float64 { return (y - mu) * (y - mu) }
+
+// LogProb returns the log density of y under the normal distribution with
+// the mean μ and the variance φ/w.
+func (Gaussian) LogProb(y, mu, dispersion, weight float64) float64 {
+	v := dispersion / weight
+	return -0.5*math.Log(2*math.Pi*v) - (y-mu)*(y-mu)/(2*v)
+}
+
+// CanonicalLink returns the identity link.
+func (Gaussian) CanonicalLink() Link { return Identity{} }
+
+// InitialMean returns the observation y.
+func (Gaussian) InitialMean(y, weight float64) float64 { return y }
+
+// ValidMean returns whether μ is finite.
+func (Gaussian) ValidMean(mu float64) bool { return !math.IsNaN(mu) && !math.IsInf(mu, 0) }
+
+// FixedDispersion returns false, since the variance is estimated.
+func (Gaussian) FixedDispersion() bool { return false }
+
+// Binomial is the binomial family, with the variance function
+// V(μ) = μ(1-μ). The responses are the proportions of successes in w
+// trials, where w is the prior weight, so a Bernoulli response is 0 or 1
+// with unit weight.
+type Binomial struct{}
+
+// Variance returns μ(1-μ), the variance of a Bernoulli trial with the
+// success probability μ.
+func (Binomial) Variance(mu float64) float64 { return mu * (1 - mu) }
+
+// UnitDeviance returns 2(y log(y/μ) + (1-y) log((1-y)/(1-μ))) for the
+// proportion of successes y.
+func (Binomial) UnitDeviance(y, mu float64) float64 {
+	return 2 * (xlogy(y, y/mu) + xlogy(1-y, (1-y)/(1-mu)))
+}
+
+// LogProb returns the log probability of w·y successes in w trials with the
+// success probability μ. The dispersion is ignored.
+func (Binomial) LogProb(y, mu, dispersion, weight float64) float64 {
+	k := weight * y
+	lc, _ := math.Lgamma(weight + 1)
+	lk, _ := math.Lgamma(k + 1)
+	lnk, _ := math.Lgamma(weight - k + 1)
+	return lc - lk - lnk + xlogy(k, mu) + xlogy(weight-k, 1-mu)
+}
+
+// CanonicalLink returns the logit link.
+func (Binomial) CanonicalLink() Link { return Logit{} }
+
+// InitialMean returns (w·y + 0.5)/(w + 1), which moves proportions of 0 and
+// 1 inside the interval (0, 1).
+func (Binomial) InitialMean(y, weight float64) float64 {
+	return (weight*y + 0.5) / (weight + 1)
+}
+
+// ValidMean returns whether μ is in the open interval (0, 1).
+func (Binomial) ValidMean(mu float64) bool { return 0 < mu && mu < 1 }
+
+// FixedDispersion returns true.
+func (Binomial) FixedDispersion() bool { return true }
+
+// Poisson is the Poisson family, with the variance function V(μ) = μ. The
+// prior weights are frequencies of the observations.
+type Poisson struct{}
+
+// Variance returns μ, since the variance of a Poisson count equals its mean.
+func (Poisson) Variance(mu float64) float64 { return mu }
+
+// UnitDeviance returns 2(y log(y/μ) - (y-μ)) for the count y.
+func (Poisson) UnitDeviance(y, mu float64) float64 {
+	return 2 * (xlogy(y, y/mu) - (y - mu))
+}
+
+// LogProb returns w times the log probability of the count y with the rate
+// μ. The dispersion is ignored.
+func (Poisson) LogProb(y, mu, dispersion, weight float64) float64 {
+	lg, _ := math.Lgamma(y + 1)
+	return weight * (xlogy(y, mu) - mu - lg)
+}
+
+// CanonicalLink returns the log link.
+func (Poisson) CanonicalLink() Link { return Log{} }
+
+// InitialMean returns y + 0.1, which keeps the mean of a zero count
+// positive.
+func (Poisson) InitialMean(y, weight float64) float64 { return y + 0.1 }
+
+// ValidMean returns whether μ is positive and finite.
+func (Poisson) ValidMean(mu float64) bool { return 0 < mu && !math.IsInf(mu, 1) }
+
+// FixedDispersion returns true.
+func (Poisson) FixedDispersion() bool { return true }
+
+// Gamma is the gamma family, with the variance function V(μ) = μ². The
+// shape of the distribution of an observation is w/φ.
+type Gamma struct{}
+
+// Variance returns μ², so the coefficient of variation is constant.
+func (Gamma) Variance(mu float64) float64 { return mu * mu }
+
+// UnitDeviance returns 2((y-μ)/μ - log(y/μ)).
+func (Gamma) UnitDeviance(y, mu float64) float64 {
+	return 2 * ((y-mu)/mu - math.Log(y/mu))
+}
+
+// LogProb returns the log density of y under the gamma distribution with the
+// mean μ and the shape w/φ.
+func (Gamma) LogProb(y, mu, dispersion, weight float64) float64 {
+	k := weight / dispersion
+	theta := mu / k
+	lg, _ := math.Lgamma(k)
+	return (k-1)*math.Log(y) - y/theta - lg - k*math.Log(theta)
+}
+
+// CanonicalLink returns the reciprocal link.
+func (Gamma) CanonicalLink() Link { return Reciprocal{} }
+
+// InitialMean returns the observation y, which must be positive.
+func (Gamma) InitialMean(y, weight float64) float64 { return y }
+
+// ValidMean returns whether μ is positive and finite.
+func (Gamma) ValidMean(mu float64) bool { return 0 < mu && !math.IsInf(mu, 1) }
+
+// FixedDispersion returns false, since the dispersion, the reciprocal of
+// the shape, is estimated.
+func (Gamma) FixedDispersion() bool { return false }
+
+// xlogy returns x*log(y), or zero if x is zero.
+func xlogy(x, y float64) float64 {
+	if x == 0 {
+		return 0
+	}
+	return x * math.Log(y)
+}
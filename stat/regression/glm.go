@@ -0,0 +1,579 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package regression
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+var (
+	// ErrSingular is returned when the coefficients of a model are not
+	// determined by the observations.
+	ErrSingular = errors.New("regression: singular design matrix")
+
+	// ErrNoValidFit is returned when no coefficients with valid means of
+	// the family are found.
+	ErrNoValidFit = errors.New("regression: no valid coefficients found")
+
+	// ErrNotConverged is returned when the fitting does not converge within
+	// the maximum number of iterations.
+	ErrNotConverged = errors.New("regression: fitting did not converge")
+)
+
+// LeastSquares returns the least squares fit of the linear model y = Xβ to
+// the observations y with the design matrix x and the given weights,
+// minimizing
+//
+//	Σ_i w_i (y_i - x_i^T β)².
+//
+// If weights is nil, all the weights are one. If intercept is true, an
+// intercept is fitted as the first coefficient. LeastSquares is equivalent
+// to fitting a GLM of the Gaussian family with the identity link.
+func LeastSquares(x mat.Matrix, y, weights []float64, intercept bool) (*Result, error) {
+	return (&GLM{Intercept: intercept}).Fit(x, y, weights)
+}
+
+// GLM is a generalized linear model. The coefficients are fitted by
+// iteratively reweighted least squares, minimizing the penalized objective
+//
+//	D(β)/2 + λ_1 Σ_j |β_j| + λ_2/2 Σ_j β_j²,
+//
+// where D is the deviance of the model, λ_1 is L1 and λ_2 is L2. The
+// intercept is not penalized. With a positive L1, the penalized least
+// squares problem of each iteration is solved by coordinate descent.
+type GLM struct {
+	// Family is the distribution of the response. If Family is nil, it is
+	// defaulted to Gaussian.
+	Family Family
+
+	// Link is the link function of the model. If Link is nil, it is
+	// defaulted to the canonical link of Family.
+	Link Link
+
+	// Intercept specifies whether an intercept is fitted as the first
+	// coefficient in addition to the coefficients of the columns of the
+	// design matrix.
+	Intercept bool
+
+	// L1 and L2 are the weights of the lasso and ridge penalties of the
+	// coefficients. They must not be negative.
+	L1, L2 float64
+
+	// MaxIterations is the maximum number of iterations of the fitting.
+	// If MaxIterations is zero, it is defaulted to 100.
+	MaxIterations int
+
+	// Tolerance is the convergence tolerance of the fitting, which stops
+	// when the relative change of the penalized objective is less than
+	// Tolerance. If Tolerance is zero, it is defaulted to 1e-8.
+	Tolerance float64
+}
+
+// Fit fits the model to the observations y with the design matrix x, whose
+// rows are the predictors of the observations, and the prior weights of
+// the observations. If weights is nil, all the weights are one.
+// Observations with zero weight do not contribute to the fit.
+//
+// Fit returns ErrSingular if the coefficients are not determined, and
+// ErrNoValidFit if the means of the fit are not valid for the family. If
+// the fitting does not converge, Fit returns the last fit and
+// ErrNotConverged.
+//
+// Fit panics if the lengths of y and weights do not match the number of
+// rows of x, or if a weight or a penalty is negative.
+func (g *GLM) Fit(x mat.Matrix, y, weights []float64) (*Result, error) {
+	n, c := x.Dims()
+	if len(y) != n {
+		panic(badLength)
+	}
+	if weights != nil && len(weights) != n {
+		panic(badLength)
+	}
+	if g.L1 < 0 || g.L2 < 0 {
+		panic("regression: negative penalty")
+	}
+	family := g.Family
+	if family == nil {
+		family = Gaussian{}
+	}
+	link := g.Link
+	if link == nil {
+		link = family.CanonicalLink()
+	}
+	maxIter := g.MaxIterations
+	if maxIter == 0 {
+		maxIter = 100
+	}
+	tol := g.Tolerance
+	if tol == 0 {
+		tol = 1e-8
+	}
+
+	// The design matrix with the intercept column. The coefficients from
+	// off on are penalized.
+	var off int
+	if g.Intercept {
+		off = 1
+	}
+	p := c + off
+	design := mat.NewDense(n, p, nil)
+	for i := 0; i < n; i++ {
+		if g.Intercept {
+			design.Set(i, 0, 1)
+		}
+		for j := 0; j < c; j++ {
+			design.Set(i, j+off, x.At(i, j))
+		}
+	}
+	w := make([]float64, n)
+	for i := range w {
+		w[i] = 1
+		if weights != nil {
+			if weights[i] < 0 {
+				panic("regression: negative weight")
+			}
+			w[i] = weights[i]
+		}
+	}
+
+	f := fitter{
+		family: family,
+		link:   link,
+		x:      design,
+		y:      y,
+		w:      w,
+		off:    off,
+		l1:     g.L1,
+		l2:     g.L2,
+	}
+	eta := make([]float64, n)
+	mu := make([]float64, n)
+	for i, v := range y {
+		if w[i] == 0 {
+			continue
+		}
+		mu[i] = family.InitialMean(v, w[i])
+		eta[i] = link.Link(mu[i])
+	}
+	beta := make([]float64, p)
+	newBeta := make([]float64, p)
+	newEta := make([]float64, n)
+	newMu := make([]float64, n)
+	obj := math.Inf(1)
+	var (
+		iter      int
+		converged bool
+	)
+	for iter = 1; iter <= maxIter; iter++ {
+		copy(newBeta, beta)
+		err := f.solve(newBeta, eta, mu)
+		if err != nil {
+			return nil, err
+		}
+		// The step is halved towards the previous coefficients while the
+		// means are not valid.
+		var newObj float64
+		for halvings := 0; ; halvings++ {
+			newObj = f.objective(newBeta, newEta, newMu)
+			if !math.IsInf(newObj, 1) {
+				break
+			}
+			if iter == 1 || halvings == 30 {
+				return nil, ErrNoValidFit
+			}
+			for j, v := range beta {
+				newBeta[j] = (newBeta[j] + v) / 2
+			}
+		}
+		converged = math.Abs(newObj-obj) < tol*(math.Abs(newObj)+0.1)
+		copy(beta, newBeta)
+		copy(eta, newEta)
+		copy(mu, newMu)
+		obj = newObj
+		if converged {
+			break
+		}
+	}
+	r := f.result(beta, eta, mu)
+	r.Iterations = min(iter, maxIter)
+	if !converged {
+		return r, ErrNotConverged
+	}
+	return r, nil
+}
+
+// fitter holds the data of the fitting of a GLM.
+type fitter struct {
+	family Family
+	link   Link
+	x      *mat.Dense
+	y, w   []float64
+	off    int
+	l1, l2 float64
+}
+
+// working stores the working weights and responses of iteratively
+// reweighted least squares at the linear predictors eta and means mu in ww
+// and z.
+func (f *fitter) working(ww, z, eta, mu []float64) {
+	for i, v := range f.y {
+		if f.w[i] == 0 {
+			ww[i] = 0
+			z[i] = eta[i]
+			continue
+		}
+		d := f.link.Deriv(mu[i])
+		ww[i] = f.w[i] / (f.family.Variance(mu[i]) * d * d)
+		z[i] = eta[i] + (v-mu[i])*d
+	}
+}
+
+// solve solves the penalized weighted least squares problem of an iteration
+// at the linear predictors eta and means mu, starting from beta, and stores
+// the result in beta.
+func (f *fitter) solve(beta, eta, mu []float64) error {
+	n, p := f.x.Dims()
+	ww := make([]float64, n)
+	z := make([]float64, n)
+	f.working(ww, z, eta, mu)
+	if f.l1 > 0 {
+		f.coordinateDescent(beta, ww, z)
+		return nil
+	}
+
+	// The ridge penalty is added as observations of zero for the
+	// penalized coefficients.
+	rows := n
+	if f.l2 > 0 {
+		rows += p - f.off
+	}
+	if rows < p {
+		return ErrSingular
+	}
+	a := mat.NewDense(rows, p, nil)
+	b := mat.NewVecDense(rows, nil)
+	for i := 0; i < n; i++ {
+		s := math.Sqrt(ww[i])
+		for j := 0; j < p; j++ {
+			a.Set(i, j, s*f.x.At(i, j))
+		}
+		b.SetVec(i, s*z[i])
+	}
+	if f.l2 > 0 {
+		s := math.Sqrt(f.l2)
+		for j := f.off; j < p; j++ {
+			a.Set(n+j-f.off, j, s)
+		}
+	}
+	var qr mat.QR
+	qr.Factorize(a)
+	var sol mat.VecDense
+	if err := qr.SolveVecTo(&sol, false, b); err != nil {
+		return ErrSingular
+	}
+	copy(beta, sol.RawVector().Data)
+	return nil
+}
+
+// coordinateDescent minimizes
+//
+//	1/2 Σ_i ww_i (z_i - x_i^T β)² + λ_1 Σ_j |β_j| + λ_2/2 Σ_j β_j²
+//
+// by cyclic coordinate descent from beta.
+func (f *fitter) coordinateDescent(beta, ww, z []float64) {
+	n, p := f.x.Dims()
+	r := make([]float64, n)
+	for i := range r {
+		r[i] = z[i] - mat.Dot(f.x.RowView(i), mat.NewVecDense(p, beta))
+	}
+	scale := make([]float64, p)
+	for j := range scale {
+		for i := 0; i < n; i++ {
+			v := f.x.At(i, j)
+			scale[j] += ww[i] * v * v
+		}
+	}
+	for sweep := 0; sweep < 10000; sweep++ {
+		var maxChange float64
+		for j := range beta {
+			if scale[j] == 0 {
+				beta[j] = 0
+				continue
+			}
+			rho := scale[j] * beta[j]
+			for i := 0; i < n; i++ {
+				rho += ww[i] * f.x.At(i, j) * r[i]
+			}
+			l1, l2 := f.l1, f.l2
+			if j < f.off {
+				l1, l2 = 0, 0
+			}
+			b := softThreshold(rho, l1) / (scale[j] + l2)
+			if d := b - beta[j]; d != 0 {
+				for i := 0; i < n; i++ {
+					r[i] -= f.x.At(i, j) * d
+				}
+				maxChange = math.Max(maxChange, math.Abs(d)*math.Sqrt(scale[j]))
+				beta[j] = b
+			}
+		}
+		if maxChange < 1e-12 {
+			break
+		}
+	}
+}
+
+// objective stores the linear predictors and means of the coefficients
+// beta in eta and mu, and returns the penalized objective. The objective
+// is +Inf if a mean is not valid.
+func (f *fitter) objective(beta, eta, mu []float64) float64 {
+	p := len(beta)
+	var dev float64
+	for i, v := range f.y {
+		eta[i] = mat.Dot(f.x.RowView(i), mat.NewVecDense(p, beta))
+		mu[i] = f.link.Inverse(eta[i])
+		if f.w[i] == 0 {
+			continue
+		}
+		if !f.family.ValidMean(mu[i]) {
+			return math.Inf(1)
+		}
+		dev += f.w[i] * f.family.UnitDeviance(v, mu[i])
+	}
+	if math.IsNaN(dev) || math.IsInf(dev, 0) {
+		return math.Inf(1)
+	}
+	obj := dev / 2
+	for _, b := range beta[f.off:] {
+		obj += f.l1*math.Abs(b) + f.l2*b*b/2
+	}
+	return obj
+}
+
+// result returns the Result of the fit with the coefficients beta, the
+// linear predictors eta and the means mu.
+func (f *fitter) result(beta, eta, mu []float64) *Result {
+	n, p := f.x.Dims()
+	r := &Result{
+		Coefficients: append([]float64(nil), beta...),
+		family:       f.family,
+		link:         f.link,
+		intercept:    f.off == 1,
+	}
+
+	var nObs int
+	var sumW, pearson, meanY float64
+	for i, v := range f.y {
+		if f.w[i] == 0 {
+			continue
+		}
+		nObs++
+		sumW += f.w[i]
+		meanY += f.w[i] * v
+		r.Deviance += f.w[i] * f.family.UnitDeviance(v, mu[i])
+		pearson += f.w[i] * (v - mu[i]) * (v - mu[i]) / f.family.Variance(mu[i])
+	}
+	meanY /= sumW
+	r.DoF = nObs - p
+
+	// The null model has only the intercept, or no coefficients.
+	mu0 := f.link.Inverse(0)
+	if f.off == 1 {
+		mu0 = meanY
+	}
+	for i, v := range f.y {
+		if f.w[i] != 0 {
+			r.NullDeviance += f.w[i] * f.family.UnitDeviance(v, mu0)
+		}
+	}
+
+	// The dispersion is estimated by the Pearson statistic, and the
+	// likelihood is evaluated at the maximum likelihood estimate D/Σw.
+	r.Dispersion = 1
+	llDispersion := 1.0
+	k := p
+	if !f.family.FixedDispersion() {
+		r.Dispersion = math.NaN()
+		if r.DoF > 0 {
+			r.Dispersion = pearson / float64(r.DoF)
+		}
+		llDispersion = r.Deviance / sumW
+		k++
+	}
+	for i, v := range f.y {
+		if f.w[i] != 0 {
+			r.LogLikelihood += f.family.LogProb(v, mu[i], llDispersion, f.w[i])
+		}
+	}
+	r.AIC = -2*r.LogLikelihood + 2*float64(k)
+	r.BIC = -2*r.LogLikelihood + math.Log(float64(nObs))*float64(k)
+
+	// The covariance of the coefficients is the inverse of the Fisher
+	// information, including the ridge penalty.
+	r.cov = mat.NewSymDense(p, nil)
+	r.StdErr = make([]float64, p)
+	ww := make([]float64, n)
+	f.working(ww, make([]float64, n), eta, mu)
+	info := mat.NewSymDense(p, nil)
+	for i := 0; i < n; i++ {
+		info.SymRankOne(info, ww[i], f.x.RowView(i))
+	}
+	for j := f.off; j < p; j++ {
+		info.SetSym(j, j, info.At(j, j)+f.l2)
+	}
+	var chol mat.Cholesky
+	if f.l1 > 0 || !chol.Factorize(info) || chol.InverseTo(r.cov) != nil {
+		for i := 0; i < p; i++ {
+			for j := i; j < p; j++ {
+				r.cov.SetSym(i, j, math.NaN())
+			}
+		}
+	}
+	r.cov.ScaleSym(r.Dispersion, r.cov)
+	for j := range r.StdErr {
+		r.StdErr[j] = math.Sqrt(r.cov.At(j, j))
+	}
+	return r
+}
+
+// Result is a fitted linear or generalized linear model.
+type Result struct {
+	// Coefficients are the fitted coefficients of the model, starting with
+	// the intercept if one is fitted.
+	Coefficients []float64
+
+	// StdErr are the standard errors of the coefficients. They are NaN if
+	// the model is fitted with a lasso penalty. With a ridge penalty they
+	// are approximate.
+	StdErr []float64
+
+	// Dispersion is the dispersion φ of the model, estimated by the
+	// Pearson statistic for families without fixed dispersion. It is NaN
+	// if DoF is not positive.
+	Dispersion float64
+
+	// Deviance is the deviance of the model, and NullDeviance the deviance
+	// of the model with only the intercept, or with zero linear
+	// predictor if there is no intercept.
+	Deviance     float64
+	NullDeviance float64
+
+	// LogLikelihood is the log likelihood of the model. For families
+	// without fixed dispersion it is evaluated at the maximum likelihood
+	// estimate of the dispersion, the deviance divided by the sum of the
+	// weights.
+	LogLikelihood float64
+
+	// AIC and BIC are the Akaike and Bayesian information criteria of the
+	// model, counting the dispersion as a parameter if it is estimated.
+	AIC float64
+	BIC float64
+
+	// DoF is the residual degrees of freedom, the number of observations
+	// with positive weight less the number of coefficients.
+	DoF int
+
+	// Iterations is the number of iterations of the fitting.
+	Iterations int
+
+	family    Family
+	link      Link
+	intercept bool
+	cov       *mat.SymDense
+}
+
+// Covariance stores the estimated covariance matrix of the coefficients in
+// dst. If dst is empty, it is resized to the number of coefficients,
+// otherwise Covariance panics if the sizes do not match.
+func (r *Result) Covariance(dst *mat.SymDense) {
+	if dst.IsEmpty() {
+		dst.ReuseAsSym(len(r.Coefficients))
+	} else if dst.SymmetricDim() != len(r.Coefficients) {
+		panic(mat.ErrShape)
+	}
+	dst.CopySym(r.cov)
+}
+
+// ConfidenceIntervals stores the lower and upper bounds of the Wald
+// confidence intervals of the coefficients with the given level in lower
+// and upper, and returns them. If lower or upper is nil, a new slice is
+// allocated, otherwise its length must be the number of coefficients.
+// The intervals use the Student's t distribution with DoF degrees of
+// freedom if the dispersion is estimated, and the normal distribution
+// otherwise.
+//
+// ConfidenceIntervals panics if level is not in (0, 1).
+func (r *Result) ConfidenceIntervals(lower, upper []float64, level float64) ([]float64, []float64) {
+	if !(0 < level && level < 1) {
+		panic("regression: confidence level out of range")
+	}
+	p := len(r.Coefficients)
+	if lower == nil {
+		lower = make([]float64, p)
+	}
+	if upper == nil {
+		upper = make([]float64, p)
+	}
+	if len(lower) != p || len(upper) != p {
+		panic(badLength)
+	}
+	q := 1 - (1-level)/2
+	var z float64
+	if r.family.FixedDispersion() || r.DoF <= 0 {
+		z = distuv.UnitNormal.Quantile(q)
+	} else {
+		z = distuv.StudentsT{Mu: 0, Sigma: 1, Nu: float64(r.DoF)}.Quantile(q)
+	}
+	for j, b := range r.Coefficients {
+		lower[j] = b - z*r.StdErr[j]
+		upper[j] = b + z*r.StdErr[j]
+	}
+	return lower, upper
+}
+
+// LinearPredictor returns the linear predictor x^T β of the predictors x.
+// The length of x is the number of columns of the design matrix of the
+// fit, without the intercept.
+func (r *Result) LinearPredictor(x []float64) float64 {
+	beta := r.Coefficients
+	var eta float64
+	if r.intercept {
+		eta = beta[0]
+		beta = beta[1:]
+	}
+	if len(x) != len(beta) {
+		panic(badLength)
+	}
+	for j, v := range x {
+		eta += v * beta[j]
+	}
+	return eta
+}
+
+// Predict returns the mean of the response predicted for the predictors x.
+// The length of x is the number of columns of the design matrix of the
+// fit, without the intercept.
+func (r *Result) Predict(x []float64) float64 {
+	return r.link.Inverse(r.LinearPredictor(x))
+}
+
+// softThreshold returns the soft thresholding of x by t,
+// sign(x) max(|x|-t, 0).
+func softThreshold(x, t float64) float64 {
+	switch {
+	case x > t:
+		return x - t
+	case x < -t:
+		return x + t
+	default:
+		return 0
+	}
+}
+
+const badLength = "regression: slice length mismatch"
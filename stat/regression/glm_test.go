@@ -0,0 +1,463 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package regression
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+func TestGLMDobson(t *testing.T) {
+	t.Parallel()
+	// The Poisson regression of Dobson, An Introduction to Generalized
+	// Linear Models (1990), p. 93, from the documentation of glm in R.
+	counts := []float64{18, 17, 15, 20, 10, 20, 25, 13, 12}
+	x := mat.NewDense(9, 4, nil)
+	for i := 0; i < 9; i++ {
+		if o := i % 3; o > 0 {
+			x.Set(i, o-1, 1)
+		}
+		if tr := i / 3; tr > 0 {
+			x.Set(i, 1+tr, 1)
+		}
+	}
+	r, err := (&GLM{Family: Poisson{}, Intercept: true}).Fit(x, counts, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The means of the outcomes are 21, 40/3 and 47/3, and the treatments
+	// have no effect.
+	want := []float64{math.Log(21), math.Log(40.0 / 3 / 21), math.Log(47.0 / 3 / 21), 0, 0}
+	if !floats.EqualApprox(r.Coefficients, want, 1e-10) {
+		t.Errorf("unexpected coefficients: got %v, want %v", r.Coefficients, want)
+	}
+	wantStdErr := []float64{0.1708987, 0.2021708, 0.1927423, 0.2, 0.2}
+	if !floats.EqualApprox(r.StdErr, wantStdErr, 1e-6) {
+		t.Errorf("unexpected standard errors: got %v, want %v", r.StdErr, wantStdErr)
+	}
+	for _, test := range []struct {
+		name      string
+		got, want float64
+	}{
+		{"deviance", r.Deviance, 5.129141},
+		{"null deviance", r.NullDeviance, 10.58145},
+		{"AIC", r.AIC, 56.76132},
+		{"dispersion", r.Dispersion, 1},
+	} {
+		if math.Abs(test.got-test.want) > 1e-5 {
+			t.Errorf("unexpected %s: got %v, want %v", test.name, test.got, test.want)
+		}
+	}
+	if r.DoF != 4 {
+		t.Errorf("unexpected residual degrees of freedom: got %d, want 4", r.DoF)
+	}
+	if got := r.Predict([]float64{1, 0, 0, 1}); math.Abs(got-40.0/3) > 1e-8 {
+		t.Errorf("unexpected prediction: got %v, want %v", got, 40.0/3)
+	}
+	lower, upper := r.ConfidenceIntervals(nil, nil, 0.95)
+	z := distuv.UnitNormal.Quantile(0.975)
+	for j, b := range r.Coefficients {
+		if math.Abs(lower[j]-(b-z*r.StdErr[j])) > 1e-12 || math.Abs(upper[j]-(b+z*r.StdErr[j])) > 1e-12 {
+			t.Errorf("unexpected confidence interval of coefficient %d: got [%v, %v]", j, lower[j], upper[j])
+		}
+	}
+}
+
+// linearData returns n observations of y = 1 + 2 x_0 - x_1 + 0.5 x_2 with
+// normal noise of standard deviation sigma.
+func linearData(n int, sigma float64, src rand.Source) (*mat.Dense, []float64) {
+	rnd := rand.New(src)
+	x := mat.NewDense(n, 3, nil)
+	y := make([]float64, n)
+	for i := range y {
+		for j := 0; j < 3; j++ {
+			x.Set(i, j, rnd.NormFloat64())
+		}
+		y[i] = 1 + 2*x.At(i, 0) - x.At(i, 1) + 0.5*x.At(i, 2) + sigma*rnd.NormFloat64()
+	}
+	return x, y
+}
+
+func TestLeastSquares(t *testing.T) {
+	t.Parallel()
+	const n = 50
+	x, y := linearData(n, 0.3, rand.NewSource(1))
+	rnd := rand.New(rand.NewSource(2))
+	for _, weights := range [][]float64{nil, randomWeights(n, rnd)} {
+		r, err := LeastSquares(x, y, weights, true)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		// The normal equations X^T W X β = X^T W y.
+		w := weights
+		if w == nil {
+			w = ones(n)
+		}
+		design := mat.NewDense(n, 4, nil)
+		for i := 0; i < n; i++ {
+			design.Set(i, 0, 1)
+			for j := 0; j < 3; j++ {
+				design.Set(i, j+1, x.At(i, j))
+			}
+		}
+		xtwx := mat.NewSymDense(4, nil)
+		xtwy := mat.NewVecDense(4, nil)
+		for i := 0; i < n; i++ {
+			row := design.RowView(i)
+			xtwx.SymRankOne(xtwx, w[i], row)
+			xtwy.AddScaledVec(xtwy, w[i]*y[i], row)
+		}
+		var chol mat.Cholesky
+		if !chol.Factorize(xtwx) {
+			t.Fatal("normal equations not positive definite")
+		}
+		var beta mat.VecDense
+		chol.SolveVecTo(&beta, xtwy)
+		if !floats.EqualApprox(r.Coefficients, beta.RawVector().Data, 1e-10) {
+			t.Errorf("unexpected coefficients: got %v, want %v", r.Coefficients, beta.RawVector().Data)
+		}
+
+		var rss, sumW float64
+		for i := 0; i < n; i++ {
+			res := y[i] - mat.Dot(design.RowView(i), &beta)
+			rss += w[i] * res * res
+			sumW += w[i]
+		}
+		sigma2 := rss / float64(n-4)
+		if !scalar.EqualWithinAbsOrRel(r.Dispersion, sigma2, 1e-10, 1e-10) || !scalar.EqualWithinAbsOrRel(r.Deviance, rss, 1e-10, 1e-10) {
+			t.Errorf("unexpected dispersion or deviance: got %v and %v, want %v and %v", r.Dispersion, r.Deviance, sigma2, rss)
+		}
+		var inv mat.SymDense
+		chol.InverseTo(&inv)
+		for j := 0; j < 4; j++ {
+			if want := math.Sqrt(sigma2 * inv.At(j, j)); !scalar.EqualWithinAbsOrRel(r.StdErr[j], want, 1e-10, 1e-10) {
+				t.Errorf("unexpected standard error of coefficient %d: got %v, want %v", j, r.StdErr[j], want)
+			}
+		}
+		var ll float64
+		for i := 0; i < n; i++ {
+			res := y[i] - mat.Dot(design.RowView(i), &beta)
+			v := rss / sumW / w[i]
+			ll += -0.5*math.Log(2*math.Pi*v) - res*res/(2*v)
+		}
+		if !scalar.EqualWithinAbsOrRel(r.LogLikelihood, ll, 1e-10, 1e-10) || !scalar.EqualWithinAbsOrRel(r.AIC, -2*ll+10, 1e-10, 1e-10) {
+			t.Errorf("unexpected log likelihood or AIC: got %v and %v, want %v and %v", r.LogLikelihood, r.AIC, ll, -2*ll+10)
+		}
+
+		// The confidence intervals use the t distribution.
+		lower, upper := r.ConfidenceIntervals(nil, nil, 0.9)
+		q := distuv.StudentsT{Mu: 0, Sigma: 1, Nu: n - 4}.Quantile(0.95)
+		for j, b := range r.Coefficients {
+			if math.Abs(lower[j]-(b-q*r.StdErr[j])) > 1e-12 || math.Abs(upper[j]-(b+q*r.StdErr[j])) > 1e-12 {
+				t.Errorf("unexpected confidence interval of coefficient %d: got [%v, %v]", j, lower[j], upper[j])
+			}
+		}
+	}
+}
+
+func TestGLMPenalties(t *testing.T) {
+	t.Parallel()
+	const n = 40
+	rnd := rand.New(rand.NewSource(1))
+	// A design with orthonormal columns, for which the lasso solution is
+	// the soft thresholding of the least squares solution.
+	a := mat.NewDense(n, 4, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < 4; j++ {
+			a.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	var qr mat.QR
+	qr.Factorize(a)
+	var q mat.Dense
+	qr.QTo(&q)
+	x := mat.DenseCopyOf(q.Slice(0, n, 0, 4))
+	y := make([]float64, n)
+	for i := range y {
+		y[i] = 3*x.At(i, 0) - 0.5*x.At(i, 1) + 0.1*x.At(i, 2) + 0.05*rnd.NormFloat64()
+	}
+	ols, err := LeastSquares(x, y, nil, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	const l1 = 0.3
+	lasso, err := (&GLM{L1: l1}).Fit(x, y, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for j, b := range ols.Coefficients {
+		if want := softThreshold(b, l1); math.Abs(lasso.Coefficients[j]-want) > 1e-10 {
+			t.Errorf("unexpected lasso coefficient %d: got %v, want %v", j, lasso.Coefficients[j], want)
+		}
+		if !math.IsNaN(lasso.StdErr[j]) {
+			t.Errorf("unexpected lasso standard error %d: got %v, want NaN", j, lasso.StdErr[j])
+		}
+	}
+
+	const l2 = 2
+	ridge, err := (&GLM{L2: l2}).Fit(x, y, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for j, b := range ols.Coefficients {
+		if want := b / (1 + l2); math.Abs(ridge.Coefficients[j]-want) > 1e-10 {
+			t.Errorf("unexpected ridge coefficient %d: got %v, want %v", j, ridge.Coefficients[j], want)
+		}
+	}
+
+	// The intercept is not penalized.
+	shifted := make([]float64, n)
+	for i, v := range y {
+		shifted[i] = v + 10
+	}
+	for _, g := range []*GLM{{Intercept: true, L1: l1}, {Intercept: true, L2: l2}} {
+		r, err := g.Fit(x, shifted, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if math.Abs(r.Coefficients[0]-10) > 1 {
+			t.Errorf("intercept penalized: got %v", r.Coefficients[0])
+		}
+	}
+
+	// A logistic model with more predictors than observations is fitted
+	// with a penalty.
+	wide := mat.NewDense(10, 20, nil)
+	cls := make([]float64, 10)
+	for i := 0; i < 10; i++ {
+		for j := 0; j < 20; j++ {
+			wide.Set(i, j, rnd.NormFloat64())
+		}
+		if wide.At(i, 0) > 0 {
+			cls[i] = 1
+		}
+	}
+	if _, err := (&GLM{Family: Binomial{}}).Fit(wide, cls, nil); err != ErrSingular {
+		t.Errorf("unexpected error without penalty: got %v, want %v", err, ErrSingular)
+	}
+	for _, g := range []*GLM{{Family: Binomial{}, Intercept: true, L1: 0.5}, {Family: Binomial{}, Intercept: true, L2: 1}} {
+		r, err := g.Fit(wide, cls, nil)
+		if err != nil {
+			t.Fatalf("unexpected error with penalty: %v", err)
+		}
+		if r.Coefficients[1] <= 0 {
+			t.Errorf("unexpected sign of the coefficient of the separating predictor: %v", r.Coefficients[1])
+		}
+	}
+}
+
+func TestGLMMaximumLikelihood(t *testing.T) {
+	t.Parallel()
+	const n = 200
+	rnd := rand.New(rand.NewSource(1))
+	x := mat.NewDense(n, 2, nil)
+	for i := 0; i < n; i++ {
+		x.Set(i, 0, rnd.NormFloat64())
+		x.Set(i, 1, rnd.Float64())
+	}
+	eta := func(i int) float64 { return 0.3 + 0.8*x.At(i, 0) - 0.5*x.At(i, 1) }
+	binary := make([]float64, n)
+	counts := make([]float64, n)
+	positive := make([]float64, n)
+	for i := range binary {
+		if rnd.Float64() < 1/(1+math.Exp(-eta(i))) {
+			binary[i] = 1
+		}
+		counts[i] = distuv.Poisson{Lambda: math.Exp(eta(i)), Src: rnd}.Rand()
+		positive[i] = distuv.Gamma{Alpha: 3, Beta: 3 / math.Exp(eta(i)), Src: rnd}.Rand()
+	}
+	weights := randomWeights(n, rnd)
+
+	for _, test := range []struct {
+		name    string
+		glm     GLM
+		y       []float64
+		weights []float64
+	}{
+		{"logistic", GLM{Family: Binomial{}, Intercept: true}, binary, nil},
+		{"probit", GLM{Family: Binomial{}, Link: Probit{}, Intercept: true}, binary, nil},
+		{"cloglog", GLM{Family: Binomial{}, Link: CLogLog{}, Intercept: true}, binary, nil},
+		{"Poisson", GLM{Family: Poisson{}, Intercept: true}, counts, weights},
+		{"Gaussian log", GLM{Family: Gaussian{}, Link: Log{}, Intercept: true}, positive, nil},
+		{"gamma log", GLM{Family: Gamma{}, Link: Log{}, Intercept: true}, positive, weights},
+		{"gamma", GLM{Family: Gamma{}, Intercept: true}, positive, nil},
+	} {
+		r, err := test.glm.Fit(x, test.y, test.weights)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+
+		// The coefficients maximize the likelihood, whose maximum does
+		// not depend on the dispersion.
+		family, link := test.glm.Family, test.glm.Link
+		if link == nil {
+			link = family.CanonicalLink()
+		}
+		w := test.weights
+		if w == nil {
+			w = ones(n)
+		}
+		nll := func(beta []float64) float64 {
+			var ll float64
+			for i, v := range test.y {
+				mu := link.Inverse(beta[0] + beta[1]*x.At(i, 0) + beta[2]*x.At(i, 1))
+				if !family.ValidMean(mu) {
+					return math.Inf(1)
+				}
+				ll += family.LogProb(v, mu, 1, w[i])
+			}
+			return -ll
+		}
+		result, err := optimize.Minimize(optimize.Problem{Func: nll}, r.Coefficients, nil, &optimize.NelderMead{})
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if result.F < nll(r.Coefficients)-1e-8 {
+			t.Errorf("%s: coefficients do not maximize the likelihood: got %v, better %v", test.name, r.Coefficients, result.X)
+		}
+		if !floats.EqualApprox(result.X, r.Coefficients, 1e-4) {
+			t.Errorf("%s: unexpected coefficients: got %v, want %v", test.name, r.Coefficients, result.X)
+		}
+
+		// The likelihood is consistent with the deviance.
+		var ll float64
+		phi := 1.0
+		if !family.FixedDispersion() {
+			phi = r.Deviance / floats.Sum(w)
+		}
+		for i, v := range test.y {
+			ll += family.LogProb(v, r.Predict(x.RawRowView(i)), phi, w[i])
+		}
+		if !scalar.EqualWithinAbsOrRel(r.LogLikelihood, ll, 1e-10, 1e-10) {
+			t.Errorf("%s: unexpected log likelihood: got %v, want %v", test.name, r.LogLikelihood, ll)
+		}
+	}
+}
+
+func TestGLMBinomialWeights(t *testing.T) {
+	t.Parallel()
+	// Grouped binomial observations with the number of trials as the
+	// weights fit the same model as the individual Bernoulli trials.
+	groups := mat.NewDense(4, 1, []float64{-1, 0, 1, 2})
+	trials := []float64{10, 8, 12, 5}
+	successes := []float64{2, 3, 8, 5}
+	props := make([]float64, 4)
+	for i := range props {
+		props[i] = successes[i] / trials[i]
+	}
+	grouped, err := (&GLM{Family: Binomial{}, Intercept: true}).Fit(groups, props, trials)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var xs, ys []float64
+	for i, n := range trials {
+		for k := 0; k < int(n); k++ {
+			xs = append(xs, groups.At(i, 0))
+			var y float64
+			if k < int(successes[i]) {
+				y = 1
+			}
+			ys = append(ys, y)
+		}
+	}
+	individual, err := (&GLM{Family: Binomial{}, Intercept: true}).Fit(mat.NewDense(len(xs), 1, xs), ys, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !floats.EqualApprox(grouped.Coefficients, individual.Coefficients, 1e-8) {
+		t.Errorf("unexpected coefficients: got %v, want %v", grouped.Coefficients, individual.Coefficients)
+	}
+	if !floats.EqualApprox(grouped.StdErr, individual.StdErr, 1e-8) {
+		t.Errorf("unexpected standard errors: got %v, want %v", grouped.StdErr, individual.StdErr)
+	}
+
+	// Observations with zero weight are ignored.
+	withZero, err := (&GLM{Family: Binomial{}, Intercept: true}).Fit(
+		mat.NewDense(5, 1, []float64{-1, 0, 1, 2, 7}),
+		append(props, 0.5),
+		append(trials, 0),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !floats.EqualApprox(withZero.Coefficients, grouped.Coefficients, 1e-10) || withZero.DoF != grouped.DoF {
+		t.Errorf("observation with zero weight not ignored: got %v, want %v", withZero.Coefficients, grouped.Coefficients)
+	}
+}
+
+func TestGLMErrors(t *testing.T) {
+	t.Parallel()
+	// Collinear predictors.
+	x := mat.NewDense(4, 2, []float64{1, 2, 2, 4, 3, 6, 4, 8})
+	if _, err := LeastSquares(x, []float64{1, 2, 3, 4}, nil, false); err != ErrSingular {
+		t.Errorf("unexpected error for collinear predictors: got %v, want %v", err, ErrSingular)
+	}
+	// The identity link gives negative means of a Poisson model.
+	neg := mat.NewDense(3, 1, []float64{-1, 0, 1})
+	if _, err := (&GLM{Family: Poisson{}, Link: Identity{}}).Fit(neg, []float64{1, 2, 3}, nil); err != ErrNoValidFit {
+		t.Errorf("unexpected error for invalid means: got %v, want %v", err, ErrNoValidFit)
+	}
+	r, err := (&GLM{Family: Poisson{}, Intercept: true, MaxIterations: 1}).Fit(neg, []float64{1, 2, 6}, nil)
+	if err != ErrNotConverged || r == nil || r.Iterations != 1 {
+		t.Errorf("unexpected result for iteration limit: got %v and %v, want %v", r, err, ErrNotConverged)
+	}
+
+	for _, test := range []struct {
+		name string
+		f    func()
+	}{
+		{"length mismatch", func() { LeastSquares(neg, []float64{1, 2}, nil, false) }},
+		{"weights length", func() { LeastSquares(neg, []float64{1, 2, 3}, []float64{1}, false) }},
+		{"negative weight", func() { LeastSquares(neg, []float64{1, 2, 3}, []float64{1, -1, 1}, false) }},
+		{"negative penalty", func() { (&GLM{L2: -1}).Fit(neg, []float64{1, 2, 3}, nil) }},
+		{"confidence level", func() {
+			r, _ := LeastSquares(neg, []float64{1, 2, 4}, nil, true)
+			r.ConfidenceIntervals(nil, nil, 1)
+		}},
+	} {
+		if !panics(test.f) {
+			t.Errorf("%s: expected panic", test.name)
+		}
+	}
+}
+
+func randomWeights(n int, rnd *rand.Rand) []float64 {
+	w := make([]float64, n)
+	for i := range w {
+		w[i] = 0.5 + rnd.Float64()
+	}
+	return w
+}
+
+func ones(n int) []float64 {
+	w := make([]float64, n)
+	for i := range w {
+		w[i] = 1
+	}
+	return w
+}
+
+func panics(f func()) (b bool) {
+	defer func() {
+		err := recover()
+		if err != nil {
+			b = true
+		}
+	}()
+	f()
+	return
+}
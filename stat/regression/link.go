@@ -0,0 +1,125 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package regression
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// Link is the link function of a generalized linear model, relating the mean
+// μ of the response to the linear predictor η by g(μ) = η.
+type Link interface {
+	// Link returns the linear predictor g(μ) for the mean μ.
+	Link(mu float64) float64
+
+	// Inverse returns the mean g^-1(η) for the linear predictor η.
+	Inverse(eta float64) float64
+
+	// Deriv returns the derivative g'(μ) of the link at the mean μ.
+	Deriv(mu float64) float64
+}
+
+var (
+	_ Link = Identity{}
+	_ Link = Log{}
+	_ Link = Logit{}
+	_ Link = Probit{}
+	_ Link = CLogLog{}
+	_ Link = Reciprocal{}
+)
+
+// The inverses of the links of means in an interval are kept inside the
+// interval by eps, so that the variances of the means stay positive.
+const eps = 0x1p-52
+
+// Identity is the identity link g(μ) = μ, the canonical link of the
+// Gaussian family.
+type Identity struct{}
+
+// Link returns μ.
+func (Identity) Link(mu float64) float64 { return mu }
+
+// Inverse returns η.
+func (Identity) Inverse(eta float64) float64 { return eta }
+
+// Deriv returns 1.
+func (Identity) Deriv(mu float64) float64 { return 1 }
+
+// Log is the log link g(μ) = log(μ), the canonical link of the Poisson
+// family.
+type Log struct{}
+
+// Link returns log(μ).
+func (Log) Link(mu float64) float64 { return math.Log(mu) }
+
+// Inverse returns exp(η), bounded below by a small positive value so that
+// the mean stays positive.
+func (Log) Inverse(eta float64) float64 { return math.Max(math.Exp(eta), eps) }
+
+// Deriv returns 1/μ.
+func (Log) Deriv(mu float64) float64 { return 1 / mu }
+
+// Logit is the logit link g(μ) = log(μ/(1-μ)), the canonical link of the
+// binomial family.
+type Logit struct{}
+
+// Link returns the log odds log(μ/(1-μ)).
+func (Logit) Link(mu float64) float64 { return math.Log(mu / (1 - mu)) }
+
+// Inverse returns the logistic function 1/(1+exp(-η)), kept strictly
+// inside the interval (0, 1).
+func (Logit) Inverse(eta float64) float64 {
+	return math.Min(math.Max(1/(1+math.Exp(-eta)), eps), 1-eps)
+}
+
+// Deriv returns 1/(μ(1-μ)).
+func (Logit) Deriv(mu float64) float64 { return 1 / (mu * (1 - mu)) }
+
+// Probit is the probit link g(μ) = Φ^-1(μ), where Φ is the distribution
+// function of the standard normal distribution.
+type Probit struct{}
+
+// Link returns the standard normal quantile Φ^-1(μ).
+func (Probit) Link(mu float64) float64 { return distuv.UnitNormal.Quantile(mu) }
+
+// Inverse returns the standard normal distribution function Φ(η), kept
+// strictly inside the interval (0, 1).
+func (Probit) Inverse(eta float64) float64 {
+	return math.Min(math.Max(distuv.UnitNormal.CDF(eta), eps), 1-eps)
+}
+
+// Deriv returns 1/φ(Φ^-1(μ)), where φ is the standard normal density.
+func (Probit) Deriv(mu float64) float64 {
+	return 1 / distuv.UnitNormal.Prob(distuv.UnitNormal.Quantile(mu))
+}
+
+// CLogLog is the complementary log-log link g(μ) = log(-log(1-μ)).
+type CLogLog struct{}
+
+// Link returns log(-log(1-μ)).
+func (CLogLog) Link(mu float64) float64 { return math.Log(-math.Log1p(-mu)) }
+
+// Inverse returns 1-exp(-exp(η)), kept strictly inside the interval (0, 1).
+func (CLogLog) Inverse(eta float64) float64 {
+	return math.Min(math.Max(-math.Expm1(-math.Exp(eta)), eps), 1-eps)
+}
+
+// Deriv returns -1/((1-μ) log(1-μ)).
+func (CLogLog) Deriv(mu float64) float64 { return -1 / ((1 - mu) * math.Log1p(-mu)) }
+
+// Reciprocal is the inverse link g(μ) = 1/μ, the canonical link of the
+// gamma family.
+type Reciprocal struct{}
+
+// Link returns 1/μ.
+func (Reciprocal) Link(mu float64) float64 { return 1 / mu }
+
+// Inverse returns 1/η.
+func (Reciprocal) Inverse(eta float64) float64 { return 1 / eta }
+
+// Deriv returns -1/μ².
+func (Reciprocal) Deriv(mu float64) float64 { return -1 / (mu * mu) }
@@ -0,0 +1,89 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package regression
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestLinks(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		link Link
+		mus  []float64
+	}{
+		{"Identity", Identity{}, []float64{-3, 0, 0.5, 10}},
+		{"Log", Log{}, []float64{0.01, 0.5, 1, 20}},
+		{"Logit", Logit{}, []float64{0.01, 0.3, 0.5, 0.9}},
+		{"Probit", Probit{}, []float64{0.01, 0.3, 0.5, 0.9}},
+		{"CLogLog", CLogLog{}, []float64{0.01, 0.3, 0.5, 0.9}},
+		{"Reciprocal", Reciprocal{}, []float64{0.1, 1, 5}},
+	} {
+		for _, mu := range test.mus {
+			eta := test.link.Link(mu)
+			if got := test.link.Inverse(eta); !scalar.EqualWithinAbsOrRel(got, mu, 1e-12, 1e-12) {
+				t.Errorf("%s: unexpected inverse of link at %v: got %v", test.name, mu, got)
+			}
+			want := fd.Derivative(test.link.Link, mu, &fd.Settings{Formula: fd.Central, Step: 1e-6})
+			if got := test.link.Deriv(mu); !scalar.EqualWithinAbsOrRel(got, want, 1e-6, 1e-6) {
+				t.Errorf("%s: unexpected derivative at %v: got %v, want %v", test.name, mu, got, want)
+			}
+		}
+	}
+	// The inverses of the links of probabilities stay inside (0, 1).
+	for _, link := range []Link{Logit{}, Probit{}, CLogLog{}} {
+		for _, eta := range []float64{-1000, 1000} {
+			if mu := link.Inverse(eta); !(0 < mu && mu < 1) {
+				t.Errorf("%T: inverse at %v out of range: %v", link, eta, mu)
+			}
+		}
+	}
+	if mu := (Log{}).Inverse(-1000); mu <= 0 {
+		t.Errorf("Log: inverse at -1000 not positive: %v", mu)
+	}
+}
+
+func TestFamilyDeviance(t *testing.T) {
+	t.Parallel()
+	// The unit deviance is twice the difference of the log likelihoods
+	// of the saturated model and the model.
+	for _, test := range []struct {
+		family Family
+		y, mu  float64
+	}{
+		{Gaussian{}, 1.5, 0.3},
+		{Binomial{}, 0.3, 0.6},
+		{Binomial{}, 1, 0.2},
+		{Poisson{}, 3, 4.5},
+		{Poisson{}, 0, 1.2},
+		{Gamma{}, 2, 0.7},
+	} {
+		want := 2 * (test.family.LogProb(test.y, test.y, 1, 1) - test.family.LogProb(test.y, test.mu, 1, 1))
+		if got := test.family.UnitDeviance(test.y, test.mu); !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+			t.Errorf("%T: unexpected unit deviance of %v at %v: got %v, want %v", test.family, test.y, test.mu, got, want)
+		}
+	}
+	// The log probabilities of the distributions of the families.
+	for _, test := range []struct {
+		family               Family
+		y, mu, dispersion, w float64
+		want                 float64
+	}{
+		{Gaussian{}, 1, 0.5, 4, 1, -0.5*math.Log(8*math.Pi) - 0.25/8},
+		{Binomial{}, 0.25, 0.5, 1, 4, math.Log(4) + 4*math.Log(0.5)},
+		{Poisson{}, 2, 3, 1, 1, 2*math.Log(3) - 3 - math.Log(2)},
+		{Gamma{}, 2, 1, 1, 1, -2},
+		{Gamma{}, 2, 1, 0.5, 1, math.Log(2) + math.Log(4) - 4},
+	} {
+		if got := test.family.LogProb(test.y, test.mu, test.dispersion, test.w); !scalar.EqualWithinAbsOrRel(got, test.want, 1e-12, 1e-12) {
+			t.Errorf("%T: unexpected log probability: got %v, want %v", test.family, got, test.want)
+		}
+	}
+}